@@ -0,0 +1,167 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/app"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/config"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/closer"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+const configPath = "./deploy/compose/inventory/.env"
+
+const usage = `usage:
+  catalog import [-file=parts.csv] [-format=csv|json|ndjson] [-dry-run]
+  catalog export [-file=parts.json] [-format=csv|json|ndjson]`
+
+const (
+	exitOK    = 0
+	exitError = 1
+	// exitUsage - неверные аргументы командной строки, как у пакета flag
+	exitUsage = 2
+)
+
+func main() {
+	// os.Exit не выполняет defer, поэтому вся работа вынесена в run
+	os.Exit(run())
+}
+
+// run выполняет команду и возвращает код завершения процесса.
+// Ошибки аргументов печатаются в stderr вместе с подсказкой, ошибки выполнения - в stderr и в лог
+func run() int {
+	if len(os.Args) < 2 || (os.Args[1] != "import" && os.Args[1] != "export") {
+		fmt.Fprintf(os.Stderr, "expected import or export command\n%s\n", usage)
+		return exitUsage
+	}
+	command := os.Args[1]
+
+	flags := flag.NewFlagSet(command, flag.ExitOnError)
+	file := flags.String("file", "", "файл каталога (по умолчанию stdin для импорта и stdout для выгрузки)")
+	formatName := flags.String("format", "", "формат каталога: csv, json или ndjson (по умолчанию по расширению файла)")
+	dryRun := flags.Bool("dry-run", false, "только проверить каталог без записи изменений")
+	_ = flags.Parse(os.Args[2:]) //nolint:errcheck // при ошибке ExitOnError завершает процесс с кодом 2
+
+	format, err := resolveFormat(*formatName, *file)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitUsage
+	}
+
+	err = config.Load(configPath)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error to load config: %v\n", err)
+		return exitError
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+	defer gracefulShutdown()
+
+	c, err := app.NewCatalog(ctx)
+	if err != nil {
+		logger.Error(ctx, "❌ Не удалось инициализировать каталог", zap.Error(err))
+		fmt.Fprintf(os.Stderr, "failed to init catalog: %v\n", err)
+		return exitError
+	}
+
+	if command == "import" {
+		err = runImport(ctx, c, *file, format, *dryRun)
+	} else {
+		err = runExport(ctx, c, *file, format)
+	}
+	if err != nil {
+		logger.Error(ctx, "❌ Ошибка при работе с каталогом", zap.String("command", command), zap.Error(err))
+		fmt.Fprintf(os.Stderr, "catalog %s failed: %v\n", command, err)
+		return exitError
+	}
+
+	return exitOK
+}
+
+func runImport(ctx context.Context, c *app.Catalog, path string, format model.CatalogFormat, dryRun bool) error {
+	var r io.Reader = os.Stdin
+	if path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	reader, err := converter.NewCatalogReader(r, format)
+	if err != nil {
+		return err
+	}
+
+	report, err := c.Import(ctx, &model.CatalogImport{Reader: reader, DryRun: dryRun})
+	if report != nil {
+		// Отчет печатаем и при прерванном импорте: он показывает, сколько строк уже записано
+		if writeErr := converter.WriteCatalogImportReportJSON(os.Stdout, report); writeErr != nil && err == nil {
+			err = writeErr
+		}
+	}
+	return err
+}
+
+func runExport(ctx context.Context, c *app.Catalog, path string, format model.CatalogFormat) error {
+	var w io.Writer = os.Stdout
+	if path != "" {
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	writer, err := converter.NewCatalogWriter(w, format)
+	if err != nil {
+		return err
+	}
+
+	count, err := c.Export(ctx, &model.CatalogExport{Write: writer.Write})
+	if err != nil {
+		return err
+	}
+	if err = writer.Close(); err != nil {
+		return err
+	}
+
+	logger.Info(ctx, "✅ Каталог выгружен", zap.Int("parts", count))
+	return nil
+}
+
+// resolveFormat берет формат из флага, а если он не задан — из расширения файла
+func resolveFormat(name, path string) (model.CatalogFormat, error) {
+	if name == "" {
+		name = strings.TrimPrefix(filepath.Ext(path), ".")
+	}
+	if name == "" {
+		return model.CATALOG_FORMAT_UNSPECIFIED, fmt.Errorf("-format is required when reading stdin or writing stdout\n%s", usage)
+	}
+	return converter.CatalogFormatFromString(name)
+}
+
+func gracefulShutdown() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := closer.CloseAll(ctx); err != nil {
+		logger.Error(ctx, "❌ Ошибка при завершении работы", zap.Error(err))
+	}
+}
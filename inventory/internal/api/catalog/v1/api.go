@@ -0,0 +1,25 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/service"
+	catalogV1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/openapi/inventory/v1"
+)
+
+var _ catalogV1.Handler = (*api)(nil)
+
+// api - публичный HTTP API каталога. Только чтение, ответы кэшируются по ETag
+type api struct {
+	partService     service.PartService
+	categoryService service.CategoryService
+	cacheMaxAge     time.Duration
+}
+
+func NewAPI(partService service.PartService, categoryService service.CategoryService, cacheMaxAge time.Duration) *api {
+	return &api{
+		partService:     partService,
+		categoryService: categoryService,
+		cacheMaxAge:     cacheMaxAge,
+	}
+}
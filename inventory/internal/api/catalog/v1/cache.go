@@ -0,0 +1,70 @@
+package v1
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	catalogV1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/openapi/inventory/v1"
+)
+
+// etagLength - сколько байт SHA-256 тела ответа попадает в ETag
+const etagLength = 16
+
+type jsonMarshaler interface {
+	MarshalJSON() ([]byte, error)
+}
+
+// etag возвращает сильный ETag тела ответа. Хэшируется каноническая форма JSON: ogen пишет
+// map (metadata) в порядке обхода, который в Go случаен, а после перекодирования ключи
+// отсортированы. Поэтому одинаковое содержимое дает одинаковый ETag на всех репликах
+// и при повторных запросах
+func etag(response jsonMarshaler) (string, error) {
+	body, err := response.MarshalJSON()
+	if err != nil {
+		return "", err
+	}
+	canonical, err := canonicalJSON(body)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(canonical)
+	return `"` + hex.EncodeToString(sum[:etagLength]) + `"`, nil
+}
+
+// canonicalJSON перекодирует JSON с отсортированными ключами объектов. Числа остаются
+// в исходной записи, чтобы перекодирование не меняло их точность
+func canonicalJSON(body []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return json.Marshal(value)
+}
+
+// notModified сообщает, совпал ли If-None-Match с текущим ETag. Заголовок может содержать
+// несколько ETag через запятую или *, слабые ETag (W/) сравниваются без префикса
+func notModified(ifNoneMatch catalogV1.OptString, current string) bool {
+	header, ok := ifNoneMatch.Get()
+	if !ok {
+		return false
+	}
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == "*" || candidate == current {
+			return true
+		}
+	}
+	return false
+}
+
+// cacheControl возвращает Cache-Control публичной части каталога
+func (a *api) cacheControl() string {
+	return fmt.Sprintf("public, max-age=%d", int(a.cacheMaxAge.Seconds()))
+}
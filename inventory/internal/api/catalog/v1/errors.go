@@ -0,0 +1,26 @@
+package v1
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+	catalogV1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/openapi/inventory/v1"
+)
+
+func badRequest(err error) *catalogV1.BadRequestError {
+	return &catalogV1.BadRequestError{
+		Error:   "BAD_REQUEST",
+		Message: err.Error(),
+	}
+}
+
+// internalError логирует причину и возвращает ответ без подробностей
+func internalError(ctx context.Context, operation string, err error) *catalogV1.InternalServerError {
+	logger.Error(ctx, "❌ Catalog request failed", zap.String("operation", operation), zap.Error(err))
+	return &catalogV1.InternalServerError{
+		Error:   "INTERNAL_ERROR",
+		Message: "An internal error occurred",
+	}
+}
@@ -0,0 +1,38 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	catalogV1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/openapi/inventory/v1"
+)
+
+func (a *api) GetPart(ctx context.Context, params catalogV1.GetPartParams) (catalogV1.GetPartRes, error) {
+	part, err := a.partService.GetPart(ctx, params.PartUUID.String(), converter.CatalogPartFields)
+	if err != nil {
+		if errors.Is(err, model.ErrPartNotFound) {
+			return &catalogV1.NotFoundError{
+				Error:   "NOT_FOUND",
+				Message: "part with UUID " + params.PartUUID.String() + " not found",
+			}, nil
+		}
+		return internalError(ctx, "GetPart", err), nil
+	}
+
+	catalogPart, err := converter.PartToCatalog(part)
+	if err != nil {
+		return internalError(ctx, "GetPart", err), nil
+	}
+	response := catalogV1.GetPartResponse{Part: catalogPart}
+	tag, err := etag(&response)
+	if err != nil {
+		return internalError(ctx, "GetPart", err), nil
+	}
+
+	if notModified(params.IfNoneMatch, tag) {
+		return &catalogV1.GetPartNotModified{CacheControl: a.cacheControl(), ETag: tag}, nil
+	}
+	return &catalogV1.GetPartResponseHeaders{CacheControl: a.cacheControl(), ETag: tag, Response: response}, nil
+}
@@ -0,0 +1,140 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/google/uuid"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	catalogV1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/openapi/inventory/v1"
+)
+
+func catalogPart(partUUID string) *model.Part {
+	updatedAt := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	return &model.Part{
+		Uuid:          partUUID,
+		Name:          "RD-180",
+		Description:   "Жидкостный ракетный двигатель",
+		Price:         2500000,
+		StockQuantity: 3,
+		Category:      model.CATEGORY_ENGINE,
+		Manufacturer:  &model.Manufacturer{Name: "НПО Энергомаш", Country: "Russia"},
+		Tags:          []string{"engine"},
+		Metadata:      map[string]interface{}{"тяга": 3827000.0},
+		UpdatedAt:     &updatedAt,
+		Attachments: []*model.Attachment{{
+			Uuid:        gofakeit.UUID(),
+			Kind:        model.ATTACHMENT_KIND_IMAGE,
+			FileName:    "rd-180.png",
+			ContentType: "image/png",
+		}},
+		StockLocations: []*model.StockLocation{{WarehouseUuid: gofakeit.UUID(), Quantity: 3}},
+	}
+}
+
+func (s *APISuite) TestGetPartSuccess() {
+	partUUID := uuid.New()
+	s.partService.On("GetPart", s.ctx, partUUID.String(), converter.CatalogPartFields).
+		Return(catalogPart(partUUID.String()), nil)
+
+	res, err := s.api.GetPart(s.ctx, catalogV1.GetPartParams{PartUUID: partUUID})
+	s.Require().NoError(err)
+
+	ok, isOK := res.(*catalogV1.GetPartResponseHeaders)
+	s.Require().True(isOK)
+	s.Equal("public, max-age=60", ok.CacheControl)
+	s.NotEmpty(ok.ETag)
+	s.Equal(partUUID, ok.Response.Part.UUID)
+	s.True(ok.Response.Part.InStock)
+	s.Equal("ENGINE", ok.Response.Part.Category)
+	s.Equal("rd-180.png", ok.Response.Part.PrimaryImage.Value.FileName)
+	s.JSONEq("3827000", string(ok.Response.Part.Metadata.Value["тяга"]))
+}
+
+func (s *APISuite) TestGetPartETagStableWithMetadata() {
+	partUUID := uuid.New()
+	part := catalogPart(partUUID.String())
+	part.Metadata = map[string]interface{}{
+		"тяга":             3827000.0,
+		"масса":            5480.0,
+		"топливо":          "керосин",
+		"окислитель":       "кислород",
+		"камер":            2.0,
+		"перезапуск":       false,
+		"удельный_импульс": 311.3,
+	}
+	s.partService.On("GetPart", s.ctx, partUUID.String(), converter.CatalogPartFields).Return(part, nil)
+
+	// Порядок обхода map случаен, поэтому ETag сравнивается на нескольких запросах
+	var first string
+	for range 20 {
+		res, err := s.api.GetPart(s.ctx, catalogV1.GetPartParams{PartUUID: partUUID})
+		s.Require().NoError(err)
+
+		tag := res.(*catalogV1.GetPartResponseHeaders).ETag
+		if first == "" {
+			first = tag
+		}
+		s.Require().Equal(first, tag)
+	}
+}
+
+func (s *APISuite) TestGetPartNotModified() {
+	partUUID := uuid.New()
+	part := catalogPart(partUUID.String())
+	s.partService.On("GetPart", s.ctx, partUUID.String(), converter.CatalogPartFields).Return(part, nil)
+
+	res, err := s.api.GetPart(s.ctx, catalogV1.GetPartParams{PartUUID: partUUID})
+	s.Require().NoError(err)
+	tag := res.(*catalogV1.GetPartResponseHeaders).ETag
+
+	res, err = s.api.GetPart(s.ctx, catalogV1.GetPartParams{
+		PartUUID:    partUUID,
+		IfNoneMatch: catalogV1.NewOptString(`"stale", W/` + tag),
+	})
+	s.Require().NoError(err)
+
+	notModified, isNotModified := res.(*catalogV1.GetPartNotModified)
+	s.Require().True(isNotModified)
+	s.Equal(tag, notModified.ETag)
+}
+
+func (s *APISuite) TestGetPartChangedETag() {
+	partUUID := uuid.New()
+	part := catalogPart(partUUID.String())
+	s.partService.On("GetPart", s.ctx, partUUID.String(), converter.CatalogPartFields).Return(part, nil).Once()
+
+	res, err := s.api.GetPart(s.ctx, catalogV1.GetPartParams{PartUUID: partUUID})
+	s.Require().NoError(err)
+	tag := res.(*catalogV1.GetPartResponseHeaders).ETag
+
+	changed := catalogPart(partUUID.String())
+	changed.Price = 2600000
+	s.partService.On("GetPart", s.ctx, partUUID.String(), converter.CatalogPartFields).Return(changed, nil).Once()
+
+	res, err = s.api.GetPart(s.ctx, catalogV1.GetPartParams{
+		PartUUID:    partUUID,
+		IfNoneMatch: catalogV1.NewOptString(tag),
+	})
+	s.Require().NoError(err)
+
+	ok, isOK := res.(*catalogV1.GetPartResponseHeaders)
+	s.Require().True(isOK)
+	s.NotEqual(tag, ok.ETag)
+	s.Equal(2600000.0, ok.Response.Part.Price)
+}
+
+func (s *APISuite) TestGetPartNotFound() {
+	partUUID := uuid.New()
+	s.partService.On("GetPart", s.ctx, partUUID.String(), converter.CatalogPartFields).
+		Return(nil, model.ErrPartNotFound)
+
+	res, err := s.api.GetPart(s.ctx, catalogV1.GetPartParams{PartUUID: partUUID})
+	s.Require().NoError(err)
+
+	notFound, isNotFound := res.(*catalogV1.NotFoundError)
+	s.Require().True(isNotFound)
+	s.Equal("NOT_FOUND", notFound.Error)
+}
@@ -0,0 +1,26 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	catalogV1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/openapi/inventory/v1"
+)
+
+func (a *api) ListCategories(ctx context.Context, params catalogV1.ListCategoriesParams) (catalogV1.ListCategoriesRes, error) {
+	categories, err := a.categoryService.ListCategories(ctx)
+	if err != nil {
+		return internalError(ctx, "ListCategories", err), nil
+	}
+
+	response := converter.PartCategoriesToCatalog(categories)
+	tag, err := etag(&response)
+	if err != nil {
+		return internalError(ctx, "ListCategories", err), nil
+	}
+
+	if notModified(params.IfNoneMatch, tag) {
+		return &catalogV1.ListCategoriesNotModified{CacheControl: a.cacheControl(), ETag: tag}, nil
+	}
+	return &catalogV1.ListCategoriesResponseHeaders{CacheControl: a.cacheControl(), ETag: tag, Response: response}, nil
+}
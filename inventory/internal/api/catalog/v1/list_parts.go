@@ -0,0 +1,35 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	catalogV1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/openapi/inventory/v1"
+)
+
+func (a *api) ListParts(ctx context.Context, params catalogV1.ListPartsParams) (catalogV1.ListPartsRes, error) {
+	page, err := a.partService.ListParts(ctx, converter.PartsQueryFromCatalog(params))
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidPageToken) || errors.Is(err, model.ErrInvalidPageSize) ||
+			errors.Is(err, model.ErrInvalidFilter) {
+			return badRequest(err), nil
+		}
+		return internalError(ctx, "ListParts", err), nil
+	}
+
+	response, err := converter.PartsPageToCatalog(page)
+	if err != nil {
+		return internalError(ctx, "ListParts", err), nil
+	}
+	tag, err := etag(&response)
+	if err != nil {
+		return internalError(ctx, "ListParts", err), nil
+	}
+
+	if notModified(params.IfNoneMatch, tag) {
+		return &catalogV1.ListPartsNotModified{CacheControl: a.cacheControl(), ETag: tag}, nil
+	}
+	return &catalogV1.ListPartsResponseHeaders{CacheControl: a.cacheControl(), ETag: tag, Response: response}, nil
+}
@@ -0,0 +1,133 @@
+package v1
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	catalogV1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/openapi/inventory/v1"
+)
+
+func (s *APISuite) TestListPartsFilter() {
+	partUUID := uuid.New()
+	s.partService.On("ListParts", s.ctx, mock.MatchedBy(func(q *model.PartsQuery) bool {
+		return q.Filter != nil &&
+			len(q.Filter.Categories) == 1 && q.Filter.Categories[0] == model.CATEGORY_ENGINE &&
+			q.Filter.Price != nil && *q.Filter.Price.Min == 1000 && q.Filter.Price.Max == nil &&
+			q.Filter.InStockOnly &&
+			q.SortBy == model.PARTS_SORT_FIELD_PRICE && q.Descending &&
+			q.PageSize == 10 && q.PageToken == "token"
+	})).Return(&model.PartsPage{
+		Parts:         []*model.Part{catalogPart(partUUID.String())},
+		NextPageToken: "next",
+		TotalSize:     11,
+	}, nil)
+
+	res, err := s.api.ListParts(s.ctx, catalogV1.ListPartsParams{
+		Category:   []string{"ENGINE"},
+		MinPrice:   catalogV1.NewOptFloat64(1000),
+		InStock:    catalogV1.NewOptBool(true),
+		SortBy:     catalogV1.NewOptPartsSortField(catalogV1.PartsSortFieldPRICE),
+		Descending: catalogV1.NewOptBool(true),
+		PageSize:   catalogV1.NewOptInt32(10),
+		PageToken:  catalogV1.NewOptString("token"),
+	})
+	s.Require().NoError(err)
+
+	ok, isOK := res.(*catalogV1.ListPartsResponseHeaders)
+	s.Require().True(isOK)
+	s.Len(ok.Response.Parts, 1)
+	s.Equal("next", ok.Response.NextPageToken.Value)
+	s.Equal(int64(11), ok.Response.TotalSize)
+}
+
+func (s *APISuite) TestListPartsWithoutFilter() {
+	s.partService.On("ListParts", s.ctx, mock.MatchedBy(func(q *model.PartsQuery) bool {
+		return q.Filter == nil && q.SortBy == model.PARTS_SORT_FIELD_CREATED_AT && len(q.Fields) > 0
+	})).Return(&model.PartsPage{}, nil)
+
+	res, err := s.api.ListParts(s.ctx, catalogV1.ListPartsParams{})
+	s.Require().NoError(err)
+
+	ok, isOK := res.(*catalogV1.ListPartsResponseHeaders)
+	s.Require().True(isOK)
+	s.Empty(ok.Response.Parts)
+	s.False(ok.Response.NextPageToken.IsSet())
+}
+
+func (s *APISuite) TestListPartsErrors() {
+	s.partService.On("ListParts", s.ctx, mock.Anything).Return(nil, model.ErrInvalidPageToken).Once()
+
+	res, err := s.api.ListParts(s.ctx, catalogV1.ListPartsParams{PageToken: catalogV1.NewOptString("bad")})
+	s.Require().NoError(err)
+	s.IsType(&catalogV1.BadRequestError{}, res)
+
+	s.partService.On("ListParts", s.ctx, mock.Anything).Return(nil, errors.New("mongo is down")).Once()
+
+	res, err = s.api.ListParts(s.ctx, catalogV1.ListPartsParams{})
+	s.Require().NoError(err)
+
+	internal, isInternal := res.(*catalogV1.InternalServerError)
+	s.Require().True(isInternal)
+	s.NotContains(internal.Message, "mongo")
+}
+
+func (s *APISuite) TestSearchParts() {
+	partUUID := uuid.New()
+	s.partService.On("SearchParts", s.ctx, mock.MatchedBy(func(search *model.PartsSearch) bool {
+		return search.Query == "двигатель" && search.Language == model.SEARCH_LANGUAGE_RUSSIAN &&
+			search.Filter != nil && len(search.Filter.Tags) == 1
+	})).Return([]*model.PartSearchHit{{Part: catalogPart(partUUID.String()), Score: 1.5}}, nil)
+
+	res, err := s.api.SearchParts(s.ctx, catalogV1.SearchPartsParams{
+		Q:    "двигатель",
+		Lang: catalogV1.NewOptSearchLanguage(catalogV1.SearchLanguageRUSSIAN),
+		Tag:  []string{"engine"},
+	})
+	s.Require().NoError(err)
+
+	ok, isOK := res.(*catalogV1.SearchPartsResponseHeaders)
+	s.Require().True(isOK)
+	s.Require().Len(ok.Response.Hits, 1)
+	s.Equal(partUUID, ok.Response.Hits[0].Part.UUID)
+	s.Equal(1.5, ok.Response.Hits[0].Score)
+}
+
+func (s *APISuite) TestSearchPartsEmptyQuery() {
+	s.partService.On("SearchParts", s.ctx, mock.Anything).Return(nil, model.ErrEmptySearchQuery)
+
+	res, err := s.api.SearchParts(s.ctx, catalogV1.SearchPartsParams{Q: " "})
+	s.Require().NoError(err)
+	s.IsType(&catalogV1.BadRequestError{}, res)
+}
+
+func (s *APISuite) TestListCategories() {
+	minThrust := 0.0
+	s.categoryService.On("ListCategories", s.ctx).Return([]*model.PartCategory{
+		{Code: model.CATEGORY_ENGINE, DisplayName: "Двигатель", MetadataSchema: []*model.MetadataField{{
+			Key:      "тяга",
+			Type:     model.METADATA_FIELD_TYPE_NUMBER,
+			Required: true,
+			Range:    &model.FloatRange{Min: &minThrust},
+		}}},
+		{Code: "ION_ENGINE", ParentCode: model.CATEGORY_ENGINE, DisplayName: "Ионный двигатель"},
+	}, nil)
+
+	res, err := s.api.ListCategories(s.ctx, catalogV1.ListCategoriesParams{})
+	s.Require().NoError(err)
+
+	ok, isOK := res.(*catalogV1.ListCategoriesResponseHeaders)
+	s.Require().True(isOK)
+	s.Require().Len(ok.Response.Categories, 2)
+	s.False(ok.Response.Categories[0].ParentCode.IsSet())
+	s.Equal(catalogV1.MetadataFieldTypeNUMBER, ok.Response.Categories[0].MetadataSchema[0].Type)
+	s.Equal(0.0, ok.Response.Categories[0].MetadataSchema[0].Min.Value)
+	s.False(ok.Response.Categories[0].MetadataSchema[0].Max.IsSet())
+	s.Equal("ENGINE", ok.Response.Categories[1].ParentCode.Value)
+
+	res, err = s.api.ListCategories(s.ctx, catalogV1.ListCategoriesParams{IfNoneMatch: catalogV1.NewOptString("*")})
+	s.Require().NoError(err)
+	s.IsType(&catalogV1.ListCategoriesNotModified{}, res)
+}
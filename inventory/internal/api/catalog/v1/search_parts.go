@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	catalogV1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/openapi/inventory/v1"
+)
+
+func (a *api) SearchParts(ctx context.Context, params catalogV1.SearchPartsParams) (catalogV1.SearchPartsRes, error) {
+	hits, err := a.partService.SearchParts(ctx, converter.PartsSearchFromCatalog(params))
+	if err != nil {
+		if errors.Is(err, model.ErrEmptySearchQuery) || errors.Is(err, model.ErrInvalidFilter) {
+			return badRequest(err), nil
+		}
+		return internalError(ctx, "SearchParts", err), nil
+	}
+
+	response, err := converter.PartSearchHitsToCatalog(hits)
+	if err != nil {
+		return internalError(ctx, "SearchParts", err), nil
+	}
+	tag, err := etag(&response)
+	if err != nil {
+		return internalError(ctx, "SearchParts", err), nil
+	}
+
+	if notModified(params.IfNoneMatch, tag) {
+		return &catalogV1.SearchPartsNotModified{CacheControl: a.cacheControl(), ETag: tag}, nil
+	}
+	return &catalogV1.SearchPartsResponseHeaders{CacheControl: a.cacheControl(), ETag: tag, Response: response}, nil
+}
@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/service/mocks"
+)
+
+type APISuite struct {
+	suite.Suite
+	ctx             context.Context
+	partService     *mocks.PartService
+	categoryService *mocks.CategoryService
+	api             *api
+}
+
+func (s *APISuite) SetupTest() {
+	s.ctx = context.Background()
+
+	s.partService = mocks.NewPartService(s.T())
+	s.categoryService = mocks.NewCategoryService(s.T())
+
+	s.api = NewAPI(s.partService, s.categoryService, time.Minute)
+}
+
+func (s *APISuite) TearDownTest() {}
+
+func TestAPIIntegration(t *testing.T) {
+	suite.Run(t, new(APISuite))
+}
@@ -0,0 +1,98 @@
+package v1
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (s *ServiceSuite) TestCreatePartSuccess() {
+	partUUID := gofakeit.UUID()
+
+	request := &inventoryv1.CreatePartRequest{
+		Part: &inventoryv1.Part{
+			Name:     "Main Engine",
+			Price:    1500000.00,
+			Category: inventoryv1.Category_CATEGORY_ENGINE,
+		},
+	}
+
+	s.partService.On("CreatePart", s.ctx, mock.AnythingOfType("*model.Part")).
+		Return(&model.Part{Uuid: partUUID, Name: "Main Engine", Category: model.CATEGORY_ENGINE}, nil)
+
+	response, err := s.api.CreatePart(s.ctx, request)
+	s.Require().NoError(err)
+	s.Require().Equal(partUUID, response.GetPart().GetUuid())
+}
+
+func (s *ServiceSuite) TestCreatePartInvalid() {
+	request := &inventoryv1.CreatePartRequest{
+		Part: &inventoryv1.Part{Name: "Main Engine"},
+	}
+
+	s.partService.On("CreatePart", s.ctx, mock.AnythingOfType("*model.Part")).Return(nil, model.ErrInvalidPart)
+
+	response, err := s.api.CreatePart(s.ctx, request)
+	s.Require().Nil(response)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *ServiceSuite) TestUpdatePartPassesMask() {
+	partUUID := gofakeit.UUID()
+
+	request := &inventoryv1.UpdatePartRequest{
+		Part:       &inventoryv1.Part{Uuid: partUUID, Name: "Main Engine Mk2", Version: 1},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{model.PartFieldName}},
+	}
+
+	s.partService.On("UpdatePart", s.ctx, mock.MatchedBy(func(update *model.PartUpdate) bool {
+		return update.Part.Uuid == partUUID && update.Part.Version == 1 &&
+			len(update.Fields) == 1 && update.Fields[0] == model.PartFieldName
+	})).Return(&model.Part{Uuid: partUUID, Name: "Main Engine Mk2", Version: 2}, nil)
+
+	response, err := s.api.UpdatePart(s.ctx, request)
+	s.Require().NoError(err)
+	s.Require().Equal("Main Engine Mk2", response.GetPart().GetName())
+	s.Require().Equal(int64(2), response.GetPart().GetVersion())
+}
+
+func (s *ServiceSuite) TestUpdatePartNotFound() {
+	partUUID := gofakeit.UUID()
+
+	s.partService.On("UpdatePart", s.ctx, mock.AnythingOfType("*model.PartUpdate")).Return(nil, model.ErrPartNotFound)
+
+	response, err := s.api.UpdatePart(s.ctx, &inventoryv1.UpdatePartRequest{
+		Part: &inventoryv1.Part{Uuid: partUUID},
+	})
+	s.Require().Nil(response)
+	s.Require().Equal(codes.NotFound, status.Code(err))
+}
+
+func (s *ServiceSuite) TestUpdatePartVersionMismatch() {
+	partUUID := gofakeit.UUID()
+
+	s.partService.On("UpdatePart", s.ctx, mock.MatchedBy(func(update *model.PartUpdate) bool {
+		return update.Part.Version == 3
+	})).Return(nil, model.ErrPartVersionMismatch)
+
+	response, err := s.api.UpdatePart(s.ctx, &inventoryv1.UpdatePartRequest{
+		Part: &inventoryv1.Part{Uuid: partUUID, Version: 3, Price: 1650000.00},
+	})
+	s.Require().Nil(response)
+	s.Require().Equal(codes.Aborted, status.Code(err))
+}
+
+func (s *ServiceSuite) TestDeletePartNotFound() {
+	partUUID := gofakeit.UUID()
+
+	s.partService.On("DeletePart", s.ctx, partUUID).Return(model.ErrPartNotFound)
+
+	response, err := s.api.DeletePart(s.ctx, &inventoryv1.DeletePartRequest{Uuid: partUUID})
+	s.Require().Nil(response)
+	s.Require().Equal(codes.NotFound, status.Code(err))
+}
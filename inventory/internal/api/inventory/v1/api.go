@@ -7,11 +7,34 @@ import (
 
 type api struct {
 	inventoryv1.UnimplementedInventoryServiceServer
-	partService service.PartService
+	partService          service.PartService
+	stockService         service.StockService
+	compatibilityService service.CompatibilityService
+	rocketModelService   service.RocketModelService
+	attachmentService    service.AttachmentService
+	priceService         service.PriceService
+	warehouseService     service.WarehouseService
+	categoryService      service.CategoryService
 }
 
-func NewAPI(partService service.PartService) *api {
+func NewAPI(
+	partService service.PartService,
+	stockService service.StockService,
+	compatibilityService service.CompatibilityService,
+	rocketModelService service.RocketModelService,
+	attachmentService service.AttachmentService,
+	priceService service.PriceService,
+	warehouseService service.WarehouseService,
+	categoryService service.CategoryService,
+) *api {
 	return &api{
-		partService: partService,
+		partService:          partService,
+		stockService:         stockService,
+		compatibilityService: compatibilityService,
+		rocketModelService:   rocketModelService,
+		attachmentService:    attachmentService,
+		priceService:         priceService,
+		warehouseService:     warehouseService,
+		categoryService:      categoryService,
 	}
 }
@@ -0,0 +1,174 @@
+package v1
+
+import (
+	"context"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+type uploadAttachmentStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*inventoryv1.UploadAttachmentRequest
+	response *inventoryv1.UploadAttachmentResponse
+}
+
+func (s *uploadAttachmentStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *uploadAttachmentStream) Recv() (*inventoryv1.UploadAttachmentRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *uploadAttachmentStream) SendAndClose(response *inventoryv1.UploadAttachmentResponse) error {
+	s.response = response
+	return nil
+}
+
+type downloadAttachmentStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*inventoryv1.DownloadAttachmentResponse
+}
+
+func (s *downloadAttachmentStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *downloadAttachmentStream) Send(response *inventoryv1.DownloadAttachmentResponse) error {
+	s.responses = append(s.responses, response)
+	return nil
+}
+
+func (s *ServiceSuite) TestUploadAttachmentReadsChunks() {
+	partUUID := gofakeit.UUID()
+	stream := &uploadAttachmentStream{
+		ctx: s.ctx,
+		requests: []*inventoryv1.UploadAttachmentRequest{
+			{
+				Upload: &inventoryv1.AttachmentUpload{
+					PartUuid:    partUUID,
+					Kind:        inventoryv1.AttachmentKind_ATTACHMENT_KIND_DATASHEET,
+					FileName:    "datasheet.pdf",
+					ContentType: "application/pdf",
+				},
+				Chunk: []byte("%PDF-"),
+			},
+			{Chunk: []byte("1.7")},
+		},
+	}
+
+	var content string
+	s.attachmentService.On("UploadAttachment", s.ctx, mock.MatchedBy(func(u *model.AttachmentUpload) bool {
+		return u.PartUuid == partUUID && u.Kind == model.ATTACHMENT_KIND_DATASHEET
+	})).Return(func(_ context.Context, u *model.AttachmentUpload) (*model.Attachment, error) {
+		data, err := io.ReadAll(u.Content)
+		if err != nil {
+			return nil, err
+		}
+		content = string(data)
+		return &model.Attachment{
+			Uuid:      gofakeit.UUID(),
+			PartUuid:  u.PartUuid,
+			Kind:      u.Kind,
+			Size:      int64(len(data)),
+			CreatedAt: time.Now(),
+		}, nil
+	})
+
+	err := s.api.UploadAttachment(stream)
+	s.Require().NoError(err)
+	s.Require().Equal("%PDF-1.7", content)
+	s.Require().Equal(int64(8), stream.response.GetAttachment().GetSize())
+	s.Require().Equal(inventoryv1.AttachmentKind_ATTACHMENT_KIND_DATASHEET, stream.response.GetAttachment().GetKind())
+}
+
+func (s *ServiceSuite) TestUploadAttachmentWithoutDescription() {
+	stream := &uploadAttachmentStream{
+		ctx:      s.ctx,
+		requests: []*inventoryv1.UploadAttachmentRequest{{Chunk: []byte("%PDF-1.7")}},
+	}
+
+	err := s.api.UploadAttachment(stream)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *ServiceSuite) TestUploadAttachmentErrors() {
+	tests := map[error]codes.Code{
+		model.ErrPartNotFound:               codes.NotFound,
+		model.ErrInvalidAttachment:          codes.InvalidArgument,
+		model.ErrAttachmentChecksumMismatch: codes.InvalidArgument,
+		model.ErrAttachmentTooLarge:         codes.ResourceExhausted,
+	}
+
+	for serviceErr, code := range tests {
+		s.Run(serviceErr.Error(), func() {
+			s.SetupTest()
+
+			stream := &uploadAttachmentStream{
+				ctx: s.ctx,
+				requests: []*inventoryv1.UploadAttachmentRequest{{
+					Upload: &inventoryv1.AttachmentUpload{PartUuid: gofakeit.UUID()},
+				}},
+			}
+			s.attachmentService.On("UploadAttachment", s.ctx, mock.AnythingOfType("*model.AttachmentUpload")).
+				Return(nil, serviceErr)
+
+			err := s.api.UploadAttachment(stream)
+			s.Require().Equal(code, status.Code(err))
+		})
+	}
+}
+
+func (s *ServiceSuite) TestDownloadAttachmentSendsDescriptionAndChunks() {
+	attachmentUUID := gofakeit.UUID()
+	content := strings.Repeat("x", streamChunkSize+10)
+	stream := &downloadAttachmentStream{ctx: s.ctx}
+
+	s.attachmentService.On("OpenAttachment", s.ctx, attachmentUUID).Return(
+		&model.Attachment{Uuid: attachmentUUID, FileName: "engine.png", CreatedAt: time.Now()},
+		io.NopCloser(strings.NewReader(content)),
+		nil,
+	)
+
+	err := s.api.DownloadAttachment(&inventoryv1.DownloadAttachmentRequest{Uuid: attachmentUUID}, stream)
+	s.Require().NoError(err)
+	s.Require().Len(stream.responses, 3)
+	s.Require().Equal("engine.png", stream.responses[0].GetAttachment().GetFileName())
+	s.Require().Len(stream.responses[1].GetChunk(), streamChunkSize)
+	s.Require().Len(stream.responses[2].GetChunk(), 10)
+}
+
+func (s *ServiceSuite) TestDownloadAttachmentNotFound() {
+	attachmentUUID := gofakeit.UUID()
+
+	s.attachmentService.On("OpenAttachment", s.ctx, attachmentUUID).Return(nil, nil, model.ErrAttachmentNotFound)
+
+	err := s.api.DownloadAttachment(&inventoryv1.DownloadAttachmentRequest{Uuid: attachmentUUID}, &downloadAttachmentStream{ctx: s.ctx})
+	s.Require().Equal(codes.NotFound, status.Code(err))
+}
+
+func (s *ServiceSuite) TestDeleteAttachmentNotFound() {
+	attachmentUUID := gofakeit.UUID()
+
+	s.attachmentService.On("DeleteAttachment", s.ctx, attachmentUUID).Return(model.ErrAttachmentNotFound)
+
+	_, err := s.api.DeleteAttachment(s.ctx, &inventoryv1.DeleteAttachmentRequest{Uuid: attachmentUUID})
+	s.Require().Equal(codes.NotFound, status.Code(err))
+}
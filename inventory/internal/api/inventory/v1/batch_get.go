@@ -0,0 +1,25 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) BatchGetParts(ctx context.Context, req *inventoryv1.BatchGetPartsRequest) (*inventoryv1.BatchGetPartsResponse, error) {
+	batch, err := a.partService.BatchGetParts(ctx, req.GetUuids(), converter.ReadMaskFromProto(req.GetReadMask().GetPaths()))
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidReadMask) || errors.Is(err, model.ErrTooManyParts) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+
+	return converter.PartsBatchToProto(batch), nil
+}
@@ -0,0 +1,165 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+type importPartsStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	requests []*inventoryv1.ImportPartsRequest
+	response *inventoryv1.ImportPartsResponse
+}
+
+func (s *importPartsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *importPartsStream) Recv() (*inventoryv1.ImportPartsRequest, error) {
+	if len(s.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := s.requests[0]
+	s.requests = s.requests[1:]
+	return req, nil
+}
+
+func (s *importPartsStream) SendAndClose(response *inventoryv1.ImportPartsResponse) error {
+	s.response = response
+	return nil
+}
+
+type exportPartsStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	chunks []string
+}
+
+func (s *exportPartsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *exportPartsStream) Send(response *inventoryv1.ExportPartsResponse) error {
+	s.chunks = append(s.chunks, string(response.GetChunk()))
+	return nil
+}
+
+func (s *ServiceSuite) TestImportPartsReadsChunks() {
+	partUUID := gofakeit.UUID()
+	header := &inventoryv1.ImportPartsRequest{
+		Format: inventoryv1.CatalogFormat_CATALOG_FORMAT_CSV,
+		DryRun: true,
+		Chunk:  []byte("uuid,name,pri"),
+	}
+	stream := &importPartsStream{
+		ctx: s.ctx,
+		requests: []*inventoryv1.ImportPartsRequest{
+			header,
+			{Chunk: []byte("ce\n" + partUUID + ",Main Engine,")},
+			{Chunk: []byte("1500\n")},
+		},
+	}
+
+	var imported []*model.CatalogRow
+	s.partService.On("ImportParts", s.ctx, mock.MatchedBy(func(i *model.CatalogImport) bool {
+		return i.DryRun
+	})).Return(func(_ context.Context, i *model.CatalogImport) (*model.CatalogImportReport, error) {
+		for {
+			row, err := i.Reader.Next()
+			if errors.Is(err, io.EOF) {
+				return &model.CatalogImportReport{DryRun: true, Total: len(imported), Created: len(imported)}, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+			imported = append(imported, row)
+		}
+	})
+
+	err := s.api.ImportParts(stream)
+	s.Require().NoError(err)
+	s.Require().Len(imported, 1)
+	s.Require().Equal(partUUID, imported[0].PartUuid)
+	s.Require().Equal(1500.0, imported[0].Part.Price)
+	s.Require().True(stream.response.GetDryRun())
+	s.Require().Equal(int32(1), stream.response.GetCreated())
+}
+
+func (s *ServiceSuite) TestImportPartsEmptyStream() {
+	err := s.api.ImportParts(&importPartsStream{ctx: s.ctx})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *ServiceSuite) TestImportPartsFormatRequired() {
+	stream := &importPartsStream{
+		ctx:      s.ctx,
+		requests: []*inventoryv1.ImportPartsRequest{{Chunk: []byte("uuid\n")}},
+	}
+
+	err := s.api.ImportParts(stream)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *ServiceSuite) TestImportPartsInvalidCatalog() {
+	stream := &importPartsStream{
+		ctx: s.ctx,
+		requests: []*inventoryv1.ImportPartsRequest{{
+			Format: inventoryv1.CatalogFormat_CATALOG_FORMAT_NDJSON,
+			Chunk:  []byte("{\"uuid\":"),
+		}},
+	}
+
+	s.partService.On("ImportParts", s.ctx, mock.AnythingOfType("*model.CatalogImport")).
+		Return(&model.CatalogImportReport{}, model.ErrInvalidCatalog)
+
+	err := s.api.ImportParts(stream)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *ServiceSuite) TestExportPartsSendsCatalog() {
+	part := &model.Part{
+		Uuid:     gofakeit.UUID(),
+		Name:     "Main Engine",
+		Price:    1500,
+		Category: model.CATEGORY_ENGINE,
+	}
+	stream := &exportPartsStream{ctx: s.ctx}
+
+	s.partService.On("ExportParts", s.ctx, mock.AnythingOfType("*model.CatalogExport")).
+		Return(func(_ context.Context, e *model.CatalogExport) (int, error) {
+			return 1, e.Write(part)
+		})
+
+	err := s.api.ExportParts(&inventoryv1.ExportPartsRequest{
+		Format: inventoryv1.CatalogFormat_CATALOG_FORMAT_NDJSON,
+	}, stream)
+	s.Require().NoError(err)
+
+	output := strings.Join(stream.chunks, "")
+	s.Require().Contains(output, part.Uuid)
+	s.Require().Contains(output, `"category":"ENGINE"`)
+}
+
+func (s *ServiceSuite) TestExportPartsInvalidFilter() {
+	stream := &exportPartsStream{ctx: s.ctx}
+
+	s.partService.On("ExportParts", s.ctx, mock.AnythingOfType("*model.CatalogExport")).
+		Return(0, model.ErrInvalidFilter)
+
+	err := s.api.ExportParts(&inventoryv1.ExportPartsRequest{
+		Format: inventoryv1.CatalogFormat_CATALOG_FORMAT_CSV,
+	}, stream)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,96 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (s *ServiceSuite) TestCreateCategorySuccess() {
+	s.categoryService.On("CreateCategory", s.ctx, mock.MatchedBy(func(c *model.PartCategory) bool {
+		return c.Code == "LIQUID_ENGINE" && c.ParentCode == model.CATEGORY_ENGINE &&
+			c.MetadataSchema[0].Type == model.METADATA_FIELD_TYPE_NUMBER
+	})).Return(&model.PartCategory{
+		Code:        "LIQUID_ENGINE",
+		ParentCode:  model.CATEGORY_ENGINE,
+		DisplayName: "ЖРД",
+		CreatedAt:   time.Now(),
+	}, nil)
+
+	response, err := s.api.CreateCategory(s.ctx, &inventoryv1.CreateCategoryRequest{
+		Category: &inventoryv1.PartCategory{
+			Code:        "LIQUID_ENGINE",
+			ParentCode:  "ENGINE",
+			DisplayName: "ЖРД",
+			MetadataSchema: []*inventoryv1.MetadataField{
+				{Key: "тяга", Type: inventoryv1.MetadataFieldType_METADATA_FIELD_TYPE_NUMBER, Unit: "кН"},
+			},
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal("LIQUID_ENGINE", response.GetCategory().GetCode())
+	s.Require().Equal("ENGINE", response.GetCategory().GetParentCode())
+}
+
+func (s *ServiceSuite) TestCreateCategoryAlreadyExists() {
+	s.categoryService.On("CreateCategory", s.ctx, mock.Anything).Return(nil, model.ErrCategoryAlreadyExists)
+
+	response, err := s.api.CreateCategory(s.ctx, &inventoryv1.CreateCategoryRequest{
+		Category: &inventoryv1.PartCategory{Code: "ENGINE", DisplayName: "Двигатель"},
+	})
+	s.Require().Nil(response)
+	s.Require().Equal(codes.AlreadyExists, status.Code(err))
+}
+
+func (s *ServiceSuite) TestUpdateCategoryInvalidMask() {
+	s.categoryService.On("UpdateCategory", s.ctx, mock.MatchedBy(func(u *model.CategoryUpdate) bool {
+		return len(u.Fields) == 1 && u.Fields[0] == "code"
+	})).Return(nil, model.ErrInvalidUpdateMask)
+
+	response, err := s.api.UpdateCategory(s.ctx, &inventoryv1.UpdateCategoryRequest{
+		Category:   &inventoryv1.PartCategory{Code: "FUEL"},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"code"}},
+	})
+	s.Require().Nil(response)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *ServiceSuite) TestDeleteCategoryInUse() {
+	s.categoryService.On("DeleteCategory", s.ctx, model.CATEGORY_ENGINE).Return(model.ErrCategoryInUse)
+
+	response, err := s.api.DeleteCategory(s.ctx, &inventoryv1.DeleteCategoryRequest{Code: "ENGINE"})
+	s.Require().Nil(response)
+	s.Require().Equal(codes.FailedPrecondition, status.Code(err))
+}
+
+func (s *ServiceSuite) TestGetCategorySchemaSuccess() {
+	minThrust := 0.0
+	s.categoryService.On("GetCategorySchema", s.ctx, model.Category("LIQUID_ENGINE")).Return(&model.CategorySchema{
+		Category: &model.PartCategory{Code: "LIQUID_ENGINE", ParentCode: model.CATEGORY_ENGINE, DisplayName: "ЖРД"},
+		Fields: []*model.MetadataField{
+			{Key: "тяга", Type: model.METADATA_FIELD_TYPE_NUMBER, Unit: "Н", Range: &model.FloatRange{Min: &minThrust}},
+		},
+	}, nil)
+
+	response, err := s.api.GetCategorySchema(s.ctx, &inventoryv1.GetCategorySchemaRequest{Code: "LIQUID_ENGINE"})
+	s.Require().NoError(err)
+	s.Require().Equal("LIQUID_ENGINE", response.GetCategory().GetCode())
+	s.Require().Len(response.GetFields(), 1)
+	s.Require().Equal(inventoryv1.MetadataFieldType_METADATA_FIELD_TYPE_NUMBER, response.GetFields()[0].GetType())
+	s.Require().InDelta(0.0, response.GetFields()[0].GetRange().GetMin(), 1e-9)
+	s.Require().Nil(response.GetFields()[0].GetRange().Max)
+}
+
+func (s *ServiceSuite) TestGetCategorySchemaNotFound() {
+	s.categoryService.On("GetCategorySchema", s.ctx, model.Category("HULL")).Return(nil, model.ErrCategoryNotFound)
+
+	response, err := s.api.GetCategorySchema(s.ctx, &inventoryv1.GetCategorySchemaRequest{Code: "HULL"})
+	s.Require().Nil(response)
+	s.Require().Equal(codes.NotFound, status.Code(err))
+}
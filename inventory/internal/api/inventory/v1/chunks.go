@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"errors"
+	"io"
+)
+
+// streamChunkSize - размер части файла в одном сообщении стрима
+const streamChunkSize = 32 * 1024
+
+// pipeChunks пишет в writer первую часть файла и части из следующих сообщений стрима.
+// Конец стрима закрывает writer, ошибка стрима передается читателю
+func pipeChunks(first []byte, recv func() ([]byte, error), writer *io.PipeWriter) {
+	chunk := first
+	for {
+		if len(chunk) > 0 {
+			if _, err := writer.Write(chunk); err != nil {
+				// Читатель закрыт: обработка завершилась раньше конца файла
+				return
+			}
+		}
+
+		next, err := recv()
+		if errors.Is(err, io.EOF) {
+			_ = writer.Close() //nolint:gosec // PipeWriter.Close всегда возвращает nil
+			return
+		}
+		if err != nil {
+			_ = writer.CloseWithError(err) //nolint:gosec // PipeWriter.CloseWithError всегда возвращает nil
+			return
+		}
+		chunk = next
+	}
+}
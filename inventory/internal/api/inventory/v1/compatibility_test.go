@@ -0,0 +1,76 @@
+package v1
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (s *ServiceSuite) TestValidateConfigurationViolations() {
+	engineUUID := gofakeit.UUID()
+	ruleUUID := gofakeit.UUID()
+
+	s.compatibilityService.On("ValidateConfiguration", s.ctx, []string{engineUUID}).
+		Return(&model.ConfigurationValidation{
+			Violations: []*model.ConfigurationViolation{
+				{
+					RuleUuid:  ruleUUID,
+					Type:      model.COMPATIBILITY_RULE_TYPE_REQUIRES,
+					PartUuids: []string{engineUUID},
+					Message:   "engine requires fuel",
+				},
+			},
+		}, nil)
+
+	response, err := s.api.ValidateConfiguration(s.ctx, &inventoryv1.ValidateConfigurationRequest{
+		PartUuids: []string{engineUUID},
+	})
+	s.Require().NoError(err)
+	s.Require().False(response.GetValid())
+	s.Require().Len(response.GetViolations(), 1)
+	s.Require().Equal(ruleUUID, response.GetViolations()[0].GetRuleUuid())
+	s.Require().Equal(inventoryv1.CompatibilityRuleType_COMPATIBILITY_RULE_TYPE_REQUIRES, response.GetViolations()[0].GetType())
+}
+
+func (s *ServiceSuite) TestValidateConfigurationErrors() {
+	s.compatibilityService.On("ValidateConfiguration", s.ctx, []string(nil)).
+		Return(nil, model.ErrEmptyConfiguration)
+
+	_, err := s.api.ValidateConfiguration(s.ctx, &inventoryv1.ValidateConfigurationRequest{})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	partUUID := gofakeit.UUID()
+	s.compatibilityService.On("ValidateConfiguration", s.ctx, []string{partUUID}).
+		Return(nil, model.ErrPartNotFound)
+
+	_, err = s.api.ValidateConfiguration(s.ctx, &inventoryv1.ValidateConfigurationRequest{
+		PartUuids: []string{partUUID},
+	})
+	s.Require().Equal(codes.NotFound, status.Code(err))
+}
+
+func (s *ServiceSuite) TestCreateCompatibilityRuleInvalid() {
+	s.compatibilityService.On("CreateRule", s.ctx, mock.AnythingOfType("*model.CompatibilityRule")).
+		Return(nil, model.ErrInvalidCompatibilityRule)
+
+	_, err := s.api.CreateCompatibilityRule(s.ctx, &inventoryv1.CreateCompatibilityRuleRequest{
+		Rule: &inventoryv1.CompatibilityRule{Type: inventoryv1.CompatibilityRuleType_COMPATIBILITY_RULE_TYPE_EXCLUDES},
+	})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = s.api.CreateCompatibilityRule(s.ctx, &inventoryv1.CreateCompatibilityRuleRequest{})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *ServiceSuite) TestDeleteCompatibilityRuleNotFound() {
+	ruleUUID := gofakeit.UUID()
+
+	s.compatibilityService.On("DeleteRule", s.ctx, ruleUUID).Return(model.ErrCompatibilityRuleNotFound)
+
+	_, err := s.api.DeleteCompatibilityRule(s.ctx, &inventoryv1.DeleteCompatibilityRuleRequest{Uuid: ruleUUID})
+	s.Require().Equal(codes.NotFound, status.Code(err))
+}
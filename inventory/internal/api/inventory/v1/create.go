@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) CreatePart(ctx context.Context, req *inventoryv1.CreatePartRequest) (*inventoryv1.CreatePartResponse, error) {
+	if req.GetPart() == nil {
+		return nil, status.Error(codes.InvalidArgument, "part is required")
+	}
+
+	part, err := a.partService.CreatePart(ctx, converter.PartFromProto(req.GetPart()))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidPart):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, model.ErrPartAlreadyExists):
+			return nil, status.Errorf(codes.AlreadyExists, "part with UUID %s already exists", req.GetPart().GetUuid())
+		}
+		return nil, err
+	}
+
+	return &inventoryv1.CreatePartResponse{
+		Part: converter.PartToProto(part),
+	}, nil
+}
@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) CreateCategory(ctx context.Context, req *inventoryv1.CreateCategoryRequest) (*inventoryv1.CreateCategoryResponse, error) {
+	if req.GetCategory() == nil {
+		return nil, status.Error(codes.InvalidArgument, "category is required")
+	}
+
+	category, err := a.categoryService.CreateCategory(ctx, converter.PartCategoryFromProto(req.GetCategory()))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidCategory):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, model.ErrCategoryAlreadyExists):
+			return nil, status.Errorf(codes.AlreadyExists, "category with code %s already exists", req.GetCategory().GetCode())
+		}
+		return nil, err
+	}
+
+	return &inventoryv1.CreateCategoryResponse{
+		Category: converter.PartCategoryToProto(category),
+	}, nil
+}
@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) CreateCompatibilityRule(ctx context.Context, req *inventoryv1.CreateCompatibilityRuleRequest) (*inventoryv1.CreateCompatibilityRuleResponse, error) {
+	if req.GetRule() == nil {
+		return nil, status.Error(codes.InvalidArgument, "rule is required")
+	}
+
+	rule, err := a.compatibilityService.CreateRule(ctx, converter.CompatibilityRuleFromProto(req.GetRule()))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidCompatibilityRule):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, model.ErrPartNotFound):
+			return nil, status.Error(codes.NotFound, "rule target part not found")
+		}
+		return nil, err
+	}
+
+	return &inventoryv1.CreateCompatibilityRuleResponse{
+		Rule: converter.CompatibilityRuleToProto(rule),
+	}, nil
+}
@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) CreateRocketModel(ctx context.Context, req *inventoryv1.CreateRocketModelRequest) (*inventoryv1.CreateRocketModelResponse, error) {
+	if req.GetModel() == nil {
+		return nil, status.Error(codes.InvalidArgument, "model is required")
+	}
+
+	rocketModel, err := a.rocketModelService.CreateModel(ctx, converter.RocketModelFromProto(req.GetModel()))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidRocketModel):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, model.ErrPartNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+
+	return &inventoryv1.CreateRocketModelResponse{
+		Model: converter.RocketModelToProto(rocketModel),
+	}, nil
+}
@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) CreateWarehouse(ctx context.Context, req *inventoryv1.CreateWarehouseRequest) (*inventoryv1.CreateWarehouseResponse, error) {
+	if req.GetWarehouse() == nil {
+		return nil, status.Error(codes.InvalidArgument, "warehouse is required")
+	}
+
+	warehouse, err := a.warehouseService.CreateWarehouse(ctx, converter.WarehouseFromProto(req.GetWarehouse()))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidWarehouse):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, model.ErrWarehouseAlreadyExists):
+			return nil, status.Errorf(codes.AlreadyExists, "warehouse with code %s already exists", req.GetWarehouse().GetCode())
+		}
+		return nil, err
+	}
+
+	return &inventoryv1.CreateWarehouseResponse{
+		Warehouse: converter.WarehouseToProto(warehouse),
+	}, nil
+}
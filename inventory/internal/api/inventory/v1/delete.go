@@ -0,0 +1,27 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) DeletePart(ctx context.Context, req *inventoryv1.DeletePartRequest) (*inventoryv1.DeletePartResponse, error) {
+	if req.GetUuid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "part uuid is required")
+	}
+
+	if err := a.partService.DeletePart(ctx, req.GetUuid()); err != nil {
+		if errors.Is(err, model.ErrPartNotFound) {
+			return nil, status.Errorf(codes.NotFound, "part with UUID %s not found", req.GetUuid())
+		}
+		return nil, err
+	}
+
+	return &inventoryv1.DeletePartResponse{}, nil
+}
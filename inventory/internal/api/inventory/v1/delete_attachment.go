@@ -0,0 +1,27 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) DeleteAttachment(ctx context.Context, req *inventoryv1.DeleteAttachmentRequest) (*inventoryv1.DeleteAttachmentResponse, error) {
+	if req.GetUuid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "attachment uuid is required")
+	}
+
+	if err := a.attachmentService.DeleteAttachment(ctx, req.GetUuid()); err != nil {
+		if errors.Is(err, model.ErrAttachmentNotFound) {
+			return nil, status.Errorf(codes.NotFound, "attachment with UUID %s not found", req.GetUuid())
+		}
+		return nil, err
+	}
+
+	return &inventoryv1.DeleteAttachmentResponse{}, nil
+}
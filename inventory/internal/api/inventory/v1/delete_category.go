@@ -0,0 +1,30 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) DeleteCategory(ctx context.Context, req *inventoryv1.DeleteCategoryRequest) (*inventoryv1.DeleteCategoryResponse, error) {
+	if req.GetCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "category code is required")
+	}
+
+	if err := a.categoryService.DeleteCategory(ctx, model.Category(req.GetCode())); err != nil {
+		switch {
+		case errors.Is(err, model.ErrCategoryNotFound):
+			return nil, status.Errorf(codes.NotFound, "category %s not found", req.GetCode())
+		case errors.Is(err, model.ErrCategoryInUse):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, err
+	}
+
+	return &inventoryv1.DeleteCategoryResponse{}, nil
+}
@@ -0,0 +1,27 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) DeleteCompatibilityRule(ctx context.Context, req *inventoryv1.DeleteCompatibilityRuleRequest) (*inventoryv1.DeleteCompatibilityRuleResponse, error) {
+	if req.GetUuid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "rule uuid is required")
+	}
+
+	if err := a.compatibilityService.DeleteRule(ctx, req.GetUuid()); err != nil {
+		if errors.Is(err, model.ErrCompatibilityRuleNotFound) {
+			return nil, status.Errorf(codes.NotFound, "compatibility rule with UUID %s not found", req.GetUuid())
+		}
+		return nil, err
+	}
+
+	return &inventoryv1.DeleteCompatibilityRuleResponse{}, nil
+}
@@ -0,0 +1,53 @@
+package v1
+
+import (
+	"errors"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) DownloadAttachment(req *inventoryv1.DownloadAttachmentRequest, stream inventoryv1.InventoryService_DownloadAttachmentServer) error {
+	if req.GetUuid() == "" {
+		return status.Error(codes.InvalidArgument, "attachment uuid is required")
+	}
+
+	attachment, content, err := a.attachmentService.OpenAttachment(stream.Context(), req.GetUuid())
+	if err != nil {
+		if errors.Is(err, model.ErrAttachmentNotFound) {
+			return status.Errorf(codes.NotFound, "attachment with UUID %s not found", req.GetUuid())
+		}
+		return err
+	}
+	defer func() {
+		_ = content.Close() //nolint:gosec // файл прочитан или стрим уже завершился с ошибкой
+	}()
+
+	if err = stream.Send(&inventoryv1.DownloadAttachmentResponse{
+		Attachment: converter.AttachmentToProto(attachment),
+	}); err != nil {
+		return err
+	}
+
+	for {
+		// Сообщение нельзя менять после Send, поэтому на каждую часть свой буфер
+		buf := make([]byte, streamChunkSize)
+		n, err := io.ReadFull(content, buf)
+		if n > 0 {
+			if sendErr := stream.Send(&inventoryv1.DownloadAttachmentResponse{Chunk: buf[:n]}); sendErr != nil {
+				return sendErr
+			}
+		}
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) ExpandRocketModel(ctx context.Context, req *inventoryv1.ExpandRocketModelRequest) (*inventoryv1.ExpandRocketModelResponse, error) {
+	expansion, err := a.rocketModelService.ExpandModel(ctx, req.GetUuid(), req.GetQuantity())
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidRocketModel):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, model.ErrRocketModelNotFound):
+			return nil, status.Errorf(codes.NotFound, "rocket model with UUID %s not found", req.GetUuid())
+		case errors.Is(err, model.ErrRocketModelIncomplete):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, err
+	}
+
+	return converter.RocketModelExpansionToProto(expansion), nil
+}
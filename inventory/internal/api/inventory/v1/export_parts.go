@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) ExportParts(req *inventoryv1.ExportPartsRequest, stream inventoryv1.InventoryService_ExportPartsServer) error {
+	buffered := bufio.NewWriterSize(&exportChunkWriter{stream: stream}, streamChunkSize)
+
+	writer, err := converter.NewCatalogWriter(buffered, converter.CatalogFormatFromProto(req.GetFormat()))
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	_, err = a.partService.ExportParts(stream.Context(), &model.CatalogExport{
+		Filter: converter.FilterFromProto(req.GetFilter()),
+		Write:  writer.Write,
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidFilter) {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		return err
+	}
+
+	if err = writer.Close(); err != nil {
+		return err
+	}
+	return buffered.Flush()
+}
+
+// exportChunkWriter отправляет каждую запись отдельным сообщением стрима
+type exportChunkWriter struct {
+	stream inventoryv1.InventoryService_ExportPartsServer
+}
+
+func (w *exportChunkWriter) Write(p []byte) (int, error) {
+	// Сообщение может быть прочитано после Send, а bufio переиспользует буфер
+	if err := w.stream.Send(&inventoryv1.ExportPartsResponse{Chunk: bytes.Clone(p)}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
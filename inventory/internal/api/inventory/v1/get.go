@@ -13,11 +13,14 @@ import (
 )
 
 func (a *api) GetPart(ctx context.Context, req *inventoryv1.GetPartRequest) (*inventoryv1.GetPartResponse, error) {
-	part, err := a.partService.GetPart(ctx, req.Uuid)
+	part, err := a.partService.GetPart(ctx, req.Uuid, converter.ReadMaskFromProto(req.GetReadMask().GetPaths()))
 	if err != nil {
 		if errors.Is(err, model.ErrPartNotFound) {
 			return nil, status.Errorf(codes.NotFound, "part with UUID %s not found", req.Uuid)
 		}
+		if errors.Is(err, model.ErrInvalidReadMask) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, err
 	}
 
@@ -0,0 +1,32 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) GetCategorySchema(ctx context.Context, req *inventoryv1.GetCategorySchemaRequest) (*inventoryv1.GetCategorySchemaResponse, error) {
+	if req.GetCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "category code is required")
+	}
+
+	schema, err := a.categoryService.GetCategorySchema(ctx, model.Category(req.GetCode()))
+	if err != nil {
+		if errors.Is(err, model.ErrCategoryNotFound) {
+			return nil, status.Errorf(codes.NotFound, "category %s not found", req.GetCode())
+		}
+		return nil, err
+	}
+
+	return &inventoryv1.GetCategorySchemaResponse{
+		Category: converter.PartCategoryToProto(schema.Category),
+		Fields:   converter.MetadataFieldsToProto(schema.Fields),
+	}, nil
+}
@@ -0,0 +1,33 @@
+package v1
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) GetPartPrices(ctx context.Context, req *inventoryv1.GetPartPricesRequest) (*inventoryv1.GetPartPricesResponse, error) {
+	at := time.Now()
+	if req.GetAt() != nil {
+		at = req.GetAt().AsTime()
+	}
+
+	prices, err := a.priceService.GetPrices(ctx, req.GetPartUuids(), at)
+	if err != nil {
+		if errors.Is(err, model.ErrPartNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+
+	return &inventoryv1.GetPartPricesResponse{
+		Prices: converter.PartPricesToProto(prices),
+	}, nil
+}
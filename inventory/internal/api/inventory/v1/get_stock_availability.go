@@ -0,0 +1,27 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) GetStockAvailability(ctx context.Context, req *inventoryv1.GetStockAvailabilityRequest) (*inventoryv1.GetStockAvailabilityResponse, error) {
+	availability, err := a.stockService.GetAvailability(ctx, req.GetPartUuids())
+	if err != nil {
+		if errors.Is(err, model.ErrPartNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+
+	return &inventoryv1.GetStockAvailabilityResponse{
+		Availability: converter.PartAvailabilitiesToProto(availability),
+	}, nil
+}
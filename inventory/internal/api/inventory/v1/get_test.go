@@ -4,6 +4,10 @@ import (
 	"time"
 
 	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 
 	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
 	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
@@ -65,7 +69,7 @@ func (s *ServiceSuite) TestGetPartSuccess() {
 		}
 	)
 
-	s.partService.On("GetPart", s.ctx, partUUID).Return(expectedPart, nil)
+	s.partService.On("GetPart", s.ctx, partUUID, []string(nil)).Return(expectedPart, nil)
 
 	response, err := s.api.GetPart(s.ctx, request)
 	s.Require().NoError(err)
@@ -85,9 +89,50 @@ func (s *ServiceSuite) TestGetPartNotFound() {
 		}
 	)
 
-	s.partService.On("GetPart", s.ctx, partUUID).Return(nil, model.ErrPartNotFound)
+	s.partService.On("GetPart", s.ctx, partUUID, []string(nil)).Return(nil, model.ErrPartNotFound)
 
 	response, err := s.api.GetPart(s.ctx, request)
 	s.Require().Error(err)
 	s.Require().Nil(response)
 }
+
+func (s *ServiceSuite) TestGetPartReadMask() {
+	partUUID := gofakeit.UUID()
+
+	s.partService.On("GetPart", s.ctx, partUUID, []string{model.PartFieldUuid, model.PartFieldName, model.PartFieldPrice}).
+		Return(&model.Part{Uuid: partUUID, Name: "RD-180", Price: 2500000}, nil)
+
+	response, err := s.api.GetPart(s.ctx, &inventoryv1.GetPartRequest{
+		Uuid:     partUUID,
+		ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"uuid", "name", "price"}},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal("RD-180", response.GetPart().GetName())
+	s.Require().Nil(response.GetPart().GetDimensions())
+}
+
+func (s *ServiceSuite) TestBatchGetParts() {
+	found, missing := gofakeit.UUID(), gofakeit.UUID()
+
+	s.partService.On("BatchGetParts", s.ctx, []string{missing, found}, []string(nil)).Return(&model.PartsBatch{
+		Parts:        []*model.Part{{Uuid: found, Name: "RD-180"}},
+		MissingUuids: []string{missing},
+	}, nil)
+
+	response, err := s.api.BatchGetParts(s.ctx, &inventoryv1.BatchGetPartsRequest{Uuids: []string{missing, found}})
+	s.Require().NoError(err)
+	s.Require().Len(response.GetParts(), 1)
+	s.Require().Equal(found, response.GetParts()[0].GetUuid())
+	s.Require().Equal([]string{missing}, response.GetMissingUuids())
+}
+
+func (s *ServiceSuite) TestBatchGetPartsInvalidReadMask() {
+	s.partService.On("BatchGetParts", s.ctx, mock.Anything, []string{"secret"}).Return(nil, model.ErrInvalidReadMask)
+
+	response, err := s.api.BatchGetParts(s.ctx, &inventoryv1.BatchGetPartsRequest{
+		Uuids:    []string{gofakeit.UUID()},
+		ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"secret"}},
+	})
+	s.Require().Nil(response)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
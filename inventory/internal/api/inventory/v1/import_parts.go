@@ -0,0 +1,51 @@
+package v1
+
+import (
+	"errors"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) ImportParts(stream inventoryv1.InventoryService_ImportPartsServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return status.Error(codes.InvalidArgument, "catalog is empty")
+		}
+		return err
+	}
+
+	// Части файла передаются читателю каталога через pipe, поэтому файл не собирается в памяти целиком
+	reader, writer := io.Pipe()
+	defer func() {
+		_ = reader.Close() //nolint:gosec // закрытие разблокирует горутину чтения стрима
+	}()
+	go pipeChunks(first.GetChunk(), func() ([]byte, error) {
+		req, err := stream.Recv()
+		return req.GetChunk(), err
+	}, writer)
+
+	catalogReader, err := converter.NewCatalogReader(reader, converter.CatalogFormatFromProto(first.GetFormat()))
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	report, err := a.partService.ImportParts(stream.Context(), &model.CatalogImport{
+		Reader: catalogReader,
+		DryRun: first.GetDryRun(),
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidCatalog) {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		return err
+	}
+
+	return stream.SendAndClose(converter.CatalogImportReportToProto(report))
+}
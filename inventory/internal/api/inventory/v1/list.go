@@ -2,22 +2,27 @@ package v1
 
 import (
 	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
 	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
 )
 
 func (a *api) ListParts(ctx context.Context, req *inventoryv1.ListPartsRequest) (*inventoryv1.ListPartsResponse, error) {
-	filter := converter.FilterFromProto(req.Filter)
+	query := converter.PartsQueryFromProto(req)
 
-	parts, err := a.partService.ListParts(ctx, filter)
+	page, err := a.partService.ListParts(ctx, query)
 	if err != nil {
+		if errors.Is(err, model.ErrInvalidPageToken) || errors.Is(err, model.ErrInvalidPageSize) ||
+			errors.Is(err, model.ErrInvalidFilter) || errors.Is(err, model.ErrInvalidReadMask) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, err
 	}
 
-	protoParts := converter.PartsToProto(parts)
-
-	return &inventoryv1.ListPartsResponse{
-		Parts: protoParts,
-	}, nil
+	return converter.PartsPageToProto(page), nil
 }
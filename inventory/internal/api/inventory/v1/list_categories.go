@@ -0,0 +1,19 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) ListCategories(ctx context.Context, _ *inventoryv1.ListCategoriesRequest) (*inventoryv1.ListCategoriesResponse, error) {
+	categories, err := a.categoryService.ListCategories(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &inventoryv1.ListCategoriesResponse{
+		Categories: converter.PartCategoriesToProto(categories),
+	}, nil
+}
@@ -0,0 +1,19 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) ListCompatibilityRules(ctx context.Context, _ *inventoryv1.ListCompatibilityRulesRequest) (*inventoryv1.ListCompatibilityRulesResponse, error) {
+	rules, err := a.compatibilityService.ListRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &inventoryv1.ListCompatibilityRulesResponse{
+		Rules: converter.CompatibilityRulesToProto(rules),
+	}, nil
+}
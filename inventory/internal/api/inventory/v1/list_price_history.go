@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) ListPriceHistory(ctx context.Context, req *inventoryv1.ListPriceHistoryRequest) (*inventoryv1.ListPriceHistoryResponse, error) {
+	if req.GetPartUuid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "part uuid is required")
+	}
+
+	changes, err := a.priceService.ListHistory(ctx, req.GetPartUuid())
+	if err != nil {
+		if errors.Is(err, model.ErrPartNotFound) {
+			return nil, status.Errorf(codes.NotFound, "part with UUID %s not found", req.GetPartUuid())
+		}
+		return nil, err
+	}
+
+	return &inventoryv1.ListPriceHistoryResponse{
+		PriceChanges: converter.PriceChangesToProto(changes),
+	}, nil
+}
@@ -0,0 +1,19 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) ListRocketModels(ctx context.Context, _ *inventoryv1.ListRocketModelsRequest) (*inventoryv1.ListRocketModelsResponse, error) {
+	summaries, err := a.rocketModelService.ListModels(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &inventoryv1.ListRocketModelsResponse{
+		Models: converter.RocketModelSummariesToProto(summaries),
+	}, nil
+}
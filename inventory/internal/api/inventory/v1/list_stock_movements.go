@@ -0,0 +1,36 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) ListStockMovements(ctx context.Context, req *inventoryv1.ListStockMovementsRequest) (*inventoryv1.ListStockMovementsResponse, error) {
+	if req.GetPartUuid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "part uuid is required")
+	}
+
+	page, err := a.stockService.ListMovements(ctx, &model.StockMovementsQuery{
+		PartUuid:  req.GetPartUuid(),
+		PageSize:  req.GetPageSize(),
+		PageToken: req.GetPageToken(),
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidPageToken) || errors.Is(err, model.ErrInvalidPageSize) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+
+	return &inventoryv1.ListStockMovementsResponse{
+		Movements:     converter.StockMovementsToProto(page.Movements),
+		NextPageToken: page.NextPageToken,
+	}, nil
+}
@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/brianvoe/gofakeit/v7"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
 	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
@@ -165,12 +167,15 @@ func (s *ServiceSuite) TestListPartSuccess() {
 		}
 	)
 
-	s.partService.On("ListParts", s.ctx, filter).Return(expectedParts, nil)
+	s.partService.On("ListParts", s.ctx, &model.PartsQuery{Filter: filter}).
+		Return(&model.PartsPage{Parts: expectedParts, NextPageToken: "next", TotalSize: 10}, nil)
 
 	response, err := s.api.ListParts(s.ctx, request)
 	s.Require().NoError(err)
 	s.Require().NotNil(response)
 	s.Require().Len(response.Parts, 3)
+	s.Require().Equal("next", response.NextPageToken)
+	s.Require().Equal(int64(10), response.TotalSize)
 
 	s.Require().Equal(uuid1, response.Parts[0].Uuid)
 	s.Require().Equal(name1, response.Parts[0].Name)
@@ -199,9 +204,27 @@ func (s *ServiceSuite) TestListPartServiceError() {
 		serviceError = model.ErrPartNotFound
 	)
 
-	s.partService.On("ListParts", s.ctx, filter).Return(nil, serviceError)
+	s.partService.On("ListParts", s.ctx, &model.PartsQuery{Filter: filter}).Return(nil, serviceError)
 
 	response, err := s.api.ListParts(s.ctx, request)
 	s.Require().Error(err)
 	s.Require().Nil(response)
 }
+
+func (s *ServiceSuite) TestListPartInvalidPageToken() {
+	request := &inventoryv1.ListPartsRequest{
+		PageSize:  10,
+		PageToken: "broken",
+		SortBy:    inventoryv1.PartsSortField_PARTS_SORT_FIELD_PRICE,
+	}
+
+	s.partService.On("ListParts", s.ctx, &model.PartsQuery{
+		PageSize:  10,
+		PageToken: "broken",
+		SortBy:    model.PARTS_SORT_FIELD_PRICE,
+	}).Return(nil, model.ErrInvalidPageToken)
+
+	response, err := s.api.ListParts(s.ctx, request)
+	s.Require().Nil(response)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,19 @@
+package v1
+
+import (
+	"context"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) ListWarehouses(ctx context.Context, _ *inventoryv1.ListWarehousesRequest) (*inventoryv1.ListWarehousesResponse, error) {
+	warehouses, err := a.warehouseService.ListWarehouses(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &inventoryv1.ListWarehousesResponse{
+		Warehouses: converter.WarehousesToProto(warehouses),
+	}, nil
+}
@@ -0,0 +1,102 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (s *ServiceSuite) TestSchedulePartPriceSuccess() {
+	partUUID := gofakeit.UUID()
+	effectiveFrom := time.Now().Add(30 * 24 * time.Hour).UTC()
+
+	s.priceService.On("SchedulePrice", s.ctx, &model.PriceChange{
+		PartUuid:      partUUID,
+		Price:         1800000.00,
+		EffectiveFrom: effectiveFrom,
+		Reason:        "supplier price increase",
+	}).Return(&model.PriceChange{
+		Uuid:          gofakeit.UUID(),
+		PartUuid:      partUUID,
+		Price:         1800000.00,
+		EffectiveFrom: effectiveFrom,
+		CreatedAt:     time.Now(),
+	}, nil)
+
+	response, err := s.api.SchedulePartPrice(s.ctx, &inventoryv1.SchedulePartPriceRequest{
+		PartUuid:      partUUID,
+		Price:         1800000.00,
+		EffectiveFrom: timestamppb.New(effectiveFrom),
+		Reason:        "supplier price increase",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(1800000.00, response.GetPriceChange().GetPrice())
+	s.Require().False(response.GetPriceChange().GetApplied())
+}
+
+func (s *ServiceSuite) TestSchedulePartPriceWithoutEffectiveFrom() {
+	response, err := s.api.SchedulePartPrice(s.ctx, &inventoryv1.SchedulePartPriceRequest{
+		PartUuid: gofakeit.UUID(),
+		Price:    1800000.00,
+	})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	s.Require().Nil(response)
+}
+
+func (s *ServiceSuite) TestSchedulePartPriceInvalid() {
+	s.priceService.On("SchedulePrice", s.ctx, mock.AnythingOfType("*model.PriceChange")).
+		Return(nil, model.ErrInvalidPriceChange)
+
+	response, err := s.api.SchedulePartPrice(s.ctx, &inventoryv1.SchedulePartPriceRequest{
+		PartUuid:      gofakeit.UUID(),
+		Price:         1800000.00,
+		EffectiveFrom: timestamppb.New(time.Now().Add(-time.Hour)),
+	})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	s.Require().Nil(response)
+}
+
+func (s *ServiceSuite) TestListPriceHistoryNotFound() {
+	partUUID := gofakeit.UUID()
+
+	s.priceService.On("ListHistory", s.ctx, partUUID).Return(nil, model.ErrPartNotFound)
+
+	response, err := s.api.ListPriceHistory(s.ctx, &inventoryv1.ListPriceHistoryRequest{PartUuid: partUUID})
+	s.Require().Equal(codes.NotFound, status.Code(err))
+	s.Require().Nil(response)
+}
+
+func (s *ServiceSuite) TestGetPartPricesAtMoment() {
+	partUUID := gofakeit.UUID()
+	at := time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC)
+	effectiveFrom := at.Add(-time.Hour)
+
+	s.priceService.On("GetPrices", s.ctx, []string{partUUID}, at).Return([]*model.PartPrice{
+		{PartUuid: partUUID, Price: 1800000.00, EffectiveFrom: &effectiveFrom},
+	}, nil)
+
+	response, err := s.api.GetPartPrices(s.ctx, &inventoryv1.GetPartPricesRequest{
+		PartUuids: []string{partUUID},
+		At:        timestamppb.New(at),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.GetPrices(), 1)
+	s.Require().Equal(1800000.00, response.GetPrices()[0].GetPrice())
+	s.Require().Equal(effectiveFrom, response.GetPrices()[0].GetEffectiveFrom().AsTime())
+}
+
+func (s *ServiceSuite) TestGetPartPricesPartNotFound() {
+	s.priceService.On("GetPrices", s.ctx, mock.Anything, mock.AnythingOfType("time.Time")).
+		Return(nil, model.ErrPartNotFound)
+
+	response, err := s.api.GetPartPrices(s.ctx, &inventoryv1.GetPartPricesRequest{PartUuids: []string{gofakeit.UUID()}})
+	s.Require().Equal(codes.NotFound, status.Code(err))
+	s.Require().Nil(response)
+}
@@ -0,0 +1,39 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) ReceiveStock(ctx context.Context, req *inventoryv1.ReceiveStockRequest) (*inventoryv1.ReceiveStockResponse, error) {
+	movement, err := a.stockService.ChangeStock(ctx, &model.StockChange{
+		PartUuid:      req.GetPartUuid(),
+		WarehouseUuid: req.GetWarehouseUuid(),
+		Type:          model.STOCK_MOVEMENT_TYPE_RECEIPT,
+		Quantity:      req.GetQuantity(),
+		Reason:        req.GetReason(),
+		Reference:     req.GetReference(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidStockChange):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, model.ErrPartNotFound):
+			return nil, status.Errorf(codes.NotFound, "part with UUID %s not found", req.GetPartUuid())
+		case errors.Is(err, model.ErrWarehouseNotFound):
+			return nil, status.Errorf(codes.NotFound, "warehouse with UUID %s not found", req.GetWarehouseUuid())
+		}
+		return nil, err
+	}
+
+	return &inventoryv1.ReceiveStockResponse{
+		Movement: converter.StockMovementToProto(movement),
+	}, nil
+}
@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) ReserveStock(ctx context.Context, req *inventoryv1.ReserveStockRequest) (*inventoryv1.ReserveStockResponse, error) {
+	movements, err := a.stockService.ReserveStock(ctx, &model.StockReservation{
+		Reference: req.GetReference(),
+		Items:     converter.ReservationItemsFromProto(req.GetItems()),
+	})
+	if err != nil {
+		return nil, reservationError(err)
+	}
+
+	return &inventoryv1.ReserveStockResponse{
+		Movements: converter.StockMovementsToProto(movements),
+	}, nil
+}
+
+func (a *api) ReleaseStock(ctx context.Context, req *inventoryv1.ReleaseStockRequest) (*inventoryv1.ReleaseStockResponse, error) {
+	movements, err := a.stockService.ReleaseStock(ctx, req.GetReference())
+	if err != nil {
+		return nil, reservationError(err)
+	}
+
+	return &inventoryv1.ReleaseStockResponse{
+		Movements: converter.StockMovementsToProto(movements),
+	}, nil
+}
+
+func (a *api) ConsumeStock(ctx context.Context, req *inventoryv1.ConsumeStockRequest) (*inventoryv1.ConsumeStockResponse, error) {
+	movements, err := a.stockService.ConsumeStock(ctx, req.GetReference())
+	if err != nil {
+		return nil, reservationError(err)
+	}
+
+	return &inventoryv1.ConsumeStockResponse{
+		Movements: converter.StockMovementsToProto(movements),
+	}, nil
+}
+
+// reservationError переводит ошибки резервирования в gRPC статусы
+func reservationError(err error) error {
+	switch {
+	case errors.Is(err, model.ErrInvalidReservation):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, model.ErrPartNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, model.ErrInsufficientStock):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return err
+}
@@ -0,0 +1,71 @@
+package v1
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (s *ServiceSuite) TestExpandRocketModelSuccess() {
+	modelUUID := gofakeit.UUID()
+	part := &model.Part{Uuid: gofakeit.UUID(), Price: 250, StockQuantity: 4}
+
+	s.rocketModelService.On("ExpandModel", s.ctx, modelUUID, int64(2)).
+		Return(&model.RocketModelExpansion{
+			Model: &model.RocketModel{Uuid: modelUUID, Name: "Falcon"},
+			Lines: []*model.BomLine{{Part: part, Quantity: 4, Available: true, Alternative: true}},
+		}, nil)
+
+	response, err := s.api.ExpandRocketModel(s.ctx, &inventoryv1.ExpandRocketModelRequest{
+		Uuid:     modelUUID,
+		Quantity: 2,
+	})
+	s.Require().NoError(err)
+	s.Require().True(response.GetAvailable())
+	s.Require().InDelta(1000, response.GetTotalPrice(), 0.001)
+	s.Require().Len(response.GetLines(), 1)
+	s.Require().Equal(part.Uuid, response.GetLines()[0].GetPart().GetUuid())
+	s.Require().True(response.GetLines()[0].GetAlternative())
+}
+
+func (s *ServiceSuite) TestExpandRocketModelErrors() {
+	tests := []struct {
+		name string
+		err  error
+		code codes.Code
+	}{
+		{name: "invalid", err: model.ErrInvalidRocketModel, code: codes.InvalidArgument},
+		{name: "not found", err: model.ErrRocketModelNotFound, code: codes.NotFound},
+		{name: "incomplete", err: model.ErrRocketModelIncomplete, code: codes.FailedPrecondition},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			modelUUID := gofakeit.UUID()
+			s.rocketModelService.On("ExpandModel", s.ctx, modelUUID, int64(1)).Return(nil, tt.err)
+
+			_, err := s.api.ExpandRocketModel(s.ctx, &inventoryv1.ExpandRocketModelRequest{
+				Uuid:     modelUUID,
+				Quantity: 1,
+			})
+			s.Require().Equal(tt.code, status.Code(err))
+		})
+	}
+}
+
+func (s *ServiceSuite) TestCreateRocketModelInvalid() {
+	_, err := s.api.CreateRocketModel(s.ctx, &inventoryv1.CreateRocketModelRequest{})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+
+	s.rocketModelService.On("CreateModel", s.ctx, mock.AnythingOfType("*model.RocketModel")).
+		Return(nil, model.ErrInvalidRocketModel)
+
+	_, err = s.api.CreateRocketModel(s.ctx, &inventoryv1.CreateRocketModelRequest{
+		Model: &inventoryv1.RocketModel{Name: "Falcon"},
+	})
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) SchedulePartPrice(ctx context.Context, req *inventoryv1.SchedulePartPriceRequest) (*inventoryv1.SchedulePartPriceResponse, error) {
+	if req.GetPartUuid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "part uuid is required")
+	}
+	if req.GetEffectiveFrom() == nil {
+		return nil, status.Error(codes.InvalidArgument, "effective_from is required")
+	}
+
+	change, err := a.priceService.SchedulePrice(ctx, &model.PriceChange{
+		PartUuid:      req.GetPartUuid(),
+		Price:         req.GetPrice(),
+		EffectiveFrom: req.GetEffectiveFrom().AsTime(),
+		Reason:        req.GetReason(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidPriceChange):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, model.ErrPartNotFound):
+			return nil, status.Errorf(codes.NotFound, "part with UUID %s not found", req.GetPartUuid())
+		}
+		return nil, err
+	}
+
+	return &inventoryv1.SchedulePartPriceResponse{
+		PriceChange: converter.PriceChangeToProto(change),
+	}, nil
+}
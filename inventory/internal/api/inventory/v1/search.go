@@ -0,0 +1,30 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) SearchParts(ctx context.Context, req *inventoryv1.SearchPartsRequest) (*inventoryv1.SearchPartsResponse, error) {
+	hits, err := a.partService.SearchParts(ctx, converter.PartsSearchFromProto(req))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrEmptySearchQuery):
+			return nil, status.Error(codes.InvalidArgument, "search query is required")
+		case errors.Is(err, model.ErrInvalidFilter):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, err
+	}
+
+	return &inventoryv1.SearchPartsResponse{
+		Hits: converter.PartSearchHitsToProto(hits),
+	}, nil
+}
@@ -0,0 +1,42 @@
+package v1
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (s *ServiceSuite) TestSearchPartsSuccess() {
+	partUUID := gofakeit.UUID()
+
+	request := &inventoryv1.SearchPartsRequest{
+		Query: "жидкостный",
+		Filter: &inventoryv1.PartsFilter{
+			ManufacturerCountries: []string{"Россия"},
+		},
+	}
+
+	s.partService.On("SearchParts", s.ctx, &model.PartsSearch{
+		Query:  "жидкостный",
+		Filter: &model.PartsFilter{ManufacturerCountries: []string{"Россия"}},
+	}).Return([]*model.PartSearchHit{
+		{Part: &model.Part{Uuid: partUUID, Name: "Ракетный двигатель RD-180"}, Score: 3.2},
+	}, nil)
+
+	response, err := s.api.SearchParts(s.ctx, request)
+	s.Require().NoError(err)
+	s.Require().Len(response.GetHits(), 1)
+	s.Require().Equal(partUUID, response.GetHits()[0].GetPart().GetUuid())
+	s.Require().Equal(3.2, response.GetHits()[0].GetScore())
+}
+
+func (s *ServiceSuite) TestSearchPartsEmptyQuery() {
+	s.partService.On("SearchParts", s.ctx, &model.PartsSearch{}).Return(nil, model.ErrEmptySearchQuery)
+
+	response, err := s.api.SearchParts(s.ctx, &inventoryv1.SearchPartsRequest{})
+	s.Require().Nil(response)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,62 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (s *ServiceSuite) TestReceiveStockSuccess() {
+	partUUID := gofakeit.UUID()
+	movementUUID := gofakeit.UUID()
+
+	s.stockService.On("ChangeStock", s.ctx, &model.StockChange{
+		PartUuid:  partUUID,
+		Type:      model.STOCK_MOVEMENT_TYPE_RECEIPT,
+		Quantity:  10,
+		Reason:    "поставка",
+		Reference: "invoice-42",
+	}).Return(&model.StockMovement{
+		Uuid:       movementUUID,
+		PartUuid:   partUUID,
+		Type:       model.STOCK_MOVEMENT_TYPE_RECEIPT,
+		Quantity:   10,
+		StockAfter: 15,
+		CreatedAt:  time.Now(),
+	}, nil)
+
+	response, err := s.api.ReceiveStock(s.ctx, &inventoryv1.ReceiveStockRequest{
+		PartUuid:  partUUID,
+		Quantity:  10,
+		Reason:    "поставка",
+		Reference: "invoice-42",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(movementUUID, response.GetMovement().GetUuid())
+	s.Require().Equal(inventoryv1.StockMovementType_STOCK_MOVEMENT_TYPE_RECEIPT, response.GetMovement().GetType())
+	s.Require().Equal(int64(15), response.GetMovement().GetStockAfter())
+}
+
+func (s *ServiceSuite) TestReceiveStockInvalidQuantity() {
+	partUUID := gofakeit.UUID()
+
+	s.stockService.On("ChangeStock", s.ctx, &model.StockChange{
+		PartUuid: partUUID,
+		Type:     model.STOCK_MOVEMENT_TYPE_RECEIPT,
+	}).Return(nil, model.ErrInvalidStockChange)
+
+	response, err := s.api.ReceiveStock(s.ctx, &inventoryv1.ReceiveStockRequest{PartUuid: partUUID})
+	s.Require().Nil(response)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func (s *ServiceSuite) TestListStockMovementsRequiresPart() {
+	response, err := s.api.ListStockMovements(s.ctx, &inventoryv1.ListStockMovementsRequest{})
+	s.Require().Nil(response)
+	s.Require().Equal(codes.InvalidArgument, status.Code(err))
+}
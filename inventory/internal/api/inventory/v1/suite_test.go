@@ -11,18 +11,39 @@ import (
 
 type ServiceSuite struct {
 	suite.Suite
-	ctx         context.Context
-	partService *mocks.PartService
-	api         *api
+	ctx                  context.Context
+	partService          *mocks.PartService
+	stockService         *mocks.StockService
+	compatibilityService *mocks.CompatibilityService
+	rocketModelService   *mocks.RocketModelService
+	attachmentService    *mocks.AttachmentService
+	priceService         *mocks.PriceService
+	warehouseService     *mocks.WarehouseService
+	categoryService      *mocks.CategoryService
+	api                  *api
 }
 
 func (s *ServiceSuite) SetupTest() {
 	s.ctx = context.Background()
 
 	s.partService = mocks.NewPartService(s.T())
+	s.stockService = mocks.NewStockService(s.T())
+	s.compatibilityService = mocks.NewCompatibilityService(s.T())
+	s.rocketModelService = mocks.NewRocketModelService(s.T())
+	s.attachmentService = mocks.NewAttachmentService(s.T())
+	s.priceService = mocks.NewPriceService(s.T())
+	s.warehouseService = mocks.NewWarehouseService(s.T())
+	s.categoryService = mocks.NewCategoryService(s.T())
 
 	s.api = NewAPI(
 		s.partService,
+		s.stockService,
+		s.compatibilityService,
+		s.rocketModelService,
+		s.attachmentService,
+		s.priceService,
+		s.warehouseService,
+		s.categoryService,
 	)
 }
 
@@ -0,0 +1,41 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) TransferStock(ctx context.Context, req *inventoryv1.TransferStockRequest) (*inventoryv1.TransferStockResponse, error) {
+	outgoing, incoming, err := a.stockService.TransferStock(ctx, &model.StockTransfer{
+		PartUuid:          req.GetPartUuid(),
+		FromWarehouseUuid: req.GetFromWarehouseUuid(),
+		ToWarehouseUuid:   req.GetToWarehouseUuid(),
+		Quantity:          req.GetQuantity(),
+		Reason:            req.GetReason(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidStockTransfer):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, model.ErrPartNotFound):
+			return nil, status.Errorf(codes.NotFound, "part with UUID %s not found", req.GetPartUuid())
+		case errors.Is(err, model.ErrWarehouseNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, model.ErrInsufficientStock):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, err
+	}
+
+	return &inventoryv1.TransferStockResponse{
+		Outgoing: converter.StockMovementToProto(outgoing),
+		Incoming: converter.StockMovementToProto(incoming),
+	}, nil
+}
@@ -0,0 +1,43 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) UpdatePart(ctx context.Context, req *inventoryv1.UpdatePartRequest) (*inventoryv1.UpdatePartResponse, error) {
+	if req.GetPart().GetUuid() == "" {
+		return nil, status.Error(codes.InvalidArgument, "part uuid is required")
+	}
+
+	part, err := a.partService.UpdatePart(ctx, &model.PartUpdate{
+		Part:   converter.PartFromProto(req.GetPart()),
+		Fields: req.GetUpdateMask().GetPaths(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidPart), errors.Is(err, model.ErrInvalidUpdateMask):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, model.ErrInsufficientStock):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		case errors.Is(err, model.ErrPartNotFound):
+			return nil, status.Errorf(codes.NotFound, "part with UUID %s not found", req.GetPart().GetUuid())
+		case errors.Is(err, model.ErrPartVersionMismatch):
+			// Клиент должен перечитать деталь и повторить изменение с новой версией
+			return nil, status.Errorf(codes.Aborted, "part with UUID %s was modified, version %d is stale",
+				req.GetPart().GetUuid(), req.GetPart().GetVersion())
+		}
+		return nil, err
+	}
+
+	return &inventoryv1.UpdatePartResponse{
+		Part: converter.PartToProto(part),
+	}, nil
+}
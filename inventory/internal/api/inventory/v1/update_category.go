@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) UpdateCategory(ctx context.Context, req *inventoryv1.UpdateCategoryRequest) (*inventoryv1.UpdateCategoryResponse, error) {
+	if req.GetCategory().GetCode() == "" {
+		return nil, status.Error(codes.InvalidArgument, "category code is required")
+	}
+
+	category, err := a.categoryService.UpdateCategory(ctx, &model.CategoryUpdate{
+		Category: converter.PartCategoryFromProto(req.GetCategory()),
+		Fields:   req.GetUpdateMask().GetPaths(),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrInvalidCategory), errors.Is(err, model.ErrInvalidUpdateMask):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, model.ErrCategoryNotFound):
+			return nil, status.Errorf(codes.NotFound, "category %s not found", req.GetCategory().GetCode())
+		}
+		return nil, err
+	}
+
+	return &inventoryv1.UpdateCategoryResponse{
+		Category: converter.PartCategoryToProto(category),
+	}, nil
+}
@@ -0,0 +1,56 @@
+package v1
+
+import (
+	"errors"
+	"io"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) UploadAttachment(stream inventoryv1.InventoryService_UploadAttachmentServer) error {
+	first, err := stream.Recv()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return status.Error(codes.InvalidArgument, "attachment is empty")
+		}
+		return err
+	}
+	if first.GetUpload() == nil {
+		return status.Error(codes.InvalidArgument, "first message must describe the attachment")
+	}
+
+	// Содержимое передается в GridFS через pipe по мере получения частей
+	reader, writer := io.Pipe()
+	defer func() {
+		_ = reader.Close() //nolint:gosec // закрытие разблокирует горутину чтения стрима
+	}()
+	go pipeChunks(first.GetChunk(), func() ([]byte, error) {
+		req, err := stream.Recv()
+		return req.GetChunk(), err
+	}, writer)
+
+	upload := converter.AttachmentUploadFromProto(first.GetUpload())
+	upload.Content = reader
+
+	attachment, err := a.attachmentService.UploadAttachment(stream.Context(), upload)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrPartNotFound):
+			return status.Errorf(codes.NotFound, "part with UUID %s not found", upload.PartUuid)
+		case errors.Is(err, model.ErrInvalidAttachment), errors.Is(err, model.ErrAttachmentChecksumMismatch):
+			return status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, model.ErrAttachmentTooLarge):
+			return status.Error(codes.ResourceExhausted, err.Error())
+		}
+		return err
+	}
+
+	return stream.SendAndClose(&inventoryv1.UploadAttachmentResponse{
+		Attachment: converter.AttachmentToProto(attachment),
+	})
+}
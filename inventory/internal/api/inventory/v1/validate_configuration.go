@@ -0,0 +1,28 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) ValidateConfiguration(ctx context.Context, req *inventoryv1.ValidateConfigurationRequest) (*inventoryv1.ValidateConfigurationResponse, error) {
+	validation, err := a.compatibilityService.ValidateConfiguration(ctx, req.GetPartUuids())
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrEmptyConfiguration):
+			return nil, status.Error(codes.InvalidArgument, "part uuids are required")
+		case errors.Is(err, model.ErrPartNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+
+	return converter.ConfigurationValidationToProto(validation), nil
+}
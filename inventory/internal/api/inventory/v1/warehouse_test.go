@@ -0,0 +1,95 @@
+package v1
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (s *ServiceSuite) TestCreateWarehouseSuccess() {
+	warehouseUUID := gofakeit.UUID()
+
+	s.warehouseService.On("CreateWarehouse", s.ctx, &model.Warehouse{
+		Code:     "PLESETSK",
+		Name:     "Плесецк",
+		Location: model.GeoPoint{Latitude: 62.925, Longitude: 40.577},
+	}).Return(&model.Warehouse{
+		Uuid:      warehouseUUID,
+		Code:      "PLESETSK",
+		Name:      "Плесецк",
+		Location:  model.GeoPoint{Latitude: 62.925, Longitude: 40.577},
+		CreatedAt: time.Now(),
+	}, nil)
+
+	response, err := s.api.CreateWarehouse(s.ctx, &inventoryv1.CreateWarehouseRequest{
+		Warehouse: &inventoryv1.Warehouse{Code: "PLESETSK", Name: "Плесецк", Latitude: 62.925, Longitude: 40.577},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(warehouseUUID, response.GetWarehouse().GetUuid())
+	s.Require().InDelta(62.925, response.GetWarehouse().GetLatitude(), 1e-9)
+}
+
+func (s *ServiceSuite) TestCreateWarehouseAlreadyExists() {
+	s.warehouseService.On("CreateWarehouse", s.ctx, mock.Anything).Return(nil, model.ErrWarehouseAlreadyExists)
+
+	response, err := s.api.CreateWarehouse(s.ctx, &inventoryv1.CreateWarehouseRequest{
+		Warehouse: &inventoryv1.Warehouse{Code: "BAIKONUR", Name: "Байконур"},
+	})
+	s.Require().Nil(response)
+	s.Require().Equal(codes.AlreadyExists, status.Code(err))
+}
+
+func (s *ServiceSuite) TestTransferStockInsufficient() {
+	s.stockService.On("TransferStock", s.ctx, mock.AnythingOfType("*model.StockTransfer")).
+		Return(nil, nil, model.ErrInsufficientStock)
+
+	response, err := s.api.TransferStock(s.ctx, &inventoryv1.TransferStockRequest{
+		PartUuid:          gofakeit.UUID(),
+		FromWarehouseUuid: gofakeit.UUID(),
+		ToWarehouseUuid:   gofakeit.UUID(),
+		Quantity:          100,
+	})
+	s.Require().Nil(response)
+	s.Require().Equal(codes.FailedPrecondition, status.Code(err))
+}
+
+func (s *ServiceSuite) TestReserveStockSuccess() {
+	partUUID := gofakeit.UUID()
+	orderUUID := gofakeit.UUID()
+	warehouseUUID := gofakeit.UUID()
+
+	s.stockService.On("ReserveStock", s.ctx, &model.StockReservation{
+		Reference: orderUUID,
+		Items:     []*model.ReservationItem{{PartUuid: partUUID, Quantity: 2}},
+	}).Return([]*model.StockMovement{{
+		PartUuid:      partUUID,
+		WarehouseUuid: warehouseUUID,
+		Type:          model.STOCK_MOVEMENT_TYPE_RESERVATION,
+		Quantity:      -2,
+		Reference:     orderUUID,
+	}}, nil)
+
+	response, err := s.api.ReserveStock(s.ctx, &inventoryv1.ReserveStockRequest{
+		Reference: orderUUID,
+		Items:     []*inventoryv1.ReservationItem{{PartUuid: partUUID, Quantity: 2}},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(response.GetMovements(), 1)
+	s.Require().Equal(warehouseUUID, response.GetMovements()[0].GetWarehouseUuid())
+}
+
+func (s *ServiceSuite) TestGetStockAvailabilityUnknownPart() {
+	partUUID := gofakeit.UUID()
+
+	s.stockService.On("GetAvailability", s.ctx, []string{partUUID}).Return(nil, model.ErrPartNotFound)
+
+	response, err := s.api.GetStockAvailability(s.ctx, &inventoryv1.GetStockAvailabilityRequest{PartUuids: []string{partUUID}})
+	s.Require().Nil(response)
+	s.Require().Equal(codes.NotFound, status.Code(err))
+}
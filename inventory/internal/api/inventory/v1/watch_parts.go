@@ -0,0 +1,32 @@
+package v1
+
+import (
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (a *api) WatchParts(req *inventoryv1.WatchPartsRequest, stream inventoryv1.InventoryService_WatchPartsServer) error {
+	err := a.partService.WatchParts(stream.Context(), &model.PartsWatch{
+		Filter: converter.FilterFromProto(req.GetFilter()),
+		Send: func(event *model.PartEvent) error {
+			return stream.Send(converter.PartEventToProto(event))
+		},
+	})
+
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, model.ErrInvalidFilter):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, model.ErrResumeTokenExpired):
+		// Подписчик читал медленнее, чем менялся каталог, и изменения вытеснены из oplog
+		return status.Error(codes.Aborted, "subscriber fell behind part changes, resubscribe to get a new snapshot")
+	}
+	return err
+}
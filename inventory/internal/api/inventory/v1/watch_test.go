@@ -0,0 +1,80 @@
+package v1
+
+import (
+	"context"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+type watchPartsStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events []*inventoryv1.PartEvent
+}
+
+func (s *watchPartsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *watchPartsStream) Send(event *inventoryv1.PartEvent) error {
+	s.events = append(s.events, event)
+	return nil
+}
+
+func (s *ServiceSuite) TestWatchPartsSendsEvents() {
+	part := &model.Part{Uuid: gofakeit.UUID(), Name: "Main Engine", Category: model.CATEGORY_ENGINE}
+	occurredAt := time.Now().UTC()
+	stream := &watchPartsStream{ctx: s.ctx}
+
+	s.partService.On("WatchParts", s.ctx, mock.MatchedBy(func(w *model.PartsWatch) bool {
+		return w.Filter != nil && len(w.Filter.Categories) == 1
+	})).Return(func(_ context.Context, w *model.PartsWatch) error {
+		if err := w.Send(&model.PartEvent{Type: model.PART_EVENT_TYPE_SNAPSHOT_COMPLETE}); err != nil {
+			return err
+		}
+		return w.Send(&model.PartEvent{
+			Type:       model.PART_EVENT_TYPE_UPDATED,
+			PartUuid:   part.Uuid,
+			Part:       part,
+			OccurredAt: occurredAt,
+		})
+	})
+
+	err := s.api.WatchParts(&inventoryv1.WatchPartsRequest{
+		Filter: &inventoryv1.PartsFilter{Categories: []inventoryv1.Category{inventoryv1.Category_CATEGORY_ENGINE}},
+	}, stream)
+	s.Require().NoError(err)
+	s.Require().Len(stream.events, 2)
+
+	s.Require().Equal(inventoryv1.PartEventType_PART_EVENT_TYPE_SNAPSHOT_COMPLETE, stream.events[0].GetType())
+	s.Require().Nil(stream.events[0].GetOccurredAt())
+
+	s.Require().Equal(inventoryv1.PartEventType_PART_EVENT_TYPE_UPDATED, stream.events[1].GetType())
+	s.Require().Equal(part.Uuid, stream.events[1].GetPart().GetUuid())
+	s.Require().True(occurredAt.Equal(stream.events[1].GetOccurredAt().AsTime()))
+}
+
+func (s *ServiceSuite) TestWatchPartsErrors() {
+	cases := []struct {
+		err  error
+		code codes.Code
+	}{
+		{model.ErrInvalidFilter, codes.InvalidArgument},
+		{model.ErrResumeTokenExpired, codes.Aborted},
+	}
+
+	for _, tc := range cases {
+		s.partService.On("WatchParts", s.ctx, mock.AnythingOfType("*model.PartsWatch")).Return(tc.err).Once()
+
+		err := s.api.WatchParts(&inventoryv1.WatchPartsRequest{}, &watchPartsStream{ctx: s.ctx})
+		s.Require().Equal(tc.code, status.Code(err))
+	}
+}
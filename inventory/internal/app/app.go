@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -14,7 +15,10 @@ import (
 	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/config"
 	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/closer"
 	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/grpc/health"
+	httpHealth "github.com/Daniil-Sakharov/RocketFactory/platform/pkg/http/health"
 	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+	grpcMiddleware "github.com/Daniil-Sakharov/RocketFactory/platform/pkg/middleware/grpc"
+	catalogV1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/openapi/inventory/v1"
 	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
 )
 
@@ -22,6 +26,7 @@ type App struct {
 	diContainer *diContainer
 	grpcServer  *grpc.Server
 	listener    net.Listener
+	httpServer  *http.Server
 }
 
 func New(ctx context.Context) (*App, error) {
@@ -38,8 +43,11 @@ func New(ctx context.Context) (*App, error) {
 func (a *App) Run(ctx context.Context) error {
 	logger.Info(ctx, "🏃 Starting application Run()")
 
-	// Запускаем gRPC сервер в горутине чтобы обрабатывать сигналы graceful shutdown
-	errChan := make(chan error, 1)
+	go a.runPriceApplier(ctx)
+	go a.runPartChangePublisher(ctx)
+
+	// Запускаем gRPC и HTTP серверы в горутинах чтобы обрабатывать сигналы graceful shutdown
+	errChan := make(chan error, 2)
 	go func() {
 		logger.Info(ctx, "🚀 Starting gRPC server goroutine")
 		if err := a.runGRPCServer(ctx); err != nil {
@@ -47,11 +55,17 @@ func (a *App) Run(ctx context.Context) error {
 			errChan <- err
 		}
 	}()
+	go func() {
+		if err := a.runHTTPServer(ctx); err != nil {
+			logger.Error(ctx, "❌ HTTP server error", zap.Error(err))
+			errChan <- err
+		}
+	}()
 
 	// Ждем ошибку или контекст
 	select {
 	case err := <-errChan:
-		logger.Error(ctx, "❌ Received error from gRPC server", zap.Error(err))
+		logger.Error(ctx, "❌ Received error from server", zap.Error(err))
 		return err
 	case <-ctx.Done():
 		logger.Info(ctx, "⏹️ Context cancelled, shutting down gracefully")
@@ -68,6 +82,7 @@ func (a *App) InitDeps(ctx context.Context) error {
 		a.initCloser,
 		a.initListener,
 		a.initGRPCServer,
+		a.initHTTPServer,
 	}
 
 	for _, f := range inits {
@@ -132,12 +147,64 @@ func (a *App) initGRPCServer(ctx context.Context) error {
 		return handler(ctx, req)
 	}
 
+	// Тот же recovery для стримов: паника в WatchParts или загрузке файла не должна ронять сервис
+	streamRecoveryInterceptor := func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Error(ss.Context(), "🔥 PANIC in gRPC stream handler",
+					zap.String("method", info.FullMethod),
+					zap.Any("panic", r),
+					zap.Stack("stacktrace"))
+				err = fmt.Errorf("panic in stream handler %s: %v", info.FullMethod, r)
+			}
+		}()
+		return handler(srv, ss)
+	}
+
+	// Создание, изменение и удаление деталей доступны только администраторам. Резерв и его расход
+	// тоже защищены токеном: их вызывает только order сервис, иначе любой клиент мог бы держать остаток
+	adminInterceptor := grpcMiddleware.NewAdminInterceptor(
+		config.AppConfig().Admin.Token(),
+		inventoryv1.InventoryService_CreatePart_FullMethodName,
+		inventoryv1.InventoryService_UpdatePart_FullMethodName,
+		inventoryv1.InventoryService_DeletePart_FullMethodName,
+		inventoryv1.InventoryService_ReceiveStock_FullMethodName,
+		inventoryv1.InventoryService_CreateCompatibilityRule_FullMethodName,
+		inventoryv1.InventoryService_DeleteCompatibilityRule_FullMethodName,
+		inventoryv1.InventoryService_CreateRocketModel_FullMethodName,
+		inventoryv1.InventoryService_ImportParts_FullMethodName,
+		inventoryv1.InventoryService_UploadAttachment_FullMethodName,
+		inventoryv1.InventoryService_DeleteAttachment_FullMethodName,
+		inventoryv1.InventoryService_SchedulePartPrice_FullMethodName,
+		inventoryv1.InventoryService_CreateWarehouse_FullMethodName,
+		inventoryv1.InventoryService_TransferStock_FullMethodName,
+		inventoryv1.InventoryService_CreateCategory_FullMethodName,
+		inventoryv1.InventoryService_UpdateCategory_FullMethodName,
+		inventoryv1.InventoryService_DeleteCategory_FullMethodName,
+		inventoryv1.InventoryService_ReserveStock_FullMethodName,
+		inventoryv1.InventoryService_ReleaseStock_FullMethodName,
+		inventoryv1.InventoryService_ConsumeStock_FullMethodName,
+	)
+
 	a.grpcServer = grpc.NewServer(
 		grpc.Creds(insecure.NewCredentials()),
-		grpc.UnaryInterceptor(recoveryInterceptor),
+		grpc.ChainUnaryInterceptor(recoveryInterceptor, adminInterceptor.Unary()),
+		grpc.ChainStreamInterceptor(streamRecoveryInterceptor, adminInterceptor.Stream()),
 	)
 	closer.AddNamed("gRPC server", func(ctx context.Context) error {
-		a.grpcServer.GracefulStop()
+		// Подписки WatchParts открыты, пока клиент не отпишется, и GracefulStop ждал бы их вечно,
+		// поэтому по истечении ctx оставшиеся стримы закрываются принудительно
+		stopped := make(chan struct{})
+		go func() {
+			a.grpcServer.GracefulStop()
+			close(stopped)
+		}()
+
+		select {
+		case <-stopped:
+		case <-ctx.Done():
+			a.grpcServer.Stop()
+		}
 		return nil
 	})
 
@@ -160,3 +227,43 @@ func (a *App) runGRPCServer(ctx context.Context) error {
 
 	return nil
 }
+
+// initHTTPServer поднимает публичный HTTP API каталога рядом с gRPC
+func (a *App) initHTTPServer(ctx context.Context) error {
+	server, err := catalogV1.NewServer(a.diContainer.CatalogV1API(ctx))
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/health", httpHealth.NewHandler(httpHealth.Config{
+		ServiceName: "inventory-service",
+		Version:     "1.0.0",
+	}))
+	mux.Handle("/api/", server)
+
+	a.httpServer = &http.Server{
+		Addr:         config.AppConfig().CatalogHTTP.Address(),
+		Handler:      mux,
+		ReadTimeout:  config.AppConfig().CatalogHTTP.ReadTimeout(),
+		WriteTimeout: config.AppConfig().CatalogHTTP.WriteTimeout(),
+		IdleTimeout:  config.AppConfig().CatalogHTTP.IdleTimeout(),
+	}
+
+	closer.AddNamed("HTTP server", func(ctx context.Context) error {
+		return a.httpServer.Shutdown(ctx)
+	})
+
+	return nil
+}
+
+func (a *App) runHTTPServer(ctx context.Context) error {
+	logger.Info(ctx, fmt.Sprintf("🚀 HTTP catalog API listening on %s", config.AppConfig().CatalogHTTP.Address()))
+
+	err := a.httpServer.ListenAndServe()
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package app
+
+import (
+	"context"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+// Catalog - одноразовый импорт или выгрузка каталога деталей без gRPC сервера
+type Catalog struct {
+	diContainer *diContainer
+}
+
+func NewCatalog(ctx context.Context) (*Catalog, error) {
+	a := &App{}
+
+	inits := []func(context.Context) error{
+		a.initDI,
+		a.initLogger,
+		a.initCloser,
+	}
+
+	for _, f := range inits {
+		err := f(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &Catalog{diContainer: a.diContainer}, nil
+}
+
+func (c *Catalog) Import(ctx context.Context, catalogImport *model.CatalogImport) (*model.CatalogImportReport, error) {
+	return c.diContainer.InventoryService(ctx).ImportParts(ctx, catalogImport)
+}
+
+func (c *Catalog) Export(ctx context.Context, export *model.CatalogExport) (int, error) {
+	return c.diContainer.InventoryService(ctx).ExportParts(ctx, export)
+}
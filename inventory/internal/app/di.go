@@ -5,27 +5,76 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/IBM/sarama"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.uber.org/zap"
 
+	apiCatalog "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/api/catalog/v1"
 	apiPart "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/api/inventory/v1"
 	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/config"
 	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository"
+	repoAttachment "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/attachment"
+	repoCategory "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/category"
+	repoCompatibility "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/compatibility"
 	repoPart "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/part"
+	repoPartChange "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/part_change"
+	repoPrice "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/price"
+	repoRocketModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/rocket_model"
+	repoStock "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/stock"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/txmanager"
+	repoWarehouse "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/warehouse"
 	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/service"
+	serviceAttachment "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/service/attachment"
+	serviceCategory "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/service/category"
+	serviceCompatibility "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/service/compatibility"
 	servicePart "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/service/part"
+	servicePartChange "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/service/part_change"
+	servicePrice "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/service/price"
+	partProducer "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/service/producer/part_producer"
+	stockProducer "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/service/producer/stock_producer"
+	serviceRocketModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/service/rocket_model"
+	serviceStock "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/service/stock"
+	serviceWarehouse "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/service/warehouse"
 	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/closer"
+	wrappedKafka "github.com/Daniil-Sakharov/RocketFactory/platform/pkg/kafka"
+	wrappedKafkaProducer "github.com/Daniil-Sakharov/RocketFactory/platform/pkg/kafka/producer"
 	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+	catalogV1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/openapi/inventory/v1"
 	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
 )
 
 type diContainer struct {
-	inventoryV1API      inventoryv1.InventoryServiceServer
-	inventoryService    service.PartService
-	inventoryRepository repository.PartRepository
-	mongoDBClient       *mongo.Client
-	mongoDBDatabase     *mongo.Database
+	inventoryV1API          inventoryv1.InventoryServiceServer
+	catalogV1API            catalogV1.Handler
+	inventoryService        service.PartService
+	inventoryRepository     repository.PartRepository
+	stockService            service.StockService
+	stockRepository         repository.StockRepository
+	compatibilityService    service.CompatibilityService
+	compatibilityRepository repository.CompatibilityRepository
+	rocketModelService      service.RocketModelService
+	rocketModelRepository   repository.RocketModelRepository
+	attachmentService       service.AttachmentService
+	attachmentRepository    repository.AttachmentRepository
+	priceService            service.PriceService
+	priceRepository         repository.PriceRepository
+	warehouseService        service.WarehouseService
+	warehouseRepository     repository.WarehouseRepository
+	categoryService         service.CategoryService
+	categoryRepository      repository.CategoryRepository
+	partChangeService       service.PartChangeService
+	partChangeRepository    repository.PartChangeRepository
+	stockProducer           service.StockProducerService
+	partProducer            service.PartProducerService
+	stockLowProducer        wrappedKafka.Producer
+	restockedProducer       wrappedKafka.Producer
+	partChangesProducer     wrappedKafka.Producer
+	syncProducer            sarama.SyncProducer
+	txManager               repository.TxManager
+	mongoDBClient           *mongo.Client
+	mongoDBDatabase         *mongo.Database
 }
 
 func NewDiContainer() *diContainer {
@@ -34,18 +83,52 @@ func NewDiContainer() *diContainer {
 
 func (d *diContainer) InventoryAPI(ctx context.Context) inventoryv1.InventoryServiceServer {
 	if d.inventoryV1API == nil {
-		d.inventoryV1API = apiPart.NewAPI(d.InventoryService(ctx))
+		d.inventoryV1API = apiPart.NewAPI(
+			d.InventoryService(ctx),
+			d.StockService(ctx),
+			d.CompatibilityService(ctx),
+			d.RocketModelService(ctx),
+			d.AttachmentService(ctx),
+			d.PriceService(ctx),
+			d.WarehouseService(ctx),
+			d.CategoryService(ctx),
+		)
 	}
 	return d.inventoryV1API
 }
 
+func (d *diContainer) CatalogV1API(ctx context.Context) catalogV1.Handler {
+	if d.catalogV1API == nil {
+		d.catalogV1API = apiCatalog.NewAPI(
+			d.InventoryService(ctx),
+			d.CategoryService(ctx),
+			config.AppConfig().CatalogHTTP.CacheMaxAge(),
+		)
+	}
+	return d.catalogV1API
+}
+
 func (d *diContainer) InventoryService(ctx context.Context) service.PartService {
 	if d.inventoryService == nil {
-		d.inventoryService = servicePart.NewService(d.InventoryRepository(ctx))
+		d.inventoryService = servicePart.NewService(
+			d.InventoryRepository(ctx),
+			d.PartChangeRepository(ctx),
+			d.StockService(ctx),
+			d.PriceService(ctx),
+			d.CategoryService(ctx),
+			d.TxManager(ctx),
+		)
 	}
 	return d.inventoryService
 }
 
+func (d *diContainer) TxManager(ctx context.Context) repository.TxManager {
+	if d.txManager == nil {
+		d.txManager = txmanager.NewManager(d.MongoDBClient(ctx))
+	}
+	return d.txManager
+}
+
 func (d *diContainer) InventoryRepository(ctx context.Context) repository.PartRepository {
 	if d.inventoryRepository == nil {
 		d.inventoryRepository = repoPart.NewRepository(ctx, d.MongoDBDatabase(ctx))
@@ -54,6 +137,227 @@ func (d *diContainer) InventoryRepository(ctx context.Context) repository.PartRe
 	return d.inventoryRepository
 }
 
+func (d *diContainer) StockService(ctx context.Context) service.StockService {
+	if d.stockService == nil {
+		d.stockService = serviceStock.NewService(
+			d.StockRepository(ctx),
+			d.InventoryRepository(ctx),
+			d.WarehouseRepository(ctx),
+			d.StockProducerService(ctx),
+			d.TxManager(ctx),
+			config.AppConfig().Warehouse.DefaultUUID(),
+			config.AppConfig().Warehouse.ReservationStrategy(),
+			config.AppConfig().Warehouse.Origin(),
+		)
+	}
+	return d.stockService
+}
+
+func (d *diContainer) StockRepository(ctx context.Context) repository.StockRepository {
+	if d.stockRepository == nil {
+		d.stockRepository = repoStock.NewRepository(ctx, d.MongoDBDatabase(ctx))
+
+		// Остаток, записанный до появления складов, относим на склад по умолчанию
+		assigned, err := d.stockRepository.AssignUnallocatedStock(ctx, config.AppConfig().Warehouse.DefaultUUID())
+		if err != nil {
+			logger.Error(ctx, "Failed to assign unallocated stock to default warehouse", zap.Error(err))
+		} else if assigned > 0 {
+			logger.Info(ctx, fmt.Sprintf("Assigned stock of %d parts to default warehouse", assigned))
+		}
+	}
+	return d.stockRepository
+}
+
+func (d *diContainer) CategoryService(ctx context.Context) service.CategoryService {
+	if d.categoryService == nil {
+		d.categoryService = serviceCategory.NewService(
+			d.CategoryRepository(ctx),
+			d.InventoryRepository(ctx),
+		)
+	}
+	return d.categoryService
+}
+
+func (d *diContainer) CategoryRepository(ctx context.Context) repository.CategoryRepository {
+	if d.categoryRepository == nil {
+		d.categoryRepository = repoCategory.NewRepository(ctx, d.MongoDBDatabase(ctx))
+		d.categoryRepository.InitTestData(ctx)
+	}
+	return d.categoryRepository
+}
+
+func (d *diContainer) WarehouseService(ctx context.Context) service.WarehouseService {
+	if d.warehouseService == nil {
+		d.warehouseService = serviceWarehouse.NewService(d.WarehouseRepository(ctx))
+	}
+	return d.warehouseService
+}
+
+func (d *diContainer) WarehouseRepository(ctx context.Context) repository.WarehouseRepository {
+	if d.warehouseRepository == nil {
+		d.warehouseRepository = repoWarehouse.NewRepository(ctx, d.MongoDBDatabase(ctx))
+		d.warehouseRepository.InitTestData(ctx)
+	}
+	return d.warehouseRepository
+}
+
+func (d *diContainer) CompatibilityService(ctx context.Context) service.CompatibilityService {
+	if d.compatibilityService == nil {
+		d.compatibilityService = serviceCompatibility.NewService(
+			d.CompatibilityRepository(ctx),
+			d.InventoryRepository(ctx),
+			d.CategoryRepository(ctx),
+		)
+	}
+	return d.compatibilityService
+}
+
+func (d *diContainer) CompatibilityRepository(ctx context.Context) repository.CompatibilityRepository {
+	if d.compatibilityRepository == nil {
+		d.compatibilityRepository = repoCompatibility.NewRepository(ctx, d.MongoDBDatabase(ctx))
+		d.compatibilityRepository.InitTestData(ctx)
+	}
+	return d.compatibilityRepository
+}
+
+func (d *diContainer) RocketModelService(ctx context.Context) service.RocketModelService {
+	if d.rocketModelService == nil {
+		d.rocketModelService = serviceRocketModel.NewService(d.RocketModelRepository(ctx), d.InventoryRepository(ctx))
+	}
+	return d.rocketModelService
+}
+
+func (d *diContainer) RocketModelRepository(ctx context.Context) repository.RocketModelRepository {
+	if d.rocketModelRepository == nil {
+		d.rocketModelRepository = repoRocketModel.NewRepository(ctx, d.MongoDBDatabase(ctx))
+		d.rocketModelRepository.InitTestData(ctx)
+	}
+	return d.rocketModelRepository
+}
+
+func (d *diContainer) AttachmentService(ctx context.Context) service.AttachmentService {
+	if d.attachmentService == nil {
+		d.attachmentService = serviceAttachment.NewService(
+			d.AttachmentRepository(ctx),
+			d.InventoryRepository(ctx),
+			config.AppConfig().Attachment.MaxSize(),
+			config.AppConfig().Attachment.ContentTypes(),
+		)
+	}
+	return d.attachmentService
+}
+
+func (d *diContainer) AttachmentRepository(ctx context.Context) repository.AttachmentRepository {
+	if d.attachmentRepository == nil {
+		d.attachmentRepository = repoAttachment.NewRepository(ctx, d.MongoDBDatabase(ctx))
+	}
+	return d.attachmentRepository
+}
+
+func (d *diContainer) PriceService(ctx context.Context) service.PriceService {
+	if d.priceService == nil {
+		d.priceService = servicePrice.NewService(d.PriceRepository(ctx), d.InventoryRepository(ctx))
+	}
+	return d.priceService
+}
+
+func (d *diContainer) PriceRepository(ctx context.Context) repository.PriceRepository {
+	if d.priceRepository == nil {
+		d.priceRepository = repoPrice.NewRepository(ctx, d.MongoDBDatabase(ctx))
+	}
+	return d.priceRepository
+}
+
+func (d *diContainer) PartChangeService(ctx context.Context) service.PartChangeService {
+	if d.partChangeService == nil {
+		d.partChangeService = servicePartChange.NewService(
+			d.PartChangeRepository(ctx),
+			d.InventoryRepository(ctx),
+			d.PartProducerService(),
+			config.AppConfig().PartChange.RetryInterval(),
+		)
+	}
+	return d.partChangeService
+}
+
+func (d *diContainer) PartChangeRepository(ctx context.Context) repository.PartChangeRepository {
+	if d.partChangeRepository == nil {
+		d.partChangeRepository = repoPartChange.NewRepository(ctx, d.MongoDBDatabase(ctx))
+	}
+	return d.partChangeRepository
+}
+
+func (d *diContainer) PartProducerService() service.PartProducerService {
+	if d.partProducer == nil {
+		d.partProducer = partProducer.NewService(d.PartChangesProducer())
+	}
+	return d.partProducer
+}
+
+func (d *diContainer) StockProducerService(ctx context.Context) service.StockProducerService {
+	if d.stockProducer == nil {
+		// Kafka необязательна: без брокеров события об остатках только логируются
+		if len(config.AppConfig().Kafka.Brokers()) == 0 {
+			logger.Warn(ctx, "KAFKA_BROKERS is empty, stock alerts are disabled")
+			d.stockProducer = stockProducer.NewDisabledService()
+		} else {
+			d.stockProducer = stockProducer.NewService(d.StockLowProducer(), d.RestockedProducer())
+		}
+	}
+	return d.stockProducer
+}
+
+func (d *diContainer) StockLowProducer() wrappedKafka.Producer {
+	if d.stockLowProducer == nil {
+		d.stockLowProducer = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().StockProducer.StockLowTopic(),
+			logger.Logger(),
+		)
+	}
+	return d.stockLowProducer
+}
+
+func (d *diContainer) RestockedProducer() wrappedKafka.Producer {
+	if d.restockedProducer == nil {
+		d.restockedProducer = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().StockProducer.RestockedTopic(),
+			logger.Logger(),
+		)
+	}
+	return d.restockedProducer
+}
+
+func (d *diContainer) PartChangesProducer() wrappedKafka.Producer {
+	if d.partChangesProducer == nil {
+		d.partChangesProducer = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().PartChange.Topic(),
+			logger.Logger(),
+		)
+	}
+	return d.partChangesProducer
+}
+
+func (d *diContainer) SyncProducer() sarama.SyncProducer {
+	if d.syncProducer == nil {
+		p, err := sarama.NewSyncProducer(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().StockProducer.Config(),
+		)
+		if err != nil {
+			panic("failed to create sync producer: " + err.Error())
+		}
+		closer.AddNamed("Kafka sync producer", func(ctx context.Context) error {
+			return p.Close()
+		})
+
+		d.syncProducer = p
+	}
+	return d.syncProducer
+}
+
 func (d *diContainer) MongoDBClient(ctx context.Context) *mongo.Client {
 	if d.mongoDBClient == nil {
 		mongoURI := config.AppConfig().Mongo.URI()
@@ -0,0 +1,27 @@
+package app
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/config"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+// runPartChangePublisher публикует изменения деталей из change stream MongoDB в Kafka до отмены ctx.
+// Change stream требует MongoDB в режиме replica set
+func (a *App) runPartChangePublisher(ctx context.Context) {
+	if len(config.AppConfig().Kafka.Brokers()) == 0 {
+		logger.Warn(ctx, "KAFKA_BROKERS is empty, part change events are disabled")
+		return
+	}
+
+	logger.Info(ctx, "📡 Публикация изменений деталей запущена",
+		zap.String("topic", config.AppConfig().PartChange.Topic()),
+	)
+
+	if err := a.diContainer.PartChangeService(ctx).PublishChanges(ctx); err != nil {
+		logger.Error(ctx, "❌ Публикация изменений деталей остановлена", zap.Error(err))
+	}
+}
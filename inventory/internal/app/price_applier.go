@@ -0,0 +1,38 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/config"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+// runPriceApplier периодически переносит наступившие запланированные цены в карточки деталей до отмены ctx
+func (a *App) runPriceApplier(ctx context.Context) {
+	interval := config.AppConfig().Price.ApplyInterval()
+	priceService := a.diContainer.PriceService(ctx)
+
+	logger.Info(ctx, "💲 Job применения запланированных цен запущен", zap.Duration("interval", interval))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			applied, err := priceService.ApplyDue(ctx, now)
+			if err != nil {
+				logger.Error(ctx, "❌ Ошибка применения запланированных цен", zap.Error(err))
+				continue
+			}
+			if applied > 0 {
+				logger.Info(ctx, "💲 Запланированные цены применены", zap.Int("parts", applied))
+			}
+		}
+	}
+}
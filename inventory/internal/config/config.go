@@ -11,9 +11,18 @@ import (
 var appConfig *config
 
 type config struct {
-	Inventory InventoryConfig
-	Logger    LoggerConfig
-	Mongo     MongoConfig
+	Inventory   InventoryConfig
+	CatalogHTTP CatalogHTTPConfig
+	Logger      LoggerConfig
+	Mongo       MongoConfig
+	Admin       AdminConfig
+	Kafka       KafkaConfig
+
+	Attachment    AttachmentConfig
+	Price         PriceConfig
+	Warehouse     WarehouseConfig
+	StockProducer StockProducerConfig
+	PartChange    PartChangeConfig
 }
 
 func Load(path ...string) error {
@@ -27,6 +36,11 @@ func Load(path ...string) error {
 		return err
 	}
 
+	catalogHTTPCfg, err := env.NewCatalogHTTPConfig()
+	if err != nil {
+		return err
+	}
+
 	loggerCfg, err := env.NewLoggerConfig()
 	if err != nil {
 		return err
@@ -37,10 +51,54 @@ func Load(path ...string) error {
 		return err
 	}
 
+	adminCfg, err := env.NewAdminConfig()
+	if err != nil {
+		return err
+	}
+
+	kafkaCfg, err := env.NewKafkaConfig()
+	if err != nil {
+		return err
+	}
+
+	attachmentCfg, err := env.NewAttachmentConfig()
+	if err != nil {
+		return err
+	}
+
+	priceCfg, err := env.NewPriceConfig()
+	if err != nil {
+		return err
+	}
+
+	warehouseCfg, err := env.NewWarehouseConfig()
+	if err != nil {
+		return err
+	}
+
+	stockProducerCfg, err := env.NewStockProducerConfig()
+	if err != nil {
+		return err
+	}
+
+	partChangeCfg, err := env.NewPartChangeConfig()
+	if err != nil {
+		return err
+	}
+
 	appConfig = &config{
-		Inventory: inventoryCfg,
-		Logger:    loggerCfg,
-		Mongo:     mongoCfg,
+		Inventory:   inventoryCfg,
+		CatalogHTTP: catalogHTTPCfg,
+		Logger:      loggerCfg,
+		Mongo:       mongoCfg,
+		Admin:       adminCfg,
+		Kafka:       kafkaCfg,
+
+		Attachment:    attachmentCfg,
+		Price:         priceCfg,
+		Warehouse:     warehouseCfg,
+		StockProducer: stockProducerCfg,
+		PartChange:    partChangeCfg,
 	}
 
 	return nil
@@ -0,0 +1,27 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type adminEnvConfig struct {
+	Token string `env:"ADMIN_TOKEN"`
+}
+
+type adminConfig struct {
+	raw adminEnvConfig
+}
+
+func NewAdminConfig() (*adminConfig, error) {
+	var raw adminEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &adminConfig{raw: raw}, nil
+}
+
+// Token - токен для административных RPC (metadata admin-token). Пустой токен отключает их
+func (cfg *adminConfig) Token() string {
+	return cfg.raw.Token
+}
@@ -0,0 +1,33 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type attachmentEnvConfig struct {
+	MaxSize      int64    `env:"ATTACHMENT_MAX_SIZE" envDefault:"20971520"`
+	ContentTypes []string `env:"ATTACHMENT_CONTENT_TYPES" envSeparator:"," envDefault:"image/jpeg,image/png,image/webp,application/pdf"`
+}
+
+type attachmentConfig struct {
+	raw attachmentEnvConfig
+}
+
+func NewAttachmentConfig() (*attachmentConfig, error) {
+	var raw attachmentEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &attachmentConfig{raw: raw}, nil
+}
+
+// MaxSize - максимальный размер вложения в байтах
+func (cfg *attachmentConfig) MaxSize() int64 {
+	return cfg.raw.MaxSize
+}
+
+// ContentTypes - разрешенные MIME-типы вложений. Тип должен определяться по сигнатуре файла
+func (cfg *attachmentConfig) ContentTypes() []string {
+	return cfg.raw.ContentTypes
+}
@@ -0,0 +1,50 @@
+package env
+
+import (
+	"net"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type catalogHTTPEnvConfig struct {
+	Host         string        `env:"HTTP_HOST,required"`
+	Port         string        `env:"HTTP_PORT,required"`
+	ReadTimeout  time.Duration `env:"HTTP_READ_TIMEOUT" envDefault:"15s"`
+	WriteTimeout time.Duration `env:"HTTP_WRITE_TIMEOUT" envDefault:"15s"`
+	IdleTimeout  time.Duration `env:"HTTP_IDLE_TIMEOUT" envDefault:"60s"`
+	CacheMaxAge  time.Duration `env:"HTTP_CACHE_MAX_AGE" envDefault:"60s"`
+}
+
+type catalogHTTPConfig struct {
+	raw catalogHTTPEnvConfig
+}
+
+func NewCatalogHTTPConfig() (*catalogHTTPConfig, error) {
+	var raw catalogHTTPEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &catalogHTTPConfig{raw: raw}, nil
+}
+
+func (cfg *catalogHTTPConfig) Address() string {
+	return net.JoinHostPort(cfg.raw.Host, cfg.raw.Port)
+}
+
+func (cfg *catalogHTTPConfig) ReadTimeout() time.Duration {
+	return cfg.raw.ReadTimeout
+}
+
+func (cfg *catalogHTTPConfig) WriteTimeout() time.Duration {
+	return cfg.raw.WriteTimeout
+}
+
+func (cfg *catalogHTTPConfig) IdleTimeout() time.Duration {
+	return cfg.raw.IdleTimeout
+}
+
+func (cfg *catalogHTTPConfig) CacheMaxAge() time.Duration {
+	return cfg.raw.CacheMaxAge
+}
@@ -0,0 +1,25 @@
+package env
+
+import "github.com/caarlos0/env/v11"
+
+type kafkaEnvConfig struct {
+	// Пусто — оповещения об остатках отключены, сервис работает без Kafka
+	Brokers []string `env:"KAFKA_BROKERS"`
+}
+
+type kafkaConfig struct {
+	raw kafkaEnvConfig
+}
+
+func NewKafkaConfig() (*kafkaConfig, error) {
+	var raw kafkaEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &kafkaConfig{raw: raw}, nil
+}
+
+func (cfg *kafkaConfig) Brokers() []string {
+	return cfg.raw.Brokers
+}
@@ -0,0 +1,35 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type partChangeEnvConfig struct {
+	TopicName     string        `env:"PART_CHANGES_TOPIC_NAME" envDefault:"inventory.part.changes"`
+	RetryInterval time.Duration `env:"PART_CHANGES_RETRY_INTERVAL" envDefault:"5s"`
+}
+
+type partChangeConfig struct {
+	raw partChangeEnvConfig
+}
+
+func NewPartChangeConfig() (*partChangeConfig, error) {
+	var raw partChangeEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &partChangeConfig{raw: raw}, nil
+}
+
+// Topic - топик событий PartCreated/PartUpdated/PartDeleted
+func (cfg *partChangeConfig) Topic() string {
+	return cfg.raw.TopicName
+}
+
+// RetryInterval - пауза перед переоткрытием change stream после ошибки MongoDB или Kafka
+func (cfg *partChangeConfig) RetryInterval() time.Duration {
+	return cfg.raw.RetryInterval
+}
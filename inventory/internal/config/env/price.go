@@ -0,0 +1,29 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type priceEnvConfig struct {
+	ApplyInterval time.Duration `env:"PRICE_APPLY_INTERVAL" envDefault:"1m"`
+}
+
+type priceConfig struct {
+	raw priceEnvConfig
+}
+
+func NewPriceConfig() (*priceConfig, error) {
+	var raw priceEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &priceConfig{raw: raw}, nil
+}
+
+// ApplyInterval - период переноса наступивших запланированных цен в карточки деталей
+func (cfg *priceConfig) ApplyInterval() time.Duration {
+	return cfg.raw.ApplyInterval
+}
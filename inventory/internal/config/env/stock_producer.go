@@ -0,0 +1,40 @@
+package env
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type stockProducerEnvConfig struct {
+	StockLowTopicName  string `env:"PART_STOCK_LOW_TOPIC_NAME" envDefault:"inventory.part.stock-low"`
+	RestockedTopicName string `env:"PART_RESTOCKED_TOPIC_NAME" envDefault:"inventory.part.restocked"`
+}
+
+type stockProducerConfig struct {
+	raw stockProducerEnvConfig
+}
+
+func NewStockProducerConfig() (*stockProducerConfig, error) {
+	var raw stockProducerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &stockProducerConfig{raw: raw}, nil
+}
+
+func (cfg *stockProducerConfig) StockLowTopic() string {
+	return cfg.raw.StockLowTopicName
+}
+
+func (cfg *stockProducerConfig) RestockedTopic() string {
+	return cfg.raw.RestockedTopicName
+}
+
+func (cfg *stockProducerConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Producer.Return.Successes = true
+
+	return config
+}
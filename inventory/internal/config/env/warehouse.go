@@ -0,0 +1,58 @@
+package env
+
+import (
+	"fmt"
+
+	"github.com/caarlos0/env/v11"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+type warehouseEnvConfig struct {
+	DefaultUUID         string  `env:"WAREHOUSE_DEFAULT_UUID" envDefault:"9d4c2a10-7b3e-4f5a-8c6d-2e1f0a9b0001"`
+	ReservationStrategy string  `env:"WAREHOUSE_RESERVATION_STRATEGY" envDefault:"most_stock"`
+	OriginLatitude      float64 `env:"WAREHOUSE_ORIGIN_LATITUDE" envDefault:"55.92"`
+	OriginLongitude     float64 `env:"WAREHOUSE_ORIGIN_LONGITUDE" envDefault:"37.82"`
+}
+
+type warehouseConfig struct {
+	raw                 warehouseEnvConfig
+	reservationStrategy model.ReservationStrategy
+}
+
+func NewWarehouseConfig() (*warehouseConfig, error) {
+	var raw warehouseEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	strategy := model.ReservationStrategy(raw.ReservationStrategy)
+	switch strategy {
+	case model.RESERVATION_STRATEGY_MOST_STOCK, model.RESERVATION_STRATEGY_NEAREST:
+	default:
+		return nil, fmt.Errorf("unknown WAREHOUSE_RESERVATION_STRATEGY %q", raw.ReservationStrategy)
+	}
+
+	return &warehouseConfig{
+		raw:                 raw,
+		reservationStrategy: strategy,
+	}, nil
+}
+
+// DefaultUUID - склад для поступлений и корректировок без явного склада
+func (cfg *warehouseConfig) DefaultUUID() string {
+	return cfg.raw.DefaultUUID
+}
+
+// ReservationStrategy - правило выбора склада при резервировании под заказ
+func (cfg *warehouseConfig) ReservationStrategy() model.ReservationStrategy {
+	return cfg.reservationStrategy
+}
+
+// Origin - точка отгрузки заказов, от нее стратегия nearest считает расстояние до складов
+func (cfg *warehouseConfig) Origin() model.GeoPoint {
+	return model.GeoPoint{
+		Latitude:  cfg.raw.OriginLatitude,
+		Longitude: cfg.raw.OriginLongitude,
+	}
+}
@@ -1,9 +1,30 @@
 package config
 
+import (
+	"time"
+
+	"github.com/IBM/sarama"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
 type InventoryConfig interface {
 	Address() string
 }
 
+type CatalogHTTPConfig interface {
+	Address() string
+	ReadTimeout() time.Duration
+	WriteTimeout() time.Duration
+	IdleTimeout() time.Duration
+	// CacheMaxAge - max-age в Cache-Control ответов публичного каталога
+	CacheMaxAge() time.Duration
+}
+
+type AdminConfig interface {
+	Token() string
+}
+
 type LoggerConfig interface {
 	Level() string
 	AsJson() bool
@@ -13,3 +34,33 @@ type MongoConfig interface {
 	URI() string
 	DBName() string
 }
+
+type KafkaConfig interface {
+	Brokers() []string
+}
+
+type AttachmentConfig interface {
+	MaxSize() int64
+	ContentTypes() []string
+}
+
+type PriceConfig interface {
+	ApplyInterval() time.Duration
+}
+
+type WarehouseConfig interface {
+	DefaultUUID() string
+	ReservationStrategy() model.ReservationStrategy
+	Origin() model.GeoPoint
+}
+
+type PartChangeConfig interface {
+	Topic() string
+	RetryInterval() time.Duration
+}
+
+type StockProducerConfig interface {
+	StockLowTopic() string
+	RestockedTopic() string
+	Config() *sarama.Config
+}
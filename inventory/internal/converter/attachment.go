@@ -0,0 +1,52 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+// AttachmentToProto конвертирует domain Attachment в protobuf Attachment
+func AttachmentToProto(attachment *model.Attachment) *inventoryv1.Attachment {
+	if attachment == nil {
+		return nil
+	}
+
+	return &inventoryv1.Attachment{
+		Uuid:        attachment.Uuid,
+		PartUuid:    attachment.PartUuid,
+		Kind:        inventoryv1.AttachmentKind(attachment.Kind),
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		Sha256:      attachment.Checksum,
+		Primary:     attachment.Primary,
+		CreatedAt:   timestamppb.New(attachment.CreatedAt),
+	}
+}
+
+// AttachmentsToProto конвертирует список вложений в protobuf
+func AttachmentsToProto(attachments []*model.Attachment) []*inventoryv1.Attachment {
+	if attachments == nil {
+		return nil
+	}
+
+	protoAttachments := make([]*inventoryv1.Attachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		protoAttachments = append(protoAttachments, AttachmentToProto(attachment))
+	}
+	return protoAttachments
+}
+
+// AttachmentUploadFromProto конвертирует описание загружаемого файла в domain AttachmentUpload
+func AttachmentUploadFromProto(upload *inventoryv1.AttachmentUpload) *model.AttachmentUpload {
+	return &model.AttachmentUpload{
+		PartUuid:    upload.GetPartUuid(),
+		Kind:        model.AttachmentKind(upload.GetKind()),
+		FileName:    upload.GetFileName(),
+		ContentType: upload.GetContentType(),
+		Primary:     upload.GetPrimary(),
+		Checksum:    upload.GetSha256(),
+	}
+}
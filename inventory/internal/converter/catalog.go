@@ -0,0 +1,582 @@
+package converter
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+// catalogPart - деталь в файле каталога. Поля совпадают с колонками CSV
+type catalogPart struct {
+	Uuid             string                 `json:"uuid"`
+	Name             string                 `json:"name"`
+	Description      string                 `json:"description,omitempty"`
+	Price            float64                `json:"price"`
+	StockQuantity    int64                  `json:"stock_quantity"`
+	Category         string                 `json:"category"`
+	Dimensions       *catalogDimensions     `json:"dimensions,omitempty"`
+	Manufacturer     *catalogManufacturer   `json:"manufacturer,omitempty"`
+	Tags             []string               `json:"tags,omitempty"`
+	Metadata         map[string]interface{} `json:"metadata,omitempty"`
+	ReorderThreshold int64                  `json:"reorder_threshold,omitempty"`
+}
+
+type catalogDimensions struct {
+	Length float64 `json:"length"`
+	Width  float64 `json:"width"`
+	Height float64 `json:"height"`
+	Weight float64 `json:"weight"`
+}
+
+type catalogManufacturer struct {
+	Name    string `json:"name"`
+	Country string `json:"country,omitempty"`
+	Website string `json:"website,omitempty"`
+}
+
+// catalogCSVHeader - колонки CSV каталога. Теги разделяются ";", metadata — JSON-объект
+var catalogCSVHeader = []string{
+	"uuid", "name", "description", "price", "stock_quantity", "category",
+	"length", "width", "height", "weight",
+	"manufacturer_name", "manufacturer_country", "manufacturer_website",
+	"tags", "metadata", "reorder_threshold",
+}
+
+const catalogTagSeparator = ";"
+
+// CatalogFormatFromProto конвертирует protobuf CatalogFormat в domain CatalogFormat
+func CatalogFormatFromProto(format inventoryv1.CatalogFormat) model.CatalogFormat {
+	switch format {
+	case inventoryv1.CatalogFormat_CATALOG_FORMAT_CSV:
+		return model.CATALOG_FORMAT_CSV
+	case inventoryv1.CatalogFormat_CATALOG_FORMAT_JSON:
+		return model.CATALOG_FORMAT_JSON
+	case inventoryv1.CatalogFormat_CATALOG_FORMAT_NDJSON:
+		return model.CATALOG_FORMAT_NDJSON
+	default:
+		return model.CATALOG_FORMAT_UNSPECIFIED
+	}
+}
+
+// CatalogFormatFromString разбирает формат каталога из имени: csv, json или ndjson
+func CatalogFormatFromString(format string) (model.CatalogFormat, error) {
+	switch strings.ToLower(format) {
+	case "csv":
+		return model.CATALOG_FORMAT_CSV, nil
+	case "json":
+		return model.CATALOG_FORMAT_JSON, nil
+	case "ndjson", "jsonl":
+		return model.CATALOG_FORMAT_NDJSON, nil
+	default:
+		return model.CATALOG_FORMAT_UNSPECIFIED, fmt.Errorf("%w: unknown format %q", model.ErrInvalidCatalog, format)
+	}
+}
+
+// CatalogImportReportToProto конвертирует отчет импорта в protobuf
+func CatalogImportReportToProto(report *model.CatalogImportReport) *inventoryv1.ImportPartsResponse {
+	rowErrors := make([]*inventoryv1.ImportRowError, 0, len(report.Errors))
+	for _, rowErr := range report.Errors {
+		rowErrors = append(rowErrors, &inventoryv1.ImportRowError{
+			Row:      int32(rowErr.Row), //nolint:gosec // номер записи ограничен размером файла
+			PartUuid: rowErr.PartUuid,
+			Message:  rowErr.Message,
+		})
+	}
+
+	return &inventoryv1.ImportPartsResponse{
+		DryRun:  report.DryRun,
+		Total:   int32(report.Total),    //nolint:gosec // количество записей ограничено размером файла
+		Created: int32(report.Created),  //nolint:gosec // количество записей ограничено размером файла
+		Updated: int32(report.Updated),  //nolint:gosec // количество записей ограничено размером файла
+		Failed:  int32(report.Failed()), //nolint:gosec // количество записей ограничено размером файла
+		Errors:  rowErrors,
+	}
+}
+
+// NewCatalogReader возвращает построчный читатель каталога. Для JSON и NDJSON принимается
+// как JSON-массив, так и поток объектов
+func NewCatalogReader(r io.Reader, format model.CatalogFormat) (model.CatalogReader, error) {
+	switch format {
+	case model.CATALOG_FORMAT_CSV:
+		return newCSVCatalogReader(r)
+	case model.CATALOG_FORMAT_JSON, model.CATALOG_FORMAT_NDJSON:
+		return newJSONCatalogReader(r)
+	default:
+		return nil, fmt.Errorf("%w: format is required", model.ErrInvalidCatalog)
+	}
+}
+
+// CatalogWriter записывает детали в файл каталога. Close дописывает окончание файла
+type CatalogWriter interface {
+	Write(part *model.Part) error
+	Close() error
+}
+
+// NewCatalogWriter возвращает писатель каталога в указанном формате
+func NewCatalogWriter(w io.Writer, format model.CatalogFormat) (CatalogWriter, error) {
+	switch format {
+	case model.CATALOG_FORMAT_CSV:
+		return newCSVCatalogWriter(w)
+	case model.CATALOG_FORMAT_JSON:
+		return &jsonCatalogWriter{w: w}, nil
+	case model.CATALOG_FORMAT_NDJSON:
+		return &ndjsonCatalogWriter{encoder: json.NewEncoder(w)}, nil
+	default:
+		return nil, fmt.Errorf("%w: format is required", model.ErrInvalidCatalog)
+	}
+}
+
+type csvCatalogReader struct {
+	reader  *csv.Reader
+	columns map[string]int
+	row     int
+}
+
+func newCSVCatalogReader(r io.Reader) (*csvCatalogReader, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%w: csv header is missing", model.ErrInvalidCatalog)
+		}
+		return nil, fmt.Errorf("%w: %w", model.ErrInvalidCatalog, err)
+	}
+
+	known := make(map[string]struct{}, len(catalogCSVHeader))
+	for _, column := range catalogCSVHeader {
+		known[column] = struct{}{}
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(column))
+		if _, ok := known[column]; !ok {
+			return nil, fmt.Errorf("%w: unknown csv column %q", model.ErrInvalidCatalog, column)
+		}
+		columns[column] = i
+	}
+	if _, ok := columns["uuid"]; !ok {
+		return nil, fmt.Errorf("%w: csv column \"uuid\" is required", model.ErrInvalidCatalog)
+	}
+
+	return &csvCatalogReader{reader: reader, columns: columns}, nil
+}
+
+func (r *csvCatalogReader) Next() (*model.CatalogRow, error) {
+	record, err := r.reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, io.EOF
+	}
+
+	r.row++
+	row := &model.CatalogRow{Row: r.row}
+
+	if err != nil {
+		// Запись с другим числом колонок пропускается, остальные ошибки CSV не дают читать дальше
+		if !errors.Is(err, csv.ErrFieldCount) {
+			return nil, fmt.Errorf("%w: %w", model.ErrInvalidCatalog, err)
+		}
+		row.Err = errors.New("wrong number of csv columns")
+		return row, nil
+	}
+
+	field := func(column string) string {
+		if i, ok := r.columns[column]; ok {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	row.PartUuid = field("uuid")
+	row.Part, row.Err = csvRecordToPart(field)
+	return row, nil
+}
+
+func csvRecordToPart(field func(string) string) (*model.Part, error) {
+	record := &catalogPart{
+		Uuid:        field("uuid"),
+		Name:        field("name"),
+		Description: field("description"),
+		Category:    field("category"),
+	}
+
+	var err error
+	if record.Price, err = parseCatalogFloat(field("price"), "price"); err != nil {
+		return nil, err
+	}
+	if record.StockQuantity, err = parseCatalogInt(field("stock_quantity"), "stock_quantity"); err != nil {
+		return nil, err
+	}
+	if record.ReorderThreshold, err = parseCatalogInt(field("reorder_threshold"), "reorder_threshold"); err != nil {
+		return nil, err
+	}
+
+	if field("length") != "" || field("width") != "" || field("height") != "" || field("weight") != "" {
+		d := &catalogDimensions{}
+		if d.Length, err = parseCatalogFloat(field("length"), "length"); err != nil {
+			return nil, err
+		}
+		if d.Width, err = parseCatalogFloat(field("width"), "width"); err != nil {
+			return nil, err
+		}
+		if d.Height, err = parseCatalogFloat(field("height"), "height"); err != nil {
+			return nil, err
+		}
+		if d.Weight, err = parseCatalogFloat(field("weight"), "weight"); err != nil {
+			return nil, err
+		}
+		record.Dimensions = d
+	}
+
+	if field("manufacturer_name") != "" || field("manufacturer_country") != "" || field("manufacturer_website") != "" {
+		record.Manufacturer = &catalogManufacturer{
+			Name:    field("manufacturer_name"),
+			Country: field("manufacturer_country"),
+			Website: field("manufacturer_website"),
+		}
+	}
+
+	if tags := field("tags"); tags != "" {
+		for _, tag := range strings.Split(tags, catalogTagSeparator) {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				record.Tags = append(record.Tags, tag)
+			}
+		}
+	}
+
+	if metadata := field("metadata"); metadata != "" {
+		decoder := json.NewDecoder(strings.NewReader(metadata))
+		decoder.UseNumber()
+		if err = decoder.Decode(&record.Metadata); err != nil {
+			return nil, errors.New("metadata must be a json object")
+		}
+	}
+
+	return catalogPartToModel(record)
+}
+
+func parseCatalogFloat(value, column string) (float64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	result, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be a number", column)
+	}
+	return result, nil
+}
+
+func parseCatalogInt(value, column string) (int64, error) {
+	if value == "" {
+		return 0, nil
+	}
+	result, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be an integer", column)
+	}
+	return result, nil
+}
+
+type jsonCatalogReader struct {
+	decoder *json.Decoder
+	array   bool
+	row     int
+}
+
+func newJSONCatalogReader(r io.Reader) (*jsonCatalogReader, error) {
+	buffered := bufio.NewReader(r)
+
+	// JSON-массив отличается от NDJSON первым значимым символом
+	var array bool
+	for {
+		b, err := buffered.ReadByte()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", model.ErrInvalidCatalog, err)
+		}
+		if b == ' ' || b == '\t' || b == '\r' || b == '\n' {
+			continue
+		}
+		array = b == '['
+		_ = buffered.UnreadByte() //nolint:gosec // байт только что прочитан, UnreadByte не вернет ошибку
+		break
+	}
+
+	decoder := json.NewDecoder(buffered)
+	if array {
+		// Открывающая скобка читается декодером, чтобы он сам разбирал запятые между записями
+		if _, err := decoder.Token(); err != nil {
+			return nil, fmt.Errorf("%w: %w", model.ErrInvalidCatalog, err)
+		}
+	}
+
+	return &jsonCatalogReader{decoder: decoder, array: array}, nil
+}
+
+func (r *jsonCatalogReader) Next() (*model.CatalogRow, error) {
+	if r.array && !r.decoder.More() {
+		// Закрывающая скобка массива
+		if _, err := r.decoder.Token(); err != nil {
+			return nil, fmt.Errorf("%w: %w", model.ErrInvalidCatalog, err)
+		}
+		return nil, io.EOF
+	}
+
+	// Синтаксическая ошибка не позволяет найти начало следующей записи, поэтому прерывает чтение
+	var raw json.RawMessage
+	if err := r.decoder.Decode(&raw); err != nil {
+		if errors.Is(err, io.EOF) && !r.array {
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("%w: %w", model.ErrInvalidCatalog, err)
+	}
+
+	r.row++
+	row := &model.CatalogRow{Row: r.row}
+
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	decoder.UseNumber()
+
+	var record catalogPart
+	if err := decoder.Decode(&record); err != nil {
+		// UUID нужен отчету даже для записи, которую не удалось разобрать
+		var partial struct {
+			Uuid string `json:"uuid"`
+		}
+		_ = json.Unmarshal(raw, &partial) //nolint:gosec // UUID в отчете необязателен
+		row.PartUuid = partial.Uuid
+		row.Err = err
+		return row, nil
+	}
+
+	row.PartUuid = record.Uuid
+	row.Part, row.Err = catalogPartToModel(&record)
+	return row, nil
+}
+
+func catalogPartToModel(record *catalogPart) (*model.Part, error) {
+	part := &model.Part{
+		Uuid:             record.Uuid,
+		Name:             record.Name,
+		Description:      record.Description,
+		Price:            record.Price,
+		StockQuantity:    record.StockQuantity,
+		Tags:             record.Tags,
+		ReorderThreshold: record.ReorderThreshold,
+	}
+
+	// Существование категории проверяет сервис при сохранении детали
+	part.Category = model.Category(strings.ToUpper(strings.TrimSpace(record.Category)))
+
+	if d := record.Dimensions; d != nil {
+		part.Dimensions = &model.Dimensions{Length: d.Length, Width: d.Width, Height: d.Height, Weight: d.Weight}
+	}
+	if m := record.Manufacturer; m != nil {
+		part.Manufacturer = &model.Manufacturer{Name: m.Name, Country: m.Country, Website: m.Website}
+	}
+
+	if len(record.Metadata) > 0 {
+		part.Metadata = make(map[string]interface{}, len(record.Metadata))
+		for key, value := range record.Metadata {
+			scalar, err := catalogMetadataValue(value)
+			if err != nil {
+				return nil, fmt.Errorf("metadata %q: %w", key, err)
+			}
+			part.Metadata[key] = scalar
+		}
+	}
+
+	return part, nil
+}
+
+// catalogMetadataValue приводит значение metadata к типам, которые поддерживает Part:
+// строка, int64, float64 или bool
+func catalogMetadataValue(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case string, bool:
+		return v, nil
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i, nil
+		}
+		return v.Float64()
+	default:
+		return nil, errors.New("value must be a string, number or boolean")
+	}
+}
+
+func partToCatalog(part *model.Part) *catalogPart {
+	record := &catalogPart{
+		Uuid:             part.Uuid,
+		Name:             part.Name,
+		Description:      part.Description,
+		Price:            part.Price,
+		StockQuantity:    part.StockQuantity,
+		Tags:             part.Tags,
+		Metadata:         part.Metadata,
+		ReorderThreshold: part.ReorderThreshold,
+		Category:         string(part.Category),
+	}
+
+	if d := part.Dimensions; d != nil {
+		record.Dimensions = &catalogDimensions{Length: d.Length, Width: d.Width, Height: d.Height, Weight: d.Weight}
+	}
+	if m := part.Manufacturer; m != nil {
+		record.Manufacturer = &catalogManufacturer{Name: m.Name, Country: m.Country, Website: m.Website}
+	}
+
+	return record
+}
+
+type csvCatalogWriter struct {
+	writer *csv.Writer
+}
+
+func newCSVCatalogWriter(w io.Writer) (*csvCatalogWriter, error) {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(catalogCSVHeader); err != nil {
+		return nil, err
+	}
+	return &csvCatalogWriter{writer: writer}, nil
+}
+
+func (w *csvCatalogWriter) Write(part *model.Part) error {
+	record := partToCatalog(part)
+
+	var metadata string
+	if len(record.Metadata) > 0 {
+		encoded, err := json.Marshal(record.Metadata)
+		if err != nil {
+			return err
+		}
+		metadata = string(encoded)
+	}
+
+	var length, width, height, weight string
+	if d := record.Dimensions; d != nil {
+		length, width, height, weight = formatCatalogFloat(d.Length), formatCatalogFloat(d.Width),
+			formatCatalogFloat(d.Height), formatCatalogFloat(d.Weight)
+	}
+
+	var manufacturerName, manufacturerCountry, manufacturerWebsite string
+	if m := record.Manufacturer; m != nil {
+		manufacturerName, manufacturerCountry, manufacturerWebsite = m.Name, m.Country, m.Website
+	}
+
+	return w.writer.Write([]string{
+		record.Uuid,
+		record.Name,
+		record.Description,
+		formatCatalogFloat(record.Price),
+		strconv.FormatInt(record.StockQuantity, 10),
+		record.Category,
+		length, width, height, weight,
+		manufacturerName, manufacturerCountry, manufacturerWebsite,
+		strings.Join(record.Tags, catalogTagSeparator),
+		metadata,
+		strconv.FormatInt(record.ReorderThreshold, 10),
+	})
+}
+
+func (w *csvCatalogWriter) Close() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+func formatCatalogFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+type jsonCatalogWriter struct {
+	w     io.Writer
+	count int
+}
+
+func (w *jsonCatalogWriter) Write(part *model.Part) error {
+	encoded, err := json.Marshal(partToCatalog(part))
+	if err != nil {
+		return err
+	}
+
+	prefix := ",\n  "
+	if w.count == 0 {
+		prefix = "[\n  "
+	}
+	w.count++
+
+	_, err = io.WriteString(w.w, prefix+string(encoded))
+	return err
+}
+
+func (w *jsonCatalogWriter) Close() error {
+	suffix := "\n]\n"
+	if w.count == 0 {
+		suffix = "[]\n"
+	}
+	_, err := io.WriteString(w.w, suffix)
+	return err
+}
+
+type ndjsonCatalogWriter struct {
+	encoder *json.Encoder
+}
+
+func (w *ndjsonCatalogWriter) Write(part *model.Part) error {
+	return w.encoder.Encode(partToCatalog(part))
+}
+
+func (w *ndjsonCatalogWriter) Close() error {
+	return nil
+}
+
+type catalogImportReportJSON struct {
+	DryRun  bool                  `json:"dry_run"`
+	Total   int                   `json:"total"`
+	Created int                   `json:"created"`
+	Updated int                   `json:"updated"`
+	Failed  int                   `json:"failed"`
+	Errors  []catalogRowErrorJSON `json:"errors"`
+}
+
+type catalogRowErrorJSON struct {
+	Row      int    `json:"row"`
+	PartUuid string `json:"part_uuid,omitempty"`
+	Message  string `json:"message"`
+}
+
+// WriteCatalogImportReportJSON записывает отчет импорта каталога в формате JSON
+func WriteCatalogImportReportJSON(w io.Writer, report *model.CatalogImportReport) error {
+	out := catalogImportReportJSON{
+		DryRun:  report.DryRun,
+		Total:   report.Total,
+		Created: report.Created,
+		Updated: report.Updated,
+		Failed:  report.Failed(),
+		Errors:  make([]catalogRowErrorJSON, 0, len(report.Errors)),
+	}
+	for _, rowErr := range report.Errors {
+		out.Errors = append(out.Errors, catalogRowErrorJSON{
+			Row:      rowErr.Row,
+			PartUuid: rowErr.PartUuid,
+			Message:  rowErr.Message,
+		})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(out)
+}
@@ -0,0 +1,249 @@
+package converter
+
+import (
+	"encoding/json"
+
+	"github.com/google/uuid"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	catalogV1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/openapi/inventory/v1"
+)
+
+// CatalogPartFields - поля детали, которые отдает публичный каталог
+var CatalogPartFields = []string{
+	model.PartFieldUuid,
+	model.PartFieldName,
+	model.PartFieldDescription,
+	model.PartFieldPrice,
+	model.PartFieldStockQuantity,
+	model.PartFieldCategory,
+	model.PartFieldDimensions,
+	model.PartFieldManufacturer,
+	model.PartFieldTags,
+	model.PartFieldMetadata,
+	model.PartFieldAttachments,
+	model.PartFieldUpdatedAt,
+}
+
+var catalogSortFields = map[catalogV1.PartsSortField]model.PartsSortField{
+	catalogV1.PartsSortFieldCREATEDAT:     model.PARTS_SORT_FIELD_CREATED_AT,
+	catalogV1.PartsSortFieldNAME:          model.PARTS_SORT_FIELD_NAME,
+	catalogV1.PartsSortFieldPRICE:         model.PARTS_SORT_FIELD_PRICE,
+	catalogV1.PartsSortFieldSTOCKQUANTITY: model.PARTS_SORT_FIELD_STOCK_QUANTITY,
+}
+
+var catalogSearchLanguages = map[catalogV1.SearchLanguage]model.SearchLanguage{
+	catalogV1.SearchLanguageAUTO:    model.SEARCH_LANGUAGE_UNSPECIFIED,
+	catalogV1.SearchLanguageRUSSIAN: model.SEARCH_LANGUAGE_RUSSIAN,
+	catalogV1.SearchLanguageENGLISH: model.SEARCH_LANGUAGE_ENGLISH,
+}
+
+var catalogMetadataFieldTypes = map[model.MetadataFieldType]catalogV1.MetadataFieldType{
+	model.METADATA_FIELD_TYPE_STRING:  catalogV1.MetadataFieldTypeSTRING,
+	model.METADATA_FIELD_TYPE_NUMBER:  catalogV1.MetadataFieldTypeNUMBER,
+	model.METADATA_FIELD_TYPE_INTEGER: catalogV1.MetadataFieldTypeINTEGER,
+	model.METADATA_FIELD_TYPE_BOOL:    catalogV1.MetadataFieldTypeBOOL,
+}
+
+// PartsQueryFromCatalog конвертирует параметры ListParts каталога в domain PartsQuery
+func PartsQueryFromCatalog(params catalogV1.ListPartsParams) *model.PartsQuery {
+	return &model.PartsQuery{
+		Filter: catalogFilter(
+			params.Category, params.ManufacturerCountry, params.Tag,
+			params.MinPrice, params.MaxPrice, params.InStock,
+		),
+		PageSize:   params.PageSize.Or(0),
+		PageToken:  params.PageToken.Or(""),
+		SortBy:     catalogSortFields[params.SortBy.Or(catalogV1.PartsSortFieldCREATEDAT)],
+		Descending: params.Descending.Or(false),
+		Fields:     CatalogPartFields,
+	}
+}
+
+// PartsSearchFromCatalog конвертирует параметры SearchParts каталога в domain PartsSearch
+func PartsSearchFromCatalog(params catalogV1.SearchPartsParams) *model.PartsSearch {
+	return &model.PartsSearch{
+		Query:    params.Q,
+		Language: catalogSearchLanguages[params.Lang.Or(catalogV1.SearchLanguageAUTO)],
+		Filter: catalogFilter(
+			params.Category, params.ManufacturerCountry, params.Tag,
+			params.MinPrice, params.MaxPrice, params.InStock,
+		),
+		Limit: params.Limit.Or(0),
+	}
+}
+
+// catalogFilter собирает фильтр из общих параметров ListParts и SearchParts, nil — без фильтрации
+func catalogFilter(categories, countries, tags []string, minPrice, maxPrice catalogV1.OptFloat64, inStock catalogV1.OptBool) *model.PartsFilter {
+	filter := &model.PartsFilter{
+		ManufacturerCountries: countries,
+		Tags:                  tags,
+		InStockOnly:           inStock.Or(false),
+	}
+	for _, category := range categories {
+		filter.Categories = append(filter.Categories, model.Category(category))
+	}
+	if minPrice.IsSet() || maxPrice.IsSet() {
+		filter.Price = &model.FloatRange{}
+		if v, ok := minPrice.Get(); ok {
+			filter.Price.Min = &v
+		}
+		if v, ok := maxPrice.Get(); ok {
+			filter.Price.Max = &v
+		}
+	}
+
+	if len(filter.Categories) == 0 && len(filter.ManufacturerCountries) == 0 && len(filter.Tags) == 0 &&
+		filter.Price == nil && !filter.InStockOnly {
+		return nil
+	}
+	return filter
+}
+
+// PartsPageToCatalog конвертирует страницу деталей в ответ ListParts каталога
+func PartsPageToCatalog(page *model.PartsPage) (catalogV1.ListPartsResponse, error) {
+	response := catalogV1.ListPartsResponse{
+		Parts:     make([]catalogV1.Part, 0, len(page.Parts)),
+		TotalSize: page.TotalSize,
+	}
+	for _, part := range page.Parts {
+		catalogPart, err := PartToCatalog(part)
+		if err != nil {
+			return catalogV1.ListPartsResponse{}, err
+		}
+		response.Parts = append(response.Parts, catalogPart)
+	}
+	if page.NextPageToken != "" {
+		response.NextPageToken = catalogV1.NewOptString(page.NextPageToken)
+	}
+	return response, nil
+}
+
+// PartSearchHitsToCatalog конвертирует результаты поиска в ответ SearchParts каталога
+func PartSearchHitsToCatalog(hits []*model.PartSearchHit) (catalogV1.SearchPartsResponse, error) {
+	response := catalogV1.SearchPartsResponse{
+		Hits: make([]catalogV1.SearchHit, 0, len(hits)),
+	}
+	for _, hit := range hits {
+		catalogPart, err := PartToCatalog(hit.Part)
+		if err != nil {
+			return catalogV1.SearchPartsResponse{}, err
+		}
+		response.Hits = append(response.Hits, catalogV1.SearchHit{
+			Part:  catalogPart,
+			Score: hit.Score,
+		})
+	}
+	return response, nil
+}
+
+// PartToCatalog конвертирует domain Part в деталь каталога. Остатки по складам и порог дозаказа
+// в каталог не попадают, наличие отдается признаком in_stock
+func PartToCatalog(part *model.Part) (catalogV1.Part, error) {
+	partUUID, err := uuid.Parse(part.Uuid)
+	if err != nil {
+		return catalogV1.Part{}, err
+	}
+
+	catalogPart := catalogV1.Part{
+		UUID:        partUUID,
+		Name:        part.Name,
+		Description: part.Description,
+		Price:       part.Price,
+		InStock:     part.StockQuantity > 0,
+		Category:    string(part.Category),
+		Tags:        part.Tags,
+	}
+	if part.Dimensions != nil {
+		catalogPart.Dimensions = catalogV1.NewOptDimensions(catalogV1.Dimensions{
+			Length: part.Dimensions.Length,
+			Width:  part.Dimensions.Width,
+			Height: part.Dimensions.Height,
+			Weight: part.Dimensions.Weight,
+		})
+	}
+	if part.Manufacturer != nil {
+		manufacturer := catalogV1.Manufacturer{
+			Name:    part.Manufacturer.Name,
+			Country: part.Manufacturer.Country,
+		}
+		if part.Manufacturer.Website != "" {
+			manufacturer.Website = catalogV1.NewOptString(part.Manufacturer.Website)
+		}
+		catalogPart.Manufacturer = catalogV1.NewOptManufacturer(manufacturer)
+	}
+	if len(part.Metadata) > 0 {
+		metadata := make(catalogV1.PartMetadata, len(part.Metadata))
+		for key, value := range part.Metadata {
+			raw, err := json.Marshal(value)
+			if err != nil {
+				return catalogV1.Part{}, err
+			}
+			metadata[key] = raw
+		}
+		catalogPart.Metadata = catalogV1.NewOptPartMetadata(metadata)
+	}
+	if image := part.PrimaryImage(); image != nil {
+		imageUUID, err := uuid.Parse(image.Uuid)
+		if err != nil {
+			return catalogV1.Part{}, err
+		}
+		catalogPart.PrimaryImage = catalogV1.NewOptImage(catalogV1.Image{
+			UUID:        imageUUID,
+			FileName:    image.FileName,
+			ContentType: image.ContentType,
+		})
+	}
+	if part.UpdatedAt != nil {
+		catalogPart.UpdatedAt = catalogV1.NewOptDateTime(*part.UpdatedAt)
+	}
+	return catalogPart, nil
+}
+
+// PartCategoriesToCatalog конвертирует справочник категорий в ответ ListCategories каталога
+func PartCategoriesToCatalog(categories []*model.PartCategory) catalogV1.ListCategoriesResponse {
+	response := catalogV1.ListCategoriesResponse{
+		Categories: make([]catalogV1.Category, 0, len(categories)),
+	}
+	for _, category := range categories {
+		catalogCategory := catalogV1.Category{
+			Code:           string(category.Code),
+			DisplayName:    category.DisplayName,
+			MetadataSchema: make([]catalogV1.MetadataField, 0, len(category.MetadataSchema)),
+		}
+		if category.ParentCode != model.CATEGORY_UNSPECIFIED {
+			catalogCategory.ParentCode = catalogV1.NewOptString(string(category.ParentCode))
+		}
+		if category.Description != "" {
+			catalogCategory.Description = catalogV1.NewOptString(category.Description)
+		}
+		for _, field := range category.MetadataSchema {
+			catalogCategory.MetadataSchema = append(catalogCategory.MetadataSchema, metadataFieldToCatalog(field))
+		}
+		response.Categories = append(response.Categories, catalogCategory)
+	}
+	return response
+}
+
+func metadataFieldToCatalog(field *model.MetadataField) catalogV1.MetadataField {
+	catalogField := catalogV1.MetadataField{
+		Key:      field.Key,
+		Type:     catalogMetadataFieldTypes[field.Type],
+		Required: field.Required,
+	}
+	if field.DisplayName != "" {
+		catalogField.DisplayName = catalogV1.NewOptString(field.DisplayName)
+	}
+	if field.Unit != "" {
+		catalogField.Unit = catalogV1.NewOptString(field.Unit)
+	}
+	if field.Range != nil {
+		if field.Range.Min != nil {
+			catalogField.Min = catalogV1.NewOptFloat64(*field.Range.Min)
+		}
+		if field.Range.Max != nil {
+			catalogField.Max = catalogV1.NewOptFloat64(*field.Range.Max)
+		}
+	}
+	return catalogField
+}
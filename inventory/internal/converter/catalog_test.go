@@ -0,0 +1,212 @@
+package converter
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func newCatalogPart() *model.Part {
+	return &model.Part{
+		Uuid:          "3f2b8c1e-5a4d-4e6f-9b7a-1c2d3e4f5a6b",
+		Name:          "Main Engine",
+		Description:   "Liquid-fuel main engine",
+		Price:         1500000.5,
+		StockQuantity: 4,
+		Category:      model.CATEGORY_ENGINE,
+		Dimensions: &model.Dimensions{
+			Length: 300.0,
+			Width:  120.0,
+			Height: 120.0,
+			Weight: 850.0,
+		},
+		Manufacturer: &model.Manufacturer{
+			Name:    "Rocket Engines Inc",
+			Country: "USA",
+			Website: "https://engines.example.com",
+		},
+		Tags: []string{"engine", "premium"},
+		Metadata: map[string]interface{}{
+			"thrust":  int64(1000),
+			"isp":     311.5,
+			"tested":  true,
+			"variant": "vacuum",
+		},
+		ReorderThreshold: 2,
+	}
+}
+
+func readCatalog(t *testing.T, reader model.CatalogReader) []*model.CatalogRow {
+	t.Helper()
+
+	var rows []*model.CatalogRow
+	for {
+		row, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return rows
+		}
+		require.NoError(t, err)
+		rows = append(rows, row)
+	}
+}
+
+func TestCatalogRoundTrip(t *testing.T) {
+	formats := map[string]model.CatalogFormat{
+		"csv":    model.CATALOG_FORMAT_CSV,
+		"json":   model.CATALOG_FORMAT_JSON,
+		"ndjson": model.CATALOG_FORMAT_NDJSON,
+	}
+
+	for name, format := range formats {
+		t.Run(name, func(t *testing.T) {
+			part := newCatalogPart()
+
+			var buf bytes.Buffer
+			writer, err := NewCatalogWriter(&buf, format)
+			require.NoError(t, err)
+			require.NoError(t, writer.Write(part))
+			require.NoError(t, writer.Close())
+
+			reader, err := NewCatalogReader(&buf, format)
+			require.NoError(t, err)
+
+			rows := readCatalog(t, reader)
+			require.Len(t, rows, 1)
+			assert.Equal(t, 1, rows[0].Row)
+			assert.Equal(t, part.Uuid, rows[0].PartUuid)
+			require.NoError(t, rows[0].Err)
+			assert.Equal(t, part, rows[0].Part)
+		})
+	}
+}
+
+func TestCatalogJSONArrayAndStream(t *testing.T) {
+	inputs := map[string]string{
+		"array":  `[{"uuid":"a","name":"A","price":1,"category":"fuel"}, {"uuid":"b","name":"B","price":2,"category":"WING"}]`,
+		"stream": "{\"uuid\":\"a\",\"name\":\"A\",\"price\":1,\"category\":\"fuel\"}\n{\"uuid\":\"b\",\"name\":\"B\",\"price\":2,\"category\":\"WING\"}\n",
+	}
+
+	for name, input := range inputs {
+		t.Run(name, func(t *testing.T) {
+			reader, err := NewCatalogReader(strings.NewReader(input), model.CATALOG_FORMAT_JSON)
+			require.NoError(t, err)
+
+			rows := readCatalog(t, reader)
+			require.Len(t, rows, 2)
+			assert.Equal(t, model.CATEGORY_FUEL, rows[0].Part.Category)
+			assert.Equal(t, model.CATEGORY_WING, rows[1].Part.Category)
+			assert.Equal(t, 2, rows[1].Row)
+		})
+	}
+}
+
+func TestCatalogJSONRowErrors(t *testing.T) {
+	input := `[
+		{"uuid":"a","name":"A","price":"cheap"},
+		{"uuid":"b","name":"B","colour":"red"},
+		{"uuid":"c","name":"C","stock_quantity":"many"},
+		{"uuid":"d","name":"D","metadata":{"nested":{"x":1}}},
+		{"uuid":"e","name":"E","price":3}
+	]`
+
+	reader, err := NewCatalogReader(strings.NewReader(input), model.CATALOG_FORMAT_JSON)
+	require.NoError(t, err)
+
+	rows := readCatalog(t, reader)
+	require.Len(t, rows, 5)
+	for _, row := range rows[:4] {
+		assert.Error(t, row.Err, "row %d", row.Row)
+	}
+	assert.Equal(t, "a", rows[0].PartUuid)
+	assert.Equal(t, "b", rows[1].PartUuid)
+	assert.NoError(t, rows[4].Err)
+}
+
+func TestCatalogJSONSyntaxError(t *testing.T) {
+	reader, err := NewCatalogReader(strings.NewReader(`[{"uuid":"a"}, {"uuid":`), model.CATALOG_FORMAT_JSON)
+	require.NoError(t, err)
+
+	row, err := reader.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "a", row.PartUuid)
+
+	_, err = reader.Next()
+	assert.ErrorIs(t, err, model.ErrInvalidCatalog)
+}
+
+func TestCatalogCSVRowErrors(t *testing.T) {
+	input := "uuid,name,price,stock_quantity,category\n" +
+		"a,A,1.5,2,engine\n" +
+		"b,B,free,2,engine\n" +
+		"c,C,1\n" +
+		"d,D,2,1.5,engine\n"
+
+	reader, err := NewCatalogReader(strings.NewReader(input), model.CATALOG_FORMAT_CSV)
+	require.NoError(t, err)
+
+	rows := readCatalog(t, reader)
+	require.Len(t, rows, 4)
+	require.NoError(t, rows[0].Err)
+	assert.Equal(t, 1.5, rows[0].Part.Price)
+	assert.Nil(t, rows[0].Part.Dimensions)
+	assert.EqualError(t, rows[1].Err, "price must be a number")
+	assert.Error(t, rows[2].Err)
+	assert.EqualError(t, rows[3].Err, "stock_quantity must be an integer")
+}
+
+func TestCatalogCSVInvalidHeader(t *testing.T) {
+	headers := map[string]string{
+		"empty":          "",
+		"unknown column": "uuid,name,colour\n",
+		"no uuid":        "name,price\n",
+	}
+
+	for name, header := range headers {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewCatalogReader(strings.NewReader(header), model.CATALOG_FORMAT_CSV)
+			assert.ErrorIs(t, err, model.ErrInvalidCatalog)
+		})
+	}
+}
+
+func TestCatalogFormatFromString(t *testing.T) {
+	tests := map[string]model.CatalogFormat{
+		"csv":   model.CATALOG_FORMAT_CSV,
+		"JSON":  model.CATALOG_FORMAT_JSON,
+		"jsonl": model.CATALOG_FORMAT_NDJSON,
+	}
+	for input, expected := range tests {
+		format, err := CatalogFormatFromString(input)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, format)
+	}
+
+	_, err := CatalogFormatFromString("xml")
+	assert.ErrorIs(t, err, model.ErrInvalidCatalog)
+}
+
+func TestCatalogImportReportToProto(t *testing.T) {
+	report := &model.CatalogImportReport{
+		DryRun:  true,
+		Total:   3,
+		Created: 1,
+		Updated: 1,
+		Errors:  []model.CatalogRowError{{Row: 2, PartUuid: "b", Message: "invalid part"}},
+	}
+
+	protoReport := CatalogImportReportToProto(report)
+
+	assert.True(t, protoReport.DryRun)
+	assert.Equal(t, int32(3), protoReport.Total)
+	assert.Equal(t, int32(1), protoReport.Failed)
+	require.Len(t, protoReport.Errors, 1)
+	assert.Equal(t, int32(2), protoReport.Errors[0].Row)
+	assert.Equal(t, "b", protoReport.Errors[0].PartUuid)
+}
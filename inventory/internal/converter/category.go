@@ -0,0 +1,84 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+// PartCategoryToProto конвертирует domain PartCategory в protobuf PartCategory
+func PartCategoryToProto(category *model.PartCategory) *inventoryv1.PartCategory {
+	if category == nil {
+		return nil
+	}
+
+	protoCategory := &inventoryv1.PartCategory{
+		Code:           string(category.Code),
+		ParentCode:     string(category.ParentCode),
+		DisplayName:    category.DisplayName,
+		Description:    category.Description,
+		MetadataSchema: MetadataFieldsToProto(category.MetadataSchema),
+		CreatedAt:      timestamppb.New(category.CreatedAt),
+	}
+	if category.UpdatedAt != nil {
+		protoCategory.UpdatedAt = timestamppb.New(*category.UpdatedAt)
+	}
+	return protoCategory
+}
+
+// PartCategoryFromProto конвертирует protobuf PartCategory в domain PartCategory
+func PartCategoryFromProto(protoCategory *inventoryv1.PartCategory) *model.PartCategory {
+	if protoCategory == nil {
+		return nil
+	}
+
+	return &model.PartCategory{
+		Code:           model.Category(protoCategory.GetCode()),
+		ParentCode:     model.Category(protoCategory.GetParentCode()),
+		DisplayName:    protoCategory.GetDisplayName(),
+		Description:    protoCategory.GetDescription(),
+		MetadataSchema: MetadataFieldsFromProto(protoCategory.GetMetadataSchema()),
+	}
+}
+
+// PartCategoriesToProto конвертирует список категорий в protobuf
+func PartCategoriesToProto(categories []*model.PartCategory) []*inventoryv1.PartCategory {
+	protoCategories := make([]*inventoryv1.PartCategory, 0, len(categories))
+	for _, category := range categories {
+		protoCategories = append(protoCategories, PartCategoryToProto(category))
+	}
+	return protoCategories
+}
+
+// MetadataFieldsToProto конвертирует схему метаданных в protobuf
+func MetadataFieldsToProto(fields []*model.MetadataField) []*inventoryv1.MetadataField {
+	protoFields := make([]*inventoryv1.MetadataField, 0, len(fields))
+	for _, field := range fields {
+		protoFields = append(protoFields, &inventoryv1.MetadataField{
+			Key:         field.Key,
+			DisplayName: field.DisplayName,
+			Type:        inventoryv1.MetadataFieldType(field.Type),
+			Unit:        field.Unit,
+			Required:    field.Required,
+			Range:       FloatRangeToProto(field.Range),
+		})
+	}
+	return protoFields
+}
+
+// MetadataFieldsFromProto конвертирует protobuf схему метаданных в domain
+func MetadataFieldsFromProto(protoFields []*inventoryv1.MetadataField) []*model.MetadataField {
+	fields := make([]*model.MetadataField, 0, len(protoFields))
+	for _, protoField := range protoFields {
+		fields = append(fields, &model.MetadataField{
+			Key:         protoField.GetKey(),
+			DisplayName: protoField.GetDisplayName(),
+			Type:        model.MetadataFieldType(protoField.GetType()),
+			Unit:        protoField.GetUnit(),
+			Required:    protoField.GetRequired(),
+			Range:       FloatRangeFromProto(protoField.GetRange()),
+		})
+	}
+	return fields
+}
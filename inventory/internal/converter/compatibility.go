@@ -0,0 +1,82 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+// RuleTargetToProto конвертирует domain RuleTarget в protobuf RuleTarget
+func RuleTargetToProto(target model.RuleTarget) *inventoryv1.RuleTarget {
+	if target.IsEmpty() {
+		return nil
+	}
+
+	return &inventoryv1.RuleTarget{
+		PartUuid:     target.PartUuid,
+		Category:     CategoryToProto(target.Category),
+		CategoryCode: string(target.Category),
+	}
+}
+
+// RuleTargetFromProto конвертирует protobuf RuleTarget в domain RuleTarget
+func RuleTargetFromProto(target *inventoryv1.RuleTarget) model.RuleTarget {
+	return model.RuleTarget{
+		PartUuid: target.GetPartUuid(),
+		Category: CategoryCodeFromProto(target.GetCategoryCode(), target.GetCategory()),
+	}
+}
+
+// CompatibilityRuleToProto конвертирует domain CompatibilityRule в protobuf CompatibilityRule
+func CompatibilityRuleToProto(rule *model.CompatibilityRule) *inventoryv1.CompatibilityRule {
+	if rule == nil {
+		return nil
+	}
+
+	return &inventoryv1.CompatibilityRule{
+		Uuid:        rule.Uuid,
+		Type:        inventoryv1.CompatibilityRuleType(rule.Type),
+		Subject:     RuleTargetToProto(rule.Subject),
+		Object:      RuleTargetToProto(rule.Object),
+		Description: rule.Description,
+		CreatedAt:   timestamppb.New(rule.CreatedAt),
+	}
+}
+
+// CompatibilityRuleFromProto конвертирует protobuf CompatibilityRule в domain CompatibilityRule
+func CompatibilityRuleFromProto(rule *inventoryv1.CompatibilityRule) *model.CompatibilityRule {
+	return &model.CompatibilityRule{
+		Type:        model.CompatibilityRuleType(rule.GetType()),
+		Subject:     RuleTargetFromProto(rule.GetSubject()),
+		Object:      RuleTargetFromProto(rule.GetObject()),
+		Description: rule.GetDescription(),
+	}
+}
+
+// CompatibilityRulesToProto конвертирует список правил в protobuf
+func CompatibilityRulesToProto(rules []*model.CompatibilityRule) []*inventoryv1.CompatibilityRule {
+	protoRules := make([]*inventoryv1.CompatibilityRule, 0, len(rules))
+	for _, rule := range rules {
+		protoRules = append(protoRules, CompatibilityRuleToProto(rule))
+	}
+	return protoRules
+}
+
+// ConfigurationValidationToProto конвертирует результат проверки конфигурации в protobuf
+func ConfigurationValidationToProto(validation *model.ConfigurationValidation) *inventoryv1.ValidateConfigurationResponse {
+	violations := make([]*inventoryv1.ConfigurationViolation, 0, len(validation.Violations))
+	for _, violation := range validation.Violations {
+		violations = append(violations, &inventoryv1.ConfigurationViolation{
+			RuleUuid:  violation.RuleUuid,
+			Type:      inventoryv1.CompatibilityRuleType(violation.Type),
+			PartUuids: violation.PartUuids,
+			Message:   violation.Message,
+		})
+	}
+
+	return &inventoryv1.ValidateConfigurationResponse{
+		Valid:      validation.Valid(),
+		Violations: violations,
+	}
+}
@@ -0,0 +1,84 @@
+package converter
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	eventsv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/events/v1"
+)
+
+// PartStockLowToProto собирает событие PartStockLow по текущему состоянию детали
+func PartStockLowToProto(eventUUID string, part *model.Part, occurredAt time.Time) *eventsv1.PartStockLow {
+	return &eventsv1.PartStockLow{
+		EventUuid:        eventUUID,
+		PartUuid:         part.Uuid,
+		PartName:         part.Name,
+		StockQuantity:    part.StockQuantity,
+		ReorderThreshold: part.ReorderThreshold,
+		OccurredAt:       timestamppb.New(occurredAt),
+	}
+}
+
+// PartRestockedToProto собирает событие PartRestocked по текущему состоянию детали
+func PartRestockedToProto(eventUUID string, part *model.Part, occurredAt time.Time) *eventsv1.PartRestocked {
+	return &eventsv1.PartRestocked{
+		EventUuid:        eventUUID,
+		PartUuid:         part.Uuid,
+		PartName:         part.Name,
+		StockQuantity:    part.StockQuantity,
+		ReorderThreshold: part.ReorderThreshold,
+		OccurredAt:       timestamppb.New(occurredAt),
+	}
+}
+
+// PartChangeToProto собирает событие об изменении детали. Для изменений без типа возвращает nil
+func PartChangeToProto(eventUUID string, change *model.PartChange) *eventsv1.PartChangeEvent {
+	occurredAt := timestamppb.New(change.OccurredAt)
+
+	switch change.Type {
+	case model.PART_CHANGE_TYPE_CREATED:
+		return &eventsv1.PartChangeEvent{Event: &eventsv1.PartChangeEvent_Created{Created: &eventsv1.PartCreated{
+			EventUuid:  eventUUID,
+			Part:       PartSnapshotToProto(change.Part),
+			OccurredAt: occurredAt,
+		}}}
+	case model.PART_CHANGE_TYPE_UPDATED:
+		return &eventsv1.PartChangeEvent{Event: &eventsv1.PartChangeEvent_Updated{Updated: &eventsv1.PartUpdated{
+			EventUuid:     eventUUID,
+			Part:          PartSnapshotToProto(change.Part),
+			UpdatedFields: change.UpdatedFields,
+			OccurredAt:    occurredAt,
+		}}}
+	case model.PART_CHANGE_TYPE_DELETED:
+		return &eventsv1.PartChangeEvent{Event: &eventsv1.PartChangeEvent_Deleted{Deleted: &eventsv1.PartDeleted{
+			EventUuid:  eventUUID,
+			PartUuid:   change.PartUuid,
+			OccurredAt: occurredAt,
+		}}}
+	default:
+		return nil
+	}
+}
+
+// PartSnapshotToProto конвертирует деталь в снимок для событий каталога
+func PartSnapshotToProto(part *model.Part) *eventsv1.PartSnapshot {
+	if part == nil {
+		return nil
+	}
+
+	snapshot := &eventsv1.PartSnapshot{
+		Uuid:          part.Uuid,
+		Name:          part.Name,
+		Description:   part.Description,
+		Price:         part.Price,
+		StockQuantity: part.StockQuantity,
+		Category:      string(part.Category),
+		Tags:          part.Tags,
+	}
+	if part.UpdatedAt != nil {
+		snapshot.UpdatedAt = timestamppb.New(*part.UpdatedAt)
+	}
+	return snapshot
+}
@@ -0,0 +1,37 @@
+package converter
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func TestPartChangeToProto(t *testing.T) {
+	now := time.Now()
+	part := &model.Part{Uuid: "part-uuid", Name: "Engine", Category: model.CATEGORY_ENGINE, StockQuantity: 7}
+
+	updated := PartChangeToProto("event-uuid", &model.PartChange{
+		Type:          model.PART_CHANGE_TYPE_UPDATED,
+		PartUuid:      part.Uuid,
+		Part:          part,
+		UpdatedFields: []string{"stock_quantity"},
+		OccurredAt:    now,
+	})
+	assert.Equal(t, "event-uuid", updated.GetUpdated().GetEventUuid())
+	assert.Equal(t, "ENGINE", updated.GetUpdated().GetPart().GetCategory())
+	assert.Equal(t, int64(7), updated.GetUpdated().GetPart().GetStockQuantity())
+	assert.Equal(t, []string{"stock_quantity"}, updated.GetUpdated().GetUpdatedFields())
+
+	deleted := PartChangeToProto("event-uuid", &model.PartChange{
+		Type:       model.PART_CHANGE_TYPE_DELETED,
+		PartUuid:   part.Uuid,
+		OccurredAt: now,
+	})
+	assert.Equal(t, part.Uuid, deleted.GetDeleted().GetPartUuid())
+	assert.Nil(t, deleted.GetCreated())
+
+	assert.Nil(t, PartChangeToProto("event-uuid", &model.PartChange{}))
+}
@@ -1,6 +1,8 @@
 package converter
 
 import (
+	"strings"
+
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
@@ -20,10 +22,18 @@ func PartToProto(part *model.Part) *inventoryv1.Part {
 		Price:         part.Price,
 		StockQuantity: part.StockQuantity,
 		Category:      CategoryToProto(part.Category),
+		CategoryCode:  string(part.Category),
 		Dimensions:    DimensionsToProto(part.Dimensions),
 		Manufacturer:  ManufacturerToProto(part.Manufacturer),
 		Tags:          part.Tags,
 		Metadata:      MetadataToProto(part.Metadata),
+
+		ReorderThreshold: part.ReorderThreshold,
+		StockLow:         part.StockLow,
+		Attachments:      AttachmentsToProto(part.Attachments),
+		PrimaryImage:     AttachmentToProto(part.PrimaryImage()),
+		StockLocations:   StockLocationsToProto(part.StockLocations),
+		Version:          part.Version,
 	}
 
 	// Конвертируем timestamps
@@ -63,11 +73,14 @@ func PartFromProto(protoPart *inventoryv1.Part) *model.Part {
 		Description:   protoPart.GetDescription(),
 		Price:         protoPart.GetPrice(),
 		StockQuantity: protoPart.GetStockQuantity(),
-		Category:      CategoryFromProto(protoPart.GetCategory()),
+		Category:      CategoryCodeFromProto(protoPart.GetCategoryCode(), protoPart.GetCategory()),
 		Dimensions:    DimensionsFromProto(protoPart.GetDimensions()),
 		Manufacturer:  ManufacturerFromProto(protoPart.GetManufacturer()),
 		Tags:          protoPart.GetTags(),
 		Metadata:      MetadataFromProto(protoPart.GetMetadata()),
+
+		ReorderThreshold: protoPart.GetReorderThreshold(),
+		Version:          protoPart.GetVersion(),
 	}
 
 	// Конвертируем timestamps
@@ -83,36 +96,29 @@ func PartFromProto(protoPart *inventoryv1.Part) *model.Part {
 	return part
 }
 
-// CategoryToProto конвертирует domain Category в protobuf Category
+// CategoryToProto конвертирует domain Category в protobuf Category.
+// Категории, которых нет в enum, становятся CATEGORY_UNSPECIFIED
 func CategoryToProto(category model.Category) inventoryv1.Category {
-	switch category {
-	case model.CATEGORY_ENGINE:
-		return inventoryv1.Category_CATEGORY_ENGINE
-	case model.CATEGORY_FUEL:
-		return inventoryv1.Category_CATEGORY_FUEL
-	case model.CATEGORY_PORTHOLE:
-		return inventoryv1.Category_CATEGORY_PORTHOLE
-	case model.CATEGORY_WING:
-		return inventoryv1.Category_CATEGORY_WING
-	default:
+	if category == model.CATEGORY_UNSPECIFIED {
 		return inventoryv1.Category_CATEGORY_UNSPECIFIED
 	}
+	return inventoryv1.Category(inventoryv1.Category_value["CATEGORY_"+string(category)])
 }
 
 // CategoryFromProto конвертирует protobuf Category в domain Category
 func CategoryFromProto(protoCategory inventoryv1.Category) model.Category {
-	switch protoCategory {
-	case inventoryv1.Category_CATEGORY_ENGINE:
-		return model.CATEGORY_ENGINE
-	case inventoryv1.Category_CATEGORY_FUEL:
-		return model.CATEGORY_FUEL
-	case inventoryv1.Category_CATEGORY_PORTHOLE:
-		return model.CATEGORY_PORTHOLE
-	case inventoryv1.Category_CATEGORY_WING:
-		return model.CATEGORY_WING
-	default:
+	if protoCategory == inventoryv1.Category_CATEGORY_UNSPECIFIED {
 		return model.CATEGORY_UNSPECIFIED
 	}
+	return model.Category(strings.TrimPrefix(protoCategory.String(), "CATEGORY_"))
+}
+
+// CategoryCodeFromProto выбирает код категории: code, если он задан, иначе значение enum
+func CategoryCodeFromProto(code string, protoCategory inventoryv1.Category) model.Category {
+	if code != "" {
+		return model.Category(code)
+	}
+	return CategoryFromProto(protoCategory)
 }
 
 // CategoriesToProto конвертирует слайс domain Categories в слайс protobuf Categories
@@ -297,9 +303,17 @@ func FilterToProto(filter *model.PartsFilter) *inventoryv1.PartsFilter {
 	return &inventoryv1.PartsFilter{
 		Uuids:                 filter.Uuids,
 		Names:                 filter.Names,
-		Categories:            CategoriesToProto(filter.Categories),
+		CategoryCodes:         CategoryCodesToProto(filter.Categories),
 		ManufacturerCountries: filter.ManufacturerCountries,
 		Tags:                  filter.Tags,
+		Price:                 FloatRangeToProto(filter.Price),
+		StockQuantity:         IntRangeToProto(filter.StockQuantity),
+		Length:                FloatRangeToProto(filter.Length),
+		Width:                 FloatRangeToProto(filter.Width),
+		Height:                FloatRangeToProto(filter.Height),
+		Weight:                FloatRangeToProto(filter.Weight),
+		InStockOnly:           filter.InStockOnly,
+		Metadata:              MetadataPredicatesToProto(filter.Metadata),
 	}
 }
 
@@ -312,8 +326,199 @@ func FilterFromProto(protoFilter *inventoryv1.PartsFilter) *model.PartsFilter {
 	return &model.PartsFilter{
 		Uuids:                 protoFilter.GetUuids(),
 		Names:                 protoFilter.GetNames(),
-		Categories:            CategoriesFromProto(protoFilter.GetCategories()),
+		Categories:            append(CategoriesFromProto(protoFilter.GetCategories()), CategoryCodesFromProto(protoFilter.GetCategoryCodes())...),
 		ManufacturerCountries: protoFilter.GetManufacturerCountries(),
 		Tags:                  protoFilter.GetTags(),
+		Price:                 FloatRangeFromProto(protoFilter.GetPrice()),
+		StockQuantity:         IntRangeFromProto(protoFilter.GetStockQuantity()),
+		Length:                FloatRangeFromProto(protoFilter.GetLength()),
+		Width:                 FloatRangeFromProto(protoFilter.GetWidth()),
+		Height:                FloatRangeFromProto(protoFilter.GetHeight()),
+		Weight:                FloatRangeFromProto(protoFilter.GetWeight()),
+		InStockOnly:           protoFilter.GetInStockOnly(),
+		Metadata:              MetadataPredicatesFromProto(protoFilter.GetMetadata()),
+	}
+}
+
+// CategoryCodesToProto конвертирует domain Categories в коды категорий protobuf
+func CategoryCodesToProto(categories []model.Category) []string {
+	if categories == nil {
+		return nil
+	}
+
+	codes := make([]string, 0, len(categories))
+	for _, category := range categories {
+		codes = append(codes, string(category))
+	}
+	return codes
+}
+
+// CategoryCodesFromProto конвертирует коды категорий protobuf в domain Categories
+func CategoryCodesFromProto(codes []string) []model.Category {
+	if codes == nil {
+		return nil
+	}
+
+	categories := make([]model.Category, 0, len(codes))
+	for _, code := range codes {
+		categories = append(categories, model.Category(code))
+	}
+	return categories
+}
+
+// FloatRangeToProto конвертирует domain FloatRange в protobuf DoubleRange
+func FloatRangeToProto(r *model.FloatRange) *inventoryv1.DoubleRange {
+	if r == nil {
+		return nil
+	}
+	return &inventoryv1.DoubleRange{Min: r.Min, Max: r.Max}
+}
+
+// FloatRangeFromProto конвертирует protobuf DoubleRange в domain FloatRange
+func FloatRangeFromProto(r *inventoryv1.DoubleRange) *model.FloatRange {
+	if r == nil {
+		return nil
+	}
+	return &model.FloatRange{Min: r.Min, Max: r.Max}
+}
+
+// IntRangeToProto конвертирует domain IntRange в protobuf Int64Range
+func IntRangeToProto(r *model.IntRange) *inventoryv1.Int64Range {
+	if r == nil {
+		return nil
+	}
+	return &inventoryv1.Int64Range{Min: r.Min, Max: r.Max}
+}
+
+// IntRangeFromProto конвертирует protobuf Int64Range в domain IntRange
+func IntRangeFromProto(r *inventoryv1.Int64Range) *model.IntRange {
+	if r == nil {
+		return nil
+	}
+	return &model.IntRange{Min: r.Min, Max: r.Max}
+}
+
+// MetadataPredicatesToProto конвертирует условия на метаданные в protobuf
+func MetadataPredicatesToProto(predicates []model.MetadataPredicate) []*inventoryv1.MetadataPredicate {
+	if len(predicates) == 0 {
+		return nil
+	}
+
+	protoPredicates := make([]*inventoryv1.MetadataPredicate, 0, len(predicates))
+	for _, p := range predicates {
+		protoPredicates = append(protoPredicates, &inventoryv1.MetadataPredicate{
+			Key:      p.Key,
+			Operator: inventoryv1.MetadataOperator(p.Operator),
+			Value:    ValueToProto(p.Value),
+		})
+	}
+	return protoPredicates
+}
+
+// MetadataPredicatesFromProto конвертирует protobuf условия на метаданные в domain
+func MetadataPredicatesFromProto(protoPredicates []*inventoryv1.MetadataPredicate) []model.MetadataPredicate {
+	if len(protoPredicates) == 0 {
+		return nil
+	}
+
+	predicates := make([]model.MetadataPredicate, 0, len(protoPredicates))
+	for _, p := range protoPredicates {
+		predicates = append(predicates, model.MetadataPredicate{
+			Key:      p.GetKey(),
+			Operator: model.MetadataOperator(p.GetOperator()),
+			Value:    ValueFromProto(p.GetValue()),
+		})
+	}
+	return predicates
+}
+
+// PartsQueryFromProto конвертирует protobuf ListPartsRequest в domain PartsQuery
+func PartsQueryFromProto(req *inventoryv1.ListPartsRequest) *model.PartsQuery {
+	return &model.PartsQuery{
+		Filter:     FilterFromProto(req.GetFilter()),
+		PageSize:   req.GetPageSize(),
+		PageToken:  req.GetPageToken(),
+		SortBy:     model.PartsSortField(req.GetSortBy()),
+		Descending: req.GetDescending(),
+		Fields:     ReadMaskFromProto(req.GetReadMask().GetPaths()),
+	}
+}
+
+// ReadMaskFromProto переводит пути маски чтения protobuf Part в поля domain Part:
+// category_code хранится в category, primary_image выбирается из attachments.
+// Неизвестные пути передаются как есть, их отклоняет сервис
+func ReadMaskFromProto(paths []string) []string {
+	if len(paths) == 0 {
+		return nil
+	}
+
+	fields := make([]string, 0, len(paths))
+	seen := make(map[string]struct{}, len(paths))
+	for _, path := range paths {
+		field := path
+		switch path {
+		case "category_code":
+			field = model.PartFieldCategory
+		case "primary_image":
+			field = model.PartFieldAttachments
+		}
+		if _, ok := seen[field]; ok {
+			continue
+		}
+		seen[field] = struct{}{}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// PartsBatchToProto конвертирует domain PartsBatch в protobuf BatchGetPartsResponse
+func PartsBatchToProto(batch *model.PartsBatch) *inventoryv1.BatchGetPartsResponse {
+	return &inventoryv1.BatchGetPartsResponse{
+		Parts:        PartsToProto(batch.Parts),
+		MissingUuids: batch.MissingUuids,
+	}
+}
+
+// PartsPageToProto конвертирует domain PartsPage в protobuf ListPartsResponse
+func PartsPageToProto(page *model.PartsPage) *inventoryv1.ListPartsResponse {
+	return &inventoryv1.ListPartsResponse{
+		Parts:         PartsToProto(page.Parts),
+		NextPageToken: page.NextPageToken,
+		TotalSize:     page.TotalSize,
+	}
+}
+
+// PartsSearchFromProto конвертирует protobuf SearchPartsRequest в domain PartsSearch
+func PartsSearchFromProto(req *inventoryv1.SearchPartsRequest) *model.PartsSearch {
+	return &model.PartsSearch{
+		Query:    req.GetQuery(),
+		Language: model.SearchLanguage(req.GetLanguage()),
+		Filter:   FilterFromProto(req.GetFilter()),
+		Limit:    req.GetLimit(),
+	}
+}
+
+// PartSearchHitsToProto конвертирует результаты поиска в protobuf
+func PartSearchHitsToProto(hits []*model.PartSearchHit) []*inventoryv1.PartSearchHit {
+	protoHits := make([]*inventoryv1.PartSearchHit, 0, len(hits))
+	for _, hit := range hits {
+		protoHits = append(protoHits, &inventoryv1.PartSearchHit{
+			Part:  PartToProto(hit.Part),
+			Score: hit.Score,
+		})
+	}
+	return protoHits
+}
+
+// PartEventToProto конвертирует событие подписки на детали в protobuf
+func PartEventToProto(event *model.PartEvent) *inventoryv1.PartEvent {
+	protoEvent := &inventoryv1.PartEvent{
+		Type:     inventoryv1.PartEventType(event.Type),
+		PartUuid: event.PartUuid,
+		Part:     PartToProto(event.Part),
+	}
+	if !event.OccurredAt.IsZero() {
+		protoEvent.OccurredAt = timestamppb.New(event.OccurredAt)
 	}
+	return protoEvent
 }
@@ -59,6 +59,24 @@ func TestPartToProto(t *testing.T) {
 	assert.NotNil(t, protoPart.UpdatedAt)
 }
 
+func TestPartToProto_PrimaryImage(t *testing.T) {
+	now := time.Now()
+	drawing := &model.Attachment{Uuid: "drawing", Kind: model.ATTACHMENT_KIND_DRAWING, CreatedAt: now}
+	first := &model.Attachment{Uuid: "first", Kind: model.ATTACHMENT_KIND_IMAGE, CreatedAt: now}
+	primary := &model.Attachment{Uuid: "primary", Kind: model.ATTACHMENT_KIND_IMAGE, Primary: true, CreatedAt: now}
+
+	protoPart := PartToProto(&model.Part{Attachments: []*model.Attachment{drawing, first}})
+	assert.Len(t, protoPart.Attachments, 2)
+	assert.Equal(t, "first", protoPart.PrimaryImage.GetUuid())
+
+	protoPart = PartToProto(&model.Part{Attachments: []*model.Attachment{drawing, first, primary}})
+	assert.Equal(t, "primary", protoPart.PrimaryImage.GetUuid())
+	assert.True(t, protoPart.PrimaryImage.GetPrimary())
+
+	protoPart = PartToProto(&model.Part{Attachments: []*model.Attachment{drawing}})
+	assert.Nil(t, protoPart.PrimaryImage)
+}
+
 func TestPartToProto_Nil(t *testing.T) {
 	protoPart := PartToProto(nil)
 	assert.Nil(t, protoPart)
@@ -175,6 +193,11 @@ func TestCategoryToProto(t *testing.T) {
 			category: model.CATEGORY_UNSPECIFIED,
 			expected: inventoryv1.Category_CATEGORY_UNSPECIFIED,
 		},
+		{
+			name:     "category without enum value",
+			category: model.Category("AVIONICS"),
+			expected: inventoryv1.Category_CATEGORY_UNSPECIFIED,
+		},
 	}
 
 	for _, tt := range tests {
@@ -226,6 +249,19 @@ func TestCategoryFromProto(t *testing.T) {
 	}
 }
 
+func TestPartCategoryCode(t *testing.T) {
+	protoPart := PartToProto(&model.Part{Uuid: "uuid-1", Category: "AVIONICS"})
+	assert.Equal(t, "AVIONICS", protoPart.GetCategoryCode())
+	assert.Equal(t, inventoryv1.Category_CATEGORY_UNSPECIFIED, protoPart.GetCategory())
+
+	// Старые клиенты передают только enum
+	part := PartFromProto(&inventoryv1.Part{Category: inventoryv1.Category_CATEGORY_WING})
+	assert.Equal(t, model.CATEGORY_WING, part.Category)
+
+	part = PartFromProto(&inventoryv1.Part{Category: inventoryv1.Category_CATEGORY_WING, CategoryCode: "LANDING_GEAR"})
+	assert.Equal(t, model.Category("LANDING_GEAR"), part.Category)
+}
+
 func TestFilterToProto(t *testing.T) {
 	domainFilter := &model.PartsFilter{
 		Uuids:                 []string{"uuid-1", "uuid-2"},
@@ -240,7 +276,7 @@ func TestFilterToProto(t *testing.T) {
 	assert.NotNil(t, protoFilter)
 	assert.Equal(t, domainFilter.Uuids, protoFilter.Uuids)
 	assert.Equal(t, domainFilter.Names, protoFilter.Names)
-	assert.Equal(t, len(domainFilter.Categories), len(protoFilter.Categories))
+	assert.Equal(t, []string{"ENGINE", "FUEL"}, protoFilter.CategoryCodes)
 	assert.Equal(t, domainFilter.ManufacturerCountries, protoFilter.ManufacturerCountries)
 	assert.Equal(t, domainFilter.Tags, protoFilter.Tags)
 }
@@ -265,10 +301,41 @@ func TestFilterFromProto(t *testing.T) {
 	assert.Equal(t, protoFilter.Uuids, domainFilter.Uuids)
 	assert.Equal(t, protoFilter.Names, domainFilter.Names)
 	assert.Equal(t, len(protoFilter.Categories), len(domainFilter.Categories))
+	assert.Equal(t, model.CATEGORY_ENGINE, domainFilter.Categories[0])
 	assert.Equal(t, protoFilter.ManufacturerCountries, domainFilter.ManufacturerCountries)
 	assert.Equal(t, protoFilter.Tags, domainFilter.Tags)
 }
 
+func TestFilterFromProto_RangesAndMetadata(t *testing.T) {
+	maxPrice := 10000000.0
+	maxWeight := 6000.0
+	minThrust := int64(3000000)
+
+	protoFilter := &inventoryv1.PartsFilter{
+		Price:       &inventoryv1.DoubleRange{Max: &maxPrice},
+		Weight:      &inventoryv1.DoubleRange{Max: &maxWeight},
+		InStockOnly: true,
+		Metadata: []*inventoryv1.MetadataPredicate{
+			{
+				Key:      "тяга",
+				Operator: inventoryv1.MetadataOperator_METADATA_OPERATOR_GT,
+				Value:    &inventoryv1.Value{Value: &inventoryv1.Value_Int64Value{Int64Value: minThrust}},
+			},
+		},
+	}
+
+	domainFilter := FilterFromProto(protoFilter)
+
+	assert.Equal(t, &model.FloatRange{Max: &maxPrice}, domainFilter.Price)
+	assert.Equal(t, &model.FloatRange{Max: &maxWeight}, domainFilter.Weight)
+	assert.Nil(t, domainFilter.Length)
+	assert.Nil(t, domainFilter.StockQuantity)
+	assert.True(t, domainFilter.InStockOnly)
+	assert.Equal(t, []model.MetadataPredicate{
+		{Key: "тяга", Operator: model.METADATA_OPERATOR_GT, Value: minThrust},
+	}, domainFilter.Metadata)
+}
+
 func TestFilterFromProto_Nil(t *testing.T) {
 	domainFilter := FilterFromProto(nil)
 	assert.Nil(t, domainFilter)
@@ -369,3 +436,12 @@ func TestValueFromProto_Nil(t *testing.T) {
 	result := ValueFromProto(nil)
 	assert.Nil(t, result)
 }
+
+func TestReadMaskFromProto(t *testing.T) {
+	fields := ReadMaskFromProto([]string{"uuid", "category_code", "category", "primary_image", "price"})
+	assert.Equal(t, []string{
+		model.PartFieldUuid, model.PartFieldCategory, model.PartFieldAttachments, model.PartFieldPrice,
+	}, fields)
+
+	assert.Nil(t, ReadMaskFromProto(nil))
+}
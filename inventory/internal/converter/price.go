@@ -0,0 +1,50 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+// PriceChangeToProto конвертирует domain PriceChange в protobuf PriceChange
+func PriceChangeToProto(change *model.PriceChange) *inventoryv1.PriceChange {
+	if change == nil {
+		return nil
+	}
+
+	return &inventoryv1.PriceChange{
+		Uuid:          change.Uuid,
+		PartUuid:      change.PartUuid,
+		Price:         change.Price,
+		EffectiveFrom: timestamppb.New(change.EffectiveFrom),
+		Reason:        change.Reason,
+		Applied:       change.Applied,
+		CreatedAt:     timestamppb.New(change.CreatedAt),
+	}
+}
+
+// PriceChangesToProto конвертирует историю цен в protobuf
+func PriceChangesToProto(changes []*model.PriceChange) []*inventoryv1.PriceChange {
+	protoChanges := make([]*inventoryv1.PriceChange, 0, len(changes))
+	for _, change := range changes {
+		protoChanges = append(protoChanges, PriceChangeToProto(change))
+	}
+	return protoChanges
+}
+
+// PartPricesToProto конвертирует цены деталей в protobuf
+func PartPricesToProto(prices []*model.PartPrice) []*inventoryv1.PartPrice {
+	protoPrices := make([]*inventoryv1.PartPrice, 0, len(prices))
+	for _, price := range prices {
+		protoPrice := &inventoryv1.PartPrice{
+			PartUuid: price.PartUuid,
+			Price:    price.Price,
+		}
+		if price.EffectiveFrom != nil {
+			protoPrice.EffectiveFrom = timestamppb.New(*price.EffectiveFrom)
+		}
+		protoPrices = append(protoPrices, protoPrice)
+	}
+	return protoPrices
+}
@@ -0,0 +1,83 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+// RocketModelToProto конвертирует domain RocketModel в protobuf RocketModel
+func RocketModelToProto(rocketModel *model.RocketModel) *inventoryv1.RocketModel {
+	if rocketModel == nil {
+		return nil
+	}
+
+	items := make([]*inventoryv1.BomItem, 0, len(rocketModel.Items))
+	for _, item := range rocketModel.Items {
+		items = append(items, &inventoryv1.BomItem{
+			PartUuid:             item.PartUuid,
+			Quantity:             item.Quantity,
+			AlternativePartUuids: item.Alternatives,
+		})
+	}
+
+	return &inventoryv1.RocketModel{
+		Uuid:        rocketModel.Uuid,
+		Name:        rocketModel.Name,
+		Description: rocketModel.Description,
+		Items:       items,
+		CreatedAt:   timestamppb.New(rocketModel.CreatedAt),
+	}
+}
+
+// RocketModelFromProto конвертирует protobuf RocketModel в domain RocketModel
+func RocketModelFromProto(rocketModel *inventoryv1.RocketModel) *model.RocketModel {
+	items := make([]model.BomItem, 0, len(rocketModel.GetItems()))
+	for _, item := range rocketModel.GetItems() {
+		items = append(items, model.BomItem{
+			PartUuid:     item.GetPartUuid(),
+			Quantity:     item.GetQuantity(),
+			Alternatives: item.GetAlternativePartUuids(),
+		})
+	}
+
+	return &model.RocketModel{
+		Name:        rocketModel.GetName(),
+		Description: rocketModel.GetDescription(),
+		Items:       items,
+	}
+}
+
+// RocketModelSummariesToProto конвертирует модели с ценой и доступностью в protobuf
+func RocketModelSummariesToProto(summaries []*model.RocketModelSummary) []*inventoryv1.RocketModelSummary {
+	protoSummaries := make([]*inventoryv1.RocketModelSummary, 0, len(summaries))
+	for _, summary := range summaries {
+		protoSummaries = append(protoSummaries, &inventoryv1.RocketModelSummary{
+			Model:     RocketModelToProto(summary.Model),
+			Price:     summary.Price,
+			Available: summary.Available,
+		})
+	}
+	return protoSummaries
+}
+
+// RocketModelExpansionToProto конвертирует раскладку модели на детали в protobuf
+func RocketModelExpansionToProto(expansion *model.RocketModelExpansion) *inventoryv1.ExpandRocketModelResponse {
+	lines := make([]*inventoryv1.BomLine, 0, len(expansion.Lines))
+	for _, line := range expansion.Lines {
+		lines = append(lines, &inventoryv1.BomLine{
+			Part:        PartToProto(line.Part),
+			Quantity:    line.Quantity,
+			Available:   line.Available,
+			Alternative: line.Alternative,
+		})
+	}
+
+	return &inventoryv1.ExpandRocketModelResponse{
+		Model:      RocketModelToProto(expansion.Model),
+		Lines:      lines,
+		TotalPrice: expansion.TotalPrice(),
+		Available:  expansion.Available(),
+	}
+}
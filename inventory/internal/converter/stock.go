@@ -0,0 +1,36 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+// StockMovementToProto конвертирует domain StockMovement в protobuf StockMovement
+func StockMovementToProto(movement *model.StockMovement) *inventoryv1.StockMovement {
+	if movement == nil {
+		return nil
+	}
+
+	return &inventoryv1.StockMovement{
+		Uuid:          movement.Uuid,
+		PartUuid:      movement.PartUuid,
+		Type:          inventoryv1.StockMovementType(movement.Type),
+		Quantity:      movement.Quantity,
+		Reason:        movement.Reason,
+		Reference:     movement.Reference,
+		StockAfter:    movement.StockAfter,
+		CreatedAt:     timestamppb.New(movement.CreatedAt),
+		WarehouseUuid: movement.WarehouseUuid,
+	}
+}
+
+// StockMovementsToProto конвертирует список движений в protobuf
+func StockMovementsToProto(movements []*model.StockMovement) []*inventoryv1.StockMovement {
+	protoMovements := make([]*inventoryv1.StockMovement, 0, len(movements))
+	for _, movement := range movements {
+		protoMovements = append(protoMovements, StockMovementToProto(movement))
+	}
+	return protoMovements
+}
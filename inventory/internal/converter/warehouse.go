@@ -0,0 +1,91 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+// WarehouseToProto конвертирует domain Warehouse в protobuf Warehouse
+func WarehouseToProto(warehouse *model.Warehouse) *inventoryv1.Warehouse {
+	if warehouse == nil {
+		return nil
+	}
+
+	return &inventoryv1.Warehouse{
+		Uuid:      warehouse.Uuid,
+		Code:      warehouse.Code,
+		Name:      warehouse.Name,
+		Latitude:  warehouse.Location.Latitude,
+		Longitude: warehouse.Location.Longitude,
+		CreatedAt: timestamppb.New(warehouse.CreatedAt),
+	}
+}
+
+// WarehousesToProto конвертирует список складов в protobuf
+func WarehousesToProto(warehouses []*model.Warehouse) []*inventoryv1.Warehouse {
+	protoWarehouses := make([]*inventoryv1.Warehouse, 0, len(warehouses))
+	for _, warehouse := range warehouses {
+		protoWarehouses = append(protoWarehouses, WarehouseToProto(warehouse))
+	}
+	return protoWarehouses
+}
+
+// WarehouseFromProto конвертирует protobuf Warehouse в domain модель.
+// UUID и дата создания назначаются сервисом
+func WarehouseFromProto(protoWarehouse *inventoryv1.Warehouse) *model.Warehouse {
+	if protoWarehouse == nil {
+		return nil
+	}
+
+	return &model.Warehouse{
+		Code: protoWarehouse.GetCode(),
+		Name: protoWarehouse.GetName(),
+		Location: model.GeoPoint{
+			Latitude:  protoWarehouse.GetLatitude(),
+			Longitude: protoWarehouse.GetLongitude(),
+		},
+	}
+}
+
+// StockLocationsToProto конвертирует остатки по складам в protobuf
+func StockLocationsToProto(locations []*model.StockLocation) []*inventoryv1.StockLocation {
+	if locations == nil {
+		return nil
+	}
+
+	protoLocations := make([]*inventoryv1.StockLocation, 0, len(locations))
+	for _, location := range locations {
+		protoLocations = append(protoLocations, &inventoryv1.StockLocation{
+			WarehouseUuid: location.WarehouseUuid,
+			Quantity:      location.Quantity,
+		})
+	}
+	return protoLocations
+}
+
+// PartAvailabilitiesToProto конвертирует доступность деталей в protobuf
+func PartAvailabilitiesToProto(availability []*model.PartAvailability) []*inventoryv1.PartAvailability {
+	protoAvailability := make([]*inventoryv1.PartAvailability, 0, len(availability))
+	for _, item := range availability {
+		protoAvailability = append(protoAvailability, &inventoryv1.PartAvailability{
+			PartUuid:      item.PartUuid,
+			StockQuantity: item.StockQuantity,
+			Locations:     StockLocationsToProto(item.Locations),
+		})
+	}
+	return protoAvailability
+}
+
+// ReservationItemsFromProto конвертирует позиции резерва из protobuf
+func ReservationItemsFromProto(protoItems []*inventoryv1.ReservationItem) []*model.ReservationItem {
+	items := make([]*model.ReservationItem, 0, len(protoItems))
+	for _, protoItem := range protoItems {
+		items = append(items, &model.ReservationItem{
+			PartUuid: protoItem.GetPartUuid(),
+			Quantity: protoItem.GetQuantity(),
+		})
+	}
+	return items
+}
@@ -0,0 +1,63 @@
+package model
+
+import (
+	"io"
+	"time"
+)
+
+type AttachmentKind int32
+
+const (
+	ATTACHMENT_KIND_UNSPECIFIED AttachmentKind = 0
+	// Фотография детали
+	ATTACHMENT_KIND_IMAGE AttachmentKind = 1
+	// Чертеж
+	ATTACHMENT_KIND_DRAWING AttachmentKind = 2
+	// Техническая документация
+	ATTACHMENT_KIND_DATASHEET AttachmentKind = 3
+)
+
+// Attachment - ссылка на файл детали. Содержимое хранится отдельно в GridFS под тем же UUID
+type Attachment struct {
+	Uuid        string
+	PartUuid    string
+	Kind        AttachmentKind
+	FileName    string
+	ContentType string
+	// Размер в байтах
+	Size int64
+	// SHA-256 содержимого в hex
+	Checksum string
+	// Основное изображение детали, может быть только у одного вложения
+	Primary   bool
+	CreatedAt time.Time
+}
+
+// AttachmentUpload - загрузка нового вложения
+type AttachmentUpload struct {
+	PartUuid    string
+	Kind        AttachmentKind
+	FileName    string
+	ContentType string
+	Primary     bool
+	// Ожидаемый SHA-256 содержимого в hex. Пусто — не проверяется
+	Checksum string
+	Content  io.Reader
+}
+
+// PrimaryImage возвращает изображение, отмеченное основным, а без него — первое загруженное
+func (p *Part) PrimaryImage() *Attachment {
+	var first *Attachment
+	for _, attachment := range p.Attachments {
+		if attachment.Kind != ATTACHMENT_KIND_IMAGE {
+			continue
+		}
+		if attachment.Primary {
+			return attachment
+		}
+		if first == nil {
+			first = attachment
+		}
+	}
+	return first
+}
@@ -0,0 +1,62 @@
+package model
+
+type CatalogFormat int32
+
+const (
+	CATALOG_FORMAT_UNSPECIFIED CatalogFormat = 0
+	// CSV с заголовком, колонки сопоставляются по имени
+	CATALOG_FORMAT_CSV CatalogFormat = 1
+	// JSON-массив деталей. При чтении принимается и NDJSON
+	CATALOG_FORMAT_JSON CatalogFormat = 2
+	// Одна деталь в формате JSON на строку
+	CATALOG_FORMAT_NDJSON CatalogFormat = 3
+)
+
+// CatalogRow - запись файла каталога. Err заполняется, если запись не удалось разобрать в деталь
+type CatalogRow struct {
+	// Номер записи, начиная с 1 (без заголовка CSV)
+	Row      int
+	PartUuid string
+	Part     *Part
+	Err      error
+}
+
+// CatalogReader - построчный источник деталей для импорта.
+// Next возвращает io.EOF после последней записи, другие ошибки прерывают импорт
+type CatalogReader interface {
+	Next() (*CatalogRow, error)
+}
+
+// CatalogImport - параметры импорта каталога
+type CatalogImport struct {
+	Reader CatalogReader
+	// Только проверить записи без изменения каталога
+	DryRun bool
+}
+
+// CatalogRowError - ошибка записи импорта
+type CatalogRowError struct {
+	Row      int
+	PartUuid string
+	Message  string
+}
+
+// CatalogImportReport - итог импорта каталога
+type CatalogImportReport struct {
+	DryRun  bool
+	Total   int
+	Created int
+	Updated int
+	Errors  []CatalogRowError
+}
+
+// Failed возвращает количество записей с ошибками
+func (r *CatalogImportReport) Failed() int {
+	return len(r.Errors)
+}
+
+// CatalogExport - параметры выгрузки каталога. Write вызывается для каждой детали по порядку
+type CatalogExport struct {
+	Filter *PartsFilter
+	Write  func(part *Part) error
+}
@@ -0,0 +1,99 @@
+package model
+
+import (
+	"time"
+)
+
+// PartCategory - категория деталей из справочника. Категории образуют дерево через ParentCode
+type PartCategory struct {
+	Code Category
+	// Родительская категория, пусто у корневой
+	ParentCode  Category
+	DisplayName string
+	Description string
+	// Поля метаданных, которыми описываются детали категории
+	MetadataSchema []*MetadataField
+	CreatedAt      time.Time
+	UpdatedAt      *time.Time
+}
+
+// CategoryParents - родительская категория по коду категории из справочника
+type CategoryParents map[Category]Category
+
+// NewCategoryParents строит индекс родителей по списку категорий
+func NewCategoryParents(categories []*PartCategory) CategoryParents {
+	parents := make(CategoryParents, len(categories))
+	for _, category := range categories {
+		parents[category.Code] = category.ParentCode
+	}
+	return parents
+}
+
+// IsWithin сообщает, что категория code совпадает с ancestor или вложена в нее на любую глубину
+func (p CategoryParents) IsWithin(code, ancestor Category) bool {
+	// Ограничение глубины защищает от цикла, если он все же оказался в базе
+	for depth := 0; code != CATEGORY_UNSPECIFIED && depth <= len(p); depth++ {
+		if code == ancestor {
+			return true
+		}
+		code = p[code]
+	}
+	return false
+}
+
+// IsLegacy сообщает, есть ли у категории значение в proto enum Category
+func (c Category) IsLegacy() bool {
+	switch c {
+	case CATEGORY_ENGINE, CATEGORY_FUEL, CATEGORY_PORTHOLE, CATEGORY_WING:
+		return true
+	default:
+		return false
+	}
+}
+
+type MetadataFieldType int32
+
+const (
+	METADATA_FIELD_TYPE_UNSPECIFIED MetadataFieldType = 0
+	// Строка
+	METADATA_FIELD_TYPE_STRING MetadataFieldType = 1
+	// Число
+	METADATA_FIELD_TYPE_NUMBER MetadataFieldType = 2
+	// Целое число
+	METADATA_FIELD_TYPE_INTEGER MetadataFieldType = 3
+	// Логическое значение
+	METADATA_FIELD_TYPE_BOOL MetadataFieldType = 4
+)
+
+// MetadataField - поле схемы метаданных категории
+type MetadataField struct {
+	Key         string
+	DisplayName string
+	Type        MetadataFieldType
+	// Единица измерения, например кН
+	Unit     string
+	Required bool
+	// Допустимый диапазон для NUMBER и INTEGER, nil — без ограничений
+	Range *FloatRange
+}
+
+// CategorySchema - категория и ее действующая схема метаданных с учетом родительских категорий
+type CategorySchema struct {
+	Category *PartCategory
+	Fields   []*MetadataField
+}
+
+// Поля категории, которые можно обновлять через UpdateCategory
+const (
+	CategoryFieldDisplayName    = "display_name"
+	CategoryFieldDescription    = "description"
+	CategoryFieldParentCode     = "parent_code"
+	CategoryFieldMetadataSchema = "metadata_schema"
+)
+
+type CategoryUpdate struct {
+	// Новые значения полей, категория ищется по Category.Code
+	Category *PartCategory
+	// Обновляемые поля. Пусто — обновляются все поля
+	Fields []string
+}
@@ -0,0 +1,65 @@
+package model
+
+import "time"
+
+type CompatibilityRuleType int32
+
+const (
+	COMPATIBILITY_RULE_TYPE_UNSPECIFIED CompatibilityRuleType = 0
+	// Субъект требует наличия объекта в конфигурации
+	COMPATIBILITY_RULE_TYPE_REQUIRES CompatibilityRuleType = 1
+	// Субъект не может быть в одной конфигурации с объектом
+	COMPATIBILITY_RULE_TYPE_EXCLUDES CompatibilityRuleType = 2
+	// Из категории объекта с субъектом допустимы только перечисленные объекты
+	COMPATIBILITY_RULE_TYPE_COMPATIBLE_WITH CompatibilityRuleType = 3
+)
+
+// RuleTarget - цель правила: конкретная деталь или вся категория.
+// Для цели-детали Category заполняется категорией этой детали
+type RuleTarget struct {
+	PartUuid string
+	Category Category
+}
+
+// IsEmpty сообщает, что цель не задана. Пустой субъект у REQUIRES означает любую конфигурацию
+func (t RuleTarget) IsEmpty() bool {
+	return t.PartUuid == "" && t.Category == CATEGORY_UNSPECIFIED
+}
+
+// Matches сообщает, подходит ли деталь под цель. Под цель-категорию подходят и детали ее подкатегорий
+func (t RuleTarget) Matches(part *Part, parents CategoryParents) bool {
+	if t.PartUuid != "" {
+		return part.Uuid == t.PartUuid
+	}
+	return parents.IsWithin(part.Category, t.Category)
+}
+
+// CompatibilityRule - ограничение на состав конфигурации ракеты
+type CompatibilityRule struct {
+	Uuid    string
+	Type    CompatibilityRuleType
+	Subject RuleTarget
+	Object  RuleTarget
+	// Пояснение, которое показывается при нарушении правила
+	Description string
+	CreatedAt   time.Time
+}
+
+// ConfigurationViolation - нарушение правила совместимости в конфигурации
+type ConfigurationViolation struct {
+	RuleUuid string
+	Type     CompatibilityRuleType
+	// Детали конфигурации, вызвавшие нарушение
+	PartUuids []string
+	Message   string
+}
+
+// ConfigurationValidation - результат проверки конфигурации
+type ConfigurationValidation struct {
+	Violations []*ConfigurationViolation
+}
+
+// Valid сообщает, что конфигурация не нарушает ни одного правила
+func (v *ConfigurationValidation) Valid() bool {
+	return len(v.Violations) == 0
+}
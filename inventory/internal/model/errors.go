@@ -6,4 +6,75 @@ import "errors"
 var (
 	ErrPartNotFound  = errors.New("part not found")
 	ErrPartsNotFound = errors.New("parts not found")
+
+	// ErrPartAlreadyExists возвращается при создании детали с существующим UUID
+	ErrPartAlreadyExists = errors.New("part already exists")
+	// ErrInvalidPart возвращается когда поля детали не проходят валидацию
+	ErrInvalidPart = errors.New("invalid part")
+	// ErrPartVersionMismatch возвращается когда деталь изменилась после чтения и версия в запросе устарела
+	ErrPartVersionMismatch = errors.New("part version mismatch")
+	// ErrInvalidUpdateMask возвращается когда маска обновления содержит неизвестное поле
+	ErrInvalidUpdateMask = errors.New("invalid update mask")
+	// ErrInvalidReadMask возвращается когда маска чтения содержит неизвестное поле
+	ErrInvalidReadMask = errors.New("invalid read mask")
+	// ErrTooManyParts возвращается когда в пакетном запросе больше деталей, чем допускается
+	ErrTooManyParts = errors.New("too many parts requested")
+	// ErrInvalidPageToken возвращается когда токен страницы поврежден или не соответствует сортировке
+	ErrInvalidPageToken = errors.New("invalid page token")
+	// ErrInvalidPageSize возвращается при отрицательном размере страницы
+	ErrInvalidPageSize = errors.New("invalid page size")
+	// ErrEmptySearchQuery возвращается при пустой поисковой строке
+	ErrEmptySearchQuery = errors.New("empty search query")
+	// ErrInvalidFilter возвращается при некорректном диапазоне или условии на метаданные
+	ErrInvalidFilter = errors.New("invalid parts filter")
+	// ErrInvalidStockChange возвращается при неположительном количестве или неизвестном типе движения
+	ErrInvalidStockChange = errors.New("invalid stock change")
+	// ErrInsufficientStock возвращается когда расход превышает остаток детали
+	ErrInsufficientStock = errors.New("insufficient stock")
+	// ErrInvalidCompatibilityRule возвращается при неизвестном типе правила или незаданной цели
+	ErrInvalidCompatibilityRule = errors.New("invalid compatibility rule")
+	// ErrCompatibilityRuleNotFound возвращается когда правило совместимости не найдено
+	ErrCompatibilityRuleNotFound = errors.New("compatibility rule not found")
+	// ErrEmptyConfiguration возвращается при проверке конфигурации без деталей
+	ErrEmptyConfiguration = errors.New("empty configuration")
+	// ErrInvalidCatalog возвращается при неизвестном формате или поврежденном файле каталога
+	ErrInvalidCatalog = errors.New("invalid catalog")
+	// ErrInvalidRocketModel возвращается при пустой спецификации или неположительном количестве
+	ErrInvalidRocketModel = errors.New("invalid rocket model")
+	// ErrRocketModelNotFound возвращается когда модель ракеты не найдена
+	ErrRocketModelNotFound = errors.New("rocket model not found")
+	// ErrRocketModelIncomplete возвращается когда деталей спецификации нет в каталоге
+	ErrRocketModelIncomplete = errors.New("rocket model references missing parts")
+	// ErrInvalidAttachment возвращается при неизвестном типе, запрещенном или не совпадающем с содержимым MIME-типе
+	ErrInvalidAttachment = errors.New("invalid attachment")
+	// ErrAttachmentTooLarge возвращается когда файл больше допустимого размера
+	ErrAttachmentTooLarge = errors.New("attachment is too large")
+	// ErrAttachmentChecksumMismatch возвращается когда SHA-256 содержимого не совпал с ожидаемым
+	ErrAttachmentChecksumMismatch = errors.New("attachment checksum mismatch")
+	// ErrAttachmentNotFound возвращается когда вложение не найдено
+	ErrAttachmentNotFound = errors.New("attachment not found")
+	// ErrInvalidPriceChange возвращается при неположительной цене или дате вступления в силу не в будущем
+	ErrInvalidPriceChange = errors.New("invalid price change")
+	// ErrInvalidWarehouse возвращается при пустом коде или названии склада и некорректных координатах
+	ErrInvalidWarehouse = errors.New("invalid warehouse")
+	// ErrWarehouseAlreadyExists возвращается при создании склада с существующим кодом
+	ErrWarehouseAlreadyExists = errors.New("warehouse already exists")
+	// ErrWarehouseNotFound возвращается когда склад не найден
+	ErrWarehouseNotFound = errors.New("warehouse not found")
+	// ErrInvalidStockTransfer возвращается при неположительном количестве или совпадающих складах
+	ErrInvalidStockTransfer = errors.New("invalid stock transfer")
+	// ErrInvalidReservation возвращается при пустом основании, без деталей или с неположительным количеством
+	ErrInvalidReservation = errors.New("invalid stock reservation")
+	// ErrReservationExists возвращается когда резерв по основанию и детали уже оформлен
+	ErrReservationExists = errors.New("stock reservation already exists")
+	// ErrResumeTokenExpired возвращается когда изменений после сохраненного токена уже нет в oplog
+	ErrResumeTokenExpired = errors.New("change stream resume token expired")
+	// ErrInvalidCategory возвращается при некорректном коде, пустом названии, неизвестном родителе или цикле в дереве
+	ErrInvalidCategory = errors.New("invalid category")
+	// ErrCategoryAlreadyExists возвращается при создании категории с существующим кодом
+	ErrCategoryAlreadyExists = errors.New("category already exists")
+	// ErrCategoryNotFound возвращается когда категория не найдена
+	ErrCategoryNotFound = errors.New("category not found")
+	// ErrCategoryInUse возвращается при удалении категории с подкатегориями, деталями или значением в enum Category
+	ErrCategoryInUse = errors.New("category is in use")
 )
@@ -4,19 +4,21 @@ import (
 	"time"
 )
 
-type Category int32
+// Category - код категории из справочника категорий, например ENGINE
+type Category string
 
+// Категории, у которых есть значение в proto enum Category
 const (
 	// Неизвестная категория
-	CATEGORY_UNSPECIFIED Category = 0
+	CATEGORY_UNSPECIFIED Category = ""
 	// Двигатель
-	CATEGORY_ENGINE Category = 1
+	CATEGORY_ENGINE Category = "ENGINE"
 	// Топливо
-	CATEGORY_FUEL Category = 2
+	CATEGORY_FUEL Category = "FUEL"
 	// Иллюминатор
-	CATEGORY_PORTHOLE Category = 3
+	CATEGORY_PORTHOLE Category = "PORTHOLE"
 	// Крыло
-	CATEGORY_WING Category = 4
+	CATEGORY_WING Category = "WING"
 )
 
 type PartsFilter struct {
@@ -24,12 +26,96 @@ type PartsFilter struct {
 	Uuids []string
 	// Список имён. Пусто — не фильтруем по имени
 	Names []string
-	// Список категорий. Пусто — не фильтруем по категории
+	// Список категорий вместе с подкатегориями. Пусто — не фильтруем по категории
 	Categories []Category
 	// Список стран производителей. Пусто — не фильтруем по стране
 	ManufacturerCountries []string
 	// Список тегов. Пусто — не фильтруем по тегам
 	Tags []string
+	// Диапазоны цены, количества и размеров. nil — не фильтруем
+	Price         *FloatRange
+	StockQuantity *IntRange
+	Length        *FloatRange
+	Width         *FloatRange
+	Height        *FloatRange
+	Weight        *FloatRange
+	// Только детали в наличии
+	InStockOnly bool
+	// Условия на метаданные, объединяются по И
+	Metadata []MetadataPredicate
+}
+
+// FloatRange - диапазон дробных значений, границы включаются. nil-граница не ограничивает
+type FloatRange struct {
+	Min *float64
+	Max *float64
+}
+
+// IntRange - диапазон целых значений, границы включаются. nil-граница не ограничивает
+type IntRange struct {
+	Min *int64
+	Max *int64
+}
+
+type MetadataOperator int32
+
+const (
+	METADATA_OPERATOR_UNSPECIFIED MetadataOperator = 0
+	METADATA_OPERATOR_EQ          MetadataOperator = 1
+	METADATA_OPERATOR_NE          MetadataOperator = 2
+	METADATA_OPERATOR_GT          MetadataOperator = 3
+	METADATA_OPERATOR_GTE         MetadataOperator = 4
+	METADATA_OPERATOR_LT          MetadataOperator = 5
+	METADATA_OPERATOR_LTE         MetadataOperator = 6
+	METADATA_OPERATOR_EXISTS      MetadataOperator = 7
+)
+
+// MetadataPredicate - условие на значение метаданных детали
+type MetadataPredicate struct {
+	Key      string
+	Operator MetadataOperator
+	// Значение (string, int64, float64, bool). Не используется для EXISTS
+	Value interface{}
+}
+
+type PartsSortField int32
+
+const (
+	// По умолчанию — по дате создания
+	PARTS_SORT_FIELD_UNSPECIFIED PartsSortField = 0
+	// По названию
+	PARTS_SORT_FIELD_NAME PartsSortField = 1
+	// По цене
+	PARTS_SORT_FIELD_PRICE PartsSortField = 2
+	// По дате создания
+	PARTS_SORT_FIELD_CREATED_AT PartsSortField = 3
+	// По количеству на складе
+	PARTS_SORT_FIELD_STOCK_QUANTITY PartsSortField = 4
+)
+
+// PartsQuery - запрос страницы списка деталей
+type PartsQuery struct {
+	// Фильтр по деталям, nil — без фильтрации
+	Filter *PartsFilter
+	// Размер страницы. 0 — размер по умолчанию
+	PageSize int32
+	// Токен следующей страницы. Пусто — первая страница
+	PageToken string
+	// Поле сортировки
+	SortBy PartsSortField
+	// Сортировать по убыванию
+	Descending bool
+	// Поля детали, которые нужно вернуть. Пусто — все поля
+	Fields []string
+}
+
+// PartsPage - страница списка деталей
+type PartsPage struct {
+	Parts []*Part
+	// Токен следующей страницы. Пусто — страниц больше нет
+	NextPageToken string
+	// Оценка общего количества деталей, подходящих под фильтр
+	TotalSize int64
 }
 
 type Dimensions struct {
@@ -61,7 +147,7 @@ type Part struct {
 	Description string
 	// Цена за единицу
 	Price float64
-	// Количество на складе
+	// Количество на всех складах
 	StockQuantity int64
 	// Категория детали
 	Category Category
@@ -77,4 +163,56 @@ type Part struct {
 	CreatedAt *time.Time
 	// Дата последнего обновления
 	UpdatedAt *time.Time
+	// Порог дозаказа: остаток ниже порога считается низким. 0 — без контроля остатка
+	ReorderThreshold int64
+	// Остаток ниже порога дозаказа, выставляется сервисом
+	StockLow bool
+	// Фото, чертежи и документация. Меняются только через AttachmentService
+	Attachments []*Attachment
+	// Остатки по складам, в сумме дают StockQuantity. Меняются только движениями
+	StockLocations []*StockLocation
+	// Версия детали, растет при каждом изменении карточки или остатка.
+	// UpdatePart сохраняет изменение, только если версия совпадает с текущей
+	Version int64
+}
+
+// Поля детали, которые можно обновлять через UpdatePart
+const (
+	PartFieldName          = "name"
+	PartFieldDescription   = "description"
+	PartFieldPrice         = "price"
+	PartFieldStockQuantity = "stock_quantity"
+	PartFieldCategory      = "category"
+	PartFieldDimensions    = "dimensions"
+	PartFieldManufacturer  = "manufacturer"
+	PartFieldTags          = "tags"
+	PartFieldMetadata      = "metadata"
+	// Порог дозаказа
+	PartFieldReorderThreshold = "reorder_threshold"
+)
+
+// Поля детали, которые можно запросить в маске чтения, но нельзя обновить через UpdatePart
+const (
+	PartFieldUuid           = "uuid"
+	PartFieldCreatedAt      = "created_at"
+	PartFieldUpdatedAt      = "updated_at"
+	PartFieldStockLow       = "stock_low"
+	PartFieldAttachments    = "attachments"
+	PartFieldStockLocations = "stock_locations"
+	PartFieldVersion        = "version"
+)
+
+// PartsBatch - результат получения деталей по списку UUID
+type PartsBatch struct {
+	// Найденные детали в порядке запроса
+	Parts []*Part
+	// UUID, для которых деталь не найдена
+	MissingUuids []string
+}
+
+type PartUpdate struct {
+	// Новые значения полей, деталь ищется по Part.Uuid
+	Part *Part
+	// Обновляемые поля. Пусто — обновляются все поля
+	Fields []string
 }
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+)
+
+type PartChangeType int32
+
+const (
+	// Изменение, не относящееся к деталям (например, служебное событие потока)
+	PART_CHANGE_TYPE_UNSPECIFIED PartChangeType = 0
+	// Деталь создана
+	PART_CHANGE_TYPE_CREATED PartChangeType = 1
+	// Деталь изменена
+	PART_CHANGE_TYPE_UPDATED PartChangeType = 2
+	// Деталь удалена, мягко или из коллекции
+	PART_CHANGE_TYPE_DELETED PartChangeType = 3
+)
+
+// PartChange - изменение документа детали из change stream коллекции parts
+type PartChange struct {
+	Type     PartChangeType
+	PartUuid string
+	// Деталь после изменения, nil при удалении из коллекции
+	Part *Part
+	// Измененные и удаленные поля документа, только для UPDATED
+	UpdatedFields []string
+	OccurredAt    time.Time
+	// Токен, с которого change stream продолжится после этого изменения
+	ResumeToken []byte
+}
@@ -0,0 +1,39 @@
+package model
+
+import (
+	"time"
+)
+
+type PartEventType int32
+
+const (
+	PART_EVENT_TYPE_UNSPECIFIED PartEventType = 0
+	// Деталь из начального снимка
+	PART_EVENT_TYPE_SNAPSHOT PartEventType = 1
+	// Начальный снимок отправлен полностью
+	PART_EVENT_TYPE_SNAPSHOT_COMPLETE PartEventType = 2
+	// Деталь создана или стала подходить под фильтр
+	PART_EVENT_TYPE_CREATED PartEventType = 3
+	// Деталь изменена и по-прежнему подходит под фильтр
+	PART_EVENT_TYPE_UPDATED PartEventType = 4
+	// Деталь удалена или перестала подходить под фильтр
+	PART_EVENT_TYPE_DELETED PartEventType = 5
+)
+
+// PartEvent - событие подписки на детали
+type PartEvent struct {
+	Type     PartEventType
+	PartUuid string
+	// Состояние детали, nil для DELETED и SNAPSHOT_COMPLETE
+	Part *Part
+	// Время изменения, нулевое для событий снимка
+	OccurredAt time.Time
+}
+
+// PartsWatch - параметры подписки на детали
+type PartsWatch struct {
+	Filter *PartsFilter
+	// Send отправляет событие подписчику и блокируется, пока тот не готов его принять.
+	// Ошибка Send завершает подписку
+	Send func(event *PartEvent) error
+}
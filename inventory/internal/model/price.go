@@ -0,0 +1,27 @@
+package model
+
+import (
+	"time"
+)
+
+// PriceChange - запись истории цен детали. Цена действует с EffectiveFrom
+// до EffectiveFrom следующей записи
+type PriceChange struct {
+	Uuid          string
+	PartUuid      string
+	Price         float64
+	EffectiveFrom time.Time
+	// Причина изменения цены
+	Reason string
+	// Цена уже записана в карточку детали
+	Applied   bool
+	CreatedAt time.Time
+}
+
+// PartPrice - цена детали на момент времени
+type PartPrice struct {
+	PartUuid string
+	Price    float64
+	// С какого момента действует цена, nil — цена из карточки детали без истории
+	EffectiveFrom *time.Time
+}
@@ -0,0 +1,73 @@
+package model
+
+import "time"
+
+// RocketModel - модель ракеты: именованная спецификация деталей (bill of materials)
+type RocketModel struct {
+	Uuid        string
+	Name        string
+	Description string
+	Items       []BomItem
+	CreatedAt   time.Time
+}
+
+// BomItem - строка спецификации: основная деталь, ее количество на одну ракету
+// и детали, которыми ее можно заменить, в порядке предпочтения
+type BomItem struct {
+	PartUuid     string
+	Quantity     int64
+	Alternatives []string
+}
+
+// PartUuids возвращает основную деталь строки и ее альтернативы
+func (i BomItem) PartUuids() []string {
+	return append([]string{i.PartUuid}, i.Alternatives...)
+}
+
+// BomLine - строка спецификации, разложенная на конкретную деталь каталога
+type BomLine struct {
+	Part *Part
+	// Количество с учетом количества ракет
+	Quantity int64
+	// Остатка выбранной детали хватает
+	Available bool
+	// Вместо основной детали выбрана альтернатива
+	Alternative bool
+}
+
+// RocketModelExpansion - модель ракеты, разложенная на детали
+type RocketModelExpansion struct {
+	Model *RocketModel
+	Lines []*BomLine
+	// Детали спецификации, которых нет в каталоге
+	MissingParts []string
+}
+
+// TotalPrice возвращает стоимость всех строк раскладки
+func (e *RocketModelExpansion) TotalPrice() float64 {
+	var total float64
+	for _, line := range e.Lines {
+		total += line.Part.Price * float64(line.Quantity)
+	}
+	return total
+}
+
+// Available сообщает, что все строки обеспечены остатком
+func (e *RocketModelExpansion) Available() bool {
+	if len(e.MissingParts) > 0 {
+		return false
+	}
+	for _, line := range e.Lines {
+		if !line.Available {
+			return false
+		}
+	}
+	return true
+}
+
+// RocketModelSummary - модель ракеты с ценой и доступностью одной ракеты
+type RocketModelSummary struct {
+	Model     *RocketModel
+	Price     float64
+	Available bool
+}
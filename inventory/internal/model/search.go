@@ -0,0 +1,30 @@
+package model
+
+type SearchLanguage int32
+
+const (
+	// Определить автоматически
+	SEARCH_LANGUAGE_UNSPECIFIED SearchLanguage = 0
+	// Русский
+	SEARCH_LANGUAGE_RUSSIAN SearchLanguage = 1
+	// Английский
+	SEARCH_LANGUAGE_ENGLISH SearchLanguage = 2
+)
+
+// PartsSearch - запрос полнотекстового поиска деталей
+type PartsSearch struct {
+	// Поисковая строка
+	Query string
+	// Язык запроса, определяет стемминг поисковых слов
+	Language SearchLanguage
+	// Дополнительный фильтр, nil — без фильтрации
+	Filter *PartsFilter
+	// Максимальное количество результатов
+	Limit int32
+}
+
+// PartSearchHit - найденная деталь с оценкой релевантности
+type PartSearchHit struct {
+	Part  *Part
+	Score float64
+}
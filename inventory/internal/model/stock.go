@@ -0,0 +1,68 @@
+package model
+
+import (
+	"time"
+)
+
+type StockMovementType int32
+
+const (
+	STOCK_MOVEMENT_TYPE_UNSPECIFIED StockMovementType = 0
+	// Поступление на склад
+	STOCK_MOVEMENT_TYPE_RECEIPT StockMovementType = 1
+	// Резервирование под заказ
+	STOCK_MOVEMENT_TYPE_RESERVATION StockMovementType = 2
+	// Снятие резерва
+	STOCK_MOVEMENT_TYPE_RELEASE StockMovementType = 3
+	// Списание в производство
+	STOCK_MOVEMENT_TYPE_CONSUMPTION StockMovementType = 4
+	// Ручная корректировка
+	STOCK_MOVEMENT_TYPE_ADJUSTMENT StockMovementType = 5
+	// Перемещение между складами
+	STOCK_MOVEMENT_TYPE_TRANSFER StockMovementType = 6
+)
+
+// StockMovement - неизменяемая запись об изменении остатка детали.
+// stock_quantity детали равен сумме Quantity всех ее движений,
+// остаток на складе — сумме движений с его WarehouseUuid
+type StockMovement struct {
+	Uuid          string
+	PartUuid      string
+	WarehouseUuid string
+	Type          StockMovementType
+	// Изменение остатка: положительное — приход, отрицательное — расход
+	Quantity int64
+	// Причина движения
+	Reason string
+	// Ссылка на документ-основание (накладная, UUID заказа и т.п.)
+	Reference string
+	// Остаток детали после движения по всем складам
+	StockAfter int64
+	CreatedAt  time.Time
+}
+
+// StockChange - запрос на изменение остатка детали
+type StockChange struct {
+	PartUuid string
+	// Склад движения. Пусто — склад по умолчанию
+	WarehouseUuid string
+	Type          StockMovementType
+	// Количество единиц. Для ADJUSTMENT — изменение со знаком,
+	// для остальных типов — положительное число, знак определяется типом
+	Quantity  int64
+	Reason    string
+	Reference string
+}
+
+// StockMovementsQuery - запрос страницы истории движений
+type StockMovementsQuery struct {
+	PartUuid  string
+	PageSize  int32
+	PageToken string
+}
+
+// StockMovementsPage - страница истории движений, от новых к старым
+type StockMovementsPage struct {
+	Movements     []*StockMovement
+	NextPageToken string
+}
@@ -0,0 +1,67 @@
+package model
+
+import (
+	"time"
+)
+
+// Warehouse - склад, на котором хранятся детали
+type Warehouse struct {
+	Uuid string
+	// Уникальный код склада, например BAIKONUR
+	Code      string
+	Name      string
+	Location  GeoPoint
+	CreatedAt time.Time
+}
+
+// GeoPoint - географические координаты в градусах
+type GeoPoint struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// StockLocation - остаток детали на складе
+type StockLocation struct {
+	WarehouseUuid string
+	Quantity      int64
+}
+
+// StockTransfer - запрос на перемещение остатка детали между складами
+type StockTransfer struct {
+	PartUuid          string
+	FromWarehouseUuid string
+	ToWarehouseUuid   string
+	Quantity          int64
+	Reason            string
+}
+
+// PartAvailability - остатки детали по складам
+type PartAvailability struct {
+	PartUuid string
+	// Общий остаток по всем складам
+	StockQuantity int64
+	Locations     []*StockLocation
+}
+
+// StockReservation - запрос на резервирование деталей под документ-основание
+type StockReservation struct {
+	// Документ-основание, например UUID заказа
+	Reference string
+	Items     []*ReservationItem
+}
+
+// ReservationItem - резервируемая деталь и количество единиц
+type ReservationItem struct {
+	PartUuid string
+	Quantity int64
+}
+
+// ReservationStrategy - правило выбора склада при резервировании
+type ReservationStrategy string
+
+const (
+	// Склад с наибольшим остатком детали
+	RESERVATION_STRATEGY_MOST_STOCK ReservationStrategy = "most_stock"
+	// Ближайший к точке отгрузки склад, на котором хватает остатка
+	RESERVATION_STRATEGY_NEAREST ReservationStrategy = "nearest"
+)
@@ -0,0 +1,48 @@
+package attachment
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/converter"
+)
+
+// AddAttachment добавляет ссылку на вложение в деталь. Основное изображение снимает
+// признак primary с остальных вложений той же операцией
+func (r *repository) AddAttachment(ctx context.Context, attachment *model.Attachment) error {
+	repoAttachment := converter.AttachmentToRepoModel(attachment)
+
+	var update interface{} = bson.M{
+		"$push": bson.M{"attachments": repoAttachment},
+		"$set":  bson.M{"updated_at": attachment.CreatedAt},
+		"$inc":  bson.M{"version": 1},
+	}
+	if attachment.Primary {
+		// $literal: имя файла со знаком $ иначе было бы прочитано как путь к полю
+		update = bson.A{bson.M{"$set": bson.M{
+			"attachments": bson.M{"$concatArrays": bson.A{
+				bson.M{"$map": bson.M{
+					"input": bson.M{"$ifNull": bson.A{"$attachments", bson.A{}}},
+					"as":    "attachment",
+					"in":    bson.M{"$mergeObjects": bson.A{"$$attachment", bson.M{"primary": false}}},
+				}},
+				bson.A{bson.M{"$literal": repoAttachment}},
+			}},
+			"updated_at": attachment.CreatedAt,
+			"version":    bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$version", 0}}, 1}},
+		}}}
+	}
+
+	result, err := r.parts.UpdateOne(ctx, bson.M{"uuid": attachment.PartUuid, "deleted_at": nil}, update)
+	if err != nil {
+		return fmt.Errorf("failed to add attachment: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return model.ErrPartNotFound
+	}
+
+	return nil
+}
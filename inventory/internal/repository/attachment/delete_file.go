@@ -0,0 +1,21 @@
+package attachment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/mongo/gridfs"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (r *repository) DeleteFile(ctx context.Context, uuid string) error {
+	if err := r.bucket.DeleteContext(ctx, uuid); err != nil {
+		if errors.Is(err, gridfs.ErrFileNotFound) {
+			return model.ErrAttachmentNotFound
+		}
+		return fmt.Errorf("failed to delete attachment file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package attachment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/converter"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
+)
+
+// GetAttachment ищет вложение среди деталей, вложения удаленных деталей недоступны
+func (r *repository) GetAttachment(ctx context.Context, uuid string) (*model.Attachment, error) {
+	findOptions := options.FindOne().SetProjection(bson.M{"uuid": 1, "attachments.$": 1})
+
+	var found struct {
+		Uuid        string                  `bson:"uuid"`
+		Attachments []*repoModel.Attachment `bson:"attachments"`
+	}
+
+	err := r.parts.FindOne(ctx, bson.M{"attachments.uuid": uuid, "deleted_at": nil}, findOptions).Decode(&found)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, model.ErrAttachmentNotFound
+		}
+		return nil, fmt.Errorf("failed to get attachment: %w", err)
+	}
+	if len(found.Attachments) == 0 {
+		return nil, model.ErrAttachmentNotFound
+	}
+
+	return converter.AttachmentToModel(found.Uuid, found.Attachments[0]), nil
+}
@@ -0,0 +1,27 @@
+package attachment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"go.mongodb.org/mongo-driver/mongo/gridfs"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (r *repository) OpenFile(ctx context.Context, uuid string) (io.ReadCloser, error) {
+	stream, err := r.bucket.OpenDownloadStream(uuid)
+	if err != nil {
+		if errors.Is(err, gridfs.ErrFileNotFound) {
+			return nil, model.ErrAttachmentNotFound
+		}
+		return nil, fmt.Errorf("failed to open download stream: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = stream.SetReadDeadline(deadline) //nolint:gosec // ошибка возможна только у закрытого стрима
+	}
+
+	return stream, nil
+}
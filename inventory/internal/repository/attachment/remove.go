@@ -0,0 +1,30 @@
+package attachment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (r *repository) RemoveAttachment(ctx context.Context, attachment *model.Attachment) error {
+	result, err := r.parts.UpdateOne(ctx,
+		bson.M{"uuid": attachment.PartUuid, "attachments.uuid": attachment.Uuid},
+		bson.M{
+			"$pull": bson.M{"attachments": bson.M{"uuid": attachment.Uuid}},
+			"$set":  bson.M{"updated_at": time.Now()},
+			"$inc":  bson.M{"version": 1},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to remove attachment: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return model.ErrAttachmentNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package attachment
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/gridfs"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	def "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository"
+)
+
+var _ def.AttachmentRepository = (*repository)(nil)
+
+const bucketName = "attachments"
+
+type repository struct {
+	parts  *mongo.Collection
+	bucket *gridfs.Bucket
+}
+
+func NewRepository(_ context.Context, db *mongo.Database) *repository {
+	bucket, err := gridfs.NewBucket(db, options.GridFSBucket().SetName(bucketName))
+	if err != nil {
+		panic("failed to create attachments bucket: " + err.Error())
+	}
+
+	parts := db.Collection("parts")
+
+	indexModel := []mongo.IndexModel{
+		{
+			// Поиск детали по UUID вложения при скачивании и удалении
+			Keys:    bson.D{{Key: "attachments.uuid", Value: 1}},
+			Options: options.Index().SetSparse(true),
+		},
+	}
+
+	indexCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	//nolint:gosec,contextcheck // Ignoring error & using background context is intentional
+	_, _ = parts.Indexes().CreateMany(indexCtx, indexModel)
+
+	return &repository{
+		parts:  parts,
+		bucket: bucket,
+	}
+}
@@ -0,0 +1,39 @@
+package attachment
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
+)
+
+// UploadFile записывает содержимое в GridFS частями по мере чтения. При ошибке чтения
+// или записи уже сохраненные части удаляются
+func (r *repository) UploadFile(ctx context.Context, attachment *model.Attachment, content io.Reader) error {
+	uploadOptions := options.GridFSUpload().SetMetadata(repoModel.AttachmentFileMetadata{
+		PartUuid:    attachment.PartUuid,
+		ContentType: attachment.ContentType,
+	})
+
+	stream, err := r.bucket.OpenUploadStreamWithID(attachment.Uuid, attachment.FileName, uploadOptions)
+	if err != nil {
+		return fmt.Errorf("failed to open upload stream: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = stream.SetWriteDeadline(deadline) //nolint:gosec // ошибка возможна только у закрытого стрима
+	}
+
+	if _, err = io.Copy(stream, content); err != nil {
+		_ = stream.Abort() //nolint:gosec // возвращаем исходную ошибку
+		return err
+	}
+
+	if err = stream.Close(); err != nil {
+		return fmt.Errorf("failed to finish upload: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package category
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/converter"
+)
+
+func (r *repository) CreateCategory(ctx context.Context, category *model.PartCategory) error {
+	_, err := r.collection.InsertOne(ctx, converter.PartCategoryToRepoModel(category))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return model.ErrCategoryAlreadyExists
+		}
+		return fmt.Errorf("failed to create category: %w", err)
+	}
+
+	return nil
+}
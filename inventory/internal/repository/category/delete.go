@@ -0,0 +1,22 @@
+package category
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (r *repository) DeleteCategory(ctx context.Context, code model.Category) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": string(code)})
+	if err != nil {
+		return fmt.Errorf("failed to delete category: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return model.ErrCategoryNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/converter"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
+)
+
+func (r *repository) GetCategory(ctx context.Context, code model.Category) (*model.PartCategory, error) {
+	var category repoModel.Category
+	err := r.collection.FindOne(ctx, bson.M{"_id": string(code)}).Decode(&category)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, model.ErrCategoryNotFound
+		}
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+
+	return converter.PartCategoryToModel(&category), nil
+}
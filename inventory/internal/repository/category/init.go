@@ -0,0 +1,70 @@
+package category
+
+import (
+	"context"
+	"time"
+
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+// InitTestData создает категории, соответствующие значениям proto enum Category
+func (r *repository) InitTestData(ctx context.Context) {
+	now := time.Now()
+	zero := 0.0
+	fullTurn := 360.0
+	logger.Info(ctx, "❗️ Init categories")
+
+	testCategories := []repoModel.Category{
+		{
+			ID:          "ENGINE",
+			Code:        "ENGINE",
+			DisplayName: "Двигатель",
+			MetadataSchema: []repoModel.MetadataField{
+				{Key: "тяга", DisplayName: "Тяга", Type: "NUMBER", Unit: "Н", Min: &zero},
+				{Key: "удельный_импульс", DisplayName: "Удельный импульс", Type: "NUMBER", Unit: "с", Min: &zero},
+				{Key: "топливо", DisplayName: "Топливо", Type: "STRING"},
+				{Key: "многоразовый", DisplayName: "Многоразовый", Type: "BOOL"},
+			},
+			CreatedAt: now,
+		},
+		{
+			ID:          "FUEL",
+			Code:        "FUEL",
+			DisplayName: "Топливо",
+			MetadataSchema: []repoModel.MetadataField{
+				{Key: "температура", DisplayName: "Температура", Type: "NUMBER", Unit: "°C"},
+				{Key: "объем", DisplayName: "Объем", Type: "NUMBER", Unit: "л", Min: &zero},
+			},
+			CreatedAt: now,
+		},
+		{
+			ID:          "PORTHOLE",
+			Code:        "PORTHOLE",
+			DisplayName: "Иллюминатор",
+			MetadataSchema: []repoModel.MetadataField{
+				{Key: "материал_стекла", DisplayName: "Материал стекла", Type: "STRING"},
+				{Key: "угол_обзора", DisplayName: "Угол обзора", Type: "NUMBER", Unit: "°", Min: &zero, Max: &fullTurn},
+			},
+			CreatedAt: now,
+		},
+		{
+			ID:          "WING",
+			Code:        "WING",
+			DisplayName: "Крыло",
+			MetadataSchema: []repoModel.MetadataField{
+				{Key: "материал", DisplayName: "Материал", Type: "STRING"},
+				{Key: "термостойкость", DisplayName: "Термостойкость", Type: "NUMBER", Unit: "°C"},
+			},
+			CreatedAt: now,
+		},
+	}
+
+	for _, category := range testCategories {
+		_, err := r.collection.InsertOne(ctx, category)
+		if err != nil {
+			return
+		}
+	}
+	logger.Info(ctx, "🎉 Categories successfully init")
+}
@@ -0,0 +1,30 @@
+package category
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/converter"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
+)
+
+func (r *repository) ListCategories(ctx context.Context) ([]*model.PartCategory, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+	defer func() {
+		_ = cursor.Close(ctx) //nolint:gosec // Cursor close error is not critical
+	}()
+
+	var categories []*repoModel.Category
+	if err = cursor.All(ctx, &categories); err != nil {
+		return nil, fmt.Errorf("failed to parse: %w", err)
+	}
+
+	return converter.PartCategoriesToModel(categories), nil
+}
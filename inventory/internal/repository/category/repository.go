@@ -0,0 +1,38 @@
+package category
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	def "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository"
+)
+
+var _ def.CategoryRepository = (*repository)(nil)
+
+type repository struct {
+	collection *mongo.Collection
+}
+
+func NewRepository(_ context.Context, db *mongo.Database) *repository {
+	collection := db.Collection("categories")
+
+	// _id совпадает с кодом, поэтому уникальность кода обеспечена без отдельного индекса
+	indexModel := []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "parent_code", Value: 1}},
+		},
+	}
+
+	indexCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	//nolint:gosec,contextcheck // Ignoring error & using background context is intentional
+	_, _ = collection.Indexes().CreateMany(indexCtx, indexModel)
+
+	return &repository{
+		collection: collection,
+	}
+}
@@ -0,0 +1,35 @@
+package category
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/converter"
+)
+
+func (r *repository) UpdateCategory(ctx context.Context, category *model.PartCategory) error {
+	repoCategory := converter.PartCategoryToRepoModel(category)
+
+	update := bson.M{
+		"$set": bson.M{
+			"parent_code":     repoCategory.ParentCode,
+			"display_name":    repoCategory.DisplayName,
+			"description":     repoCategory.Description,
+			"metadata_schema": repoCategory.MetadataSchema,
+			"updated_at":      repoCategory.UpdatedAt,
+		},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": repoCategory.ID}, update)
+	if err != nil {
+		return fmt.Errorf("failed to update category: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return model.ErrCategoryNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+package compatibility
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/converter"
+)
+
+func (r *repository) CreateRule(ctx context.Context, rule *model.CompatibilityRule) error {
+	_, err := r.collection.InsertOne(ctx, converter.CompatibilityRuleToRepoModel(rule))
+	if err != nil {
+		return fmt.Errorf("failed to create compatibility rule: %w", err)
+	}
+
+	return nil
+}
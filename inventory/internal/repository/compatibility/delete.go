@@ -0,0 +1,22 @@
+package compatibility
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (r *repository) DeleteRule(ctx context.Context, uuid string) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"uuid": uuid})
+	if err != nil {
+		return fmt.Errorf("failed to delete compatibility rule: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return model.ErrCompatibilityRuleNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package compatibility
+
+import (
+	"context"
+	"time"
+
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+func (r *repository) InitTestData(ctx context.Context) {
+	now := time.Now()
+	logger.Info(ctx, "❗️ Init compatibility rules")
+
+	// Правила для тестового каталога (UUID деталей из part.InitTestData)
+	testRules := []repoModel.CompatibilityRule{
+		{
+			ID:          "7c9e6679-7425-40de-944b-e07fc1f90001",
+			Uuid:        "7c9e6679-7425-40de-944b-e07fc1f90001",
+			Type:        "REQUIRES",
+			Object:      repoModel.RuleTarget{Category: "ENGINE"},
+			Description: "every rocket needs at least one engine",
+			CreatedAt:   now,
+		},
+		{
+			ID:          "7c9e6679-7425-40de-944b-e07fc1f90002",
+			Uuid:        "7c9e6679-7425-40de-944b-e07fc1f90002",
+			Type:        "EXCLUDES",
+			Subject:     repoModel.RuleTarget{PartUuid: "550e8400-e29b-41d4-a716-446655440001", Category: "ENGINE"},
+			Object:      repoModel.RuleTarget{PartUuid: "550e8400-e29b-41d4-a716-446655440003", Category: "FUEL"},
+			Description: "RD-180 burns kerosene with liquid oxygen, liquid hydrogen is not supported",
+			CreatedAt:   now,
+		},
+		{
+			ID:          "7c9e6679-7425-40de-944b-e07fc1f90003",
+			Uuid:        "7c9e6679-7425-40de-944b-e07fc1f90003",
+			Type:        "EXCLUDES",
+			Subject:     repoModel.RuleTarget{PartUuid: "550e8400-e29b-41d4-a716-446655440009", Category: "ENGINE"},
+			Object:      repoModel.RuleTarget{PartUuid: "550e8400-e29b-41d4-a716-446655440003", Category: "FUEL"},
+			Description: "Raptor burns methane with liquid oxygen, liquid hydrogen is not supported",
+			CreatedAt:   now,
+		},
+	}
+
+	for _, rule := range testRules {
+		_, err := r.collection.InsertOne(ctx, rule)
+		if err != nil {
+			return
+		}
+	}
+	logger.Info(ctx, "🎉 Compatibility rules successfully init")
+}
@@ -0,0 +1,34 @@
+package compatibility
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/converter"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
+)
+
+// ListRules возвращает все правила. Правил на порядки меньше, чем деталей,
+// поэтому проверка конфигурации читает их целиком
+func (r *repository) ListRules(ctx context.Context) ([]*model.CompatibilityRule, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}, {Key: "uuid", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list compatibility rules: %w", err)
+	}
+	defer func() {
+		_ = cursor.Close(ctx) //nolint:gosec // Cursor close error is not critical
+	}()
+
+	var rules []*repoModel.CompatibilityRule
+	if err = cursor.All(ctx, &rules); err != nil {
+		return nil, fmt.Errorf("failed to parse: %w", err)
+	}
+
+	return converter.CompatibilityRulesToModel(rules), nil
+}
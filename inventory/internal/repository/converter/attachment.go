@@ -0,0 +1,84 @@
+package converter
+
+import (
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
+)
+
+func AttachmentKindToRepoModel(kind model.AttachmentKind) string {
+	switch kind {
+	case model.ATTACHMENT_KIND_IMAGE:
+		return "IMAGE"
+	case model.ATTACHMENT_KIND_DRAWING:
+		return "DRAWING"
+	case model.ATTACHMENT_KIND_DATASHEET:
+		return "DATASHEET"
+	default:
+		return "UNSPECIFIED"
+	}
+}
+
+func AttachmentKindToModel(s string) model.AttachmentKind {
+	switch s {
+	case "IMAGE":
+		return model.ATTACHMENT_KIND_IMAGE
+	case "DRAWING":
+		return model.ATTACHMENT_KIND_DRAWING
+	case "DATASHEET":
+		return model.ATTACHMENT_KIND_DATASHEET
+	default:
+		return model.ATTACHMENT_KIND_UNSPECIFIED
+	}
+}
+
+func AttachmentToRepoModel(attachment *model.Attachment) *repoModel.Attachment {
+	return &repoModel.Attachment{
+		Uuid:        attachment.Uuid,
+		Kind:        AttachmentKindToRepoModel(attachment.Kind),
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		Checksum:    attachment.Checksum,
+		Primary:     attachment.Primary,
+		CreatedAt:   attachment.CreatedAt,
+	}
+}
+
+// AttachmentToModel восстанавливает вложение детали partUUID: в документе детали UUID детали не дублируется
+func AttachmentToModel(partUUID string, attachment *repoModel.Attachment) *model.Attachment {
+	return &model.Attachment{
+		Uuid:        attachment.Uuid,
+		PartUuid:    partUUID,
+		Kind:        AttachmentKindToModel(attachment.Kind),
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		Checksum:    attachment.Checksum,
+		Primary:     attachment.Primary,
+		CreatedAt:   attachment.CreatedAt,
+	}
+}
+
+func AttachmentsToRepoModel(attachments []*model.Attachment) []*repoModel.Attachment {
+	if attachments == nil {
+		return nil
+	}
+
+	result := make([]*repoModel.Attachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		result = append(result, AttachmentToRepoModel(attachment))
+	}
+	return result
+}
+
+func AttachmentsToModel(partUUID string, attachments []*repoModel.Attachment) []*model.Attachment {
+	if attachments == nil {
+		return nil
+	}
+
+	result := make([]*model.Attachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		result = append(result, AttachmentToModel(partUUID, attachment))
+	}
+	return result
+}
@@ -0,0 +1,100 @@
+package converter
+
+import (
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
+)
+
+func MetadataFieldTypeToRepoModel(fieldType model.MetadataFieldType) string {
+	switch fieldType {
+	case model.METADATA_FIELD_TYPE_STRING:
+		return "STRING"
+	case model.METADATA_FIELD_TYPE_NUMBER:
+		return "NUMBER"
+	case model.METADATA_FIELD_TYPE_INTEGER:
+		return "INTEGER"
+	case model.METADATA_FIELD_TYPE_BOOL:
+		return "BOOL"
+	default:
+		return "UNSPECIFIED"
+	}
+}
+
+func MetadataFieldTypeToModel(s string) model.MetadataFieldType {
+	switch s {
+	case "STRING":
+		return model.METADATA_FIELD_TYPE_STRING
+	case "NUMBER":
+		return model.METADATA_FIELD_TYPE_NUMBER
+	case "INTEGER":
+		return model.METADATA_FIELD_TYPE_INTEGER
+	case "BOOL":
+		return model.METADATA_FIELD_TYPE_BOOL
+	default:
+		return model.METADATA_FIELD_TYPE_UNSPECIFIED
+	}
+}
+
+func PartCategoryToRepoModel(category *model.PartCategory) *repoModel.Category {
+	schema := make([]repoModel.MetadataField, 0, len(category.MetadataSchema))
+	for _, field := range category.MetadataSchema {
+		repoField := repoModel.MetadataField{
+			Key:         field.Key,
+			DisplayName: field.DisplayName,
+			Type:        MetadataFieldTypeToRepoModel(field.Type),
+			Unit:        field.Unit,
+			Required:    field.Required,
+		}
+		if field.Range != nil {
+			repoField.Min = field.Range.Min
+			repoField.Max = field.Range.Max
+		}
+		schema = append(schema, repoField)
+	}
+
+	return &repoModel.Category{
+		ID:             string(category.Code),
+		Code:           string(category.Code),
+		ParentCode:     string(category.ParentCode),
+		DisplayName:    category.DisplayName,
+		Description:    category.Description,
+		MetadataSchema: schema,
+		CreatedAt:      category.CreatedAt,
+		UpdatedAt:      category.UpdatedAt,
+	}
+}
+
+func PartCategoryToModel(category *repoModel.Category) *model.PartCategory {
+	schema := make([]*model.MetadataField, 0, len(category.MetadataSchema))
+	for _, field := range category.MetadataSchema {
+		modelField := &model.MetadataField{
+			Key:         field.Key,
+			DisplayName: field.DisplayName,
+			Type:        MetadataFieldTypeToModel(field.Type),
+			Unit:        field.Unit,
+			Required:    field.Required,
+		}
+		if field.Min != nil || field.Max != nil {
+			modelField.Range = &model.FloatRange{Min: field.Min, Max: field.Max}
+		}
+		schema = append(schema, modelField)
+	}
+
+	return &model.PartCategory{
+		Code:           model.Category(category.Code),
+		ParentCode:     model.Category(category.ParentCode),
+		DisplayName:    category.DisplayName,
+		Description:    category.Description,
+		MetadataSchema: schema,
+		CreatedAt:      category.CreatedAt,
+		UpdatedAt:      category.UpdatedAt,
+	}
+}
+
+func PartCategoriesToModel(categories []*repoModel.Category) []*model.PartCategory {
+	result := make([]*model.PartCategory, 0, len(categories))
+	for _, category := range categories {
+		result = append(result, PartCategoryToModel(category))
+	}
+	return result
+}
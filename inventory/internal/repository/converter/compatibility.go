@@ -0,0 +1,78 @@
+package converter
+
+import (
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
+)
+
+func CompatibilityRuleTypeToRepo(t model.CompatibilityRuleType) string {
+	switch t {
+	case model.COMPATIBILITY_RULE_TYPE_REQUIRES:
+		return "REQUIRES"
+	case model.COMPATIBILITY_RULE_TYPE_EXCLUDES:
+		return "EXCLUDES"
+	case model.COMPATIBILITY_RULE_TYPE_COMPATIBLE_WITH:
+		return "COMPATIBLE_WITH"
+	default:
+		return "UNSPECIFIED"
+	}
+}
+
+func CompatibilityRuleTypeToModel(s string) model.CompatibilityRuleType {
+	switch s {
+	case "REQUIRES":
+		return model.COMPATIBILITY_RULE_TYPE_REQUIRES
+	case "EXCLUDES":
+		return model.COMPATIBILITY_RULE_TYPE_EXCLUDES
+	case "COMPATIBLE_WITH":
+		return model.COMPATIBILITY_RULE_TYPE_COMPATIBLE_WITH
+	default:
+		return model.COMPATIBILITY_RULE_TYPE_UNSPECIFIED
+	}
+}
+
+func RuleTargetToRepo(target model.RuleTarget) repoModel.RuleTarget {
+	result := repoModel.RuleTarget{PartUuid: target.PartUuid}
+	if target.Category != model.CATEGORY_UNSPECIFIED {
+		result.Category = CategoryToRepoModel(target.Category)
+	}
+	return result
+}
+
+func RuleTargetToModel(target repoModel.RuleTarget) model.RuleTarget {
+	return model.RuleTarget{
+		PartUuid: target.PartUuid,
+		Category: CategoryToModel(target.Category),
+	}
+}
+
+func CompatibilityRuleToRepoModel(rule *model.CompatibilityRule) *repoModel.CompatibilityRule {
+	return &repoModel.CompatibilityRule{
+		ID:          rule.Uuid,
+		Uuid:        rule.Uuid,
+		Type:        CompatibilityRuleTypeToRepo(rule.Type),
+		Subject:     RuleTargetToRepo(rule.Subject),
+		Object:      RuleTargetToRepo(rule.Object),
+		Description: rule.Description,
+		CreatedAt:   rule.CreatedAt,
+	}
+}
+
+func CompatibilityRuleToModel(rule *repoModel.CompatibilityRule) *model.CompatibilityRule {
+	return &model.CompatibilityRule{
+		Uuid:        rule.Uuid,
+		Type:        CompatibilityRuleTypeToModel(rule.Type),
+		Subject:     RuleTargetToModel(rule.Subject),
+		Object:      RuleTargetToModel(rule.Object),
+		Description: rule.Description,
+		CreatedAt:   rule.CreatedAt,
+	}
+}
+
+func CompatibilityRulesToModel(rules []*repoModel.CompatibilityRule) []*model.CompatibilityRule {
+	result := make([]*model.CompatibilityRule, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, CompatibilityRuleToModel(rule))
+	}
+	return result
+}
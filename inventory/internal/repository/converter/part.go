@@ -5,34 +5,13 @@ import (
 	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
 )
 
+// CategoryToRepoModel возвращает код категории, под которым она хранится в документе детали
 func CategoryToRepoModel(cat model.Category) string {
-	switch cat {
-	case model.CATEGORY_ENGINE:
-		return "ENGINE"
-	case model.CATEGORY_FUEL:
-		return "FUEL"
-	case model.CATEGORY_PORTHOLE:
-		return "PORTHOLE"
-	case model.CATEGORY_WING:
-		return "WING"
-	default:
-		return "UNSPECIFIED"
-	}
+	return string(cat)
 }
 
 func CategoryToModel(s string) model.Category {
-	switch s {
-	case "ENGINE":
-		return model.CATEGORY_ENGINE
-	case "FUEL":
-		return model.CATEGORY_FUEL
-	case "PORTHOLE":
-		return model.CATEGORY_PORTHOLE
-	case "WING":
-		return model.CATEGORY_WING
-	default:
-		return model.CATEGORY_UNSPECIFIED
-	}
+	return model.Category(s)
 }
 
 func PartsToModel(repoParts []*repoModel.Part) []*model.Part {
@@ -103,6 +82,12 @@ func PartToModel(part *repoModel.Part) *model.Part {
 		Metadata:      part.Metadata,
 		CreatedAt:     part.CreatedAt,
 		UpdatedAt:     part.UpdatedAt,
+
+		ReorderThreshold: part.ReorderThreshold,
+		StockLow:         part.StockLow,
+		Attachments:      AttachmentsToModel(part.Uuid, part.Attachments),
+		StockLocations:   StockLocationsToModel(part.StockLocations),
+		Version:          part.Version,
 	}
 }
 
@@ -149,6 +134,12 @@ func PartToRepoModel(part *model.Part) *repoModel.Part {
 		Metadata:      part.Metadata,
 		CreatedAt:     part.CreatedAt,
 		UpdatedAt:     part.UpdatedAt,
+
+		ReorderThreshold: part.ReorderThreshold,
+		StockLow:         part.StockLow,
+		Attachments:      AttachmentsToRepoModel(part.Attachments),
+		StockLocations:   StockLocationsToRepoModel(part.StockLocations),
+		Version:          part.Version,
 	}
 }
 
@@ -0,0 +1,67 @@
+package converter
+
+import (
+	"slices"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
+)
+
+// PartChangeToModel переводит событие change stream в изменение детали.
+// Мягкое удаление приходит как update с deleted_at и считается удалением,
+// изменения уже удаленных деталей и служебные события дают PART_CHANGE_TYPE_UNSPECIFIED
+func PartChangeToModel(event *repoModel.PartChangeEvent, resumeToken []byte) *model.PartChange {
+	change := &model.PartChange{
+		OccurredAt:  event.WallTime,
+		ResumeToken: resumeToken,
+	}
+
+	switch event.OperationType {
+	case "insert":
+		if event.FullDocument == nil {
+			return change
+		}
+		change.Type = model.PART_CHANGE_TYPE_CREATED
+		change.PartUuid = event.FullDocument.Uuid
+		change.Part = PartToModel(event.FullDocument)
+	case "update", "replace":
+		// Документ удалили из коллекции до чтения события — удаление придет следующим событием
+		if event.FullDocument == nil {
+			return change
+		}
+		fields := updatedFields(event.UpdateDescription)
+		change.PartUuid = event.FullDocument.Uuid
+		switch {
+		case event.FullDocument.DeletedAt == nil:
+			change.Type = model.PART_CHANGE_TYPE_UPDATED
+			change.Part = PartToModel(event.FullDocument)
+			change.UpdatedFields = fields
+		case slices.Contains(fields, "deleted_at"):
+			change.Type = model.PART_CHANGE_TYPE_DELETED
+		}
+	case "delete":
+		// UUID удаленного документа известен только из pre-image
+		if event.FullDocumentBeforeChange == nil || event.FullDocumentBeforeChange.DeletedAt != nil {
+			return change
+		}
+		change.Type = model.PART_CHANGE_TYPE_DELETED
+		change.PartUuid = event.FullDocumentBeforeChange.Uuid
+	}
+
+	return change
+}
+
+func updatedFields(description *repoModel.UpdateDescription) []string {
+	if description == nil {
+		return nil
+	}
+
+	var fields []string
+	elements, err := description.UpdatedFields.Elements()
+	if err == nil {
+		for _, element := range elements {
+			fields = append(fields, element.Key())
+		}
+	}
+	return append(fields, description.RemovedFields...)
+}
@@ -0,0 +1,39 @@
+package converter
+
+import (
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
+)
+
+func PriceChangeToRepoModel(change *model.PriceChange) *repoModel.PriceChange {
+	return &repoModel.PriceChange{
+		ID:            change.Uuid,
+		Uuid:          change.Uuid,
+		PartUuid:      change.PartUuid,
+		Price:         change.Price,
+		EffectiveFrom: change.EffectiveFrom,
+		Reason:        change.Reason,
+		Applied:       change.Applied,
+		CreatedAt:     change.CreatedAt,
+	}
+}
+
+func PriceChangeToModel(change *repoModel.PriceChange) *model.PriceChange {
+	return &model.PriceChange{
+		Uuid:          change.Uuid,
+		PartUuid:      change.PartUuid,
+		Price:         change.Price,
+		EffectiveFrom: change.EffectiveFrom,
+		Reason:        change.Reason,
+		Applied:       change.Applied,
+		CreatedAt:     change.CreatedAt,
+	}
+}
+
+func PriceChangesToModel(changes []*repoModel.PriceChange) []*model.PriceChange {
+	result := make([]*model.PriceChange, 0, len(changes))
+	for _, change := range changes {
+		result = append(result, PriceChangeToModel(change))
+	}
+	return result
+}
@@ -0,0 +1,53 @@
+package converter
+
+import (
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
+)
+
+func RocketModelToRepoModel(rocketModel *model.RocketModel) *repoModel.RocketModel {
+	items := make([]repoModel.BomItem, 0, len(rocketModel.Items))
+	for _, item := range rocketModel.Items {
+		items = append(items, repoModel.BomItem{
+			PartUuid:     item.PartUuid,
+			Quantity:     item.Quantity,
+			Alternatives: item.Alternatives,
+		})
+	}
+
+	return &repoModel.RocketModel{
+		ID:          rocketModel.Uuid,
+		Uuid:        rocketModel.Uuid,
+		Name:        rocketModel.Name,
+		Description: rocketModel.Description,
+		Items:       items,
+		CreatedAt:   rocketModel.CreatedAt,
+	}
+}
+
+func RocketModelToModel(rocketModel *repoModel.RocketModel) *model.RocketModel {
+	items := make([]model.BomItem, 0, len(rocketModel.Items))
+	for _, item := range rocketModel.Items {
+		items = append(items, model.BomItem{
+			PartUuid:     item.PartUuid,
+			Quantity:     item.Quantity,
+			Alternatives: item.Alternatives,
+		})
+	}
+
+	return &model.RocketModel{
+		Uuid:        rocketModel.Uuid,
+		Name:        rocketModel.Name,
+		Description: rocketModel.Description,
+		Items:       items,
+		CreatedAt:   rocketModel.CreatedAt,
+	}
+}
+
+func RocketModelsToModel(rocketModels []*repoModel.RocketModel) []*model.RocketModel {
+	result := make([]*model.RocketModel, 0, len(rocketModels))
+	for _, rocketModel := range rocketModels {
+		result = append(result, RocketModelToModel(rocketModel))
+	}
+	return result
+}
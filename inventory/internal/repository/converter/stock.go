@@ -0,0 +1,81 @@
+package converter
+
+import (
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
+)
+
+func StockMovementTypeToRepo(t model.StockMovementType) string {
+	switch t {
+	case model.STOCK_MOVEMENT_TYPE_RECEIPT:
+		return "RECEIPT"
+	case model.STOCK_MOVEMENT_TYPE_RESERVATION:
+		return "RESERVATION"
+	case model.STOCK_MOVEMENT_TYPE_RELEASE:
+		return "RELEASE"
+	case model.STOCK_MOVEMENT_TYPE_CONSUMPTION:
+		return "CONSUMPTION"
+	case model.STOCK_MOVEMENT_TYPE_ADJUSTMENT:
+		return "ADJUSTMENT"
+	case model.STOCK_MOVEMENT_TYPE_TRANSFER:
+		return "TRANSFER"
+	default:
+		return "UNSPECIFIED"
+	}
+}
+
+func StockMovementTypeToModel(s string) model.StockMovementType {
+	switch s {
+	case "RECEIPT":
+		return model.STOCK_MOVEMENT_TYPE_RECEIPT
+	case "RESERVATION":
+		return model.STOCK_MOVEMENT_TYPE_RESERVATION
+	case "RELEASE":
+		return model.STOCK_MOVEMENT_TYPE_RELEASE
+	case "CONSUMPTION":
+		return model.STOCK_MOVEMENT_TYPE_CONSUMPTION
+	case "ADJUSTMENT":
+		return model.STOCK_MOVEMENT_TYPE_ADJUSTMENT
+	case "TRANSFER":
+		return model.STOCK_MOVEMENT_TYPE_TRANSFER
+	default:
+		return model.STOCK_MOVEMENT_TYPE_UNSPECIFIED
+	}
+}
+
+func StockMovementToRepoModel(movement *model.StockMovement) *repoModel.StockMovement {
+	return &repoModel.StockMovement{
+		ID:            movement.Uuid,
+		Uuid:          movement.Uuid,
+		PartUuid:      movement.PartUuid,
+		WarehouseUuid: movement.WarehouseUuid,
+		Type:          StockMovementTypeToRepo(movement.Type),
+		Quantity:      movement.Quantity,
+		Reason:        movement.Reason,
+		Reference:     movement.Reference,
+		StockAfter:    movement.StockAfter,
+		CreatedAt:     movement.CreatedAt,
+	}
+}
+
+func StockMovementToModel(movement *repoModel.StockMovement) *model.StockMovement {
+	return &model.StockMovement{
+		Uuid:          movement.Uuid,
+		PartUuid:      movement.PartUuid,
+		WarehouseUuid: movement.WarehouseUuid,
+		Type:          StockMovementTypeToModel(movement.Type),
+		Quantity:      movement.Quantity,
+		Reason:        movement.Reason,
+		Reference:     movement.Reference,
+		StockAfter:    movement.StockAfter,
+		CreatedAt:     movement.CreatedAt,
+	}
+}
+
+func StockMovementsToModel(movements []*repoModel.StockMovement) []*model.StockMovement {
+	result := make([]*model.StockMovement, 0, len(movements))
+	for _, movement := range movements {
+		result = append(result, StockMovementToModel(movement))
+	}
+	return result
+}
@@ -0,0 +1,69 @@
+package converter
+
+import (
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
+)
+
+func WarehouseToRepoModel(warehouse *model.Warehouse) *repoModel.Warehouse {
+	return &repoModel.Warehouse{
+		ID:        warehouse.Uuid,
+		Uuid:      warehouse.Uuid,
+		Code:      warehouse.Code,
+		Name:      warehouse.Name,
+		Latitude:  warehouse.Location.Latitude,
+		Longitude: warehouse.Location.Longitude,
+		CreatedAt: warehouse.CreatedAt,
+	}
+}
+
+func WarehouseToModel(warehouse *repoModel.Warehouse) *model.Warehouse {
+	return &model.Warehouse{
+		Uuid: warehouse.Uuid,
+		Code: warehouse.Code,
+		Name: warehouse.Name,
+		Location: model.GeoPoint{
+			Latitude:  warehouse.Latitude,
+			Longitude: warehouse.Longitude,
+		},
+		CreatedAt: warehouse.CreatedAt,
+	}
+}
+
+func WarehousesToModel(warehouses []*repoModel.Warehouse) []*model.Warehouse {
+	result := make([]*model.Warehouse, 0, len(warehouses))
+	for _, warehouse := range warehouses {
+		result = append(result, WarehouseToModel(warehouse))
+	}
+	return result
+}
+
+func StockLocationsToRepoModel(locations []*model.StockLocation) []*repoModel.StockLocation {
+	if locations == nil {
+		return nil
+	}
+
+	result := make([]*repoModel.StockLocation, 0, len(locations))
+	for _, location := range locations {
+		result = append(result, &repoModel.StockLocation{
+			WarehouseUuid: location.WarehouseUuid,
+			Quantity:      location.Quantity,
+		})
+	}
+	return result
+}
+
+func StockLocationsToModel(locations []*repoModel.StockLocation) []*model.StockLocation {
+	if locations == nil {
+		return nil
+	}
+
+	result := make([]*model.StockLocation, 0, len(locations))
+	for _, location := range locations {
+		result = append(result, &model.StockLocation{
+			WarehouseUuid: location.WarehouseUuid,
+			Quantity:      location.Quantity,
+		})
+	}
+	return result
+}
@@ -0,0 +1,348 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+// AttachmentRepository is an autogenerated mock type for the AttachmentRepository type
+type AttachmentRepository struct {
+	mock.Mock
+}
+
+type AttachmentRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AttachmentRepository) EXPECT() *AttachmentRepository_Expecter {
+	return &AttachmentRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddAttachment provides a mock function with given fields: ctx, attachment
+func (_m *AttachmentRepository) AddAttachment(ctx context.Context, attachment *model.Attachment) error {
+	ret := _m.Called(ctx, attachment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAttachment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Attachment) error); ok {
+		r0 = rf(ctx, attachment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AttachmentRepository_AddAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAttachment'
+type AttachmentRepository_AddAttachment_Call struct {
+	*mock.Call
+}
+
+// AddAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - attachment *model.Attachment
+func (_e *AttachmentRepository_Expecter) AddAttachment(ctx interface{}, attachment interface{}) *AttachmentRepository_AddAttachment_Call {
+	return &AttachmentRepository_AddAttachment_Call{Call: _e.mock.On("AddAttachment", ctx, attachment)}
+}
+
+func (_c *AttachmentRepository_AddAttachment_Call) Run(run func(ctx context.Context, attachment *model.Attachment)) *AttachmentRepository_AddAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Attachment))
+	})
+	return _c
+}
+
+func (_c *AttachmentRepository_AddAttachment_Call) Return(_a0 error) *AttachmentRepository_AddAttachment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AttachmentRepository_AddAttachment_Call) RunAndReturn(run func(context.Context, *model.Attachment) error) *AttachmentRepository_AddAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteFile provides a mock function with given fields: ctx, uuid
+func (_m *AttachmentRepository) DeleteFile(ctx context.Context, uuid string) error {
+	ret := _m.Called(ctx, uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteFile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, uuid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AttachmentRepository_DeleteFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteFile'
+type AttachmentRepository_DeleteFile_Call struct {
+	*mock.Call
+}
+
+// DeleteFile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+func (_e *AttachmentRepository_Expecter) DeleteFile(ctx interface{}, uuid interface{}) *AttachmentRepository_DeleteFile_Call {
+	return &AttachmentRepository_DeleteFile_Call{Call: _e.mock.On("DeleteFile", ctx, uuid)}
+}
+
+func (_c *AttachmentRepository_DeleteFile_Call) Run(run func(ctx context.Context, uuid string)) *AttachmentRepository_DeleteFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AttachmentRepository_DeleteFile_Call) Return(_a0 error) *AttachmentRepository_DeleteFile_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AttachmentRepository_DeleteFile_Call) RunAndReturn(run func(context.Context, string) error) *AttachmentRepository_DeleteFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAttachment provides a mock function with given fields: ctx, uuid
+func (_m *AttachmentRepository) GetAttachment(ctx context.Context, uuid string) (*model.Attachment, error) {
+	ret := _m.Called(ctx, uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAttachment")
+	}
+
+	var r0 *model.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Attachment, error)); ok {
+		return rf(ctx, uuid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Attachment); ok {
+		r0 = rf(ctx, uuid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, uuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AttachmentRepository_GetAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAttachment'
+type AttachmentRepository_GetAttachment_Call struct {
+	*mock.Call
+}
+
+// GetAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+func (_e *AttachmentRepository_Expecter) GetAttachment(ctx interface{}, uuid interface{}) *AttachmentRepository_GetAttachment_Call {
+	return &AttachmentRepository_GetAttachment_Call{Call: _e.mock.On("GetAttachment", ctx, uuid)}
+}
+
+func (_c *AttachmentRepository_GetAttachment_Call) Run(run func(ctx context.Context, uuid string)) *AttachmentRepository_GetAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AttachmentRepository_GetAttachment_Call) Return(_a0 *model.Attachment, _a1 error) *AttachmentRepository_GetAttachment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AttachmentRepository_GetAttachment_Call) RunAndReturn(run func(context.Context, string) (*model.Attachment, error)) *AttachmentRepository_GetAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenFile provides a mock function with given fields: ctx, uuid
+func (_m *AttachmentRepository) OpenFile(ctx context.Context, uuid string) (io.ReadCloser, error) {
+	ret := _m.Called(ctx, uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenFile")
+	}
+
+	var r0 io.ReadCloser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (io.ReadCloser, error)); ok {
+		return rf(ctx, uuid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) io.ReadCloser); ok {
+		r0 = rf(ctx, uuid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, uuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AttachmentRepository_OpenFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenFile'
+type AttachmentRepository_OpenFile_Call struct {
+	*mock.Call
+}
+
+// OpenFile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+func (_e *AttachmentRepository_Expecter) OpenFile(ctx interface{}, uuid interface{}) *AttachmentRepository_OpenFile_Call {
+	return &AttachmentRepository_OpenFile_Call{Call: _e.mock.On("OpenFile", ctx, uuid)}
+}
+
+func (_c *AttachmentRepository_OpenFile_Call) Run(run func(ctx context.Context, uuid string)) *AttachmentRepository_OpenFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AttachmentRepository_OpenFile_Call) Return(_a0 io.ReadCloser, _a1 error) *AttachmentRepository_OpenFile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AttachmentRepository_OpenFile_Call) RunAndReturn(run func(context.Context, string) (io.ReadCloser, error)) *AttachmentRepository_OpenFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveAttachment provides a mock function with given fields: ctx, attachment
+func (_m *AttachmentRepository) RemoveAttachment(ctx context.Context, attachment *model.Attachment) error {
+	ret := _m.Called(ctx, attachment)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveAttachment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Attachment) error); ok {
+		r0 = rf(ctx, attachment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AttachmentRepository_RemoveAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveAttachment'
+type AttachmentRepository_RemoveAttachment_Call struct {
+	*mock.Call
+}
+
+// RemoveAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - attachment *model.Attachment
+func (_e *AttachmentRepository_Expecter) RemoveAttachment(ctx interface{}, attachment interface{}) *AttachmentRepository_RemoveAttachment_Call {
+	return &AttachmentRepository_RemoveAttachment_Call{Call: _e.mock.On("RemoveAttachment", ctx, attachment)}
+}
+
+func (_c *AttachmentRepository_RemoveAttachment_Call) Run(run func(ctx context.Context, attachment *model.Attachment)) *AttachmentRepository_RemoveAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Attachment))
+	})
+	return _c
+}
+
+func (_c *AttachmentRepository_RemoveAttachment_Call) Return(_a0 error) *AttachmentRepository_RemoveAttachment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AttachmentRepository_RemoveAttachment_Call) RunAndReturn(run func(context.Context, *model.Attachment) error) *AttachmentRepository_RemoveAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadFile provides a mock function with given fields: ctx, attachment, content
+func (_m *AttachmentRepository) UploadFile(ctx context.Context, attachment *model.Attachment, content io.Reader) error {
+	ret := _m.Called(ctx, attachment, content)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadFile")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Attachment, io.Reader) error); ok {
+		r0 = rf(ctx, attachment, content)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AttachmentRepository_UploadFile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadFile'
+type AttachmentRepository_UploadFile_Call struct {
+	*mock.Call
+}
+
+// UploadFile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - attachment *model.Attachment
+//   - content io.Reader
+func (_e *AttachmentRepository_Expecter) UploadFile(ctx interface{}, attachment interface{}, content interface{}) *AttachmentRepository_UploadFile_Call {
+	return &AttachmentRepository_UploadFile_Call{Call: _e.mock.On("UploadFile", ctx, attachment, content)}
+}
+
+func (_c *AttachmentRepository_UploadFile_Call) Run(run func(ctx context.Context, attachment *model.Attachment, content io.Reader)) *AttachmentRepository_UploadFile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Attachment), args[2].(io.Reader))
+	})
+	return _c
+}
+
+func (_c *AttachmentRepository_UploadFile_Call) Return(_a0 error) *AttachmentRepository_UploadFile_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AttachmentRepository_UploadFile_Call) RunAndReturn(run func(context.Context, *model.Attachment, io.Reader) error) *AttachmentRepository_UploadFile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAttachmentRepository creates a new instance of AttachmentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAttachmentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AttachmentRepository {
+	mock := &AttachmentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
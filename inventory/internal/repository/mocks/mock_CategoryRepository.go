@@ -0,0 +1,330 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CategoryRepository is an autogenerated mock type for the CategoryRepository type
+type CategoryRepository struct {
+	mock.Mock
+}
+
+type CategoryRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CategoryRepository) EXPECT() *CategoryRepository_Expecter {
+	return &CategoryRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateCategory provides a mock function with given fields: ctx, category
+func (_m *CategoryRepository) CreateCategory(ctx context.Context, category *model.PartCategory) error {
+	ret := _m.Called(ctx, category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PartCategory) error); ok {
+		r0 = rf(ctx, category)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CategoryRepository_CreateCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCategory'
+type CategoryRepository_CreateCategory_Call struct {
+	*mock.Call
+}
+
+// CreateCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category *model.PartCategory
+func (_e *CategoryRepository_Expecter) CreateCategory(ctx interface{}, category interface{}) *CategoryRepository_CreateCategory_Call {
+	return &CategoryRepository_CreateCategory_Call{Call: _e.mock.On("CreateCategory", ctx, category)}
+}
+
+func (_c *CategoryRepository_CreateCategory_Call) Run(run func(ctx context.Context, category *model.PartCategory)) *CategoryRepository_CreateCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.PartCategory))
+	})
+	return _c
+}
+
+func (_c *CategoryRepository_CreateCategory_Call) Return(_a0 error) *CategoryRepository_CreateCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CategoryRepository_CreateCategory_Call) RunAndReturn(run func(context.Context, *model.PartCategory) error) *CategoryRepository_CreateCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCategory provides a mock function with given fields: ctx, code
+func (_m *CategoryRepository) DeleteCategory(ctx context.Context, code model.Category) error {
+	ret := _m.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Category) error); ok {
+		r0 = rf(ctx, code)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CategoryRepository_DeleteCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCategory'
+type CategoryRepository_DeleteCategory_Call struct {
+	*mock.Call
+}
+
+// DeleteCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code model.Category
+func (_e *CategoryRepository_Expecter) DeleteCategory(ctx interface{}, code interface{}) *CategoryRepository_DeleteCategory_Call {
+	return &CategoryRepository_DeleteCategory_Call{Call: _e.mock.On("DeleteCategory", ctx, code)}
+}
+
+func (_c *CategoryRepository_DeleteCategory_Call) Run(run func(ctx context.Context, code model.Category)) *CategoryRepository_DeleteCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Category))
+	})
+	return _c
+}
+
+func (_c *CategoryRepository_DeleteCategory_Call) Return(_a0 error) *CategoryRepository_DeleteCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CategoryRepository_DeleteCategory_Call) RunAndReturn(run func(context.Context, model.Category) error) *CategoryRepository_DeleteCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategory provides a mock function with given fields: ctx, code
+func (_m *CategoryRepository) GetCategory(ctx context.Context, code model.Category) (*model.PartCategory, error) {
+	ret := _m.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategory")
+	}
+
+	var r0 *model.PartCategory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Category) (*model.PartCategory, error)); ok {
+		return rf(ctx, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Category) *model.PartCategory); ok {
+		r0 = rf(ctx, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PartCategory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Category) error); ok {
+		r1 = rf(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CategoryRepository_GetCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategory'
+type CategoryRepository_GetCategory_Call struct {
+	*mock.Call
+}
+
+// GetCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code model.Category
+func (_e *CategoryRepository_Expecter) GetCategory(ctx interface{}, code interface{}) *CategoryRepository_GetCategory_Call {
+	return &CategoryRepository_GetCategory_Call{Call: _e.mock.On("GetCategory", ctx, code)}
+}
+
+func (_c *CategoryRepository_GetCategory_Call) Run(run func(ctx context.Context, code model.Category)) *CategoryRepository_GetCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Category))
+	})
+	return _c
+}
+
+func (_c *CategoryRepository_GetCategory_Call) Return(_a0 *model.PartCategory, _a1 error) *CategoryRepository_GetCategory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CategoryRepository_GetCategory_Call) RunAndReturn(run func(context.Context, model.Category) (*model.PartCategory, error)) *CategoryRepository_GetCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InitTestData provides a mock function with given fields: ctx
+func (_m *CategoryRepository) InitTestData(ctx context.Context) {
+	_m.Called(ctx)
+}
+
+// CategoryRepository_InitTestData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InitTestData'
+type CategoryRepository_InitTestData_Call struct {
+	*mock.Call
+}
+
+// InitTestData is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *CategoryRepository_Expecter) InitTestData(ctx interface{}) *CategoryRepository_InitTestData_Call {
+	return &CategoryRepository_InitTestData_Call{Call: _e.mock.On("InitTestData", ctx)}
+}
+
+func (_c *CategoryRepository_InitTestData_Call) Run(run func(ctx context.Context)) *CategoryRepository_InitTestData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *CategoryRepository_InitTestData_Call) Return() *CategoryRepository_InitTestData_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *CategoryRepository_InitTestData_Call) RunAndReturn(run func(context.Context)) *CategoryRepository_InitTestData_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ListCategories provides a mock function with given fields: ctx
+func (_m *CategoryRepository) ListCategories(ctx context.Context) ([]*model.PartCategory, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCategories")
+	}
+
+	var r0 []*model.PartCategory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.PartCategory, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.PartCategory); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PartCategory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CategoryRepository_ListCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCategories'
+type CategoryRepository_ListCategories_Call struct {
+	*mock.Call
+}
+
+// ListCategories is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *CategoryRepository_Expecter) ListCategories(ctx interface{}) *CategoryRepository_ListCategories_Call {
+	return &CategoryRepository_ListCategories_Call{Call: _e.mock.On("ListCategories", ctx)}
+}
+
+func (_c *CategoryRepository_ListCategories_Call) Run(run func(ctx context.Context)) *CategoryRepository_ListCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *CategoryRepository_ListCategories_Call) Return(_a0 []*model.PartCategory, _a1 error) *CategoryRepository_ListCategories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CategoryRepository_ListCategories_Call) RunAndReturn(run func(context.Context) ([]*model.PartCategory, error)) *CategoryRepository_ListCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCategory provides a mock function with given fields: ctx, category
+func (_m *CategoryRepository) UpdateCategory(ctx context.Context, category *model.PartCategory) error {
+	ret := _m.Called(ctx, category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PartCategory) error); ok {
+		r0 = rf(ctx, category)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CategoryRepository_UpdateCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCategory'
+type CategoryRepository_UpdateCategory_Call struct {
+	*mock.Call
+}
+
+// UpdateCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category *model.PartCategory
+func (_e *CategoryRepository_Expecter) UpdateCategory(ctx interface{}, category interface{}) *CategoryRepository_UpdateCategory_Call {
+	return &CategoryRepository_UpdateCategory_Call{Call: _e.mock.On("UpdateCategory", ctx, category)}
+}
+
+func (_c *CategoryRepository_UpdateCategory_Call) Run(run func(ctx context.Context, category *model.PartCategory)) *CategoryRepository_UpdateCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.PartCategory))
+	})
+	return _c
+}
+
+func (_c *CategoryRepository_UpdateCategory_Call) Return(_a0 error) *CategoryRepository_UpdateCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CategoryRepository_UpdateCategory_Call) RunAndReturn(run func(context.Context, *model.PartCategory) error) *CategoryRepository_UpdateCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCategoryRepository creates a new instance of CategoryRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCategoryRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CategoryRepository {
+	mock := &CategoryRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,224 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CompatibilityRepository is an autogenerated mock type for the CompatibilityRepository type
+type CompatibilityRepository struct {
+	mock.Mock
+}
+
+type CompatibilityRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CompatibilityRepository) EXPECT() *CompatibilityRepository_Expecter {
+	return &CompatibilityRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateRule provides a mock function with given fields: ctx, rule
+func (_m *CompatibilityRepository) CreateRule(ctx context.Context, rule *model.CompatibilityRule) error {
+	ret := _m.Called(ctx, rule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CompatibilityRule) error); ok {
+		r0 = rf(ctx, rule)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CompatibilityRepository_CreateRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRule'
+type CompatibilityRepository_CreateRule_Call struct {
+	*mock.Call
+}
+
+// CreateRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rule *model.CompatibilityRule
+func (_e *CompatibilityRepository_Expecter) CreateRule(ctx interface{}, rule interface{}) *CompatibilityRepository_CreateRule_Call {
+	return &CompatibilityRepository_CreateRule_Call{Call: _e.mock.On("CreateRule", ctx, rule)}
+}
+
+func (_c *CompatibilityRepository_CreateRule_Call) Run(run func(ctx context.Context, rule *model.CompatibilityRule)) *CompatibilityRepository_CreateRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.CompatibilityRule))
+	})
+	return _c
+}
+
+func (_c *CompatibilityRepository_CreateRule_Call) Return(_a0 error) *CompatibilityRepository_CreateRule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CompatibilityRepository_CreateRule_Call) RunAndReturn(run func(context.Context, *model.CompatibilityRule) error) *CompatibilityRepository_CreateRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRule provides a mock function with given fields: ctx, uuid
+func (_m *CompatibilityRepository) DeleteRule(ctx context.Context, uuid string) error {
+	ret := _m.Called(ctx, uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, uuid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CompatibilityRepository_DeleteRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRule'
+type CompatibilityRepository_DeleteRule_Call struct {
+	*mock.Call
+}
+
+// DeleteRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+func (_e *CompatibilityRepository_Expecter) DeleteRule(ctx interface{}, uuid interface{}) *CompatibilityRepository_DeleteRule_Call {
+	return &CompatibilityRepository_DeleteRule_Call{Call: _e.mock.On("DeleteRule", ctx, uuid)}
+}
+
+func (_c *CompatibilityRepository_DeleteRule_Call) Run(run func(ctx context.Context, uuid string)) *CompatibilityRepository_DeleteRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CompatibilityRepository_DeleteRule_Call) Return(_a0 error) *CompatibilityRepository_DeleteRule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CompatibilityRepository_DeleteRule_Call) RunAndReturn(run func(context.Context, string) error) *CompatibilityRepository_DeleteRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InitTestData provides a mock function with given fields: ctx
+func (_m *CompatibilityRepository) InitTestData(ctx context.Context) {
+	_m.Called(ctx)
+}
+
+// CompatibilityRepository_InitTestData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InitTestData'
+type CompatibilityRepository_InitTestData_Call struct {
+	*mock.Call
+}
+
+// InitTestData is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *CompatibilityRepository_Expecter) InitTestData(ctx interface{}) *CompatibilityRepository_InitTestData_Call {
+	return &CompatibilityRepository_InitTestData_Call{Call: _e.mock.On("InitTestData", ctx)}
+}
+
+func (_c *CompatibilityRepository_InitTestData_Call) Run(run func(ctx context.Context)) *CompatibilityRepository_InitTestData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *CompatibilityRepository_InitTestData_Call) Return() *CompatibilityRepository_InitTestData_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *CompatibilityRepository_InitTestData_Call) RunAndReturn(run func(context.Context)) *CompatibilityRepository_InitTestData_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ListRules provides a mock function with given fields: ctx
+func (_m *CompatibilityRepository) ListRules(ctx context.Context) ([]*model.CompatibilityRule, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRules")
+	}
+
+	var r0 []*model.CompatibilityRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.CompatibilityRule, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.CompatibilityRule); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CompatibilityRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CompatibilityRepository_ListRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRules'
+type CompatibilityRepository_ListRules_Call struct {
+	*mock.Call
+}
+
+// ListRules is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *CompatibilityRepository_Expecter) ListRules(ctx interface{}) *CompatibilityRepository_ListRules_Call {
+	return &CompatibilityRepository_ListRules_Call{Call: _e.mock.On("ListRules", ctx)}
+}
+
+func (_c *CompatibilityRepository_ListRules_Call) Run(run func(ctx context.Context)) *CompatibilityRepository_ListRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *CompatibilityRepository_ListRules_Call) Return(_a0 []*model.CompatibilityRule, _a1 error) *CompatibilityRepository_ListRules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CompatibilityRepository_ListRules_Call) RunAndReturn(run func(context.Context) ([]*model.CompatibilityRule, error)) *CompatibilityRepository_ListRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCompatibilityRepository creates a new instance of CompatibilityRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCompatibilityRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CompatibilityRepository {
+	mock := &CompatibilityRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,205 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	repository "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PartChangeRepository is an autogenerated mock type for the PartChangeRepository type
+type PartChangeRepository struct {
+	mock.Mock
+}
+
+type PartChangeRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PartChangeRepository) EXPECT() *PartChangeRepository_Expecter {
+	return &PartChangeRepository_Expecter{mock: &_m.Mock}
+}
+
+// GetResumeToken provides a mock function with given fields: ctx, name
+func (_m *PartChangeRepository) GetResumeToken(ctx context.Context, name string) ([]byte, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetResumeToken")
+	}
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]byte, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []byte); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartChangeRepository_GetResumeToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetResumeToken'
+type PartChangeRepository_GetResumeToken_Call struct {
+	*mock.Call
+}
+
+// GetResumeToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *PartChangeRepository_Expecter) GetResumeToken(ctx interface{}, name interface{}) *PartChangeRepository_GetResumeToken_Call {
+	return &PartChangeRepository_GetResumeToken_Call{Call: _e.mock.On("GetResumeToken", ctx, name)}
+}
+
+func (_c *PartChangeRepository_GetResumeToken_Call) Run(run func(ctx context.Context, name string)) *PartChangeRepository_GetResumeToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PartChangeRepository_GetResumeToken_Call) Return(_a0 []byte, _a1 error) *PartChangeRepository_GetResumeToken_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartChangeRepository_GetResumeToken_Call) RunAndReturn(run func(context.Context, string) ([]byte, error)) *PartChangeRepository_GetResumeToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SaveResumeToken provides a mock function with given fields: ctx, name, token
+func (_m *PartChangeRepository) SaveResumeToken(ctx context.Context, name string, token []byte) error {
+	ret := _m.Called(ctx, name, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SaveResumeToken")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) error); ok {
+		r0 = rf(ctx, name, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PartChangeRepository_SaveResumeToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SaveResumeToken'
+type PartChangeRepository_SaveResumeToken_Call struct {
+	*mock.Call
+}
+
+// SaveResumeToken is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - token []byte
+func (_e *PartChangeRepository_Expecter) SaveResumeToken(ctx interface{}, name interface{}, token interface{}) *PartChangeRepository_SaveResumeToken_Call {
+	return &PartChangeRepository_SaveResumeToken_Call{Call: _e.mock.On("SaveResumeToken", ctx, name, token)}
+}
+
+func (_c *PartChangeRepository_SaveResumeToken_Call) Run(run func(ctx context.Context, name string, token []byte)) *PartChangeRepository_SaveResumeToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]byte))
+	})
+	return _c
+}
+
+func (_c *PartChangeRepository_SaveResumeToken_Call) Return(_a0 error) *PartChangeRepository_SaveResumeToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PartChangeRepository_SaveResumeToken_Call) RunAndReturn(run func(context.Context, string, []byte) error) *PartChangeRepository_SaveResumeToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchParts provides a mock function with given fields: ctx, resumeToken
+func (_m *PartChangeRepository) WatchParts(ctx context.Context, resumeToken []byte) (repository.PartChangeStream, error) {
+	ret := _m.Called(ctx, resumeToken)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchParts")
+	}
+
+	var r0 repository.PartChangeStream
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) (repository.PartChangeStream, error)); ok {
+		return rf(ctx, resumeToken)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte) repository.PartChangeStream); ok {
+		r0 = rf(ctx, resumeToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(repository.PartChangeStream)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte) error); ok {
+		r1 = rf(ctx, resumeToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartChangeRepository_WatchParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchParts'
+type PartChangeRepository_WatchParts_Call struct {
+	*mock.Call
+}
+
+// WatchParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - resumeToken []byte
+func (_e *PartChangeRepository_Expecter) WatchParts(ctx interface{}, resumeToken interface{}) *PartChangeRepository_WatchParts_Call {
+	return &PartChangeRepository_WatchParts_Call{Call: _e.mock.On("WatchParts", ctx, resumeToken)}
+}
+
+func (_c *PartChangeRepository_WatchParts_Call) Run(run func(ctx context.Context, resumeToken []byte)) *PartChangeRepository_WatchParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]byte))
+	})
+	return _c
+}
+
+func (_c *PartChangeRepository_WatchParts_Call) Return(_a0 repository.PartChangeStream, _a1 error) *PartChangeRepository_WatchParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartChangeRepository_WatchParts_Call) RunAndReturn(run func(context.Context, []byte) (repository.PartChangeStream, error)) *PartChangeRepository_WatchParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPartChangeRepository creates a new instance of PartChangeRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPartChangeRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PartChangeRepository {
+	mock := &PartChangeRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -9,6 +9,8 @@ import (
 
 	model "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // PartRepository is an autogenerated mock type for the PartRepository type
@@ -24,6 +26,101 @@ func (_m *PartRepository) EXPECT() *PartRepository_Expecter {
 	return &PartRepository_Expecter{mock: &_m.Mock}
 }
 
+// CreatePart provides a mock function with given fields: ctx, part
+func (_m *PartRepository) CreatePart(ctx context.Context, part *model.Part) error {
+	ret := _m.Called(ctx, part)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Part) error); ok {
+		r0 = rf(ctx, part)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PartRepository_CreatePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePart'
+type PartRepository_CreatePart_Call struct {
+	*mock.Call
+}
+
+// CreatePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - part *model.Part
+func (_e *PartRepository_Expecter) CreatePart(ctx interface{}, part interface{}) *PartRepository_CreatePart_Call {
+	return &PartRepository_CreatePart_Call{Call: _e.mock.On("CreatePart", ctx, part)}
+}
+
+func (_c *PartRepository_CreatePart_Call) Run(run func(ctx context.Context, part *model.Part)) *PartRepository_CreatePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Part))
+	})
+	return _c
+}
+
+func (_c *PartRepository_CreatePart_Call) Return(_a0 error) *PartRepository_CreatePart_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PartRepository_CreatePart_Call) RunAndReturn(run func(context.Context, *model.Part) error) *PartRepository_CreatePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePart provides a mock function with given fields: ctx, uuid, deletedAt
+func (_m *PartRepository) DeletePart(ctx context.Context, uuid string, deletedAt time.Time) error {
+	ret := _m.Called(ctx, uuid, deletedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, uuid, deletedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PartRepository_DeletePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePart'
+type PartRepository_DeletePart_Call struct {
+	*mock.Call
+}
+
+// DeletePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+//   - deletedAt time.Time
+func (_e *PartRepository_Expecter) DeletePart(ctx interface{}, uuid interface{}, deletedAt interface{}) *PartRepository_DeletePart_Call {
+	return &PartRepository_DeletePart_Call{Call: _e.mock.On("DeletePart", ctx, uuid, deletedAt)}
+}
+
+func (_c *PartRepository_DeletePart_Call) Run(run func(ctx context.Context, uuid string, deletedAt time.Time)) *PartRepository_DeletePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *PartRepository_DeletePart_Call) Return(_a0 error) *PartRepository_DeletePart_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PartRepository_DeletePart_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *PartRepository_DeletePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPart provides a mock function with given fields: ctx, uuid
 func (_m *PartRepository) GetPart(ctx context.Context, uuid string) (*model.Part, error) {
 	ret := _m.Called(ctx, uuid)
@@ -83,6 +180,66 @@ func (_c *PartRepository_GetPart_Call) RunAndReturn(run func(context.Context, st
 	return _c
 }
 
+// GetParts provides a mock function with given fields: ctx, uuids, fields
+func (_m *PartRepository) GetParts(ctx context.Context, uuids []string, fields []string) ([]*model.Part, error) {
+	ret := _m.Called(ctx, uuids, fields)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetParts")
+	}
+
+	var r0 []*model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, []string) ([]*model.Part, error)); ok {
+		return rf(ctx, uuids, fields)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, []string) []*model.Part); ok {
+		r0 = rf(ctx, uuids, fields)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Part)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, []string) error); ok {
+		r1 = rf(ctx, uuids, fields)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartRepository_GetParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetParts'
+type PartRepository_GetParts_Call struct {
+	*mock.Call
+}
+
+// GetParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuids []string
+//   - fields []string
+func (_e *PartRepository_Expecter) GetParts(ctx interface{}, uuids interface{}, fields interface{}) *PartRepository_GetParts_Call {
+	return &PartRepository_GetParts_Call{Call: _e.mock.On("GetParts", ctx, uuids, fields)}
+}
+
+func (_c *PartRepository_GetParts_Call) Run(run func(ctx context.Context, uuids []string, fields []string)) *PartRepository_GetParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *PartRepository_GetParts_Call) Return(_a0 []*model.Part, _a1 error) *PartRepository_GetParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartRepository_GetParts_Call) RunAndReturn(run func(context.Context, []string, []string) ([]*model.Part, error)) *PartRepository_GetParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // InitTestData provides a mock function with given fields: ctx
 func (_m *PartRepository) InitTestData(ctx context.Context) {
 	_m.Called(ctx)
@@ -116,29 +273,29 @@ func (_c *PartRepository_InitTestData_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
-// ListParts provides a mock function with given fields: ctx, filter
-func (_m *PartRepository) ListParts(ctx context.Context, filter *model.PartsFilter) ([]*model.Part, error) {
-	ret := _m.Called(ctx, filter)
+// ListParts provides a mock function with given fields: ctx, query
+func (_m *PartRepository) ListParts(ctx context.Context, query *model.PartsQuery) (*model.PartsPage, error) {
+	ret := _m.Called(ctx, query)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListParts")
 	}
 
-	var r0 []*model.Part
+	var r0 *model.PartsPage
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.PartsFilter) ([]*model.Part, error)); ok {
-		return rf(ctx, filter)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PartsQuery) (*model.PartsPage, error)); ok {
+		return rf(ctx, query)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.PartsFilter) []*model.Part); ok {
-		r0 = rf(ctx, filter)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PartsQuery) *model.PartsPage); ok {
+		r0 = rf(ctx, query)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Part)
+			r0 = ret.Get(0).(*model.PartsPage)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.PartsFilter) error); ok {
-		r1 = rf(ctx, filter)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.PartsQuery) error); ok {
+		r1 = rf(ctx, query)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -153,24 +310,188 @@ type PartRepository_ListParts_Call struct {
 
 // ListParts is a helper method to define mock.On call
 //   - ctx context.Context
-//   - filter *model.PartsFilter
-func (_e *PartRepository_Expecter) ListParts(ctx interface{}, filter interface{}) *PartRepository_ListParts_Call {
-	return &PartRepository_ListParts_Call{Call: _e.mock.On("ListParts", ctx, filter)}
+//   - query *model.PartsQuery
+func (_e *PartRepository_Expecter) ListParts(ctx interface{}, query interface{}) *PartRepository_ListParts_Call {
+	return &PartRepository_ListParts_Call{Call: _e.mock.On("ListParts", ctx, query)}
 }
 
-func (_c *PartRepository_ListParts_Call) Run(run func(ctx context.Context, filter *model.PartsFilter)) *PartRepository_ListParts_Call {
+func (_c *PartRepository_ListParts_Call) Run(run func(ctx context.Context, query *model.PartsQuery)) *PartRepository_ListParts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*model.PartsFilter))
+		run(args[0].(context.Context), args[1].(*model.PartsQuery))
 	})
 	return _c
 }
 
-func (_c *PartRepository_ListParts_Call) Return(_a0 []*model.Part, _a1 error) *PartRepository_ListParts_Call {
+func (_c *PartRepository_ListParts_Call) Return(_a0 *model.PartsPage, _a1 error) *PartRepository_ListParts_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *PartRepository_ListParts_Call) RunAndReturn(run func(context.Context, *model.PartsFilter) ([]*model.Part, error)) *PartRepository_ListParts_Call {
+func (_c *PartRepository_ListParts_Call) RunAndReturn(run func(context.Context, *model.PartsQuery) (*model.PartsPage, error)) *PartRepository_ListParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchParts provides a mock function with given fields: ctx, search
+func (_m *PartRepository) SearchParts(ctx context.Context, search *model.PartsSearch) ([]*model.PartSearchHit, error) {
+	ret := _m.Called(ctx, search)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchParts")
+	}
+
+	var r0 []*model.PartSearchHit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PartsSearch) ([]*model.PartSearchHit, error)); ok {
+		return rf(ctx, search)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PartsSearch) []*model.PartSearchHit); ok {
+		r0 = rf(ctx, search)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PartSearchHit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.PartsSearch) error); ok {
+		r1 = rf(ctx, search)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartRepository_SearchParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchParts'
+type PartRepository_SearchParts_Call struct {
+	*mock.Call
+}
+
+// SearchParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - search *model.PartsSearch
+func (_e *PartRepository_Expecter) SearchParts(ctx interface{}, search interface{}) *PartRepository_SearchParts_Call {
+	return &PartRepository_SearchParts_Call{Call: _e.mock.On("SearchParts", ctx, search)}
+}
+
+func (_c *PartRepository_SearchParts_Call) Run(run func(ctx context.Context, search *model.PartsSearch)) *PartRepository_SearchParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.PartsSearch))
+	})
+	return _c
+}
+
+func (_c *PartRepository_SearchParts_Call) Return(_a0 []*model.PartSearchHit, _a1 error) *PartRepository_SearchParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartRepository_SearchParts_Call) RunAndReturn(run func(context.Context, *model.PartsSearch) ([]*model.PartSearchHit, error)) *PartRepository_SearchParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetStockLow provides a mock function with given fields: ctx, uuid, low
+func (_m *PartRepository) SetStockLow(ctx context.Context, uuid string, low bool) (bool, error) {
+	ret := _m.Called(ctx, uuid, low)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetStockLow")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) (bool, error)); ok {
+		return rf(ctx, uuid, low)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) bool); ok {
+		r0 = rf(ctx, uuid, low)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = rf(ctx, uuid, low)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartRepository_SetStockLow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetStockLow'
+type PartRepository_SetStockLow_Call struct {
+	*mock.Call
+}
+
+// SetStockLow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+//   - low bool
+func (_e *PartRepository_Expecter) SetStockLow(ctx interface{}, uuid interface{}, low interface{}) *PartRepository_SetStockLow_Call {
+	return &PartRepository_SetStockLow_Call{Call: _e.mock.On("SetStockLow", ctx, uuid, low)}
+}
+
+func (_c *PartRepository_SetStockLow_Call) Run(run func(ctx context.Context, uuid string, low bool)) *PartRepository_SetStockLow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *PartRepository_SetStockLow_Call) Return(_a0 bool, _a1 error) *PartRepository_SetStockLow_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartRepository_SetStockLow_Call) RunAndReturn(run func(context.Context, string, bool) (bool, error)) *PartRepository_SetStockLow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePart provides a mock function with given fields: ctx, part
+func (_m *PartRepository) UpdatePart(ctx context.Context, part *model.Part) error {
+	ret := _m.Called(ctx, part)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Part) error); ok {
+		r0 = rf(ctx, part)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PartRepository_UpdatePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePart'
+type PartRepository_UpdatePart_Call struct {
+	*mock.Call
+}
+
+// UpdatePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - part *model.Part
+func (_e *PartRepository_Expecter) UpdatePart(ctx interface{}, part interface{}) *PartRepository_UpdatePart_Call {
+	return &PartRepository_UpdatePart_Call{Call: _e.mock.On("UpdatePart", ctx, part)}
+}
+
+func (_c *PartRepository_UpdatePart_Call) Run(run func(ctx context.Context, part *model.Part)) *PartRepository_UpdatePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Part))
+	})
+	return _c
+}
+
+func (_c *PartRepository_UpdatePart_Call) Return(_a0 error) *PartRepository_UpdatePart_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PartRepository_UpdatePart_Call) RunAndReturn(run func(context.Context, *model.Part) error) *PartRepository_UpdatePart_Call {
 	_c.Call.Return(run)
 	return _c
 }
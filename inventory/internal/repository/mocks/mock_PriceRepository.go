@@ -0,0 +1,363 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// PriceRepository is an autogenerated mock type for the PriceRepository type
+type PriceRepository struct {
+	mock.Mock
+}
+
+type PriceRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PriceRepository) EXPECT() *PriceRepository_Expecter {
+	return &PriceRepository_Expecter{mock: &_m.Mock}
+}
+
+// AddPriceChange provides a mock function with given fields: ctx, change
+func (_m *PriceRepository) AddPriceChange(ctx context.Context, change *model.PriceChange) error {
+	ret := _m.Called(ctx, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddPriceChange")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PriceChange) error); ok {
+		r0 = rf(ctx, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PriceRepository_AddPriceChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddPriceChange'
+type PriceRepository_AddPriceChange_Call struct {
+	*mock.Call
+}
+
+// AddPriceChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - change *model.PriceChange
+func (_e *PriceRepository_Expecter) AddPriceChange(ctx interface{}, change interface{}) *PriceRepository_AddPriceChange_Call {
+	return &PriceRepository_AddPriceChange_Call{Call: _e.mock.On("AddPriceChange", ctx, change)}
+}
+
+func (_c *PriceRepository_AddPriceChange_Call) Run(run func(ctx context.Context, change *model.PriceChange)) *PriceRepository_AddPriceChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.PriceChange))
+	})
+	return _c
+}
+
+func (_c *PriceRepository_AddPriceChange_Call) Return(_a0 error) *PriceRepository_AddPriceChange_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PriceRepository_AddPriceChange_Call) RunAndReturn(run func(context.Context, *model.PriceChange) error) *PriceRepository_AddPriceChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetEffectivePrices provides a mock function with given fields: ctx, partUUIDs, at
+func (_m *PriceRepository) GetEffectivePrices(ctx context.Context, partUUIDs []string, at time.Time) (map[string]*model.PriceChange, error) {
+	ret := _m.Called(ctx, partUUIDs, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEffectivePrices")
+	}
+
+	var r0 map[string]*model.PriceChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, time.Time) (map[string]*model.PriceChange, error)); ok {
+		return rf(ctx, partUUIDs, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, time.Time) map[string]*model.PriceChange); ok {
+		r0 = rf(ctx, partUUIDs, at)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*model.PriceChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, time.Time) error); ok {
+		r1 = rf(ctx, partUUIDs, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PriceRepository_GetEffectivePrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetEffectivePrices'
+type PriceRepository_GetEffectivePrices_Call struct {
+	*mock.Call
+}
+
+// GetEffectivePrices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partUUIDs []string
+//   - at time.Time
+func (_e *PriceRepository_Expecter) GetEffectivePrices(ctx interface{}, partUUIDs interface{}, at interface{}) *PriceRepository_GetEffectivePrices_Call {
+	return &PriceRepository_GetEffectivePrices_Call{Call: _e.mock.On("GetEffectivePrices", ctx, partUUIDs, at)}
+}
+
+func (_c *PriceRepository_GetEffectivePrices_Call) Run(run func(ctx context.Context, partUUIDs []string, at time.Time)) *PriceRepository_GetEffectivePrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *PriceRepository_GetEffectivePrices_Call) Return(_a0 map[string]*model.PriceChange, _a1 error) *PriceRepository_GetEffectivePrices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PriceRepository_GetEffectivePrices_Call) RunAndReturn(run func(context.Context, []string, time.Time) (map[string]*model.PriceChange, error)) *PriceRepository_GetEffectivePrices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListDuePartUUIDs provides a mock function with given fields: ctx, now
+func (_m *PriceRepository) ListDuePartUUIDs(ctx context.Context, now time.Time) ([]string, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListDuePartUUIDs")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) ([]string, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) []string); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PriceRepository_ListDuePartUUIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListDuePartUUIDs'
+type PriceRepository_ListDuePartUUIDs_Call struct {
+	*mock.Call
+}
+
+// ListDuePartUUIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *PriceRepository_Expecter) ListDuePartUUIDs(ctx interface{}, now interface{}) *PriceRepository_ListDuePartUUIDs_Call {
+	return &PriceRepository_ListDuePartUUIDs_Call{Call: _e.mock.On("ListDuePartUUIDs", ctx, now)}
+}
+
+func (_c *PriceRepository_ListDuePartUUIDs_Call) Run(run func(ctx context.Context, now time.Time)) *PriceRepository_ListDuePartUUIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *PriceRepository_ListDuePartUUIDs_Call) Return(_a0 []string, _a1 error) *PriceRepository_ListDuePartUUIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PriceRepository_ListDuePartUUIDs_Call) RunAndReturn(run func(context.Context, time.Time) ([]string, error)) *PriceRepository_ListDuePartUUIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPriceChanges provides a mock function with given fields: ctx, partUUID
+func (_m *PriceRepository) ListPriceChanges(ctx context.Context, partUUID string) ([]*model.PriceChange, error) {
+	ret := _m.Called(ctx, partUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPriceChanges")
+	}
+
+	var r0 []*model.PriceChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*model.PriceChange, error)); ok {
+		return rf(ctx, partUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.PriceChange); ok {
+		r0 = rf(ctx, partUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PriceChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, partUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PriceRepository_ListPriceChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPriceChanges'
+type PriceRepository_ListPriceChanges_Call struct {
+	*mock.Call
+}
+
+// ListPriceChanges is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partUUID string
+func (_e *PriceRepository_Expecter) ListPriceChanges(ctx interface{}, partUUID interface{}) *PriceRepository_ListPriceChanges_Call {
+	return &PriceRepository_ListPriceChanges_Call{Call: _e.mock.On("ListPriceChanges", ctx, partUUID)}
+}
+
+func (_c *PriceRepository_ListPriceChanges_Call) Run(run func(ctx context.Context, partUUID string)) *PriceRepository_ListPriceChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PriceRepository_ListPriceChanges_Call) Return(_a0 []*model.PriceChange, _a1 error) *PriceRepository_ListPriceChanges_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PriceRepository_ListPriceChanges_Call) RunAndReturn(run func(context.Context, string) ([]*model.PriceChange, error)) *PriceRepository_ListPriceChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkApplied provides a mock function with given fields: ctx, partUUID, now
+func (_m *PriceRepository) MarkApplied(ctx context.Context, partUUID string, now time.Time) error {
+	ret := _m.Called(ctx, partUUID, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkApplied")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, partUUID, now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PriceRepository_MarkApplied_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkApplied'
+type PriceRepository_MarkApplied_Call struct {
+	*mock.Call
+}
+
+// MarkApplied is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partUUID string
+//   - now time.Time
+func (_e *PriceRepository_Expecter) MarkApplied(ctx interface{}, partUUID interface{}, now interface{}) *PriceRepository_MarkApplied_Call {
+	return &PriceRepository_MarkApplied_Call{Call: _e.mock.On("MarkApplied", ctx, partUUID, now)}
+}
+
+func (_c *PriceRepository_MarkApplied_Call) Run(run func(ctx context.Context, partUUID string, now time.Time)) *PriceRepository_MarkApplied_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *PriceRepository_MarkApplied_Call) Return(_a0 error) *PriceRepository_MarkApplied_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PriceRepository_MarkApplied_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *PriceRepository_MarkApplied_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetPartPrice provides a mock function with given fields: ctx, partUUID, price, updatedAt
+func (_m *PriceRepository) SetPartPrice(ctx context.Context, partUUID string, price float64, updatedAt time.Time) error {
+	ret := _m.Called(ctx, partUUID, price, updatedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetPartPrice")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64, time.Time) error); ok {
+		r0 = rf(ctx, partUUID, price, updatedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PriceRepository_SetPartPrice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetPartPrice'
+type PriceRepository_SetPartPrice_Call struct {
+	*mock.Call
+}
+
+// SetPartPrice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partUUID string
+//   - price float64
+//   - updatedAt time.Time
+func (_e *PriceRepository_Expecter) SetPartPrice(ctx interface{}, partUUID interface{}, price interface{}, updatedAt interface{}) *PriceRepository_SetPartPrice_Call {
+	return &PriceRepository_SetPartPrice_Call{Call: _e.mock.On("SetPartPrice", ctx, partUUID, price, updatedAt)}
+}
+
+func (_c *PriceRepository_SetPartPrice_Call) Run(run func(ctx context.Context, partUUID string, price float64, updatedAt time.Time)) *PriceRepository_SetPartPrice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(float64), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *PriceRepository_SetPartPrice_Call) Return(_a0 error) *PriceRepository_SetPartPrice_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PriceRepository_SetPartPrice_Call) RunAndReturn(run func(context.Context, string, float64, time.Time) error) *PriceRepository_SetPartPrice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPriceRepository creates a new instance of PriceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPriceRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PriceRepository {
+	mock := &PriceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,236 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RocketModelRepository is an autogenerated mock type for the RocketModelRepository type
+type RocketModelRepository struct {
+	mock.Mock
+}
+
+type RocketModelRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RocketModelRepository) EXPECT() *RocketModelRepository_Expecter {
+	return &RocketModelRepository_Expecter{mock: &_m.Mock}
+}
+
+// CreateModel provides a mock function with given fields: ctx, rocketModel
+func (_m *RocketModelRepository) CreateModel(ctx context.Context, rocketModel *model.RocketModel) error {
+	ret := _m.Called(ctx, rocketModel)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateModel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.RocketModel) error); ok {
+		r0 = rf(ctx, rocketModel)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RocketModelRepository_CreateModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateModel'
+type RocketModelRepository_CreateModel_Call struct {
+	*mock.Call
+}
+
+// CreateModel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rocketModel *model.RocketModel
+func (_e *RocketModelRepository_Expecter) CreateModel(ctx interface{}, rocketModel interface{}) *RocketModelRepository_CreateModel_Call {
+	return &RocketModelRepository_CreateModel_Call{Call: _e.mock.On("CreateModel", ctx, rocketModel)}
+}
+
+func (_c *RocketModelRepository_CreateModel_Call) Run(run func(ctx context.Context, rocketModel *model.RocketModel)) *RocketModelRepository_CreateModel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.RocketModel))
+	})
+	return _c
+}
+
+func (_c *RocketModelRepository_CreateModel_Call) Return(_a0 error) *RocketModelRepository_CreateModel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RocketModelRepository_CreateModel_Call) RunAndReturn(run func(context.Context, *model.RocketModel) error) *RocketModelRepository_CreateModel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetModel provides a mock function with given fields: ctx, uuid
+func (_m *RocketModelRepository) GetModel(ctx context.Context, uuid string) (*model.RocketModel, error) {
+	ret := _m.Called(ctx, uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetModel")
+	}
+
+	var r0 *model.RocketModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.RocketModel, error)); ok {
+		return rf(ctx, uuid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.RocketModel); ok {
+		r0 = rf(ctx, uuid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RocketModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, uuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RocketModelRepository_GetModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetModel'
+type RocketModelRepository_GetModel_Call struct {
+	*mock.Call
+}
+
+// GetModel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+func (_e *RocketModelRepository_Expecter) GetModel(ctx interface{}, uuid interface{}) *RocketModelRepository_GetModel_Call {
+	return &RocketModelRepository_GetModel_Call{Call: _e.mock.On("GetModel", ctx, uuid)}
+}
+
+func (_c *RocketModelRepository_GetModel_Call) Run(run func(ctx context.Context, uuid string)) *RocketModelRepository_GetModel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RocketModelRepository_GetModel_Call) Return(_a0 *model.RocketModel, _a1 error) *RocketModelRepository_GetModel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RocketModelRepository_GetModel_Call) RunAndReturn(run func(context.Context, string) (*model.RocketModel, error)) *RocketModelRepository_GetModel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InitTestData provides a mock function with given fields: ctx
+func (_m *RocketModelRepository) InitTestData(ctx context.Context) {
+	_m.Called(ctx)
+}
+
+// RocketModelRepository_InitTestData_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InitTestData'
+type RocketModelRepository_InitTestData_Call struct {
+	*mock.Call
+}
+
+// InitTestData is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *RocketModelRepository_Expecter) InitTestData(ctx interface{}) *RocketModelRepository_InitTestData_Call {
+	return &RocketModelRepository_InitTestData_Call{Call: _e.mock.On("InitTestData", ctx)}
+}
+
+func (_c *RocketModelRepository_InitTestData_Call) Run(run func(ctx context.Context)) *RocketModelRepository_InitTestData_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *RocketModelRepository_InitTestData_Call) Return() *RocketModelRepository_InitTestData_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *RocketModelRepository_InitTestData_Call) RunAndReturn(run func(context.Context)) *RocketModelRepository_InitTestData_Call {
+	_c.Run(run)
+	return _c
+}
+
+// ListModels provides a mock function with given fields: ctx
+func (_m *RocketModelRepository) ListModels(ctx context.Context) ([]*model.RocketModel, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListModels")
+	}
+
+	var r0 []*model.RocketModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.RocketModel, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.RocketModel); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.RocketModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RocketModelRepository_ListModels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListModels'
+type RocketModelRepository_ListModels_Call struct {
+	*mock.Call
+}
+
+// ListModels is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *RocketModelRepository_Expecter) ListModels(ctx interface{}) *RocketModelRepository_ListModels_Call {
+	return &RocketModelRepository_ListModels_Call{Call: _e.mock.On("ListModels", ctx)}
+}
+
+func (_c *RocketModelRepository_ListModels_Call) Run(run func(ctx context.Context)) *RocketModelRepository_ListModels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *RocketModelRepository_ListModels_Call) Return(_a0 []*model.RocketModel, _a1 error) *RocketModelRepository_ListModels_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RocketModelRepository_ListModels_Call) RunAndReturn(run func(context.Context) ([]*model.RocketModel, error)) *RocketModelRepository_ListModels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRocketModelRepository creates a new instance of RocketModelRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRocketModelRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RocketModelRepository {
+	mock := &RocketModelRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,420 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// StockRepository is an autogenerated mock type for the StockRepository type
+type StockRepository struct {
+	mock.Mock
+}
+
+type StockRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StockRepository) EXPECT() *StockRepository_Expecter {
+	return &StockRepository_Expecter{mock: &_m.Mock}
+}
+
+// ApplyMovement provides a mock function with given fields: ctx, movement
+func (_m *StockRepository) ApplyMovement(ctx context.Context, movement *model.StockMovement) (*model.StockMovement, error) {
+	ret := _m.Called(ctx, movement)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyMovement")
+	}
+
+	var r0 *model.StockMovement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockMovement) (*model.StockMovement, error)); ok {
+		return rf(ctx, movement)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockMovement) *model.StockMovement); ok {
+		r0 = rf(ctx, movement)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.StockMovement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.StockMovement) error); ok {
+		r1 = rf(ctx, movement)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StockRepository_ApplyMovement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyMovement'
+type StockRepository_ApplyMovement_Call struct {
+	*mock.Call
+}
+
+// ApplyMovement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - movement *model.StockMovement
+func (_e *StockRepository_Expecter) ApplyMovement(ctx interface{}, movement interface{}) *StockRepository_ApplyMovement_Call {
+	return &StockRepository_ApplyMovement_Call{Call: _e.mock.On("ApplyMovement", ctx, movement)}
+}
+
+func (_c *StockRepository_ApplyMovement_Call) Run(run func(ctx context.Context, movement *model.StockMovement)) *StockRepository_ApplyMovement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.StockMovement))
+	})
+	return _c
+}
+
+func (_c *StockRepository_ApplyMovement_Call) Return(_a0 *model.StockMovement, _a1 error) *StockRepository_ApplyMovement_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StockRepository_ApplyMovement_Call) RunAndReturn(run func(context.Context, *model.StockMovement) (*model.StockMovement, error)) *StockRepository_ApplyMovement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AssignUnallocatedStock provides a mock function with given fields: ctx, warehouseUUID
+func (_m *StockRepository) AssignUnallocatedStock(ctx context.Context, warehouseUUID string) (int64, error) {
+	ret := _m.Called(ctx, warehouseUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AssignUnallocatedStock")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int64, error)); ok {
+		return rf(ctx, warehouseUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, warehouseUUID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, warehouseUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StockRepository_AssignUnallocatedStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AssignUnallocatedStock'
+type StockRepository_AssignUnallocatedStock_Call struct {
+	*mock.Call
+}
+
+// AssignUnallocatedStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - warehouseUUID string
+func (_e *StockRepository_Expecter) AssignUnallocatedStock(ctx interface{}, warehouseUUID interface{}) *StockRepository_AssignUnallocatedStock_Call {
+	return &StockRepository_AssignUnallocatedStock_Call{Call: _e.mock.On("AssignUnallocatedStock", ctx, warehouseUUID)}
+}
+
+func (_c *StockRepository_AssignUnallocatedStock_Call) Run(run func(ctx context.Context, warehouseUUID string)) *StockRepository_AssignUnallocatedStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *StockRepository_AssignUnallocatedStock_Call) Return(_a0 int64, _a1 error) *StockRepository_AssignUnallocatedStock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StockRepository_AssignUnallocatedStock_Call) RunAndReturn(run func(context.Context, string) (int64, error)) *StockRepository_AssignUnallocatedStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClaimReservation provides a mock function with given fields: ctx, reference, partUUIDs, createdAt
+func (_m *StockRepository) ClaimReservation(ctx context.Context, reference string, partUUIDs []string, createdAt time.Time) error {
+	ret := _m.Called(ctx, reference, partUUIDs, createdAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, time.Time) error); ok {
+		r0 = rf(ctx, reference, partUUIDs, createdAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StockRepository_ClaimReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimReservation'
+type StockRepository_ClaimReservation_Call struct {
+	*mock.Call
+}
+
+// ClaimReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reference string
+//   - partUUIDs []string
+//   - createdAt time.Time
+func (_e *StockRepository_Expecter) ClaimReservation(ctx interface{}, reference interface{}, partUUIDs interface{}, createdAt interface{}) *StockRepository_ClaimReservation_Call {
+	return &StockRepository_ClaimReservation_Call{Call: _e.mock.On("ClaimReservation", ctx, reference, partUUIDs, createdAt)}
+}
+
+func (_c *StockRepository_ClaimReservation_Call) Run(run func(ctx context.Context, reference string, partUUIDs []string, createdAt time.Time)) *StockRepository_ClaimReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string), args[3].(time.Time))
+	})
+	return _c
+}
+
+func (_c *StockRepository_ClaimReservation_Call) Return(_a0 error) *StockRepository_ClaimReservation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StockRepository_ClaimReservation_Call) RunAndReturn(run func(context.Context, string, []string, time.Time) error) *StockRepository_ClaimReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConsumeReservation provides a mock function with given fields: ctx, release, consumption
+func (_m *StockRepository) ConsumeReservation(ctx context.Context, release *model.StockMovement, consumption *model.StockMovement) error {
+	ret := _m.Called(ctx, release, consumption)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumeReservation")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockMovement, *model.StockMovement) error); ok {
+		r0 = rf(ctx, release, consumption)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StockRepository_ConsumeReservation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumeReservation'
+type StockRepository_ConsumeReservation_Call struct {
+	*mock.Call
+}
+
+// ConsumeReservation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - release *model.StockMovement
+//   - consumption *model.StockMovement
+func (_e *StockRepository_Expecter) ConsumeReservation(ctx interface{}, release interface{}, consumption interface{}) *StockRepository_ConsumeReservation_Call {
+	return &StockRepository_ConsumeReservation_Call{Call: _e.mock.On("ConsumeReservation", ctx, release, consumption)}
+}
+
+func (_c *StockRepository_ConsumeReservation_Call) Run(run func(ctx context.Context, release *model.StockMovement, consumption *model.StockMovement)) *StockRepository_ConsumeReservation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.StockMovement), args[2].(*model.StockMovement))
+	})
+	return _c
+}
+
+func (_c *StockRepository_ConsumeReservation_Call) Return(_a0 error) *StockRepository_ConsumeReservation_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StockRepository_ConsumeReservation_Call) RunAndReturn(run func(context.Context, *model.StockMovement, *model.StockMovement) error) *StockRepository_ConsumeReservation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMovements provides a mock function with given fields: ctx, query
+func (_m *StockRepository) ListMovements(ctx context.Context, query *model.StockMovementsQuery) (*model.StockMovementsPage, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMovements")
+	}
+
+	var r0 *model.StockMovementsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockMovementsQuery) (*model.StockMovementsPage, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockMovementsQuery) *model.StockMovementsPage); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.StockMovementsPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.StockMovementsQuery) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StockRepository_ListMovements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMovements'
+type StockRepository_ListMovements_Call struct {
+	*mock.Call
+}
+
+// ListMovements is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query *model.StockMovementsQuery
+func (_e *StockRepository_Expecter) ListMovements(ctx interface{}, query interface{}) *StockRepository_ListMovements_Call {
+	return &StockRepository_ListMovements_Call{Call: _e.mock.On("ListMovements", ctx, query)}
+}
+
+func (_c *StockRepository_ListMovements_Call) Run(run func(ctx context.Context, query *model.StockMovementsQuery)) *StockRepository_ListMovements_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.StockMovementsQuery))
+	})
+	return _c
+}
+
+func (_c *StockRepository_ListMovements_Call) Return(_a0 *model.StockMovementsPage, _a1 error) *StockRepository_ListMovements_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StockRepository_ListMovements_Call) RunAndReturn(run func(context.Context, *model.StockMovementsQuery) (*model.StockMovementsPage, error)) *StockRepository_ListMovements_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListReservations provides a mock function with given fields: ctx, reference
+func (_m *StockRepository) ListReservations(ctx context.Context, reference string) ([]*model.StockMovement, error) {
+	ret := _m.Called(ctx, reference)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListReservations")
+	}
+
+	var r0 []*model.StockMovement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*model.StockMovement, error)); ok {
+		return rf(ctx, reference)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.StockMovement); ok {
+		r0 = rf(ctx, reference)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.StockMovement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, reference)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StockRepository_ListReservations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListReservations'
+type StockRepository_ListReservations_Call struct {
+	*mock.Call
+}
+
+// ListReservations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reference string
+func (_e *StockRepository_Expecter) ListReservations(ctx interface{}, reference interface{}) *StockRepository_ListReservations_Call {
+	return &StockRepository_ListReservations_Call{Call: _e.mock.On("ListReservations", ctx, reference)}
+}
+
+func (_c *StockRepository_ListReservations_Call) Run(run func(ctx context.Context, reference string)) *StockRepository_ListReservations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *StockRepository_ListReservations_Call) Return(_a0 []*model.StockMovement, _a1 error) *StockRepository_ListReservations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StockRepository_ListReservations_Call) RunAndReturn(run func(context.Context, string) ([]*model.StockMovement, error)) *StockRepository_ListReservations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransferStock provides a mock function with given fields: ctx, outgoing, incoming
+func (_m *StockRepository) TransferStock(ctx context.Context, outgoing *model.StockMovement, incoming *model.StockMovement) error {
+	ret := _m.Called(ctx, outgoing, incoming)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferStock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockMovement, *model.StockMovement) error); ok {
+		r0 = rf(ctx, outgoing, incoming)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StockRepository_TransferStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferStock'
+type StockRepository_TransferStock_Call struct {
+	*mock.Call
+}
+
+// TransferStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - outgoing *model.StockMovement
+//   - incoming *model.StockMovement
+func (_e *StockRepository_Expecter) TransferStock(ctx interface{}, outgoing interface{}, incoming interface{}) *StockRepository_TransferStock_Call {
+	return &StockRepository_TransferStock_Call{Call: _e.mock.On("TransferStock", ctx, outgoing, incoming)}
+}
+
+func (_c *StockRepository_TransferStock_Call) Run(run func(ctx context.Context, outgoing *model.StockMovement, incoming *model.StockMovement)) *StockRepository_TransferStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.StockMovement), args[2].(*model.StockMovement))
+	})
+	return _c
+}
+
+func (_c *StockRepository_TransferStock_Call) Return(_a0 error) *StockRepository_TransferStock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StockRepository_TransferStock_Call) RunAndReturn(run func(context.Context, *model.StockMovement, *model.StockMovement) error) *StockRepository_TransferStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStockRepository creates a new instance of StockRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStockRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StockRepository {
+	mock := &StockRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
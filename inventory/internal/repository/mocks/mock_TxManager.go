@@ -0,0 +1,85 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TxManager is an autogenerated mock type for the TxManager type
+type TxManager struct {
+	mock.Mock
+}
+
+type TxManager_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TxManager) EXPECT() *TxManager_Expecter {
+	return &TxManager_Expecter{mock: &_m.Mock}
+}
+
+// Run provides a mock function with given fields: ctx, fn
+func (_m *TxManager) Run(ctx context.Context, fn func(context.Context) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Run")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(context.Context) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TxManager_Run_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Run'
+type TxManager_Run_Call struct {
+	*mock.Call
+}
+
+// Run is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(context.Context) error
+func (_e *TxManager_Expecter) Run(ctx interface{}, fn interface{}) *TxManager_Run_Call {
+	return &TxManager_Run_Call{Call: _e.mock.On("Run", ctx, fn)}
+}
+
+func (_c *TxManager_Run_Call) Run(run func(ctx context.Context, fn func(context.Context) error)) *TxManager_Run_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(context.Context) error))
+	})
+	return _c
+}
+
+func (_c *TxManager_Run_Call) Return(_a0 error) *TxManager_Run_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TxManager_Run_Call) RunAndReturn(run func(context.Context, func(context.Context) error) error) *TxManager_Run_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTxManager creates a new instance of TxManager. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTxManager(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TxManager {
+	mock := &TxManager{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+// Attachment - ссылка на вложение внутри документа детали
+type Attachment struct {
+	// Уникальный идентификатор вложения, совпадает с _id файла в GridFS
+	Uuid string `bson:"uuid"`
+	// Тип вложения: IMAGE, DRAWING или DATASHEET
+	Kind string `bson:"kind"`
+	// Имя файла
+	FileName string `bson:"file_name"`
+	// MIME-тип содержимого
+	ContentType string `bson:"content_type"`
+	// Размер в байтах
+	Size int64 `bson:"size"`
+	// SHA-256 содержимого в hex
+	Checksum string `bson:"sha256"`
+	// Основное изображение детали
+	Primary bool `bson:"primary"`
+	// Дата загрузки
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+// AttachmentFileMetadata - metadata файла в GridFS, чтобы файл можно было найти без детали
+type AttachmentFileMetadata struct {
+	PartUuid    string `bson:"part_uuid"`
+	ContentType string `bson:"content_type"`
+}
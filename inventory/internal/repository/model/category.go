@@ -0,0 +1,41 @@
+package model
+
+import (
+	"time"
+)
+
+type Category struct {
+	// MongoDB document ID, совпадает с кодом категории
+	ID string `bson:"_id,omitempty"`
+	// Уникальный код категории
+	Code string `bson:"code"`
+	// Код родительской категории, пусто у корневой
+	ParentCode string `bson:"parent_code,omitempty"`
+	// Отображаемое название
+	DisplayName string `bson:"display_name"`
+	// Описание категории
+	Description string `bson:"description,omitempty"`
+	// Поля метаданных деталей категории
+	MetadataSchema []MetadataField `bson:"metadata_schema,omitempty"`
+	// Дата создания записи
+	CreatedAt time.Time `bson:"created_at"`
+	// Дата последнего обновления
+	UpdatedAt *time.Time `bson:"updated_at,omitempty"`
+}
+
+type MetadataField struct {
+	// Ключ в metadata детали
+	Key string `bson:"key"`
+	// Отображаемое название поля
+	DisplayName string `bson:"display_name,omitempty"`
+	// Тип значения: STRING, NUMBER, INTEGER или BOOL
+	Type string `bson:"type"`
+	// Единица измерения
+	Unit string `bson:"unit,omitempty"`
+	// Поле обязательно для деталей категории
+	Required bool `bson:"required"`
+	// Нижняя граница значения для числовых полей
+	Min *float64 `bson:"min,omitempty"`
+	// Верхняя граница значения для числовых полей
+	Max *float64 `bson:"max,omitempty"`
+}
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+)
+
+type CompatibilityRule struct {
+	// MongoDB document ID
+	ID string `bson:"_id,omitempty"`
+	// Уникальный идентификатор правила
+	Uuid string `bson:"uuid"`
+	// Тип правила (REQUIRES, EXCLUDES, COMPATIBLE_WITH)
+	Type string `bson:"type"`
+	// К кому применяется правило
+	Subject RuleTarget `bson:"subject"`
+	// Что требуется, исключается или разрешается
+	Object RuleTarget `bson:"object"`
+	// Пояснение при нарушении правила
+	Description string `bson:"description,omitempty"`
+	// Дата создания правила
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+type RuleTarget struct {
+	// Уникальный идентификатор детали, пусто — вся категория
+	PartUuid string `bson:"part_uuid,omitempty"`
+	// Категория (ENGINE, FUEL, PORTHOLE, WING), пусто — цель не задана
+	Category string `bson:"category,omitempty"`
+}
@@ -48,7 +48,7 @@ type Part struct {
 	Description string `bson:"description"`
 	// Цена за единицу
 	Price float64 `bson:"price"`
-	// Количество на складе
+	// Количество на всех складах
 	StockQuantity int64 `bson:"stock_quantity"`
 	// Категория детали
 	Category string `bson:"category"`
@@ -64,4 +64,24 @@ type Part struct {
 	CreatedAt *time.Time `bson:"created_at"`
 	// Дата последнего обновления
 	UpdatedAt *time.Time `bson:"updated_at"`
+	// Порог дозаказа, 0 — без контроля остатка
+	ReorderThreshold int64 `bson:"reorder_threshold"`
+	// Остаток ниже порога дозаказа (состояние последнего оповещения)
+	StockLow bool `bson:"stock_low"`
+	// Дата удаления (мягкое удаление), nil — деталь активна
+	DeletedAt *time.Time `bson:"deleted_at,omitempty"`
+	// Язык полнотекстового индекса документа (russian/english). Пусто — язык индекса по умолчанию
+	SearchLanguage string `bson:"search_language,omitempty"`
+	// Вложения детали, содержимое хранится в GridFS
+	Attachments []*Attachment `bson:"attachments,omitempty"`
+	// Остатки по складам, в сумме дают stock_quantity
+	StockLocations []*StockLocation `bson:"stock_locations,omitempty"`
+	// Версия для оптимистичной блокировки, увеличивается каждым изменением документа
+	Version int64 `bson:"version"`
+}
+
+// PartSearchHit - документ детали вместе с text score
+type PartSearchHit struct {
+	Part  `bson:",inline"`
+	Score float64 `bson:"score"`
 }
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// PartChangeEvent - событие change stream коллекции parts
+type PartChangeEvent struct {
+	// insert, update, replace, delete или служебный тип (invalidate, drop)
+	OperationType string `bson:"operationType"`
+	// Документ после изменения (для update — на момент чтения события)
+	FullDocument *Part `bson:"fullDocument,omitempty"`
+	// Документ до изменения, есть только при включенных pre-images коллекции
+	FullDocumentBeforeChange *Part `bson:"fullDocumentBeforeChange,omitempty"`
+	// Описание изменений update
+	UpdateDescription *UpdateDescription `bson:"updateDescription,omitempty"`
+	// Время записи изменения
+	WallTime time.Time `bson:"wallTime"`
+}
+
+type UpdateDescription struct {
+	UpdatedFields bson.Raw `bson:"updatedFields"`
+	RemovedFields []string `bson:"removedFields"`
+}
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+)
+
+type PriceChange struct {
+	// MongoDB document ID
+	ID string `bson:"_id,omitempty"`
+	// Уникальный идентификатор записи
+	Uuid string `bson:"uuid"`
+	// Уникальный идентификатор детали
+	PartUuid string `bson:"part_uuid"`
+	// Цена
+	Price float64 `bson:"price"`
+	// Момент вступления цены в силу
+	EffectiveFrom time.Time `bson:"effective_from"`
+	// Причина изменения цены
+	Reason string `bson:"reason"`
+	// Цена уже записана в карточку детали
+	Applied bool `bson:"applied"`
+	// Время создания записи
+	CreatedAt time.Time `bson:"created_at"`
+}
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+)
+
+type RocketModel struct {
+	// MongoDB document ID
+	ID string `bson:"_id,omitempty"`
+	// Уникальный идентификатор модели
+	Uuid string `bson:"uuid"`
+	// Название модели
+	Name string `bson:"name"`
+	// Описание модели
+	Description string `bson:"description,omitempty"`
+	// Строки спецификации
+	Items []BomItem `bson:"items"`
+	// Дата создания модели
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+type BomItem struct {
+	// Уникальный идентификатор основной детали
+	PartUuid string `bson:"part_uuid"`
+	// Количество деталей на одну ракету
+	Quantity int64 `bson:"quantity"`
+	// Альтернативные детали в порядке предпочтения
+	Alternatives []string `bson:"alternatives,omitempty"`
+}
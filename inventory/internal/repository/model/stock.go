@@ -0,0 +1,41 @@
+package model
+
+import (
+	"time"
+)
+
+type StockMovement struct {
+	// MongoDB document ID
+	ID string `bson:"_id,omitempty"`
+	// Уникальный идентификатор движения
+	Uuid string `bson:"uuid"`
+	// Уникальный идентификатор детали
+	PartUuid string `bson:"part_uuid"`
+	// Склад, остаток которого изменился
+	WarehouseUuid string `bson:"warehouse_uuid,omitempty"`
+	// Тип движения (RECEIPT, RESERVATION, RELEASE, CONSUMPTION, ADJUSTMENT, TRANSFER)
+	Type string `bson:"type"`
+	// Изменение остатка со знаком
+	Quantity int64 `bson:"quantity"`
+	// Причина движения
+	Reason string `bson:"reason"`
+	// Ссылка на документ-основание
+	Reference string `bson:"reference,omitempty"`
+	// Остаток детали после движения
+	StockAfter int64 `bson:"stock_after"`
+	// Время движения
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+// StockReservationClaim - отметка, что по основанию уже оформлен резерв детали.
+// Уникальный индекс по (reference, part_uuid) не дает оформить резерв дважды
+type StockReservationClaim struct {
+	// MongoDB document ID
+	ID string `bson:"_id,omitempty"`
+	// Ссылка на документ-основание
+	Reference string `bson:"reference"`
+	// Уникальный идентификатор детали
+	PartUuid string `bson:"part_uuid"`
+	// Время оформления резерва
+	CreatedAt time.Time `bson:"created_at"`
+}
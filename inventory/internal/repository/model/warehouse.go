@@ -0,0 +1,29 @@
+package model
+
+import (
+	"time"
+)
+
+type Warehouse struct {
+	// MongoDB document ID
+	ID string `bson:"_id,omitempty"`
+	// Уникальный идентификатор склада
+	Uuid string `bson:"uuid"`
+	// Уникальный код склада
+	Code string `bson:"code"`
+	// Название склада
+	Name string `bson:"name"`
+	// Широта в градусах
+	Latitude float64 `bson:"latitude"`
+	// Долгота в градусах
+	Longitude float64 `bson:"longitude"`
+	// Дата создания записи
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+type StockLocation struct {
+	// Уникальный идентификатор склада
+	WarehouseUuid string `bson:"warehouse_uuid"`
+	// Количество единиц на складе
+	Quantity int64 `bson:"quantity"`
+}
@@ -0,0 +1,26 @@
+package part
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/converter"
+)
+
+func (r *repository) CreatePart(ctx context.Context, part *model.Part) error {
+	repoPart := converter.PartToRepoModel(part)
+	repoPart.SearchLanguage = documentSearchLanguage(repoPart)
+
+	_, err := r.collection.InsertOne(ctx, repoPart)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return model.ErrPartAlreadyExists
+		}
+		return fmt.Errorf("failed to create part: %w", err)
+	}
+
+	return nil
+}
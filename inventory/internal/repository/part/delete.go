@@ -0,0 +1,31 @@
+package part
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (r *repository) DeletePart(ctx context.Context, uuid string, deletedAt time.Time) error {
+	update := bson.M{
+		"$set": bson.M{
+			"deleted_at": deletedAt,
+			"updated_at": deletedAt,
+		},
+		"$inc": bson.M{"version": 1},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"uuid": uuid, "deleted_at": nil}, update)
+	if err != nil {
+		return fmt.Errorf("failed to delete part: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return model.ErrPartNotFound
+	}
+
+	return nil
+}
@@ -15,7 +15,7 @@ import (
 
 func (r *repository) GetPart(ctx context.Context, uuid string) (*model.Part, error) {
 	var repoPart repoModel.Part
-	err := r.collection.FindOne(ctx, bson.M{"uuid": uuid}).Decode(&repoPart)
+	err := r.collection.FindOne(ctx, bson.M{"uuid": uuid, "deleted_at": nil}).Decode(&repoPart)
 	if err != nil {
 		if errors.Is(err, mongo.ErrNoDocuments) {
 			return nil, model.ErrPartNotFound
@@ -0,0 +1,36 @@
+package part
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/converter"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
+)
+
+func (r *repository) GetParts(ctx context.Context, uuids []string, fields []string) ([]*model.Part, error) {
+	findOptions := options.Find()
+	if p := projection(fields); p != nil {
+		findOptions.SetProjection(p)
+	}
+
+	cursor, err := r.collection.Find(ctx, bson.M{"uuid": bson.M{"$in": uuids}, "deleted_at": nil}, findOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find parts: %w", err)
+	}
+
+	defer func() {
+		_ = cursor.Close(ctx) //nolint:gosec // Cursor close error is not critical
+	}()
+
+	var repoParts []*repoModel.Part
+	if err = cursor.All(ctx, &repoParts); err != nil {
+		return nil, fmt.Errorf("failed to parse: %w", err)
+	}
+
+	return converter.PartsToModel(repoParts), nil
+}
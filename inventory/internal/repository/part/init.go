@@ -12,7 +12,7 @@ func (r *repository) InitTestData(ctx context.Context) {
 	now := time.Now()
 	logger.Info(ctx, "❗️ Init TestData")
 
-	// Тестовые данные
+	// Тестовые данные, остатки распределены между складами из warehouse.InitTestData
 	testParts := []repoModel.Part{
 		{
 			Uuid:          "550e8400-e29b-41d4-a716-446655440001",
@@ -20,7 +20,11 @@ func (r *repository) InitTestData(ctx context.Context) {
 			Description:   "Мощный жидкостный ракетный двигатель",
 			Price:         15000000.0,
 			StockQuantity: 3,
-			Category:      "ENGINE",
+			StockLocations: []*repoModel.StockLocation{
+				{WarehouseUuid: "9d4c2a10-7b3e-4f5a-8c6d-2e1f0a9b0001", Quantity: 2},
+				{WarehouseUuid: "9d4c2a10-7b3e-4f5a-8c6d-2e1f0a9b0002", Quantity: 1},
+			},
+			Category: "ENGINE",
 			Dimensions: &repoModel.Dimensions{
 				Length: 350.0,
 				Width:  240.0,
@@ -46,7 +50,11 @@ func (r *repository) InitTestData(ctx context.Context) {
 			Description:   "Аэродинамическое крыло для атмосферного полета",
 			Price:         2500000.0,
 			StockQuantity: 8,
-			Category:      "WING",
+			StockLocations: []*repoModel.StockLocation{
+				{WarehouseUuid: "9d4c2a10-7b3e-4f5a-8c6d-2e1f0a9b0001", Quantity: 6},
+				{WarehouseUuid: "9d4c2a10-7b3e-4f5a-8c6d-2e1f0a9b0002", Quantity: 2},
+			},
+			Category: "WING",
 			Dimensions: &repoModel.Dimensions{
 				Length: 1200.0,
 				Width:  600.0,
@@ -72,7 +80,11 @@ func (r *repository) InitTestData(ctx context.Context) {
 			Description:   "Жидкий водород для ракетных двигателей",
 			Price:         50000.0,
 			StockQuantity: 150,
-			Category:      "FUEL",
+			StockLocations: []*repoModel.StockLocation{
+				{WarehouseUuid: "9d4c2a10-7b3e-4f5a-8c6d-2e1f0a9b0001", Quantity: 100},
+				{WarehouseUuid: "9d4c2a10-7b3e-4f5a-8c6d-2e1f0a9b0002", Quantity: 50},
+			},
+			Category: "FUEL",
 			Dimensions: &repoModel.Dimensions{
 				Length: 100.0,
 				Width:  100.0,
@@ -98,7 +110,11 @@ func (r *repository) InitTestData(ctx context.Context) {
 			Description:   "Прочный иллюминатор для наблюдения в космосе",
 			Price:         750000.0,
 			StockQuantity: 12,
-			Category:      "PORTHOLE",
+			StockLocations: []*repoModel.StockLocation{
+				{WarehouseUuid: "9d4c2a10-7b3e-4f5a-8c6d-2e1f0a9b0001", Quantity: 8},
+				{WarehouseUuid: "9d4c2a10-7b3e-4f5a-8c6d-2e1f0a9b0002", Quantity: 4},
+			},
+			Category: "PORTHOLE",
 			Dimensions: &repoModel.Dimensions{
 				Length: 60.0,
 				Width:  60.0,
@@ -124,7 +140,11 @@ func (r *repository) InitTestData(ctx context.Context) {
 			Description:   "Компактный двигатель для первой ступени",
 			Price:         1200000.0,
 			StockQuantity: 25,
-			Category:      "ENGINE",
+			StockLocations: []*repoModel.StockLocation{
+				{WarehouseUuid: "9d4c2a10-7b3e-4f5a-8c6d-2e1f0a9b0001", Quantity: 17},
+				{WarehouseUuid: "9d4c2a10-7b3e-4f5a-8c6d-2e1f0a9b0002", Quantity: 8},
+			},
+			Category: "ENGINE",
 			Dimensions: &repoModel.Dimensions{
 				Length: 300.0,
 				Width:  100.0,
@@ -150,7 +170,11 @@ func (r *repository) InitTestData(ctx context.Context) {
 			Description:   "Большое крыло для тяжелых ракет",
 			Price:         4200000.0,
 			StockQuantity: 4,
-			Category:      "WING",
+			StockLocations: []*repoModel.StockLocation{
+				{WarehouseUuid: "9d4c2a10-7b3e-4f5a-8c6d-2e1f0a9b0001", Quantity: 3},
+				{WarehouseUuid: "9d4c2a10-7b3e-4f5a-8c6d-2e1f0a9b0002", Quantity: 1},
+			},
+			Category: "WING",
 			Dimensions: &repoModel.Dimensions{
 				Length: 1800.0,
 				Width:  900.0,
@@ -176,7 +200,11 @@ func (r *repository) InitTestData(ctx context.Context) {
 			Description:   "Бак для жидкого кислорода большой емкости",
 			Price:         890000.0,
 			StockQuantity: 18,
-			Category:      "FUEL",
+			StockLocations: []*repoModel.StockLocation{
+				{WarehouseUuid: "9d4c2a10-7b3e-4f5a-8c6d-2e1f0a9b0001", Quantity: 12},
+				{WarehouseUuid: "9d4c2a10-7b3e-4f5a-8c6d-2e1f0a9b0002", Quantity: 6},
+			},
+			Category: "FUEL",
 			Dimensions: &repoModel.Dimensions{
 				Length: 500.0,
 				Width:  200.0,
@@ -203,7 +231,11 @@ func (r *repository) InitTestData(ctx context.Context) {
 			Description:   "Панорамный иллюминатор для туристических полетов",
 			Price:         1250000.0,
 			StockQuantity: 6,
-			Category:      "PORTHOLE",
+			StockLocations: []*repoModel.StockLocation{
+				{WarehouseUuid: "9d4c2a10-7b3e-4f5a-8c6d-2e1f0a9b0001", Quantity: 4},
+				{WarehouseUuid: "9d4c2a10-7b3e-4f5a-8c6d-2e1f0a9b0002", Quantity: 2},
+			},
+			Category: "PORTHOLE",
 			Dimensions: &repoModel.Dimensions{
 				Length: 150.0,
 				Width:  100.0,
@@ -229,7 +261,11 @@ func (r *repository) InitTestData(ctx context.Context) {
 			Description:   "Полнопоточный двигатель на метане",
 			Price:         2800000.0,
 			StockQuantity: 15,
-			Category:      "ENGINE",
+			StockLocations: []*repoModel.StockLocation{
+				{WarehouseUuid: "9d4c2a10-7b3e-4f5a-8c6d-2e1f0a9b0001", Quantity: 10},
+				{WarehouseUuid: "9d4c2a10-7b3e-4f5a-8c6d-2e1f0a9b0002", Quantity: 5},
+			},
+			Category: "ENGINE",
 			Dimensions: &repoModel.Dimensions{
 				Length: 340.0,
 				Width:  130.0,
@@ -256,7 +292,11 @@ func (r *repository) InitTestData(ctx context.Context) {
 			Description:   "Решетчатые рули для управления посадкой",
 			Price:         650000.0,
 			StockQuantity: 20,
-			Category:      "WING",
+			StockLocations: []*repoModel.StockLocation{
+				{WarehouseUuid: "9d4c2a10-7b3e-4f5a-8c6d-2e1f0a9b0001", Quantity: 14},
+				{WarehouseUuid: "9d4c2a10-7b3e-4f5a-8c6d-2e1f0a9b0002", Quantity: 6},
+			},
+			Category: "WING",
 			Dimensions: &repoModel.Dimensions{
 				Length: 150.0,
 				Width:  120.0,
@@ -279,6 +319,7 @@ func (r *repository) InitTestData(ctx context.Context) {
 	}
 
 	for _, part := range testParts {
+		part.Version = 1
 		_, err := r.collection.InsertOne(ctx, part)
 		if err != nil {
 			return
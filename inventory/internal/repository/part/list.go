@@ -7,14 +7,100 @@ import (
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 
 	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
 	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/converter"
 	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
 )
 
-func (r *repository) ListParts(ctx context.Context, filter *model.PartsFilter) ([]*model.Part, error) {
-	mongoFilter := bson.M{}
+// ListParts возвращает страницу деталей. Пагинация keyset: следующая страница начинается
+// строго после (значение поля сортировки, uuid) последней детали, uuid делает порядок стабильным
+func (r *repository) ListParts(ctx context.Context, query *model.PartsQuery) (*model.PartsPage, error) {
+	if query == nil {
+		query = &model.PartsQuery{}
+	}
+
+	mongoFilter := partsFilterToBson(query.Filter)
+
+	total, err := r.collection.CountDocuments(ctx, mongoFilter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count parts: %w", err)
+	}
+
+	key := sortKey(query.SortBy)
+	order, cmp := 1, "$gt"
+	if query.Descending {
+		order, cmp = -1, "$lt"
+	}
+
+	pageFilter := mongoFilter
+	if query.PageToken != "" {
+		token, decodeErr := decodePageToken(query.PageToken)
+		if decodeErr != nil {
+			return nil, decodeErr
+		}
+		if token.SortBy != query.SortBy || token.Descending != query.Descending {
+			return nil, fmt.Errorf("%w: sort order does not match", model.ErrInvalidPageToken)
+		}
+
+		pageFilter = bson.M{"$and": bson.A{
+			mongoFilter,
+			bson.M{"$or": bson.A{
+				bson.M{key: bson.M{cmp: token.value()}},
+				bson.M{key: token.value(), "uuid": bson.M{cmp: token.Uuid}},
+			}},
+		}}
+	}
+
+	findOptions := options.Find().SetSort(bson.D{{Key: key, Value: order}, {Key: "uuid", Value: order}})
+	// Поле сортировки нужно для токена следующей страницы, даже если его нет в маске
+	if p := projection(query.Fields, key); p != nil {
+		findOptions.SetProjection(p)
+	}
+	if query.PageSize > 0 {
+		// Лишний документ показывает, есть ли следующая страница
+		findOptions.SetLimit(int64(query.PageSize) + 1)
+	}
+
+	var repoParts []*repoModel.Part
+
+	cursor, err := r.collection.Find(ctx, pageFilter, findOptions)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, model.ErrPartsNotFound
+		}
+		return nil, fmt.Errorf("failed to find parts %w", err)
+	}
+
+	defer func() {
+		_ = cursor.Close(ctx) //nolint:gosec // Cursor close error is not critical
+	}()
+
+	err = cursor.All(ctx, &repoParts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse: %w", err)
+	}
+
+	page := &model.PartsPage{TotalSize: total}
+
+	if query.PageSize > 0 && len(repoParts) > int(query.PageSize) {
+		repoParts = repoParts[:query.PageSize]
+
+		page.NextPageToken, err = newPageToken(query, repoParts[len(repoParts)-1]).encode()
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode page token: %w", err)
+		}
+	}
+
+	page.Parts = converter.PartsToModel(repoParts)
+
+	return page, nil
+}
+
+func partsFilterToBson(filter *model.PartsFilter) bson.M {
+	// Мягко удаленные детали не попадают в выборку
+	mongoFilter := bson.M{"deleted_at": nil}
 
 	// Handle nil filter
 	if filter == nil {
@@ -33,32 +119,78 @@ func (r *repository) ListParts(ctx context.Context, filter *model.PartsFilter) (
 		mongoFilter["category"] = bson.M{"$in": repoCategories}
 	}
 	if len(filter.ManufacturerCountries) > 0 {
-		mongoFilter["manufacturer_country"] = bson.M{"$in": filter.ManufacturerCountries}
+		mongoFilter["manufacturer.country"] = bson.M{"$in": filter.ManufacturerCountries}
 	}
 	if len(filter.Tags) > 0 {
 		mongoFilter["tags"] = bson.M{"$in": filter.Tags}
 	}
 
-	var repoParts []*repoModel.Part
+	floatRanges := map[string]*model.FloatRange{
+		"price":             filter.Price,
+		"dimensions.length": filter.Length,
+		"dimensions.width":  filter.Width,
+		"dimensions.height": filter.Height,
+		"dimensions.weight": filter.Weight,
+	}
+	for field, r := range floatRanges {
+		if r == nil {
+			continue
+		}
+		if cond := rangeToBson(r.Min, r.Max); len(cond) > 0 {
+			mongoFilter[field] = cond
+		}
+	}
 
-	cursor, err := r.collection.Find(ctx, mongoFilter)
-	if err != nil {
-		if errors.Is(err, mongo.ErrNoDocuments) {
-			return nil, model.ErrPartsNotFound
+	stockCond := bson.M{}
+	if filter.StockQuantity != nil {
+		stockCond = rangeToBson(filter.StockQuantity.Min, filter.StockQuantity.Max)
+	}
+	if filter.InStockOnly {
+		stockCond["$gt"] = int64(0)
+	}
+	if len(stockCond) > 0 {
+		mongoFilter["stock_quantity"] = stockCond
+	}
+
+	if len(filter.Metadata) > 0 {
+		// Несколько условий на один ключ не должны перезаписывать друг друга, поэтому $and
+		predicates := make(bson.A, 0, len(filter.Metadata))
+		for _, predicate := range filter.Metadata {
+			predicates = append(predicates, metadataPredicateToBson(predicate))
 		}
-		return nil, fmt.Errorf("failed to find parts %w", err)
+		mongoFilter["$and"] = predicates
 	}
 
-	defer func() {
-		_ = cursor.Close(ctx) //nolint:gosec // Cursor close error is not critical
-	}()
+	return mongoFilter
+}
 
-	err = cursor.All(ctx, &repoParts)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse: %w", err)
+// rangeToBson строит условие $gte/$lte по заданным границам диапазона
+func rangeToBson[T int64 | float64](minValue, maxValue *T) bson.M {
+	cond := bson.M{}
+	if minValue != nil {
+		cond["$gte"] = *minValue
 	}
+	if maxValue != nil {
+		cond["$lte"] = *maxValue
+	}
+	return cond
+}
 
-	modelParts := converter.PartsToModel(repoParts)
+var metadataOperators = map[model.MetadataOperator]string{
+	model.METADATA_OPERATOR_EQ:  "$eq",
+	model.METADATA_OPERATOR_NE:  "$ne",
+	model.METADATA_OPERATOR_GT:  "$gt",
+	model.METADATA_OPERATOR_GTE: "$gte",
+	model.METADATA_OPERATOR_LT:  "$lt",
+	model.METADATA_OPERATOR_LTE: "$lte",
+}
+
+func metadataPredicateToBson(predicate model.MetadataPredicate) bson.M {
+	field := "metadata." + predicate.Key
+
+	if predicate.Operator == model.METADATA_OPERATOR_EXISTS {
+		return bson.M{field: bson.M{"$exists": true}}
+	}
 
-	return modelParts, nil
+	return bson.M{field: bson.M{metadataOperators[predicate.Operator]: predicate.Value}}
 }
@@ -0,0 +1,100 @@
+package part
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
+)
+
+// pageToken - позиция последней выданной детали для keyset-пагинации.
+// Вместе с позицией хранится сортировка, чтобы токен нельзя было применить к другому порядку
+type pageToken struct {
+	SortBy        model.PartsSortField `json:"s"`
+	Descending    bool                 `json:"d"`
+	Uuid          string               `json:"u"`
+	Name          string               `json:"n,omitempty"`
+	Price         float64              `json:"p,omitempty"`
+	StockQuantity int64                `json:"q,omitempty"`
+	CreatedAt     time.Time            `json:"c,omitempty"`
+}
+
+func newPageToken(query *model.PartsQuery, last *repoModel.Part) *pageToken {
+	token := &pageToken{
+		SortBy:     query.SortBy,
+		Descending: query.Descending,
+		Uuid:       last.Uuid,
+	}
+
+	switch query.SortBy {
+	case model.PARTS_SORT_FIELD_NAME:
+		token.Name = last.Name
+	case model.PARTS_SORT_FIELD_PRICE:
+		token.Price = last.Price
+	case model.PARTS_SORT_FIELD_STOCK_QUANTITY:
+		token.StockQuantity = last.StockQuantity
+	default:
+		if last.CreatedAt != nil {
+			token.CreatedAt = *last.CreatedAt
+		}
+	}
+
+	return token
+}
+
+// value возвращает значение поля сортировки последней детали
+func (t *pageToken) value() interface{} {
+	switch t.SortBy {
+	case model.PARTS_SORT_FIELD_NAME:
+		return t.Name
+	case model.PARTS_SORT_FIELD_PRICE:
+		return t.Price
+	case model.PARTS_SORT_FIELD_STOCK_QUANTITY:
+		return t.StockQuantity
+	default:
+		return t.CreatedAt
+	}
+}
+
+func (t *pageToken) encode() (string, error) {
+	raw, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func decodePageToken(encoded string) (*pageToken, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", model.ErrInvalidPageToken, err)
+	}
+
+	var token pageToken
+	if err = json.Unmarshal(raw, &token); err != nil {
+		return nil, fmt.Errorf("%w: %v", model.ErrInvalidPageToken, err)
+	}
+	if token.Uuid == "" {
+		return nil, model.ErrInvalidPageToken
+	}
+
+	return &token, nil
+}
+
+// sortKey возвращает имя поля документа для сортировки
+func sortKey(sortBy model.PartsSortField) string {
+	switch sortBy {
+	case model.PARTS_SORT_FIELD_NAME:
+		return "name"
+	case model.PARTS_SORT_FIELD_PRICE:
+		return "price"
+	case model.PARTS_SORT_FIELD_STOCK_QUANTITY:
+		return "stock_quantity"
+	default:
+		return "created_at"
+	}
+}
@@ -0,0 +1,23 @@
+package part
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// projection строит проекцию MongoDB по полям маски чтения. Имена полей модели совпадают с полями
+// документа. uuid возвращается всегда: по нему строятся ссылки на вложения и сопоставляются детали
+// пакетного запроса. required — поля, без которых не обойтись запросу, например поле сортировки
+func projection(fields []string, required ...string) bson.M {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	result := bson.M{"uuid": 1}
+	for _, field := range fields {
+		result[field] = 1
+	}
+	for _, field := range required {
+		result[field] = 1
+	}
+	return result
+}
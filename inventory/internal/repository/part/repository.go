@@ -7,8 +7,10 @@ import (
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
 
 	def "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
 )
 
 var _ def.PartRepository = (*repository)(nil)
@@ -17,24 +19,74 @@ type repository struct {
 	collection *mongo.Collection
 }
 
-func NewRepository(_ context.Context, db *mongo.Database) *repository {
+func NewRepository(ctx context.Context, db *mongo.Database) *repository {
 	collection := db.Collection("parts")
 
-	// Create indexes synchronously but with error recovery
-	// We use background context to avoid cancellation issues
 	indexModel := []mongo.IndexModel{
 		{
 			Keys:    bson.D{{Key: "name", Value: 1}, {Key: "category", Value: 1}},
 			Options: options.Index().SetUnique(false),
 		},
+		// Индексы для сортировки и keyset-пагинации ListParts
+		{Keys: bson.D{{Key: "name", Value: 1}, {Key: "uuid", Value: 1}}},
+		{Keys: bson.D{{Key: "price", Value: 1}, {Key: "uuid", Value: 1}}},
+		{Keys: bson.D{{Key: "created_at", Value: 1}, {Key: "uuid", Value: 1}}},
+		{Keys: bson.D{{Key: "stock_quantity", Value: 1}, {Key: "uuid", Value: 1}}},
+	}
+
+	// Полнотекстовый индекс для SearchParts. Язык документа берется из search_language,
+	// по умолчанию каталог русскоязычный
+	textIndex := mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "name", Value: "text"},
+			{Key: "description", Value: "text"},
+			{Key: "tags", Value: "text"},
+			{Key: "manufacturer.name", Value: "text"},
+		},
+		Options: options.Index().
+			SetName("parts_text_search").
+			SetDefaultLanguage(searchLanguageRussian).
+			SetLanguageOverride("search_language").
+			SetWeights(bson.D{
+				{Key: "name", Value: 10},
+				{Key: "tags", Value: 5},
+				{Key: "manufacturer.name", Value: 3},
+				{Key: "description", Value: 1},
+			}),
 	}
 
 	indexCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	// Create indexes - if they already exist, MongoDB will silently skip
-	//nolint:gosec,contextcheck // Ignoring error & using background context is intentional
-	_, _ = collection.Indexes().CreateMany(indexCtx, indexModel)
+	// Индексы создаются по отдельности: CreateMany создает набор одной командой, и конфликт
+	// одного индекса (например, текстового с измененными весами) не дал бы создать остальные.
+	// Уникальный индекс по uuid создается первым: без него не отсекаются дубликаты деталей
+	//nolint:contextcheck // using background context is intentional
+	_, err := collection.Indexes().CreateOne(indexCtx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "uuid", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to create parts uuid index", zap.Error(err))
+	}
+
+	//nolint:contextcheck // using background context is intentional
+	if _, err = collection.Indexes().CreateOne(indexCtx, textIndex); err != nil {
+		logger.Error(ctx, "Failed to create parts text search index", zap.Error(err))
+	}
+
+	//nolint:contextcheck // using background context is intentional
+	if _, err = collection.Indexes().CreateMany(indexCtx, indexModel); err != nil {
+		logger.Error(ctx, "Failed to create parts indexes", zap.Error(err))
+	}
+
+	// Детали, созданные до появления версий, получают версию 1, чтобы их можно было обновить.
+	// Ошибка не критична: миграция повторится при следующем старте
+	//nolint:contextcheck // using background context is intentional
+	_, err = collection.UpdateMany(indexCtx, bson.M{"version": bson.M{"$exists": false}}, bson.M{"$set": bson.M{"version": 1}})
+	if err != nil {
+		logger.Error(ctx, "Failed to set initial part versions", zap.Error(err))
+	}
 
 	return &repository{collection: collection}
 }
@@ -0,0 +1,78 @@
+package part
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/converter"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
+)
+
+const (
+	searchLanguageRussian = "russian"
+	searchLanguageEnglish = "english"
+)
+
+// SearchParts ищет детали по текстовому индексу и возвращает их по убыванию релевантности
+func (r *repository) SearchParts(ctx context.Context, search *model.PartsSearch) ([]*model.PartSearchHit, error) {
+	mongoFilter := partsFilterToBson(search.Filter)
+	mongoFilter["$text"] = bson.M{
+		"$search":   search.Query,
+		"$language": searchLanguageToRepo(search.Language),
+	}
+
+	score := bson.M{"$meta": "textScore"}
+	findOptions := options.Find().
+		SetProjection(bson.M{"score": score}).
+		SetSort(bson.D{{Key: "score", Value: score}, {Key: "uuid", Value: 1}})
+	if search.Limit > 0 {
+		findOptions.SetLimit(int64(search.Limit))
+	}
+
+	cursor, err := r.collection.Find(ctx, mongoFilter, findOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search parts: %w", err)
+	}
+
+	defer func() {
+		_ = cursor.Close(ctx) //nolint:gosec // Cursor close error is not critical
+	}()
+
+	var repoHits []*repoModel.PartSearchHit
+	if err = cursor.All(ctx, &repoHits); err != nil {
+		return nil, fmt.Errorf("failed to parse: %w", err)
+	}
+
+	hits := make([]*model.PartSearchHit, 0, len(repoHits))
+	for _, hit := range repoHits {
+		hits = append(hits, &model.PartSearchHit{
+			Part:  converter.PartToModel(&hit.Part),
+			Score: hit.Score,
+		})
+	}
+
+	return hits, nil
+}
+
+func searchLanguageToRepo(language model.SearchLanguage) string {
+	if language == model.SEARCH_LANGUAGE_ENGLISH {
+		return searchLanguageEnglish
+	}
+	return searchLanguageRussian
+}
+
+// documentSearchLanguage выбирает анализатор для документа: английский только для деталей
+// без кириллицы в названии и описании, остальные индексируются русским стеммером
+func documentSearchLanguage(part *repoModel.Part) string {
+	text := part.Name + " " + part.Description
+	if strings.IndexFunc(text, func(r rune) bool { return unicode.Is(unicode.Cyrillic, r) }) >= 0 {
+		return searchLanguageRussian
+	}
+	return searchLanguageEnglish
+}
@@ -0,0 +1,29 @@
+package part
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// SetStockLow переключает признак низкого остатка. Обновление условное, поэтому при
+// конкурентных вызовах переключение происходит ровно один раз. Признак входит в карточку,
+// поэтому переключение увеличивает версию
+func (r *repository) SetStockLow(ctx context.Context, uuid string, low bool) (bool, error) {
+	filter := bson.M{
+		"uuid":       uuid,
+		"deleted_at": nil,
+		"stock_low":  bson.M{"$ne": low},
+	}
+
+	result, err := r.collection.UpdateOne(ctx, filter, bson.M{
+		"$set": bson.M{"stock_low": low},
+		"$inc": bson.M{"version": 1},
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to set stock_low: %w", err)
+	}
+
+	return result.ModifiedCount == 1, nil
+}
@@ -0,0 +1,55 @@
+package part
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/converter"
+)
+
+func (r *repository) UpdatePart(ctx context.Context, part *model.Part) error {
+	repoPart := converter.PartToRepoModel(part)
+
+	update := bson.M{
+		"$inc": bson.M{"version": 1},
+		"$set": bson.M{
+			"name":              repoPart.Name,
+			"description":       repoPart.Description,
+			"price":             repoPart.Price,
+			"category":          repoPart.Category,
+			"dimensions":        repoPart.Dimensions,
+			"manufacturer":      repoPart.Manufacturer,
+			"tags":              repoPart.Tags,
+			"metadata":          repoPart.Metadata,
+			"reorder_threshold": repoPart.ReorderThreshold,
+			"updated_at":        repoPart.UpdatedAt,
+			"search_language":   documentSearchLanguage(repoPart),
+		},
+	}
+
+	filter := bson.M{"uuid": part.Uuid, "deleted_at": nil, "version": part.Version}
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("failed to update part: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return r.notUpdatedReason(ctx, part.Uuid)
+	}
+
+	return nil
+}
+
+// notUpdatedReason отличает отсутствующую деталь от устаревшей версии
+func (r *repository) notUpdatedReason(ctx context.Context, uuid string) error {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"uuid": uuid, "deleted_at": nil})
+	if err != nil {
+		return fmt.Errorf("failed to check part: %w", err)
+	}
+	if count == 0 {
+		return model.ErrPartNotFound
+	}
+	return model.ErrPartVersionMismatch
+}
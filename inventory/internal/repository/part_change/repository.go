@@ -0,0 +1,38 @@
+package part_change
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	def "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository"
+)
+
+var _ def.PartChangeRepository = (*repository)(nil)
+
+type repository struct {
+	parts  *mongo.Collection
+	tokens *mongo.Collection
+}
+
+func NewRepository(_ context.Context, db *mongo.Database) *repository {
+	parts := db.Collection("parts")
+
+	// Pre-images нужны, чтобы узнать UUID детали, удаленной из коллекции:
+	// событие delete содержит только _id документа
+	cmdCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	//nolint:gosec,contextcheck // Ignoring error & using background context is intentional
+	_ = db.RunCommand(cmdCtx, bson.D{
+		{Key: "collMod", Value: parts.Name()},
+		{Key: "changeStreamPreAndPostImages", Value: bson.M{"enabled": true}},
+	}).Err()
+
+	return &repository{
+		parts:  parts,
+		tokens: db.Collection("change_stream_tokens"),
+	}
+}
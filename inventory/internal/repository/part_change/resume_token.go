@@ -0,0 +1,44 @@
+package part_change
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type resumeTokenDocument struct {
+	Name      string    `bson:"_id"`
+	Token     bson.Raw  `bson:"token"`
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+func (r *repository) GetResumeToken(ctx context.Context, name string) ([]byte, error) {
+	var document resumeTokenDocument
+	err := r.tokens.FindOne(ctx, bson.M{"_id": name}).Decode(&document)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get resume token: %w", err)
+	}
+
+	return document.Token, nil
+}
+
+func (r *repository) SaveResumeToken(ctx context.Context, name string, token []byte) error {
+	_, err := r.tokens.UpdateOne(ctx,
+		bson.M{"_id": name},
+		bson.M{"$set": bson.M{"token": bson.Raw(token), "updated_at": time.Now()}},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to save resume token: %w", err)
+	}
+
+	return nil
+}
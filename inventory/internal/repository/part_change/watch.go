@@ -0,0 +1,85 @@
+package part_change
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	def "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/converter"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+// changeStreamHistoryLost - код ошибки MongoDB, когда позиции токена уже нет в oplog
+const changeStreamHistoryLost = 286
+
+var _ def.PartChangeStream = (*stream)(nil)
+
+type stream struct {
+	changeStream *mongo.ChangeStream
+}
+
+func (r *repository) WatchParts(ctx context.Context, resumeToken []byte) (def.PartChangeStream, error) {
+	opts := options.ChangeStream().
+		SetFullDocument(options.UpdateLookup).
+		SetFullDocumentBeforeChange(options.WhenAvailable)
+	if resumeToken != nil {
+		// startAfter, в отличие от resumeAfter, продолжает поток и после invalidate
+		opts.SetStartAfter(bson.Raw(resumeToken))
+	}
+
+	changeStream, err := r.parts.Watch(ctx, mongo.Pipeline{}, opts)
+	if err != nil {
+		return nil, watchError(err)
+	}
+
+	return &stream{changeStream: changeStream}, nil
+}
+
+func (s *stream) Next(ctx context.Context) (*model.PartChange, error) {
+	if !s.changeStream.Next(ctx) {
+		if err := s.changeStream.Err(); err != nil {
+			return nil, watchError(err)
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		// Поток закрыт сервером, например после invalidate
+		return nil, errors.New("change stream closed")
+	}
+
+	resumeToken := make([]byte, len(s.changeStream.ResumeToken()))
+	copy(resumeToken, s.changeStream.ResumeToken())
+
+	var event repoModel.PartChangeEvent
+	if err := s.changeStream.Decode(&event); err != nil {
+		// Событие, которое не разбирается, при повторе не разберется тоже: оно пропускается
+		// как служебное, чтобы позиция потока сдвинулась и поток не застрял на нем
+		logger.Error(ctx, "❌ Failed to decode part change event, skipping",
+			zap.String("document", s.changeStream.Current.String()),
+			zap.Error(err),
+		)
+		return &model.PartChange{ResumeToken: resumeToken}, nil
+	}
+
+	return converter.PartChangeToModel(&event, resumeToken), nil
+}
+
+func (s *stream) Close(ctx context.Context) error {
+	return s.changeStream.Close(ctx)
+}
+
+func watchError(err error) error {
+	var serverErr mongo.ServerError
+	if errors.As(err, &serverErr) && serverErr.HasErrorCode(changeStreamHistoryLost) {
+		return fmt.Errorf("%w: %w", model.ErrResumeTokenExpired, err)
+	}
+	return fmt.Errorf("failed to watch parts: %w", err)
+}
@@ -0,0 +1,17 @@
+package price
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/converter"
+)
+
+func (r *repository) AddPriceChange(ctx context.Context, change *model.PriceChange) error {
+	if _, err := r.prices.InsertOne(ctx, converter.PriceChangeToRepoModel(change)); err != nil {
+		return fmt.Errorf("failed to insert price change: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package price
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (r *repository) ListDuePartUUIDs(ctx context.Context, now time.Time) ([]string, error) {
+	values, err := r.prices.Distinct(ctx, "part_uuid", bson.M{
+		"applied":        false,
+		"effective_from": bson.M{"$lte": now},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to find due prices: %w", err)
+	}
+
+	partUUIDs := make([]string, 0, len(values))
+	for _, value := range values {
+		if partUUID, ok := value.(string); ok {
+			partUUIDs = append(partUUIDs, partUUID)
+		}
+	}
+
+	return partUUIDs, nil
+}
+
+func (r *repository) SetPartPrice(ctx context.Context, partUUID string, price float64, updatedAt time.Time) error {
+	_, err := r.parts.UpdateOne(ctx,
+		bson.M{"uuid": partUUID, "deleted_at": nil},
+		bson.M{
+			"$set": bson.M{"price": price, "updated_at": updatedAt},
+			"$inc": bson.M{"version": 1},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to set part price: %w", err)
+	}
+
+	return nil
+}
+
+func (r *repository) MarkApplied(ctx context.Context, partUUID string, now time.Time) error {
+	_, err := r.prices.UpdateMany(ctx,
+		bson.M{"part_uuid": partUUID, "applied": false, "effective_from": bson.M{"$lte": now}},
+		bson.M{"$set": bson.M{"applied": true}},
+	)
+	if err != nil {
+		return fmt.Errorf("failed to mark prices applied: %w", err)
+	}
+
+	return nil
+}
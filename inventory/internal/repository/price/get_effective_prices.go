@@ -0,0 +1,49 @@
+package price
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/converter"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
+)
+
+// GetEffectivePrices берет по каждой детали последнюю запись, вступившую в силу не позже at
+func (r *repository) GetEffectivePrices(ctx context.Context, partUUIDs []string, at time.Time) (map[string]*model.PriceChange, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"part_uuid":      bson.M{"$in": partUUIDs},
+			"effective_from": bson.M{"$lte": at},
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "part_uuid", Value: 1}, {Key: "effective_from", Value: -1}, {Key: "created_at", Value: -1}}}},
+		{{Key: "$group", Value: bson.M{"_id": "$part_uuid", "change": bson.M{"$first": "$$ROOT"}}}},
+	}
+
+	cursor, err := r.prices.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate effective prices: %w", err)
+	}
+
+	defer func() {
+		_ = cursor.Close(ctx) //nolint:gosec // Cursor close error is not critical
+	}()
+
+	var groups []struct {
+		Change *repoModel.PriceChange `bson:"change"`
+	}
+	if err = cursor.All(ctx, &groups); err != nil {
+		return nil, fmt.Errorf("failed to parse: %w", err)
+	}
+
+	result := make(map[string]*model.PriceChange, len(groups))
+	for _, group := range groups {
+		result[group.Change.PartUuid] = converter.PriceChangeToModel(group.Change)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,34 @@
+package price
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/converter"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
+)
+
+// historyOrder - порядок записей истории: при равном effective_from побеждает более поздняя запись
+var historyOrder = bson.D{{Key: "effective_from", Value: -1}, {Key: "created_at", Value: -1}}
+
+func (r *repository) ListPriceChanges(ctx context.Context, partUUID string) ([]*model.PriceChange, error) {
+	cursor, err := r.prices.Find(ctx, bson.M{"part_uuid": partUUID}, options.Find().SetSort(historyOrder))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find price changes: %w", err)
+	}
+
+	defer func() {
+		_ = cursor.Close(ctx) //nolint:gosec // Cursor close error is not critical
+	}()
+
+	var repoChanges []*repoModel.PriceChange
+	if err = cursor.All(ctx, &repoChanges); err != nil {
+		return nil, fmt.Errorf("failed to parse: %w", err)
+	}
+
+	return converter.PriceChangesToModel(repoChanges), nil
+}
@@ -0,0 +1,44 @@
+package price
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	def "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository"
+)
+
+var _ def.PriceRepository = (*repository)(nil)
+
+type repository struct {
+	parts  *mongo.Collection
+	prices *mongo.Collection
+}
+
+func NewRepository(_ context.Context, db *mongo.Database) *repository {
+	prices := db.Collection("part_prices")
+
+	indexModel := []mongo.IndexModel{
+		{
+			// История цен детали и поиск действующей цены на момент времени
+			Keys: bson.D{{Key: "part_uuid", Value: 1}, {Key: "effective_from", Value: -1}, {Key: "created_at", Value: -1}},
+		},
+		{
+			// Поиск наступивших цен, которые еще не перенесены в карточки деталей
+			Keys: bson.D{{Key: "applied", Value: 1}, {Key: "effective_from", Value: 1}},
+		},
+	}
+
+	indexCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	//nolint:gosec,contextcheck // Ignoring error & using background context is intentional
+	_, _ = prices.Indexes().CreateMany(indexCtx, indexModel)
+
+	return &repository{
+		parts:  db.Collection("parts"),
+		prices: prices,
+	}
+}
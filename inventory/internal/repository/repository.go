@@ -2,12 +2,140 @@ package repository
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
 )
 
+// TxManager объединяет записи нескольких репозиториев в одну транзакцию MongoDB
+type TxManager interface {
+	// Run выполняет fn в транзакции: вызовы репозиториев с переданным в fn контекстом
+	// фиксируются вместе или не фиксируются вовсе. fn может быть вызвана повторно
+	Run(ctx context.Context, fn func(ctx context.Context) error) error
+}
+
 type PartRepository interface {
 	GetPart(ctx context.Context, uuid string) (*model.Part, error)
-	ListParts(ctx context.Context, filter *model.PartsFilter) ([]*model.Part, error)
+	// GetParts возвращает найденные детали из списка UUID в произвольном порядке.
+	// fields ограничивает возвращаемые поля, пусто — все поля
+	GetParts(ctx context.Context, uuids []string, fields []string) ([]*model.Part, error)
+	ListParts(ctx context.Context, query *model.PartsQuery) (*model.PartsPage, error)
+	SearchParts(ctx context.Context, search *model.PartsSearch) ([]*model.PartSearchHit, error)
+	// CreatePart сохраняет новую деталь, ErrPartAlreadyExists при повторе UUID
+	CreatePart(ctx context.Context, part *model.Part) error
+	// UpdatePart перезаписывает изменяемые поля не удаленной детали с версией part.Version и увеличивает версию,
+	// ErrPartVersionMismatch если деталь уже изменили. stock_quantity не меняется: остаток изменяется
+	// только через StockRepository
+	UpdatePart(ctx context.Context, part *model.Part) error
+	// DeletePart помечает деталь удаленной, после чего она не возвращается при чтении
+	DeletePart(ctx context.Context, uuid string, deletedAt time.Time) error
+	// SetStockLow переключает признак низкого остатка, true — если значение изменилось
+	SetStockLow(ctx context.Context, uuid string, low bool) (bool, error)
+	InitTestData(ctx context.Context)
+}
+
+type StockRepository interface {
+	// ApplyMovement изменяет остаток детали на складе движения и записывает движение,
+	// ErrInsufficientStock если расход больше остатка на этом складе
+	ApplyMovement(ctx context.Context, movement *model.StockMovement) (*model.StockMovement, error)
+	// TransferStock переносит остаток между складами одним обновлением детали и записывает оба движения,
+	// ErrInsufficientStock если на складе-отправителе не хватает остатка
+	TransferStock(ctx context.Context, outgoing, incoming *model.StockMovement) error
+	// ConsumeReservation записывает снятие резерва и расход детали в одной транзакции, остаток не меняется.
+	// ErrPartNotFound если детали нет
+	ConsumeReservation(ctx context.Context, release, consumption *model.StockMovement) error
+	ListMovements(ctx context.Context, query *model.StockMovementsQuery) (*model.StockMovementsPage, error)
+	// ClaimReservation отмечает, что по основанию оформляется резерв деталей,
+	// ErrReservationExists если резерв по основанию и одной из деталей уже оформлен
+	ClaimReservation(ctx context.Context, reference string, partUUIDs []string, createdAt time.Time) error
+	// ListReservations возвращает движения RESERVATION и RELEASE с заданным документом-основанием
+	ListReservations(ctx context.Context, reference string) ([]*model.StockMovement, error)
+	// AssignUnallocatedStock относит остаток деталей без разбивки по складам на указанный склад
+	// и возвращает число обновленных деталей
+	AssignUnallocatedStock(ctx context.Context, warehouseUUID string) (int64, error)
+}
+
+type WarehouseRepository interface {
+	// CreateWarehouse сохраняет склад, ErrWarehouseAlreadyExists при повторе кода
+	CreateWarehouse(ctx context.Context, warehouse *model.Warehouse) error
+	// GetWarehouse возвращает склад, ErrWarehouseNotFound если его нет
+	GetWarehouse(ctx context.Context, uuid string) (*model.Warehouse, error)
+	ListWarehouses(ctx context.Context) ([]*model.Warehouse, error)
+	InitTestData(ctx context.Context)
+}
+
+type CategoryRepository interface {
+	// CreateCategory сохраняет категорию, ErrCategoryAlreadyExists при повторе кода
+	CreateCategory(ctx context.Context, category *model.PartCategory) error
+	// GetCategory возвращает категорию, ErrCategoryNotFound если ее нет
+	GetCategory(ctx context.Context, code model.Category) (*model.PartCategory, error)
+	// UpdateCategory перезаписывает изменяемые поля категории, ErrCategoryNotFound если ее нет
+	UpdateCategory(ctx context.Context, category *model.PartCategory) error
+	DeleteCategory(ctx context.Context, code model.Category) error
+	ListCategories(ctx context.Context) ([]*model.PartCategory, error)
+	InitTestData(ctx context.Context)
+}
+
+type CompatibilityRepository interface {
+	CreateRule(ctx context.Context, rule *model.CompatibilityRule) error
+	// DeleteRule удаляет правило, ErrCompatibilityRuleNotFound если его нет
+	DeleteRule(ctx context.Context, uuid string) error
+	ListRules(ctx context.Context) ([]*model.CompatibilityRule, error)
+	InitTestData(ctx context.Context)
+}
+
+type RocketModelRepository interface {
+	CreateModel(ctx context.Context, rocketModel *model.RocketModel) error
+	// GetModel возвращает модель, ErrRocketModelNotFound если ее нет
+	GetModel(ctx context.Context, uuid string) (*model.RocketModel, error)
+	ListModels(ctx context.Context) ([]*model.RocketModel, error)
 	InitTestData(ctx context.Context)
 }
+
+// AttachmentRepository хранит содержимое вложений в GridFS, а ссылки на них — в документах деталей
+type AttachmentRepository interface {
+	// UploadFile сохраняет содержимое под UUID вложения
+	UploadFile(ctx context.Context, attachment *model.Attachment, content io.Reader) error
+	// OpenFile открывает содержимое на чтение, ErrAttachmentNotFound если файла нет
+	OpenFile(ctx context.Context, uuid string) (io.ReadCloser, error)
+	DeleteFile(ctx context.Context, uuid string) error
+	// AddAttachment добавляет ссылку в не удаленную деталь, ErrPartNotFound если ее нет
+	AddAttachment(ctx context.Context, attachment *model.Attachment) error
+	// GetAttachment возвращает ссылку на вложение, ErrAttachmentNotFound если его нет
+	GetAttachment(ctx context.Context, uuid string) (*model.Attachment, error)
+	RemoveAttachment(ctx context.Context, attachment *model.Attachment) error
+}
+
+// PriceRepository хранит историю цен деталей и переносит наступившие цены в карточки деталей
+type PriceRepository interface {
+	AddPriceChange(ctx context.Context, change *model.PriceChange) error
+	// ListPriceChanges возвращает историю цен детали от поздних к ранним по effective_from
+	ListPriceChanges(ctx context.Context, partUUID string) ([]*model.PriceChange, error)
+	// GetEffectivePrices возвращает действующие на момент at записи по UUID детали.
+	// Детали без записей в истории в результат не попадают
+	GetEffectivePrices(ctx context.Context, partUUIDs []string, at time.Time) (map[string]*model.PriceChange, error)
+	// ListDuePartUUIDs возвращает детали с наступившими, но еще не перенесенными в карточку ценами
+	ListDuePartUUIDs(ctx context.Context, now time.Time) ([]string, error)
+	// SetPartPrice записывает цену в карточку не удаленной детали
+	SetPartPrice(ctx context.Context, partUUID string, price float64, updatedAt time.Time) error
+	// MarkApplied помечает наступившие записи детали перенесенными в карточку
+	MarkApplied(ctx context.Context, partUUID string, now time.Time) error
+}
+
+// PartChangeRepository читает change stream коллекции деталей и хранит позицию его чтения
+type PartChangeRepository interface {
+	// WatchParts открывает change stream деталей после resumeToken, nil — с текущего момента.
+	// ErrResumeTokenExpired, если изменений после токена уже нет в oplog
+	WatchParts(ctx context.Context, resumeToken []byte) (PartChangeStream, error)
+	// GetResumeToken возвращает сохраненный токен потока name, nil если его нет
+	GetResumeToken(ctx context.Context, name string) ([]byte, error)
+	SaveResumeToken(ctx context.Context, name string, token []byte) error
+}
+
+// PartChangeStream - открытый change stream деталей
+type PartChangeStream interface {
+	// Next ждет следующее изменение до отмены ctx
+	Next(ctx context.Context) (*model.PartChange, error)
+	Close(ctx context.Context) error
+}
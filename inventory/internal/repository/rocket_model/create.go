@@ -0,0 +1,18 @@
+package rocket_model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/converter"
+)
+
+func (r *repository) CreateModel(ctx context.Context, rocketModel *model.RocketModel) error {
+	_, err := r.collection.InsertOne(ctx, converter.RocketModelToRepoModel(rocketModel))
+	if err != nil {
+		return fmt.Errorf("failed to create rocket model: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package rocket_model
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/converter"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
+)
+
+func (r *repository) GetModel(ctx context.Context, uuid string) (*model.RocketModel, error) {
+	var rocketModel repoModel.RocketModel
+	err := r.collection.FindOne(ctx, bson.M{"uuid": uuid}).Decode(&rocketModel)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, model.ErrRocketModelNotFound
+		}
+		return nil, fmt.Errorf("failed to get rocket model: %w", err)
+	}
+
+	return converter.RocketModelToModel(&rocketModel), nil
+}
@@ -0,0 +1,60 @@
+package rocket_model
+
+import (
+	"context"
+	"time"
+
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+func (r *repository) InitTestData(ctx context.Context) {
+	now := time.Now()
+	logger.Info(ctx, "❗️ Init rocket models")
+
+	// Модели для тестового каталога (UUID деталей из part.InitTestData)
+	testModels := []repoModel.RocketModel{
+		{
+			ID:          "3f2b8c1e-5a4d-4e6f-9b7a-1c2d3e4f0001",
+			Uuid:        "3f2b8c1e-5a4d-4e6f-9b7a-1c2d3e4f0001",
+			Name:        "Falcon 9",
+			Description: "Двухступенчатая ракета-носитель с девятью двигателями Merlin",
+			Items: []repoModel.BomItem{
+				{PartUuid: "550e8400-e29b-41d4-a716-446655440005", Quantity: 9},
+				{PartUuid: "550e8400-e29b-41d4-a716-446655440007", Quantity: 1},
+				{
+					PartUuid:     "550e8400-e29b-41d4-a716-446655440010",
+					Quantity:     4,
+					Alternatives: []string{"550e8400-e29b-41d4-a716-446655440006"},
+				},
+				{
+					PartUuid:     "550e8400-e29b-41d4-a716-446655440008",
+					Quantity:     1,
+					Alternatives: []string{"550e8400-e29b-41d4-a716-446655440004"},
+				},
+			},
+			CreatedAt: now,
+		},
+		{
+			ID:          "3f2b8c1e-5a4d-4e6f-9b7a-1c2d3e4f0002",
+			Uuid:        "3f2b8c1e-5a4d-4e6f-9b7a-1c2d3e4f0002",
+			Name:        "Союз",
+			Description: "Ракета-носитель на керосине и жидком кислороде с двигателем RD-180",
+			Items: []repoModel.BomItem{
+				{PartUuid: "550e8400-e29b-41d4-a716-446655440001", Quantity: 1},
+				{PartUuid: "550e8400-e29b-41d4-a716-446655440007", Quantity: 2},
+				{PartUuid: "550e8400-e29b-41d4-a716-446655440002", Quantity: 2},
+				{PartUuid: "550e8400-e29b-41d4-a716-446655440004", Quantity: 1},
+			},
+			CreatedAt: now,
+		},
+	}
+
+	for _, rocketModel := range testModels {
+		_, err := r.collection.InsertOne(ctx, rocketModel)
+		if err != nil {
+			return
+		}
+	}
+	logger.Info(ctx, "🎉 Rocket models successfully init")
+}
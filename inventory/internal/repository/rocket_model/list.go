@@ -0,0 +1,32 @@
+package rocket_model
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/converter"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
+)
+
+func (r *repository) ListModels(ctx context.Context) ([]*model.RocketModel, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}, {Key: "uuid", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list rocket models: %w", err)
+	}
+	defer func() {
+		_ = cursor.Close(ctx) //nolint:gosec // Cursor close error is not critical
+	}()
+
+	var rocketModels []*repoModel.RocketModel
+	if err = cursor.All(ctx, &rocketModels); err != nil {
+		return nil, fmt.Errorf("failed to parse: %w", err)
+	}
+
+	return converter.RocketModelsToModel(rocketModels), nil
+}
@@ -0,0 +1,39 @@
+package rocket_model
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	def "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository"
+)
+
+var _ def.RocketModelRepository = (*repository)(nil)
+
+type repository struct {
+	collection *mongo.Collection
+}
+
+func NewRepository(_ context.Context, db *mongo.Database) *repository {
+	collection := db.Collection("rocket_models")
+
+	indexModel := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "uuid", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+	}
+
+	indexCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	//nolint:gosec,contextcheck // Ignoring error & using background context is intentional
+	_, _ = collection.Indexes().CreateMany(indexCtx, indexModel)
+
+	return &repository{
+		collection: collection,
+	}
+}
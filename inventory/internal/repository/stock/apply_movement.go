@@ -0,0 +1,77 @@
+package stock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/txmanager"
+)
+
+// ApplyMovement изменяет stock_quantity детали и остаток склада движения на movement.Quantity
+// и записывает движение. Расход применяется только если на складе хватает остатка. Обновление
+// детали и запись движения идут в одной транзакции, поэтому остаток всегда равен сумме движений
+func (r *repository) ApplyMovement(ctx context.Context, movement *model.StockMovement) (*model.StockMovement, error) {
+	filter := bson.M{"uuid": movement.PartUuid, "deleted_at": nil}
+	if movement.Quantity < 0 {
+		filter["stock_locations"] = hasStock(movement.WarehouseUuid, -movement.Quantity)
+	}
+
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"stock_quantity": bson.M{"$add": bson.A{"$stock_quantity", movement.Quantity}},
+			"updated_at":     movement.CreatedAt,
+			"version":        nextVersion,
+		}}},
+		locationStage(movement.WarehouseUuid, movement.Quantity),
+	}
+
+	findOptions := options.FindOneAndUpdate().
+		SetReturnDocument(options.After).
+		SetProjection(bson.M{"stock_quantity": 1})
+
+	err := txmanager.Run(ctx, r.client, func(ctx context.Context) error {
+		var updated struct {
+			StockQuantity int64 `bson:"stock_quantity"`
+		}
+
+		err := r.parts.FindOneAndUpdate(ctx, filter, update, findOptions).Decode(&updated)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return r.notAppliedReason(ctx, movement.PartUuid)
+			}
+			return fmt.Errorf("failed to update stock: %w", err)
+		}
+
+		movement.StockAfter = updated.StockQuantity
+
+		if _, err = r.movements.InsertOne(ctx, converter.StockMovementToRepoModel(movement)); err != nil {
+			return fmt.Errorf("failed to record stock movement: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return movement, nil
+}
+
+// notAppliedReason отличает отсутствующую деталь от нехватки остатка
+func (r *repository) notAppliedReason(ctx context.Context, partUUID string) error {
+	count, err := r.parts.CountDocuments(ctx, bson.M{"uuid": partUUID, "deleted_at": nil})
+	if err != nil {
+		return fmt.Errorf("failed to check part: %w", err)
+	}
+	if count == 0 {
+		return model.ErrPartNotFound
+	}
+	return model.ErrInsufficientStock
+}
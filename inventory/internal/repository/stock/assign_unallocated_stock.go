@@ -0,0 +1,29 @@
+package stock
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// AssignUnallocatedStock переносит остаток деталей, созданных до появления складов, на указанный склад
+func (r *repository) AssignUnallocatedStock(ctx context.Context, warehouseUUID string) (int64, error) {
+	result, err := r.parts.UpdateMany(ctx,
+		bson.M{"stock_locations": bson.M{"$exists": false}, "stock_quantity": bson.M{"$gt": 0}},
+		mongo.Pipeline{
+			{{Key: "$set", Value: bson.M{
+				"stock_locations": bson.A{bson.M{
+					"warehouse_uuid": bson.M{"$literal": warehouseUUID},
+					"quantity":       "$stock_quantity",
+				}},
+			}}},
+		},
+	)
+	if err != nil {
+		return 0, fmt.Errorf("failed to assign unallocated stock: %w", err)
+	}
+
+	return result.ModifiedCount, nil
+}
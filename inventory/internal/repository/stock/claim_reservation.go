@@ -0,0 +1,34 @@
+package stock
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
+)
+
+// ClaimReservation вставляет по отметке на каждую деталь. Вызывается в транзакции резерва:
+// параллельный резерв по тому же основанию упирается в уникальный индекс и откатывается целиком
+func (r *repository) ClaimReservation(ctx context.Context, reference string, partUUIDs []string, createdAt time.Time) error {
+	claims := make([]interface{}, 0, len(partUUIDs))
+	for _, partUUID := range partUUIDs {
+		claims = append(claims, repoModel.StockReservationClaim{
+			Reference: reference,
+			PartUuid:  partUUID,
+			CreatedAt: createdAt,
+		})
+	}
+
+	if _, err := r.reservations.InsertMany(ctx, claims); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return model.ErrReservationExists
+		}
+		return fmt.Errorf("failed to claim reservation: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package stock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/txmanager"
+)
+
+// ConsumeReservation записывает снятие резерва и расход той же детали в одной транзакции.
+// Зарезервированное количество уже вычтено из остатка, поэтому stock_quantity не меняется
+func (r *repository) ConsumeReservation(ctx context.Context, release, consumption *model.StockMovement) error {
+	update := bson.M{"$set": bson.M{"updated_at": consumption.CreatedAt}, "$inc": bson.M{"version": 1}}
+
+	findOptions := options.FindOneAndUpdate().
+		SetReturnDocument(options.After).
+		SetProjection(bson.M{"stock_quantity": 1})
+
+	return txmanager.Run(ctx, r.client, func(ctx context.Context) error {
+		var updated struct {
+			StockQuantity int64 `bson:"stock_quantity"`
+		}
+
+		err := r.parts.FindOneAndUpdate(ctx, bson.M{"uuid": consumption.PartUuid, "deleted_at": nil}, update, findOptions).
+			Decode(&updated)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return model.ErrPartNotFound
+			}
+			return fmt.Errorf("failed to update part: %w", err)
+		}
+
+		release.StockAfter = updated.StockQuantity
+		consumption.StockAfter = updated.StockQuantity
+
+		_, err = r.movements.InsertMany(ctx, []interface{}{
+			converter.StockMovementToRepoModel(release),
+			converter.StockMovementToRepoModel(consumption),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record stock consumption: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,88 @@
+package stock
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/converter"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
+)
+
+// movementsPageToken - позиция последнего выданного движения
+type movementsPageToken struct {
+	CreatedAt time.Time `json:"c"`
+	Uuid      string    `json:"u"`
+}
+
+// ListMovements возвращает историю движений детали от новых к старым с keyset-пагинацией
+func (r *repository) ListMovements(ctx context.Context, query *model.StockMovementsQuery) (*model.StockMovementsPage, error) {
+	filter := bson.M{"part_uuid": query.PartUuid}
+
+	if query.PageToken != "" {
+		token, err := decodeMovementsPageToken(query.PageToken)
+		if err != nil {
+			return nil, err
+		}
+		filter["$or"] = bson.A{
+			bson.M{"created_at": bson.M{"$lt": token.CreatedAt}},
+			bson.M{"created_at": token.CreatedAt, "uuid": bson.M{"$lt": token.Uuid}},
+		}
+	}
+
+	findOptions := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}, {Key: "uuid", Value: -1}})
+	if query.PageSize > 0 {
+		findOptions.SetLimit(int64(query.PageSize) + 1)
+	}
+
+	cursor, err := r.movements.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find stock movements: %w", err)
+	}
+
+	defer func() {
+		_ = cursor.Close(ctx) //nolint:gosec // Cursor close error is not critical
+	}()
+
+	var repoMovements []*repoModel.StockMovement
+	if err = cursor.All(ctx, &repoMovements); err != nil {
+		return nil, fmt.Errorf("failed to parse: %w", err)
+	}
+
+	page := &model.StockMovementsPage{}
+
+	if query.PageSize > 0 && len(repoMovements) > int(query.PageSize) {
+		repoMovements = repoMovements[:query.PageSize]
+		last := repoMovements[len(repoMovements)-1]
+
+		raw, marshalErr := json.Marshal(movementsPageToken{CreatedAt: last.CreatedAt, Uuid: last.Uuid})
+		if marshalErr != nil {
+			return nil, fmt.Errorf("failed to encode page token: %w", marshalErr)
+		}
+		page.NextPageToken = base64.RawURLEncoding.EncodeToString(raw)
+	}
+
+	page.Movements = converter.StockMovementsToModel(repoMovements)
+
+	return page, nil
+}
+
+func decodeMovementsPageToken(encoded string) (*movementsPageToken, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", model.ErrInvalidPageToken, err)
+	}
+
+	var token movementsPageToken
+	if err = json.Unmarshal(raw, &token); err != nil || token.Uuid == "" {
+		return nil, model.ErrInvalidPageToken
+	}
+
+	return &token, nil
+}
@@ -0,0 +1,39 @@
+package stock
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/converter"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
+)
+
+func (r *repository) ListReservations(ctx context.Context, reference string) ([]*model.StockMovement, error) {
+	filter := bson.M{
+		"reference": reference,
+		"type": bson.M{"$in": bson.A{
+			converter.StockMovementTypeToRepo(model.STOCK_MOVEMENT_TYPE_RESERVATION),
+			converter.StockMovementTypeToRepo(model.STOCK_MOVEMENT_TYPE_RELEASE),
+		}},
+	}
+
+	cursor, err := r.movements.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find reservations: %w", err)
+	}
+
+	defer func() {
+		_ = cursor.Close(ctx) //nolint:gosec // Cursor close error is not critical
+	}()
+
+	var repoMovements []*repoModel.StockMovement
+	if err = cursor.All(ctx, &repoMovements); err != nil {
+		return nil, fmt.Errorf("failed to parse: %w", err)
+	}
+
+	return converter.StockMovementsToModel(repoMovements), nil
+}
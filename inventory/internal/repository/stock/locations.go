@@ -0,0 +1,44 @@
+package stock
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// nextVersion - выражение update-пайплайна, увеличивающее версию детали. Движение меняет остаток,
+// поэтому UpdatePart с версией, прочитанной до движения, будет отклонен и не перезапишет его
+var nextVersion = bson.M{"$add": bson.A{bson.M{"$ifNull": bson.A{"$version", 0}}, 1}}
+
+// locationStage - стадия update-пайплайна, изменяющая остаток склада в stock_locations на quantity.
+// Если склада еще нет в списке, он добавляется с остатком quantity
+func locationStage(warehouseUUID string, quantity int64) bson.D {
+	locations := bson.M{"$ifNull": bson.A{"$stock_locations", bson.A{}}}
+	warehouse := bson.M{"$literal": warehouseUUID}
+
+	return bson.D{{Key: "$set", Value: bson.M{
+		"stock_locations": bson.M{"$cond": bson.A{
+			bson.M{"$in": bson.A{warehouse, bson.M{"$map": bson.M{
+				"input": locations,
+				"as":    "location",
+				"in":    "$$location.warehouse_uuid",
+			}}}},
+			bson.M{"$map": bson.M{
+				"input": locations,
+				"as":    "location",
+				"in": bson.M{"$cond": bson.A{
+					bson.M{"$eq": bson.A{"$$location.warehouse_uuid", warehouse}},
+					bson.M{
+						"warehouse_uuid": "$$location.warehouse_uuid",
+						"quantity":       bson.M{"$add": bson.A{"$$location.quantity", quantity}},
+					},
+					"$$location",
+				}},
+			}},
+			bson.M{"$concatArrays": bson.A{locations, bson.A{bson.M{"warehouse_uuid": warehouse, "quantity": quantity}}}},
+		}},
+	}}}
+}
+
+// hasStock - условие фильтра: на складе не меньше quantity единиц
+func hasStock(warehouseUUID string, quantity int64) bson.M {
+	return bson.M{"$elemMatch": bson.M{"warehouse_uuid": warehouseUUID, "quantity": bson.M{"$gte": quantity}}}
+}
@@ -0,0 +1,63 @@
+package stock
+
+import (
+	"context"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.uber.org/zap"
+
+	def "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+var _ def.StockRepository = (*repository)(nil)
+
+type repository struct {
+	client       *mongo.Client
+	parts        *mongo.Collection
+	movements    *mongo.Collection
+	reservations *mongo.Collection
+}
+
+func NewRepository(ctx context.Context, db *mongo.Database) *repository {
+	movements := db.Collection("stock_movements")
+	reservations := db.Collection("stock_reservation_claims")
+
+	indexModel := []mongo.IndexModel{
+		{
+			// История движений детали от новых к старым
+			Keys: bson.D{{Key: "part_uuid", Value: 1}, {Key: "created_at", Value: -1}, {Key: "uuid", Value: -1}},
+		},
+		{
+			// Резервы и их снятие по документу-основанию
+			Keys: bson.D{{Key: "reference", Value: 1}, {Key: "type", Value: 1}},
+		},
+	}
+
+	indexCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	//nolint:gosec,contextcheck // Ignoring error & using background context is intentional
+	_, _ = movements.Indexes().CreateMany(indexCtx, indexModel)
+
+	// Без уникального индекса повторный резерв по тому же основанию не отсекается,
+	// поэтому ошибка его создания логируется
+	//nolint:contextcheck // using background context is intentional
+	_, err := reservations.Indexes().CreateOne(indexCtx, mongo.IndexModel{
+		Keys:    bson.D{{Key: "reference", Value: 1}, {Key: "part_uuid", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	})
+	if err != nil {
+		logger.Error(ctx, "Failed to create stock reservation claims index", zap.Error(err))
+	}
+
+	return &repository{
+		client:       db.Client(),
+		parts:        db.Collection("parts"),
+		movements:    movements,
+		reservations: reservations,
+	}
+}
@@ -0,0 +1,63 @@
+package stock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/txmanager"
+)
+
+// TransferStock уменьшает остаток склада outgoing и увеличивает остаток склада incoming
+// одним обновлением детали, поэтому stock_quantity не меняется. outgoing.Quantity отрицательное.
+// Обновление детали и запись обоих движений идут в одной транзакции
+func (r *repository) TransferStock(ctx context.Context, outgoing, incoming *model.StockMovement) error {
+	filter := bson.M{
+		"uuid":            outgoing.PartUuid,
+		"deleted_at":      nil,
+		"stock_locations": hasStock(outgoing.WarehouseUuid, -outgoing.Quantity),
+	}
+
+	update := mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{"updated_at": incoming.CreatedAt, "version": nextVersion}}},
+		locationStage(outgoing.WarehouseUuid, outgoing.Quantity),
+		locationStage(incoming.WarehouseUuid, incoming.Quantity),
+	}
+
+	findOptions := options.FindOneAndUpdate().
+		SetReturnDocument(options.After).
+		SetProjection(bson.M{"stock_quantity": 1})
+
+	return txmanager.Run(ctx, r.client, func(ctx context.Context) error {
+		var updated struct {
+			StockQuantity int64 `bson:"stock_quantity"`
+		}
+
+		err := r.parts.FindOneAndUpdate(ctx, filter, update, findOptions).Decode(&updated)
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return r.notAppliedReason(ctx, outgoing.PartUuid)
+			}
+			return fmt.Errorf("failed to transfer stock: %w", err)
+		}
+
+		outgoing.StockAfter = updated.StockQuantity
+		incoming.StockAfter = updated.StockQuantity
+
+		_, err = r.movements.InsertMany(ctx, []interface{}{
+			converter.StockMovementToRepoModel(outgoing),
+			converter.StockMovementToRepoModel(incoming),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to record stock transfer: %w", err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,25 @@
+package txmanager
+
+import (
+	"context"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	def "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository"
+)
+
+var _ def.TxManager = (*manager)(nil)
+
+type manager struct {
+	client *mongo.Client
+}
+
+func NewManager(client *mongo.Client) *manager {
+	return &manager{
+		client: client,
+	}
+}
+
+func (m *manager) Run(ctx context.Context, fn func(ctx context.Context) error) error {
+	return Run(ctx, m.client, fn)
+}
@@ -0,0 +1,29 @@
+package txmanager
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Run выполняет fn в транзакции MongoDB. Если ctx уже содержит сессию транзакции,
+// fn выполняется в ней, поэтому вложенные вызовы фиксируются вместе с внешним.
+// WithTransaction повторяет fn при временных ошибках, поэтому fn не должна иметь
+// побочных эффектов вне базы
+func Run(ctx context.Context, client *mongo.Client, fn func(ctx context.Context) error) error {
+	if mongo.SessionFromContext(ctx) != nil {
+		return fn(ctx)
+	}
+
+	session, err := client.StartSession()
+	if err != nil {
+		return fmt.Errorf("failed to start session: %w", err)
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sessCtx)
+	})
+	return err
+}
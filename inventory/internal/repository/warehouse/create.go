@@ -0,0 +1,23 @@
+package warehouse
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/converter"
+)
+
+func (r *repository) CreateWarehouse(ctx context.Context, warehouse *model.Warehouse) error {
+	_, err := r.collection.InsertOne(ctx, converter.WarehouseToRepoModel(warehouse))
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return model.ErrWarehouseAlreadyExists
+		}
+		return fmt.Errorf("failed to create warehouse: %w", err)
+	}
+
+	return nil
+}
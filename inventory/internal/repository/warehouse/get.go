@@ -0,0 +1,27 @@
+package warehouse
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/converter"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
+)
+
+func (r *repository) GetWarehouse(ctx context.Context, uuid string) (*model.Warehouse, error) {
+	var warehouse repoModel.Warehouse
+	err := r.collection.FindOne(ctx, bson.M{"uuid": uuid}).Decode(&warehouse)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, model.ErrWarehouseNotFound
+		}
+		return nil, fmt.Errorf("failed to get warehouse: %w", err)
+	}
+
+	return converter.WarehouseToModel(&warehouse), nil
+}
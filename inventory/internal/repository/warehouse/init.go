@@ -0,0 +1,44 @@
+package warehouse
+
+import (
+	"context"
+	"time"
+
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/model"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+func (r *repository) InitTestData(ctx context.Context) {
+	now := time.Now()
+	logger.Info(ctx, "❗️ Init warehouses")
+
+	// Первый склад используется по умолчанию (WAREHOUSE_DEFAULT_UUID)
+	testWarehouses := []repoModel.Warehouse{
+		{
+			ID:        "9d4c2a10-7b3e-4f5a-8c6d-2e1f0a9b0001",
+			Uuid:      "9d4c2a10-7b3e-4f5a-8c6d-2e1f0a9b0001",
+			Code:      "BAIKONUR",
+			Name:      "Космодром Байконур",
+			Latitude:  45.965,
+			Longitude: 63.305,
+			CreatedAt: now,
+		},
+		{
+			ID:        "9d4c2a10-7b3e-4f5a-8c6d-2e1f0a9b0002",
+			Uuid:      "9d4c2a10-7b3e-4f5a-8c6d-2e1f0a9b0002",
+			Code:      "VOSTOCHNY",
+			Name:      "Космодром Восточный",
+			Latitude:  51.884,
+			Longitude: 128.334,
+			CreatedAt: now,
+		},
+	}
+
+	for _, warehouse := range testWarehouses {
+		_, err := r.collection.InsertOne(ctx, warehouse)
+		if err != nil {
+			return
+		}
+	}
+	logger.Info(ctx, "🎉 Warehouses successfully init")
+}
@@ -0,0 +1,33 @@
+package attachment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+// DeleteAttachment сначала убирает ссылку из детали, затем содержимое: при сбое между
+// шагами остается недоступный файл, а не ссылка на отсутствующий
+func (s *service) DeleteAttachment(ctx context.Context, uuid string) error {
+	attachment, err := s.attachmentRepository.GetAttachment(ctx, uuid)
+	if err != nil {
+		if errors.Is(err, model.ErrAttachmentNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to get attachment: %w", err)
+	}
+
+	if err = s.attachmentRepository.RemoveAttachment(ctx, attachment); err != nil {
+		if errors.Is(err, model.ErrAttachmentNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to remove attachment: %w", err)
+	}
+
+	if err = s.attachmentRepository.DeleteFile(ctx, uuid); err != nil && !errors.Is(err, model.ErrAttachmentNotFound) {
+		return fmt.Errorf("failed to delete attachment file: %w", err)
+	}
+	return nil
+}
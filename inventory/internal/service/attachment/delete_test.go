@@ -0,0 +1,38 @@
+package attachment
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestDeleteAttachmentSuccess() {
+	attachment := &model.Attachment{Uuid: gofakeit.UUID(), PartUuid: gofakeit.UUID()}
+
+	s.attachmentRepository.On("GetAttachment", s.ctx, attachment.Uuid).Return(attachment, nil)
+	removed := s.attachmentRepository.On("RemoveAttachment", s.ctx, attachment).Return(nil)
+	s.attachmentRepository.On("DeleteFile", s.ctx, attachment.Uuid).Return(nil).NotBefore(removed)
+
+	err := s.service.DeleteAttachment(s.ctx, attachment.Uuid)
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestDeleteAttachmentMissingFile() {
+	attachment := &model.Attachment{Uuid: gofakeit.UUID(), PartUuid: gofakeit.UUID()}
+
+	s.attachmentRepository.On("GetAttachment", s.ctx, attachment.Uuid).Return(attachment, nil)
+	s.attachmentRepository.On("RemoveAttachment", s.ctx, attachment).Return(nil)
+	s.attachmentRepository.On("DeleteFile", s.ctx, attachment.Uuid).Return(model.ErrAttachmentNotFound)
+
+	err := s.service.DeleteAttachment(s.ctx, attachment.Uuid)
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestDeleteAttachmentNotFound() {
+	attachmentUUID := gofakeit.UUID()
+
+	s.attachmentRepository.On("GetAttachment", s.ctx, attachmentUUID).Return(nil, model.ErrAttachmentNotFound)
+
+	err := s.service.DeleteAttachment(s.ctx, attachmentUUID)
+	s.Require().ErrorIs(err, model.ErrAttachmentNotFound)
+}
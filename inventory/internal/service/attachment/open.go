@@ -0,0 +1,30 @@
+package attachment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *service) OpenAttachment(ctx context.Context, uuid string) (*model.Attachment, io.ReadCloser, error) {
+	attachment, err := s.attachmentRepository.GetAttachment(ctx, uuid)
+	if err != nil {
+		if errors.Is(err, model.ErrAttachmentNotFound) {
+			return nil, nil, err
+		}
+		return nil, nil, fmt.Errorf("failed to get attachment: %w", err)
+	}
+
+	content, err := s.attachmentRepository.OpenFile(ctx, uuid)
+	if err != nil {
+		if errors.Is(err, model.ErrAttachmentNotFound) {
+			return nil, nil, err
+		}
+		return nil, nil, fmt.Errorf("failed to open attachment: %w", err)
+	}
+
+	return attachment, content, nil
+}
@@ -0,0 +1,49 @@
+package attachment
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestOpenAttachmentSuccess() {
+	attachment := &model.Attachment{Uuid: gofakeit.UUID(), PartUuid: gofakeit.UUID()}
+
+	s.attachmentRepository.On("GetAttachment", s.ctx, attachment.Uuid).Return(attachment, nil)
+	s.attachmentRepository.On("OpenFile", s.ctx, attachment.Uuid).Return(io.NopCloser(strings.NewReader("%PDF-1.7")), nil)
+
+	found, content, err := s.service.OpenAttachment(s.ctx, attachment.Uuid)
+	s.Require().NoError(err)
+	s.Require().Equal(attachment, found)
+
+	data, err := io.ReadAll(content)
+	s.Require().NoError(err)
+	s.Require().Equal("%PDF-1.7", string(data))
+}
+
+func (s *ServiceSuite) TestOpenAttachmentNotFound() {
+	attachmentUUID := gofakeit.UUID()
+
+	s.attachmentRepository.On("GetAttachment", s.ctx, attachmentUUID).Return(nil, model.ErrAttachmentNotFound)
+
+	found, content, err := s.service.OpenAttachment(s.ctx, attachmentUUID)
+	s.Require().ErrorIs(err, model.ErrAttachmentNotFound)
+	s.Require().Nil(found)
+	s.Require().Nil(content)
+}
+
+func (s *ServiceSuite) TestOpenAttachmentStorageError() {
+	attachment := &model.Attachment{Uuid: gofakeit.UUID(), PartUuid: gofakeit.UUID()}
+
+	s.attachmentRepository.On("GetAttachment", s.ctx, attachment.Uuid).Return(attachment, nil)
+	s.attachmentRepository.On("OpenFile", s.ctx, attachment.Uuid).Return(nil, errors.New("connection refused"))
+
+	found, content, err := s.service.OpenAttachment(s.ctx, attachment.Uuid)
+	s.Require().Error(err)
+	s.Require().Nil(found)
+	s.Require().Nil(content)
+}
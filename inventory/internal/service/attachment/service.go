@@ -0,0 +1,37 @@
+package attachment
+
+import (
+	"strings"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository"
+	def "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/service"
+)
+
+var _ def.AttachmentService = (*service)(nil)
+
+type service struct {
+	attachmentRepository repository.AttachmentRepository
+	partRepository       repository.PartRepository
+
+	maxSize      int64
+	contentTypes map[string]struct{}
+}
+
+func NewService(
+	attachmentRepository repository.AttachmentRepository,
+	partRepository repository.PartRepository,
+	maxSize int64,
+	contentTypes []string,
+) *service {
+	allowed := make(map[string]struct{}, len(contentTypes))
+	for _, contentType := range contentTypes {
+		allowed[strings.ToLower(strings.TrimSpace(contentType))] = struct{}{}
+	}
+
+	return &service{
+		attachmentRepository: attachmentRepository,
+		partRepository:       partRepository,
+		maxSize:              maxSize,
+		contentTypes:         allowed,
+	}
+}
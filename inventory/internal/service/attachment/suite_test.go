@@ -0,0 +1,40 @@
+package attachment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/mocks"
+)
+
+const testMaxSize = 1024
+
+type ServiceSuite struct {
+	suite.Suite
+	ctx                  context.Context
+	attachmentRepository *mocks.AttachmentRepository
+	partRepository       *mocks.PartRepository
+	service              *service
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctx = context.Background()
+
+	s.attachmentRepository = mocks.NewAttachmentRepository(s.T())
+	s.partRepository = mocks.NewPartRepository(s.T())
+
+	s.service = NewService(
+		s.attachmentRepository,
+		s.partRepository,
+		testMaxSize,
+		[]string{"image/png", "image/jpeg", "application/pdf"},
+	)
+}
+
+func (s *ServiceSuite) TearDownTest() {}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
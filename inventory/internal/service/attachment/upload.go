@@ -0,0 +1,115 @@
+package attachment
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+// sniffLength - сколько первых байт нужно http.DetectContentType
+const sniffLength = 512
+
+// UploadAttachment сохраняет файл в GridFS по мере чтения и прикрепляет его к детали.
+// Файл, не прошедший проверку размера или контрольной суммы, удаляется из GridFS
+func (s *service) UploadAttachment(ctx context.Context, upload *model.AttachmentUpload) (*model.Attachment, error) {
+	if err := s.validateUpload(upload); err != nil {
+		return nil, err
+	}
+
+	// Деталь проверяется до загрузки, чтобы не принимать файл впустую
+	if _, err := s.partRepository.GetPart(ctx, upload.PartUuid); err != nil {
+		if errors.Is(err, model.ErrPartNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get part: %w", err)
+	}
+
+	content := bufio.NewReaderSize(upload.Content, sniffLength)
+	head, err := content.Peek(sniffLength)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to read attachment: %w", err)
+	}
+	if len(head) == 0 {
+		return nil, fmt.Errorf("%w: file is empty", model.ErrInvalidAttachment)
+	}
+	// Объявленный тип должен совпадать с сигнатурой, иначе под видом картинки можно сохранить HTML
+	if detected, _, _ := mime.ParseMediaType(http.DetectContentType(head)); detected != upload.ContentType {
+		return nil, fmt.Errorf("%w: content is %s, not %s", model.ErrInvalidAttachment, detected, upload.ContentType)
+	}
+
+	attachment := &model.Attachment{
+		Uuid:        uuid.NewString(),
+		PartUuid:    upload.PartUuid,
+		Kind:        upload.Kind,
+		FileName:    upload.FileName,
+		ContentType: upload.ContentType,
+		Primary:     upload.Primary,
+		CreatedAt:   time.Now(),
+	}
+
+	// Лишний байт сверх лимита показывает, что файл слишком большой, без чтения его до конца
+	measured := &measuredReader{reader: io.LimitReader(content, s.maxSize+1), hash: sha256.New()}
+	if err = s.attachmentRepository.UploadFile(ctx, attachment, measured); err != nil {
+		return nil, fmt.Errorf("failed to upload attachment: %w", err)
+	}
+
+	attachment.Size = measured.size
+	attachment.Checksum = hex.EncodeToString(measured.hash.Sum(nil))
+
+	switch {
+	case attachment.Size > s.maxSize:
+		err = fmt.Errorf("%w: limit is %d bytes", model.ErrAttachmentTooLarge, s.maxSize)
+	case upload.Checksum != "" && upload.Checksum != attachment.Checksum:
+		err = fmt.Errorf("%w: got %s", model.ErrAttachmentChecksumMismatch, attachment.Checksum)
+	default:
+		err = s.attachmentRepository.AddAttachment(ctx, attachment)
+		if err != nil && !errors.Is(err, model.ErrPartNotFound) {
+			err = fmt.Errorf("failed to add attachment: %w", err)
+		}
+	}
+	if err != nil {
+		s.discardFile(ctx, attachment.Uuid)
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+// discardFile удаляет содержимое, которое не попало в деталь. Ошибка только логируется:
+// без ссылки из детали файл недоступен
+func (s *service) discardFile(ctx context.Context, uuid string) {
+	if err := s.attachmentRepository.DeleteFile(context.WithoutCancel(ctx), uuid); err != nil {
+		logger.Error(ctx, "Failed to delete attachment file",
+			zap.String("attachment_uuid", uuid),
+			zap.Error(err),
+		)
+	}
+}
+
+// measuredReader считает размер и SHA-256 прочитанного содержимого
+type measuredReader struct {
+	reader io.Reader
+	hash   hash.Hash
+	size   int64
+}
+
+func (r *measuredReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.size += int64(n)
+	r.hash.Write(p[:n])
+	return n, err
+}
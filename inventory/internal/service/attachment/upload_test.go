@@ -0,0 +1,158 @@
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+// pngContent - сигнатура PNG и произвольные данные указанной длины
+func pngContent(size int) []byte {
+	content := []byte("\x89PNG\r\n\x1a\n")
+	return append(content, bytes.Repeat([]byte{0x42}, size-len(content))...)
+}
+
+func newImageUpload(partUUID string, content []byte) *model.AttachmentUpload {
+	return &model.AttachmentUpload{
+		PartUuid:    partUUID,
+		Kind:        model.ATTACHMENT_KIND_IMAGE,
+		FileName:    "engine.png",
+		ContentType: "image/png",
+		Content:     bytes.NewReader(content),
+	}
+}
+
+// expectUploadFile читает содержимое, как это делает GridFS
+func (s *ServiceSuite) expectUploadFile() {
+	s.attachmentRepository.On("UploadFile", s.ctx, mock.AnythingOfType("*model.Attachment"), mock.Anything).
+		Return(func(_ context.Context, _ *model.Attachment, content io.Reader) error {
+			_, err := io.Copy(io.Discard, content)
+			return err
+		})
+}
+
+func (s *ServiceSuite) TestUploadAttachmentSuccess() {
+	partUUID := gofakeit.UUID()
+	content := pngContent(600)
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+
+	upload := newImageUpload(partUUID, content)
+	upload.Primary = true
+	upload.ContentType = "Image/PNG"
+	upload.Checksum = checksum
+
+	s.partRepository.On("GetPart", s.ctx, partUUID).Return(&model.Part{Uuid: partUUID}, nil)
+	s.expectUploadFile()
+	s.attachmentRepository.On("AddAttachment", s.ctx, mock.MatchedBy(func(a *model.Attachment) bool {
+		return a.PartUuid == partUUID && a.Primary && a.Size == 600 && a.Checksum == checksum
+	})).Return(nil)
+
+	attachment, err := s.service.UploadAttachment(s.ctx, upload)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(attachment.Uuid)
+	s.Require().Equal("image/png", attachment.ContentType)
+	s.Require().Equal(int64(600), attachment.Size)
+	s.Require().Equal(checksum, attachment.Checksum)
+}
+
+func (s *ServiceSuite) TestUploadAttachmentInvalid() {
+	tests := map[string]func(upload *model.AttachmentUpload){
+		"unknown kind":        func(u *model.AttachmentUpload) { u.Kind = model.ATTACHMENT_KIND_UNSPECIFIED },
+		"empty file name":     func(u *model.AttachmentUpload) { u.FileName = "" },
+		"path in file name":   func(u *model.AttachmentUpload) { u.FileName = "../engine.png" },
+		"not allowed type":    func(u *model.AttachmentUpload) { u.ContentType = "text/html" },
+		"image as pdf":        func(u *model.AttachmentUpload) { u.ContentType = "application/pdf" },
+		"primary drawing":     func(u *model.AttachmentUpload) { u.Kind, u.Primary = model.ATTACHMENT_KIND_DRAWING, true },
+		"malformed checksum":  func(u *model.AttachmentUpload) { u.Checksum = "abc" },
+		"malformed mime type": func(u *model.AttachmentUpload) { u.ContentType = "image/" },
+	}
+
+	for name, mutate := range tests {
+		s.Run(name, func() {
+			upload := newImageUpload(gofakeit.UUID(), pngContent(16))
+			mutate(upload)
+
+			attachment, err := s.service.UploadAttachment(s.ctx, upload)
+			s.Require().ErrorIs(err, model.ErrInvalidAttachment)
+			s.Require().Nil(attachment)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestUploadAttachmentContentMismatch() {
+	partUUID := gofakeit.UUID()
+	upload := newImageUpload(partUUID, []byte("<html><script>alert(1)</script></html>"))
+
+	s.partRepository.On("GetPart", s.ctx, partUUID).Return(&model.Part{Uuid: partUUID}, nil)
+
+	attachment, err := s.service.UploadAttachment(s.ctx, upload)
+	s.Require().ErrorIs(err, model.ErrInvalidAttachment)
+	s.Require().Nil(attachment)
+}
+
+func (s *ServiceSuite) TestUploadAttachmentEmpty() {
+	partUUID := gofakeit.UUID()
+
+	s.partRepository.On("GetPart", s.ctx, partUUID).Return(&model.Part{Uuid: partUUID}, nil)
+
+	attachment, err := s.service.UploadAttachment(s.ctx, newImageUpload(partUUID, nil))
+	s.Require().ErrorIs(err, model.ErrInvalidAttachment)
+	s.Require().Nil(attachment)
+}
+
+func (s *ServiceSuite) TestUploadAttachmentPartNotFound() {
+	partUUID := gofakeit.UUID()
+
+	s.partRepository.On("GetPart", s.ctx, partUUID).Return(nil, model.ErrPartNotFound)
+
+	attachment, err := s.service.UploadAttachment(s.ctx, newImageUpload(partUUID, pngContent(16)))
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+	s.Require().Nil(attachment)
+}
+
+func (s *ServiceSuite) TestUploadAttachmentTooLarge() {
+	partUUID := gofakeit.UUID()
+
+	s.partRepository.On("GetPart", s.ctx, partUUID).Return(&model.Part{Uuid: partUUID}, nil)
+	s.expectUploadFile()
+	s.attachmentRepository.On("DeleteFile", mock.Anything, mock.AnythingOfType("string")).Return(nil)
+
+	attachment, err := s.service.UploadAttachment(s.ctx, newImageUpload(partUUID, pngContent(testMaxSize+1)))
+	s.Require().ErrorIs(err, model.ErrAttachmentTooLarge)
+	s.Require().Nil(attachment)
+}
+
+func (s *ServiceSuite) TestUploadAttachmentChecksumMismatch() {
+	partUUID := gofakeit.UUID()
+	upload := newImageUpload(partUUID, pngContent(64))
+	upload.Checksum = hex.EncodeToString(make([]byte, sha256.Size))
+
+	s.partRepository.On("GetPart", s.ctx, partUUID).Return(&model.Part{Uuid: partUUID}, nil)
+	s.expectUploadFile()
+	s.attachmentRepository.On("DeleteFile", mock.Anything, mock.AnythingOfType("string")).Return(nil)
+
+	attachment, err := s.service.UploadAttachment(s.ctx, upload)
+	s.Require().ErrorIs(err, model.ErrAttachmentChecksumMismatch)
+	s.Require().Nil(attachment)
+}
+
+func (s *ServiceSuite) TestUploadAttachmentPartDeletedDuringUpload() {
+	partUUID := gofakeit.UUID()
+
+	s.partRepository.On("GetPart", s.ctx, partUUID).Return(&model.Part{Uuid: partUUID}, nil)
+	s.expectUploadFile()
+	s.attachmentRepository.On("AddAttachment", s.ctx, mock.AnythingOfType("*model.Attachment")).Return(model.ErrPartNotFound)
+	s.attachmentRepository.On("DeleteFile", mock.Anything, mock.AnythingOfType("string")).Return(nil)
+
+	attachment, err := s.service.UploadAttachment(s.ctx, newImageUpload(partUUID, pngContent(64)))
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+	s.Require().Nil(attachment)
+}
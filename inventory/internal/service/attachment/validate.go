@@ -0,0 +1,53 @@
+package attachment
+
+import (
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"strings"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+const maxFileNameLength = 255
+
+// validateUpload проверяет описание файла до чтения содержимого и приводит MIME-тип
+// и контрольную сумму к нижнему регистру
+func (s *service) validateUpload(upload *model.AttachmentUpload) error {
+	switch upload.Kind {
+	case model.ATTACHMENT_KIND_IMAGE, model.ATTACHMENT_KIND_DRAWING, model.ATTACHMENT_KIND_DATASHEET:
+	default:
+		return fmt.Errorf("%w: kind is required", model.ErrInvalidAttachment)
+	}
+
+	name := upload.FileName
+	if name == "" || name == "." || name == ".." || len(name) > maxFileNameLength || strings.ContainsAny(name, `/\`) {
+		return fmt.Errorf("%w: file name must be a plain name up to %d bytes", model.ErrInvalidAttachment, maxFileNameLength)
+	}
+
+	contentType, _, err := mime.ParseMediaType(upload.ContentType)
+	if err != nil {
+		return fmt.Errorf("%w: invalid content type %q", model.ErrInvalidAttachment, upload.ContentType)
+	}
+	if _, ok := s.contentTypes[contentType]; !ok {
+		return fmt.Errorf("%w: content type %q is not allowed", model.ErrInvalidAttachment, contentType)
+	}
+	if upload.Kind == model.ATTACHMENT_KIND_IMAGE && !strings.HasPrefix(contentType, "image/") {
+		return fmt.Errorf("%w: image must have image content type", model.ErrInvalidAttachment)
+	}
+	upload.ContentType = contentType
+
+	if upload.Primary && upload.Kind != model.ATTACHMENT_KIND_IMAGE {
+		return fmt.Errorf("%w: only image can be primary", model.ErrInvalidAttachment)
+	}
+
+	if upload.Checksum != "" {
+		checksum, err := hex.DecodeString(upload.Checksum)
+		if err != nil || len(checksum) != 32 {
+			return fmt.Errorf("%w: sha256 must be 64 hex characters", model.ErrInvalidAttachment)
+		}
+		upload.Checksum = strings.ToLower(upload.Checksum)
+	}
+
+	return nil
+}
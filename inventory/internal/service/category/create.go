@@ -0,0 +1,37 @@
+package category
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *service) CreateCategory(ctx context.Context, category *model.PartCategory) (*model.PartCategory, error) {
+	normalizeCategory(category)
+
+	if err := validateCategory(category); err != nil {
+		return nil, err
+	}
+
+	categories, err := s.categoryRepository.ListCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+	if err = validateParent(category, categories); err != nil {
+		return nil, err
+	}
+	if err = validateInheritedSchema(category, categories); err != nil {
+		return nil, err
+	}
+
+	category.CreatedAt = time.Now()
+	category.UpdatedAt = nil
+
+	if err = s.categoryRepository.CreateCategory(ctx, category); err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
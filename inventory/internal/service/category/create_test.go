@@ -0,0 +1,70 @@
+package category
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestCreateCategorySuccess() {
+	s.categoryRepository.On("ListCategories", s.ctx).Return(categoryTree(), nil)
+	s.categoryRepository.On("CreateCategory", s.ctx, mock.MatchedBy(func(c *model.PartCategory) bool {
+		return c.Code == "AVIONICS" && c.ParentCode == "" && !c.CreatedAt.IsZero()
+	})).Return(nil)
+
+	created, err := s.service.CreateCategory(s.ctx, &model.PartCategory{
+		Code:        " avionics ",
+		DisplayName: "Авионика",
+		MetadataSchema: []*model.MetadataField{
+			{Key: "частота", Type: model.METADATA_FIELD_TYPE_NUMBER, Unit: "МГц"},
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(model.Category("AVIONICS"), created.Code)
+}
+
+func (s *ServiceSuite) TestCreateSubcategory() {
+	s.categoryRepository.On("ListCategories", s.ctx).Return(categoryTree(), nil)
+	s.categoryRepository.On("CreateCategory", s.ctx, mock.AnythingOfType("*model.PartCategory")).Return(nil)
+
+	created, err := s.service.CreateCategory(s.ctx, &model.PartCategory{
+		Code:        "SOLID_ENGINE",
+		ParentCode:  "engine",
+		DisplayName: "РДТТ",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(model.CATEGORY_ENGINE, created.ParentCode)
+}
+
+func (s *ServiceSuite) TestCreateCategoryUnknownParent() {
+	s.categoryRepository.On("ListCategories", s.ctx).Return(categoryTree(), nil)
+
+	_, err := s.service.CreateCategory(s.ctx, &model.PartCategory{
+		Code:        "LANDING_GEAR",
+		ParentCode:  "CHASSIS",
+		DisplayName: "Шасси",
+	})
+	s.Require().ErrorIs(err, model.ErrInvalidCategory)
+}
+
+func (s *ServiceSuite) TestCreateCategoryInvalid() {
+	cases := map[string]*model.PartCategory{
+		"bad code":        {Code: "LANDING-GEAR", DisplayName: "Шасси"},
+		"no display name": {Code: "LANDING_GEAR"},
+		"duplicate key": {Code: "LANDING_GEAR", DisplayName: "Шасси", MetadataSchema: []*model.MetadataField{
+			{Key: "ход", Type: model.METADATA_FIELD_TYPE_NUMBER},
+			{Key: "ход", Type: model.METADATA_FIELD_TYPE_NUMBER},
+		}},
+		"bad key": {Code: "LANDING_GEAR", DisplayName: "Шасси", MetadataSchema: []*model.MetadataField{
+			{Key: "$where", Type: model.METADATA_FIELD_TYPE_STRING},
+		}},
+		"no type": {Code: "LANDING_GEAR", DisplayName: "Шасси", MetadataSchema: []*model.MetadataField{
+			{Key: "ход"},
+		}},
+	}
+
+	for name, category := range cases {
+		_, err := s.service.CreateCategory(s.ctx, category)
+		s.Require().ErrorIs(err, model.ErrInvalidCategory, name)
+	}
+}
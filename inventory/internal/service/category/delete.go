@@ -0,0 +1,47 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+// DeleteCategory удаляет категорию, на которую ничего не ссылается. Категории из enum Category
+// не удаляются: старые клиенты продолжают присылать их значения
+func (s *service) DeleteCategory(ctx context.Context, code model.Category) error {
+	if code.IsLegacy() {
+		return fmt.Errorf("%w: %s is a built-in category", model.ErrCategoryInUse, code)
+	}
+
+	categories, err := s.categoryRepository.ListCategories(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list categories: %w", err)
+	}
+	for _, category := range categories {
+		if category.ParentCode == code {
+			return fmt.Errorf("%w: %s has subcategory %s", model.ErrCategoryInUse, code, category.Code)
+		}
+	}
+
+	page, err := s.partRepository.ListParts(ctx, &model.PartsQuery{
+		Filter:   &model.PartsFilter{Categories: []model.Category{code}},
+		PageSize: 1,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get parts: %w", err)
+	}
+	if len(page.Parts) > 0 {
+		return fmt.Errorf("%w: %s has parts", model.ErrCategoryInUse, code)
+	}
+
+	if err = s.categoryRepository.DeleteCategory(ctx, code); err != nil {
+		if errors.Is(err, model.ErrCategoryNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to delete category: %w", err)
+	}
+
+	return nil
+}
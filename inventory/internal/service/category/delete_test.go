@@ -0,0 +1,38 @@
+package category
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestDeleteCategorySuccess() {
+	s.categoryRepository.On("ListCategories", s.ctx).Return(categoryTree(), nil)
+	s.partRepository.On("ListParts", s.ctx, mock.MatchedBy(func(q *model.PartsQuery) bool {
+		return q.Filter.Categories[0] == "ION_ENGINE"
+	})).Return(&model.PartsPage{Parts: []*model.Part{}}, nil)
+	s.categoryRepository.On("DeleteCategory", s.ctx, model.Category("ION_ENGINE")).Return(nil)
+
+	s.Require().NoError(s.service.DeleteCategory(s.ctx, "ION_ENGINE"))
+}
+
+func (s *ServiceSuite) TestDeleteCategoryWithSubcategories() {
+	s.categoryRepository.On("ListCategories", s.ctx).Return(categoryTree(), nil)
+
+	err := s.service.DeleteCategory(s.ctx, "LIQUID_ENGINE")
+	s.Require().ErrorIs(err, model.ErrCategoryInUse)
+}
+
+func (s *ServiceSuite) TestDeleteCategoryWithParts() {
+	s.categoryRepository.On("ListCategories", s.ctx).Return(categoryTree(), nil)
+	s.partRepository.On("ListParts", s.ctx, mock.AnythingOfType("*model.PartsQuery")).
+		Return(&model.PartsPage{Parts: []*model.Part{{Uuid: "part"}}}, nil)
+
+	err := s.service.DeleteCategory(s.ctx, "CRYOGENIC_ENGINE")
+	s.Require().ErrorIs(err, model.ErrCategoryInUse)
+}
+
+func (s *ServiceSuite) TestDeleteLegacyCategory() {
+	err := s.service.DeleteCategory(s.ctx, model.CATEGORY_FUEL)
+	s.Require().ErrorIs(err, model.ErrCategoryInUse)
+}
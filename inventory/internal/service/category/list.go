@@ -0,0 +1,94 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+// ListCategories возвращает категории обходом дерева в глубину: родитель идет раньше подкатегорий,
+// соседние категории — по коду
+func (s *service) ListCategories(ctx context.Context) ([]*model.PartCategory, error) {
+	categories, err := s.categoryRepository.ListCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	children := childrenByParent(categories)
+	result := make([]*model.PartCategory, 0, len(categories))
+
+	var walk func(parent model.Category)
+	walk = func(parent model.Category) {
+		for _, category := range children[parent] {
+			result = append(result, category)
+			walk(category.Code)
+		}
+	}
+	walk(model.CATEGORY_UNSPECIFIED)
+
+	return result, nil
+}
+
+func (s *service) GetCategory(ctx context.Context, code model.Category) (*model.PartCategory, error) {
+	category, err := s.categoryRepository.GetCategory(ctx, code)
+	if err != nil {
+		if errors.Is(err, model.ErrCategoryNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+	return category, nil
+}
+
+// ExpandCategories добавляет к категориям все их подкатегории. Неизвестные коды остаются как есть
+func (s *service) ExpandCategories(ctx context.Context, codes []model.Category) ([]model.Category, error) {
+	if len(codes) == 0 {
+		return codes, nil
+	}
+
+	categories, err := s.categoryRepository.ListCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+	children := childrenByParent(categories)
+
+	seen := make(map[model.Category]struct{}, len(codes))
+	result := make([]model.Category, 0, len(codes))
+	queue := append([]model.Category(nil), codes...)
+	for len(queue) > 0 {
+		code := queue[0]
+		queue = queue[1:]
+		if _, ok := seen[code]; ok {
+			continue
+		}
+		seen[code] = struct{}{}
+		result = append(result, code)
+
+		for _, child := range children[code] {
+			queue = append(queue, child.Code)
+		}
+	}
+
+	return result, nil
+}
+
+// childrenByParent группирует категории по родителю, сохраняя порядок по коду из репозитория.
+// Категории с несуществующим родителем считаются корневыми, чтобы не пропасть из списка
+func childrenByParent(categories []*model.PartCategory) map[model.Category][]*model.PartCategory {
+	known := make(map[model.Category]struct{}, len(categories))
+	for _, category := range categories {
+		known[category.Code] = struct{}{}
+	}
+
+	children := make(map[model.Category][]*model.PartCategory, len(categories))
+	for _, category := range categories {
+		parent := category.ParentCode
+		if _, ok := known[parent]; !ok {
+			parent = model.CATEGORY_UNSPECIFIED
+		}
+		children[parent] = append(children[parent], category)
+	}
+	return children
+}
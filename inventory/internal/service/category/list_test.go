@@ -0,0 +1,36 @@
+package category
+
+import (
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestListCategoriesParentsFirst() {
+	s.categoryRepository.On("ListCategories", s.ctx).Return(categoryTree(), nil)
+
+	categories, err := s.service.ListCategories(s.ctx)
+	s.Require().NoError(err)
+
+	codes := make([]model.Category, 0, len(categories))
+	for _, category := range categories {
+		codes = append(codes, category.Code)
+	}
+	s.Require().Equal([]model.Category{
+		model.CATEGORY_ENGINE, "ION_ENGINE", "LIQUID_ENGINE", "CRYOGENIC_ENGINE", model.CATEGORY_FUEL,
+	}, codes)
+}
+
+func (s *ServiceSuite) TestExpandCategories() {
+	s.categoryRepository.On("ListCategories", s.ctx).Return(categoryTree(), nil)
+
+	codes, err := s.service.ExpandCategories(s.ctx, []model.Category{model.CATEGORY_ENGINE, "LIQUID_ENGINE", "HULL"})
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]model.Category{
+		model.CATEGORY_ENGINE, "ION_ENGINE", "LIQUID_ENGINE", "CRYOGENIC_ENGINE", "HULL",
+	}, codes)
+}
+
+func (s *ServiceSuite) TestExpandCategoriesEmpty() {
+	codes, err := s.service.ExpandCategories(s.ctx, nil)
+	s.Require().NoError(err)
+	s.Require().Empty(codes)
+}
@@ -0,0 +1,73 @@
+package category
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+// GetCategorySchema возвращает категорию и ее действующую схему метаданных: поля предков от корня,
+// затем собственные. Собственное поле заменяет унаследованное с тем же ключом
+func (s *service) GetCategorySchema(ctx context.Context, code model.Category) (*model.CategorySchema, error) {
+	categories, err := s.categoryRepository.ListCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	byCode := indexByCode(categories)
+	category, ok := byCode[code]
+	if !ok {
+		return nil, model.ErrCategoryNotFound
+	}
+
+	return &model.CategorySchema{
+		Category: category,
+		Fields:   mergeSchema(inheritedSchema(category.ParentCode, byCode), category.MetadataSchema),
+	}, nil
+}
+
+// inheritedSchema собирает схему категории parent с учетом ее предков
+func inheritedSchema(parent model.Category, byCode map[model.Category]*model.PartCategory) []*model.MetadataField {
+	var chain []*model.PartCategory
+	// Ограничение глубины защищает от цикла, если он все же оказался в базе
+	for code := parent; code != model.CATEGORY_UNSPECIFIED && len(chain) <= len(byCode); {
+		category, ok := byCode[code]
+		if !ok {
+			break
+		}
+		chain = append(chain, category)
+		code = category.ParentCode
+	}
+
+	var fields []*model.MetadataField
+	for i := len(chain) - 1; i >= 0; i-- {
+		fields = mergeSchema(fields, chain[i].MetadataSchema)
+	}
+	return fields
+}
+
+// mergeSchema дополняет схему base полями own, поле own с тем же ключом занимает место поля base
+func mergeSchema(base, own []*model.MetadataField) []*model.MetadataField {
+	fields := make([]*model.MetadataField, 0, len(base)+len(own))
+	positions := make(map[string]int, len(base)+len(own))
+	for _, group := range [][]*model.MetadataField{base, own} {
+		for _, field := range group {
+			if i, ok := positions[field.Key]; ok {
+				fields[i] = field
+				continue
+			}
+			positions[field.Key] = len(fields)
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+func indexByCode(categories []*model.PartCategory) map[model.Category]*model.PartCategory {
+	byCode := make(map[model.Category]*model.PartCategory, len(categories))
+	for _, category := range categories {
+		byCode[category.Code] = category
+	}
+	return byCode
+}
@@ -0,0 +1,88 @@
+package category
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+// schemaTree - ENGINE со схемой и подкатегория LIQUID_ENGINE, которая сужает диапазон тяги
+// и добавляет свое поле
+func schemaTree() []*model.PartCategory {
+	minThrust := 0.0
+	minLiquidThrust := 1000.0
+	return []*model.PartCategory{
+		{
+			Code:        model.CATEGORY_ENGINE,
+			DisplayName: "Двигатель",
+			MetadataSchema: []*model.MetadataField{
+				{Key: "тяга", Type: model.METADATA_FIELD_TYPE_NUMBER, Range: &model.FloatRange{Min: &minThrust}},
+				{Key: "топливо", Type: model.METADATA_FIELD_TYPE_STRING},
+			},
+		},
+		{
+			Code:        "LIQUID_ENGINE",
+			ParentCode:  model.CATEGORY_ENGINE,
+			DisplayName: "ЖРД",
+			MetadataSchema: []*model.MetadataField{
+				{Key: "тяга", Type: model.METADATA_FIELD_TYPE_NUMBER, Required: true, Range: &model.FloatRange{Min: &minLiquidThrust}},
+				{Key: "камер", Type: model.METADATA_FIELD_TYPE_INTEGER},
+			},
+		},
+	}
+}
+
+func (s *ServiceSuite) TestGetCategorySchemaInherited() {
+	s.categoryRepository.On("ListCategories", s.ctx).Return(schemaTree(), nil)
+
+	schema, err := s.service.GetCategorySchema(s.ctx, "LIQUID_ENGINE")
+	s.Require().NoError(err)
+	s.Require().Equal(model.Category("LIQUID_ENGINE"), schema.Category.Code)
+	s.Require().Len(schema.Fields, 3)
+
+	// Поле подкатегории остается на месте унаследованного
+	s.Require().Equal("тяга", schema.Fields[0].Key)
+	s.Require().True(schema.Fields[0].Required)
+	s.Require().InDelta(1000.0, *schema.Fields[0].Range.Min, 1e-9)
+	s.Require().Equal("топливо", schema.Fields[1].Key)
+	s.Require().Equal("камер", schema.Fields[2].Key)
+}
+
+func (s *ServiceSuite) TestGetCategorySchemaNotFound() {
+	s.categoryRepository.On("ListCategories", s.ctx).Return(schemaTree(), nil)
+
+	_, err := s.service.GetCategorySchema(s.ctx, "HULL")
+	s.Require().ErrorIs(err, model.ErrCategoryNotFound)
+}
+
+func (s *ServiceSuite) TestCreateCategoryRejectsInheritedTypeChange() {
+	s.categoryRepository.On("ListCategories", s.ctx).Return(schemaTree(), nil)
+
+	_, err := s.service.CreateCategory(s.ctx, &model.PartCategory{
+		Code:        "CRYOGENIC_ENGINE",
+		ParentCode:  "LIQUID_ENGINE",
+		DisplayName: "Криогенный двигатель",
+		MetadataSchema: []*model.MetadataField{
+			{Key: "камер", Type: model.METADATA_FIELD_TYPE_STRING},
+		},
+	})
+	s.Require().ErrorIs(err, model.ErrInvalidCategory)
+}
+
+func (s *ServiceSuite) TestCreateCategoryInvalidRange() {
+	low, high := 10.0, 1.0
+	cases := map[string]*model.MetadataField{
+		"min above max": {Key: "ход", Type: model.METADATA_FIELD_TYPE_NUMBER, Range: &model.FloatRange{Min: &low, Max: &high}},
+		"not numeric":   {Key: "марка", Type: model.METADATA_FIELD_TYPE_STRING, Range: &model.FloatRange{Min: &high}},
+	}
+
+	for name, field := range cases {
+		_, err := s.service.CreateCategory(s.ctx, &model.PartCategory{
+			Code:           "LANDING_GEAR",
+			DisplayName:    "Шасси",
+			MetadataSchema: []*model.MetadataField{field},
+		})
+		s.Require().ErrorIs(err, model.ErrInvalidCategory, name)
+	}
+	s.categoryRepository.AssertNotCalled(s.T(), "CreateCategory", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,23 @@
+package category
+
+import (
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository"
+	def "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/service"
+)
+
+var _ def.CategoryService = (*service)(nil)
+
+type service struct {
+	categoryRepository repository.CategoryRepository
+	partRepository     repository.PartRepository
+}
+
+func NewService(
+	categoryRepository repository.CategoryRepository,
+	partRepository repository.PartRepository,
+) *service {
+	return &service{
+		categoryRepository: categoryRepository,
+		partRepository:     partRepository,
+	}
+}
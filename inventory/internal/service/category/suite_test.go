@@ -0,0 +1,51 @@
+package category
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/mocks"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	ctx                context.Context
+	categoryRepository *mocks.CategoryRepository
+	partRepository     *mocks.PartRepository
+	service            *service
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctx = context.Background()
+
+	s.categoryRepository = mocks.NewCategoryRepository(s.T())
+	s.partRepository = mocks.NewPartRepository(s.T())
+
+	s.service = NewService(
+		s.categoryRepository,
+		s.partRepository,
+	)
+}
+
+func (s *ServiceSuite) TearDownTest() {}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
+
+// categoryTree - ENGINE с подкатегориями LIQUID_ENGINE и ION_ENGINE, у LIQUID_ENGINE есть
+// подкатегория CRYOGENIC_ENGINE. Коды отсортированы, как их возвращает репозиторий
+func categoryTree() []*model.PartCategory {
+	now := time.Now()
+	return []*model.PartCategory{
+		{Code: "CRYOGENIC_ENGINE", ParentCode: "LIQUID_ENGINE", DisplayName: "Криогенный двигатель", CreatedAt: now},
+		{Code: model.CATEGORY_ENGINE, DisplayName: "Двигатель", CreatedAt: now},
+		{Code: model.CATEGORY_FUEL, DisplayName: "Топливо", CreatedAt: now},
+		{Code: "ION_ENGINE", ParentCode: model.CATEGORY_ENGINE, DisplayName: "Ионный двигатель", CreatedAt: now},
+		{Code: "LIQUID_ENGINE", ParentCode: model.CATEGORY_ENGINE, DisplayName: "ЖРД", CreatedAt: now},
+	}
+}
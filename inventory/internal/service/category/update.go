@@ -0,0 +1,84 @@
+package category
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+var allCategoryFields = []string{
+	model.CategoryFieldDisplayName,
+	model.CategoryFieldDescription,
+	model.CategoryFieldParentCode,
+	model.CategoryFieldMetadataSchema,
+}
+
+// UpdateCategory применяет к текущей категории поля из маски. Код категории не меняется:
+// по нему на категорию ссылаются детали и правила совместимости
+func (s *service) UpdateCategory(ctx context.Context, update *model.CategoryUpdate) (*model.PartCategory, error) {
+	normalizeCategory(update.Category)
+
+	current, err := s.categoryRepository.GetCategory(ctx, update.Category.Code)
+	if err != nil {
+		if errors.Is(err, model.ErrCategoryNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get category: %w", err)
+	}
+
+	fields := update.Fields
+	if len(fields) == 0 {
+		fields = allCategoryFields
+	}
+	if err = applyFields(current, update.Category, fields); err != nil {
+		return nil, err
+	}
+
+	if err = validateCategory(current); err != nil {
+		return nil, err
+	}
+
+	categories, err := s.categoryRepository.ListCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+	if err = validateParent(current, categories); err != nil {
+		return nil, err
+	}
+	if err = validateInheritedSchema(current, categories); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	current.UpdatedAt = &now
+
+	if err = s.categoryRepository.UpdateCategory(ctx, current); err != nil {
+		if errors.Is(err, model.ErrCategoryNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to update category: %w", err)
+	}
+
+	return current, nil
+}
+
+func applyFields(dst, src *model.PartCategory, fields []string) error {
+	for _, field := range fields {
+		switch field {
+		case model.CategoryFieldDisplayName:
+			dst.DisplayName = src.DisplayName
+		case model.CategoryFieldDescription:
+			dst.Description = src.Description
+		case model.CategoryFieldParentCode:
+			dst.ParentCode = src.ParentCode
+		case model.CategoryFieldMetadataSchema:
+			dst.MetadataSchema = src.MetadataSchema
+		default:
+			return fmt.Errorf("%w: unknown field %q", model.ErrInvalidUpdateMask, field)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package category
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestUpdateCategoryMaskedFields() {
+	current := categoryTree()[3]
+
+	s.categoryRepository.On("GetCategory", s.ctx, model.Category("ION_ENGINE")).Return(current, nil)
+	s.categoryRepository.On("ListCategories", s.ctx).Return(categoryTree(), nil)
+	s.categoryRepository.On("UpdateCategory", s.ctx, mock.MatchedBy(func(c *model.PartCategory) bool {
+		return c.DisplayName == "Ионный двигатель малой тяги" && c.ParentCode == model.CATEGORY_ENGINE
+	})).Return(nil)
+
+	updated, err := s.service.UpdateCategory(s.ctx, &model.CategoryUpdate{
+		Category: &model.PartCategory{Code: "ION_ENGINE", DisplayName: "Ионный двигатель малой тяги"},
+		Fields:   []string{model.CategoryFieldDisplayName},
+	})
+	s.Require().NoError(err)
+	s.Require().NotNil(updated.UpdatedAt)
+}
+
+func (s *ServiceSuite) TestUpdateCategoryRejectsCycle() {
+	current := categoryTree()[4]
+
+	s.categoryRepository.On("GetCategory", s.ctx, model.Category("LIQUID_ENGINE")).Return(current, nil)
+	s.categoryRepository.On("ListCategories", s.ctx).Return(categoryTree(), nil)
+
+	_, err := s.service.UpdateCategory(s.ctx, &model.CategoryUpdate{
+		Category: &model.PartCategory{Code: "LIQUID_ENGINE", ParentCode: "CRYOGENIC_ENGINE"},
+		Fields:   []string{model.CategoryFieldParentCode},
+	})
+	s.Require().ErrorIs(err, model.ErrInvalidCategory)
+}
+
+func (s *ServiceSuite) TestUpdateCategoryUnknownField() {
+	s.categoryRepository.On("GetCategory", s.ctx, model.CATEGORY_FUEL).Return(categoryTree()[2], nil)
+
+	_, err := s.service.UpdateCategory(s.ctx, &model.CategoryUpdate{
+		Category: &model.PartCategory{Code: model.CATEGORY_FUEL},
+		Fields:   []string{"code"},
+	})
+	s.Require().ErrorIs(err, model.ErrInvalidUpdateMask)
+}
+
+func (s *ServiceSuite) TestUpdateCategoryNotFound() {
+	s.categoryRepository.On("GetCategory", s.ctx, model.Category("HULL")).Return(nil, model.ErrCategoryNotFound)
+
+	_, err := s.service.UpdateCategory(s.ctx, &model.CategoryUpdate{
+		Category: &model.PartCategory{Code: "HULL", DisplayName: "Корпус"},
+	})
+	s.Require().ErrorIs(err, model.ErrCategoryNotFound)
+}
@@ -0,0 +1,108 @@
+package category
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+// Код категории совпадает по виду с именами значений enum Category без префикса
+var codePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+
+func normalizeCategory(category *model.PartCategory) {
+	category.Code = model.Category(strings.ToUpper(strings.TrimSpace(string(category.Code))))
+	category.ParentCode = model.Category(strings.ToUpper(strings.TrimSpace(string(category.ParentCode))))
+	category.DisplayName = strings.TrimSpace(category.DisplayName)
+}
+
+func validateCategory(category *model.PartCategory) error {
+	switch {
+	case !codePattern.MatchString(string(category.Code)):
+		return fmt.Errorf("%w: code must match %s", model.ErrInvalidCategory, codePattern)
+	case category.DisplayName == "":
+		return fmt.Errorf("%w: display_name is required", model.ErrInvalidCategory)
+	case category.ParentCode == category.Code:
+		return fmt.Errorf("%w: category cannot be its own parent", model.ErrInvalidCategory)
+	}
+
+	keys := make(map[string]struct{}, len(category.MetadataSchema))
+	for _, field := range category.MetadataSchema {
+		// Ключ подставляется в путь metadata.<key> фильтров, точка и $ изменили бы смысл запроса
+		if field.Key == "" || strings.Contains(field.Key, ".") || strings.HasPrefix(field.Key, "$") {
+			return fmt.Errorf("%w: invalid metadata key %q", model.ErrInvalidCategory, field.Key)
+		}
+		if _, ok := keys[field.Key]; ok {
+			return fmt.Errorf("%w: duplicate metadata key %q", model.ErrInvalidCategory, field.Key)
+		}
+		keys[field.Key] = struct{}{}
+
+		if err := validateMetadataField(field); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateMetadataField(field *model.MetadataField) error {
+	if field.Type == model.METADATA_FIELD_TYPE_UNSPECIFIED {
+		return fmt.Errorf("%w: metadata %q type is required", model.ErrInvalidCategory, field.Key)
+	}
+	if field.Range == nil {
+		return nil
+	}
+
+	if field.Type != model.METADATA_FIELD_TYPE_NUMBER && field.Type != model.METADATA_FIELD_TYPE_INTEGER {
+		return fmt.Errorf("%w: metadata %q range is allowed only for numeric fields", model.ErrInvalidCategory, field.Key)
+	}
+	if field.Range.Min != nil && field.Range.Max != nil && *field.Range.Min > *field.Range.Max {
+		return fmt.Errorf("%w: metadata %q range min is greater than max", model.ErrInvalidCategory, field.Key)
+	}
+
+	return nil
+}
+
+// validateParent проверяет, что родитель существует и категория не становится предком самой себя
+func validateParent(category *model.PartCategory, categories []*model.PartCategory) error {
+	if category.ParentCode == model.CATEGORY_UNSPECIFIED {
+		return nil
+	}
+
+	parents := make(map[model.Category]model.Category, len(categories))
+	for _, c := range categories {
+		parents[c.Code] = c.ParentCode
+	}
+
+	if _, ok := parents[category.ParentCode]; !ok {
+		return fmt.Errorf("%w: parent category %s not found", model.ErrInvalidCategory, category.ParentCode)
+	}
+
+	for code := category.ParentCode; code != model.CATEGORY_UNSPECIFIED; code = parents[code] {
+		if code == category.Code {
+			return fmt.Errorf("%w: category %s cannot be moved under its subcategory %s",
+				model.ErrInvalidCategory, category.Code, category.ParentCode)
+		}
+	}
+
+	return nil
+}
+
+// validateInheritedSchema проверяет, что поля категории не меняют тип полей, унаследованных от родителей:
+// иначе фильтр по metadata родительской категории сравнивал бы значения разных типов
+func validateInheritedSchema(category *model.PartCategory, categories []*model.PartCategory) error {
+	inherited := make(map[string]*model.MetadataField)
+	for _, field := range inheritedSchema(category.ParentCode, indexByCode(categories)) {
+		inherited[field.Key] = field
+	}
+
+	for _, field := range category.MetadataSchema {
+		if parentField, ok := inherited[field.Key]; ok && parentField.Type != field.Type {
+			return fmt.Errorf("%w: metadata %q type differs from the inherited field",
+				model.ErrInvalidCategory, field.Key)
+		}
+	}
+
+	return nil
+}
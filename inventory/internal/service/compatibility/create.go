@@ -0,0 +1,75 @@
+package compatibility
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *service) CreateRule(ctx context.Context, rule *model.CompatibilityRule) (*model.CompatibilityRule, error) {
+	if err := validateRule(rule); err != nil {
+		return nil, err
+	}
+
+	var err error
+	if rule.Subject, err = s.resolveTarget(ctx, rule.Subject); err != nil {
+		return nil, err
+	}
+	if rule.Object, err = s.resolveTarget(ctx, rule.Object); err != nil {
+		return nil, err
+	}
+
+	rule.Uuid = uuid.NewString()
+	rule.CreatedAt = time.Now()
+
+	if err = s.compatibilityRepository.CreateRule(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+func validateRule(rule *model.CompatibilityRule) error {
+	switch rule.Type {
+	case model.COMPATIBILITY_RULE_TYPE_REQUIRES:
+	case model.COMPATIBILITY_RULE_TYPE_EXCLUDES, model.COMPATIBILITY_RULE_TYPE_COMPATIBLE_WITH:
+		if rule.Subject.IsEmpty() {
+			return fmt.Errorf("%w: subject is required", model.ErrInvalidCompatibilityRule)
+		}
+	default:
+		return fmt.Errorf("%w: unknown rule type", model.ErrInvalidCompatibilityRule)
+	}
+
+	if rule.Object.IsEmpty() {
+		return fmt.Errorf("%w: object is required", model.ErrInvalidCompatibilityRule)
+	}
+	if rule.Subject == rule.Object {
+		return fmt.Errorf("%w: subject and object must differ", model.ErrInvalidCompatibilityRule)
+	}
+
+	return nil
+}
+
+// resolveTarget подставляет в цель-деталь ее категорию из каталога.
+// COMPATIBLE_WITH группирует допустимые детали по категории, поэтому она должна быть точной
+func (s *service) resolveTarget(ctx context.Context, target model.RuleTarget) (model.RuleTarget, error) {
+	if target.PartUuid == "" {
+		return target, nil
+	}
+
+	part, err := s.partRepository.GetPart(ctx, target.PartUuid)
+	if err != nil {
+		if errors.Is(err, model.ErrPartNotFound) {
+			return target, err
+		}
+		return target, fmt.Errorf("failed to get part: %w", err)
+	}
+
+	target.Category = part.Category
+	return target, nil
+}
@@ -0,0 +1,59 @@
+package compatibility
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestCreateRuleResolvesPartCategory() {
+	engineUUID := gofakeit.UUID()
+
+	s.partRepository.On("GetPart", s.ctx, engineUUID).
+		Return(&model.Part{Uuid: engineUUID, Category: model.CATEGORY_ENGINE}, nil)
+	s.compatibilityRepository.On("CreateRule", s.ctx, mock.MatchedBy(func(r *model.CompatibilityRule) bool {
+		return r.Uuid != "" && !r.CreatedAt.IsZero() && r.Subject.Category == model.CATEGORY_ENGINE
+	})).Return(nil)
+
+	rule, err := s.service.CreateRule(s.ctx, &model.CompatibilityRule{
+		Type:    model.COMPATIBILITY_RULE_TYPE_REQUIRES,
+		Subject: model.RuleTarget{PartUuid: engineUUID},
+		Object:  model.RuleTarget{Category: model.CATEGORY_FUEL},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(model.CATEGORY_ENGINE, rule.Subject.Category)
+}
+
+func (s *ServiceSuite) TestCreateRuleInvalid() {
+	rules := []*model.CompatibilityRule{
+		{Object: model.RuleTarget{Category: model.CATEGORY_FUEL}},
+		{Type: model.COMPATIBILITY_RULE_TYPE_REQUIRES},
+		{Type: model.COMPATIBILITY_RULE_TYPE_EXCLUDES, Object: model.RuleTarget{Category: model.CATEGORY_FUEL}},
+		{
+			Type:    model.COMPATIBILITY_RULE_TYPE_EXCLUDES,
+			Subject: model.RuleTarget{Category: model.CATEGORY_FUEL},
+			Object:  model.RuleTarget{Category: model.CATEGORY_FUEL},
+		},
+	}
+
+	for _, rule := range rules {
+		created, err := s.service.CreateRule(s.ctx, rule)
+		s.Require().ErrorIs(err, model.ErrInvalidCompatibilityRule)
+		s.Require().Nil(created)
+	}
+}
+
+func (s *ServiceSuite) TestCreateRuleUnknownPart() {
+	partUUID := gofakeit.UUID()
+
+	s.partRepository.On("GetPart", s.ctx, partUUID).Return(nil, model.ErrPartNotFound)
+
+	created, err := s.service.CreateRule(s.ctx, &model.CompatibilityRule{
+		Type:    model.COMPATIBILITY_RULE_TYPE_EXCLUDES,
+		Subject: model.RuleTarget{PartUuid: partUUID},
+		Object:  model.RuleTarget{Category: model.CATEGORY_FUEL},
+	})
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+	s.Require().Nil(created)
+}
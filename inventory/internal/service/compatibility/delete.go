@@ -0,0 +1,9 @@
+package compatibility
+
+import (
+	"context"
+)
+
+func (s *service) DeleteRule(ctx context.Context, uuid string) error {
+	return s.compatibilityRepository.DeleteRule(ctx, uuid)
+}
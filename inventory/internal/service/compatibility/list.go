@@ -0,0 +1,11 @@
+package compatibility
+
+import (
+	"context"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *service) ListRules(ctx context.Context) ([]*model.CompatibilityRule, error) {
+	return s.compatibilityRepository.ListRules(ctx)
+}
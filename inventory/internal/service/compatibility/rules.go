@@ -0,0 +1,163 @@
+package compatibility
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+// compatibleGroup - правила COMPATIBLE_WITH одного субъекта для одной категории объектов.
+// Деталь этой категории допустима рядом с субъектом, если подходит хотя бы под одно правило группы
+type compatibleGroup struct {
+	subject  model.RuleTarget
+	category model.Category
+	rules    []*model.CompatibilityRule
+}
+
+// checkConfiguration применяет правила к деталям конфигурации и возвращает все нарушения.
+// Правило на категорию распространяется и на ее подкатегории из parents
+func checkConfiguration(rules []*model.CompatibilityRule, parts []*model.Part, parents model.CategoryParents) []*model.ConfigurationViolation {
+	names := make(map[string]string, len(parts))
+	for _, part := range parts {
+		names[part.Uuid] = part.Name
+	}
+
+	var (
+		violations []*model.ConfigurationViolation
+		groups     []*compatibleGroup
+	)
+
+	for _, rule := range rules {
+		switch rule.Type {
+		case model.COMPATIBILITY_RULE_TYPE_REQUIRES:
+			violations = append(violations, checkRequires(rule, parts, names, parents)...)
+		case model.COMPATIBILITY_RULE_TYPE_EXCLUDES:
+			violations = append(violations, checkExcludes(rule, parts, names, parents)...)
+		case model.COMPATIBILITY_RULE_TYPE_COMPATIBLE_WITH:
+			groups = addToGroup(groups, rule)
+		}
+	}
+
+	for _, group := range groups {
+		violations = append(violations, checkCompatible(group, parts, names, parents)...)
+	}
+
+	return violations
+}
+
+func checkRequires(rule *model.CompatibilityRule, parts []*model.Part, names map[string]string, parents model.CategoryParents) []*model.ConfigurationViolation {
+	if len(matching(rule.Object, parts, parents)) > 0 {
+		return nil
+	}
+
+	// Правило без субъекта относится к конфигурации целиком
+	if rule.Subject.IsEmpty() {
+		return []*model.ConfigurationViolation{
+			newViolation(rule, nil, fmt.Sprintf("configuration requires %s", describe(rule.Object, names))),
+		}
+	}
+
+	var violations []*model.ConfigurationViolation
+	for _, subject := range matching(rule.Subject, parts, parents) {
+		violations = append(violations, newViolation(rule, []string{subject.Uuid},
+			fmt.Sprintf("%q requires %s", subject.Name, describe(rule.Object, names))))
+	}
+	return violations
+}
+
+func checkExcludes(rule *model.CompatibilityRule, parts []*model.Part, names map[string]string, parents model.CategoryParents) []*model.ConfigurationViolation {
+	var violations []*model.ConfigurationViolation
+	for _, subject := range matching(rule.Subject, parts, parents) {
+		for _, object := range matching(rule.Object, parts, parents) {
+			if object.Uuid == subject.Uuid {
+				continue
+			}
+			violations = append(violations, newViolation(rule, []string{subject.Uuid, object.Uuid},
+				fmt.Sprintf("%q cannot be combined with %q", subject.Name, object.Name)))
+		}
+	}
+	return violations
+}
+
+func checkCompatible(group *compatibleGroup, parts []*model.Part, names map[string]string, parents model.CategoryParents) []*model.ConfigurationViolation {
+	var violations []*model.ConfigurationViolation
+	for _, subject := range matching(group.subject, parts, parents) {
+		for _, part := range parts {
+			if part.Uuid == subject.Uuid || !parents.IsWithin(part.Category, group.category) || group.allows(part, parents) {
+				continue
+			}
+			violations = append(violations, newViolation(group.rules[0], []string{subject.Uuid, part.Uuid},
+				fmt.Sprintf("%q is not compatible with %q, allowed %s parts: %s",
+					part.Name, subject.Name, group.category, group.describeAllowed(names))))
+		}
+	}
+	return violations
+}
+
+func addToGroup(groups []*compatibleGroup, rule *model.CompatibilityRule) []*compatibleGroup {
+	for _, group := range groups {
+		if group.subject == rule.Subject && group.category == rule.Object.Category {
+			group.rules = append(group.rules, rule)
+			return groups
+		}
+	}
+
+	return append(groups, &compatibleGroup{
+		subject:  rule.Subject,
+		category: rule.Object.Category,
+		rules:    []*model.CompatibilityRule{rule},
+	})
+}
+
+func (g *compatibleGroup) allows(part *model.Part, parents model.CategoryParents) bool {
+	for _, rule := range g.rules {
+		if rule.Object.Matches(part, parents) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *compatibleGroup) describeAllowed(names map[string]string) string {
+	allowed := make([]string, 0, len(g.rules))
+	for _, rule := range g.rules {
+		allowed = append(allowed, describe(rule.Object, names))
+	}
+	return strings.Join(allowed, ", ")
+}
+
+func matching(target model.RuleTarget, parts []*model.Part, parents model.CategoryParents) []*model.Part {
+	var result []*model.Part
+	for _, part := range parts {
+		if target.Matches(part, parents) {
+			result = append(result, part)
+		}
+	}
+	return result
+}
+
+// describe возвращает читаемое описание цели: название детали, если она есть в конфигурации,
+// иначе ее UUID, или категорию
+func describe(target model.RuleTarget, names map[string]string) string {
+	if target.PartUuid == "" {
+		return fmt.Sprintf("a part of category %s", target.Category)
+	}
+	if name, ok := names[target.PartUuid]; ok {
+		return fmt.Sprintf("%q", name)
+	}
+	return fmt.Sprintf("part %s", target.PartUuid)
+}
+
+func newViolation(rule *model.CompatibilityRule, partUUIDs []string, message string) *model.ConfigurationViolation {
+	if rule.Description != "" {
+		message += ": " + rule.Description
+	}
+
+	return &model.ConfigurationViolation{
+		RuleUuid:  rule.Uuid,
+		Type:      rule.Type,
+		PartUuids: partUUIDs,
+		Message:   message,
+	}
+}
@@ -0,0 +1,26 @@
+package compatibility
+
+import (
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository"
+	def "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/service"
+)
+
+var _ def.CompatibilityService = (*service)(nil)
+
+type service struct {
+	compatibilityRepository repository.CompatibilityRepository
+	partRepository          repository.PartRepository
+	categoryRepository      repository.CategoryRepository
+}
+
+func NewService(
+	compatibilityRepository repository.CompatibilityRepository,
+	partRepository repository.PartRepository,
+	categoryRepository repository.CategoryRepository,
+) *service {
+	return &service{
+		compatibilityRepository: compatibilityRepository,
+		partRepository:          partRepository,
+		categoryRepository:      categoryRepository,
+	}
+}
@@ -0,0 +1,39 @@
+package compatibility
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/mocks"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	ctx                     context.Context
+	compatibilityRepository *mocks.CompatibilityRepository
+	partRepository          *mocks.PartRepository
+	categoryRepository      *mocks.CategoryRepository
+	service                 *service
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctx = context.Background()
+
+	s.compatibilityRepository = mocks.NewCompatibilityRepository(s.T())
+	s.partRepository = mocks.NewPartRepository(s.T())
+	s.categoryRepository = mocks.NewCategoryRepository(s.T())
+
+	s.service = NewService(
+		s.compatibilityRepository,
+		s.partRepository,
+		s.categoryRepository,
+	)
+}
+
+func (s *ServiceSuite) TearDownTest() {}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
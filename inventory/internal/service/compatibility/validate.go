@@ -0,0 +1,72 @@
+package compatibility
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *service) ValidateConfiguration(ctx context.Context, partUUIDs []string) (*model.ConfigurationValidation, error) {
+	uuids := uniqueUUIDs(partUUIDs)
+	if len(uuids) == 0 {
+		return nil, model.ErrEmptyConfiguration
+	}
+
+	page, err := s.partRepository.ListParts(ctx, &model.PartsQuery{
+		Filter:   &model.PartsFilter{Uuids: uuids},
+		PageSize: int32(len(uuids)), //nolint:gosec // количество деталей в конфигурации невелико
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parts: %w", err)
+	}
+	if missing := missingUUIDs(uuids, page.Parts); len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", model.ErrPartNotFound, strings.Join(missing, ", "))
+	}
+
+	rules, err := s.compatibilityRepository.ListRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := s.categoryRepository.ListCategories(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list categories: %w", err)
+	}
+
+	return &model.ConfigurationValidation{
+		Violations: checkConfiguration(rules, page.Parts, model.NewCategoryParents(categories)),
+	}, nil
+}
+
+func uniqueUUIDs(uuids []string) []string {
+	seen := make(map[string]struct{}, len(uuids))
+	result := make([]string, 0, len(uuids))
+	for _, id := range uuids {
+		if id == "" {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		result = append(result, id)
+	}
+	return result
+}
+
+func missingUUIDs(uuids []string, parts []*model.Part) []string {
+	found := make(map[string]struct{}, len(parts))
+	for _, part := range parts {
+		found[part.Uuid] = struct{}{}
+	}
+
+	var missing []string
+	for _, id := range uuids {
+		if _, ok := found[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+	return missing
+}
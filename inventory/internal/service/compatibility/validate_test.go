@@ -0,0 +1,156 @@
+package compatibility
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+var (
+	rd180 = &model.Part{Uuid: "rd-180", Name: "RD-180", Category: model.CATEGORY_ENGINE}
+	lh2   = &model.Part{Uuid: "lh2", Name: "LH2", Category: model.CATEGORY_FUEL}
+	rp1   = &model.Part{Uuid: "rp-1", Name: "RP-1", Category: model.CATEGORY_FUEL}
+	wing  = &model.Part{Uuid: "wing", Name: "Delta-V", Category: model.CATEGORY_WING}
+
+	// Подкатегории справочника: жидкостные двигатели вложены в ENGINE, криогенное топливо - в FUEL
+	categoryLiquidEngine model.Category = "LIQUID_ENGINE"
+	categoryCryoFuel     model.Category = "CRYO_FUEL"
+
+	raptor   = &model.Part{Uuid: "raptor", Name: "Raptor", Category: categoryLiquidEngine}
+	methalox = &model.Part{Uuid: "methalox", Name: "Methalox", Category: categoryCryoFuel}
+
+	categories = []*model.PartCategory{
+		{Code: model.CATEGORY_ENGINE},
+		{Code: model.CATEGORY_FUEL},
+		{Code: model.CATEGORY_WING},
+		{Code: categoryLiquidEngine, ParentCode: model.CATEGORY_ENGINE},
+		{Code: categoryCryoFuel, ParentCode: model.CATEGORY_FUEL},
+	}
+
+	requireEngine = &model.CompatibilityRule{
+		Uuid:   "require-engine",
+		Type:   model.COMPATIBILITY_RULE_TYPE_REQUIRES,
+		Object: model.RuleTarget{Category: model.CATEGORY_ENGINE},
+	}
+	engineRequiresFuel = &model.CompatibilityRule{
+		Uuid:    "engine-requires-fuel",
+		Type:    model.COMPATIBILITY_RULE_TYPE_REQUIRES,
+		Subject: model.RuleTarget{Category: model.CATEGORY_ENGINE},
+		Object:  model.RuleTarget{Category: model.CATEGORY_FUEL},
+	}
+	rd180ExcludesLH2 = &model.CompatibilityRule{
+		Uuid:        "rd-180-excludes-lh2",
+		Type:        model.COMPATIBILITY_RULE_TYPE_EXCLUDES,
+		Subject:     model.RuleTarget{PartUuid: rd180.Uuid, Category: model.CATEGORY_ENGINE},
+		Object:      model.RuleTarget{PartUuid: lh2.Uuid, Category: model.CATEGORY_FUEL},
+		Description: "kerosene engine",
+	}
+	rd180WithRP1 = &model.CompatibilityRule{
+		Uuid:    "rd-180-with-rp-1",
+		Type:    model.COMPATIBILITY_RULE_TYPE_COMPATIBLE_WITH,
+		Subject: model.RuleTarget{PartUuid: rd180.Uuid, Category: model.CATEGORY_ENGINE},
+		Object:  model.RuleTarget{PartUuid: rp1.Uuid, Category: model.CATEGORY_FUEL},
+	}
+)
+
+func (s *ServiceSuite) TestCheckConfiguration() {
+	tests := []struct {
+		name     string
+		rules    []*model.CompatibilityRule
+		parts    []*model.Part
+		expected []string
+	}{
+		{
+			name:     "no engine",
+			rules:    []*model.CompatibilityRule{requireEngine},
+			parts:    []*model.Part{wing},
+			expected: []string{"configuration requires a part of category ENGINE"},
+		},
+		{
+			name:     "engine without fuel",
+			rules:    []*model.CompatibilityRule{requireEngine, engineRequiresFuel},
+			parts:    []*model.Part{rd180, wing},
+			expected: []string{`"RD-180" requires a part of category FUEL`},
+		},
+		{
+			name:     "excluded fuel",
+			rules:    []*model.CompatibilityRule{rd180ExcludesLH2},
+			parts:    []*model.Part{rd180, lh2},
+			expected: []string{`"RD-180" cannot be combined with "LH2": kerosene engine`},
+		},
+		{
+			name:     "fuel outside of compatible list",
+			rules:    []*model.CompatibilityRule{rd180WithRP1},
+			parts:    []*model.Part{rd180, lh2, wing},
+			expected: []string{`"LH2" is not compatible with "RD-180", allowed FUEL parts: part rp-1`},
+		},
+		{
+			name:  "valid configuration",
+			rules: []*model.CompatibilityRule{requireEngine, engineRequiresFuel, rd180ExcludesLH2, rd180WithRP1},
+			parts: []*model.Part{rd180, rp1, wing},
+		},
+		{
+			name:  "subcategory engine satisfies category requirement",
+			rules: []*model.CompatibilityRule{requireEngine, engineRequiresFuel},
+			parts: []*model.Part{raptor, methalox},
+		},
+		{
+			name:     "subcategory engine is a category subject",
+			rules:    []*model.CompatibilityRule{engineRequiresFuel},
+			parts:    []*model.Part{raptor, wing},
+			expected: []string{`"Raptor" requires a part of category FUEL`},
+		},
+		{
+			name:     "subcategory fuel outside of compatible list",
+			rules:    []*model.CompatibilityRule{rd180WithRP1},
+			parts:    []*model.Part{rd180, methalox},
+			expected: []string{`"Methalox" is not compatible with "RD-180", allowed FUEL parts: part rp-1`},
+		},
+	}
+
+	parents := model.NewCategoryParents(categories)
+	for _, tt := range tests {
+		violations := checkConfiguration(tt.rules, tt.parts, parents)
+
+		messages := make([]string, 0, len(violations))
+		for _, violation := range violations {
+			messages = append(messages, violation.Message)
+		}
+		s.Require().ElementsMatch(tt.expected, messages, tt.name)
+	}
+}
+
+func (s *ServiceSuite) TestValidateConfigurationSuccess() {
+	s.partRepository.On("ListParts", s.ctx, mock.MatchedBy(func(q *model.PartsQuery) bool {
+		return len(q.Filter.Uuids) == 2 && q.PageSize == 2
+	})).Return(&model.PartsPage{Parts: []*model.Part{rd180, lh2}}, nil)
+	s.compatibilityRepository.On("ListRules", s.ctx).
+		Return([]*model.CompatibilityRule{requireEngine, rd180ExcludesLH2}, nil)
+	s.categoryRepository.On("ListCategories", s.ctx).Return(categories, nil)
+
+	validation, err := s.service.ValidateConfiguration(s.ctx, []string{rd180.Uuid, lh2.Uuid, rd180.Uuid})
+	s.Require().NoError(err)
+	s.Require().False(validation.Valid())
+	s.Require().Len(validation.Violations, 1)
+	s.Require().Equal(rd180ExcludesLH2.Uuid, validation.Violations[0].RuleUuid)
+	s.Require().Equal([]string{rd180.Uuid, lh2.Uuid}, validation.Violations[0].PartUuids)
+}
+
+func (s *ServiceSuite) TestValidateConfigurationMissingPart() {
+	missingUUID := gofakeit.UUID()
+
+	s.partRepository.On("ListParts", s.ctx, mock.AnythingOfType("*model.PartsQuery")).
+		Return(&model.PartsPage{Parts: []*model.Part{rd180}}, nil)
+
+	validation, err := s.service.ValidateConfiguration(s.ctx, []string{rd180.Uuid, missingUUID})
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+	s.Require().Contains(err.Error(), missingUUID)
+	s.Require().Nil(validation)
+}
+
+func (s *ServiceSuite) TestValidateConfigurationEmpty() {
+	validation, err := s.service.ValidateConfiguration(s.ctx, []string{""})
+	s.Require().ErrorIs(err, model.ErrEmptyConfiguration)
+	s.Require().Nil(validation)
+}
@@ -0,0 +1,215 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	io "io"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+// AttachmentService is an autogenerated mock type for the AttachmentService type
+type AttachmentService struct {
+	mock.Mock
+}
+
+type AttachmentService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *AttachmentService) EXPECT() *AttachmentService_Expecter {
+	return &AttachmentService_Expecter{mock: &_m.Mock}
+}
+
+// DeleteAttachment provides a mock function with given fields: ctx, uuid
+func (_m *AttachmentService) DeleteAttachment(ctx context.Context, uuid string) error {
+	ret := _m.Called(ctx, uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAttachment")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, uuid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AttachmentService_DeleteAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAttachment'
+type AttachmentService_DeleteAttachment_Call struct {
+	*mock.Call
+}
+
+// DeleteAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+func (_e *AttachmentService_Expecter) DeleteAttachment(ctx interface{}, uuid interface{}) *AttachmentService_DeleteAttachment_Call {
+	return &AttachmentService_DeleteAttachment_Call{Call: _e.mock.On("DeleteAttachment", ctx, uuid)}
+}
+
+func (_c *AttachmentService_DeleteAttachment_Call) Run(run func(ctx context.Context, uuid string)) *AttachmentService_DeleteAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AttachmentService_DeleteAttachment_Call) Return(_a0 error) *AttachmentService_DeleteAttachment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *AttachmentService_DeleteAttachment_Call) RunAndReturn(run func(context.Context, string) error) *AttachmentService_DeleteAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// OpenAttachment provides a mock function with given fields: ctx, uuid
+func (_m *AttachmentService) OpenAttachment(ctx context.Context, uuid string) (*model.Attachment, io.ReadCloser, error) {
+	ret := _m.Called(ctx, uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for OpenAttachment")
+	}
+
+	var r0 *model.Attachment
+	var r1 io.ReadCloser
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Attachment, io.ReadCloser, error)); ok {
+		return rf(ctx, uuid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Attachment); ok {
+		r0 = rf(ctx, uuid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) io.ReadCloser); ok {
+		r1 = rf(ctx, uuid)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(io.ReadCloser)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(ctx, uuid)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// AttachmentService_OpenAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'OpenAttachment'
+type AttachmentService_OpenAttachment_Call struct {
+	*mock.Call
+}
+
+// OpenAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+func (_e *AttachmentService_Expecter) OpenAttachment(ctx interface{}, uuid interface{}) *AttachmentService_OpenAttachment_Call {
+	return &AttachmentService_OpenAttachment_Call{Call: _e.mock.On("OpenAttachment", ctx, uuid)}
+}
+
+func (_c *AttachmentService_OpenAttachment_Call) Run(run func(ctx context.Context, uuid string)) *AttachmentService_OpenAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *AttachmentService_OpenAttachment_Call) Return(_a0 *model.Attachment, _a1 io.ReadCloser, _a2 error) *AttachmentService_OpenAttachment_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *AttachmentService_OpenAttachment_Call) RunAndReturn(run func(context.Context, string) (*model.Attachment, io.ReadCloser, error)) *AttachmentService_OpenAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UploadAttachment provides a mock function with given fields: ctx, upload
+func (_m *AttachmentService) UploadAttachment(ctx context.Context, upload *model.AttachmentUpload) (*model.Attachment, error) {
+	ret := _m.Called(ctx, upload)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UploadAttachment")
+	}
+
+	var r0 *model.Attachment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.AttachmentUpload) (*model.Attachment, error)); ok {
+		return rf(ctx, upload)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.AttachmentUpload) *model.Attachment); ok {
+		r0 = rf(ctx, upload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Attachment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.AttachmentUpload) error); ok {
+		r1 = rf(ctx, upload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AttachmentService_UploadAttachment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UploadAttachment'
+type AttachmentService_UploadAttachment_Call struct {
+	*mock.Call
+}
+
+// UploadAttachment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - upload *model.AttachmentUpload
+func (_e *AttachmentService_Expecter) UploadAttachment(ctx interface{}, upload interface{}) *AttachmentService_UploadAttachment_Call {
+	return &AttachmentService_UploadAttachment_Call{Call: _e.mock.On("UploadAttachment", ctx, upload)}
+}
+
+func (_c *AttachmentService_UploadAttachment_Call) Run(run func(ctx context.Context, upload *model.AttachmentUpload)) *AttachmentService_UploadAttachment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.AttachmentUpload))
+	})
+	return _c
+}
+
+func (_c *AttachmentService_UploadAttachment_Call) Return(_a0 *model.Attachment, _a1 error) *AttachmentService_UploadAttachment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *AttachmentService_UploadAttachment_Call) RunAndReturn(run func(context.Context, *model.AttachmentUpload) (*model.Attachment, error)) *AttachmentService_UploadAttachment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewAttachmentService creates a new instance of AttachmentService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAttachmentService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AttachmentService {
+	mock := &AttachmentService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
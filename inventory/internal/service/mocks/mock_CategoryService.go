@@ -0,0 +1,439 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CategoryService is an autogenerated mock type for the CategoryService type
+type CategoryService struct {
+	mock.Mock
+}
+
+type CategoryService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CategoryService) EXPECT() *CategoryService_Expecter {
+	return &CategoryService_Expecter{mock: &_m.Mock}
+}
+
+// CreateCategory provides a mock function with given fields: ctx, category
+func (_m *CategoryService) CreateCategory(ctx context.Context, category *model.PartCategory) (*model.PartCategory, error) {
+	ret := _m.Called(ctx, category)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateCategory")
+	}
+
+	var r0 *model.PartCategory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PartCategory) (*model.PartCategory, error)); ok {
+		return rf(ctx, category)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PartCategory) *model.PartCategory); ok {
+		r0 = rf(ctx, category)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PartCategory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.PartCategory) error); ok {
+		r1 = rf(ctx, category)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CategoryService_CreateCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateCategory'
+type CategoryService_CreateCategory_Call struct {
+	*mock.Call
+}
+
+// CreateCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - category *model.PartCategory
+func (_e *CategoryService_Expecter) CreateCategory(ctx interface{}, category interface{}) *CategoryService_CreateCategory_Call {
+	return &CategoryService_CreateCategory_Call{Call: _e.mock.On("CreateCategory", ctx, category)}
+}
+
+func (_c *CategoryService_CreateCategory_Call) Run(run func(ctx context.Context, category *model.PartCategory)) *CategoryService_CreateCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.PartCategory))
+	})
+	return _c
+}
+
+func (_c *CategoryService_CreateCategory_Call) Return(_a0 *model.PartCategory, _a1 error) *CategoryService_CreateCategory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CategoryService_CreateCategory_Call) RunAndReturn(run func(context.Context, *model.PartCategory) (*model.PartCategory, error)) *CategoryService_CreateCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteCategory provides a mock function with given fields: ctx, code
+func (_m *CategoryService) DeleteCategory(ctx context.Context, code model.Category) error {
+	ret := _m.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCategory")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Category) error); ok {
+		r0 = rf(ctx, code)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CategoryService_DeleteCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCategory'
+type CategoryService_DeleteCategory_Call struct {
+	*mock.Call
+}
+
+// DeleteCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code model.Category
+func (_e *CategoryService_Expecter) DeleteCategory(ctx interface{}, code interface{}) *CategoryService_DeleteCategory_Call {
+	return &CategoryService_DeleteCategory_Call{Call: _e.mock.On("DeleteCategory", ctx, code)}
+}
+
+func (_c *CategoryService_DeleteCategory_Call) Run(run func(ctx context.Context, code model.Category)) *CategoryService_DeleteCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Category))
+	})
+	return _c
+}
+
+func (_c *CategoryService_DeleteCategory_Call) Return(_a0 error) *CategoryService_DeleteCategory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CategoryService_DeleteCategory_Call) RunAndReturn(run func(context.Context, model.Category) error) *CategoryService_DeleteCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpandCategories provides a mock function with given fields: ctx, codes
+func (_m *CategoryService) ExpandCategories(ctx context.Context, codes []model.Category) ([]model.Category, error) {
+	ret := _m.Called(ctx, codes)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpandCategories")
+	}
+
+	var r0 []model.Category
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []model.Category) ([]model.Category, error)); ok {
+		return rf(ctx, codes)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []model.Category) []model.Category); ok {
+		r0 = rf(ctx, codes)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Category)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []model.Category) error); ok {
+		r1 = rf(ctx, codes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CategoryService_ExpandCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpandCategories'
+type CategoryService_ExpandCategories_Call struct {
+	*mock.Call
+}
+
+// ExpandCategories is a helper method to define mock.On call
+//   - ctx context.Context
+//   - codes []model.Category
+func (_e *CategoryService_Expecter) ExpandCategories(ctx interface{}, codes interface{}) *CategoryService_ExpandCategories_Call {
+	return &CategoryService_ExpandCategories_Call{Call: _e.mock.On("ExpandCategories", ctx, codes)}
+}
+
+func (_c *CategoryService_ExpandCategories_Call) Run(run func(ctx context.Context, codes []model.Category)) *CategoryService_ExpandCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]model.Category))
+	})
+	return _c
+}
+
+func (_c *CategoryService_ExpandCategories_Call) Return(_a0 []model.Category, _a1 error) *CategoryService_ExpandCategories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CategoryService_ExpandCategories_Call) RunAndReturn(run func(context.Context, []model.Category) ([]model.Category, error)) *CategoryService_ExpandCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategory provides a mock function with given fields: ctx, code
+func (_m *CategoryService) GetCategory(ctx context.Context, code model.Category) (*model.PartCategory, error) {
+	ret := _m.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategory")
+	}
+
+	var r0 *model.PartCategory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Category) (*model.PartCategory, error)); ok {
+		return rf(ctx, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Category) *model.PartCategory); ok {
+		r0 = rf(ctx, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PartCategory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Category) error); ok {
+		r1 = rf(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CategoryService_GetCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategory'
+type CategoryService_GetCategory_Call struct {
+	*mock.Call
+}
+
+// GetCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code model.Category
+func (_e *CategoryService_Expecter) GetCategory(ctx interface{}, code interface{}) *CategoryService_GetCategory_Call {
+	return &CategoryService_GetCategory_Call{Call: _e.mock.On("GetCategory", ctx, code)}
+}
+
+func (_c *CategoryService_GetCategory_Call) Run(run func(ctx context.Context, code model.Category)) *CategoryService_GetCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Category))
+	})
+	return _c
+}
+
+func (_c *CategoryService_GetCategory_Call) Return(_a0 *model.PartCategory, _a1 error) *CategoryService_GetCategory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CategoryService_GetCategory_Call) RunAndReturn(run func(context.Context, model.Category) (*model.PartCategory, error)) *CategoryService_GetCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetCategorySchema provides a mock function with given fields: ctx, code
+func (_m *CategoryService) GetCategorySchema(ctx context.Context, code model.Category) (*model.CategorySchema, error) {
+	ret := _m.Called(ctx, code)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetCategorySchema")
+	}
+
+	var r0 *model.CategorySchema
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.Category) (*model.CategorySchema, error)); ok {
+		return rf(ctx, code)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.Category) *model.CategorySchema); ok {
+		r0 = rf(ctx, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CategorySchema)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.Category) error); ok {
+		r1 = rf(ctx, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CategoryService_GetCategorySchema_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetCategorySchema'
+type CategoryService_GetCategorySchema_Call struct {
+	*mock.Call
+}
+
+// GetCategorySchema is a helper method to define mock.On call
+//   - ctx context.Context
+//   - code model.Category
+func (_e *CategoryService_Expecter) GetCategorySchema(ctx interface{}, code interface{}) *CategoryService_GetCategorySchema_Call {
+	return &CategoryService_GetCategorySchema_Call{Call: _e.mock.On("GetCategorySchema", ctx, code)}
+}
+
+func (_c *CategoryService_GetCategorySchema_Call) Run(run func(ctx context.Context, code model.Category)) *CategoryService_GetCategorySchema_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(model.Category))
+	})
+	return _c
+}
+
+func (_c *CategoryService_GetCategorySchema_Call) Return(_a0 *model.CategorySchema, _a1 error) *CategoryService_GetCategorySchema_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CategoryService_GetCategorySchema_Call) RunAndReturn(run func(context.Context, model.Category) (*model.CategorySchema, error)) *CategoryService_GetCategorySchema_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListCategories provides a mock function with given fields: ctx
+func (_m *CategoryService) ListCategories(ctx context.Context) ([]*model.PartCategory, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListCategories")
+	}
+
+	var r0 []*model.PartCategory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.PartCategory, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.PartCategory); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PartCategory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CategoryService_ListCategories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListCategories'
+type CategoryService_ListCategories_Call struct {
+	*mock.Call
+}
+
+// ListCategories is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *CategoryService_Expecter) ListCategories(ctx interface{}) *CategoryService_ListCategories_Call {
+	return &CategoryService_ListCategories_Call{Call: _e.mock.On("ListCategories", ctx)}
+}
+
+func (_c *CategoryService_ListCategories_Call) Run(run func(ctx context.Context)) *CategoryService_ListCategories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *CategoryService_ListCategories_Call) Return(_a0 []*model.PartCategory, _a1 error) *CategoryService_ListCategories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CategoryService_ListCategories_Call) RunAndReturn(run func(context.Context) ([]*model.PartCategory, error)) *CategoryService_ListCategories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateCategory provides a mock function with given fields: ctx, update
+func (_m *CategoryService) UpdateCategory(ctx context.Context, update *model.CategoryUpdate) (*model.PartCategory, error) {
+	ret := _m.Called(ctx, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateCategory")
+	}
+
+	var r0 *model.PartCategory
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CategoryUpdate) (*model.PartCategory, error)); ok {
+		return rf(ctx, update)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CategoryUpdate) *model.PartCategory); ok {
+		r0 = rf(ctx, update)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PartCategory)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.CategoryUpdate) error); ok {
+		r1 = rf(ctx, update)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CategoryService_UpdateCategory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateCategory'
+type CategoryService_UpdateCategory_Call struct {
+	*mock.Call
+}
+
+// UpdateCategory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - update *model.CategoryUpdate
+func (_e *CategoryService_Expecter) UpdateCategory(ctx interface{}, update interface{}) *CategoryService_UpdateCategory_Call {
+	return &CategoryService_UpdateCategory_Call{Call: _e.mock.On("UpdateCategory", ctx, update)}
+}
+
+func (_c *CategoryService_UpdateCategory_Call) Run(run func(ctx context.Context, update *model.CategoryUpdate)) *CategoryService_UpdateCategory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.CategoryUpdate))
+	})
+	return _c
+}
+
+func (_c *CategoryService_UpdateCategory_Call) Return(_a0 *model.PartCategory, _a1 error) *CategoryService_UpdateCategory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CategoryService_UpdateCategory_Call) RunAndReturn(run func(context.Context, *model.CategoryUpdate) (*model.PartCategory, error)) *CategoryService_UpdateCategory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCategoryService creates a new instance of CategoryService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCategoryService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CategoryService {
+	mock := &CategoryService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,262 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CompatibilityService is an autogenerated mock type for the CompatibilityService type
+type CompatibilityService struct {
+	mock.Mock
+}
+
+type CompatibilityService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CompatibilityService) EXPECT() *CompatibilityService_Expecter {
+	return &CompatibilityService_Expecter{mock: &_m.Mock}
+}
+
+// CreateRule provides a mock function with given fields: ctx, rule
+func (_m *CompatibilityService) CreateRule(ctx context.Context, rule *model.CompatibilityRule) (*model.CompatibilityRule, error) {
+	ret := _m.Called(ctx, rule)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateRule")
+	}
+
+	var r0 *model.CompatibilityRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CompatibilityRule) (*model.CompatibilityRule, error)); ok {
+		return rf(ctx, rule)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CompatibilityRule) *model.CompatibilityRule); ok {
+		r0 = rf(ctx, rule)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CompatibilityRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.CompatibilityRule) error); ok {
+		r1 = rf(ctx, rule)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CompatibilityService_CreateRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRule'
+type CompatibilityService_CreateRule_Call struct {
+	*mock.Call
+}
+
+// CreateRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rule *model.CompatibilityRule
+func (_e *CompatibilityService_Expecter) CreateRule(ctx interface{}, rule interface{}) *CompatibilityService_CreateRule_Call {
+	return &CompatibilityService_CreateRule_Call{Call: _e.mock.On("CreateRule", ctx, rule)}
+}
+
+func (_c *CompatibilityService_CreateRule_Call) Run(run func(ctx context.Context, rule *model.CompatibilityRule)) *CompatibilityService_CreateRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.CompatibilityRule))
+	})
+	return _c
+}
+
+func (_c *CompatibilityService_CreateRule_Call) Return(_a0 *model.CompatibilityRule, _a1 error) *CompatibilityService_CreateRule_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CompatibilityService_CreateRule_Call) RunAndReturn(run func(context.Context, *model.CompatibilityRule) (*model.CompatibilityRule, error)) *CompatibilityService_CreateRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRule provides a mock function with given fields: ctx, uuid
+func (_m *CompatibilityService) DeleteRule(ctx context.Context, uuid string) error {
+	ret := _m.Called(ctx, uuid)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRule")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, uuid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CompatibilityService_DeleteRule_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRule'
+type CompatibilityService_DeleteRule_Call struct {
+	*mock.Call
+}
+
+// DeleteRule is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+func (_e *CompatibilityService_Expecter) DeleteRule(ctx interface{}, uuid interface{}) *CompatibilityService_DeleteRule_Call {
+	return &CompatibilityService_DeleteRule_Call{Call: _e.mock.On("DeleteRule", ctx, uuid)}
+}
+
+func (_c *CompatibilityService_DeleteRule_Call) Run(run func(ctx context.Context, uuid string)) *CompatibilityService_DeleteRule_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *CompatibilityService_DeleteRule_Call) Return(_a0 error) *CompatibilityService_DeleteRule_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *CompatibilityService_DeleteRule_Call) RunAndReturn(run func(context.Context, string) error) *CompatibilityService_DeleteRule_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRules provides a mock function with given fields: ctx
+func (_m *CompatibilityService) ListRules(ctx context.Context) ([]*model.CompatibilityRule, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRules")
+	}
+
+	var r0 []*model.CompatibilityRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.CompatibilityRule, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.CompatibilityRule); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CompatibilityRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CompatibilityService_ListRules_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRules'
+type CompatibilityService_ListRules_Call struct {
+	*mock.Call
+}
+
+// ListRules is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *CompatibilityService_Expecter) ListRules(ctx interface{}) *CompatibilityService_ListRules_Call {
+	return &CompatibilityService_ListRules_Call{Call: _e.mock.On("ListRules", ctx)}
+}
+
+func (_c *CompatibilityService_ListRules_Call) Run(run func(ctx context.Context)) *CompatibilityService_ListRules_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *CompatibilityService_ListRules_Call) Return(_a0 []*model.CompatibilityRule, _a1 error) *CompatibilityService_ListRules_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CompatibilityService_ListRules_Call) RunAndReturn(run func(context.Context) ([]*model.CompatibilityRule, error)) *CompatibilityService_ListRules_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateConfiguration provides a mock function with given fields: ctx, partUUIDs
+func (_m *CompatibilityService) ValidateConfiguration(ctx context.Context, partUUIDs []string) (*model.ConfigurationValidation, error) {
+	ret := _m.Called(ctx, partUUIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateConfiguration")
+	}
+
+	var r0 *model.ConfigurationValidation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (*model.ConfigurationValidation, error)); ok {
+		return rf(ctx, partUUIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) *model.ConfigurationValidation); ok {
+		r0 = rf(ctx, partUUIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ConfigurationValidation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, partUUIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CompatibilityService_ValidateConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateConfiguration'
+type CompatibilityService_ValidateConfiguration_Call struct {
+	*mock.Call
+}
+
+// ValidateConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partUUIDs []string
+func (_e *CompatibilityService_Expecter) ValidateConfiguration(ctx interface{}, partUUIDs interface{}) *CompatibilityService_ValidateConfiguration_Call {
+	return &CompatibilityService_ValidateConfiguration_Call{Call: _e.mock.On("ValidateConfiguration", ctx, partUUIDs)}
+}
+
+func (_c *CompatibilityService_ValidateConfiguration_Call) Run(run func(ctx context.Context, partUUIDs []string)) *CompatibilityService_ValidateConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *CompatibilityService_ValidateConfiguration_Call) Return(_a0 *model.ConfigurationValidation, _a1 error) *CompatibilityService_ValidateConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *CompatibilityService_ValidateConfiguration_Call) RunAndReturn(run func(context.Context, []string) (*model.ConfigurationValidation, error)) *CompatibilityService_ValidateConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewCompatibilityService creates a new instance of CompatibilityService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCompatibilityService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CompatibilityService {
+	mock := &CompatibilityService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
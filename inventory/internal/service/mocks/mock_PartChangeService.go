@@ -0,0 +1,84 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PartChangeService is an autogenerated mock type for the PartChangeService type
+type PartChangeService struct {
+	mock.Mock
+}
+
+type PartChangeService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PartChangeService) EXPECT() *PartChangeService_Expecter {
+	return &PartChangeService_Expecter{mock: &_m.Mock}
+}
+
+// PublishChanges provides a mock function with given fields: ctx
+func (_m *PartChangeService) PublishChanges(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishChanges")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PartChangeService_PublishChanges_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishChanges'
+type PartChangeService_PublishChanges_Call struct {
+	*mock.Call
+}
+
+// PublishChanges is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *PartChangeService_Expecter) PublishChanges(ctx interface{}) *PartChangeService_PublishChanges_Call {
+	return &PartChangeService_PublishChanges_Call{Call: _e.mock.On("PublishChanges", ctx)}
+}
+
+func (_c *PartChangeService_PublishChanges_Call) Run(run func(ctx context.Context)) *PartChangeService_PublishChanges_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *PartChangeService_PublishChanges_Call) Return(_a0 error) *PartChangeService_PublishChanges_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PartChangeService_PublishChanges_Call) RunAndReturn(run func(context.Context) error) *PartChangeService_PublishChanges_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPartChangeService creates a new instance of PartChangeService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPartChangeService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PartChangeService {
+	mock := &PartChangeService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
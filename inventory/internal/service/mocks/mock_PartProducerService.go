@@ -0,0 +1,86 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PartProducerService is an autogenerated mock type for the PartProducerService type
+type PartProducerService struct {
+	mock.Mock
+}
+
+type PartProducerService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PartProducerService) EXPECT() *PartProducerService_Expecter {
+	return &PartProducerService_Expecter{mock: &_m.Mock}
+}
+
+// PublishPartChange provides a mock function with given fields: ctx, change
+func (_m *PartProducerService) PublishPartChange(ctx context.Context, change *model.PartChange) error {
+	ret := _m.Called(ctx, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishPartChange")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PartChange) error); ok {
+		r0 = rf(ctx, change)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PartProducerService_PublishPartChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishPartChange'
+type PartProducerService_PublishPartChange_Call struct {
+	*mock.Call
+}
+
+// PublishPartChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - change *model.PartChange
+func (_e *PartProducerService_Expecter) PublishPartChange(ctx interface{}, change interface{}) *PartProducerService_PublishPartChange_Call {
+	return &PartProducerService_PublishPartChange_Call{Call: _e.mock.On("PublishPartChange", ctx, change)}
+}
+
+func (_c *PartProducerService_PublishPartChange_Call) Run(run func(ctx context.Context, change *model.PartChange)) *PartProducerService_PublishPartChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.PartChange))
+	})
+	return _c
+}
+
+func (_c *PartProducerService_PublishPartChange_Call) Return(_a0 error) *PartProducerService_PublishPartChange_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PartProducerService_PublishPartChange_Call) RunAndReturn(run func(context.Context, *model.PartChange) error) *PartProducerService_PublishPartChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPartProducerService creates a new instance of PartProducerService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPartProducerService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PartProducerService {
+	mock := &PartProducerService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -24,29 +24,252 @@ func (_m *PartService) EXPECT() *PartService_Expecter {
 	return &PartService_Expecter{mock: &_m.Mock}
 }
 
-// GetPart provides a mock function with given fields: ctx, uuid
-func (_m *PartService) GetPart(ctx context.Context, uuid string) (*model.Part, error) {
+// BatchGetParts provides a mock function with given fields: ctx, uuids, fields
+func (_m *PartService) BatchGetParts(ctx context.Context, uuids []string, fields []string) (*model.PartsBatch, error) {
+	ret := _m.Called(ctx, uuids, fields)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BatchGetParts")
+	}
+
+	var r0 *model.PartsBatch
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, []string) (*model.PartsBatch, error)); ok {
+		return rf(ctx, uuids, fields)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, []string) *model.PartsBatch); ok {
+		r0 = rf(ctx, uuids, fields)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PartsBatch)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, []string) error); ok {
+		r1 = rf(ctx, uuids, fields)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartService_BatchGetParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BatchGetParts'
+type PartService_BatchGetParts_Call struct {
+	*mock.Call
+}
+
+// BatchGetParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuids []string
+//   - fields []string
+func (_e *PartService_Expecter) BatchGetParts(ctx interface{}, uuids interface{}, fields interface{}) *PartService_BatchGetParts_Call {
+	return &PartService_BatchGetParts_Call{Call: _e.mock.On("BatchGetParts", ctx, uuids, fields)}
+}
+
+func (_c *PartService_BatchGetParts_Call) Run(run func(ctx context.Context, uuids []string, fields []string)) *PartService_BatchGetParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *PartService_BatchGetParts_Call) Return(_a0 *model.PartsBatch, _a1 error) *PartService_BatchGetParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartService_BatchGetParts_Call) RunAndReturn(run func(context.Context, []string, []string) (*model.PartsBatch, error)) *PartService_BatchGetParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePart provides a mock function with given fields: ctx, part
+func (_m *PartService) CreatePart(ctx context.Context, part *model.Part) (*model.Part, error) {
+	ret := _m.Called(ctx, part)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePart")
+	}
+
+	var r0 *model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Part) (*model.Part, error)); ok {
+		return rf(ctx, part)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Part) *model.Part); ok {
+		r0 = rf(ctx, part)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Part)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Part) error); ok {
+		r1 = rf(ctx, part)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartService_CreatePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePart'
+type PartService_CreatePart_Call struct {
+	*mock.Call
+}
+
+// CreatePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - part *model.Part
+func (_e *PartService_Expecter) CreatePart(ctx interface{}, part interface{}) *PartService_CreatePart_Call {
+	return &PartService_CreatePart_Call{Call: _e.mock.On("CreatePart", ctx, part)}
+}
+
+func (_c *PartService_CreatePart_Call) Run(run func(ctx context.Context, part *model.Part)) *PartService_CreatePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Part))
+	})
+	return _c
+}
+
+func (_c *PartService_CreatePart_Call) Return(_a0 *model.Part, _a1 error) *PartService_CreatePart_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartService_CreatePart_Call) RunAndReturn(run func(context.Context, *model.Part) (*model.Part, error)) *PartService_CreatePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePart provides a mock function with given fields: ctx, uuid
+func (_m *PartService) DeletePart(ctx context.Context, uuid string) error {
 	ret := _m.Called(ctx, uuid)
 
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePart")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, uuid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PartService_DeletePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePart'
+type PartService_DeletePart_Call struct {
+	*mock.Call
+}
+
+// DeletePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+func (_e *PartService_Expecter) DeletePart(ctx interface{}, uuid interface{}) *PartService_DeletePart_Call {
+	return &PartService_DeletePart_Call{Call: _e.mock.On("DeletePart", ctx, uuid)}
+}
+
+func (_c *PartService_DeletePart_Call) Run(run func(ctx context.Context, uuid string)) *PartService_DeletePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PartService_DeletePart_Call) Return(_a0 error) *PartService_DeletePart_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PartService_DeletePart_Call) RunAndReturn(run func(context.Context, string) error) *PartService_DeletePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExportParts provides a mock function with given fields: ctx, export
+func (_m *PartService) ExportParts(ctx context.Context, export *model.CatalogExport) (int, error) {
+	ret := _m.Called(ctx, export)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExportParts")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CatalogExport) (int, error)); ok {
+		return rf(ctx, export)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CatalogExport) int); ok {
+		r0 = rf(ctx, export)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.CatalogExport) error); ok {
+		r1 = rf(ctx, export)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartService_ExportParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExportParts'
+type PartService_ExportParts_Call struct {
+	*mock.Call
+}
+
+// ExportParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - export *model.CatalogExport
+func (_e *PartService_Expecter) ExportParts(ctx interface{}, export interface{}) *PartService_ExportParts_Call {
+	return &PartService_ExportParts_Call{Call: _e.mock.On("ExportParts", ctx, export)}
+}
+
+func (_c *PartService_ExportParts_Call) Run(run func(ctx context.Context, export *model.CatalogExport)) *PartService_ExportParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.CatalogExport))
+	})
+	return _c
+}
+
+func (_c *PartService_ExportParts_Call) Return(_a0 int, _a1 error) *PartService_ExportParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartService_ExportParts_Call) RunAndReturn(run func(context.Context, *model.CatalogExport) (int, error)) *PartService_ExportParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPart provides a mock function with given fields: ctx, uuid, fields
+func (_m *PartService) GetPart(ctx context.Context, uuid string, fields []string) (*model.Part, error) {
+	ret := _m.Called(ctx, uuid, fields)
+
 	if len(ret) == 0 {
 		panic("no return value specified for GetPart")
 	}
 
 	var r0 *model.Part
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Part, error)); ok {
-		return rf(ctx, uuid)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) (*model.Part, error)); ok {
+		return rf(ctx, uuid, fields)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Part); ok {
-		r0 = rf(ctx, uuid)
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) *model.Part); ok {
+		r0 = rf(ctx, uuid, fields)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Part)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, uuid)
+	if rf, ok := ret.Get(1).(func(context.Context, string, []string) error); ok {
+		r1 = rf(ctx, uuid, fields)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -62,13 +285,14 @@ type PartService_GetPart_Call struct {
 // GetPart is a helper method to define mock.On call
 //   - ctx context.Context
 //   - uuid string
-func (_e *PartService_Expecter) GetPart(ctx interface{}, uuid interface{}) *PartService_GetPart_Call {
-	return &PartService_GetPart_Call{Call: _e.mock.On("GetPart", ctx, uuid)}
+//   - fields []string
+func (_e *PartService_Expecter) GetPart(ctx interface{}, uuid interface{}, fields interface{}) *PartService_GetPart_Call {
+	return &PartService_GetPart_Call{Call: _e.mock.On("GetPart", ctx, uuid, fields)}
 }
 
-func (_c *PartService_GetPart_Call) Run(run func(ctx context.Context, uuid string)) *PartService_GetPart_Call {
+func (_c *PartService_GetPart_Call) Run(run func(ctx context.Context, uuid string, fields []string)) *PartService_GetPart_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
 	})
 	return _c
 }
@@ -78,34 +302,93 @@ func (_c *PartService_GetPart_Call) Return(_a0 *model.Part, _a1 error) *PartServ
 	return _c
 }
 
-func (_c *PartService_GetPart_Call) RunAndReturn(run func(context.Context, string) (*model.Part, error)) *PartService_GetPart_Call {
+func (_c *PartService_GetPart_Call) RunAndReturn(run func(context.Context, string, []string) (*model.Part, error)) *PartService_GetPart_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListParts provides a mock function with given fields: ctx, filter
-func (_m *PartService) ListParts(ctx context.Context, filter *model.PartsFilter) ([]*model.Part, error) {
-	ret := _m.Called(ctx, filter)
+// ImportParts provides a mock function with given fields: ctx, catalogImport
+func (_m *PartService) ImportParts(ctx context.Context, catalogImport *model.CatalogImport) (*model.CatalogImportReport, error) {
+	ret := _m.Called(ctx, catalogImport)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ImportParts")
+	}
+
+	var r0 *model.CatalogImportReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CatalogImport) (*model.CatalogImportReport, error)); ok {
+		return rf(ctx, catalogImport)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.CatalogImport) *model.CatalogImportReport); ok {
+		r0 = rf(ctx, catalogImport)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CatalogImportReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.CatalogImport) error); ok {
+		r1 = rf(ctx, catalogImport)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartService_ImportParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ImportParts'
+type PartService_ImportParts_Call struct {
+	*mock.Call
+}
+
+// ImportParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - catalogImport *model.CatalogImport
+func (_e *PartService_Expecter) ImportParts(ctx interface{}, catalogImport interface{}) *PartService_ImportParts_Call {
+	return &PartService_ImportParts_Call{Call: _e.mock.On("ImportParts", ctx, catalogImport)}
+}
+
+func (_c *PartService_ImportParts_Call) Run(run func(ctx context.Context, catalogImport *model.CatalogImport)) *PartService_ImportParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.CatalogImport))
+	})
+	return _c
+}
+
+func (_c *PartService_ImportParts_Call) Return(_a0 *model.CatalogImportReport, _a1 error) *PartService_ImportParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartService_ImportParts_Call) RunAndReturn(run func(context.Context, *model.CatalogImport) (*model.CatalogImportReport, error)) *PartService_ImportParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListParts provides a mock function with given fields: ctx, query
+func (_m *PartService) ListParts(ctx context.Context, query *model.PartsQuery) (*model.PartsPage, error) {
+	ret := _m.Called(ctx, query)
 
 	if len(ret) == 0 {
 		panic("no return value specified for ListParts")
 	}
 
-	var r0 []*model.Part
+	var r0 *model.PartsPage
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *model.PartsFilter) ([]*model.Part, error)); ok {
-		return rf(ctx, filter)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PartsQuery) (*model.PartsPage, error)); ok {
+		return rf(ctx, query)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *model.PartsFilter) []*model.Part); ok {
-		r0 = rf(ctx, filter)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PartsQuery) *model.PartsPage); ok {
+		r0 = rf(ctx, query)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.Part)
+			r0 = ret.Get(0).(*model.PartsPage)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *model.PartsFilter) error); ok {
-		r1 = rf(ctx, filter)
+	if rf, ok := ret.Get(1).(func(context.Context, *model.PartsQuery) error); ok {
+		r1 = rf(ctx, query)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -120,24 +403,189 @@ type PartService_ListParts_Call struct {
 
 // ListParts is a helper method to define mock.On call
 //   - ctx context.Context
-//   - filter *model.PartsFilter
-func (_e *PartService_Expecter) ListParts(ctx interface{}, filter interface{}) *PartService_ListParts_Call {
-	return &PartService_ListParts_Call{Call: _e.mock.On("ListParts", ctx, filter)}
+//   - query *model.PartsQuery
+func (_e *PartService_Expecter) ListParts(ctx interface{}, query interface{}) *PartService_ListParts_Call {
+	return &PartService_ListParts_Call{Call: _e.mock.On("ListParts", ctx, query)}
 }
 
-func (_c *PartService_ListParts_Call) Run(run func(ctx context.Context, filter *model.PartsFilter)) *PartService_ListParts_Call {
+func (_c *PartService_ListParts_Call) Run(run func(ctx context.Context, query *model.PartsQuery)) *PartService_ListParts_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(*model.PartsFilter))
+		run(args[0].(context.Context), args[1].(*model.PartsQuery))
 	})
 	return _c
 }
 
-func (_c *PartService_ListParts_Call) Return(_a0 []*model.Part, _a1 error) *PartService_ListParts_Call {
+func (_c *PartService_ListParts_Call) Return(_a0 *model.PartsPage, _a1 error) *PartService_ListParts_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *PartService_ListParts_Call) RunAndReturn(run func(context.Context, *model.PartsFilter) ([]*model.Part, error)) *PartService_ListParts_Call {
+func (_c *PartService_ListParts_Call) RunAndReturn(run func(context.Context, *model.PartsQuery) (*model.PartsPage, error)) *PartService_ListParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SearchParts provides a mock function with given fields: ctx, search
+func (_m *PartService) SearchParts(ctx context.Context, search *model.PartsSearch) ([]*model.PartSearchHit, error) {
+	ret := _m.Called(ctx, search)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchParts")
+	}
+
+	var r0 []*model.PartSearchHit
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PartsSearch) ([]*model.PartSearchHit, error)); ok {
+		return rf(ctx, search)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PartsSearch) []*model.PartSearchHit); ok {
+		r0 = rf(ctx, search)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PartSearchHit)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.PartsSearch) error); ok {
+		r1 = rf(ctx, search)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartService_SearchParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchParts'
+type PartService_SearchParts_Call struct {
+	*mock.Call
+}
+
+// SearchParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - search *model.PartsSearch
+func (_e *PartService_Expecter) SearchParts(ctx interface{}, search interface{}) *PartService_SearchParts_Call {
+	return &PartService_SearchParts_Call{Call: _e.mock.On("SearchParts", ctx, search)}
+}
+
+func (_c *PartService_SearchParts_Call) Run(run func(ctx context.Context, search *model.PartsSearch)) *PartService_SearchParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.PartsSearch))
+	})
+	return _c
+}
+
+func (_c *PartService_SearchParts_Call) Return(_a0 []*model.PartSearchHit, _a1 error) *PartService_SearchParts_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartService_SearchParts_Call) RunAndReturn(run func(context.Context, *model.PartsSearch) ([]*model.PartSearchHit, error)) *PartService_SearchParts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePart provides a mock function with given fields: ctx, update
+func (_m *PartService) UpdatePart(ctx context.Context, update *model.PartUpdate) (*model.Part, error) {
+	ret := _m.Called(ctx, update)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePart")
+	}
+
+	var r0 *model.Part
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PartUpdate) (*model.Part, error)); ok {
+		return rf(ctx, update)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PartUpdate) *model.Part); ok {
+		r0 = rf(ctx, update)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Part)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.PartUpdate) error); ok {
+		r1 = rf(ctx, update)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PartService_UpdatePart_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePart'
+type PartService_UpdatePart_Call struct {
+	*mock.Call
+}
+
+// UpdatePart is a helper method to define mock.On call
+//   - ctx context.Context
+//   - update *model.PartUpdate
+func (_e *PartService_Expecter) UpdatePart(ctx interface{}, update interface{}) *PartService_UpdatePart_Call {
+	return &PartService_UpdatePart_Call{Call: _e.mock.On("UpdatePart", ctx, update)}
+}
+
+func (_c *PartService_UpdatePart_Call) Run(run func(ctx context.Context, update *model.PartUpdate)) *PartService_UpdatePart_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.PartUpdate))
+	})
+	return _c
+}
+
+func (_c *PartService_UpdatePart_Call) Return(_a0 *model.Part, _a1 error) *PartService_UpdatePart_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PartService_UpdatePart_Call) RunAndReturn(run func(context.Context, *model.PartUpdate) (*model.Part, error)) *PartService_UpdatePart_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchParts provides a mock function with given fields: ctx, watch
+func (_m *PartService) WatchParts(ctx context.Context, watch *model.PartsWatch) error {
+	ret := _m.Called(ctx, watch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchParts")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PartsWatch) error); ok {
+		r0 = rf(ctx, watch)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PartService_WatchParts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchParts'
+type PartService_WatchParts_Call struct {
+	*mock.Call
+}
+
+// WatchParts is a helper method to define mock.On call
+//   - ctx context.Context
+//   - watch *model.PartsWatch
+func (_e *PartService_Expecter) WatchParts(ctx interface{}, watch interface{}) *PartService_WatchParts_Call {
+	return &PartService_WatchParts_Call{Call: _e.mock.On("WatchParts", ctx, watch)}
+}
+
+func (_c *PartService_WatchParts_Call) Run(run func(ctx context.Context, watch *model.PartsWatch)) *PartService_WatchParts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.PartsWatch))
+	})
+	return _c
+}
+
+func (_c *PartService_WatchParts_Call) Return(_a0 error) *PartService_WatchParts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PartService_WatchParts_Call) RunAndReturn(run func(context.Context, *model.PartsWatch) error) *PartService_WatchParts_Call {
 	_c.Call.Return(run)
 	return _c
 }
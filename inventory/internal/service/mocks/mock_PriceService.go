@@ -0,0 +1,325 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// PriceService is an autogenerated mock type for the PriceService type
+type PriceService struct {
+	mock.Mock
+}
+
+type PriceService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PriceService) EXPECT() *PriceService_Expecter {
+	return &PriceService_Expecter{mock: &_m.Mock}
+}
+
+// ApplyDue provides a mock function with given fields: ctx, now
+func (_m *PriceService) ApplyDue(ctx context.Context, now time.Time) (int, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyDue")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, now)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PriceService_ApplyDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyDue'
+type PriceService_ApplyDue_Call struct {
+	*mock.Call
+}
+
+// ApplyDue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *PriceService_Expecter) ApplyDue(ctx interface{}, now interface{}) *PriceService_ApplyDue_Call {
+	return &PriceService_ApplyDue_Call{Call: _e.mock.On("ApplyDue", ctx, now)}
+}
+
+func (_c *PriceService_ApplyDue_Call) Run(run func(ctx context.Context, now time.Time)) *PriceService_ApplyDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *PriceService_ApplyDue_Call) Return(_a0 int, _a1 error) *PriceService_ApplyDue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PriceService_ApplyDue_Call) RunAndReturn(run func(context.Context, time.Time) (int, error)) *PriceService_ApplyDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPrices provides a mock function with given fields: ctx, partUUIDs, at
+func (_m *PriceService) GetPrices(ctx context.Context, partUUIDs []string, at time.Time) ([]*model.PartPrice, error) {
+	ret := _m.Called(ctx, partUUIDs, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPrices")
+	}
+
+	var r0 []*model.PartPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, time.Time) ([]*model.PartPrice, error)); ok {
+		return rf(ctx, partUUIDs, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, time.Time) []*model.PartPrice); ok {
+		r0 = rf(ctx, partUUIDs, at)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PartPrice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, time.Time) error); ok {
+		r1 = rf(ctx, partUUIDs, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PriceService_GetPrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPrices'
+type PriceService_GetPrices_Call struct {
+	*mock.Call
+}
+
+// GetPrices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partUUIDs []string
+//   - at time.Time
+func (_e *PriceService_Expecter) GetPrices(ctx interface{}, partUUIDs interface{}, at interface{}) *PriceService_GetPrices_Call {
+	return &PriceService_GetPrices_Call{Call: _e.mock.On("GetPrices", ctx, partUUIDs, at)}
+}
+
+func (_c *PriceService_GetPrices_Call) Run(run func(ctx context.Context, partUUIDs []string, at time.Time)) *PriceService_GetPrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *PriceService_GetPrices_Call) Return(_a0 []*model.PartPrice, _a1 error) *PriceService_GetPrices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PriceService_GetPrices_Call) RunAndReturn(run func(context.Context, []string, time.Time) ([]*model.PartPrice, error)) *PriceService_GetPrices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListHistory provides a mock function with given fields: ctx, partUUID
+func (_m *PriceService) ListHistory(ctx context.Context, partUUID string) ([]*model.PriceChange, error) {
+	ret := _m.Called(ctx, partUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListHistory")
+	}
+
+	var r0 []*model.PriceChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*model.PriceChange, error)); ok {
+		return rf(ctx, partUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.PriceChange); ok {
+		r0 = rf(ctx, partUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PriceChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, partUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PriceService_ListHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListHistory'
+type PriceService_ListHistory_Call struct {
+	*mock.Call
+}
+
+// ListHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partUUID string
+func (_e *PriceService_Expecter) ListHistory(ctx interface{}, partUUID interface{}) *PriceService_ListHistory_Call {
+	return &PriceService_ListHistory_Call{Call: _e.mock.On("ListHistory", ctx, partUUID)}
+}
+
+func (_c *PriceService_ListHistory_Call) Run(run func(ctx context.Context, partUUID string)) *PriceService_ListHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PriceService_ListHistory_Call) Return(_a0 []*model.PriceChange, _a1 error) *PriceService_ListHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PriceService_ListHistory_Call) RunAndReturn(run func(context.Context, string) ([]*model.PriceChange, error)) *PriceService_ListHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RecordPrice provides a mock function with given fields: ctx, partUUID, price, reason
+func (_m *PriceService) RecordPrice(ctx context.Context, partUUID string, price float64, reason string) error {
+	ret := _m.Called(ctx, partUUID, price, reason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordPrice")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64, string) error); ok {
+		r0 = rf(ctx, partUUID, price, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PriceService_RecordPrice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RecordPrice'
+type PriceService_RecordPrice_Call struct {
+	*mock.Call
+}
+
+// RecordPrice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partUUID string
+//   - price float64
+//   - reason string
+func (_e *PriceService_Expecter) RecordPrice(ctx interface{}, partUUID interface{}, price interface{}, reason interface{}) *PriceService_RecordPrice_Call {
+	return &PriceService_RecordPrice_Call{Call: _e.mock.On("RecordPrice", ctx, partUUID, price, reason)}
+}
+
+func (_c *PriceService_RecordPrice_Call) Run(run func(ctx context.Context, partUUID string, price float64, reason string)) *PriceService_RecordPrice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(float64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *PriceService_RecordPrice_Call) Return(_a0 error) *PriceService_RecordPrice_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PriceService_RecordPrice_Call) RunAndReturn(run func(context.Context, string, float64, string) error) *PriceService_RecordPrice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SchedulePrice provides a mock function with given fields: ctx, change
+func (_m *PriceService) SchedulePrice(ctx context.Context, change *model.PriceChange) (*model.PriceChange, error) {
+	ret := _m.Called(ctx, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SchedulePrice")
+	}
+
+	var r0 *model.PriceChange
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PriceChange) (*model.PriceChange, error)); ok {
+		return rf(ctx, change)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PriceChange) *model.PriceChange); ok {
+		r0 = rf(ctx, change)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PriceChange)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.PriceChange) error); ok {
+		r1 = rf(ctx, change)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PriceService_SchedulePrice_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SchedulePrice'
+type PriceService_SchedulePrice_Call struct {
+	*mock.Call
+}
+
+// SchedulePrice is a helper method to define mock.On call
+//   - ctx context.Context
+//   - change *model.PriceChange
+func (_e *PriceService_Expecter) SchedulePrice(ctx interface{}, change interface{}) *PriceService_SchedulePrice_Call {
+	return &PriceService_SchedulePrice_Call{Call: _e.mock.On("SchedulePrice", ctx, change)}
+}
+
+func (_c *PriceService_SchedulePrice_Call) Run(run func(ctx context.Context, change *model.PriceChange)) *PriceService_SchedulePrice_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.PriceChange))
+	})
+	return _c
+}
+
+func (_c *PriceService_SchedulePrice_Call) Return(_a0 *model.PriceChange, _a1 error) *PriceService_SchedulePrice_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PriceService_SchedulePrice_Call) RunAndReturn(run func(context.Context, *model.PriceChange) (*model.PriceChange, error)) *PriceService_SchedulePrice_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPriceService creates a new instance of PriceService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPriceService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PriceService {
+	mock := &PriceService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
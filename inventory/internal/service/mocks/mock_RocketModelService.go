@@ -0,0 +1,216 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// RocketModelService is an autogenerated mock type for the RocketModelService type
+type RocketModelService struct {
+	mock.Mock
+}
+
+type RocketModelService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *RocketModelService) EXPECT() *RocketModelService_Expecter {
+	return &RocketModelService_Expecter{mock: &_m.Mock}
+}
+
+// CreateModel provides a mock function with given fields: ctx, rocketModel
+func (_m *RocketModelService) CreateModel(ctx context.Context, rocketModel *model.RocketModel) (*model.RocketModel, error) {
+	ret := _m.Called(ctx, rocketModel)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateModel")
+	}
+
+	var r0 *model.RocketModel
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.RocketModel) (*model.RocketModel, error)); ok {
+		return rf(ctx, rocketModel)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.RocketModel) *model.RocketModel); ok {
+		r0 = rf(ctx, rocketModel)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RocketModel)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.RocketModel) error); ok {
+		r1 = rf(ctx, rocketModel)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RocketModelService_CreateModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateModel'
+type RocketModelService_CreateModel_Call struct {
+	*mock.Call
+}
+
+// CreateModel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rocketModel *model.RocketModel
+func (_e *RocketModelService_Expecter) CreateModel(ctx interface{}, rocketModel interface{}) *RocketModelService_CreateModel_Call {
+	return &RocketModelService_CreateModel_Call{Call: _e.mock.On("CreateModel", ctx, rocketModel)}
+}
+
+func (_c *RocketModelService_CreateModel_Call) Run(run func(ctx context.Context, rocketModel *model.RocketModel)) *RocketModelService_CreateModel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.RocketModel))
+	})
+	return _c
+}
+
+func (_c *RocketModelService_CreateModel_Call) Return(_a0 *model.RocketModel, _a1 error) *RocketModelService_CreateModel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RocketModelService_CreateModel_Call) RunAndReturn(run func(context.Context, *model.RocketModel) (*model.RocketModel, error)) *RocketModelService_CreateModel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpandModel provides a mock function with given fields: ctx, uuid, quantity
+func (_m *RocketModelService) ExpandModel(ctx context.Context, uuid string, quantity int64) (*model.RocketModelExpansion, error) {
+	ret := _m.Called(ctx, uuid, quantity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpandModel")
+	}
+
+	var r0 *model.RocketModelExpansion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) (*model.RocketModelExpansion, error)); ok {
+		return rf(ctx, uuid, quantity)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) *model.RocketModelExpansion); ok {
+		r0 = rf(ctx, uuid, quantity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.RocketModelExpansion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, uuid, quantity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RocketModelService_ExpandModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpandModel'
+type RocketModelService_ExpandModel_Call struct {
+	*mock.Call
+}
+
+// ExpandModel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - uuid string
+//   - quantity int64
+func (_e *RocketModelService_Expecter) ExpandModel(ctx interface{}, uuid interface{}, quantity interface{}) *RocketModelService_ExpandModel_Call {
+	return &RocketModelService_ExpandModel_Call{Call: _e.mock.On("ExpandModel", ctx, uuid, quantity)}
+}
+
+func (_c *RocketModelService_ExpandModel_Call) Run(run func(ctx context.Context, uuid string, quantity int64)) *RocketModelService_ExpandModel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *RocketModelService_ExpandModel_Call) Return(_a0 *model.RocketModelExpansion, _a1 error) *RocketModelService_ExpandModel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RocketModelService_ExpandModel_Call) RunAndReturn(run func(context.Context, string, int64) (*model.RocketModelExpansion, error)) *RocketModelService_ExpandModel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListModels provides a mock function with given fields: ctx
+func (_m *RocketModelService) ListModels(ctx context.Context) ([]*model.RocketModelSummary, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListModels")
+	}
+
+	var r0 []*model.RocketModelSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.RocketModelSummary, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.RocketModelSummary); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.RocketModelSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RocketModelService_ListModels_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListModels'
+type RocketModelService_ListModels_Call struct {
+	*mock.Call
+}
+
+// ListModels is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *RocketModelService_Expecter) ListModels(ctx interface{}) *RocketModelService_ListModels_Call {
+	return &RocketModelService_ListModels_Call{Call: _e.mock.On("ListModels", ctx)}
+}
+
+func (_c *RocketModelService_ListModels_Call) Run(run func(ctx context.Context)) *RocketModelService_ListModels_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *RocketModelService_ListModels_Call) Return(_a0 []*model.RocketModelSummary, _a1 error) *RocketModelService_ListModels_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RocketModelService_ListModels_Call) RunAndReturn(run func(context.Context) ([]*model.RocketModelSummary, error)) *RocketModelService_ListModels_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewRocketModelService creates a new instance of RocketModelService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewRocketModelService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *RocketModelService {
+	mock := &RocketModelService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
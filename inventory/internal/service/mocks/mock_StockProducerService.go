@@ -0,0 +1,133 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StockProducerService is an autogenerated mock type for the StockProducerService type
+type StockProducerService struct {
+	mock.Mock
+}
+
+type StockProducerService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StockProducerService) EXPECT() *StockProducerService_Expecter {
+	return &StockProducerService_Expecter{mock: &_m.Mock}
+}
+
+// PublishPartRestocked provides a mock function with given fields: ctx, part
+func (_m *StockProducerService) PublishPartRestocked(ctx context.Context, part *model.Part) error {
+	ret := _m.Called(ctx, part)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishPartRestocked")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Part) error); ok {
+		r0 = rf(ctx, part)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StockProducerService_PublishPartRestocked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishPartRestocked'
+type StockProducerService_PublishPartRestocked_Call struct {
+	*mock.Call
+}
+
+// PublishPartRestocked is a helper method to define mock.On call
+//   - ctx context.Context
+//   - part *model.Part
+func (_e *StockProducerService_Expecter) PublishPartRestocked(ctx interface{}, part interface{}) *StockProducerService_PublishPartRestocked_Call {
+	return &StockProducerService_PublishPartRestocked_Call{Call: _e.mock.On("PublishPartRestocked", ctx, part)}
+}
+
+func (_c *StockProducerService_PublishPartRestocked_Call) Run(run func(ctx context.Context, part *model.Part)) *StockProducerService_PublishPartRestocked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Part))
+	})
+	return _c
+}
+
+func (_c *StockProducerService_PublishPartRestocked_Call) Return(_a0 error) *StockProducerService_PublishPartRestocked_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StockProducerService_PublishPartRestocked_Call) RunAndReturn(run func(context.Context, *model.Part) error) *StockProducerService_PublishPartRestocked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishPartStockLow provides a mock function with given fields: ctx, part
+func (_m *StockProducerService) PublishPartStockLow(ctx context.Context, part *model.Part) error {
+	ret := _m.Called(ctx, part)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishPartStockLow")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Part) error); ok {
+		r0 = rf(ctx, part)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StockProducerService_PublishPartStockLow_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishPartStockLow'
+type StockProducerService_PublishPartStockLow_Call struct {
+	*mock.Call
+}
+
+// PublishPartStockLow is a helper method to define mock.On call
+//   - ctx context.Context
+//   - part *model.Part
+func (_e *StockProducerService_Expecter) PublishPartStockLow(ctx interface{}, part interface{}) *StockProducerService_PublishPartStockLow_Call {
+	return &StockProducerService_PublishPartStockLow_Call{Call: _e.mock.On("PublishPartStockLow", ctx, part)}
+}
+
+func (_c *StockProducerService_PublishPartStockLow_Call) Run(run func(ctx context.Context, part *model.Part)) *StockProducerService_PublishPartStockLow_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Part))
+	})
+	return _c
+}
+
+func (_c *StockProducerService_PublishPartStockLow_Call) Return(_a0 error) *StockProducerService_PublishPartStockLow_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StockProducerService_PublishPartStockLow_Call) RunAndReturn(run func(context.Context, *model.Part) error) *StockProducerService_PublishPartStockLow_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStockProducerService creates a new instance of StockProducerService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStockProducerService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StockProducerService {
+	mock := &StockProducerService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,567 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StockService is an autogenerated mock type for the StockService type
+type StockService struct {
+	mock.Mock
+}
+
+type StockService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *StockService) EXPECT() *StockService_Expecter {
+	return &StockService_Expecter{mock: &_m.Mock}
+}
+
+// ApplyStockChange provides a mock function with given fields: ctx, change
+func (_m *StockService) ApplyStockChange(ctx context.Context, change *model.StockChange) (*model.StockMovement, error) {
+	ret := _m.Called(ctx, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ApplyStockChange")
+	}
+
+	var r0 *model.StockMovement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockChange) (*model.StockMovement, error)); ok {
+		return rf(ctx, change)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockChange) *model.StockMovement); ok {
+		r0 = rf(ctx, change)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.StockMovement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.StockChange) error); ok {
+		r1 = rf(ctx, change)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StockService_ApplyStockChange_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ApplyStockChange'
+type StockService_ApplyStockChange_Call struct {
+	*mock.Call
+}
+
+// ApplyStockChange is a helper method to define mock.On call
+//   - ctx context.Context
+//   - change *model.StockChange
+func (_e *StockService_Expecter) ApplyStockChange(ctx interface{}, change interface{}) *StockService_ApplyStockChange_Call {
+	return &StockService_ApplyStockChange_Call{Call: _e.mock.On("ApplyStockChange", ctx, change)}
+}
+
+func (_c *StockService_ApplyStockChange_Call) Run(run func(ctx context.Context, change *model.StockChange)) *StockService_ApplyStockChange_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.StockChange))
+	})
+	return _c
+}
+
+func (_c *StockService_ApplyStockChange_Call) Return(_a0 *model.StockMovement, _a1 error) *StockService_ApplyStockChange_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StockService_ApplyStockChange_Call) RunAndReturn(run func(context.Context, *model.StockChange) (*model.StockMovement, error)) *StockService_ApplyStockChange_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChangeStock provides a mock function with given fields: ctx, change
+func (_m *StockService) ChangeStock(ctx context.Context, change *model.StockChange) (*model.StockMovement, error) {
+	ret := _m.Called(ctx, change)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeStock")
+	}
+
+	var r0 *model.StockMovement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockChange) (*model.StockMovement, error)); ok {
+		return rf(ctx, change)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockChange) *model.StockMovement); ok {
+		r0 = rf(ctx, change)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.StockMovement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.StockChange) error); ok {
+		r1 = rf(ctx, change)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StockService_ChangeStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeStock'
+type StockService_ChangeStock_Call struct {
+	*mock.Call
+}
+
+// ChangeStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - change *model.StockChange
+func (_e *StockService_Expecter) ChangeStock(ctx interface{}, change interface{}) *StockService_ChangeStock_Call {
+	return &StockService_ChangeStock_Call{Call: _e.mock.On("ChangeStock", ctx, change)}
+}
+
+func (_c *StockService_ChangeStock_Call) Run(run func(ctx context.Context, change *model.StockChange)) *StockService_ChangeStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.StockChange))
+	})
+	return _c
+}
+
+func (_c *StockService_ChangeStock_Call) Return(_a0 *model.StockMovement, _a1 error) *StockService_ChangeStock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StockService_ChangeStock_Call) RunAndReturn(run func(context.Context, *model.StockChange) (*model.StockMovement, error)) *StockService_ChangeStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckStockLevel provides a mock function with given fields: ctx, partUUID
+func (_m *StockService) CheckStockLevel(ctx context.Context, partUUID string) error {
+	ret := _m.Called(ctx, partUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckStockLevel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, partUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StockService_CheckStockLevel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckStockLevel'
+type StockService_CheckStockLevel_Call struct {
+	*mock.Call
+}
+
+// CheckStockLevel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partUUID string
+func (_e *StockService_Expecter) CheckStockLevel(ctx interface{}, partUUID interface{}) *StockService_CheckStockLevel_Call {
+	return &StockService_CheckStockLevel_Call{Call: _e.mock.On("CheckStockLevel", ctx, partUUID)}
+}
+
+func (_c *StockService_CheckStockLevel_Call) Run(run func(ctx context.Context, partUUID string)) *StockService_CheckStockLevel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *StockService_CheckStockLevel_Call) Return(_a0 error) *StockService_CheckStockLevel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *StockService_CheckStockLevel_Call) RunAndReturn(run func(context.Context, string) error) *StockService_CheckStockLevel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConsumeStock provides a mock function with given fields: ctx, reference
+func (_m *StockService) ConsumeStock(ctx context.Context, reference string) ([]*model.StockMovement, error) {
+	ret := _m.Called(ctx, reference)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumeStock")
+	}
+
+	var r0 []*model.StockMovement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*model.StockMovement, error)); ok {
+		return rf(ctx, reference)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.StockMovement); ok {
+		r0 = rf(ctx, reference)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.StockMovement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, reference)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StockService_ConsumeStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumeStock'
+type StockService_ConsumeStock_Call struct {
+	*mock.Call
+}
+
+// ConsumeStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reference string
+func (_e *StockService_Expecter) ConsumeStock(ctx interface{}, reference interface{}) *StockService_ConsumeStock_Call {
+	return &StockService_ConsumeStock_Call{Call: _e.mock.On("ConsumeStock", ctx, reference)}
+}
+
+func (_c *StockService_ConsumeStock_Call) Run(run func(ctx context.Context, reference string)) *StockService_ConsumeStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *StockService_ConsumeStock_Call) Return(_a0 []*model.StockMovement, _a1 error) *StockService_ConsumeStock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StockService_ConsumeStock_Call) RunAndReturn(run func(context.Context, string) ([]*model.StockMovement, error)) *StockService_ConsumeStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAvailability provides a mock function with given fields: ctx, partUUIDs
+func (_m *StockService) GetAvailability(ctx context.Context, partUUIDs []string) ([]*model.PartAvailability, error) {
+	ret := _m.Called(ctx, partUUIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAvailability")
+	}
+
+	var r0 []*model.PartAvailability
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*model.PartAvailability, error)); ok {
+		return rf(ctx, partUUIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*model.PartAvailability); ok {
+		r0 = rf(ctx, partUUIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PartAvailability)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, partUUIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StockService_GetAvailability_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAvailability'
+type StockService_GetAvailability_Call struct {
+	*mock.Call
+}
+
+// GetAvailability is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partUUIDs []string
+func (_e *StockService_Expecter) GetAvailability(ctx interface{}, partUUIDs interface{}) *StockService_GetAvailability_Call {
+	return &StockService_GetAvailability_Call{Call: _e.mock.On("GetAvailability", ctx, partUUIDs)}
+}
+
+func (_c *StockService_GetAvailability_Call) Run(run func(ctx context.Context, partUUIDs []string)) *StockService_GetAvailability_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *StockService_GetAvailability_Call) Return(_a0 []*model.PartAvailability, _a1 error) *StockService_GetAvailability_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StockService_GetAvailability_Call) RunAndReturn(run func(context.Context, []string) ([]*model.PartAvailability, error)) *StockService_GetAvailability_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListMovements provides a mock function with given fields: ctx, query
+func (_m *StockService) ListMovements(ctx context.Context, query *model.StockMovementsQuery) (*model.StockMovementsPage, error) {
+	ret := _m.Called(ctx, query)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListMovements")
+	}
+
+	var r0 *model.StockMovementsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockMovementsQuery) (*model.StockMovementsPage, error)); ok {
+		return rf(ctx, query)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockMovementsQuery) *model.StockMovementsPage); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.StockMovementsPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.StockMovementsQuery) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StockService_ListMovements_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListMovements'
+type StockService_ListMovements_Call struct {
+	*mock.Call
+}
+
+// ListMovements is a helper method to define mock.On call
+//   - ctx context.Context
+//   - query *model.StockMovementsQuery
+func (_e *StockService_Expecter) ListMovements(ctx interface{}, query interface{}) *StockService_ListMovements_Call {
+	return &StockService_ListMovements_Call{Call: _e.mock.On("ListMovements", ctx, query)}
+}
+
+func (_c *StockService_ListMovements_Call) Run(run func(ctx context.Context, query *model.StockMovementsQuery)) *StockService_ListMovements_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.StockMovementsQuery))
+	})
+	return _c
+}
+
+func (_c *StockService_ListMovements_Call) Return(_a0 *model.StockMovementsPage, _a1 error) *StockService_ListMovements_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StockService_ListMovements_Call) RunAndReturn(run func(context.Context, *model.StockMovementsQuery) (*model.StockMovementsPage, error)) *StockService_ListMovements_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseStock provides a mock function with given fields: ctx, reference
+func (_m *StockService) ReleaseStock(ctx context.Context, reference string) ([]*model.StockMovement, error) {
+	ret := _m.Called(ctx, reference)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseStock")
+	}
+
+	var r0 []*model.StockMovement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]*model.StockMovement, error)); ok {
+		return rf(ctx, reference)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.StockMovement); ok {
+		r0 = rf(ctx, reference)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.StockMovement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, reference)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StockService_ReleaseStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseStock'
+type StockService_ReleaseStock_Call struct {
+	*mock.Call
+}
+
+// ReleaseStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reference string
+func (_e *StockService_Expecter) ReleaseStock(ctx interface{}, reference interface{}) *StockService_ReleaseStock_Call {
+	return &StockService_ReleaseStock_Call{Call: _e.mock.On("ReleaseStock", ctx, reference)}
+}
+
+func (_c *StockService_ReleaseStock_Call) Run(run func(ctx context.Context, reference string)) *StockService_ReleaseStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *StockService_ReleaseStock_Call) Return(_a0 []*model.StockMovement, _a1 error) *StockService_ReleaseStock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StockService_ReleaseStock_Call) RunAndReturn(run func(context.Context, string) ([]*model.StockMovement, error)) *StockService_ReleaseStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveStock provides a mock function with given fields: ctx, reservation
+func (_m *StockService) ReserveStock(ctx context.Context, reservation *model.StockReservation) ([]*model.StockMovement, error) {
+	ret := _m.Called(ctx, reservation)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveStock")
+	}
+
+	var r0 []*model.StockMovement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockReservation) ([]*model.StockMovement, error)); ok {
+		return rf(ctx, reservation)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockReservation) []*model.StockMovement); ok {
+		r0 = rf(ctx, reservation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.StockMovement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.StockReservation) error); ok {
+		r1 = rf(ctx, reservation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StockService_ReserveStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveStock'
+type StockService_ReserveStock_Call struct {
+	*mock.Call
+}
+
+// ReserveStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - reservation *model.StockReservation
+func (_e *StockService_Expecter) ReserveStock(ctx interface{}, reservation interface{}) *StockService_ReserveStock_Call {
+	return &StockService_ReserveStock_Call{Call: _e.mock.On("ReserveStock", ctx, reservation)}
+}
+
+func (_c *StockService_ReserveStock_Call) Run(run func(ctx context.Context, reservation *model.StockReservation)) *StockService_ReserveStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.StockReservation))
+	})
+	return _c
+}
+
+func (_c *StockService_ReserveStock_Call) Return(_a0 []*model.StockMovement, _a1 error) *StockService_ReserveStock_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *StockService_ReserveStock_Call) RunAndReturn(run func(context.Context, *model.StockReservation) ([]*model.StockMovement, error)) *StockService_ReserveStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TransferStock provides a mock function with given fields: ctx, transfer
+func (_m *StockService) TransferStock(ctx context.Context, transfer *model.StockTransfer) (*model.StockMovement, *model.StockMovement, error) {
+	ret := _m.Called(ctx, transfer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TransferStock")
+	}
+
+	var r0 *model.StockMovement
+	var r1 *model.StockMovement
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockTransfer) (*model.StockMovement, *model.StockMovement, error)); ok {
+		return rf(ctx, transfer)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StockTransfer) *model.StockMovement); ok {
+		r0 = rf(ctx, transfer)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.StockMovement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.StockTransfer) *model.StockMovement); ok {
+		r1 = rf(ctx, transfer)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*model.StockMovement)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *model.StockTransfer) error); ok {
+		r2 = rf(ctx, transfer)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// StockService_TransferStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TransferStock'
+type StockService_TransferStock_Call struct {
+	*mock.Call
+}
+
+// TransferStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transfer *model.StockTransfer
+func (_e *StockService_Expecter) TransferStock(ctx interface{}, transfer interface{}) *StockService_TransferStock_Call {
+	return &StockService_TransferStock_Call{Call: _e.mock.On("TransferStock", ctx, transfer)}
+}
+
+func (_c *StockService_TransferStock_Call) Run(run func(ctx context.Context, transfer *model.StockTransfer)) *StockService_TransferStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.StockTransfer))
+	})
+	return _c
+}
+
+func (_c *StockService_TransferStock_Call) Return(_a0 *model.StockMovement, _a1 *model.StockMovement, _a2 error) *StockService_TransferStock_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *StockService_TransferStock_Call) RunAndReturn(run func(context.Context, *model.StockTransfer) (*model.StockMovement, *model.StockMovement, error)) *StockService_TransferStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStockService creates a new instance of StockService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStockService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *StockService {
+	mock := &StockService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,156 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WarehouseService is an autogenerated mock type for the WarehouseService type
+type WarehouseService struct {
+	mock.Mock
+}
+
+type WarehouseService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *WarehouseService) EXPECT() *WarehouseService_Expecter {
+	return &WarehouseService_Expecter{mock: &_m.Mock}
+}
+
+// CreateWarehouse provides a mock function with given fields: ctx, warehouse
+func (_m *WarehouseService) CreateWarehouse(ctx context.Context, warehouse *model.Warehouse) (*model.Warehouse, error) {
+	ret := _m.Called(ctx, warehouse)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateWarehouse")
+	}
+
+	var r0 *model.Warehouse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Warehouse) (*model.Warehouse, error)); ok {
+		return rf(ctx, warehouse)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Warehouse) *model.Warehouse); ok {
+		r0 = rf(ctx, warehouse)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Warehouse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Warehouse) error); ok {
+		r1 = rf(ctx, warehouse)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WarehouseService_CreateWarehouse_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWarehouse'
+type WarehouseService_CreateWarehouse_Call struct {
+	*mock.Call
+}
+
+// CreateWarehouse is a helper method to define mock.On call
+//   - ctx context.Context
+//   - warehouse *model.Warehouse
+func (_e *WarehouseService_Expecter) CreateWarehouse(ctx interface{}, warehouse interface{}) *WarehouseService_CreateWarehouse_Call {
+	return &WarehouseService_CreateWarehouse_Call{Call: _e.mock.On("CreateWarehouse", ctx, warehouse)}
+}
+
+func (_c *WarehouseService_CreateWarehouse_Call) Run(run func(ctx context.Context, warehouse *model.Warehouse)) *WarehouseService_CreateWarehouse_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Warehouse))
+	})
+	return _c
+}
+
+func (_c *WarehouseService_CreateWarehouse_Call) Return(_a0 *model.Warehouse, _a1 error) *WarehouseService_CreateWarehouse_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WarehouseService_CreateWarehouse_Call) RunAndReturn(run func(context.Context, *model.Warehouse) (*model.Warehouse, error)) *WarehouseService_CreateWarehouse_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWarehouses provides a mock function with given fields: ctx
+func (_m *WarehouseService) ListWarehouses(ctx context.Context) ([]*model.Warehouse, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWarehouses")
+	}
+
+	var r0 []*model.Warehouse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]*model.Warehouse, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.Warehouse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Warehouse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WarehouseService_ListWarehouses_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWarehouses'
+type WarehouseService_ListWarehouses_Call struct {
+	*mock.Call
+}
+
+// ListWarehouses is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *WarehouseService_Expecter) ListWarehouses(ctx interface{}) *WarehouseService_ListWarehouses_Call {
+	return &WarehouseService_ListWarehouses_Call{Call: _e.mock.On("ListWarehouses", ctx)}
+}
+
+func (_c *WarehouseService_ListWarehouses_Call) Run(run func(ctx context.Context)) *WarehouseService_ListWarehouses_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *WarehouseService_ListWarehouses_Call) Return(_a0 []*model.Warehouse, _a1 error) *WarehouseService_ListWarehouses_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *WarehouseService_ListWarehouses_Call) RunAndReturn(run func(context.Context) ([]*model.Warehouse, error)) *WarehouseService_ListWarehouses_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewWarehouseService creates a new instance of WarehouseService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWarehouseService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WarehouseService {
+	mock := &WarehouseService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
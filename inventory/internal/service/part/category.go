@@ -0,0 +1,38 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+// checkCategory проверяет, что категория детали есть в справочнике категорий,
+// а metadata детали соответствует схеме категории
+func (s *service) checkCategory(ctx context.Context, part *model.Part) error {
+	schema, err := s.categoryService.GetCategorySchema(ctx, part.Category)
+	if err != nil {
+		if errors.Is(err, model.ErrCategoryNotFound) {
+			return fmt.Errorf("%w: unknown category %s", model.ErrInvalidPart, part.Category)
+		}
+		return err
+	}
+	return validateMetadata(part.Metadata, schema.Fields)
+}
+
+// expandFilter возвращает копию фильтра, в которой категории дополнены подкатегориями
+func (s *service) expandFilter(ctx context.Context, filter *model.PartsFilter) (*model.PartsFilter, error) {
+	if filter == nil || len(filter.Categories) == 0 {
+		return filter, nil
+	}
+
+	categories, err := s.categoryService.ExpandCategories(ctx, filter.Categories)
+	if err != nil {
+		return nil, err
+	}
+
+	expanded := *filter
+	expanded.Categories = categories
+	return &expanded, nil
+}
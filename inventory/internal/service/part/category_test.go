@@ -0,0 +1,135 @@
+package part
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestCreatePartUnknownCategory() {
+	part := newValidPart()
+	part.Category = "AVIONICS"
+
+	s.categoryService.On("GetCategorySchema", s.ctx, model.Category("AVIONICS")).Return(nil, model.ErrCategoryNotFound)
+
+	created, err := s.service.CreatePart(s.ctx, part)
+	s.Require().ErrorIs(err, model.ErrInvalidPart)
+	s.Require().Nil(created)
+}
+
+func (s *ServiceSuite) TestUpdatePartChecksChangedCategory() {
+	partUUID := gofakeit.UUID()
+	current := newValidPart()
+	current.Uuid = partUUID
+
+	s.partRepository.On("GetPart", s.ctx, partUUID).Return(current, nil)
+	s.categoryService.On("GetCategorySchema", s.ctx, model.Category("LANDING_GEAR")).
+		Return(&model.CategorySchema{Category: &model.PartCategory{Code: "LANDING_GEAR"}}, nil).Once()
+	s.partRepository.On("UpdatePart", s.ctx, mock.MatchedBy(func(p *model.Part) bool {
+		return p.Category == "LANDING_GEAR"
+	})).Return(nil)
+
+	updated, err := s.service.UpdatePart(s.ctx, &model.PartUpdate{
+		Part:   &model.Part{Uuid: partUUID, Version: 1, Category: "LANDING_GEAR"},
+		Fields: []string{model.PartFieldCategory},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(model.Category("LANDING_GEAR"), updated.Category)
+}
+
+func (s *ServiceSuite) TestListPartsIncludesSubcategories() {
+	s.categoryService.On("ExpandCategories", s.ctx, []model.Category{model.CATEGORY_ENGINE}).
+		Return([]model.Category{model.CATEGORY_ENGINE, "ION_ENGINE"}, nil)
+	s.partRepository.On("ListParts", s.ctx, mock.MatchedBy(func(q *model.PartsQuery) bool {
+		return len(q.Filter.Categories) == 2 && q.Filter.Categories[1] == "ION_ENGINE"
+	})).Return(&model.PartsPage{Parts: []*model.Part{}}, nil)
+
+	filter := &model.PartsFilter{Categories: []model.Category{model.CATEGORY_ENGINE}}
+	_, err := s.service.ListParts(s.ctx, &model.PartsQuery{Filter: filter})
+	s.Require().NoError(err)
+	// Фильтр вызывающего не меняется
+	s.Require().Len(filter.Categories, 1)
+}
+
+func engineSchema() *model.CategorySchema {
+	minThrust := 0.0
+	return &model.CategorySchema{
+		Category: &model.PartCategory{Code: model.CATEGORY_ENGINE},
+		Fields: []*model.MetadataField{
+			{Key: "тяга", Type: model.METADATA_FIELD_TYPE_NUMBER, Required: true, Range: &model.FloatRange{Min: &minThrust}},
+			{Key: "камер", Type: model.METADATA_FIELD_TYPE_INTEGER},
+			{Key: "многоразовый", Type: model.METADATA_FIELD_TYPE_BOOL},
+		},
+	}
+}
+
+func (s *ServiceSuite) TestCreatePartValidatesMetadata() {
+	s.categoryService.On("GetCategorySchema", s.ctx, model.CATEGORY_ENGINE).Return(engineSchema(), nil)
+
+	cases := map[string]map[string]interface{}{
+		"missing required": {"камер": int64(4)},
+		"wrong type":       {"тяга": "много"},
+		"out of range":     {"тяга": -1.0},
+		"not an integer":   {"тяга": 1000.0, "камер": 4.5},
+		"not a boolean":    {"тяга": 1000.0, "многоразовый": "да"},
+	}
+
+	for name, metadata := range cases {
+		part := newValidPart()
+		part.Metadata = metadata
+
+		created, err := s.service.CreatePart(s.ctx, part)
+		s.Require().ErrorIs(err, model.ErrInvalidPart, name)
+		s.Require().Nil(created, name)
+	}
+}
+
+func (s *ServiceSuite) TestCreatePartMetadataMatchesSchema() {
+	part := newValidPart()
+	part.StockQuantity = 0
+	// Целое значение подходит для NUMBER, ключи вне схемы не проверяются
+	part.Metadata = map[string]interface{}{"тяга": int64(845000), "камер": int64(9), "сертификат": "ГОСТ"}
+
+	s.categoryService.On("GetCategorySchema", s.ctx, model.CATEGORY_ENGINE).Return(engineSchema(), nil)
+	s.partRepository.On("CreatePart", s.ctx, mock.AnythingOfType("*model.Part")).Return(nil)
+	s.priceService.On("RecordPrice", s.ctx, mock.AnythingOfType("string"), part.Price, "initial price").Return(nil)
+
+	_, err := s.service.CreatePart(s.ctx, part)
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestUpdatePartValidatesChangedMetadata() {
+	partUUID := gofakeit.UUID()
+	current := newValidPart()
+	current.Uuid = partUUID
+	current.Metadata = map[string]interface{}{"тяга": 845000.0}
+
+	s.partRepository.On("GetPart", s.ctx, partUUID).Return(current, nil)
+	s.categoryService.On("GetCategorySchema", s.ctx, model.CATEGORY_ENGINE).Return(engineSchema(), nil)
+
+	_, err := s.service.UpdatePart(s.ctx, &model.PartUpdate{
+		Part:   &model.Part{Uuid: partUUID, Version: 1, Metadata: map[string]interface{}{"камер": int64(9)}},
+		Fields: []string{model.PartFieldMetadata},
+	})
+	s.Require().ErrorIs(err, model.ErrInvalidPart)
+}
+
+func (s *ServiceSuite) TestUpdatePartOtherFieldsSkipsSchema() {
+	partUUID := gofakeit.UUID()
+	current := newValidPart()
+	current.Uuid = partUUID
+	// Деталь заведена до появления обязательного поля в схеме
+	current.Metadata = map[string]interface{}{"камер": int64(9)}
+
+	s.partRepository.On("GetPart", s.ctx, partUUID).Return(current, nil)
+	s.partRepository.On("UpdatePart", s.ctx, mock.AnythingOfType("*model.Part")).Return(nil)
+
+	updated, err := s.service.UpdatePart(s.ctx, &model.PartUpdate{
+		Part:   &model.Part{Uuid: partUUID, Version: 1, Name: "Raptor 3"},
+		Fields: []string{model.PartFieldName},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal("Raptor 3", updated.Name)
+	s.categoryService.AssertNotCalled(s.T(), "GetCategorySchema", mock.Anything, mock.Anything)
+}
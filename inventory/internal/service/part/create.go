@@ -0,0 +1,68 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *service) CreatePart(ctx context.Context, part *model.Part) (*model.Part, error) {
+	if err := validatePart(part); err != nil {
+		return nil, err
+	}
+	if err := s.checkCategory(ctx, part); err != nil {
+		return nil, err
+	}
+
+	if part.Uuid == "" {
+		part.Uuid = uuid.NewString()
+	}
+
+	now := time.Now()
+	part.CreatedAt = &now
+	part.UpdatedAt = &now
+	part.Version = 1
+
+	// Остаток — проекция движений: деталь создается пустой, начальный остаток приходуется движением
+	initialStock := part.StockQuantity
+	part.StockQuantity = 0
+
+	if err := s.partRepository.CreatePart(ctx, part); err != nil {
+		if errors.Is(err, model.ErrPartAlreadyExists) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to create part: %w", err)
+	}
+
+	if err := s.priceService.RecordPrice(ctx, part.Uuid, part.Price, "initial price"); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case initialStock > 0:
+		movement, err := s.stockService.ChangeStock(ctx, &model.StockChange{
+			PartUuid: part.Uuid,
+			Type:     model.STOCK_MOVEMENT_TYPE_RECEIPT,
+			Quantity: initialStock,
+			Reason:   "initial stock",
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to receive initial stock: %w", err)
+		}
+		part.StockQuantity = movement.StockAfter
+		// Приход начального остатка увеличивает версию детали
+		part.Version++
+	case part.ReorderThreshold > 0:
+		// Движения не было, поэтому пустую деталь с порогом проверяем явно
+		s.checkStockLevel(ctx, part.Uuid)
+	default:
+		return part, nil
+	}
+
+	return s.reloadPart(ctx, part), nil
+}
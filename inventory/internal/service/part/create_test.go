@@ -0,0 +1,126 @@
+package part
+
+import (
+	"errors"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func newValidPart() *model.Part {
+	return &model.Part{
+		Name:          "Main Engine",
+		Description:   "Liquid-fuel main engine",
+		Price:         1500000.00,
+		StockQuantity: 4,
+		Category:      model.CATEGORY_ENGINE,
+		Dimensions: &model.Dimensions{
+			Length: 300.0,
+			Width:  120.0,
+			Height: 120.0,
+			Weight: 850.0,
+		},
+		Manufacturer: &model.Manufacturer{
+			Name:    "Rocket Engines Inc",
+			Country: "USA",
+		},
+		Tags: []string{"engine"},
+		// Версия сохраненной детали, CreatePart выставляет ее заново
+		Version: 1,
+	}
+}
+
+func (s *ServiceSuite) TestCreatePartSuccess() {
+	s.allowCategories()
+	part := newValidPart()
+
+	s.partRepository.On("CreatePart", s.ctx, mock.MatchedBy(func(p *model.Part) bool {
+		return p.StockQuantity == 0
+	})).Return(nil)
+	s.priceService.On("RecordPrice", s.ctx, mock.AnythingOfType("string"), part.Price, "initial price").Return(nil)
+	s.stockService.On("ChangeStock", s.ctx, mock.MatchedBy(func(c *model.StockChange) bool {
+		return c.Type == model.STOCK_MOVEMENT_TYPE_RECEIPT && c.Quantity == 4
+	})).Return(&model.StockMovement{StockAfter: 4}, nil)
+	s.partRepository.On("GetPart", s.ctx, mock.AnythingOfType("string")).Return(part, nil)
+
+	created, err := s.service.CreatePart(s.ctx, part)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(created.Uuid)
+	s.Require().Equal(int64(4), created.StockQuantity)
+	s.Require().NotNil(created.CreatedAt)
+	s.Require().NotNil(created.UpdatedAt)
+}
+
+func (s *ServiceSuite) TestCreatePartInvalid() {
+	part := newValidPart()
+	part.Price = 0
+
+	created, err := s.service.CreatePart(s.ctx, part)
+	s.Require().ErrorIs(err, model.ErrInvalidPart)
+	s.Require().Nil(created)
+}
+
+func (s *ServiceSuite) TestCreatePartAlreadyExists() {
+	s.allowCategories()
+	part := newValidPart()
+	part.Uuid = gofakeit.UUID()
+
+	s.partRepository.On("CreatePart", s.ctx, part).Return(model.ErrPartAlreadyExists)
+
+	created, err := s.service.CreatePart(s.ctx, part)
+	s.Require().ErrorIs(err, model.ErrPartAlreadyExists)
+	s.Require().Nil(created)
+}
+
+func (s *ServiceSuite) TestCreatePartWithoutStock() {
+	s.allowCategories()
+	part := newValidPart()
+	part.StockQuantity = 0
+
+	s.partRepository.On("CreatePart", s.ctx, mock.AnythingOfType("*model.Part")).Return(nil)
+	s.priceService.On("RecordPrice", s.ctx, mock.AnythingOfType("string"), part.Price, "initial price").Return(nil)
+
+	created, err := s.service.CreatePart(s.ctx, part)
+	s.Require().NoError(err)
+	s.Require().Zero(created.StockQuantity)
+}
+
+func (s *ServiceSuite) TestCreateEmptyPartWithThresholdChecksStockLevel() {
+	s.allowCategories()
+	part := newValidPart()
+	part.StockQuantity = 0
+	part.ReorderThreshold = 10
+
+	s.partRepository.On("CreatePart", s.ctx, mock.AnythingOfType("*model.Part")).Return(nil)
+	s.priceService.On("RecordPrice", s.ctx, mock.AnythingOfType("string"), part.Price, "initial price").Return(nil)
+	s.stockService.On("CheckStockLevel", s.ctx, mock.AnythingOfType("string")).Return(nil)
+
+	// Проверка порога переключила stock_low, поэтому возвращается перечитанная деталь с новой версией
+	stored := newValidPart()
+	stored.StockQuantity = 0
+	stored.ReorderThreshold = 10
+	stored.StockLow = true
+	stored.Version = 2
+	s.partRepository.On("GetPart", s.ctx, mock.AnythingOfType("string")).Return(stored, nil)
+
+	created, err := s.service.CreatePart(s.ctx, part)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(0), created.StockQuantity)
+	s.Require().True(created.StockLow)
+	s.Require().Equal(int64(2), created.Version)
+}
+
+func (s *ServiceSuite) TestCreatePartPriceHistoryError() {
+	s.allowCategories()
+	part := newValidPart()
+	recordErr := errors.New("mongo unavailable")
+
+	s.partRepository.On("CreatePart", s.ctx, mock.AnythingOfType("*model.Part")).Return(nil)
+	s.priceService.On("RecordPrice", s.ctx, mock.AnythingOfType("string"), part.Price, "initial price").Return(recordErr)
+
+	created, err := s.service.CreatePart(s.ctx, part)
+	s.Require().ErrorIs(err, recordErr)
+	s.Require().Nil(created)
+}
@@ -0,0 +1,22 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *service) DeletePart(ctx context.Context, uuid string) error {
+	err := s.partRepository.DeletePart(ctx, uuid, time.Now())
+	if err != nil {
+		if errors.Is(err, model.ErrPartNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to delete part: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package part
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestDeletePartSuccess() {
+	partUUID := gofakeit.UUID()
+
+	s.partRepository.On("DeletePart", s.ctx, partUUID, mock.AnythingOfType("time.Time")).Return(nil)
+
+	err := s.service.DeletePart(s.ctx, partUUID)
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestDeletePartNotFound() {
+	partUUID := gofakeit.UUID()
+
+	s.partRepository.On("DeletePart", s.ctx, partUUID, mock.AnythingOfType("time.Time")).Return(model.ErrPartNotFound)
+
+	err := s.service.DeletePart(s.ctx, partUUID)
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+}
@@ -0,0 +1,37 @@
+package part
+
+import (
+	"context"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+const exportPageSize = 500
+
+// ExportParts читает каталог страницами по exportPageSize, чтобы не держать его в памяти целиком
+func (s *service) ExportParts(ctx context.Context, export *model.CatalogExport) (int, error) {
+	query := &model.PartsQuery{
+		Filter:   export.Filter,
+		PageSize: exportPageSize,
+	}
+
+	var count int
+	for {
+		page, err := s.ListParts(ctx, query)
+		if err != nil {
+			return count, err
+		}
+
+		for _, part := range page.Parts {
+			if err = export.Write(part); err != nil {
+				return count, err
+			}
+			count++
+		}
+
+		if page.NextPageToken == "" {
+			return count, nil
+		}
+		query.PageToken = page.NextPageToken
+	}
+}
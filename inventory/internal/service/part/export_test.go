@@ -0,0 +1,59 @@
+package part
+
+import (
+	"errors"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestExportPartsPages() {
+	first := newValidPart()
+	first.Uuid = gofakeit.UUID()
+	second := newValidPart()
+	second.Uuid = gofakeit.UUID()
+
+	s.partRepository.On("ListParts", s.ctx, mock.MatchedBy(func(q *model.PartsQuery) bool {
+		return q.PageSize == exportPageSize && q.PageToken == ""
+	})).Return(&model.PartsPage{Parts: []*model.Part{first}, NextPageToken: "next"}, nil).Once()
+	s.partRepository.On("ListParts", s.ctx, mock.MatchedBy(func(q *model.PartsQuery) bool {
+		return q.PageToken == "next"
+	})).Return(&model.PartsPage{Parts: []*model.Part{second}}, nil).Once()
+
+	var written []string
+	count, err := s.service.ExportParts(s.ctx, &model.CatalogExport{
+		Write: func(part *model.Part) error {
+			written = append(written, part.Uuid)
+			return nil
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(2, count)
+	s.Require().Equal([]string{first.Uuid, second.Uuid}, written)
+}
+
+func (s *ServiceSuite) TestExportPartsInvalidFilter() {
+	count, err := s.service.ExportParts(s.ctx, &model.CatalogExport{
+		Filter: &model.PartsFilter{Metadata: []model.MetadataPredicate{{Key: "$where"}}},
+		Write:  func(*model.Part) error { return nil },
+	})
+	s.Require().ErrorIs(err, model.ErrInvalidFilter)
+	s.Require().Zero(count)
+}
+
+func (s *ServiceSuite) TestExportPartsWriteError() {
+	part := newValidPart()
+	part.Uuid = gofakeit.UUID()
+
+	s.partRepository.On("ListParts", s.ctx, mock.AnythingOfType("*model.PartsQuery")).
+		Return(&model.PartsPage{Parts: []*model.Part{part}, NextPageToken: "next"}, nil).Once()
+
+	writeErr := errors.New("client disconnected")
+	count, err := s.service.ExportParts(s.ctx, &model.CatalogExport{
+		Write: func(*model.Part) error { return writeErr },
+	})
+	s.Require().ErrorIs(err, writeErr)
+	s.Require().Zero(count)
+}
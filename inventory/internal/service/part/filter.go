@@ -0,0 +1,67 @@
+package part
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+// validateFilter проверяет диапазоны и условия на метаданные до обращения к базе
+func validateFilter(filter *model.PartsFilter) error {
+	if filter == nil {
+		return nil
+	}
+
+	floatRanges := map[string]*model.FloatRange{
+		"price":  filter.Price,
+		"length": filter.Length,
+		"width":  filter.Width,
+		"height": filter.Height,
+		"weight": filter.Weight,
+	}
+	for name, r := range floatRanges {
+		if r != nil && r.Min != nil && r.Max != nil && *r.Min > *r.Max {
+			return fmt.Errorf("%w: %s min is greater than max", model.ErrInvalidFilter, name)
+		}
+	}
+
+	if r := filter.StockQuantity; r != nil && r.Min != nil && r.Max != nil && *r.Min > *r.Max {
+		return fmt.Errorf("%w: stock_quantity min is greater than max", model.ErrInvalidFilter)
+	}
+
+	for _, predicate := range filter.Metadata {
+		if err := validateMetadataPredicate(predicate); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateMetadataPredicate(predicate model.MetadataPredicate) error {
+	// Ключ подставляется в путь metadata.<key>, точка и $ изменили бы смысл запроса
+	if predicate.Key == "" || strings.Contains(predicate.Key, ".") || strings.HasPrefix(predicate.Key, "$") {
+		return fmt.Errorf("%w: invalid metadata key %q", model.ErrInvalidFilter, predicate.Key)
+	}
+
+	switch predicate.Operator {
+	case model.METADATA_OPERATOR_EXISTS:
+		return nil
+	case model.METADATA_OPERATOR_EQ, model.METADATA_OPERATOR_NE:
+		if predicate.Value == nil {
+			return fmt.Errorf("%w: metadata %q value is required", model.ErrInvalidFilter, predicate.Key)
+		}
+		return nil
+	case model.METADATA_OPERATOR_GT, model.METADATA_OPERATOR_GTE,
+		model.METADATA_OPERATOR_LT, model.METADATA_OPERATOR_LTE:
+		switch predicate.Value.(type) {
+		case int64, float64, string:
+			return nil
+		default:
+			return fmt.Errorf("%w: metadata %q comparison requires number or string", model.ErrInvalidFilter, predicate.Key)
+		}
+	default:
+		return fmt.Errorf("%w: metadata %q operator is not set", model.ErrInvalidFilter, predicate.Key)
+	}
+}
@@ -0,0 +1,63 @@
+package part
+
+import (
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestListPartsRangeAndMetadataFilter() {
+	s.allowCategories()
+	maxPrice := 10000000.0
+	maxWeight := 6000.0
+
+	filter := &model.PartsFilter{
+		Categories:  []model.Category{model.CATEGORY_ENGINE},
+		Price:       &model.FloatRange{Max: &maxPrice},
+		Weight:      &model.FloatRange{Max: &maxWeight},
+		InStockOnly: true,
+		Metadata: []model.MetadataPredicate{
+			{Key: "тяга", Operator: model.METADATA_OPERATOR_GT, Value: int64(3000000)},
+			{Key: "certified", Operator: model.METADATA_OPERATOR_EXISTS},
+		},
+	}
+
+	s.partRepository.On("ListParts", s.ctx, &model.PartsQuery{Filter: filter, PageSize: defaultPageSize}).
+		Return(&model.PartsPage{}, nil)
+
+	_, err := s.service.ListParts(s.ctx, &model.PartsQuery{Filter: filter})
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestListPartsInvalidFilter() {
+	var (
+		minPrice = 100.0
+		maxPrice = 10.0
+		minStock = int64(5)
+		maxStock = int64(1)
+	)
+
+	filters := map[string]*model.PartsFilter{
+		"inverted price range": {Price: &model.FloatRange{Min: &minPrice, Max: &maxPrice}},
+		"inverted stock range": {StockQuantity: &model.IntRange{Min: &minStock, Max: &maxStock}},
+		"dotted metadata key": {Metadata: []model.MetadataPredicate{
+			{Key: "engine.thrust", Operator: model.METADATA_OPERATOR_EQ, Value: int64(1)},
+		}},
+		"operator key": {Metadata: []model.MetadataPredicate{
+			{Key: "$where", Operator: model.METADATA_OPERATOR_EXISTS},
+		}},
+		"missing operator": {Metadata: []model.MetadataPredicate{
+			{Key: "thrust", Value: int64(1)},
+		}},
+		"missing value": {Metadata: []model.MetadataPredicate{
+			{Key: "thrust", Operator: model.METADATA_OPERATOR_EQ},
+		}},
+		"bool comparison": {Metadata: []model.MetadataPredicate{
+			{Key: "reusable", Operator: model.METADATA_OPERATOR_GT, Value: true},
+		}},
+	}
+
+	for name, filter := range filters {
+		page, err := s.service.ListParts(s.ctx, &model.PartsQuery{Filter: filter})
+		s.Require().ErrorIs(err, model.ErrInvalidFilter, name)
+		s.Require().Nil(page, name)
+	}
+}
@@ -4,11 +4,35 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
 )
 
-func (s *service) GetPart(ctx context.Context, uuid string) (*model.Part, error) {
+// readableFields - поля детали, которые можно указать в маске чтения
+var readableFields = append([]string{
+	model.PartFieldUuid,
+	model.PartFieldCreatedAt,
+	model.PartFieldUpdatedAt,
+	model.PartFieldStockLow,
+	model.PartFieldAttachments,
+	model.PartFieldStockLocations,
+	model.PartFieldVersion,
+}, allPartFields...)
+
+// GetPart возвращает деталь по UUID. fields ограничивает возвращаемые поля, пусто — все поля
+func (s *service) GetPart(ctx context.Context, uuid string, fields []string) (*model.Part, error) {
+	if len(fields) > 0 {
+		batch, err := s.BatchGetParts(ctx, []string{uuid}, fields)
+		if err != nil {
+			return nil, err
+		}
+		if len(batch.Parts) == 0 {
+			return nil, model.ErrPartNotFound
+		}
+		return batch.Parts[0], nil
+	}
+
 	part, err := s.partRepository.GetPart(ctx, uuid)
 	if err != nil {
 		if errors.Is(err, model.ErrPartNotFound) {
@@ -19,3 +43,60 @@ func (s *service) GetPart(ctx context.Context, uuid string) (*model.Part, error)
 
 	return part, nil
 }
+
+// BatchGetParts возвращает детали в порядке запроса, повтор UUID повторяет деталь в ответе.
+// Не найденные и удаленные детали попадают в MissingUuids по одному разу
+func (s *service) BatchGetParts(ctx context.Context, uuids []string, fields []string) (*model.PartsBatch, error) {
+	if len(uuids) > maxPageSize {
+		return nil, fmt.Errorf("%w: at most %d uuids", model.ErrTooManyParts, maxPageSize)
+	}
+	if err := validateReadMask(fields); err != nil {
+		return nil, err
+	}
+
+	batch := &model.PartsBatch{Parts: make([]*model.Part, 0, len(uuids))}
+	if len(uuids) == 0 {
+		return batch, nil
+	}
+
+	unique := make([]string, 0, len(uuids))
+	seen := make(map[string]struct{}, len(uuids))
+	for _, uuid := range uuids {
+		if _, ok := seen[uuid]; !ok {
+			seen[uuid] = struct{}{}
+			unique = append(unique, uuid)
+		}
+	}
+
+	parts, err := s.partRepository.GetParts(ctx, unique, fields)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parts: %w", err)
+	}
+
+	byUUID := make(map[string]*model.Part, len(parts))
+	for _, part := range parts {
+		byUUID[part.Uuid] = part
+	}
+
+	for _, uuid := range uuids {
+		if part, ok := byUUID[uuid]; ok {
+			batch.Parts = append(batch.Parts, part)
+		}
+	}
+	for _, uuid := range unique {
+		if _, ok := byUUID[uuid]; !ok {
+			batch.MissingUuids = append(batch.MissingUuids, uuid)
+		}
+	}
+
+	return batch, nil
+}
+
+func validateReadMask(fields []string) error {
+	for _, field := range fields {
+		if !slices.Contains(readableFields, field) {
+			return fmt.Errorf("%w: unknown field %q", model.ErrInvalidReadMask, field)
+		}
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
 )
@@ -62,7 +63,7 @@ func (s *ServiceSuite) TestGetPartSuccess() {
 
 	s.partRepository.On("GetPart", s.ctx, partUUID).Return(expectedPart, nil)
 
-	part, err := s.service.GetPart(s.ctx, partUUID)
+	part, err := s.service.GetPart(s.ctx, partUUID, nil)
 	s.Require().NoError(err)
 	s.Require().Equal(expectedPart, part)
 }
@@ -72,8 +73,60 @@ func (s *ServiceSuite) TestGetPartNotFound() {
 
 	s.partRepository.On("GetPart", s.ctx, partUUID).Return(nil, model.ErrPartNotFound)
 
-	part, err := s.service.GetPart(s.ctx, partUUID)
+	part, err := s.service.GetPart(s.ctx, partUUID, nil)
 	s.Require().Error(err)
 	s.Require().Nil(part)
 	s.Require().ErrorIs(err, model.ErrPartNotFound)
 }
+
+func (s *ServiceSuite) TestGetPartWithFields() {
+	partUUID := gofakeit.UUID()
+	fields := []string{model.PartFieldName, model.PartFieldPrice}
+
+	s.partRepository.On("GetParts", s.ctx, []string{partUUID}, fields).
+		Return([]*model.Part{{Uuid: partUUID, Name: "Merlin 1D", Price: 1000000}}, nil)
+
+	part, err := s.service.GetPart(s.ctx, partUUID, fields)
+	s.Require().NoError(err)
+	s.Require().Equal("Merlin 1D", part.Name)
+	s.Require().Nil(part.Dimensions)
+}
+
+func (s *ServiceSuite) TestGetPartWithFieldsNotFound() {
+	partUUID := gofakeit.UUID()
+
+	s.partRepository.On("GetParts", s.ctx, []string{partUUID}, []string{model.PartFieldName}).
+		Return([]*model.Part{}, nil)
+
+	part, err := s.service.GetPart(s.ctx, partUUID, []string{model.PartFieldName})
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+	s.Require().Nil(part)
+}
+
+func (s *ServiceSuite) TestBatchGetPartsKeepsRequestOrder() {
+	first, second, missing := gofakeit.UUID(), gofakeit.UUID(), gofakeit.UUID()
+
+	// Репозиторий возвращает детали в своем порядке и без повторов
+	s.partRepository.On("GetParts", s.ctx, []string{second, missing, first}, []string(nil)).
+		Return([]*model.Part{{Uuid: first}, {Uuid: second}}, nil)
+
+	batch, err := s.service.BatchGetParts(s.ctx, []string{second, missing, first, second, missing}, nil)
+	s.Require().NoError(err)
+
+	uuids := make([]string, 0, len(batch.Parts))
+	for _, part := range batch.Parts {
+		uuids = append(uuids, part.Uuid)
+	}
+	s.Require().Equal([]string{second, first, second}, uuids)
+	s.Require().Equal([]string{missing}, batch.MissingUuids)
+}
+
+func (s *ServiceSuite) TestBatchGetPartsRejectsInvalidRequest() {
+	_, err := s.service.BatchGetParts(s.ctx, []string{gofakeit.UUID()}, []string{"deleted_at"})
+	s.Require().ErrorIs(err, model.ErrInvalidReadMask)
+
+	_, err = s.service.BatchGetParts(s.ctx, make([]string, maxPageSize+1), nil)
+	s.Require().ErrorIs(err, model.ErrTooManyParts)
+
+	s.partRepository.AssertNotCalled(s.T(), "GetParts", mock.Anything, mock.Anything, mock.Anything)
+}
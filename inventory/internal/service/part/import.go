@@ -0,0 +1,103 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/google/uuid"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+// ImportParts создает новые детали и обновляет существующие по UUID. Ошибки отдельных записей
+// попадают в отчет и не прерывают импорт; ошибки чтения файла и хранилища прерывают его
+func (s *service) ImportParts(ctx context.Context, catalogImport *model.CatalogImport) (*model.CatalogImportReport, error) {
+	report := &model.CatalogImportReport{DryRun: catalogImport.DryRun}
+	// Первая запись с каждым UUID, повторы в одном файле считаются ошибкой
+	seen := make(map[string]int)
+
+	for {
+		row, err := catalogImport.Reader.Next()
+		if errors.Is(err, io.EOF) {
+			return report, nil
+		}
+		if err != nil {
+			return report, err
+		}
+
+		report.Total++
+
+		created, err := s.importRow(ctx, row, seen, catalogImport.DryRun)
+		if err != nil {
+			if !isRowError(err) {
+				return report, err
+			}
+			report.Errors = append(report.Errors, model.CatalogRowError{
+				Row:      row.Row,
+				PartUuid: row.PartUuid,
+				Message:  err.Error(),
+			})
+			continue
+		}
+
+		if created {
+			report.Created++
+		} else {
+			report.Updated++
+		}
+	}
+}
+
+// importRow проверяет запись и сохраняет деталь, true — если деталь создана
+func (s *service) importRow(ctx context.Context, row *model.CatalogRow, seen map[string]int, dryRun bool) (bool, error) {
+	if row.Err != nil {
+		return false, fmt.Errorf("%w: %w", model.ErrInvalidPart, row.Err)
+	}
+
+	part := row.Part
+	if _, err := uuid.Parse(part.Uuid); err != nil {
+		return false, fmt.Errorf("%w: uuid is required and must be valid", model.ErrInvalidPart)
+	}
+	if first, ok := seen[part.Uuid]; ok {
+		return false, fmt.Errorf("%w: uuid already imported in row %d", model.ErrInvalidPart, first)
+	}
+	seen[part.Uuid] = row.Row
+
+	if err := validatePart(part); err != nil {
+		return false, err
+	}
+	if err := s.checkCategory(ctx, part); err != nil {
+		return false, err
+	}
+
+	existing, err := s.partRepository.GetPart(ctx, part.Uuid)
+	switch {
+	case errors.Is(err, model.ErrPartNotFound):
+		if dryRun {
+			return true, nil
+		}
+		_, err = s.CreatePart(ctx, part)
+		return true, err
+	case err != nil:
+		return false, fmt.Errorf("failed to get part: %w", err)
+	}
+
+	if dryRun {
+		return false, nil
+	}
+	// Файл каталога перезаписывает деталь целиком. Версия берется текущая: если деталь изменят
+	// между чтением и записью, запись отклоняется как ошибка строки, а не перезаписывает правку
+	part.Version = existing.Version
+	_, err = s.UpdatePart(ctx, &model.PartUpdate{Part: part})
+	return false, err
+}
+
+// isRowError отделяет ошибки данных записи от ошибок хранилища
+func isRowError(err error) bool {
+	return errors.Is(err, model.ErrInvalidPart) ||
+		errors.Is(err, model.ErrPartAlreadyExists) ||
+		errors.Is(err, model.ErrPartVersionMismatch) ||
+		errors.Is(err, model.ErrInsufficientStock)
+}
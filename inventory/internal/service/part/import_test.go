@@ -0,0 +1,185 @@
+package part
+
+import (
+	"errors"
+	"io"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+// sliceCatalogReader отдает заранее подготовленные записи
+type sliceCatalogReader struct {
+	rows []*model.CatalogRow
+	err  error
+}
+
+func (r *sliceCatalogReader) Next() (*model.CatalogRow, error) {
+	if len(r.rows) == 0 {
+		if r.err != nil {
+			return nil, r.err
+		}
+		return nil, io.EOF
+	}
+	row := r.rows[0]
+	r.rows = r.rows[1:]
+	return row, nil
+}
+
+func newCatalogRow(row int, part *model.Part) *model.CatalogRow {
+	return &model.CatalogRow{Row: row, PartUuid: part.Uuid, Part: part}
+}
+
+func (s *ServiceSuite) TestImportPartsCreatesAndUpdates() {
+	s.allowCategories()
+	newPart := newValidPart()
+	newPart.Uuid = gofakeit.UUID()
+
+	existing := newValidPart()
+	existing.Uuid = gofakeit.UUID()
+	changed := newValidPart()
+	changed.Uuid = existing.Uuid
+	changed.Price = 1750000.00
+
+	s.partRepository.On("GetPart", s.ctx, newPart.Uuid).Return(nil, model.ErrPartNotFound).Once()
+	s.partRepository.On("CreatePart", s.ctx, mock.MatchedBy(func(p *model.Part) bool {
+		return p.Uuid == newPart.Uuid
+	})).Return(nil)
+	s.stockService.On("ChangeStock", s.ctx, mock.MatchedBy(func(c *model.StockChange) bool {
+		return c.PartUuid == newPart.Uuid && c.Type == model.STOCK_MOVEMENT_TYPE_RECEIPT
+	})).Return(&model.StockMovement{StockAfter: 4}, nil)
+	s.priceService.On("RecordPrice", s.ctx, newPart.Uuid, newPart.Price, "initial price").Return(nil)
+	s.partRepository.On("GetPart", s.ctx, newPart.Uuid).Return(newPart, nil).Once()
+
+	s.partRepository.On("GetPart", s.ctx, existing.Uuid).Return(existing, nil)
+	s.partRepository.On("UpdatePart", s.ctx, mock.MatchedBy(func(p *model.Part) bool {
+		return p.Uuid == existing.Uuid && p.Price == 1750000.00
+	})).Return(nil)
+	s.priceService.On("RecordPrice", s.ctx, existing.Uuid, 1750000.00, "manual update").Return(nil)
+
+	report, err := s.service.ImportParts(s.ctx, &model.CatalogImport{
+		Reader: &sliceCatalogReader{rows: []*model.CatalogRow{
+			newCatalogRow(1, newPart),
+			newCatalogRow(2, changed),
+		}},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(2, report.Total)
+	s.Require().Equal(1, report.Created)
+	s.Require().Equal(1, report.Updated)
+	s.Require().Zero(report.Failed())
+}
+
+func (s *ServiceSuite) TestImportPartsReportsRowErrors() {
+	s.allowCategories()
+	valid := newValidPart()
+	valid.Uuid = gofakeit.UUID()
+
+	invalid := newValidPart()
+	invalid.Uuid = gofakeit.UUID()
+	invalid.Price = 0
+
+	duplicate := newValidPart()
+	duplicate.Uuid = valid.Uuid
+
+	noUUID := newValidPart()
+
+	s.partRepository.On("GetPart", s.ctx, valid.Uuid).Return(nil, model.ErrPartNotFound)
+
+	report, err := s.service.ImportParts(s.ctx, &model.CatalogImport{
+		DryRun: true,
+		Reader: &sliceCatalogReader{rows: []*model.CatalogRow{
+			newCatalogRow(1, valid),
+			newCatalogRow(2, invalid),
+			newCatalogRow(3, duplicate),
+			newCatalogRow(4, noUUID),
+			{Row: 5, PartUuid: "broken", Err: errors.New("price must be a number")},
+		}},
+	})
+	s.Require().NoError(err)
+	s.Require().True(report.DryRun)
+	s.Require().Equal(5, report.Total)
+	s.Require().Equal(1, report.Created)
+	s.Require().Equal(4, report.Failed())
+
+	rows := make([]int, 0, len(report.Errors))
+	for _, rowErr := range report.Errors {
+		rows = append(rows, rowErr.Row)
+	}
+	s.Require().Equal([]int{2, 3, 4, 5}, rows)
+	s.Require().Contains(report.Errors[1].Message, "row 1")
+	s.Require().Equal("broken", report.Errors[3].PartUuid)
+}
+
+func (s *ServiceSuite) TestImportPartsDryRunSkipsWrites() {
+	s.allowCategories()
+	existing := newValidPart()
+	existing.Uuid = gofakeit.UUID()
+
+	s.partRepository.On("GetPart", s.ctx, existing.Uuid).Return(existing, nil)
+
+	report, err := s.service.ImportParts(s.ctx, &model.CatalogImport{
+		DryRun: true,
+		Reader: &sliceCatalogReader{rows: []*model.CatalogRow{newCatalogRow(1, existing)}},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(1, report.Updated)
+	s.partRepository.AssertNotCalled(s.T(), "UpdatePart", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestImportPartsStopsOnReaderError() {
+	s.allowCategories()
+	part := newValidPart()
+	part.Uuid = gofakeit.UUID()
+
+	s.partRepository.On("GetPart", s.ctx, part.Uuid).Return(part, nil)
+
+	report, err := s.service.ImportParts(s.ctx, &model.CatalogImport{
+		DryRun: true,
+		Reader: &sliceCatalogReader{
+			rows: []*model.CatalogRow{newCatalogRow(1, part)},
+			err:  model.ErrInvalidCatalog,
+		},
+	})
+	s.Require().ErrorIs(err, model.ErrInvalidCatalog)
+	s.Require().Equal(1, report.Updated)
+}
+
+func (s *ServiceSuite) TestImportPartsStopsOnRepositoryError() {
+	s.allowCategories()
+	part := newValidPart()
+	part.Uuid = gofakeit.UUID()
+
+	s.partRepository.On("GetPart", s.ctx, part.Uuid).Return(nil, errors.New("connection refused"))
+
+	report, err := s.service.ImportParts(s.ctx, &model.CatalogImport{
+		Reader: &sliceCatalogReader{rows: []*model.CatalogRow{newCatalogRow(1, part)}},
+	})
+	s.Require().Error(err)
+	s.Require().Equal(1, report.Total)
+	s.Require().Zero(report.Failed())
+}
+
+func (s *ServiceSuite) TestImportPartsReportsConcurrentChange() {
+	s.allowCategories()
+	existing := newValidPart()
+	existing.Uuid = gofakeit.UUID()
+	existing.Version = 5
+	changed := newValidPart()
+	changed.Uuid = existing.Uuid
+	changed.Version = 0
+	changed.Name = "Main Engine Mk2"
+
+	s.partRepository.On("GetPart", s.ctx, existing.Uuid).Return(existing, nil)
+	s.partRepository.On("UpdatePart", s.ctx, mock.MatchedBy(func(p *model.Part) bool {
+		return p.Version == 5
+	})).Return(model.ErrPartVersionMismatch)
+
+	report, err := s.service.ImportParts(s.ctx, &model.CatalogImport{
+		Reader: &sliceCatalogReader{rows: []*model.CatalogRow{newCatalogRow(1, changed)}},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(1, report.Failed())
+}
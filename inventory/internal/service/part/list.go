@@ -8,13 +8,46 @@ import (
 	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
 )
 
-func (s *service) ListParts(ctx context.Context, filter *model.PartsFilter) ([]*model.Part, error) {
-	part, err := s.partRepository.ListParts(ctx, filter)
+const (
+	defaultPageSize = 50
+	maxPageSize     = 1000
+)
+
+func (s *service) ListParts(ctx context.Context, query *model.PartsQuery) (*model.PartsPage, error) {
+	if query == nil {
+		query = &model.PartsQuery{}
+	}
+
+	if err := validateFilter(query.Filter); err != nil {
+		return nil, err
+	}
+	if err := validateReadMask(query.Fields); err != nil {
+		return nil, err
+	}
+	filter, err := s.expandFilter(ctx, query.Filter)
+	if err != nil {
+		return nil, err
+	}
+	query.Filter = filter
+
+	switch {
+	case query.PageSize < 0:
+		return nil, model.ErrInvalidPageSize
+	case query.PageSize == 0:
+		query.PageSize = defaultPageSize
+	case query.PageSize > maxPageSize:
+		query.PageSize = maxPageSize
+	}
+
+	page, err := s.partRepository.ListParts(ctx, query)
 	if err != nil {
 		if errors.Is(err, model.ErrPartsNotFound) {
 			return nil, model.ErrPartsNotFound
 		}
+		if errors.Is(err, model.ErrInvalidPageToken) {
+			return nil, err
+		}
 		return nil, fmt.Errorf("failed to get parts: %w", err)
 	}
-	return part, nil
+	return page, nil
 }
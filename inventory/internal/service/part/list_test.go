@@ -9,6 +9,7 @@ import (
 )
 
 func (s *ServiceSuite) TestListPartsSuccess() {
+	s.allowCategories()
 	var (
 		filter = &model.PartsFilter{
 			Categories: []model.Category{model.CATEGORY_ENGINE, model.CATEGORY_FUEL},
@@ -154,14 +155,17 @@ func (s *ServiceSuite) TestListPartsSuccess() {
 		}
 	)
 
-	s.partRepository.On("ListParts", s.ctx, filter).Return(expectedParts, nil)
+	s.partRepository.On("ListParts", s.ctx, &model.PartsQuery{Filter: filter, PageSize: defaultPageSize}).
+		Return(&model.PartsPage{Parts: expectedParts, TotalSize: 3}, nil)
 
-	parts, err := s.service.ListParts(s.ctx, filter)
+	page, err := s.service.ListParts(s.ctx, &model.PartsQuery{Filter: filter})
 	s.Require().NoError(err)
-	s.Require().Equal(expectedParts, parts)
+	s.Require().Equal(expectedParts, page.Parts)
+	s.Require().Equal(int64(3), page.TotalSize)
 }
 
 func (s *ServiceSuite) TestListPartsRepositoryError() {
+	s.allowCategories()
 	var (
 		filter = &model.PartsFilter{
 			Categories: []model.Category{model.CATEGORY_ENGINE},
@@ -171,10 +175,40 @@ func (s *ServiceSuite) TestListPartsRepositoryError() {
 		repositoryError = model.ErrPartsNotFound
 	)
 
-	s.partRepository.On("ListParts", s.ctx, filter).Return(nil, repositoryError)
+	s.partRepository.On("ListParts", s.ctx, &model.PartsQuery{Filter: filter, PageSize: defaultPageSize}).
+		Return(nil, repositoryError)
 
-	parts, err := s.service.ListParts(s.ctx, filter)
+	parts, err := s.service.ListParts(s.ctx, &model.PartsQuery{Filter: filter})
 	s.Require().Error(err)
 	s.Require().Nil(parts)
 	s.Require().Equal(repositoryError, err)
 }
+
+func (s *ServiceSuite) TestListPartsClampsPageSize() {
+	s.partRepository.On("ListParts", s.ctx, &model.PartsQuery{
+		PageSize: maxPageSize,
+		SortBy:   model.PARTS_SORT_FIELD_PRICE,
+	}).Return(&model.PartsPage{}, nil)
+
+	_, err := s.service.ListParts(s.ctx, &model.PartsQuery{
+		PageSize: maxPageSize + 1,
+		SortBy:   model.PARTS_SORT_FIELD_PRICE,
+	})
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestListPartsNegativePageSize() {
+	page, err := s.service.ListParts(s.ctx, &model.PartsQuery{PageSize: -1})
+	s.Require().ErrorIs(err, model.ErrInvalidPageSize)
+	s.Require().Nil(page)
+}
+
+func (s *ServiceSuite) TestListPartsInvalidPageToken() {
+	query := &model.PartsQuery{PageSize: 10, PageToken: "broken"}
+
+	s.partRepository.On("ListParts", s.ctx, query).Return(nil, model.ErrInvalidPageToken)
+
+	page, err := s.service.ListParts(s.ctx, query)
+	s.Require().ErrorIs(err, model.ErrInvalidPageToken)
+	s.Require().Nil(page)
+}
@@ -0,0 +1,57 @@
+package part
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+const (
+	defaultSearchLimit = 20
+	maxSearchLimit     = 100
+)
+
+func (s *service) SearchParts(ctx context.Context, search *model.PartsSearch) ([]*model.PartSearchHit, error) {
+	search.Query = strings.TrimSpace(search.Query)
+	if search.Query == "" {
+		return nil, model.ErrEmptySearchQuery
+	}
+
+	if err := validateFilter(search.Filter); err != nil {
+		return nil, err
+	}
+	filter, err := s.expandFilter(ctx, search.Filter)
+	if err != nil {
+		return nil, err
+	}
+	search.Filter = filter
+
+	if search.Limit <= 0 {
+		search.Limit = defaultSearchLimit
+	}
+	if search.Limit > maxSearchLimit {
+		search.Limit = maxSearchLimit
+	}
+
+	if search.Language == model.SEARCH_LANGUAGE_UNSPECIFIED {
+		search.Language = detectSearchLanguage(search.Query)
+	}
+
+	hits, err := s.partRepository.SearchParts(ctx, search)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search parts: %w", err)
+	}
+
+	return hits, nil
+}
+
+// detectSearchLanguage считает запрос русским, если в нем есть кириллица
+func detectSearchLanguage(query string) model.SearchLanguage {
+	if strings.IndexFunc(query, func(r rune) bool { return unicode.Is(unicode.Cyrillic, r) }) >= 0 {
+		return model.SEARCH_LANGUAGE_RUSSIAN
+	}
+	return model.SEARCH_LANGUAGE_ENGLISH
+}
@@ -0,0 +1,64 @@
+package part
+
+import (
+	"errors"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestSearchPartsDetectsRussian() {
+	hits := []*model.PartSearchHit{
+		{Part: &model.Part{Uuid: gofakeit.UUID(), Name: "Ракетный двигатель RD-180"}, Score: 12.5},
+	}
+
+	s.partRepository.On("SearchParts", s.ctx, &model.PartsSearch{
+		Query:    "двигатель",
+		Language: model.SEARCH_LANGUAGE_RUSSIAN,
+		Limit:    defaultSearchLimit,
+	}).Return(hits, nil)
+
+	result, err := s.service.SearchParts(s.ctx, &model.PartsSearch{Query: "  двигатель "})
+	s.Require().NoError(err)
+	s.Require().Equal(hits, result)
+}
+
+func (s *ServiceSuite) TestSearchPartsDetectsEnglish() {
+	s.allowCategories()
+	filter := &model.PartsFilter{Categories: []model.Category{model.CATEGORY_ENGINE}}
+
+	s.partRepository.On("SearchParts", s.ctx, &model.PartsSearch{
+		Query:    "merlin",
+		Language: model.SEARCH_LANGUAGE_ENGLISH,
+		Filter:   filter,
+		Limit:    maxSearchLimit,
+	}).Return([]*model.PartSearchHit{}, nil)
+
+	_, err := s.service.SearchParts(s.ctx, &model.PartsSearch{
+		Query:  "merlin",
+		Filter: filter,
+		Limit:  maxSearchLimit + 1,
+	})
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestSearchPartsEmptyQuery() {
+	hits, err := s.service.SearchParts(s.ctx, &model.PartsSearch{Query: "   "})
+	s.Require().ErrorIs(err, model.ErrEmptySearchQuery)
+	s.Require().Nil(hits)
+}
+
+func (s *ServiceSuite) TestSearchPartsRepositoryError() {
+	repositoryError := errors.New("mongo is down")
+
+	s.partRepository.On("SearchParts", s.ctx, &model.PartsSearch{
+		Query:    "крыло",
+		Language: model.SEARCH_LANGUAGE_RUSSIAN,
+		Limit:    5,
+	}).Return(nil, repositoryError)
+
+	hits, err := s.service.SearchParts(s.ctx, &model.PartsSearch{Query: "крыло", Limit: 5})
+	s.Require().ErrorIs(err, repositoryError)
+	s.Require().Nil(hits)
+}
@@ -8,11 +8,28 @@ import (
 var _ def.PartService = (*service)(nil)
 
 type service struct {
-	partRepository repository.PartRepository
+	partRepository       repository.PartRepository
+	partChangeRepository repository.PartChangeRepository
+	stockService         def.StockService
+	priceService         def.PriceService
+	categoryService      def.CategoryService
+	txManager            repository.TxManager
 }
 
-func NewService(partRepository repository.PartRepository) *service {
+func NewService(
+	partRepository repository.PartRepository,
+	partChangeRepository repository.PartChangeRepository,
+	stockService def.StockService,
+	priceService def.PriceService,
+	categoryService def.CategoryService,
+	txManager repository.TxManager,
+) *service {
 	return &service{
-		partRepository: partRepository,
+		partRepository:       partRepository,
+		partChangeRepository: partChangeRepository,
+		stockService:         stockService,
+		priceService:         priceService,
+		categoryService:      categoryService,
+		txManager:            txManager,
 	}
 }
@@ -0,0 +1,38 @@
+package part
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+// checkStockLevel запускает проверку порога дозаказа. Деталь уже сохранена,
+// поэтому ошибка оповещения только логируется
+func (s *service) checkStockLevel(ctx context.Context, partUUID string) {
+	if err := s.stockService.CheckStockLevel(ctx, partUUID); err != nil {
+		logger.Error(ctx, "Failed to check stock level",
+			zap.String("part_uuid", partUUID),
+			zap.Error(err),
+		)
+	}
+}
+
+// reloadPart перечитывает деталь после проверки порога: переключение stock_low увеличивает
+// версию, и клиенту нужна актуальная версия для следующего UpdatePart. Изменения уже сохранены,
+// поэтому при ошибке чтения возвращается локальная копия
+func (s *service) reloadPart(ctx context.Context, part *model.Part) *model.Part {
+	stored, err := s.partRepository.GetPart(ctx, part.Uuid)
+	if err != nil {
+		logger.Error(ctx, "Failed to reload part",
+			zap.String("part_uuid", part.Uuid),
+			zap.Error(err),
+		)
+		return part
+	}
+
+	return stored
+}
@@ -4,30 +4,64 @@ import (
 	"context"
 	"testing"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
 	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/mocks"
+	serviceMocks "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/service/mocks"
 )
 
 type ServiceSuite struct {
 	suite.Suite
-	ctx            context.Context
-	partRepository *mocks.PartRepository
-	service        *service
+	ctx                  context.Context
+	partRepository       *mocks.PartRepository
+	partChangeRepository *mocks.PartChangeRepository
+	stockService         *serviceMocks.StockService
+	priceService         *serviceMocks.PriceService
+	categoryService      *serviceMocks.CategoryService
+	txManager            *mocks.TxManager
+	service              *service
 }
 
 func (s *ServiceSuite) SetupTest() {
 	s.ctx = context.Background()
 
 	s.partRepository = mocks.NewPartRepository(s.T())
+	s.partChangeRepository = mocks.NewPartChangeRepository(s.T())
+	s.stockService = serviceMocks.NewStockService(s.T())
+	s.priceService = serviceMocks.NewPriceService(s.T())
+	s.categoryService = serviceMocks.NewCategoryService(s.T())
+	s.txManager = mocks.NewTxManager(s.T())
+
+	// Транзакция в тестах просто вызывает fn с тем же контекстом
+	s.txManager.On("Run", s.ctx, mock.Anything).
+		Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		}).Maybe()
 
 	s.service = NewService(
 		s.partRepository,
+		s.partChangeRepository,
+		s.stockService,
+		s.priceService,
+		s.categoryService,
+		s.txManager,
 	)
 }
 
 func (s *ServiceSuite) TearDownTest() {}
 
+// allowCategories считает любую категорию существующей, без подкатегорий и без схемы метаданных
+func (s *ServiceSuite) allowCategories() {
+	s.categoryService.On("GetCategorySchema", s.ctx, mock.AnythingOfType("model.Category")).
+		Return(&model.CategorySchema{Category: &model.PartCategory{}}, nil).Maybe()
+	s.categoryService.On("ExpandCategories", s.ctx, mock.AnythingOfType("[]model.Category")).
+		Return(func(_ context.Context, codes []model.Category) ([]model.Category, error) {
+			return codes, nil
+		}).Maybe()
+}
+
 func TestServiceIntegration(t *testing.T) {
 	suite.Run(t, new(ServiceSuite))
 }
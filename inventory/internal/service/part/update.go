@@ -0,0 +1,160 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+var allPartFields = []string{
+	model.PartFieldName,
+	model.PartFieldDescription,
+	model.PartFieldPrice,
+	model.PartFieldStockQuantity,
+	model.PartFieldCategory,
+	model.PartFieldDimensions,
+	model.PartFieldManufacturer,
+	model.PartFieldTags,
+	model.PartFieldMetadata,
+	model.PartFieldReorderThreshold,
+}
+
+// UpdatePart применяет к текущей детали поля из маски и сохраняет результат после валидации.
+// Версия в запросе должна совпадать с текущей, иначе ErrPartVersionMismatch: изменение сделано
+// по устаревшим данным и перезаписало бы чужую правку
+func (s *service) UpdatePart(ctx context.Context, update *model.PartUpdate) (*model.Part, error) {
+	if update.Part.Version <= 0 {
+		return nil, fmt.Errorf("%w: version is required", model.ErrInvalidPart)
+	}
+
+	current, err := s.partRepository.GetPart(ctx, update.Part.Uuid)
+	if err != nil {
+		if errors.Is(err, model.ErrPartNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get part: %w", err)
+	}
+	if current.Version != update.Part.Version {
+		return nil, model.ErrPartVersionMismatch
+	}
+
+	fields := update.Fields
+	if len(fields) == 0 {
+		fields = allPartFields
+	}
+	stockBefore := current.StockQuantity
+	thresholdBefore := current.ReorderThreshold
+	priceBefore := current.Price
+	categoryBefore := current.Category
+	if err = applyFields(current, update.Part, fields); err != nil {
+		return nil, err
+	}
+
+	if err = validatePart(current); err != nil {
+		return nil, err
+	}
+	// Деталь, заведенная до изменения схемы, проверяется только при смене категории или metadata,
+	// чтобы не блокировать правку остальных полей
+	if current.Category != categoryBefore || slices.Contains(fields, model.PartFieldMetadata) {
+		if err = s.checkCategory(ctx, current); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	current.UpdatedAt = &now
+
+	// Карточка, история цены и корректирующее движение пишутся в одной транзакции:
+	// если движение отклонено, карточка остается прежней и ее версия не растет.
+	// Проверка порога с публикацией оповещения идет уже после коммита
+	delta := current.StockQuantity - stockBefore
+	var movement *model.StockMovement
+	err = s.txManager.Run(ctx, func(ctx context.Context) error {
+		if err := s.partRepository.UpdatePart(ctx, current); err != nil {
+			if errors.Is(err, model.ErrPartNotFound) || errors.Is(err, model.ErrPartVersionMismatch) {
+				return err
+			}
+			return fmt.Errorf("failed to update part: %w", err)
+		}
+
+		// Цена в карточке меняется сразу, в историю она попадает действующей с текущего момента
+		if current.Price != priceBefore {
+			if err := s.priceService.RecordPrice(ctx, current.Uuid, current.Price, "manual update"); err != nil {
+				return err
+			}
+		}
+
+		// Остаток не перезаписывается напрямую, разница оформляется корректирующим движением
+		if delta != 0 {
+			var err error
+			movement, err = s.stockService.ApplyStockChange(ctx, &model.StockChange{
+				PartUuid: current.Uuid,
+				Type:     model.STOCK_MOVEMENT_TYPE_ADJUSTMENT,
+				Quantity: delta,
+				Reason:   "manual update",
+			})
+			if err != nil {
+				if errors.Is(err, model.ErrInsufficientStock) {
+					return err
+				}
+				return fmt.Errorf("failed to adjust stock: %w", err)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	current.Version++
+
+	switch {
+	case movement != nil:
+		current.StockQuantity = movement.StockAfter
+		// Корректирующее движение тоже увеличивает версию детали
+		current.Version++
+	case current.ReorderThreshold != thresholdBefore:
+		// Смена порога без движения тоже может перевести деталь в низкий остаток или из него
+	default:
+		return current, nil
+	}
+	s.checkStockLevel(ctx, current.Uuid)
+
+	return s.reloadPart(ctx, current), nil
+}
+
+// applyFields копирует в dst значения перечисленных полей из src
+func applyFields(dst, src *model.Part, fields []string) error {
+	for _, field := range fields {
+		switch field {
+		case model.PartFieldName:
+			dst.Name = src.Name
+		case model.PartFieldDescription:
+			dst.Description = src.Description
+		case model.PartFieldPrice:
+			dst.Price = src.Price
+		case model.PartFieldStockQuantity:
+			dst.StockQuantity = src.StockQuantity
+		case model.PartFieldCategory:
+			dst.Category = src.Category
+		case model.PartFieldDimensions:
+			dst.Dimensions = src.Dimensions
+		case model.PartFieldManufacturer:
+			dst.Manufacturer = src.Manufacturer
+		case model.PartFieldTags:
+			dst.Tags = src.Tags
+		case model.PartFieldMetadata:
+			dst.Metadata = src.Metadata
+		case model.PartFieldReorderThreshold:
+			dst.ReorderThreshold = src.ReorderThreshold
+		default:
+			return fmt.Errorf("%w: unknown field %q", model.ErrInvalidUpdateMask, field)
+		}
+	}
+
+	return nil
+}
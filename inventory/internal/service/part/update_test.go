@@ -0,0 +1,295 @@
+package part
+
+import (
+	"context"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestUpdatePartMaskedFields() {
+	partUUID := gofakeit.UUID()
+
+	current := newValidPart()
+	current.Uuid = partUUID
+
+	patch := &model.Part{
+		Uuid:    partUUID,
+		Name:    "Main Engine Mk2",
+		Price:   0,
+		Version: 1,
+	}
+
+	s.partRepository.On("GetPart", s.ctx, partUUID).Return(current, nil)
+	s.partRepository.On("UpdatePart", s.ctx, mock.AnythingOfType("*model.Part")).Return(nil)
+
+	updated, err := s.service.UpdatePart(s.ctx, &model.PartUpdate{
+		Part:   patch,
+		Fields: []string{model.PartFieldName},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal("Main Engine Mk2", updated.Name)
+	s.Require().Equal(1500000.00, updated.Price)
+	s.Require().NotNil(updated.UpdatedAt)
+}
+
+func (s *ServiceSuite) TestUpdatePartPriceRecordsHistory() {
+	partUUID := gofakeit.UUID()
+
+	current := newValidPart()
+	current.Uuid = partUUID
+
+	s.partRepository.On("GetPart", s.ctx, partUUID).Return(current, nil)
+	s.partRepository.On("UpdatePart", s.ctx, mock.AnythingOfType("*model.Part")).Return(nil)
+	s.priceService.On("RecordPrice", s.ctx, partUUID, 1650000.00, "manual update").Return(nil)
+
+	updated, err := s.service.UpdatePart(s.ctx, &model.PartUpdate{
+		Part:   &model.Part{Uuid: partUUID, Version: 1, Price: 1650000.00},
+		Fields: []string{model.PartFieldPrice},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(1650000.00, updated.Price)
+}
+
+func (s *ServiceSuite) TestUpdatePartUnknownField() {
+	partUUID := gofakeit.UUID()
+
+	current := newValidPart()
+	current.Uuid = partUUID
+
+	s.partRepository.On("GetPart", s.ctx, partUUID).Return(current, nil)
+
+	updated, err := s.service.UpdatePart(s.ctx, &model.PartUpdate{
+		Part:   &model.Part{Uuid: partUUID, Version: 1},
+		Fields: []string{"uuid"},
+	})
+	s.Require().ErrorIs(err, model.ErrInvalidUpdateMask)
+	s.Require().Nil(updated)
+}
+
+func (s *ServiceSuite) TestUpdatePartInvalidResult() {
+	partUUID := gofakeit.UUID()
+
+	current := newValidPart()
+	current.Uuid = partUUID
+
+	s.partRepository.On("GetPart", s.ctx, partUUID).Return(current, nil)
+
+	updated, err := s.service.UpdatePart(s.ctx, &model.PartUpdate{
+		Part:   &model.Part{Uuid: partUUID, Version: 1, StockQuantity: -1},
+		Fields: []string{model.PartFieldStockQuantity},
+	})
+	s.Require().ErrorIs(err, model.ErrInvalidPart)
+	s.Require().Nil(updated)
+}
+
+func (s *ServiceSuite) TestUpdatePartNotFound() {
+	partUUID := gofakeit.UUID()
+
+	s.partRepository.On("GetPart", s.ctx, partUUID).Return(nil, model.ErrPartNotFound)
+
+	updated, err := s.service.UpdatePart(s.ctx, &model.PartUpdate{
+		Part: &model.Part{Uuid: partUUID, Version: 1},
+	})
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+	s.Require().Nil(updated)
+}
+
+func (s *ServiceSuite) TestUpdatePartStockBecomesAdjustment() {
+	partUUID := gofakeit.UUID()
+
+	current := newValidPart()
+	current.Uuid = partUUID
+
+	s.partRepository.On("GetPart", s.ctx, partUUID).Return(current, nil)
+	s.partRepository.On("UpdatePart", s.ctx, mock.AnythingOfType("*model.Part")).Return(nil)
+	s.stockService.On("ApplyStockChange", s.ctx, mock.MatchedBy(func(c *model.StockChange) bool {
+		return c.PartUuid == partUUID && c.Type == model.STOCK_MOVEMENT_TYPE_ADJUSTMENT && c.Quantity == -3
+	})).Return(&model.StockMovement{StockAfter: 1}, nil)
+	s.stockService.On("CheckStockLevel", s.ctx, partUUID).Return(nil)
+
+	updated, err := s.service.UpdatePart(s.ctx, &model.PartUpdate{
+		Part:   &model.Part{Uuid: partUUID, Version: 1, StockQuantity: 1},
+		Fields: []string{model.PartFieldStockQuantity},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), updated.StockQuantity)
+}
+
+func (s *ServiceSuite) TestUpdatePartInsufficientStockInTransaction() {
+	partUUID := gofakeit.UUID()
+
+	current := newValidPart()
+	current.Uuid = partUUID
+
+	s.partRepository.On("GetPart", s.ctx, partUUID).Return(current, nil)
+	s.partRepository.On("UpdatePart", s.ctx, mock.AnythingOfType("*model.Part")).Return(nil)
+	s.priceService.On("RecordPrice", s.ctx, partUUID, 1650000.00, "manual update").Return(nil)
+	s.stockService.On("ApplyStockChange", s.ctx, mock.AnythingOfType("*model.StockChange")).
+		Return(nil, model.ErrInsufficientStock)
+
+	updated, err := s.service.UpdatePart(s.ctx, &model.PartUpdate{
+		Part:   &model.Part{Uuid: partUUID, Version: 1, Price: 1650000.00, StockQuantity: 1},
+		Fields: []string{model.PartFieldPrice, model.PartFieldStockQuantity},
+	})
+
+	// Ошибка движения возвращается из транзакции, поэтому карточка и цена откатываются вместе с ним
+	s.Require().ErrorIs(err, model.ErrInsufficientStock)
+	s.Require().Nil(updated)
+	s.txManager.AssertCalled(s.T(), "Run", s.ctx, mock.Anything)
+	s.stockService.AssertNotCalled(s.T(), "CheckStockLevel", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestUpdatePartChecksStockLevelAfterCommit() {
+	partUUID := gofakeit.UUID()
+
+	current := newValidPart()
+	current.Uuid = partUUID
+
+	// Оповещение о низком остатке публикуется только после коммита транзакции
+	committed := false
+	s.txManager.ExpectedCalls = nil
+	s.txManager.On("Run", s.ctx, mock.Anything).
+		Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			err := fn(ctx)
+			committed = err == nil
+			return err
+		}).Once()
+
+	s.partRepository.On("GetPart", s.ctx, partUUID).Return(current, nil)
+	s.partRepository.On("UpdatePart", s.ctx, mock.AnythingOfType("*model.Part")).Return(nil)
+	s.stockService.On("ApplyStockChange", s.ctx, mock.AnythingOfType("*model.StockChange")).
+		Return(&model.StockMovement{StockAfter: 1}, nil).Once()
+	s.stockService.On("CheckStockLevel", s.ctx, partUUID).
+		Run(func(mock.Arguments) {
+			s.Require().True(committed)
+		}).
+		Return(nil).Once()
+
+	updated, err := s.service.UpdatePart(s.ctx, &model.PartUpdate{
+		Part:   &model.Part{Uuid: partUUID, Version: 1, StockQuantity: 1},
+		Fields: []string{model.PartFieldStockQuantity},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), updated.StockQuantity)
+	s.stockService.AssertNotCalled(s.T(), "ChangeStock", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestUpdatePartThresholdChecksStockLevel() {
+	partUUID := gofakeit.UUID()
+
+	current := newValidPart()
+	current.Uuid = partUUID
+
+	// Проверка порога переключила stock_low, поэтому возвращается перечитанная деталь с новой версией
+	stored := newValidPart()
+	stored.Uuid = partUUID
+	stored.ReorderThreshold = 10
+	stored.StockLow = true
+	stored.Version = 3
+
+	s.partRepository.On("GetPart", s.ctx, partUUID).Return(current, nil).Once()
+	s.partRepository.On("UpdatePart", s.ctx, mock.AnythingOfType("*model.Part")).Return(nil)
+	s.stockService.On("CheckStockLevel", s.ctx, partUUID).Return(nil)
+	s.partRepository.On("GetPart", s.ctx, partUUID).Return(stored, nil).Once()
+
+	updated, err := s.service.UpdatePart(s.ctx, &model.PartUpdate{
+		Part:   &model.Part{Uuid: partUUID, Version: 1, ReorderThreshold: 10},
+		Fields: []string{model.PartFieldReorderThreshold},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(10), updated.ReorderThreshold)
+	s.Require().True(updated.StockLow)
+	s.Require().Equal(int64(3), updated.Version)
+}
+
+func (s *ServiceSuite) TestUpdatePartNegativeThreshold() {
+	partUUID := gofakeit.UUID()
+
+	current := newValidPart()
+	current.Uuid = partUUID
+
+	s.partRepository.On("GetPart", s.ctx, partUUID).Return(current, nil)
+
+	updated, err := s.service.UpdatePart(s.ctx, &model.PartUpdate{
+		Part:   &model.Part{Uuid: partUUID, Version: 1, ReorderThreshold: -1},
+		Fields: []string{model.PartFieldReorderThreshold},
+	})
+	s.Require().ErrorIs(err, model.ErrInvalidPart)
+	s.Require().Nil(updated)
+}
+
+func (s *ServiceSuite) TestUpdatePartRequiresVersion() {
+	partUUID := gofakeit.UUID()
+
+	updated, err := s.service.UpdatePart(s.ctx, &model.PartUpdate{
+		Part:   &model.Part{Uuid: partUUID, Name: "Main Engine Mk2"},
+		Fields: []string{model.PartFieldName},
+	})
+	s.Require().ErrorIs(err, model.ErrInvalidPart)
+	s.Require().Nil(updated)
+	s.partRepository.AssertNotCalled(s.T(), "GetPart", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestUpdatePartStaleVersion() {
+	partUUID := gofakeit.UUID()
+
+	current := newValidPart()
+	current.Uuid = partUUID
+	current.Version = 4
+
+	s.partRepository.On("GetPart", s.ctx, partUUID).Return(current, nil)
+
+	updated, err := s.service.UpdatePart(s.ctx, &model.PartUpdate{
+		Part:   &model.Part{Uuid: partUUID, Version: 3, Price: 1650000.00},
+		Fields: []string{model.PartFieldPrice},
+	})
+	s.Require().ErrorIs(err, model.ErrPartVersionMismatch)
+	s.Require().Nil(updated)
+	s.partRepository.AssertNotCalled(s.T(), "UpdatePart", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestUpdatePartConcurrentChange() {
+	partUUID := gofakeit.UUID()
+
+	current := newValidPart()
+	current.Uuid = partUUID
+
+	// Деталь изменили между чтением и записью
+	s.partRepository.On("GetPart", s.ctx, partUUID).Return(current, nil)
+	s.partRepository.On("UpdatePart", s.ctx, mock.AnythingOfType("*model.Part")).Return(model.ErrPartVersionMismatch)
+
+	updated, err := s.service.UpdatePart(s.ctx, &model.PartUpdate{
+		Part:   &model.Part{Uuid: partUUID, Version: 1, Price: 1650000.00},
+		Fields: []string{model.PartFieldPrice},
+	})
+	s.Require().ErrorIs(err, model.ErrPartVersionMismatch)
+	s.Require().Nil(updated)
+	s.priceService.AssertNotCalled(s.T(), "RecordPrice", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestUpdatePartIncrementsVersion() {
+	partUUID := gofakeit.UUID()
+
+	current := newValidPart()
+	current.Uuid = partUUID
+	current.Version = 7
+
+	s.partRepository.On("GetPart", s.ctx, partUUID).Return(current, nil)
+	s.partRepository.On("UpdatePart", s.ctx, mock.MatchedBy(func(p *model.Part) bool {
+		return p.Version == 7
+	})).Return(nil)
+	s.stockService.On("ApplyStockChange", s.ctx, mock.AnythingOfType("*model.StockChange")).
+		Return(&model.StockMovement{StockAfter: 6}, nil)
+	s.stockService.On("CheckStockLevel", s.ctx, partUUID).Return(nil)
+
+	updated, err := s.service.UpdatePart(s.ctx, &model.PartUpdate{
+		Part:   &model.Part{Uuid: partUUID, Version: 7, Name: "Main Engine Mk2", StockQuantity: 6},
+		Fields: []string{model.PartFieldName, model.PartFieldStockQuantity},
+	})
+	s.Require().NoError(err)
+	// Запись карточки и корректирующее движение — два изменения детали
+	s.Require().Equal(int64(9), updated.Version)
+}
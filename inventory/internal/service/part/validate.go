@@ -0,0 +1,112 @@
+package part
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+// validatePart проверяет обязательные поля и допустимые значения детали
+func validatePart(part *model.Part) error {
+	switch {
+	case strings.TrimSpace(part.Name) == "":
+		return fmt.Errorf("%w: name is required", model.ErrInvalidPart)
+	case part.Category == model.CATEGORY_UNSPECIFIED:
+		return fmt.Errorf("%w: category is required", model.ErrInvalidPart)
+	case part.Price <= 0:
+		return fmt.Errorf("%w: price must be positive", model.ErrInvalidPart)
+	case part.StockQuantity < 0:
+		return fmt.Errorf("%w: stock quantity must not be negative", model.ErrInvalidPart)
+	case part.ReorderThreshold < 0:
+		return fmt.Errorf("%w: reorder threshold must not be negative", model.ErrInvalidPart)
+	}
+
+	d := part.Dimensions
+	if d == nil || d.Length <= 0 || d.Width <= 0 || d.Height <= 0 || d.Weight <= 0 {
+		return fmt.Errorf("%w: dimensions and weight must be positive", model.ErrInvalidPart)
+	}
+
+	return nil
+}
+
+// validateMetadata проверяет metadata детали по схеме категории. Ключи, которых нет в схеме, не проверяются
+func validateMetadata(metadata map[string]interface{}, schema []*model.MetadataField) error {
+	for _, field := range schema {
+		value, ok := metadata[field.Key]
+		if !ok || value == nil {
+			if field.Required {
+				return fmt.Errorf("%w: metadata %q is required", model.ErrInvalidPart, field.Key)
+			}
+			continue
+		}
+
+		if err := validateMetadataValue(field, value); err != nil {
+			return fmt.Errorf("%w: metadata %q %s", model.ErrInvalidPart, field.Key, err)
+		}
+	}
+
+	return nil
+}
+
+func validateMetadataValue(field *model.MetadataField, value interface{}) error {
+	var number float64
+	switch field.Type {
+	case model.METADATA_FIELD_TYPE_STRING:
+		if _, ok := value.(string); !ok {
+			return errors.New("must be a string")
+		}
+		return nil
+	case model.METADATA_FIELD_TYPE_BOOL:
+		if _, ok := value.(bool); !ok {
+			return errors.New("must be a boolean")
+		}
+		return nil
+	case model.METADATA_FIELD_TYPE_INTEGER:
+		v, ok := integerValue(value)
+		if !ok {
+			return errors.New("must be an integer")
+		}
+		number = float64(v)
+	case model.METADATA_FIELD_TYPE_NUMBER:
+		// Целое значение тоже число: клиент может прислать 3000 как int64_value
+		f, isFloat := value.(float64)
+		i, isInteger := integerValue(value)
+		switch {
+		case isFloat:
+			number = f
+		case isInteger:
+			number = float64(i)
+		default:
+			return errors.New("must be a number")
+		}
+	default:
+		return nil
+	}
+
+	if r := field.Range; r != nil {
+		if r.Min != nil && number < *r.Min {
+			return fmt.Errorf("must be at least %v", *r.Min)
+		}
+		if r.Max != nil && number > *r.Max {
+			return fmt.Errorf("must be at most %v", *r.Max)
+		}
+	}
+
+	return nil
+}
+
+// integerValue учитывает, что MongoDB возвращает небольшие целые как int32
+func integerValue(value interface{}) (int64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return v, true
+	case int32:
+		return int64(v), true
+	case int:
+		return int64(v), true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,175 @@
+package part
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+const snapshotPageSize = 500
+
+// WatchParts отправляет снимок деталей под фильтром, затем их изменения до отмены ctx.
+// Change stream открывается до чтения снимка, поэтому изменение во время снимка не теряется,
+// а может лишь прийти повторно как UPDATED. Следующее изменение читается из потока только после
+// того, как Send вернул управление: медленный клиент не копит события в памяти сервиса, а отстает
+// в oplog. Если отставание вытеснено из oplog, подписка завершается с ErrResumeTokenExpired
+func (s *service) WatchParts(ctx context.Context, watch *model.PartsWatch) error {
+	if err := validateFilter(watch.Filter); err != nil {
+		return err
+	}
+	// Подкатегории раскрываются один раз: категория, созданная во время подписки, в нее не попадет
+	filter, err := s.expandFilter(ctx, watch.Filter)
+	if err != nil {
+		return err
+	}
+	watch = &model.PartsWatch{Filter: filter, Send: watch.Send}
+
+	stream, err := s.partChangeRepository.WatchParts(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := stream.Close(context.WithoutCancel(ctx)); closeErr != nil {
+			logger.Warn(ctx, "Failed to close part change stream", zap.Error(closeErr))
+		}
+	}()
+
+	// UUID деталей, о которых подписчик знает: по ним решается, CREATED или UPDATED,
+	// и нужно ли сообщать об удалении
+	visible, err := s.sendSnapshot(ctx, watch)
+	if err != nil {
+		return err
+	}
+
+	for {
+		change, err := stream.Next(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		event, err := s.partEvent(ctx, watch.Filter, change, visible)
+		if err != nil {
+			return err
+		}
+		if event == nil {
+			continue
+		}
+
+		if err = watch.Send(event); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *service) sendSnapshot(ctx context.Context, watch *model.PartsWatch) (map[string]struct{}, error) {
+	visible := make(map[string]struct{})
+	query := &model.PartsQuery{
+		Filter:   watch.Filter,
+		PageSize: snapshotPageSize,
+	}
+
+	for {
+		page, err := s.ListParts(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, part := range page.Parts {
+			if err = watch.Send(&model.PartEvent{
+				Type:     model.PART_EVENT_TYPE_SNAPSHOT,
+				PartUuid: part.Uuid,
+				Part:     part,
+			}); err != nil {
+				return nil, err
+			}
+			visible[part.Uuid] = struct{}{}
+		}
+
+		if page.NextPageToken == "" {
+			break
+		}
+		query.PageToken = page.NextPageToken
+	}
+
+	return visible, watch.Send(&model.PartEvent{Type: model.PART_EVENT_TYPE_SNAPSHOT_COMPLETE})
+}
+
+// partEvent переводит изменение в событие подписчика с учетом фильтра, nil — подписчику сообщать нечего
+func (s *service) partEvent(
+	ctx context.Context,
+	filter *model.PartsFilter,
+	change *model.PartChange,
+	visible map[string]struct{},
+) (*model.PartEvent, error) {
+	if change.Type == model.PART_CHANGE_TYPE_UNSPECIFIED {
+		return nil, nil
+	}
+
+	var part *model.Part
+	if change.Type != model.PART_CHANGE_TYPE_DELETED {
+		var err error
+		if part, err = s.matchingPart(ctx, filter, change); err != nil {
+			return nil, err
+		}
+	}
+
+	_, known := visible[change.PartUuid]
+	event := &model.PartEvent{
+		PartUuid:   change.PartUuid,
+		Part:       part,
+		OccurredAt: change.OccurredAt,
+	}
+
+	switch {
+	case part == nil && !known:
+		return nil, nil
+	case part == nil:
+		delete(visible, change.PartUuid)
+		event.Type = model.PART_EVENT_TYPE_DELETED
+	case known:
+		event.Type = model.PART_EVENT_TYPE_UPDATED
+	default:
+		visible[change.PartUuid] = struct{}{}
+		event.Type = model.PART_EVENT_TYPE_CREATED
+	}
+
+	return event, nil
+}
+
+// matchingPart возвращает деталь из изменения, если она подходит под фильтр, иначе nil.
+// Фильтр проверяется запросом к базе, чтобы условия совпадали с ListParts
+func (s *service) matchingPart(ctx context.Context, filter *model.PartsFilter, change *model.PartChange) (*model.Part, error) {
+	if filter == nil {
+		return change.Part, nil
+	}
+	if len(filter.Uuids) > 0 && !slices.Contains(filter.Uuids, change.PartUuid) {
+		return nil, nil
+	}
+
+	partFilter := *filter
+	partFilter.Uuids = []string{change.PartUuid}
+
+	page, err := s.partRepository.ListParts(ctx, &model.PartsQuery{
+		Filter:   &partFilter,
+		PageSize: 1,
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrPartsNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to match part %s: %w", change.PartUuid, err)
+	}
+	if len(page.Parts) == 0 {
+		return nil, nil
+	}
+	return page.Parts[0], nil
+}
@@ -0,0 +1,180 @@
+package part
+
+import (
+	"context"
+	"errors"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+// fakeStream отдает заданные изменения, затем ошибку err или ожидание отмены ctx
+type fakeStream struct {
+	changes []*model.PartChange
+	err     error
+	closed  bool
+}
+
+func (f *fakeStream) Next(ctx context.Context) (*model.PartChange, error) {
+	if len(f.changes) > 0 {
+		change := f.changes[0]
+		f.changes = f.changes[1:]
+		return change, nil
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (f *fakeStream) Close(context.Context) error {
+	f.closed = true
+	return nil
+}
+
+// collectEvents возвращает Send, который копит события и отменяет ctx после limit событий
+func collectEvents(events *[]*model.PartEvent, limit int, cancel context.CancelFunc) func(*model.PartEvent) error {
+	return func(event *model.PartEvent) error {
+		*events = append(*events, event)
+		if len(*events) == limit {
+			cancel()
+		}
+		return nil
+	}
+}
+
+func eventTypes(events []*model.PartEvent) []model.PartEventType {
+	types := make([]model.PartEventType, 0, len(events))
+	for _, event := range events {
+		types = append(types, event.Type)
+	}
+	return types
+}
+
+func (s *ServiceSuite) TestWatchPartsSnapshotThenChanges() {
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	existing := newValidPart()
+	existing.Uuid = gofakeit.UUID()
+	created := newValidPart()
+	created.Uuid = gofakeit.UUID()
+
+	stream := &fakeStream{changes: []*model.PartChange{
+		{Type: model.PART_CHANGE_TYPE_CREATED, PartUuid: created.Uuid, Part: created},
+		{Type: model.PART_CHANGE_TYPE_UPDATED, PartUuid: existing.Uuid, Part: existing},
+		{Type: model.PART_CHANGE_TYPE_DELETED, PartUuid: existing.Uuid},
+	}}
+
+	s.partChangeRepository.On("WatchParts", ctx, []byte(nil)).Return(stream, nil)
+	s.partRepository.On("ListParts", ctx, mock.MatchedBy(func(q *model.PartsQuery) bool {
+		return q.PageSize == snapshotPageSize
+	})).Return(&model.PartsPage{Parts: []*model.Part{existing}}, nil).Once()
+
+	var events []*model.PartEvent
+	err := s.service.WatchParts(ctx, &model.PartsWatch{Send: collectEvents(&events, 5, cancel)})
+	s.Require().NoError(err)
+	s.Require().True(stream.closed)
+
+	s.Require().Equal([]model.PartEventType{
+		model.PART_EVENT_TYPE_SNAPSHOT,
+		model.PART_EVENT_TYPE_SNAPSHOT_COMPLETE,
+		model.PART_EVENT_TYPE_CREATED,
+		model.PART_EVENT_TYPE_UPDATED,
+		model.PART_EVENT_TYPE_DELETED,
+	}, eventTypes(events))
+	s.Require().Equal(created, events[2].Part)
+	s.Require().Nil(events[4].Part)
+}
+
+func (s *ServiceSuite) TestWatchPartsAppliesFilterToChanges() {
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	inStock := newValidPart()
+	inStock.Uuid = gofakeit.UUID()
+	soldOut := newValidPart()
+	soldOut.Uuid = gofakeit.UUID()
+	filter := &model.PartsFilter{InStockOnly: true}
+
+	stream := &fakeStream{changes: []*model.PartChange{
+		// Деталь без остатка подписчику неизвестна и под фильтр не подходит — событие не отправляется
+		{Type: model.PART_CHANGE_TYPE_UPDATED, PartUuid: soldOut.Uuid, Part: soldOut},
+		// Деталь из снимка перестала подходить под фильтр
+		{Type: model.PART_CHANGE_TYPE_UPDATED, PartUuid: inStock.Uuid, Part: inStock},
+	}}
+
+	s.partChangeRepository.On("WatchParts", ctx, []byte(nil)).Return(stream, nil)
+	s.partRepository.On("ListParts", ctx, mock.MatchedBy(func(q *model.PartsQuery) bool {
+		return q.PageSize == snapshotPageSize
+	})).Return(&model.PartsPage{Parts: []*model.Part{inStock}}, nil).Once()
+	s.partRepository.On("ListParts", ctx, mock.MatchedBy(func(q *model.PartsQuery) bool {
+		return q.PageSize == 1 && q.Filter.InStockOnly && len(q.Filter.Uuids) == 1
+	})).Return(&model.PartsPage{Parts: []*model.Part{}}, nil).Twice()
+
+	var events []*model.PartEvent
+	err := s.service.WatchParts(ctx, &model.PartsWatch{Filter: filter, Send: collectEvents(&events, 3, cancel)})
+	s.Require().NoError(err)
+
+	s.Require().Equal([]model.PartEventType{
+		model.PART_EVENT_TYPE_SNAPSHOT,
+		model.PART_EVENT_TYPE_SNAPSHOT_COMPLETE,
+		model.PART_EVENT_TYPE_DELETED,
+	}, eventTypes(events))
+	s.Require().Equal(inStock.Uuid, events[2].PartUuid)
+	// Фильтр подписчика не изменен проверкой отдельных деталей
+	s.Require().Empty(filter.Uuids)
+}
+
+func (s *ServiceSuite) TestWatchPartsSkipsChangesOutsideUUIDFilter() {
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	watched := gofakeit.UUID()
+	stream := &fakeStream{
+		changes: []*model.PartChange{{Type: model.PART_CHANGE_TYPE_CREATED, PartUuid: gofakeit.UUID()}},
+		err:     model.ErrResumeTokenExpired,
+	}
+
+	s.partChangeRepository.On("WatchParts", ctx, []byte(nil)).Return(stream, nil)
+	s.partRepository.On("ListParts", ctx, mock.AnythingOfType("*model.PartsQuery")).
+		Return(&model.PartsPage{Parts: []*model.Part{}}, nil).Once()
+
+	var events []*model.PartEvent
+	err := s.service.WatchParts(ctx, &model.PartsWatch{
+		Filter: &model.PartsFilter{Uuids: []string{watched}},
+		Send:   collectEvents(&events, -1, cancel),
+	})
+	// Отставший подписчик получает ошибку, а не молча пропущенные изменения
+	s.Require().ErrorIs(err, model.ErrResumeTokenExpired)
+	s.Require().Equal([]model.PartEventType{model.PART_EVENT_TYPE_SNAPSHOT_COMPLETE}, eventTypes(events))
+}
+
+func (s *ServiceSuite) TestWatchPartsSendError() {
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	stream := &fakeStream{}
+	sendErr := errors.New("client disconnected")
+
+	s.partChangeRepository.On("WatchParts", ctx, []byte(nil)).Return(stream, nil)
+	s.partRepository.On("ListParts", ctx, mock.AnythingOfType("*model.PartsQuery")).
+		Return(&model.PartsPage{Parts: []*model.Part{}}, nil).Once()
+
+	err := s.service.WatchParts(ctx, &model.PartsWatch{
+		Send: func(*model.PartEvent) error { return sendErr },
+	})
+	s.Require().ErrorIs(err, sendErr)
+	s.Require().True(stream.closed)
+}
+
+func (s *ServiceSuite) TestWatchPartsInvalidFilter() {
+	err := s.service.WatchParts(s.ctx, &model.PartsWatch{
+		Filter: &model.PartsFilter{Metadata: []model.MetadataPredicate{{Key: "$where"}}},
+		Send:   func(*model.PartEvent) error { return nil },
+	})
+	s.Require().ErrorIs(err, model.ErrInvalidFilter)
+}
@@ -0,0 +1,127 @@
+package part_change
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+// PublishChanges публикует изменения, начиная с сохраненного resume token. Токен сохраняется
+// только после успешной публикации, поэтому после ошибки или рестарта изменение публикуется
+// повторно (at-least-once), а не теряется. Если токен вытеснен из oplog, поток открывается
+// с текущего момента и все детали публикуются заново как UPDATED (полная ресинхронизация)
+func (s *service) PublishChanges(ctx context.Context) error {
+	resumeToken, err := s.partChangeRepository.GetResumeToken(ctx, resumeTokenName)
+	if err != nil {
+		return err
+	}
+
+	resync := false
+	for {
+		resumeToken, resync, err = s.publishFrom(ctx, resumeToken, resync)
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if errors.Is(err, model.ErrResumeTokenExpired) {
+			// Изменения после токена уже вытеснены из oplog: пропущенное восполняется ресинхронизацией
+			logger.Error(ctx, "❌ Resume token expired, resyncing all parts", zap.Error(err))
+			resumeToken = nil
+			resync = true
+		} else {
+			logger.Error(ctx, "❌ Part change stream failed, reopening", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(s.retryInterval):
+		}
+	}
+}
+
+// publishFrom читает поток после resumeToken до первой ошибки и возвращает последний сохраненный
+// токен и признак, что ресинхронизация еще нужна. Поток открывается до ресинхронизации, поэтому
+// изменение во время нее не теряется, а может лишь прийти повторно
+func (s *service) publishFrom(ctx context.Context, resumeToken []byte, resync bool) ([]byte, bool, error) {
+	stream, err := s.partChangeRepository.WatchParts(ctx, resumeToken)
+	if err != nil {
+		return resumeToken, resync, err
+	}
+	defer func() {
+		if closeErr := stream.Close(context.WithoutCancel(ctx)); closeErr != nil {
+			logger.Warn(ctx, "Failed to close part change stream", zap.Error(closeErr))
+		}
+	}()
+
+	if resync {
+		if err = s.resyncParts(ctx); err != nil {
+			return resumeToken, true, err
+		}
+	}
+
+	for {
+		change, err := stream.Next(ctx)
+		if err != nil {
+			return resumeToken, false, err
+		}
+
+		if change.Type != model.PART_CHANGE_TYPE_UNSPECIFIED {
+			if err = s.partProducer.PublishPartChange(ctx, change); err != nil {
+				return resumeToken, false, fmt.Errorf("failed to publish part change: %w", err)
+			}
+		}
+
+		if err = s.partChangeRepository.SaveResumeToken(ctx, resumeTokenName, change.ResumeToken); err != nil {
+			return resumeToken, false, err
+		}
+		resumeToken = change.ResumeToken
+	}
+}
+
+// resyncParts публикует все не удаленные детали как UPDATED. Удаления за потерянный участок
+// восстановить нельзя: потребители сверяют их по отсутствию детали в ресинхронизации
+func (s *service) resyncParts(ctx context.Context) error {
+	query := &model.PartsQuery{PageSize: resyncPageSize}
+	published := 0
+
+	for {
+		page, err := s.partRepository.ListParts(ctx, query)
+		if err != nil {
+			if errors.Is(err, model.ErrPartsNotFound) {
+				break
+			}
+			return fmt.Errorf("failed to list parts for resync: %w", err)
+		}
+
+		now := time.Now()
+		for _, part := range page.Parts {
+			// Без ResumeToken продюсер выдает событию новый UUID: повтор ресинхронизации не дедуплицируется
+			err = s.partProducer.PublishPartChange(ctx, &model.PartChange{
+				Type:       model.PART_CHANGE_TYPE_UPDATED,
+				PartUuid:   part.Uuid,
+				Part:       part,
+				OccurredAt: now,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to publish part resync: %w", err)
+			}
+			published++
+		}
+
+		if page.NextPageToken == "" {
+			break
+		}
+		query.PageToken = page.NextPageToken
+	}
+
+	logger.Info(ctx, "🔁 Part changes resynced", zap.Int("parts", published))
+
+	return nil
+}
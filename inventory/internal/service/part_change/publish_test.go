@@ -0,0 +1,95 @@
+package part_change
+
+import (
+	"context"
+	"errors"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestPublishChangesSavesTokenAfterPublish() {
+	partUUID := gofakeit.UUID()
+	created := &model.PartChange{Type: model.PART_CHANGE_TYPE_CREATED, PartUuid: partUUID, ResumeToken: []byte("t1")}
+	noop := &model.PartChange{ResumeToken: []byte("t2")}
+	stream := &fakeStream{changes: []*model.PartChange{created, noop}}
+
+	s.partChangeRepository.On("GetResumeToken", s.ctx, resumeTokenName).Return([]byte("t0"), nil)
+	s.partChangeRepository.On("WatchParts", s.ctx, []byte("t0")).Return(stream, nil)
+	s.partProducer.On("PublishPartChange", s.ctx, created).Return(nil).Once()
+	s.partChangeRepository.On("SaveResumeToken", s.ctx, resumeTokenName, []byte("t1")).Return(nil).Once()
+	// Служебное событие не публикуется, но позиция потока сдвигается
+	s.partChangeRepository.On("SaveResumeToken", s.ctx, resumeTokenName, []byte("t2")).
+		Run(func(mock.Arguments) { s.cancel() }).
+		Return(nil).Once()
+
+	s.Require().NoError(s.service.PublishChanges(s.ctx))
+	s.Require().True(stream.closed)
+}
+
+func (s *ServiceSuite) TestPublishChangesRetriesFromLastSavedToken() {
+	partUUID := gofakeit.UUID()
+	updated := &model.PartChange{Type: model.PART_CHANGE_TYPE_UPDATED, PartUuid: partUUID, ResumeToken: []byte("t1")}
+
+	s.partChangeRepository.On("GetResumeToken", s.ctx, resumeTokenName).Return(nil, nil)
+	s.partChangeRepository.On("WatchParts", s.ctx, []byte(nil)).
+		Return(&fakeStream{changes: []*model.PartChange{updated}}, nil).Once()
+	// Kafka недоступна: токен не сохраняется, поток переоткрывается с прежней позиции
+	s.partProducer.On("PublishPartChange", s.ctx, updated).Return(errors.New("kafka unavailable")).Once()
+	s.partChangeRepository.On("WatchParts", s.ctx, []byte(nil)).
+		Return(&fakeStream{changes: []*model.PartChange{updated}}, nil).Once()
+	s.partProducer.On("PublishPartChange", s.ctx, updated).Return(nil).Once()
+	s.partChangeRepository.On("SaveResumeToken", s.ctx, resumeTokenName, []byte("t1")).
+		Run(func(mock.Arguments) { s.cancel() }).
+		Return(nil).Once()
+
+	s.Require().NoError(s.service.PublishChanges(s.ctx))
+}
+
+func (s *ServiceSuite) TestPublishChangesResyncsAfterExpiredToken() {
+	first := &model.Part{Uuid: gofakeit.UUID()}
+	second := &model.Part{Uuid: gofakeit.UUID()}
+
+	s.partChangeRepository.On("GetResumeToken", s.ctx, resumeTokenName).Return([]byte("old"), nil)
+	s.partChangeRepository.On("WatchParts", s.ctx, []byte("old")).Return(nil, model.ErrResumeTokenExpired).Once()
+	s.partChangeRepository.On("WatchParts", s.ctx, []byte(nil)).Return(&fakeStream{}, nil).Once()
+	s.partRepository.On("ListParts", s.ctx, mock.MatchedBy(func(q *model.PartsQuery) bool { return q.PageToken == "" })).
+		Return(&model.PartsPage{Parts: []*model.Part{first}, NextPageToken: "p2"}, nil).Once()
+	s.partRepository.On("ListParts", s.ctx, mock.MatchedBy(func(q *model.PartsQuery) bool { return q.PageToken == "p2" })).
+		Return(&model.PartsPage{Parts: []*model.Part{second}}, nil).Once()
+	s.partProducer.On("PublishPartChange", s.ctx, mock.MatchedBy(func(c *model.PartChange) bool {
+		return c.Type == model.PART_CHANGE_TYPE_UPDATED && c.PartUuid == first.Uuid && c.ResumeToken == nil
+	})).Return(nil).Once()
+	// Последняя деталь ресинхронизации опубликована — дальше поток ждет изменений
+	s.partProducer.On("PublishPartChange", s.ctx, mock.MatchedBy(func(c *model.PartChange) bool {
+		return c.Type == model.PART_CHANGE_TYPE_UPDATED && c.PartUuid == second.Uuid
+	})).Run(func(mock.Arguments) { s.cancel() }).Return(nil).Once()
+
+	s.Require().NoError(s.service.PublishChanges(s.ctx))
+}
+
+func (s *ServiceSuite) TestPublishChangesRetriesFailedResync() {
+	part := &model.Part{Uuid: gofakeit.UUID()}
+
+	s.partChangeRepository.On("GetResumeToken", s.ctx, resumeTokenName).Return([]byte("old"), nil)
+	s.partChangeRepository.On("WatchParts", s.ctx, []byte("old")).Return(nil, model.ErrResumeTokenExpired).Once()
+	s.partChangeRepository.On("WatchParts", s.ctx, []byte(nil)).Return(&fakeStream{}, nil).Twice()
+	s.partRepository.On("ListParts", s.ctx, mock.AnythingOfType("*model.PartsQuery")).
+		Return(&model.PartsPage{Parts: []*model.Part{part}}, nil).Twice()
+	// Kafka недоступна: ресинхронизация повторяется после переоткрытия потока
+	s.partProducer.On("PublishPartChange", s.ctx, mock.AnythingOfType("*model.PartChange")).
+		Return(errors.New("kafka unavailable")).Once()
+	s.partProducer.On("PublishPartChange", s.ctx, mock.AnythingOfType("*model.PartChange")).
+		Run(func(mock.Arguments) { s.cancel() }).Return(nil).Once()
+
+	s.Require().NoError(s.service.PublishChanges(s.ctx))
+}
+
+func (s *ServiceSuite) TestPublishChangesTokenLookupError() {
+	lookupErr := errors.New("mongo unavailable")
+	s.partChangeRepository.On("GetResumeToken", mock.Anything, resumeTokenName).Return(nil, lookupErr)
+
+	s.Require().ErrorIs(s.service.PublishChanges(context.Background()), lookupErr)
+}
@@ -0,0 +1,38 @@
+package part_change
+
+import (
+	"time"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository"
+	def "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/service"
+)
+
+var _ def.PartChangeService = (*service)(nil)
+
+// resumeTokenName - имя позиции потока деталей в хранилище resume token
+const resumeTokenName = "parts"
+
+// resyncPageSize - размер страницы деталей при полной ресинхронизации
+const resyncPageSize = 500
+
+type service struct {
+	partChangeRepository repository.PartChangeRepository
+	partRepository       repository.PartRepository
+	partProducer         def.PartProducerService
+	// Пауза перед переоткрытием потока после ошибки
+	retryInterval time.Duration
+}
+
+func NewService(
+	partChangeRepository repository.PartChangeRepository,
+	partRepository repository.PartRepository,
+	partProducer def.PartProducerService,
+	retryInterval time.Duration,
+) *service {
+	return &service{
+		partChangeRepository: partChangeRepository,
+		partRepository:       partRepository,
+		partProducer:         partProducer,
+		retryInterval:        retryInterval,
+	}
+}
@@ -0,0 +1,71 @@
+package part_change
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/mocks"
+	serviceMocks "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/service/mocks"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	ctx                  context.Context
+	cancel               context.CancelFunc
+	partChangeRepository *mocks.PartChangeRepository
+	partRepository       *mocks.PartRepository
+	partProducer         *serviceMocks.PartProducerService
+	service              *service
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+
+	s.partChangeRepository = mocks.NewPartChangeRepository(s.T())
+	s.partRepository = mocks.NewPartRepository(s.T())
+	s.partProducer = serviceMocks.NewPartProducerService(s.T())
+
+	s.service = NewService(
+		s.partChangeRepository,
+		s.partRepository,
+		s.partProducer,
+		time.Millisecond,
+	)
+}
+
+func (s *ServiceSuite) TearDownTest() {
+	s.cancel()
+}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
+
+// fakeStream отдает заданные изменения, затем ошибку err или ожидание отмены ctx
+type fakeStream struct {
+	changes []*model.PartChange
+	err     error
+	closed  bool
+}
+
+func (f *fakeStream) Next(ctx context.Context) (*model.PartChange, error) {
+	if len(f.changes) > 0 {
+		change := f.changes[0]
+		f.changes = f.changes[1:]
+		return change, nil
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (f *fakeStream) Close(context.Context) error {
+	f.closed = true
+	return nil
+}
@@ -0,0 +1,57 @@
+package price
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+// ApplyDue записывает в карточку каждой детали с наступившими ценами цену, действующую на now.
+// Берется последняя по effective_from запись, поэтому ручное изменение цены после
+// запланированной даты не перезаписывается. Ошибка по одной детали не останавливает остальные
+func (s *service) ApplyDue(ctx context.Context, now time.Time) (int, error) {
+	partUUIDs, err := s.priceRepository.ListDuePartUUIDs(ctx, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list due prices: %w", err)
+	}
+	if len(partUUIDs) == 0 {
+		return 0, nil
+	}
+
+	effective, err := s.priceRepository.GetEffectivePrices(ctx, partUUIDs, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get effective prices: %w", err)
+	}
+
+	applied := 0
+	for _, partUUID := range partUUIDs {
+		change, ok := effective[partUUID]
+		if !ok {
+			continue
+		}
+
+		if err = s.priceRepository.SetPartPrice(ctx, partUUID, change.Price, now); err != nil {
+			logger.Error(ctx, "Failed to apply scheduled price",
+				zap.String("part_uuid", partUUID),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		if err = s.priceRepository.MarkApplied(ctx, partUUID, now); err != nil {
+			logger.Error(ctx, "Failed to mark scheduled price applied",
+				zap.String("part_uuid", partUUID),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		applied++
+	}
+
+	return applied, nil
+}
@@ -0,0 +1,57 @@
+package price
+
+import (
+	"errors"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestApplyDueSetsEffectivePrice() {
+	partUUID := gofakeit.UUID()
+	now := time.Now()
+
+	s.priceRepository.On("ListDuePartUUIDs", s.ctx, now).Return([]string{partUUID}, nil)
+	s.priceRepository.On("GetEffectivePrices", s.ctx, []string{partUUID}, now).
+		Return(map[string]*model.PriceChange{
+			partUUID: {PartUuid: partUUID, Price: 1800000.00, EffectiveFrom: now.Add(-time.Minute)},
+		}, nil)
+	s.priceRepository.On("SetPartPrice", s.ctx, partUUID, 1800000.00, now).Return(nil)
+	s.priceRepository.On("MarkApplied", s.ctx, partUUID, now).Return(nil)
+
+	applied, err := s.service.ApplyDue(s.ctx, now)
+	s.Require().NoError(err)
+	s.Require().Equal(1, applied)
+}
+
+func (s *ServiceSuite) TestApplyDueNothingDue() {
+	now := time.Now()
+
+	s.priceRepository.On("ListDuePartUUIDs", s.ctx, now).Return([]string{}, nil)
+
+	applied, err := s.service.ApplyDue(s.ctx, now)
+	s.Require().NoError(err)
+	s.Require().Zero(applied)
+}
+
+func (s *ServiceSuite) TestApplyDueSkipsFailedPart() {
+	failed := gofakeit.UUID()
+	succeeded := gofakeit.UUID()
+	now := time.Now()
+
+	s.priceRepository.On("ListDuePartUUIDs", s.ctx, now).Return([]string{failed, succeeded}, nil)
+	s.priceRepository.On("GetEffectivePrices", s.ctx, []string{failed, succeeded}, now).
+		Return(map[string]*model.PriceChange{
+			failed:    {PartUuid: failed, Price: 100},
+			succeeded: {PartUuid: succeeded, Price: 200},
+		}, nil)
+	s.priceRepository.On("SetPartPrice", s.ctx, failed, 100.0, now).Return(errors.New("write conflict"))
+	s.priceRepository.On("SetPartPrice", s.ctx, succeeded, 200.0, now).Return(nil)
+	s.priceRepository.On("MarkApplied", s.ctx, succeeded, now).Return(nil)
+
+	applied, err := s.service.ApplyDue(s.ctx, now)
+	s.Require().NoError(err)
+	s.Require().Equal(1, applied)
+}
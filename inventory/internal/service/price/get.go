@@ -0,0 +1,68 @@
+package price
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+// GetPrices берет цену из истории, а для деталей без истории на момент at — из карточки детали.
+// Деталь, которой нет в каталоге, дает ErrPartNotFound
+func (s *service) GetPrices(ctx context.Context, partUUIDs []string, at time.Time) ([]*model.PartPrice, error) {
+	if len(partUUIDs) == 0 {
+		return []*model.PartPrice{}, nil
+	}
+
+	uniqueUUIDs := unique(partUUIDs)
+
+	page, err := s.partRepository.ListParts(ctx, &model.PartsQuery{
+		Filter:   &model.PartsFilter{Uuids: uniqueUUIDs},
+		PageSize: int32(len(uniqueUUIDs)), //nolint:gosec // количество деталей в запросе невелико
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parts: %w", err)
+	}
+
+	parts := make(map[string]*model.Part, len(page.Parts))
+	for _, part := range page.Parts {
+		parts[part.Uuid] = part
+	}
+
+	effective, err := s.priceRepository.GetEffectivePrices(ctx, uniqueUUIDs, at)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get effective prices: %w", err)
+	}
+
+	prices := make([]*model.PartPrice, 0, len(partUUIDs))
+	for _, partUUID := range partUUIDs {
+		part, ok := parts[partUUID]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", model.ErrPartNotFound, partUUID)
+		}
+
+		price := &model.PartPrice{PartUuid: partUUID, Price: part.Price}
+		if change, ok := effective[partUUID]; ok {
+			effectiveFrom := change.EffectiveFrom
+			price.Price = change.Price
+			price.EffectiveFrom = &effectiveFrom
+		}
+		prices = append(prices, price)
+	}
+
+	return prices, nil
+}
+
+func unique(values []string) []string {
+	seen := make(map[string]struct{}, len(values))
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if _, ok := seen[value]; ok {
+			continue
+		}
+		seen[value] = struct{}{}
+		result = append(result, value)
+	}
+	return result
+}
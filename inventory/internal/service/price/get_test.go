@@ -0,0 +1,51 @@
+package price
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestGetPricesUsesHistoryAndFallback() {
+	withHistory := gofakeit.UUID()
+	withoutHistory := gofakeit.UUID()
+	at := time.Now()
+	effectiveFrom := at.Add(-24 * time.Hour)
+
+	s.partRepository.On("ListParts", s.ctx, &model.PartsQuery{
+		Filter:   &model.PartsFilter{Uuids: []string{withHistory, withoutHistory}},
+		PageSize: 2,
+	}).Return(&model.PartsPage{Parts: []*model.Part{
+		{Uuid: withHistory, Price: 100},
+		{Uuid: withoutHistory, Price: 200},
+	}}, nil)
+	s.priceRepository.On("GetEffectivePrices", s.ctx, []string{withHistory, withoutHistory}, at).
+		Return(map[string]*model.PriceChange{
+			withHistory: {PartUuid: withHistory, Price: 150, EffectiveFrom: effectiveFrom},
+		}, nil)
+
+	prices, err := s.service.GetPrices(s.ctx, []string{withHistory, withoutHistory, withHistory}, at)
+	s.Require().NoError(err)
+	s.Require().Len(prices, 3)
+	s.Require().Equal(150.0, prices[0].Price)
+	s.Require().Equal(effectiveFrom, *prices[0].EffectiveFrom)
+	s.Require().Equal(200.0, prices[1].Price)
+	s.Require().Nil(prices[1].EffectiveFrom)
+	s.Require().Equal(prices[0], prices[2])
+}
+
+func (s *ServiceSuite) TestGetPricesPartNotFound() {
+	partUUID := gofakeit.UUID()
+
+	s.partRepository.On("ListParts", s.ctx, mock.AnythingOfType("*model.PartsQuery")).
+		Return(&model.PartsPage{}, nil)
+	s.priceRepository.On("GetEffectivePrices", s.ctx, []string{partUUID}, mock.AnythingOfType("time.Time")).
+		Return(map[string]*model.PriceChange{}, nil)
+
+	prices, err := s.service.GetPrices(s.ctx, []string{partUUID}, time.Now())
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+	s.Require().Nil(prices)
+}
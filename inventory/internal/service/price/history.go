@@ -0,0 +1,25 @@
+package price
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *service) ListHistory(ctx context.Context, partUUID string) ([]*model.PriceChange, error) {
+	if _, err := s.partRepository.GetPart(ctx, partUUID); err != nil {
+		if errors.Is(err, model.ErrPartNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get part: %w", err)
+	}
+
+	changes, err := s.priceRepository.ListPriceChanges(ctx, partUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list price history: %w", err)
+	}
+
+	return changes, nil
+}
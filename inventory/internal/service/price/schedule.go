@@ -0,0 +1,59 @@
+package price
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *service) SchedulePrice(ctx context.Context, change *model.PriceChange) (*model.PriceChange, error) {
+	now := time.Now()
+
+	switch {
+	case change.Price <= 0:
+		return nil, fmt.Errorf("%w: price must be positive", model.ErrInvalidPriceChange)
+	case !change.EffectiveFrom.After(now):
+		return nil, fmt.Errorf("%w: effective_from must be in the future", model.ErrInvalidPriceChange)
+	}
+
+	if _, err := s.partRepository.GetPart(ctx, change.PartUuid); err != nil {
+		if errors.Is(err, model.ErrPartNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get part: %w", err)
+	}
+
+	change.Uuid = uuid.NewString()
+	change.Applied = false
+	change.CreatedAt = now
+
+	if err := s.priceRepository.AddPriceChange(ctx, change); err != nil {
+		return nil, fmt.Errorf("failed to schedule price: %w", err)
+	}
+
+	return change, nil
+}
+
+func (s *service) RecordPrice(ctx context.Context, partUUID string, price float64, reason string) error {
+	now := time.Now()
+
+	err := s.priceRepository.AddPriceChange(ctx, &model.PriceChange{
+		Uuid:          uuid.NewString(),
+		PartUuid:      partUUID,
+		Price:         price,
+		EffectiveFrom: now,
+		Reason:        reason,
+		Applied:       true,
+		CreatedAt:     now,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record price: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package price
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestSchedulePriceSuccess() {
+	partUUID := gofakeit.UUID()
+	effectiveFrom := time.Now().Add(30 * 24 * time.Hour)
+
+	s.partRepository.On("GetPart", s.ctx, partUUID).Return(&model.Part{Uuid: partUUID}, nil)
+	s.priceRepository.On("AddPriceChange", s.ctx, mock.MatchedBy(func(c *model.PriceChange) bool {
+		return c.PartUuid == partUUID && c.Price == 1800000.00 && !c.Applied
+	})).Return(nil)
+
+	change, err := s.service.SchedulePrice(s.ctx, &model.PriceChange{
+		PartUuid:      partUUID,
+		Price:         1800000.00,
+		EffectiveFrom: effectiveFrom,
+		Reason:        "supplier price increase",
+	})
+	s.Require().NoError(err)
+	s.Require().NotEmpty(change.Uuid)
+	s.Require().False(change.CreatedAt.IsZero())
+	s.Require().Equal(effectiveFrom, change.EffectiveFrom)
+}
+
+func (s *ServiceSuite) TestSchedulePriceInPast() {
+	change, err := s.service.SchedulePrice(s.ctx, &model.PriceChange{
+		PartUuid:      gofakeit.UUID(),
+		Price:         1800000.00,
+		EffectiveFrom: time.Now().Add(-time.Hour),
+	})
+	s.Require().ErrorIs(err, model.ErrInvalidPriceChange)
+	s.Require().Nil(change)
+}
+
+func (s *ServiceSuite) TestSchedulePriceNotPositive() {
+	change, err := s.service.SchedulePrice(s.ctx, &model.PriceChange{
+		PartUuid:      gofakeit.UUID(),
+		EffectiveFrom: time.Now().Add(time.Hour),
+	})
+	s.Require().ErrorIs(err, model.ErrInvalidPriceChange)
+	s.Require().Nil(change)
+}
+
+func (s *ServiceSuite) TestSchedulePricePartNotFound() {
+	partUUID := gofakeit.UUID()
+
+	s.partRepository.On("GetPart", s.ctx, partUUID).Return(nil, model.ErrPartNotFound)
+
+	change, err := s.service.SchedulePrice(s.ctx, &model.PriceChange{
+		PartUuid:      partUUID,
+		Price:         1800000.00,
+		EffectiveFrom: time.Now().Add(time.Hour),
+	})
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+	s.Require().Nil(change)
+}
+
+func (s *ServiceSuite) TestRecordPriceIsApplied() {
+	partUUID := gofakeit.UUID()
+
+	s.priceRepository.On("AddPriceChange", s.ctx, mock.MatchedBy(func(c *model.PriceChange) bool {
+		return c.PartUuid == partUUID && c.Price == 1500000.00 && c.Applied && !c.EffectiveFrom.After(time.Now())
+	})).Return(nil)
+
+	err := s.service.RecordPrice(s.ctx, partUUID, 1500000.00, "initial price")
+	s.Require().NoError(err)
+}
@@ -0,0 +1,20 @@
+package price
+
+import (
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository"
+	def "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/service"
+)
+
+var _ def.PriceService = (*service)(nil)
+
+type service struct {
+	priceRepository repository.PriceRepository
+	partRepository  repository.PartRepository
+}
+
+func NewService(priceRepository repository.PriceRepository, partRepository repository.PartRepository) *service {
+	return &service{
+		priceRepository: priceRepository,
+		partRepository:  partRepository,
+	}
+}
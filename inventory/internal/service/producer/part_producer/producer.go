@@ -0,0 +1,65 @@
+package part_producer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	def "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/service"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/kafka"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+var _ def.PartProducerService = (*service)(nil)
+
+// eventNamespace - пространство имен UUID событий. UUID выводится из resume token,
+// поэтому повторная публикация того же изменения после рестарта дает тот же event_uuid
+var eventNamespace = uuid.MustParse("5f0c7a52-3d1e-4b8a-9c61-7e2d4f8a1b30")
+
+type service struct {
+	partChangesProducer kafka.Producer
+}
+
+func NewService(partChangesProducer kafka.Producer) *service {
+	return &service{
+		partChangesProducer: partChangesProducer,
+	}
+}
+
+func (s *service) PublishPartChange(ctx context.Context, change *model.PartChange) error {
+	// UUID выводится из позиции в потоке, поэтому повторная публикация того же изменения
+	// несет тот же UUID. У изменений ресинхронизации позиции нет
+	eventUUID := uuid.NewString()
+	if change.ResumeToken != nil {
+		eventUUID = uuid.NewSHA1(eventNamespace, change.ResumeToken).String()
+	}
+
+	event := converter.PartChangeToProto(eventUUID, change)
+	if event == nil {
+		return nil
+	}
+
+	payload, err := proto.Marshal(event)
+	if err != nil {
+		logger.Error(ctx, "Failed to marshal part change event", zap.Error(err))
+		return err
+	}
+
+	err = s.partChangesProducer.Send(ctx, []byte(change.PartUuid), payload)
+	if err != nil {
+		logger.Error(ctx, "Failed to publish part change event", zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "📤 Part change event published",
+		zap.String("event_uuid", eventUUID),
+		zap.String("part_uuid", change.PartUuid),
+		zap.Int32("type", int32(change.Type)),
+	)
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package stock_producer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	def "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/service"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+var _ def.StockProducerService = (*disabledService)(nil)
+
+// disabledService используется, когда Kafka не настроена: события только логируются
+type disabledService struct{}
+
+func NewDisabledService() *disabledService {
+	return &disabledService{}
+}
+
+func (s *disabledService) PublishPartStockLow(ctx context.Context, part *model.Part) error {
+	logger.Warn(ctx, "Kafka is not configured, PartStockLow event skipped",
+		zap.String("part_uuid", part.Uuid),
+		zap.Int64("stock_quantity", part.StockQuantity),
+	)
+	return nil
+}
+
+func (s *disabledService) PublishPartRestocked(ctx context.Context, part *model.Part) error {
+	logger.Warn(ctx, "Kafka is not configured, PartRestocked event skipped",
+		zap.String("part_uuid", part.Uuid),
+		zap.Int64("stock_quantity", part.StockQuantity),
+	)
+	return nil
+}
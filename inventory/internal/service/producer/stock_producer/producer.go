@@ -0,0 +1,80 @@
+package stock_producer
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	def "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/service"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/kafka"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+var _ def.StockProducerService = (*service)(nil)
+
+type service struct {
+	stockLowProducer  kafka.Producer
+	restockedProducer kafka.Producer
+}
+
+func NewService(stockLowProducer, restockedProducer kafka.Producer) *service {
+	return &service{
+		stockLowProducer:  stockLowProducer,
+		restockedProducer: restockedProducer,
+	}
+}
+
+func (s *service) PublishPartStockLow(ctx context.Context, part *model.Part) error {
+	eventUUID := uuid.NewString()
+
+	payload, err := proto.Marshal(converter.PartStockLowToProto(eventUUID, part, time.Now()))
+	if err != nil {
+		logger.Error(ctx, "Failed to marshal PartStockLow event", zap.Error(err))
+		return err
+	}
+
+	err = s.stockLowProducer.Send(ctx, []byte(part.Uuid), payload)
+	if err != nil {
+		logger.Error(ctx, "Failed to publish PartStockLow event", zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "📤 PartStockLow event published",
+		zap.String("event_uuid", eventUUID),
+		zap.String("part_uuid", part.Uuid),
+		zap.Int64("stock_quantity", part.StockQuantity),
+		zap.Int64("reorder_threshold", part.ReorderThreshold),
+	)
+
+	return nil
+}
+
+func (s *service) PublishPartRestocked(ctx context.Context, part *model.Part) error {
+	eventUUID := uuid.NewString()
+
+	payload, err := proto.Marshal(converter.PartRestockedToProto(eventUUID, part, time.Now()))
+	if err != nil {
+		logger.Error(ctx, "Failed to marshal PartRestocked event", zap.Error(err))
+		return err
+	}
+
+	err = s.restockedProducer.Send(ctx, []byte(part.Uuid), payload)
+	if err != nil {
+		logger.Error(ctx, "Failed to publish PartRestocked event", zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "📤 PartRestocked event published",
+		zap.String("event_uuid", eventUUID),
+		zap.String("part_uuid", part.Uuid),
+		zap.Int64("stock_quantity", part.StockQuantity),
+		zap.Int64("reorder_threshold", part.ReorderThreshold),
+	)
+
+	return nil
+}
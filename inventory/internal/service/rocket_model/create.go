@@ -0,0 +1,67 @@
+package rocket_model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *service) CreateModel(ctx context.Context, rocketModel *model.RocketModel) (*model.RocketModel, error) {
+	if err := validateModel(rocketModel); err != nil {
+		return nil, err
+	}
+
+	uuids := referencedUUIDs([]*model.RocketModel{rocketModel})
+	parts, err := s.getParts(ctx, uuids)
+	if err != nil {
+		return nil, err
+	}
+	if missing := missingUUIDs(uuids, parts); len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", model.ErrPartNotFound, strings.Join(missing, ", "))
+	}
+
+	rocketModel.Uuid = uuid.NewString()
+	rocketModel.CreatedAt = time.Now()
+
+	if err = s.rocketModelRepository.CreateModel(ctx, rocketModel); err != nil {
+		return nil, err
+	}
+
+	return rocketModel, nil
+}
+
+func validateModel(rocketModel *model.RocketModel) error {
+	if strings.TrimSpace(rocketModel.Name) == "" {
+		return fmt.Errorf("%w: name is required", model.ErrInvalidRocketModel)
+	}
+	if len(rocketModel.Items) == 0 {
+		return fmt.Errorf("%w: at least one item is required", model.ErrInvalidRocketModel)
+	}
+
+	seen := make(map[string]struct{}, len(rocketModel.Items))
+	for _, item := range rocketModel.Items {
+		if item.PartUuid == "" {
+			return fmt.Errorf("%w: item part uuid is required", model.ErrInvalidRocketModel)
+		}
+		if item.Quantity <= 0 {
+			return fmt.Errorf("%w: item quantity must be positive", model.ErrInvalidRocketModel)
+		}
+		if _, ok := seen[item.PartUuid]; ok {
+			return fmt.Errorf("%w: part %s is listed twice", model.ErrInvalidRocketModel, item.PartUuid)
+		}
+		seen[item.PartUuid] = struct{}{}
+
+		for _, alternative := range item.Alternatives {
+			if alternative == "" || alternative == item.PartUuid {
+				return fmt.Errorf("%w: invalid alternative for part %s", model.ErrInvalidRocketModel, item.PartUuid)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package rocket_model
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestCreateModelSuccess() {
+	rocketModel := &model.RocketModel{
+		Name:  "Falcon",
+		Items: []model.BomItem{{PartUuid: gridFins.Uuid, Quantity: 4, Alternatives: []string{wing.Uuid}}},
+	}
+
+	s.partRepository.On("ListParts", s.ctx, mock.AnythingOfType("*model.PartsQuery")).
+		Return(&model.PartsPage{Parts: []*model.Part{gridFins, wing}}, nil)
+	s.rocketModelRepository.On("CreateModel", s.ctx, rocketModel).Return(nil)
+
+	created, err := s.service.CreateModel(s.ctx, rocketModel)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(created.Uuid)
+	s.Require().False(created.CreatedAt.IsZero())
+}
+
+func (s *ServiceSuite) TestCreateModelMissingPart() {
+	rocketModel := &model.RocketModel{
+		Name:  "Falcon",
+		Items: []model.BomItem{{PartUuid: gridFins.Uuid, Quantity: 4, Alternatives: []string{wing.Uuid}}},
+	}
+
+	s.partRepository.On("ListParts", s.ctx, mock.AnythingOfType("*model.PartsQuery")).
+		Return(&model.PartsPage{Parts: []*model.Part{gridFins}}, nil)
+
+	_, err := s.service.CreateModel(s.ctx, rocketModel)
+	s.Require().ErrorIs(err, model.ErrPartNotFound)
+	s.Require().ErrorContains(err, wing.Uuid)
+}
+
+func (s *ServiceSuite) TestCreateModelInvalid() {
+	tests := []struct {
+		name  string
+		model *model.RocketModel
+	}{
+		{
+			name:  "empty name",
+			model: &model.RocketModel{Items: []model.BomItem{{PartUuid: merlin.Uuid, Quantity: 1}}},
+		},
+		{
+			name:  "no items",
+			model: &model.RocketModel{Name: "Falcon"},
+		},
+		{
+			name:  "zero quantity",
+			model: &model.RocketModel{Name: "Falcon", Items: []model.BomItem{{PartUuid: merlin.Uuid}}},
+		},
+		{
+			name: "duplicate part",
+			model: &model.RocketModel{Name: "Falcon", Items: []model.BomItem{
+				{PartUuid: merlin.Uuid, Quantity: 1},
+				{PartUuid: merlin.Uuid, Quantity: 2},
+			}},
+		},
+		{
+			name: "alternative equals primary",
+			model: &model.RocketModel{Name: "Falcon", Items: []model.BomItem{
+				{PartUuid: merlin.Uuid, Quantity: 1, Alternatives: []string{merlin.Uuid}},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			_, err := s.service.CreateModel(s.ctx, tt.model)
+			s.Require().ErrorIs(err, model.ErrInvalidRocketModel)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestListModels() {
+	s.rocketModelRepository.On("ListModels", s.ctx).Return([]*model.RocketModel{falcon}, nil)
+	s.partRepository.On("ListParts", s.ctx, mock.MatchedBy(func(query *model.PartsQuery) bool {
+		return len(query.Filter.Uuids) == 3
+	})).Return(&model.PartsPage{Parts: []*model.Part{merlin, gridFins}}, nil)
+
+	summaries, err := s.service.ListModels(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Len(summaries, 1)
+	s.Require().InDelta(980, summaries[0].Price, 0.001)
+	s.Require().True(summaries[0].Available)
+}
@@ -0,0 +1,128 @@
+package rocket_model
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *service) ExpandModel(ctx context.Context, uuid string, quantity int64) (*model.RocketModelExpansion, error) {
+	switch {
+	case quantity < 0:
+		return nil, fmt.Errorf("%w: quantity must be positive", model.ErrInvalidRocketModel)
+	case quantity == 0:
+		quantity = 1
+	}
+
+	rocketModel, err := s.rocketModelRepository.GetModel(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	parts, err := s.getParts(ctx, referencedUUIDs([]*model.RocketModel{rocketModel}))
+	if err != nil {
+		return nil, err
+	}
+
+	expansion := expand(rocketModel, parts, quantity)
+	if len(expansion.MissingParts) > 0 {
+		return nil, fmt.Errorf("%w: %s", model.ErrRocketModelIncomplete, strings.Join(expansion.MissingParts, ", "))
+	}
+
+	return expansion, nil
+}
+
+// expand выбирает для каждой строки спецификации деталь: основную или первую альтернативу,
+// остатка которой хватает. Если не хватает ни одной, строка остается на основной детали
+// и помечается недоступной. Остаток, занятый предыдущими строками, учитывается
+func expand(rocketModel *model.RocketModel, parts map[string]*model.Part, quantity int64) *model.RocketModelExpansion {
+	expansion := &model.RocketModelExpansion{Model: rocketModel}
+	reserved := make(map[string]int64)
+
+	for _, item := range rocketModel.Items {
+		needed := item.Quantity * quantity
+
+		var fallback, chosen *model.Part
+		for _, id := range item.PartUuids() {
+			part, ok := parts[id]
+			if !ok {
+				continue
+			}
+			if fallback == nil {
+				fallback = part
+			}
+			if part.StockQuantity-reserved[id] >= needed {
+				chosen = part
+				break
+			}
+		}
+
+		if fallback == nil {
+			expansion.MissingParts = append(expansion.MissingParts, item.PartUuid)
+			continue
+		}
+
+		line := &model.BomLine{Part: fallback, Quantity: needed}
+		if chosen != nil {
+			line.Part = chosen
+			line.Available = true
+			reserved[chosen.Uuid] += needed
+		}
+		line.Alternative = line.Part.Uuid != item.PartUuid
+
+		expansion.Lines = append(expansion.Lines, line)
+	}
+
+	return expansion
+}
+
+// getParts читает детали каталога по UUID одним запросом
+func (s *service) getParts(ctx context.Context, uuids []string) (map[string]*model.Part, error) {
+	if len(uuids) == 0 {
+		return map[string]*model.Part{}, nil
+	}
+
+	page, err := s.partRepository.ListParts(ctx, &model.PartsQuery{
+		Filter:   &model.PartsFilter{Uuids: uuids},
+		PageSize: int32(len(uuids)), //nolint:gosec // количество деталей в спецификациях невелико
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parts: %w", err)
+	}
+
+	parts := make(map[string]*model.Part, len(page.Parts))
+	for _, part := range page.Parts {
+		parts[part.Uuid] = part
+	}
+	return parts, nil
+}
+
+// referencedUUIDs возвращает без повторов все детали спецификаций, включая альтернативы
+func referencedUUIDs(rocketModels []*model.RocketModel) []string {
+	seen := make(map[string]struct{})
+	var result []string
+	for _, rocketModel := range rocketModels {
+		for _, item := range rocketModel.Items {
+			for _, id := range item.PartUuids() {
+				if _, ok := seen[id]; ok {
+					continue
+				}
+				seen[id] = struct{}{}
+				result = append(result, id)
+			}
+		}
+	}
+	return result
+}
+
+func missingUUIDs(uuids []string, parts map[string]*model.Part) []string {
+	var missing []string
+	for _, id := range uuids {
+		if _, ok := parts[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+	return missing
+}
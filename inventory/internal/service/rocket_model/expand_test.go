@@ -0,0 +1,145 @@
+package rocket_model
+
+import (
+	"errors"
+
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+var (
+	merlin   = &model.Part{Uuid: "merlin", Name: "Merlin", Price: 100, StockQuantity: 10}
+	gridFins = &model.Part{Uuid: "grid-fins", Name: "Grid Fins", Price: 20, StockQuantity: 4}
+	wing     = &model.Part{Uuid: "wing", Name: "Wing", Price: 30, StockQuantity: 8}
+
+	falcon = &model.RocketModel{
+		Uuid: "falcon",
+		Name: "Falcon",
+		Items: []model.BomItem{
+			{PartUuid: merlin.Uuid, Quantity: 9},
+			{PartUuid: gridFins.Uuid, Quantity: 4, Alternatives: []string{wing.Uuid}},
+		},
+	}
+)
+
+func (s *ServiceSuite) TestExpand() {
+	parts := map[string]*model.Part{merlin.Uuid: merlin, gridFins.Uuid: gridFins, wing.Uuid: wing}
+
+	tests := []struct {
+		name         string
+		quantity     int64
+		parts        map[string]*model.Part
+		partUUIDs    []string
+		available    []bool
+		alternatives []bool
+		price        float64
+		missing      []string
+	}{
+		{
+			name:         "primary parts in stock",
+			quantity:     1,
+			parts:        parts,
+			partUUIDs:    []string{merlin.Uuid, gridFins.Uuid},
+			available:    []bool{true, true},
+			alternatives: []bool{false, false},
+			price:        980,
+		},
+		{
+			name:         "alternative replaces primary",
+			quantity:     2,
+			parts:        parts,
+			partUUIDs:    []string{merlin.Uuid, wing.Uuid},
+			available:    []bool{false, true},
+			alternatives: []bool{false, true},
+			price:        2040,
+		},
+		{
+			name:         "primary kept when alternative is missing",
+			quantity:     2,
+			parts:        map[string]*model.Part{merlin.Uuid: merlin, gridFins.Uuid: gridFins},
+			partUUIDs:    []string{merlin.Uuid, gridFins.Uuid},
+			available:    []bool{false, false},
+			alternatives: []bool{false, false},
+			price:        1960,
+		},
+		{
+			name:         "missing primary",
+			quantity:     1,
+			parts:        map[string]*model.Part{gridFins.Uuid: gridFins},
+			partUUIDs:    []string{gridFins.Uuid},
+			available:    []bool{true},
+			alternatives: []bool{false},
+			price:        80,
+			missing:      []string{merlin.Uuid},
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			expansion := expand(falcon, tt.parts, tt.quantity)
+
+			s.Require().Len(expansion.Lines, len(tt.partUUIDs))
+			for i, line := range expansion.Lines {
+				s.Require().Equal(tt.partUUIDs[i], line.Part.Uuid)
+				s.Require().Equal(tt.available[i], line.Available)
+				s.Require().Equal(tt.alternatives[i], line.Alternative)
+			}
+			s.Require().InDelta(tt.price, expansion.TotalPrice(), 0.001)
+			s.Require().Equal(tt.missing, expansion.MissingParts)
+		})
+	}
+}
+
+func (s *ServiceSuite) TestExpandReservesStock() {
+	engine := &model.Part{Uuid: "engine", Price: 10, StockQuantity: 3}
+	rocketModel := &model.RocketModel{
+		Items: []model.BomItem{
+			{PartUuid: "booster", Quantity: 2, Alternatives: []string{engine.Uuid}},
+			{PartUuid: engine.Uuid, Quantity: 2},
+		},
+	}
+
+	expansion := expand(rocketModel, map[string]*model.Part{engine.Uuid: engine}, 1)
+
+	s.Require().Len(expansion.Lines, 2)
+	s.Require().True(expansion.Lines[0].Available)
+	s.Require().False(expansion.Lines[1].Available)
+	s.Require().False(expansion.Available())
+}
+
+func (s *ServiceSuite) TestExpandModelSuccess() {
+	s.rocketModelRepository.On("GetModel", s.ctx, falcon.Uuid).Return(falcon, nil)
+	s.partRepository.On("ListParts", s.ctx, mock.AnythingOfType("*model.PartsQuery")).
+		Return(&model.PartsPage{Parts: []*model.Part{merlin, gridFins, wing}}, nil)
+
+	expansion, err := s.service.ExpandModel(s.ctx, falcon.Uuid, 0)
+	s.Require().NoError(err)
+	s.Require().True(expansion.Available())
+	s.Require().Equal(int64(9), expansion.Lines[0].Quantity)
+}
+
+func (s *ServiceSuite) TestExpandModelIncomplete() {
+	s.rocketModelRepository.On("GetModel", s.ctx, falcon.Uuid).Return(falcon, nil)
+	s.partRepository.On("ListParts", s.ctx, mock.AnythingOfType("*model.PartsQuery")).
+		Return(&model.PartsPage{Parts: []*model.Part{gridFins}}, nil)
+
+	_, err := s.service.ExpandModel(s.ctx, falcon.Uuid, 1)
+	s.Require().ErrorIs(err, model.ErrRocketModelIncomplete)
+	s.Require().ErrorContains(err, merlin.Uuid)
+}
+
+func (s *ServiceSuite) TestExpandModelErrors() {
+	_, err := s.service.ExpandModel(s.ctx, falcon.Uuid, -1)
+	s.Require().ErrorIs(err, model.ErrInvalidRocketModel)
+
+	s.rocketModelRepository.On("GetModel", s.ctx, "unknown").Return(nil, model.ErrRocketModelNotFound)
+	_, err = s.service.ExpandModel(s.ctx, "unknown", 1)
+	s.Require().ErrorIs(err, model.ErrRocketModelNotFound)
+
+	dbErr := errors.New("db error")
+	s.rocketModelRepository.On("GetModel", s.ctx, falcon.Uuid).Return(falcon, nil)
+	s.partRepository.On("ListParts", s.ctx, mock.AnythingOfType("*model.PartsQuery")).Return(nil, dbErr)
+	_, err = s.service.ExpandModel(s.ctx, falcon.Uuid, 1)
+	s.Require().ErrorIs(err, dbErr)
+}
@@ -0,0 +1,32 @@
+package rocket_model
+
+import (
+	"context"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *service) ListModels(ctx context.Context) ([]*model.RocketModelSummary, error) {
+	rocketModels, err := s.rocketModelRepository.ListModels(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Детали всех моделей читаются одним запросом
+	parts, err := s.getParts(ctx, referencedUUIDs(rocketModels))
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make([]*model.RocketModelSummary, 0, len(rocketModels))
+	for _, rocketModel := range rocketModels {
+		expansion := expand(rocketModel, parts, 1)
+		summaries = append(summaries, &model.RocketModelSummary{
+			Model:     rocketModel,
+			Price:     expansion.TotalPrice(),
+			Available: expansion.Available(),
+		})
+	}
+
+	return summaries, nil
+}
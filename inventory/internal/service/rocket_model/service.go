@@ -0,0 +1,23 @@
+package rocket_model
+
+import (
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository"
+	def "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/service"
+)
+
+var _ def.RocketModelService = (*service)(nil)
+
+type service struct {
+	rocketModelRepository repository.RocketModelRepository
+	partRepository        repository.PartRepository
+}
+
+func NewService(
+	rocketModelRepository repository.RocketModelRepository,
+	partRepository repository.PartRepository,
+) *service {
+	return &service{
+		rocketModelRepository: rocketModelRepository,
+		partRepository:        partRepository,
+	}
+}
@@ -0,0 +1,36 @@
+package rocket_model
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/mocks"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	ctx                   context.Context
+	rocketModelRepository *mocks.RocketModelRepository
+	partRepository        *mocks.PartRepository
+	service               *service
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctx = context.Background()
+
+	s.rocketModelRepository = mocks.NewRocketModelRepository(s.T())
+	s.partRepository = mocks.NewPartRepository(s.T())
+
+	s.service = NewService(
+		s.rocketModelRepository,
+		s.partRepository,
+	)
+}
+
+func (s *ServiceSuite) TearDownTest() {}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
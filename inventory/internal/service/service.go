@@ -2,11 +2,117 @@ package service
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
 )
 
 type PartService interface {
-	GetPart(ctx context.Context, uuid string) (*model.Part, error)
-	ListParts(ctx context.Context, filter *model.PartsFilter) ([]*model.Part, error)
+	// GetPart возвращает деталь по UUID. fields ограничивает возвращаемые поля, пусто — все поля
+	GetPart(ctx context.Context, uuid string, fields []string) (*model.Part, error)
+	// BatchGetParts возвращает детали в порядке запроса и UUID, для которых деталь не найдена
+	BatchGetParts(ctx context.Context, uuids []string, fields []string) (*model.PartsBatch, error)
+	ListParts(ctx context.Context, query *model.PartsQuery) (*model.PartsPage, error)
+	SearchParts(ctx context.Context, search *model.PartsSearch) ([]*model.PartSearchHit, error)
+	CreatePart(ctx context.Context, part *model.Part) (*model.Part, error)
+	UpdatePart(ctx context.Context, update *model.PartUpdate) (*model.Part, error)
+	DeletePart(ctx context.Context, uuid string) error
+	// ImportParts создает или обновляет детали по UUID и собирает ошибки по записям
+	ImportParts(ctx context.Context, catalogImport *model.CatalogImport) (*model.CatalogImportReport, error)
+	// ExportParts выгружает детали под фильтром постранично и возвращает их количество
+	ExportParts(ctx context.Context, export *model.CatalogExport) (int, error)
+	// WatchParts отправляет снимок деталей под фильтром, затем их изменения до отмены ctx
+	WatchParts(ctx context.Context, watch *model.PartsWatch) error
+}
+
+type StockService interface {
+	// ChangeStock записывает движение остатка и возвращает его вместе с новым остатком
+	ChangeStock(ctx context.Context, change *model.StockChange) (*model.StockMovement, error)
+	// ApplyStockChange записывает движение без проверки порога дозаказа — для записи внутри
+	// транзакции. Порог вызывающий проверяет через CheckStockLevel после коммита
+	ApplyStockChange(ctx context.Context, change *model.StockChange) (*model.StockMovement, error)
+	ListMovements(ctx context.Context, query *model.StockMovementsQuery) (*model.StockMovementsPage, error)
+	// CheckStockLevel публикует событие, если остаток пересек порог дозаказа
+	CheckStockLevel(ctx context.Context, partUUID string) error
+	// TransferStock перемещает остаток между складами и возвращает расход и приход
+	TransferStock(ctx context.Context, transfer *model.StockTransfer) (*model.StockMovement, *model.StockMovement, error)
+	GetAvailability(ctx context.Context, partUUIDs []string) ([]*model.PartAvailability, error)
+	// ReserveStock резервирует детали со складов по стратегии, ReleaseStock снимает резерв по ссылке
+	ReserveStock(ctx context.Context, reservation *model.StockReservation) ([]*model.StockMovement, error)
+	ReleaseStock(ctx context.Context, reference string) ([]*model.StockMovement, error)
+	// ConsumeStock переводит все, что еще зарезервировано по ссылке, в расход
+	ConsumeStock(ctx context.Context, reference string) ([]*model.StockMovement, error)
+}
+
+type CategoryService interface {
+	CreateCategory(ctx context.Context, category *model.PartCategory) (*model.PartCategory, error)
+	UpdateCategory(ctx context.Context, update *model.CategoryUpdate) (*model.PartCategory, error)
+	// DeleteCategory удаляет категорию без подкатегорий и деталей, ErrCategoryInUse иначе
+	DeleteCategory(ctx context.Context, code model.Category) error
+	// ListCategories возвращает категории так, что родитель идет раньше подкатегорий
+	ListCategories(ctx context.Context) ([]*model.PartCategory, error)
+	GetCategory(ctx context.Context, code model.Category) (*model.PartCategory, error)
+	// ExpandCategories возвращает категории вместе со всеми их подкатегориями
+	ExpandCategories(ctx context.Context, codes []model.Category) ([]model.Category, error)
+	// GetCategorySchema возвращает схему метаданных категории вместе с унаследованными полями
+	GetCategorySchema(ctx context.Context, code model.Category) (*model.CategorySchema, error)
+}
+
+type WarehouseService interface {
+	CreateWarehouse(ctx context.Context, warehouse *model.Warehouse) (*model.Warehouse, error)
+	ListWarehouses(ctx context.Context) ([]*model.Warehouse, error)
+}
+
+type CompatibilityService interface {
+	CreateRule(ctx context.Context, rule *model.CompatibilityRule) (*model.CompatibilityRule, error)
+	DeleteRule(ctx context.Context, uuid string) error
+	ListRules(ctx context.Context) ([]*model.CompatibilityRule, error)
+	// ValidateConfiguration проверяет набор деталей по всем правилам совместимости
+	ValidateConfiguration(ctx context.Context, partUUIDs []string) (*model.ConfigurationValidation, error)
+}
+
+type RocketModelService interface {
+	CreateModel(ctx context.Context, rocketModel *model.RocketModel) (*model.RocketModel, error)
+	// ListModels возвращает модели с ценой и доступностью одной ракеты
+	ListModels(ctx context.Context) ([]*model.RocketModelSummary, error)
+	// ExpandModel раскладывает модель на детали для заданного количества ракет,
+	// подменяя основные детали альтернативами, если их остатка не хватает
+	ExpandModel(ctx context.Context, uuid string, quantity int64) (*model.RocketModelExpansion, error)
+}
+
+type AttachmentService interface {
+	// UploadAttachment проверяет тип, размер и контрольную сумму файла, сохраняет его и прикрепляет к детали
+	UploadAttachment(ctx context.Context, upload *model.AttachmentUpload) (*model.Attachment, error)
+	// OpenAttachment возвращает вложение и его содержимое, которое нужно закрыть после чтения
+	OpenAttachment(ctx context.Context, uuid string) (*model.Attachment, io.ReadCloser, error)
+	DeleteAttachment(ctx context.Context, uuid string) error
+}
+
+type PriceService interface {
+	// SchedulePrice добавляет в историю цену, которая вступит в силу в будущем
+	SchedulePrice(ctx context.Context, change *model.PriceChange) (*model.PriceChange, error)
+	// RecordPrice добавляет в историю цену, уже записанную в карточку детали
+	RecordPrice(ctx context.Context, partUUID string, price float64, reason string) error
+	ListHistory(ctx context.Context, partUUID string) ([]*model.PriceChange, error)
+	// GetPrices возвращает цены деталей на момент at в порядке partUUIDs
+	GetPrices(ctx context.Context, partUUIDs []string, at time.Time) ([]*model.PartPrice, error)
+	// ApplyDue переносит наступившие цены в карточки деталей и возвращает число обновленных деталей
+	ApplyDue(ctx context.Context, now time.Time) (int, error)
+}
+
+type StockProducerService interface {
+	PublishPartStockLow(ctx context.Context, part *model.Part) error
+	PublishPartRestocked(ctx context.Context, part *model.Part) error
+}
+
+type PartProducerService interface {
+	// PublishPartChange публикует PartCreated, PartUpdated или PartDeleted по изменению детали
+	PublishPartChange(ctx context.Context, change *model.PartChange) error
+}
+
+type PartChangeService interface {
+	// PublishChanges публикует изменения деталей из change stream до отмены ctx,
+	// продолжая с сохраненной позиции потока
+	PublishChanges(ctx context.Context) error
 }
@@ -0,0 +1,51 @@
+package stock
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *service) GetAvailability(ctx context.Context, partUUIDs []string) ([]*model.PartAvailability, error) {
+	parts, err := s.getParts(ctx, partUUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	availability := make([]*model.PartAvailability, 0, len(partUUIDs))
+	for _, partUUID := range partUUIDs {
+		part, ok := parts[partUUID]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", model.ErrPartNotFound, partUUID)
+		}
+		availability = append(availability, &model.PartAvailability{
+			PartUuid:      part.Uuid,
+			StockQuantity: part.StockQuantity,
+			Locations:     part.StockLocations,
+		})
+	}
+
+	return availability, nil
+}
+
+// getParts возвращает не удаленные детали по UUID
+func (s *service) getParts(ctx context.Context, partUUIDs []string) (map[string]*model.Part, error) {
+	if len(partUUIDs) == 0 {
+		return map[string]*model.Part{}, nil
+	}
+
+	page, err := s.partRepository.ListParts(ctx, &model.PartsQuery{
+		Filter:   &model.PartsFilter{Uuids: partUUIDs},
+		PageSize: int32(len(partUUIDs)), //nolint:gosec // количество деталей в запросе невелико
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parts: %w", err)
+	}
+
+	parts := make(map[string]*model.Part, len(page.Parts))
+	for _, part := range page.Parts {
+		parts[part.Uuid] = part
+	}
+	return parts, nil
+}
@@ -0,0 +1,118 @@
+package stock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+func (s *service) ChangeStock(ctx context.Context, change *model.StockChange) (*model.StockMovement, error) {
+	movement, err := s.ApplyStockChange(ctx, change)
+	if err != nil {
+		return nil, err
+	}
+
+	s.checkStockLevel(ctx, movement.PartUuid)
+
+	return movement, nil
+}
+
+func (s *service) ApplyStockChange(ctx context.Context, change *model.StockChange) (*model.StockMovement, error) {
+	if change.PartUuid == "" {
+		return nil, fmt.Errorf("%w: part uuid is required", model.ErrInvalidStockChange)
+	}
+
+	quantity, err := signedQuantity(change)
+	if err != nil {
+		return nil, err
+	}
+
+	warehouseUUID := change.WarehouseUuid
+	if warehouseUUID == "" {
+		warehouseUUID = s.defaultWarehouseUUID
+	} else if _, err = s.warehouseRepository.GetWarehouse(ctx, warehouseUUID); err != nil {
+		if errors.Is(err, model.ErrWarehouseNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get warehouse: %w", err)
+	}
+
+	return s.writeMovement(ctx, &model.StockMovement{
+		PartUuid:      change.PartUuid,
+		WarehouseUuid: warehouseUUID,
+		Type:          change.Type,
+		Quantity:      quantity,
+		Reason:        change.Reason,
+		Reference:     change.Reference,
+	})
+}
+
+// applyMovement записывает движение с уже проверенными складом и знаком количества
+// и проверяет порог дозаказа детали
+func (s *service) applyMovement(ctx context.Context, movement *model.StockMovement) (*model.StockMovement, error) {
+	movement, err := s.writeMovement(ctx, movement)
+	if err != nil {
+		return nil, err
+	}
+
+	s.checkStockLevel(ctx, movement.PartUuid)
+
+	return movement, nil
+}
+
+// writeMovement записывает движение с уже проверенными складом и знаком количества
+func (s *service) writeMovement(ctx context.Context, movement *model.StockMovement) (*model.StockMovement, error) {
+	movement.Uuid = uuid.NewString()
+	movement.CreatedAt = time.Now()
+
+	movement, err := s.stockRepository.ApplyMovement(ctx, movement)
+	if err != nil {
+		if errors.Is(err, model.ErrPartNotFound) || errors.Is(err, model.ErrInsufficientStock) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to change stock: %w", err)
+	}
+
+	return movement, nil
+}
+
+// checkStockLevel проверяет порог дозаказа после записи движения. Движение уже записано,
+// поэтому ошибка оповещения не отменяет операцию и только логируется
+func (s *service) checkStockLevel(ctx context.Context, partUUID string) {
+	if err := s.CheckStockLevel(ctx, partUUID); err != nil {
+		logger.Error(ctx, "Failed to check stock level",
+			zap.String("part_uuid", partUUID),
+			zap.Error(err),
+		)
+	}
+}
+
+// signedQuantity переводит количество из запроса в изменение остатка со знаком
+func signedQuantity(change *model.StockChange) (int64, error) {
+	switch change.Type {
+	case model.STOCK_MOVEMENT_TYPE_ADJUSTMENT:
+		if change.Quantity == 0 {
+			return 0, fmt.Errorf("%w: adjustment must not be zero", model.ErrInvalidStockChange)
+		}
+		return change.Quantity, nil
+	case model.STOCK_MOVEMENT_TYPE_RECEIPT, model.STOCK_MOVEMENT_TYPE_RELEASE:
+		if change.Quantity <= 0 {
+			return 0, fmt.Errorf("%w: quantity must be positive", model.ErrInvalidStockChange)
+		}
+		return change.Quantity, nil
+	case model.STOCK_MOVEMENT_TYPE_RESERVATION, model.STOCK_MOVEMENT_TYPE_CONSUMPTION:
+		if change.Quantity <= 0 {
+			return 0, fmt.Errorf("%w: quantity must be positive", model.ErrInvalidStockChange)
+		}
+		return -change.Quantity, nil
+	default:
+		return 0, fmt.Errorf("%w: unknown movement type", model.ErrInvalidStockChange)
+	}
+}
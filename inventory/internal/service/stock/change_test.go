@@ -0,0 +1,89 @@
+package stock
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestChangeStockSigns() {
+	tests := []struct {
+		movementType model.StockMovementType
+		quantity     int64
+		expected     int64
+	}{
+		{model.STOCK_MOVEMENT_TYPE_RECEIPT, 5, 5},
+		{model.STOCK_MOVEMENT_TYPE_RELEASE, 2, 2},
+		{model.STOCK_MOVEMENT_TYPE_RESERVATION, 2, -2},
+		{model.STOCK_MOVEMENT_TYPE_CONSUMPTION, 1, -1},
+		{model.STOCK_MOVEMENT_TYPE_ADJUSTMENT, -4, -4},
+	}
+
+	for _, tt := range tests {
+		partUUID := gofakeit.UUID()
+		orderUUID := gofakeit.UUID()
+
+		s.stockRepository.On("ApplyMovement", s.ctx, mock.MatchedBy(func(m *model.StockMovement) bool {
+			return m.PartUuid == partUUID && m.WarehouseUuid == baikonurUUID && m.Type == tt.movementType && m.Quantity == tt.expected &&
+				m.Reference == orderUUID && m.Uuid != "" && !m.CreatedAt.IsZero()
+		})).Return(&model.StockMovement{PartUuid: partUUID, Quantity: tt.expected}, nil).Once()
+		s.partRepository.On("GetPart", s.ctx, partUUID).Return(&model.Part{Uuid: partUUID}, nil).Once()
+
+		movement, err := s.service.ChangeStock(s.ctx, &model.StockChange{
+			PartUuid:  partUUID,
+			Type:      tt.movementType,
+			Quantity:  tt.quantity,
+			Reason:    "test",
+			Reference: orderUUID,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(tt.expected, movement.Quantity)
+	}
+}
+
+func (s *ServiceSuite) TestChangeStockInvalid() {
+	changes := []*model.StockChange{
+		{Type: model.STOCK_MOVEMENT_TYPE_RECEIPT, Quantity: 1},
+		{PartUuid: gofakeit.UUID(), Type: model.STOCK_MOVEMENT_TYPE_RECEIPT, Quantity: 0},
+		{PartUuid: gofakeit.UUID(), Type: model.STOCK_MOVEMENT_TYPE_RESERVATION, Quantity: -1},
+		{PartUuid: gofakeit.UUID(), Type: model.STOCK_MOVEMENT_TYPE_ADJUSTMENT, Quantity: 0},
+		{PartUuid: gofakeit.UUID(), Quantity: 1},
+	}
+
+	for _, change := range changes {
+		movement, err := s.service.ChangeStock(s.ctx, change)
+		s.Require().ErrorIs(err, model.ErrInvalidStockChange)
+		s.Require().Nil(movement)
+	}
+}
+
+func (s *ServiceSuite) TestChangeStockInsufficient() {
+	partUUID := gofakeit.UUID()
+
+	s.stockRepository.On("ApplyMovement", s.ctx, mock.AnythingOfType("*model.StockMovement")).
+		Return(nil, model.ErrInsufficientStock)
+
+	movement, err := s.service.ChangeStock(s.ctx, &model.StockChange{
+		PartUuid: partUUID,
+		Type:     model.STOCK_MOVEMENT_TYPE_CONSUMPTION,
+		Quantity: 100,
+	})
+	s.Require().ErrorIs(err, model.ErrInsufficientStock)
+	s.Require().Nil(movement)
+}
+
+func (s *ServiceSuite) TestChangeStockUnknownWarehouse() {
+	warehouseUUID := gofakeit.UUID()
+
+	s.warehouseRepository.On("GetWarehouse", s.ctx, warehouseUUID).Return(nil, model.ErrWarehouseNotFound)
+
+	movement, err := s.service.ChangeStock(s.ctx, &model.StockChange{
+		PartUuid:      gofakeit.UUID(),
+		WarehouseUuid: warehouseUUID,
+		Type:          model.STOCK_MOVEMENT_TYPE_RECEIPT,
+		Quantity:      1,
+	})
+	s.Require().ErrorIs(err, model.ErrWarehouseNotFound)
+	s.Require().Nil(movement)
+}
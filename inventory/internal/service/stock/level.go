@@ -0,0 +1,39 @@
+package stock
+
+import (
+	"context"
+	"fmt"
+)
+
+// CheckStockLevel сравнивает остаток детали с порогом дозаказа и при смене состояния
+// публикует PartStockLow или PartRestocked. Признак переключается только после публикации:
+// если Kafka недоступна, следующая проверка увидит прежний признак и отправит событие снова.
+// Параллельные проверки могут отправить событие дважды, потребители это допускают
+func (s *service) CheckStockLevel(ctx context.Context, partUUID string) error {
+	part, err := s.partRepository.GetPart(ctx, partUUID)
+	if err != nil {
+		return fmt.Errorf("failed to get part: %w", err)
+	}
+
+	low := part.ReorderThreshold > 0 && part.StockQuantity < part.ReorderThreshold
+	if low == part.StockLow {
+		return nil
+	}
+
+	part.StockLow = low
+	if low {
+		err = s.stockProducer.PublishPartStockLow(ctx, part)
+	} else {
+		err = s.stockProducer.PublishPartRestocked(ctx, part)
+	}
+	if err != nil {
+		return err
+	}
+
+	// Условное обновление: если признак уже переключил параллельный запрос, ничего не меняется
+	if _, err = s.partRepository.SetStockLow(ctx, partUUID, low); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+package stock
+
+import (
+	"errors"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestCheckStockLevelBecomesLow() {
+	part := &model.Part{Uuid: gofakeit.UUID(), StockQuantity: 2, ReorderThreshold: 5}
+
+	s.partRepository.On("GetPart", s.ctx, part.Uuid).Return(part, nil)
+	s.partRepository.On("SetStockLow", s.ctx, part.Uuid, true).Return(true, nil)
+	s.stockProducer.On("PublishPartStockLow", s.ctx, mock.MatchedBy(func(p *model.Part) bool {
+		return p.Uuid == part.Uuid && p.StockLow
+	})).Return(nil)
+
+	err := s.service.CheckStockLevel(s.ctx, part.Uuid)
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestCheckStockLevelRestocked() {
+	part := &model.Part{Uuid: gofakeit.UUID(), StockQuantity: 5, ReorderThreshold: 5, StockLow: true}
+
+	s.partRepository.On("GetPart", s.ctx, part.Uuid).Return(part, nil)
+	s.partRepository.On("SetStockLow", s.ctx, part.Uuid, false).Return(true, nil)
+	s.stockProducer.On("PublishPartRestocked", s.ctx, mock.MatchedBy(func(p *model.Part) bool {
+		return p.Uuid == part.Uuid && !p.StockLow
+	})).Return(nil)
+
+	err := s.service.CheckStockLevel(s.ctx, part.Uuid)
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestCheckStockLevelUnchanged() {
+	parts := []*model.Part{
+		{Uuid: gofakeit.UUID(), StockQuantity: 1, ReorderThreshold: 5, StockLow: true},
+		{Uuid: gofakeit.UUID(), StockQuantity: 10, ReorderThreshold: 5},
+		{Uuid: gofakeit.UUID(), StockQuantity: 0},
+	}
+
+	for _, part := range parts {
+		s.partRepository.On("GetPart", s.ctx, part.Uuid).Return(part, nil).Once()
+
+		err := s.service.CheckStockLevel(s.ctx, part.Uuid)
+		s.Require().NoError(err)
+	}
+}
+
+func (s *ServiceSuite) TestCheckStockLevelPublishErrorKeepsFlag() {
+	part := &model.Part{Uuid: gofakeit.UUID(), StockQuantity: 2, ReorderThreshold: 5}
+	publishErr := errors.New("kafka is down")
+
+	s.partRepository.On("GetPart", s.ctx, part.Uuid).Return(part, nil)
+	s.stockProducer.On("PublishPartStockLow", s.ctx, mock.AnythingOfType("*model.Part")).Return(publishErr)
+
+	err := s.service.CheckStockLevel(s.ctx, part.Uuid)
+
+	// Признак не переключен, поэтому следующая проверка отправит событие снова
+	s.Require().ErrorIs(err, publishErr)
+	s.partRepository.AssertNotCalled(s.T(), "SetStockLow", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestChangeStockIgnoresAlertError() {
+	partUUID := gofakeit.UUID()
+
+	s.stockRepository.On("ApplyMovement", s.ctx, mock.AnythingOfType("*model.StockMovement")).
+		Return(&model.StockMovement{PartUuid: partUUID, Quantity: -3, StockAfter: 1}, nil)
+	s.partRepository.On("GetPart", s.ctx, partUUID).
+		Return(&model.Part{Uuid: partUUID, StockQuantity: 1, ReorderThreshold: 5}, nil)
+	s.stockProducer.On("PublishPartStockLow", s.ctx, mock.AnythingOfType("*model.Part")).
+		Return(errors.New("kafka is down"))
+
+	movement, err := s.service.ChangeStock(s.ctx, &model.StockChange{
+		PartUuid: partUUID,
+		Type:     model.STOCK_MOVEMENT_TYPE_CONSUMPTION,
+		Quantity: 3,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), movement.StockAfter)
+}
@@ -0,0 +1,35 @@
+package stock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 1000
+)
+
+func (s *service) ListMovements(ctx context.Context, query *model.StockMovementsQuery) (*model.StockMovementsPage, error) {
+	switch {
+	case query.PageSize < 0:
+		return nil, model.ErrInvalidPageSize
+	case query.PageSize == 0:
+		query.PageSize = defaultPageSize
+	case query.PageSize > maxPageSize:
+		query.PageSize = maxPageSize
+	}
+
+	page, err := s.stockRepository.ListMovements(ctx, query)
+	if err != nil {
+		if errors.Is(err, model.ErrInvalidPageToken) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to list stock movements: %w", err)
+	}
+
+	return page, nil
+}
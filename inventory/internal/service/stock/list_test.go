@@ -0,0 +1,29 @@
+package stock
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestListMovementsDefaultPageSize() {
+	partUUID := gofakeit.UUID()
+	expected := &model.StockMovementsPage{
+		Movements: []*model.StockMovement{{Uuid: gofakeit.UUID(), PartUuid: partUUID, Quantity: 5}},
+	}
+
+	s.stockRepository.On("ListMovements", s.ctx, &model.StockMovementsQuery{
+		PartUuid: partUUID,
+		PageSize: defaultPageSize,
+	}).Return(expected, nil)
+
+	page, err := s.service.ListMovements(s.ctx, &model.StockMovementsQuery{PartUuid: partUUID})
+	s.Require().NoError(err)
+	s.Require().Equal(expected, page)
+}
+
+func (s *ServiceSuite) TestListMovementsNegativePageSize() {
+	page, err := s.service.ListMovements(s.ctx, &model.StockMovementsQuery{PartUuid: gofakeit.UUID(), PageSize: -1})
+	s.Require().ErrorIs(err, model.ErrInvalidPageSize)
+	s.Require().Nil(page)
+}
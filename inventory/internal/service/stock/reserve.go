@@ -0,0 +1,315 @@
+package stock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+// ReserveStock списывает под заказ детали со складов, выбранных стратегией резервирования.
+// Все движения резерва пишутся в одной транзакции вместе с отметками по (ссылка, деталь):
+// повторный или параллельный вызов с той же ссылкой возвращает уже оформленный резерв.
+// Порог дозаказа проверяется после коммита
+func (s *service) ReserveStock(ctx context.Context, reservation *model.StockReservation) ([]*model.StockMovement, error) {
+	if reservation.Reference == "" {
+		return nil, fmt.Errorf("%w: reference is required", model.ErrInvalidReservation)
+	}
+	if len(reservation.Items) == 0 {
+		return nil, fmt.Errorf("%w: items are required", model.ErrInvalidReservation)
+	}
+
+	// Суммируем количество по деталям, сохраняя порядок позиций
+	partUUIDs := make([]string, 0, len(reservation.Items))
+	needed := make(map[string]int64, len(reservation.Items))
+	for _, item := range reservation.Items {
+		if item.PartUuid == "" || item.Quantity <= 0 {
+			return nil, fmt.Errorf("%w: item must have part uuid and positive quantity", model.ErrInvalidReservation)
+		}
+		if _, ok := needed[item.PartUuid]; !ok {
+			partUUIDs = append(partUUIDs, item.PartUuid)
+		}
+		needed[item.PartUuid] += item.Quantity
+	}
+
+	existing, err := s.outstanding(ctx, reservation.Reference)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 {
+		return existing, nil
+	}
+
+	parts, err := s.getParts(ctx, partUUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	ranking, err := s.warehouseRanking(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	planned := make([]*model.StockMovement, 0, len(partUUIDs))
+	for _, partUUID := range partUUIDs {
+		part, ok := parts[partUUID]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", model.ErrPartNotFound, partUUID)
+		}
+
+		allocation, err := s.allocate(part, needed[partUUID], ranking)
+		if err != nil {
+			return nil, err
+		}
+		for _, location := range allocation {
+			planned = append(planned, &model.StockMovement{
+				PartUuid:      partUUID,
+				WarehouseUuid: location.WarehouseUuid,
+				Type:          model.STOCK_MOVEMENT_TYPE_RESERVATION,
+				Quantity:      -location.Quantity,
+				Reason:        "order reservation",
+				Reference:     reservation.Reference,
+			})
+		}
+	}
+
+	var movements []*model.StockMovement
+	err = s.txManager.Run(ctx, func(ctx context.Context) error {
+		// Транзакция может повторяться, поэтому движения собираются заново
+		movements = make([]*model.StockMovement, 0, len(planned))
+
+		if err := s.stockRepository.ClaimReservation(ctx, reservation.Reference, partUUIDs, time.Now()); err != nil {
+			if errors.Is(err, model.ErrReservationExists) {
+				return err
+			}
+			return fmt.Errorf("failed to claim reservation: %w", err)
+		}
+
+		for _, movement := range planned {
+			// Остаток мог измениться между чтением и списанием — тогда откатывается весь резерв
+			applied, err := s.writeMovement(ctx, copyMovement(movement))
+			if err != nil {
+				return err
+			}
+			movements = append(movements, applied)
+		}
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrReservationExists) {
+			// Резерв по ссылке оформил параллельный вызов
+			return s.outstanding(ctx, reservation.Reference)
+		}
+		return nil, err
+	}
+
+	for _, partUUID := range partUUIDs {
+		s.checkStockLevel(ctx, partUUID)
+	}
+
+	return movements, nil
+}
+
+// copyMovement возвращает копию запланированного движения для очередной попытки транзакции
+func copyMovement(movement *model.StockMovement) *model.StockMovement {
+	copied := *movement
+	return &copied
+}
+
+// ReleaseStock возвращает на склады все, что еще зарезервировано по ссылке
+func (s *service) ReleaseStock(ctx context.Context, reference string) ([]*model.StockMovement, error) {
+	if reference == "" {
+		return nil, fmt.Errorf("%w: reference is required", model.ErrInvalidReservation)
+	}
+
+	outstanding, err := s.outstanding(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	movements := make([]*model.StockMovement, 0, len(outstanding))
+	for _, reserved := range outstanding {
+		released, err := s.applyMovement(ctx, &model.StockMovement{
+			PartUuid:      reserved.PartUuid,
+			WarehouseUuid: reserved.WarehouseUuid,
+			Type:          model.STOCK_MOVEMENT_TYPE_RELEASE,
+			Quantity:      -reserved.Quantity,
+			Reason:        "order release",
+			Reference:     reference,
+		})
+		if err != nil {
+			return nil, err
+		}
+		movements = append(movements, released)
+	}
+
+	return movements, nil
+}
+
+// ConsumeStock закрывает резервы по ссылке движениями RELEASE и CONSUMPTION: детали заказа
+// ушли со склада, и журнал движений показывает расход, а не снятие резерва. Остаток не меняется,
+// поэтому порог дозаказа не проверяется. Повторный вызов ничего не делает
+func (s *service) ConsumeStock(ctx context.Context, reference string) ([]*model.StockMovement, error) {
+	if reference == "" {
+		return nil, fmt.Errorf("%w: reference is required", model.ErrInvalidReservation)
+	}
+
+	outstanding, err := s.outstanding(ctx, reference)
+	if err != nil {
+		return nil, err
+	}
+
+	movements := make([]*model.StockMovement, 0, len(outstanding))
+	for _, reserved := range outstanding {
+		now := time.Now()
+		release := &model.StockMovement{
+			Uuid:          uuid.NewString(),
+			PartUuid:      reserved.PartUuid,
+			WarehouseUuid: reserved.WarehouseUuid,
+			Type:          model.STOCK_MOVEMENT_TYPE_RELEASE,
+			Quantity:      -reserved.Quantity,
+			Reason:        "order consumption",
+			Reference:     reference,
+			CreatedAt:     now,
+		}
+		consumption := &model.StockMovement{
+			Uuid:          uuid.NewString(),
+			PartUuid:      reserved.PartUuid,
+			WarehouseUuid: reserved.WarehouseUuid,
+			Type:          model.STOCK_MOVEMENT_TYPE_CONSUMPTION,
+			Quantity:      reserved.Quantity,
+			Reason:        "order consumption",
+			Reference:     reference,
+			CreatedAt:     now,
+		}
+
+		if err = s.stockRepository.ConsumeReservation(ctx, release, consumption); err != nil {
+			if errors.Is(err, model.ErrPartNotFound) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("failed to consume stock: %w", err)
+		}
+		movements = append(movements, consumption)
+	}
+
+	return movements, nil
+}
+
+// outstanding возвращает движения резерва по ссылке, которые еще не сняты.
+// Количество в движениях — непогашенный остаток резерва по детали и складу
+func (s *service) outstanding(ctx context.Context, reference string) ([]*model.StockMovement, error) {
+	history, err := s.stockRepository.ListReservations(ctx, reference)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list reservations: %w", err)
+	}
+
+	type key struct{ part, warehouse string }
+	balance := make(map[key]int64)
+	reservations := make([]*model.StockMovement, 0, len(history))
+	for _, movement := range history {
+		k := key{movement.PartUuid, movement.WarehouseUuid}
+		if movement.Type == model.STOCK_MOVEMENT_TYPE_RESERVATION {
+			if _, ok := balance[k]; !ok {
+				reservations = append(reservations, movement)
+			}
+		}
+		balance[k] += movement.Quantity
+	}
+
+	result := make([]*model.StockMovement, 0, len(reservations))
+	for _, movement := range reservations {
+		if left := balance[key{movement.PartUuid, movement.WarehouseUuid}]; left < 0 {
+			outstanding := *movement
+			outstanding.Quantity = left
+			result = append(result, &outstanding)
+		}
+	}
+	return result, nil
+}
+
+// warehouseRanking возвращает порядок предпочтения складов для стратегии nearest.
+// Для most_stock порядок определяется остатком детали и ранжирование не нужно
+func (s *service) warehouseRanking(ctx context.Context) (map[string]float64, error) {
+	if s.reservationStrategy != model.RESERVATION_STRATEGY_NEAREST {
+		return nil, nil
+	}
+
+	warehouses, err := s.warehouseRepository.ListWarehouses(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list warehouses: %w", err)
+	}
+
+	distances := make(map[string]float64, len(warehouses))
+	for _, warehouse := range warehouses {
+		distances[warehouse.Uuid] = distanceKm(s.reservationOrigin, warehouse.Location)
+	}
+	return distances, nil
+}
+
+// allocate распределяет количество по складам детали: сначала ищет один склад,
+// которого хватает целиком, иначе берет со складов по порядку стратегии
+func (s *service) allocate(part *model.Part, quantity int64, distances map[string]float64) ([]*model.StockLocation, error) {
+	locations := make([]*model.StockLocation, 0, len(part.StockLocations))
+	for _, location := range part.StockLocations {
+		if location.Quantity > 0 {
+			locations = append(locations, location)
+		}
+	}
+
+	sort.SliceStable(locations, func(i, j int) bool {
+		if distances != nil {
+			return rankDistance(distances, locations[i].WarehouseUuid) < rankDistance(distances, locations[j].WarehouseUuid)
+		}
+		return locations[i].Quantity > locations[j].Quantity
+	})
+
+	for _, location := range locations {
+		if location.Quantity >= quantity {
+			return []*model.StockLocation{{WarehouseUuid: location.WarehouseUuid, Quantity: quantity}}, nil
+		}
+	}
+
+	allocation := make([]*model.StockLocation, 0, len(locations))
+	left := quantity
+	for _, location := range locations {
+		if left == 0 {
+			break
+		}
+		take := min(location.Quantity, left)
+		allocation = append(allocation, &model.StockLocation{WarehouseUuid: location.WarehouseUuid, Quantity: take})
+		left -= take
+	}
+	if left > 0 {
+		return nil, fmt.Errorf("%w: part %s", model.ErrInsufficientStock, part.Uuid)
+	}
+
+	return allocation, nil
+}
+
+// rankDistance возвращает расстояние до склада, неизвестные склады идут последними
+func rankDistance(distances map[string]float64, warehouseUUID string) float64 {
+	if distance, ok := distances[warehouseUUID]; ok {
+		return distance
+	}
+	return math.Inf(1)
+}
+
+const earthRadiusKm = 6371.0
+
+// distanceKm - расстояние между точками по формуле гаверсинусов
+func distanceKm(from, to model.GeoPoint) float64 {
+	lat1 := from.Latitude * math.Pi / 180
+	lat2 := to.Latitude * math.Pi / 180
+	dLat := lat2 - lat1
+	dLon := (to.Longitude - from.Longitude) * math.Pi / 180
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(h))
+}
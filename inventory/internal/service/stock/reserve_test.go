@@ -0,0 +1,223 @@
+package stock
+
+import (
+	"context"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) partWithLocations(partUUID string, baikonur, vostochny int64) {
+	part := &model.Part{
+		Uuid:          partUUID,
+		StockQuantity: baikonur + vostochny,
+		StockLocations: []*model.StockLocation{
+			{WarehouseUuid: baikonurUUID, Quantity: baikonur},
+			{WarehouseUuid: vostochnyUUID, Quantity: vostochny},
+		},
+	}
+	s.partRepository.On("ListParts", s.ctx, mock.AnythingOfType("*model.PartsQuery")).
+		Return(&model.PartsPage{Parts: []*model.Part{part}}, nil)
+	s.partRepository.On("GetPart", s.ctx, partUUID).Return(part, nil).Maybe()
+}
+
+func (s *ServiceSuite) expectClaim(orderUUID string, partUUIDs ...string) {
+	s.stockRepository.On("ClaimReservation", s.ctx, orderUUID, partUUIDs, mock.AnythingOfType("time.Time")).
+		Return(nil).Once()
+}
+
+func (s *ServiceSuite) expectReservation(partUUID, warehouseUUID string, quantity int64) {
+	s.stockRepository.On("ApplyMovement", s.ctx, mock.MatchedBy(func(m *model.StockMovement) bool {
+		return m.PartUuid == partUUID && m.WarehouseUuid == warehouseUUID &&
+			m.Type == model.STOCK_MOVEMENT_TYPE_RESERVATION && m.Quantity == -quantity
+	})).Return(func(_ context.Context, m *model.StockMovement) *model.StockMovement { return m }, nil).Once()
+}
+
+func (s *ServiceSuite) TestReserveStockMostStockSingleWarehouse() {
+	partUUID := gofakeit.UUID()
+	orderUUID := gofakeit.UUID()
+
+	s.stockRepository.On("ListReservations", s.ctx, orderUUID).Return([]*model.StockMovement{}, nil)
+	s.partWithLocations(partUUID, 2, 8)
+	s.expectClaim(orderUUID, partUUID)
+	s.expectReservation(partUUID, vostochnyUUID, 5)
+
+	movements, err := s.service.ReserveStock(s.ctx, &model.StockReservation{
+		Reference: orderUUID,
+		Items: []*model.ReservationItem{
+			{PartUuid: partUUID, Quantity: 3},
+			{PartUuid: partUUID, Quantity: 2},
+		},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(movements, 1)
+}
+
+func (s *ServiceSuite) TestReserveStockNearestSplits() {
+	s.service.reservationStrategy = model.RESERVATION_STRATEGY_NEAREST
+
+	partUUID := gofakeit.UUID()
+	orderUUID := gofakeit.UUID()
+
+	s.stockRepository.On("ListReservations", s.ctx, orderUUID).Return([]*model.StockMovement{}, nil)
+	s.warehouseRepository.On("ListWarehouses", s.ctx).Return([]*model.Warehouse{
+		{Uuid: baikonurUUID, Location: model.GeoPoint{Latitude: 45.965, Longitude: 63.305}},
+		{Uuid: vostochnyUUID, Location: model.GeoPoint{Latitude: 51.884, Longitude: 128.334}},
+	}, nil)
+	s.partWithLocations(partUUID, 4, 4)
+	s.expectClaim(orderUUID, partUUID)
+	s.expectReservation(partUUID, baikonurUUID, 4)
+	s.expectReservation(partUUID, vostochnyUUID, 2)
+
+	movements, err := s.service.ReserveStock(s.ctx, &model.StockReservation{
+		Reference: orderUUID,
+		Items:     []*model.ReservationItem{{PartUuid: partUUID, Quantity: 6}},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(movements, 2)
+}
+
+func (s *ServiceSuite) TestReserveStockInsufficient() {
+	partUUID := gofakeit.UUID()
+	orderUUID := gofakeit.UUID()
+
+	s.stockRepository.On("ListReservations", s.ctx, orderUUID).Return([]*model.StockMovement{}, nil)
+	s.partWithLocations(partUUID, 1, 1)
+
+	movements, err := s.service.ReserveStock(s.ctx, &model.StockReservation{
+		Reference: orderUUID,
+		Items:     []*model.ReservationItem{{PartUuid: partUUID, Quantity: 3}},
+	})
+	s.Require().ErrorIs(err, model.ErrInsufficientStock)
+	s.Require().Nil(movements)
+}
+
+func (s *ServiceSuite) TestReserveStockIdempotent() {
+	partUUID := gofakeit.UUID()
+	orderUUID := gofakeit.UUID()
+
+	s.stockRepository.On("ListReservations", s.ctx, orderUUID).Return([]*model.StockMovement{
+		{PartUuid: partUUID, WarehouseUuid: baikonurUUID, Type: model.STOCK_MOVEMENT_TYPE_RESERVATION, Quantity: -2},
+	}, nil)
+
+	movements, err := s.service.ReserveStock(s.ctx, &model.StockReservation{
+		Reference: orderUUID,
+		Items:     []*model.ReservationItem{{PartUuid: partUUID, Quantity: 2}},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(movements, 1)
+	s.Require().Equal(int64(-2), movements[0].Quantity)
+}
+
+func (s *ServiceSuite) TestReserveStockConcurrentReservation() {
+	partUUID := gofakeit.UUID()
+	orderUUID := gofakeit.UUID()
+
+	// Параллельный вызов оформил резерв между проверкой и транзакцией
+	s.stockRepository.On("ListReservations", s.ctx, orderUUID).Return([]*model.StockMovement{}, nil).Once()
+	s.partWithLocations(partUUID, 2, 8)
+	s.stockRepository.On("ClaimReservation", s.ctx, orderUUID, []string{partUUID}, mock.AnythingOfType("time.Time")).
+		Return(model.ErrReservationExists).Once()
+	s.stockRepository.On("ListReservations", s.ctx, orderUUID).Return([]*model.StockMovement{
+		{PartUuid: partUUID, WarehouseUuid: vostochnyUUID, Type: model.STOCK_MOVEMENT_TYPE_RESERVATION, Quantity: -5},
+	}, nil).Once()
+
+	movements, err := s.service.ReserveStock(s.ctx, &model.StockReservation{
+		Reference: orderUUID,
+		Items:     []*model.ReservationItem{{PartUuid: partUUID, Quantity: 5}},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(movements, 1)
+	s.Require().Equal(int64(-5), movements[0].Quantity)
+	s.stockRepository.AssertNotCalled(s.T(), "ApplyMovement", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestReserveStockInsufficientInTransaction() {
+	partUUID := gofakeit.UUID()
+	orderUUID := gofakeit.UUID()
+
+	// Остаток ушел между чтением детали и списанием: ошибка возвращается из транзакции,
+	// и отметка резерва откатывается вместе с уже записанными движениями
+	s.stockRepository.On("ListReservations", s.ctx, orderUUID).Return([]*model.StockMovement{}, nil)
+	s.partWithLocations(partUUID, 4, 4)
+	s.expectClaim(orderUUID, partUUID)
+	s.expectReservation(partUUID, baikonurUUID, 4)
+	s.stockRepository.On("ApplyMovement", s.ctx, mock.MatchedBy(func(m *model.StockMovement) bool {
+		return m.WarehouseUuid == vostochnyUUID
+	})).Return(nil, model.ErrInsufficientStock).Once()
+
+	movements, err := s.service.ReserveStock(s.ctx, &model.StockReservation{
+		Reference: orderUUID,
+		Items:     []*model.ReservationItem{{PartUuid: partUUID, Quantity: 6}},
+	})
+	s.Require().ErrorIs(err, model.ErrInsufficientStock)
+	s.Require().Nil(movements)
+	s.txManager.AssertCalled(s.T(), "Run", s.ctx, mock.Anything)
+}
+
+func (s *ServiceSuite) TestReleaseStockOutstandingOnly() {
+	partUUID := gofakeit.UUID()
+	orderUUID := gofakeit.UUID()
+
+	s.stockRepository.On("ListReservations", s.ctx, orderUUID).Return([]*model.StockMovement{
+		{PartUuid: partUUID, WarehouseUuid: baikonurUUID, Type: model.STOCK_MOVEMENT_TYPE_RESERVATION, Quantity: -4},
+		{PartUuid: partUUID, WarehouseUuid: vostochnyUUID, Type: model.STOCK_MOVEMENT_TYPE_RESERVATION, Quantity: -2},
+		{PartUuid: partUUID, WarehouseUuid: vostochnyUUID, Type: model.STOCK_MOVEMENT_TYPE_RELEASE, Quantity: 2},
+	}, nil)
+	s.partRepository.On("GetPart", s.ctx, partUUID).Return(&model.Part{Uuid: partUUID}, nil)
+	s.stockRepository.On("ApplyMovement", s.ctx, mock.MatchedBy(func(m *model.StockMovement) bool {
+		return m.WarehouseUuid == baikonurUUID && m.Type == model.STOCK_MOVEMENT_TYPE_RELEASE && m.Quantity == 4
+	})).Return(&model.StockMovement{PartUuid: partUUID, Quantity: 4}, nil).Once()
+
+	movements, err := s.service.ReleaseStock(s.ctx, orderUUID)
+	s.Require().NoError(err)
+	s.Require().Len(movements, 1)
+}
+
+func (s *ServiceSuite) TestConsumeStockOutstandingOnly() {
+	partUUID := gofakeit.UUID()
+	orderUUID := gofakeit.UUID()
+
+	s.stockRepository.On("ListReservations", s.ctx, orderUUID).Return([]*model.StockMovement{
+		{PartUuid: partUUID, WarehouseUuid: baikonurUUID, Type: model.STOCK_MOVEMENT_TYPE_RESERVATION, Quantity: -4},
+		{PartUuid: partUUID, WarehouseUuid: vostochnyUUID, Type: model.STOCK_MOVEMENT_TYPE_RESERVATION, Quantity: -2},
+		{PartUuid: partUUID, WarehouseUuid: vostochnyUUID, Type: model.STOCK_MOVEMENT_TYPE_RELEASE, Quantity: 2},
+	}, nil)
+	s.stockRepository.On("ConsumeReservation", s.ctx,
+		mock.MatchedBy(func(m *model.StockMovement) bool {
+			return m.WarehouseUuid == baikonurUUID && m.Type == model.STOCK_MOVEMENT_TYPE_RELEASE && m.Quantity == 4
+		}),
+		mock.MatchedBy(func(m *model.StockMovement) bool {
+			return m.WarehouseUuid == baikonurUUID && m.Type == model.STOCK_MOVEMENT_TYPE_CONSUMPTION && m.Quantity == -4
+		}),
+	).Return(nil).Once()
+
+	movements, err := s.service.ConsumeStock(s.ctx, orderUUID)
+	s.Require().NoError(err)
+	s.Require().Len(movements, 1)
+	s.Require().Equal(model.STOCK_MOVEMENT_TYPE_CONSUMPTION, movements[0].Type)
+	s.Require().Equal(orderUUID, movements[0].Reference)
+}
+
+func (s *ServiceSuite) TestConsumeStockAlreadyConsumed() {
+	partUUID := gofakeit.UUID()
+	orderUUID := gofakeit.UUID()
+
+	// Резерв уже закрыт прошлым расходом — повторная доставка события ничего не пишет
+	s.stockRepository.On("ListReservations", s.ctx, orderUUID).Return([]*model.StockMovement{
+		{PartUuid: partUUID, WarehouseUuid: baikonurUUID, Type: model.STOCK_MOVEMENT_TYPE_RESERVATION, Quantity: -4},
+		{PartUuid: partUUID, WarehouseUuid: baikonurUUID, Type: model.STOCK_MOVEMENT_TYPE_RELEASE, Quantity: 4},
+	}, nil)
+
+	movements, err := s.service.ConsumeStock(s.ctx, orderUUID)
+	s.Require().NoError(err)
+	s.Require().Empty(movements)
+	s.stockRepository.AssertNotCalled(s.T(), "ConsumeReservation", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestConsumeStockEmptyReference() {
+	_, err := s.service.ConsumeStock(s.ctx, "")
+	s.Require().ErrorIs(err, model.ErrInvalidReservation)
+}
@@ -0,0 +1,45 @@
+package stock
+
+import (
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository"
+	def "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/service"
+)
+
+var _ def.StockService = (*service)(nil)
+
+type service struct {
+	stockRepository     repository.StockRepository
+	partRepository      repository.PartRepository
+	warehouseRepository repository.WarehouseRepository
+	stockProducer       def.StockProducerService
+	txManager           repository.TxManager
+
+	// Склад движений, для которых склад не указан
+	defaultWarehouseUUID string
+	// Правило выбора склада при резервировании и точка отгрузки для стратегии nearest
+	reservationStrategy model.ReservationStrategy
+	reservationOrigin   model.GeoPoint
+}
+
+func NewService(
+	stockRepository repository.StockRepository,
+	partRepository repository.PartRepository,
+	warehouseRepository repository.WarehouseRepository,
+	stockProducer def.StockProducerService,
+	txManager repository.TxManager,
+	defaultWarehouseUUID string,
+	reservationStrategy model.ReservationStrategy,
+	reservationOrigin model.GeoPoint,
+) *service {
+	return &service{
+		stockRepository:      stockRepository,
+		partRepository:       partRepository,
+		warehouseRepository:  warehouseRepository,
+		stockProducer:        stockProducer,
+		txManager:            txManager,
+		defaultWarehouseUUID: defaultWarehouseUUID,
+		reservationStrategy:  reservationStrategy,
+		reservationOrigin:    reservationOrigin,
+	}
+}
@@ -0,0 +1,62 @@
+package stock
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/mocks"
+	serviceMocks "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/service/mocks"
+)
+
+const (
+	baikonurUUID  = "9d4c2a10-7b3e-4f5a-8c6d-2e1f0a9b0001"
+	vostochnyUUID = "9d4c2a10-7b3e-4f5a-8c6d-2e1f0a9b0002"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	ctx                 context.Context
+	stockRepository     *mocks.StockRepository
+	partRepository      *mocks.PartRepository
+	warehouseRepository *mocks.WarehouseRepository
+	stockProducer       *serviceMocks.StockProducerService
+	txManager           *mocks.TxManager
+	service             *service
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctx = context.Background()
+
+	s.stockRepository = mocks.NewStockRepository(s.T())
+	s.partRepository = mocks.NewPartRepository(s.T())
+	s.warehouseRepository = mocks.NewWarehouseRepository(s.T())
+	s.stockProducer = serviceMocks.NewStockProducerService(s.T())
+	s.txManager = mocks.NewTxManager(s.T())
+
+	// Транзакция в тестах просто вызывает fn с тем же контекстом
+	s.txManager.On("Run", s.ctx, mock.Anything).
+		Return(func(ctx context.Context, fn func(ctx context.Context) error) error {
+			return fn(ctx)
+		}).Maybe()
+
+	s.service = NewService(
+		s.stockRepository,
+		s.partRepository,
+		s.warehouseRepository,
+		s.stockProducer,
+		s.txManager,
+		baikonurUUID,
+		model.RESERVATION_STRATEGY_MOST_STOCK,
+		model.GeoPoint{Latitude: 55.92, Longitude: 37.82},
+	)
+}
+
+func (s *ServiceSuite) TearDownTest() {}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
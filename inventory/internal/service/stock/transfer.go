@@ -0,0 +1,64 @@
+package stock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *service) TransferStock(ctx context.Context, transfer *model.StockTransfer) (*model.StockMovement, *model.StockMovement, error) {
+	switch {
+	case transfer.PartUuid == "":
+		return nil, nil, fmt.Errorf("%w: part uuid is required", model.ErrInvalidStockTransfer)
+	case transfer.Quantity <= 0:
+		return nil, nil, fmt.Errorf("%w: quantity must be positive", model.ErrInvalidStockTransfer)
+	case transfer.FromWarehouseUuid == transfer.ToWarehouseUuid:
+		return nil, nil, fmt.Errorf("%w: warehouses must differ", model.ErrInvalidStockTransfer)
+	}
+
+	for _, warehouseUUID := range []string{transfer.FromWarehouseUuid, transfer.ToWarehouseUuid} {
+		if _, err := s.warehouseRepository.GetWarehouse(ctx, warehouseUUID); err != nil {
+			if errors.Is(err, model.ErrWarehouseNotFound) {
+				return nil, nil, fmt.Errorf("%w: %s", err, warehouseUUID)
+			}
+			return nil, nil, fmt.Errorf("failed to get warehouse: %w", err)
+		}
+	}
+
+	now := time.Now()
+	reference := uuid.NewString()
+	outgoing := &model.StockMovement{
+		Uuid:          uuid.NewString(),
+		PartUuid:      transfer.PartUuid,
+		WarehouseUuid: transfer.FromWarehouseUuid,
+		Type:          model.STOCK_MOVEMENT_TYPE_TRANSFER,
+		Quantity:      -transfer.Quantity,
+		Reason:        transfer.Reason,
+		Reference:     reference,
+		CreatedAt:     now,
+	}
+	incoming := &model.StockMovement{
+		Uuid:          uuid.NewString(),
+		PartUuid:      transfer.PartUuid,
+		WarehouseUuid: transfer.ToWarehouseUuid,
+		Type:          model.STOCK_MOVEMENT_TYPE_TRANSFER,
+		Quantity:      transfer.Quantity,
+		Reason:        transfer.Reason,
+		Reference:     reference,
+		CreatedAt:     now,
+	}
+
+	if err := s.stockRepository.TransferStock(ctx, outgoing, incoming); err != nil {
+		if errors.Is(err, model.ErrPartNotFound) || errors.Is(err, model.ErrInsufficientStock) {
+			return nil, nil, err
+		}
+		return nil, nil, fmt.Errorf("failed to transfer stock: %w", err)
+	}
+
+	return outgoing, incoming, nil
+}
@@ -0,0 +1,61 @@
+package stock
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestTransferStock() {
+	partUUID := gofakeit.UUID()
+
+	s.warehouseRepository.On("GetWarehouse", s.ctx, baikonurUUID).Return(&model.Warehouse{Uuid: baikonurUUID}, nil)
+	s.warehouseRepository.On("GetWarehouse", s.ctx, vostochnyUUID).Return(&model.Warehouse{Uuid: vostochnyUUID}, nil)
+	s.stockRepository.On("TransferStock", s.ctx,
+		mock.MatchedBy(func(m *model.StockMovement) bool {
+			return m.WarehouseUuid == baikonurUUID && m.Quantity == -3 && m.Type == model.STOCK_MOVEMENT_TYPE_TRANSFER
+		}),
+		mock.MatchedBy(func(m *model.StockMovement) bool {
+			return m.WarehouseUuid == vostochnyUUID && m.Quantity == 3 && m.Type == model.STOCK_MOVEMENT_TYPE_TRANSFER
+		}),
+	).Return(nil)
+
+	outgoing, incoming, err := s.service.TransferStock(s.ctx, &model.StockTransfer{
+		PartUuid:          partUUID,
+		FromWarehouseUuid: baikonurUUID,
+		ToWarehouseUuid:   vostochnyUUID,
+		Quantity:          3,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(outgoing.Reference, incoming.Reference)
+	s.Require().NotEqual(outgoing.Uuid, incoming.Uuid)
+}
+
+func (s *ServiceSuite) TestTransferStockInvalid() {
+	transfers := []*model.StockTransfer{
+		{FromWarehouseUuid: baikonurUUID, ToWarehouseUuid: vostochnyUUID, Quantity: 1},
+		{PartUuid: gofakeit.UUID(), FromWarehouseUuid: baikonurUUID, ToWarehouseUuid: vostochnyUUID},
+		{PartUuid: gofakeit.UUID(), FromWarehouseUuid: baikonurUUID, ToWarehouseUuid: baikonurUUID, Quantity: 1},
+	}
+
+	for _, transfer := range transfers {
+		outgoing, incoming, err := s.service.TransferStock(s.ctx, transfer)
+		s.Require().ErrorIs(err, model.ErrInvalidStockTransfer)
+		s.Require().Nil(outgoing)
+		s.Require().Nil(incoming)
+	}
+}
+
+func (s *ServiceSuite) TestTransferStockInsufficient() {
+	s.warehouseRepository.On("GetWarehouse", s.ctx, mock.Anything).Return(&model.Warehouse{}, nil)
+	s.stockRepository.On("TransferStock", s.ctx, mock.Anything, mock.Anything).Return(model.ErrInsufficientStock)
+
+	_, _, err := s.service.TransferStock(s.ctx, &model.StockTransfer{
+		PartUuid:          gofakeit.UUID(),
+		FromWarehouseUuid: baikonurUUID,
+		ToWarehouseUuid:   vostochnyUUID,
+		Quantity:          100,
+	})
+	s.Require().ErrorIs(err, model.ErrInsufficientStock)
+}
@@ -0,0 +1,44 @@
+package warehouse
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *service) CreateWarehouse(ctx context.Context, warehouse *model.Warehouse) (*model.Warehouse, error) {
+	warehouse.Code = strings.ToUpper(strings.TrimSpace(warehouse.Code))
+	warehouse.Name = strings.TrimSpace(warehouse.Name)
+
+	if err := validateWarehouse(warehouse); err != nil {
+		return nil, err
+	}
+
+	warehouse.Uuid = uuid.NewString()
+	warehouse.CreatedAt = time.Now()
+
+	if err := s.warehouseRepository.CreateWarehouse(ctx, warehouse); err != nil {
+		return nil, err
+	}
+
+	return warehouse, nil
+}
+
+func validateWarehouse(warehouse *model.Warehouse) error {
+	switch {
+	case warehouse.Code == "":
+		return fmt.Errorf("%w: code is required", model.ErrInvalidWarehouse)
+	case warehouse.Name == "":
+		return fmt.Errorf("%w: name is required", model.ErrInvalidWarehouse)
+	case warehouse.Location.Latitude < -90 || warehouse.Location.Latitude > 90:
+		return fmt.Errorf("%w: latitude must be within [-90, 90]", model.ErrInvalidWarehouse)
+	case warehouse.Location.Longitude < -180 || warehouse.Location.Longitude > 180:
+		return fmt.Errorf("%w: longitude must be within [-180, 180]", model.ErrInvalidWarehouse)
+	}
+	return nil
+}
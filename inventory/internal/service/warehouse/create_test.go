@@ -0,0 +1,44 @@
+package warehouse
+
+import (
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *ServiceSuite) TestCreateWarehouseNormalizesCode() {
+	s.warehouseRepository.On("CreateWarehouse", s.ctx, mock.MatchedBy(func(w *model.Warehouse) bool {
+		return w.Uuid != "" && !w.CreatedAt.IsZero() && w.Code == "PLESETSK"
+	})).Return(nil)
+
+	warehouse, err := s.service.CreateWarehouse(s.ctx, &model.Warehouse{
+		Code:     " plesetsk ",
+		Name:     "Плесецк",
+		Location: model.GeoPoint{Latitude: 62.925, Longitude: 40.577},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal("PLESETSK", warehouse.Code)
+}
+
+func (s *ServiceSuite) TestCreateWarehouseInvalid() {
+	warehouses := []*model.Warehouse{
+		{Name: "Плесецк"},
+		{Code: "PLESETSK"},
+		{Code: "PLESETSK", Name: "Плесецк", Location: model.GeoPoint{Latitude: 91}},
+		{Code: "PLESETSK", Name: "Плесецк", Location: model.GeoPoint{Longitude: -181}},
+	}
+
+	for _, warehouse := range warehouses {
+		created, err := s.service.CreateWarehouse(s.ctx, warehouse)
+		s.Require().ErrorIs(err, model.ErrInvalidWarehouse)
+		s.Require().Nil(created)
+	}
+}
+
+func (s *ServiceSuite) TestCreateWarehouseDuplicate() {
+	s.warehouseRepository.On("CreateWarehouse", s.ctx, mock.Anything).Return(model.ErrWarehouseAlreadyExists)
+
+	created, err := s.service.CreateWarehouse(s.ctx, &model.Warehouse{Code: "BAIKONUR", Name: "Байконур"})
+	s.Require().ErrorIs(err, model.ErrWarehouseAlreadyExists)
+	s.Require().Nil(created)
+}
@@ -0,0 +1,11 @@
+package warehouse
+
+import (
+	"context"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/model"
+)
+
+func (s *service) ListWarehouses(ctx context.Context) ([]*model.Warehouse, error) {
+	return s.warehouseRepository.ListWarehouses(ctx)
+}
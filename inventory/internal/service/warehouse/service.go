@@ -0,0 +1,18 @@
+package warehouse
+
+import (
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository"
+	def "github.com/Daniil-Sakharov/RocketFactory/inventory/internal/service"
+)
+
+var _ def.WarehouseService = (*service)(nil)
+
+type service struct {
+	warehouseRepository repository.WarehouseRepository
+}
+
+func NewService(warehouseRepository repository.WarehouseRepository) *service {
+	return &service{
+		warehouseRepository: warehouseRepository,
+	}
+}
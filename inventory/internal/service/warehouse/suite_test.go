@@ -0,0 +1,33 @@
+package warehouse
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/Daniil-Sakharov/RocketFactory/inventory/internal/repository/mocks"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	ctx                 context.Context
+	warehouseRepository *mocks.WarehouseRepository
+	service             *service
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctx = context.Background()
+
+	s.warehouseRepository = mocks.NewWarehouseRepository(s.T())
+
+	s.service = NewService(
+		s.warehouseRepository,
+	)
+}
+
+func (s *ServiceSuite) TearDownTest() {}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
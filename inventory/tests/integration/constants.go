@@ -8,4 +8,17 @@ const (
 
 	// partsCollectionName - имя коллекции MongoDB для деталей космических кораблей
 	partsCollectionName = "parts"
+
+	// rocketModelsCollectionName - имя коллекции MongoDB для моделей ракет
+	rocketModelsCollectionName = "rocket_models"
+
+	// baikonurWarehouseUUID и vostochnyWarehouseUUID - склады, которые приложение создает при старте
+	baikonurWarehouseUUID  = "9d4c2a10-7b3e-4f5a-8c6d-2e1f0a9b0001"
+	vostochnyWarehouseUUID = "9d4c2a10-7b3e-4f5a-8c6d-2e1f0a9b0002"
+
+	// mongoReplicaSet - имя одноузлового replica set MongoDB, как в docker-compose
+	mongoReplicaSet = "rs0"
+
+	// adminToken - токен административных RPC приложения в тестах
+	adminToken = "integration-admin-token"
 )
@@ -3,13 +3,26 @@
 package integration
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/brianvoe/gofakeit/v7"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	inventoryV1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
 )
@@ -104,6 +117,611 @@ var _ = Describe("InventoryService", func() {
 				Expect(part.Category).To(Equal(inventoryV1.Category_CATEGORY_ENGINE))
 			}
 		})
+
+		It("должен фильтровать детали по диапазонам и метаданным", func() {
+			maxPrice := 60000.0
+			minPower := int64(4000)
+
+			resp, err := inventoryClient.ListParts(ctx, &inventoryV1.ListPartsRequest{
+				Filter: &inventoryV1.PartsFilter{
+					Categories:  []inventoryV1.Category{inventoryV1.Category_CATEGORY_ENGINE},
+					Price:       &inventoryV1.DoubleRange{Max: &maxPrice},
+					InStockOnly: true,
+					Metadata: []*inventoryV1.MetadataPredicate{
+						{
+							Key:      "power_output",
+							Operator: inventoryV1.MetadataOperator_METADATA_OPERATOR_GTE,
+							Value:    &inventoryV1.Value{Value: &inventoryV1.Value_Int64Value{Int64Value: minPower}},
+						},
+					},
+				},
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetParts()).ToNot(BeEmpty())
+			for _, part := range resp.GetParts() {
+				Expect(part.Price).To(BeNumerically("<=", maxPrice))
+				Expect(part.StockQuantity).To(BeNumerically(">", 0))
+				Expect(part.GetMetadata()["power_output"].GetInt64Value()).To(BeNumerically(">=", minPower))
+			}
+		})
+
+		It("должен отклонять перевернутый диапазон", func() {
+			minPrice, maxPrice := 100.0, 10.0
+
+			_, err := inventoryClient.ListParts(ctx, &inventoryV1.ListPartsRequest{
+				Filter: &inventoryV1.PartsFilter{
+					Price: &inventoryV1.DoubleRange{Min: &minPrice, Max: &maxPrice},
+				},
+			})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("должен постранично возвращать детали в порядке сортировки", func() {
+			var (
+				pageToken string
+				prices    []float64
+				seen      = make(map[string]struct{})
+			)
+
+			for {
+				resp, err := inventoryClient.ListParts(ctx, &inventoryV1.ListPartsRequest{
+					PageSize:  2,
+					PageToken: pageToken,
+					SortBy:    inventoryV1.PartsSortField_PARTS_SORT_FIELD_PRICE,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(len(resp.GetParts())).To(BeNumerically("<=", 2))
+				Expect(resp.GetTotalSize()).To(Equal(int64(5)))
+
+				for _, part := range resp.GetParts() {
+					Expect(seen).ToNot(HaveKey(part.Uuid), "деталь не должна повторяться на разных страницах")
+					seen[part.Uuid] = struct{}{}
+					prices = append(prices, part.Price)
+				}
+
+				pageToken = resp.GetNextPageToken()
+				if pageToken == "" {
+					break
+				}
+			}
+
+			Expect(seen).To(HaveLen(5))
+			Expect(sort.Float64sAreSorted(prices)).To(BeTrue(), "детали должны быть отсортированы по цене")
+		})
+
+		It("должен отклонять токен страницы с другой сортировкой", func() {
+			resp, err := inventoryClient.ListParts(ctx, &inventoryV1.ListPartsRequest{PageSize: 2})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetNextPageToken()).ToNot(BeEmpty())
+
+			_, err = inventoryClient.ListParts(ctx, &inventoryV1.ListPartsRequest{
+				PageSize:   2,
+				PageToken:  resp.GetNextPageToken(),
+				Descending: true,
+			})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Describe("SearchParts", func() {
+		BeforeEach(func() {
+			err := env.InsertTestParts(ctx)
+			Expect(err).ToNot(HaveOccurred(), "ожидали успешную вставку тестовых деталей в MongoDB")
+		})
+
+		It("должен находить детали по другой словоформе и ранжировать по релевантности", func() {
+			resp, err := inventoryClient.SearchParts(ctx, &inventoryV1.SearchPartsRequest{
+				Query: "двигатели",
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetHits()).ToNot(BeEmpty())
+
+			// Совпадение в названии весит больше, чем в описании
+			Expect(resp.GetHits()[0].GetPart().GetCategory()).To(Equal(inventoryV1.Category_CATEGORY_ENGINE))
+			for i := 1; i < len(resp.GetHits()); i++ {
+				Expect(resp.GetHits()[i-1].GetScore()).To(BeNumerically(">=", resp.GetHits()[i].GetScore()))
+			}
+		})
+
+		It("должен комбинировать поиск с фильтром по категории", func() {
+			resp, err := inventoryClient.SearchParts(ctx, &inventoryV1.SearchPartsRequest{
+				Query: "двигатель",
+				Filter: &inventoryV1.PartsFilter{
+					Categories: []inventoryV1.Category{inventoryV1.Category_CATEGORY_FUEL},
+				},
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetHits()).ToNot(BeEmpty())
+			for _, hit := range resp.GetHits() {
+				Expect(hit.GetPart().GetCategory()).To(Equal(inventoryV1.Category_CATEGORY_FUEL))
+			}
+		})
+
+		It("должен отклонять пустой запрос", func() {
+			_, err := inventoryClient.SearchParts(ctx, &inventoryV1.SearchPartsRequest{Query: " "})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Describe("ValidateConfiguration", func() {
+		insertPart := func(category inventoryV1.Category) string {
+			part := env.GetTestPartData()
+			part.Category = category
+
+			partUUID, err := env.InsertTestPartWithData(ctx, part)
+			Expect(err).ToNot(HaveOccurred(), "ожидали успешную вставку детали в MongoDB")
+			return partUUID
+		}
+
+		It("должен отклонять конфигурацию без двигателя с пояснением", func() {
+			wingUUID := insertPart(inventoryV1.Category_CATEGORY_WING)
+
+			resp, err := inventoryClient.ValidateConfiguration(ctx, &inventoryV1.ValidateConfigurationRequest{
+				PartUuids: []string{wingUUID},
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetValid()).To(BeFalse())
+			Expect(resp.GetViolations()).To(HaveLen(1))
+			Expect(resp.GetViolations()[0].GetType()).To(Equal(inventoryV1.CompatibilityRuleType_COMPATIBILITY_RULE_TYPE_REQUIRES))
+			Expect(resp.GetViolations()[0].GetMessage()).To(ContainSubstring("ENGINE"))
+		})
+
+		It("должен принимать конфигурацию с двигателем", func() {
+			engineUUID := insertPart(inventoryV1.Category_CATEGORY_ENGINE)
+			wingUUID := insertPart(inventoryV1.Category_CATEGORY_WING)
+
+			resp, err := inventoryClient.ValidateConfiguration(ctx, &inventoryV1.ValidateConfigurationRequest{
+				PartUuids: []string{engineUUID, wingUUID},
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetValid()).To(BeTrue())
+			Expect(resp.GetViolations()).To(BeEmpty())
+		})
+
+		It("должен возвращать NotFound для неизвестной детали", func() {
+			_, err := inventoryClient.ValidateConfiguration(ctx, &inventoryV1.ValidateConfigurationRequest{
+				PartUuids: []string{"00000000-0000-0000-0000-000000000000"},
+			})
+
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+
+	Describe("ExpandRocketModel", func() {
+		insertPart := func(stock int64) string {
+			part := env.GetTestPartData()
+			part.StockQuantity = stock
+
+			partUUID, err := env.InsertTestPartWithData(ctx, part)
+			Expect(err).ToNot(HaveOccurred(), "ожидали успешную вставку детали в MongoDB")
+			return partUUID
+		}
+
+		It("должен подменять деталь без остатка альтернативой", func() {
+			engineUUID := insertPart(10)
+			finsUUID := insertPart(1)
+			wingUUID := insertPart(10)
+
+			modelUUID, err := env.InsertTestRocketModel(ctx, []*inventoryV1.BomItem{
+				{PartUuid: engineUUID, Quantity: 3},
+				{PartUuid: finsUUID, Quantity: 4, AlternativePartUuids: []string{wingUUID}},
+			})
+			Expect(err).ToNot(HaveOccurred(), "ожидали успешную вставку модели ракеты в MongoDB")
+
+			resp, err := inventoryClient.ExpandRocketModel(ctx, &inventoryV1.ExpandRocketModelRequest{
+				Uuid:     modelUUID,
+				Quantity: 2,
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetAvailable()).To(BeTrue())
+			Expect(resp.GetLines()).To(HaveLen(2))
+			Expect(resp.GetLines()[0].GetPart().GetUuid()).To(Equal(engineUUID))
+			Expect(resp.GetLines()[0].GetQuantity()).To(Equal(int64(6)))
+			Expect(resp.GetLines()[1].GetPart().GetUuid()).To(Equal(wingUUID))
+			Expect(resp.GetLines()[1].GetAlternative()).To(BeTrue())
+		})
+
+		It("должен возвращать FailedPrecondition, если детали нет в каталоге", func() {
+			modelUUID, err := env.InsertTestRocketModel(ctx, []*inventoryV1.BomItem{
+				{PartUuid: "00000000-0000-0000-0000-000000000000", Quantity: 1},
+			})
+			Expect(err).ToNot(HaveOccurred(), "ожидали успешную вставку модели ракеты в MongoDB")
+
+			_, err = inventoryClient.ExpandRocketModel(ctx, &inventoryV1.ExpandRocketModelRequest{
+				Uuid: modelUUID,
+			})
+
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+		})
+
+		It("должен возвращать NotFound для неизвестной модели", func() {
+			_, err := inventoryClient.ExpandRocketModel(ctx, &inventoryV1.ExpandRocketModelRequest{
+				Uuid: "00000000-0000-0000-0000-000000000000",
+			})
+
+			Expect(status.Code(err)).To(Equal(codes.NotFound))
+		})
+	})
+
+	Describe("ExportParts", func() {
+		It("должен выгружать детали выбранной категории в NDJSON", func() {
+			err := env.InsertTestParts(ctx)
+			Expect(err).ToNot(HaveOccurred(), "ожидали успешную вставку тестовых деталей в MongoDB")
+
+			stream, err := inventoryClient.ExportParts(ctx, &inventoryV1.ExportPartsRequest{
+				Format: inventoryV1.CatalogFormat_CATALOG_FORMAT_NDJSON,
+				Filter: &inventoryV1.PartsFilter{
+					Categories: []inventoryV1.Category{inventoryV1.Category_CATEGORY_ENGINE},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			var output strings.Builder
+			for {
+				resp, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				Expect(err).ToNot(HaveOccurred())
+				output.Write(resp.GetChunk())
+			}
+
+			lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+			Expect(lines).ToNot(BeEmpty())
+			for _, line := range lines {
+				Expect(line).To(ContainSubstring(`"category":"ENGINE"`))
+			}
+		})
+
+		It("должен отклонять выгрузку без формата", func() {
+			stream, err := inventoryClient.ExportParts(ctx, &inventoryV1.ExportPartsRequest{})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = stream.Recv()
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Describe("Attachments", func() {
+		var partUUID string
+
+		// Сигнатура PNG, по которой сервис проверяет MIME-тип
+		image := append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0x42}, 100*1024)...)
+
+		upload := func(ctx context.Context, content []byte) (*inventoryV1.UploadAttachmentResponse, error) {
+			stream, err := inventoryClient.UploadAttachment(ctx)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = stream.Send(&inventoryV1.UploadAttachmentRequest{
+				Upload: &inventoryV1.AttachmentUpload{
+					PartUuid:    partUUID,
+					Kind:        inventoryV1.AttachmentKind_ATTACHMENT_KIND_IMAGE,
+					FileName:    "engine.png",
+					ContentType: "image/png",
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			for len(content) > 0 {
+				n := min(len(content), 32*1024)
+				if err = stream.Send(&inventoryV1.UploadAttachmentRequest{Chunk: content[:n]}); err != nil {
+					break
+				}
+				content = content[n:]
+			}
+			return stream.CloseAndRecv()
+		}
+
+		BeforeEach(func() {
+			var err error
+			partUUID, err = env.InsertTestPart(ctx)
+			Expect(err).ToNot(HaveOccurred(), "ожидали успешную вставку тестовой детали в MongoDB")
+		})
+
+		It("должен сохранять изображение и отдавать его в детали и при скачивании", func() {
+			adminCtx := metadata.AppendToOutgoingContext(ctx, "admin-token", adminToken)
+
+			resp, err := upload(adminCtx, image)
+			Expect(err).ToNot(HaveOccurred())
+
+			sum := sha256.Sum256(image)
+			attachment := resp.GetAttachment()
+			Expect(attachment.GetSize()).To(Equal(int64(len(image))))
+			Expect(attachment.GetSha256()).To(Equal(hex.EncodeToString(sum[:])))
+
+			part, err := inventoryClient.GetPart(ctx, &inventoryV1.GetPartRequest{Uuid: partUUID})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(part.GetPart().GetAttachments()).To(HaveLen(1))
+			Expect(part.GetPart().GetPrimaryImage().GetUuid()).To(Equal(attachment.GetUuid()))
+
+			download, err := inventoryClient.DownloadAttachment(ctx, &inventoryV1.DownloadAttachmentRequest{
+				Uuid: attachment.GetUuid(),
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			var content []byte
+			for {
+				chunk, err := download.Recv()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				Expect(err).ToNot(HaveOccurred())
+				if chunk.GetAttachment() != nil {
+					Expect(chunk.GetAttachment().GetContentType()).To(Equal("image/png"))
+				}
+				content = append(content, chunk.GetChunk()...)
+			}
+			Expect(content).To(Equal(image))
+		})
+
+		It("должен отклонять файл, не совпадающий с MIME-типом", func() {
+			adminCtx := metadata.AppendToOutgoingContext(ctx, "admin-token", adminToken)
+
+			_, err := upload(adminCtx, []byte("<html></html>"))
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+
+		It("должен требовать admin-token для загрузки", func() {
+			_, err := upload(ctx, image)
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+		})
+	})
+
+	Describe("Prices", func() {
+		var partUUID string
+
+		BeforeEach(func() {
+			var err error
+			partUUID, err = env.InsertTestPart(ctx)
+			Expect(err).ToNot(HaveOccurred(), "ожидали успешную вставку тестовой детали в MongoDB")
+		})
+
+		It("должен применять запланированную цену только с даты вступления в силу", func() {
+			adminCtx := metadata.AppendToOutgoingContext(ctx, "admin-token", adminToken)
+			effectiveFrom := time.Now().Add(30 * 24 * time.Hour)
+
+			current, err := inventoryClient.GetPartPrices(ctx, &inventoryV1.GetPartPricesRequest{
+				PartUuids: []string{partUUID},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(current.GetPrices()).To(HaveLen(1))
+			Expect(current.GetPrices()[0].GetEffectiveFrom()).To(BeNil(), "без истории цена берется из карточки")
+			cardPrice := current.GetPrices()[0].GetPrice()
+
+			_, err = inventoryClient.SchedulePartPrice(adminCtx, &inventoryV1.SchedulePartPriceRequest{
+				PartUuid:      partUUID,
+				Price:         cardPrice + 1000,
+				EffectiveFrom: timestamppb.New(effectiveFrom),
+				Reason:        "повышение цены поставщиком",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			now, err := inventoryClient.GetPartPrices(ctx, &inventoryV1.GetPartPricesRequest{
+				PartUuids: []string{partUUID},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(now.GetPrices()[0].GetPrice()).To(Equal(cardPrice))
+
+			future, err := inventoryClient.GetPartPrices(ctx, &inventoryV1.GetPartPricesRequest{
+				PartUuids: []string{partUUID},
+				At:        timestamppb.New(effectiveFrom.Add(time.Hour)),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(future.GetPrices()[0].GetPrice()).To(Equal(cardPrice + 1000))
+
+			history, err := inventoryClient.ListPriceHistory(ctx, &inventoryV1.ListPriceHistoryRequest{PartUuid: partUUID})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(history.GetPriceChanges()).To(HaveLen(1))
+			Expect(history.GetPriceChanges()[0].GetApplied()).To(BeFalse())
+		})
+
+		It("должен отклонять цену с датой в прошлом", func() {
+			adminCtx := metadata.AppendToOutgoingContext(ctx, "admin-token", adminToken)
+
+			_, err := inventoryClient.SchedulePartPrice(adminCtx, &inventoryV1.SchedulePartPriceRequest{
+				PartUuid:      partUUID,
+				Price:         1000,
+				EffectiveFrom: timestamppb.New(time.Now().Add(-time.Hour)),
+			})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Describe("Warehouses", func() {
+		var partUUID string
+
+		BeforeEach(func() {
+			var err error
+			partUUID, err = env.InsertTestPart(ctx)
+			Expect(err).ToNot(HaveOccurred(), "ожидали успешную вставку тестовой детали в MongoDB")
+		})
+
+		locationQuantity := func(availability *inventoryV1.PartAvailability, warehouseUUID string) int64 {
+			for _, location := range availability.GetLocations() {
+				if location.GetWarehouseUuid() == warehouseUUID {
+					return location.GetQuantity()
+				}
+			}
+			return 0
+		}
+
+		It("должен перемещать остаток между складами и резервировать со склада с наибольшим остатком", func() {
+			adminCtx := metadata.AppendToOutgoingContext(ctx, "admin-token", adminToken)
+
+			warehouses, err := inventoryClient.ListWarehouses(ctx, &inventoryV1.ListWarehousesRequest{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(warehouses.GetWarehouses())).To(BeNumerically(">=", 2))
+
+			_, err = inventoryClient.ReceiveStock(adminCtx, &inventoryV1.ReceiveStockRequest{
+				PartUuid:      partUUID,
+				Quantity:      10,
+				WarehouseUuid: vostochnyWarehouseUUID,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			transfer, err := inventoryClient.TransferStock(adminCtx, &inventoryV1.TransferStockRequest{
+				PartUuid:          partUUID,
+				FromWarehouseUuid: vostochnyWarehouseUUID,
+				ToWarehouseUuid:   baikonurWarehouseUUID,
+				Quantity:          4,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(transfer.GetOutgoing().GetQuantity()).To(Equal(int64(-4)))
+			Expect(transfer.GetIncoming().GetQuantity()).To(Equal(int64(4)))
+
+			availability, err := inventoryClient.GetStockAvailability(ctx, &inventoryV1.GetStockAvailabilityRequest{
+				PartUuids: []string{partUUID},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(locationQuantity(availability.GetAvailability()[0], vostochnyWarehouseUUID)).To(Equal(int64(6)))
+			Expect(locationQuantity(availability.GetAvailability()[0], baikonurWarehouseUUID)).To(Equal(int64(4)))
+
+			reference := gofakeit.UUID()
+			_, err = inventoryClient.ReserveStock(ctx, &inventoryV1.ReserveStockRequest{
+				Reference: reference,
+				Items:     []*inventoryV1.ReservationItem{{PartUuid: partUUID, Quantity: 5}},
+			})
+			Expect(status.Code(err)).To(Equal(codes.Unauthenticated), "резерв без admin-token должен отклоняться")
+
+			reserved, err := inventoryClient.ReserveStock(adminCtx, &inventoryV1.ReserveStockRequest{
+				Reference: reference,
+				Items:     []*inventoryV1.ReservationItem{{PartUuid: partUUID, Quantity: 5}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reserved.GetMovements()).To(HaveLen(1))
+			Expect(reserved.GetMovements()[0].GetWarehouseUuid()).To(Equal(vostochnyWarehouseUUID))
+
+			released, err := inventoryClient.ReleaseStock(adminCtx, &inventoryV1.ReleaseStockRequest{Reference: reference})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(released.GetMovements()).To(HaveLen(1))
+			Expect(released.GetMovements()[0].GetQuantity()).To(Equal(int64(5)))
+		})
+
+		It("должен отклонять перемещение сверх остатка склада", func() {
+			adminCtx := metadata.AppendToOutgoingContext(ctx, "admin-token", adminToken)
+
+			_, err := inventoryClient.TransferStock(adminCtx, &inventoryV1.TransferStockRequest{
+				PartUuid:          partUUID,
+				FromWarehouseUuid: vostochnyWarehouseUUID,
+				ToWarehouseUuid:   baikonurWarehouseUUID,
+				Quantity:          1_000_000,
+			})
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+		})
+	})
+
+	Describe("Categories", func() {
+		It("должен включать детали подкатегорий в фильтр по родительской категории", func() {
+			adminCtx := metadata.AppendToOutgoingContext(ctx, "admin-token", adminToken)
+			code := "TEST_ENGINE_" + strings.ToUpper(gofakeit.LetterN(6))
+
+			created, err := inventoryClient.CreateCategory(adminCtx, &inventoryV1.CreateCategoryRequest{
+				Category: &inventoryV1.PartCategory{
+					Code:        code,
+					ParentCode:  "ENGINE",
+					DisplayName: "Тестовая подкатегория двигателей",
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created.GetCategory().GetParentCode()).To(Equal("ENGINE"))
+
+			partUUID, err := env.InsertTestPart(ctx)
+			Expect(err).ToNot(HaveOccurred(), "ожидали успешную вставку тестовой детали в MongoDB")
+
+			updated, err := inventoryClient.UpdatePart(adminCtx, &inventoryV1.UpdatePartRequest{
+				Part:       &inventoryV1.Part{Uuid: partUUID, CategoryCode: code, Version: 1},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"category"}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated.GetPart().GetCategoryCode()).To(Equal(code))
+			Expect(updated.GetPart().GetVersion()).To(Equal(int64(2)))
+
+			_, err = inventoryClient.UpdatePart(adminCtx, &inventoryV1.UpdatePartRequest{
+				Part:       &inventoryV1.Part{Uuid: partUUID, CategoryCode: "ENGINE", Version: 1},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"category"}},
+			})
+			Expect(status.Code(err)).To(Equal(codes.Aborted), "ожидали отказ при устаревшей версии детали")
+
+			resp, err := inventoryClient.ListParts(ctx, &inventoryV1.ListPartsRequest{
+				Filter: &inventoryV1.PartsFilter{
+					Uuids:         []string{partUUID},
+					CategoryCodes: []string{"ENGINE"},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetParts()).To(HaveLen(1))
+
+			_, err = inventoryClient.DeleteCategory(adminCtx, &inventoryV1.DeleteCategoryRequest{Code: code})
+			Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+
+			_, err = inventoryClient.DeletePart(adminCtx, &inventoryV1.DeletePartRequest{Uuid: partUUID})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = inventoryClient.DeleteCategory(adminCtx, &inventoryV1.DeleteCategoryRequest{Code: code})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("должен наследовать схему метаданных и проверять по ней детали", func() {
+			adminCtx := metadata.AppendToOutgoingContext(ctx, "admin-token", adminToken)
+			code := "TEST_ENGINE_" + strings.ToUpper(gofakeit.LetterN(6))
+			maxChambers := 40.0
+
+			_, err := inventoryClient.CreateCategory(adminCtx, &inventoryV1.CreateCategoryRequest{
+				Category: &inventoryV1.PartCategory{
+					Code:        code,
+					ParentCode:  "ENGINE",
+					DisplayName: "Тестовая подкатегория двигателей",
+					MetadataSchema: []*inventoryV1.MetadataField{{
+						Key:      "камер",
+						Type:     inventoryV1.MetadataFieldType_METADATA_FIELD_TYPE_INTEGER,
+						Required: true,
+						Range:    &inventoryV1.DoubleRange{Max: &maxChambers},
+					}},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			schema, err := inventoryClient.GetCategorySchema(ctx, &inventoryV1.GetCategorySchemaRequest{Code: code})
+			Expect(err).ToNot(HaveOccurred())
+			keys := make([]string, 0, len(schema.GetFields()))
+			for _, field := range schema.GetFields() {
+				keys = append(keys, field.GetKey())
+			}
+			Expect(keys).To(ContainElements("тяга", "камер"))
+
+			partUUID, err := env.InsertTestPart(ctx)
+			Expect(err).ToNot(HaveOccurred(), "ожидали успешную вставку тестовой детали в MongoDB")
+
+			_, err = inventoryClient.UpdatePart(adminCtx, &inventoryV1.UpdatePartRequest{
+				Part:       &inventoryV1.Part{Uuid: partUUID, CategoryCode: code, Version: 1},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"category"}},
+			})
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+			_, err = inventoryClient.UpdatePart(adminCtx, &inventoryV1.UpdatePartRequest{
+				Part: &inventoryV1.Part{
+					Uuid:         partUUID,
+					CategoryCode: code,
+					Version:      1,
+					Metadata: map[string]*inventoryV1.Value{
+						"камер": {Value: &inventoryV1.Value_Int64Value{Int64Value: 33}},
+					},
+				},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"category", "metadata"}},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = inventoryClient.DeletePart(adminCtx, &inventoryV1.DeletePartRequest{Uuid: partUUID})
+			Expect(err).ToNot(HaveOccurred())
+			_, err = inventoryClient.DeleteCategory(adminCtx, &inventoryV1.DeleteCategoryRequest{Code: code})
+			Expect(err).ToNot(HaveOccurred())
+		})
 	})
 
 	Describe("GetPart", func() {
@@ -141,6 +759,47 @@ var _ = Describe("InventoryService", func() {
 
 			Expect(err).To(HaveOccurred(), "ожидали ошибку при запросе несуществующей детали")
 		})
+
+		It("должен возвращать только поля из маски чтения", func() {
+			resp, err := inventoryClient.GetPart(ctx, &inventoryV1.GetPartRequest{
+				Uuid:     testPartUUID,
+				ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"name", "price"}},
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetPart().GetUuid()).To(Equal(testPartUUID))
+			Expect(resp.GetPart().GetName()).ToNot(BeEmpty())
+			Expect(resp.GetPart().GetPrice()).To(BeNumerically(">", 0))
+			Expect(resp.GetPart().GetDescription()).To(BeEmpty())
+			Expect(resp.GetPart().GetDimensions()).To(BeNil())
+			Expect(resp.GetPart().GetMetadata()).To(BeEmpty())
+		})
+
+		It("должен возвращать детали пакетом в порядке запроса", func() {
+			secondUUID, err := env.InsertTestPart(ctx)
+			Expect(err).ToNot(HaveOccurred(), "ожидали успешную вставку тестовой детали в MongoDB")
+			missingUUID := gofakeit.UUID()
+
+			resp, err := inventoryClient.BatchGetParts(ctx, &inventoryV1.BatchGetPartsRequest{
+				Uuids:    []string{secondUUID, missingUUID, testPartUUID},
+				ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"uuid", "name", "price"}},
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.GetParts()).To(HaveLen(2))
+			Expect(resp.GetParts()[0].GetUuid()).To(Equal(secondUUID))
+			Expect(resp.GetParts()[1].GetUuid()).To(Equal(testPartUUID))
+			Expect(resp.GetMissingUuids()).To(Equal([]string{missingUUID}))
+		})
+
+		It("должен отклонять неизвестное поле в маске чтения", func() {
+			_, err := inventoryClient.GetPart(ctx, &inventoryV1.GetPartRequest{
+				Uuid:     testPartUUID,
+				ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"deleted_at"}},
+			})
+
+			Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+		})
 	})
 
 	Describe("Полный сценарий работы с инвентарем", func() {
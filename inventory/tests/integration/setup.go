@@ -27,9 +27,13 @@ const (
 	// Переменные окружения приложения
 	grpcHostKey = "GRPC_HOST"
 	grpcPortKey = "GRPC_PORT"
+	httpHostKey = "HTTP_HOST"
+	httpPortKey = "HTTP_PORT"
 
 	// Значения переменных окружения
 	grpcHostValue    = "0.0.0.0"
+	httpHostValue    = "0.0.0.0"
+	httpPortValue    = "8082"
 	loggerLevelValue = "debug"
 	startupTimeout   = 45 * time.Minute // Увеличен для сборки Docker образа (go mod download занимает ~15 минут)
 )
@@ -62,6 +66,8 @@ func setupTestEnvironment(ctx context.Context) *TestEnvironment {
 		mongo.WithImageName(mongoImageName),
 		mongo.WithDatabase(mongoDatabase),
 		mongo.WithAuth(mongoUsername, mongoPassword),
+		// Остатки пишутся в транзакциях, а они требуют replica set
+		mongo.WithReplicaSet(mongoReplicaSet),
 		mongo.WithLogger(logger.Logger()),
 	)
 	if err != nil {
@@ -82,8 +88,11 @@ func setupTestEnvironment(ctx context.Context) *TestEnvironment {
 		testcontainers.MongoAuthDBKey:   getEnvWithLogging(ctx, testcontainers.MongoAuthDBKey),
 		grpcHostKey:                     grpcHostValue,
 		grpcPortKey:                     grpcPort,
+		httpHostKey:                     httpHostValue,
+		httpPortKey:                     httpPortValue,
 		"LOGGER_LEVEL":                  loggerLevelValue,
 		"LOGGER_AS_JSON":                "true",
+		"ADMIN_TOKEN":                   adminToken,
 	}
 
 	logger.Info(ctx, "🚀 Starting app container",
@@ -50,6 +50,7 @@ func (env *TestEnvironment) InsertTestPart(ctx context.Context) (string, error)
 			"quality":        "premium",
 			"warranty_years": int64(5),
 		},
+		"version":    int64(1),
 		"created_at": primitive.NewDateTimeFromTime(now),
 		"updated_at": primitive.NewDateTimeFromTime(now),
 	}
@@ -108,6 +109,7 @@ func (env *TestEnvironment) InsertTestParts(ctx context.Context) error {
 			},
 			"tags":       []string{"ion", "efficient", "space"},
 			"metadata":   bson.M{"power_output": int64(5000), "fuel_type": "xenon"},
+			"version":    int64(1),
 			"created_at": primitive.NewDateTimeFromTime(now),
 			"updated_at": primitive.NewDateTimeFromTime(now),
 		},
@@ -133,6 +135,7 @@ func (env *TestEnvironment) InsertTestParts(ctx context.Context) error {
 			},
 			"tags":       []string{"xenon", "fuel", "premium"},
 			"metadata":   bson.M{"purity": "99.999%", "volume_liters": int64(10)},
+			"version":    int64(1),
 			"created_at": primitive.NewDateTimeFromTime(now),
 			"updated_at": primitive.NewDateTimeFromTime(now),
 		},
@@ -158,6 +161,7 @@ func (env *TestEnvironment) InsertTestParts(ctx context.Context) error {
 			},
 			"tags":       []string{"porthole", "panoramic", "protected"},
 			"metadata":   bson.M{"layers": int64(5), "uv_protection": true},
+			"version":    int64(1),
 			"created_at": primitive.NewDateTimeFromTime(now),
 			"updated_at": primitive.NewDateTimeFromTime(now),
 		},
@@ -183,6 +187,7 @@ func (env *TestEnvironment) InsertTestParts(ctx context.Context) error {
 			},
 			"tags":       []string{"wing", "titanium", "adjustable"},
 			"metadata":   bson.M{"material": "titanium-alloy", "max_speed_kmh": int64(25000)},
+			"version":    int64(1),
 			"created_at": primitive.NewDateTimeFromTime(now),
 			"updated_at": primitive.NewDateTimeFromTime(now),
 		},
@@ -208,6 +213,7 @@ func (env *TestEnvironment) InsertTestParts(ctx context.Context) error {
 			},
 			"tags":       []string{"plasma", "compact", "next-gen"},
 			"metadata":   bson.M{"power_output": int64(7500), "fuel_type": "plasma"},
+			"version":    int64(1),
 			"created_at": primitive.NewDateTimeFromTime(now),
 			"updated_at": primitive.NewDateTimeFromTime(now),
 		},
@@ -260,6 +266,7 @@ func (env *TestEnvironment) InsertTestPartWithData(ctx context.Context, part *in
 		"price":          part.Price,
 		"stock_quantity": part.StockQuantity,
 		"category":       categoryStr,
+		"version":        int64(1),
 		"created_at":     primitive.NewDateTimeFromTime(createdAt),
 		"updated_at":     primitive.NewDateTimeFromTime(updatedAt),
 	}
@@ -354,6 +361,41 @@ func (env *TestEnvironment) GetTestPartData() *inventoryV1.Part {
 	}
 }
 
+// InsertTestRocketModel — вставляет модель ракеты из переданных строк спецификации и возвращает ее UUID
+func (env *TestEnvironment) InsertTestRocketModel(ctx context.Context, items []*inventoryV1.BomItem) (string, error) {
+	modelUUID := gofakeit.UUID()
+
+	bomItems := make([]bson.M, 0, len(items))
+	for _, item := range items {
+		bomItems = append(bomItems, bson.M{
+			"part_uuid":    item.GetPartUuid(),
+			"quantity":     item.GetQuantity(),
+			"alternatives": item.GetAlternativePartUuids(),
+		})
+	}
+
+	modelDoc := bson.M{
+		"_id":        modelUUID,
+		"uuid":       modelUUID,
+		"name":       gofakeit.AppName(),
+		"items":      bomItems,
+		"created_at": primitive.NewDateTimeFromTime(time.Now()),
+	}
+
+	// Используем базу данных из переменной окружения MONGO_DATABASE
+	databaseName := os.Getenv("MONGO_DATABASE")
+	if databaseName == "" {
+		databaseName = "inventory" // fallback значение должно совпадать с .env
+	}
+
+	_, err := env.Mongo.Client().Database(databaseName).Collection(rocketModelsCollectionName).InsertOne(ctx, modelDoc)
+	if err != nil {
+		return "", err
+	}
+
+	return modelUUID, nil
+}
+
 // ClearPartsCollection — удаляет все записи из коллекции parts
 func (env *TestEnvironment) ClearPartsCollection(ctx context.Context) error {
 	// Используем базу данных из переменной окружения MONGO_DATABASE
@@ -44,7 +44,7 @@ func (a *App) initDeps(ctx context.Context) error {
 }
 
 func (a *App) Run(ctx context.Context) error {
-	errCh := make(chan error, 3)
+	errCh := make(chan error, 5)
 
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
@@ -61,6 +61,18 @@ func (a *App) Run(ctx context.Context) error {
 		}
 	}()
 
+	go func() {
+		if err := a.runReceiptIssuedConsumer(ctx); err != nil {
+			errCh <- errors.Errorf("ReceiptIssued consumer crashed: %v", err)
+		}
+	}()
+
+	go func() {
+		if err := a.runStockAlertConsumer(ctx); err != nil {
+			errCh <- errors.Errorf("StockAlert consumer crashed: %v", err)
+		}
+	}()
+
 	go func() {
 		a.runTelegramBot(ctx)
 	}()
@@ -117,6 +129,28 @@ func (a *App) runShipAssembledConsumer(ctx context.Context) error {
 	return nil
 }
 
+func (a *App) runReceiptIssuedConsumer(ctx context.Context) error {
+	logger.Info(ctx, "🚀 ReceiptIssued Kafka consumer starting")
+
+	err := a.diContainer.ReceiptIssuedConsumerService().RunReceiptConsumer(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (a *App) runStockAlertConsumer(ctx context.Context) error {
+	logger.Info(ctx, "🚀 StockAlert Kafka consumer starting")
+
+	err := a.diContainer.StockAlertConsumerService().RunStockAlertConsumer(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (a *App) runTelegramBot(ctx context.Context) {
 	logger.Info(ctx, "🤖 Starting Telegram Bot service")
 
@@ -15,7 +15,9 @@ import (
 	"github.com/Daniil-Sakharov/RocketFactory/notification/internal/converter/kafka/decoder"
 	"github.com/Daniil-Sakharov/RocketFactory/notification/internal/service"
 	orderPaidConsumer "github.com/Daniil-Sakharov/RocketFactory/notification/internal/service/consumer/order_paid_consumer"
+	receiptIssuedConsumer "github.com/Daniil-Sakharov/RocketFactory/notification/internal/service/consumer/receipt_issued_consumer"
 	shipAssemledConsumer "github.com/Daniil-Sakharov/RocketFactory/notification/internal/service/consumer/ship_assembly_consumer"
+	stockAlertConsumer "github.com/Daniil-Sakharov/RocketFactory/notification/internal/service/consumer/stock_alert_consumer"
 	"github.com/Daniil-Sakharov/RocketFactory/notification/internal/service/telegram"
 	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/closer"
 	wrappedKafka "github.com/Daniil-Sakharov/RocketFactory/platform/pkg/kafka"
@@ -29,14 +31,22 @@ type diContainer struct {
 	botService                  service.BotService
 	orderPaidConsumerService    service.OrderPaidConsumerService
 	shipAssemblyConsumerService service.ShipAssemblyConsumerService
+	receiptConsumerService      service.ReceiptIssuedConsumerService
+	stockAlertConsumerService   service.StockAlertConsumerService
 
 	orderPaidDecoder     kafkaConverter.OrderDecoder
 	shipAssembledDecoder kafkaConverter.AssemblyDecoder
+	receiptIssuedDecoder kafkaConverter.ReceiptDecoder
+	stockAlertDecoder    kafkaConverter.StockAlertDecoder
 
 	orderPaidConsumerGroup     sarama.ConsumerGroup
 	shipAssembledConsumerGroup sarama.ConsumerGroup
+	receiptIssuedConsumerGroup sarama.ConsumerGroup
 	orderPaidConsumer          wrappedKafka.Consumer
 	shipAssembledConsumer      wrappedKafka.Consumer
+	receiptIssuedConsumer      wrappedKafka.Consumer
+	stockAlertConsumerGroup    sarama.ConsumerGroup
+	stockAlertConsumer         wrappedKafka.Consumer
 
 	telegramBot    *bot.Bot
 	telegramClient httpClient.TelegramClient
@@ -88,6 +98,7 @@ func (d *diContainer) TelegramService() service.TelegramService {
 		d.telegramService = telegram.NewService(
 			d.TelegramClient(),
 			d.TemplateEngine(),
+			config.AppConfig().TelegramBot.OperatorsChatID(),
 		)
 	}
 	return d.telegramService
@@ -206,3 +217,110 @@ func (d *diContainer) ShipAssemblyConsumerService() service.ShipAssemblyConsumer
 	}
 	return d.shipAssemblyConsumerService
 }
+
+func (d *diContainer) ReceiptIssuedDecoder() kafkaConverter.ReceiptDecoder {
+	if d.receiptIssuedDecoder == nil {
+		d.receiptIssuedDecoder = decoder.NewReceiptDecoder()
+	}
+	return d.receiptIssuedDecoder
+}
+
+func (d *diContainer) ReceiptIssuedConsumerGroup() sarama.ConsumerGroup {
+	if d.receiptIssuedConsumerGroup == nil {
+		consumerGroup, err := sarama.NewConsumerGroup(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().ReceiptConsumer.GroupID(),
+			config.AppConfig().ReceiptConsumer.Config(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create receipt_issued consumer group: %s", err.Error()))
+		}
+
+		closer.AddNamed("Kafka ReceiptIssued consumer group", func(ctx context.Context) error {
+			return consumerGroup.Close()
+		})
+
+		d.receiptIssuedConsumerGroup = consumerGroup
+	}
+	return d.receiptIssuedConsumerGroup
+}
+
+func (d *diContainer) ReceiptIssuedConsumer() wrappedKafka.Consumer {
+	if d.receiptIssuedConsumer == nil {
+		d.receiptIssuedConsumer = wrappedKafkaConsumer.NewConsumer(
+			d.ReceiptIssuedConsumerGroup(),
+			[]string{
+				config.AppConfig().ReceiptConsumer.Topic(),
+			},
+			logger.Logger(),
+			kafkaMiddleware.Logging(logger.Logger()),
+		)
+	}
+	return d.receiptIssuedConsumer
+}
+
+func (d *diContainer) ReceiptIssuedConsumerService() service.ReceiptIssuedConsumerService {
+	if d.receiptConsumerService == nil {
+		d.receiptConsumerService = receiptIssuedConsumer.NewService(
+			d.ReceiptIssuedConsumer(),
+			d.ReceiptIssuedDecoder(),
+			d.TelegramService(),
+		)
+	}
+	return d.receiptConsumerService
+}
+
+func (d *diContainer) StockAlertDecoder() kafkaConverter.StockAlertDecoder {
+	if d.stockAlertDecoder == nil {
+		d.stockAlertDecoder = decoder.NewStockAlertDecoder()
+	}
+	return d.stockAlertDecoder
+}
+
+func (d *diContainer) StockAlertConsumerGroup() sarama.ConsumerGroup {
+	if d.stockAlertConsumerGroup == nil {
+		consumerGroup, err := sarama.NewConsumerGroup(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().StockAlertConsumer.GroupID(),
+			config.AppConfig().StockAlertConsumer.Config(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create stock_alert consumer group: %s", err.Error()))
+		}
+
+		closer.AddNamed("Kafka StockAlert consumer group", func(ctx context.Context) error {
+			return consumerGroup.Close()
+		})
+
+		d.stockAlertConsumerGroup = consumerGroup
+	}
+	return d.stockAlertConsumerGroup
+}
+
+func (d *diContainer) StockAlertConsumer() wrappedKafka.Consumer {
+	if d.stockAlertConsumer == nil {
+		d.stockAlertConsumer = wrappedKafkaConsumer.NewConsumer(
+			d.StockAlertConsumerGroup(),
+			[]string{
+				config.AppConfig().StockAlertConsumer.StockLowTopic(),
+				config.AppConfig().StockAlertConsumer.RestockedTopic(),
+			},
+			logger.Logger(),
+			kafkaMiddleware.Logging(logger.Logger()),
+		)
+	}
+	return d.stockAlertConsumer
+}
+
+func (d *diContainer) StockAlertConsumerService() service.StockAlertConsumerService {
+	if d.stockAlertConsumerService == nil {
+		d.stockAlertConsumerService = stockAlertConsumer.NewService(
+			d.StockAlertConsumer(),
+			d.StockAlertDecoder(),
+			d.TelegramService(),
+			config.AppConfig().StockAlertConsumer.StockLowTopic(),
+			config.AppConfig().StockAlertConsumer.RestockedTopic(),
+		)
+	}
+	return d.stockAlertConsumerService
+}
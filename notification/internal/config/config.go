@@ -11,11 +11,13 @@ import (
 var appConfig *config
 
 type config struct {
-	Logger           LoggerConfig
-	Kafka            KafkaConfig
-	TelegramBot      TelegramBotConfig
-	OrderConsumer    OrderConsumerConfig
-	AssemblyConsumer AssemblyConsumerConfig
+	Logger             LoggerConfig
+	Kafka              KafkaConfig
+	TelegramBot        TelegramBotConfig
+	OrderConsumer      OrderConsumerConfig
+	AssemblyConsumer   AssemblyConsumerConfig
+	ReceiptConsumer    ReceiptConsumerConfig
+	StockAlertConsumer StockAlertConsumerConfig
 }
 
 func Load(path ...string) error {
@@ -32,6 +34,14 @@ func Load(path ...string) error {
 	if err != nil {
 		return err
 	}
+	receiptCfg, err := env.NewReceiptConsumerConfig()
+	if err != nil {
+		return err
+	}
+	stockAlertCfg, err := env.NewStockAlertConsumerConfig()
+	if err != nil {
+		return err
+	}
 	tokenCfg, err := env.NewTelegramBotConfig()
 	if err != nil {
 		return err
@@ -48,11 +58,13 @@ func Load(path ...string) error {
 	}
 
 	appConfig = &config{
-		Logger:           loggerCfg,
-		Kafka:            kafkaCfg,
-		OrderConsumer:    orderCfg,
-		AssemblyConsumer: assemblyCfg,
-		TelegramBot:      tokenCfg,
+		Logger:             loggerCfg,
+		Kafka:              kafkaCfg,
+		OrderConsumer:      orderCfg,
+		AssemblyConsumer:   assemblyCfg,
+		ReceiptConsumer:    receiptCfg,
+		StockAlertConsumer: stockAlertCfg,
+		TelegramBot:        tokenCfg,
 	}
 
 	return nil
@@ -0,0 +1,36 @@
+package env
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type receiptConsumerEnvConfig struct {
+	Topic   string `env:"RECEIPT_ISSUED_TOPIC_NAME,required"`
+	GroupID string `env:"RECEIPT_ISSUED_CONSUMER_GROUP_ID,required"`
+}
+
+type receiptConsumerConfig struct {
+	raw receiptConsumerEnvConfig
+}
+
+func NewReceiptConsumerConfig() (*receiptConsumerConfig, error) {
+	var raw receiptConsumerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &receiptConsumerConfig{raw: raw}, nil
+}
+
+func (cfg *receiptConsumerConfig) Topic() string {
+	return cfg.raw.Topic
+}
+
+func (cfg *receiptConsumerConfig) GroupID() string {
+	return cfg.raw.GroupID
+}
+
+func (cfg *receiptConsumerConfig) Config() *sarama.Config {
+	return newConsumerSaramaConfig()
+}
@@ -0,0 +1,41 @@
+package env
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type stockAlertConsumerEnvConfig struct {
+	StockLowTopic  string `env:"PART_STOCK_LOW_TOPIC_NAME,required"`
+	RestockedTopic string `env:"PART_RESTOCKED_TOPIC_NAME,required"`
+	GroupID        string `env:"STOCK_ALERT_CONSUMER_GROUP_ID,required"`
+}
+
+type stockAlertConsumerConfig struct {
+	raw stockAlertConsumerEnvConfig
+}
+
+func NewStockAlertConsumerConfig() (*stockAlertConsumerConfig, error) {
+	var raw stockAlertConsumerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &stockAlertConsumerConfig{raw: raw}, nil
+}
+
+func (cfg *stockAlertConsumerConfig) StockLowTopic() string {
+	return cfg.raw.StockLowTopic
+}
+
+func (cfg *stockAlertConsumerConfig) RestockedTopic() string {
+	return cfg.raw.RestockedTopic
+}
+
+func (cfg *stockAlertConsumerConfig) GroupID() string {
+	return cfg.raw.GroupID
+}
+
+func (cfg *stockAlertConsumerConfig) Config() *sarama.Config {
+	return newConsumerSaramaConfig()
+}
@@ -4,6 +4,8 @@ import "github.com/caarlos0/env/v11"
 
 type telegramBotEnvConfig struct {
 	Token string `env:"TELEGRAM_BOT_TOKEN,required"`
+	// Чат операторов склада для оповещений об остатках, 0 — основной чат
+	OperatorsChatID int64 `env:"TELEGRAM_OPERATORS_CHAT_ID"`
 }
 
 type telegramBotConfig struct {
@@ -22,3 +24,7 @@ func NewTelegramBotConfig() (*telegramBotConfig, error) {
 func (cfg *telegramBotConfig) Token() string {
 	return cfg.raw.Token
 }
+
+func (cfg *telegramBotConfig) OperatorsChatID() int64 {
+	return cfg.raw.OperatorsChatID
+}
@@ -9,6 +9,7 @@ type LoggerConfig interface {
 
 type TelegramBotConfig interface {
 	Token() string
+	OperatorsChatID() int64
 }
 
 type KafkaConfig interface {
@@ -25,3 +26,16 @@ type AssemblyConsumerConfig interface {
 	GroupID() string
 	Config() *sarama.Config
 }
+
+type ReceiptConsumerConfig interface {
+	Topic() string
+	GroupID() string
+	Config() *sarama.Config
+}
+
+type StockAlertConsumerConfig interface {
+	StockLowTopic() string
+	RestockedTopic() string
+	GroupID() string
+	Config() *sarama.Config
+}
@@ -0,0 +1,39 @@
+package decoder
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	def "github.com/Daniil-Sakharov/RocketFactory/notification/internal/converter/kafka"
+	"github.com/Daniil-Sakharov/RocketFactory/notification/internal/model/domain"
+	eventsv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/events/v1"
+)
+
+var _ def.ReceiptDecoder = (*receiptDecoder)(nil)
+
+type receiptDecoder struct{}
+
+func NewReceiptDecoder() *receiptDecoder {
+	return &receiptDecoder{}
+}
+
+func (d *receiptDecoder) ReceiptDecode(data []byte) (domain.ReceiptConsumeEvent, error) {
+	var pb eventsv1.ReceiptIssued
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return domain.ReceiptConsumeEvent{}, fmt.Errorf("failed to unmarshal protobuf: %w", err)
+	}
+
+	return domain.ReceiptConsumeEvent{
+		EventUUID:       pb.EventUuid,
+		ReceiptUUID:     pb.ReceiptUuid,
+		TransactionUUID: pb.TransactionUuid,
+		OrderUUID:       pb.OrderUuid,
+		UserUUID:        pb.UserUuid,
+		PaymentMethod:   pb.PaymentMethod,
+		Total:           pb.Total,
+		VatAmount:       pb.VatAmount,
+		Text:            pb.Text,
+		IssuedAt:        pb.GetIssuedAt().AsTime(),
+	}, nil
+}
@@ -0,0 +1,51 @@
+package decoder
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	def "github.com/Daniil-Sakharov/RocketFactory/notification/internal/converter/kafka"
+	"github.com/Daniil-Sakharov/RocketFactory/notification/internal/model/domain"
+	eventsv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/events/v1"
+)
+
+var _ def.StockAlertDecoder = (*stockAlertDecoder)(nil)
+
+type stockAlertDecoder struct{}
+
+func NewStockAlertDecoder() *stockAlertDecoder {
+	return &stockAlertDecoder{}
+}
+
+func (d *stockAlertDecoder) StockLowDecode(data []byte) (domain.StockAlertConsumeEvent, error) {
+	var pb eventsv1.PartStockLow
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return domain.StockAlertConsumeEvent{}, fmt.Errorf("failed to unmarshal protobuf: %w", err)
+	}
+
+	return domain.StockAlertConsumeEvent{
+		EventUUID:        pb.EventUuid,
+		PartUUID:         pb.PartUuid,
+		PartName:         pb.PartName,
+		StockQuantity:    pb.StockQuantity,
+		ReorderThreshold: pb.ReorderThreshold,
+		OccurredAt:       pb.GetOccurredAt().AsTime(),
+	}, nil
+}
+
+func (d *stockAlertDecoder) PartRestockedDecode(data []byte) (domain.StockAlertConsumeEvent, error) {
+	var pb eventsv1.PartRestocked
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return domain.StockAlertConsumeEvent{}, fmt.Errorf("failed to unmarshal protobuf: %w", err)
+	}
+
+	return domain.StockAlertConsumeEvent{
+		EventUUID:        pb.EventUuid,
+		PartUUID:         pb.PartUuid,
+		PartName:         pb.PartName,
+		StockQuantity:    pb.StockQuantity,
+		ReorderThreshold: pb.ReorderThreshold,
+		OccurredAt:       pb.GetOccurredAt().AsTime(),
+	}, nil
+}
@@ -9,3 +9,12 @@ type OrderDecoder interface {
 type AssemblyDecoder interface {
 	AssemblyDecode(data []byte) (domain.AssemblyConsumeEvent, error)
 }
+
+type ReceiptDecoder interface {
+	ReceiptDecode(data []byte) (domain.ReceiptConsumeEvent, error)
+}
+
+type StockAlertDecoder interface {
+	StockLowDecode(data []byte) (domain.StockAlertConsumeEvent, error)
+	PartRestockedDecode(data []byte) (domain.StockAlertConsumeEvent, error)
+}
@@ -20,3 +20,21 @@ func ShipAssembledEventToTemplateData(event *domain.AssemblyConsumeEvent) *domai
 		BuildTime: event.BuildTime,
 	}
 }
+
+func ReceiptIssuedEventToTemplateData(event *domain.ReceiptConsumeEvent) *domain.ReceiptTemplateData {
+	return &domain.ReceiptTemplateData{
+		OrderUUID:       event.OrderUUID,
+		TransactionUUID: event.TransactionUUID,
+		Total:           event.Total,
+		Text:            event.Text,
+	}
+}
+
+func StockAlertEventToTemplateData(event *domain.StockAlertConsumeEvent) *domain.StockAlertTemplateData {
+	return &domain.StockAlertTemplateData{
+		PartUUID:         event.PartUUID,
+		PartName:         event.PartName,
+		StockQuantity:    event.StockQuantity,
+		ReorderThreshold: event.ReorderThreshold,
+	}
+}
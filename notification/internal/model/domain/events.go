@@ -16,3 +16,26 @@ type AssemblyConsumeEvent struct {
 	UserUUID  string
 	BuildTime time.Duration
 }
+
+type ReceiptConsumeEvent struct {
+	EventUUID       string
+	ReceiptUUID     string
+	TransactionUUID string
+	OrderUUID       string
+	UserUUID        string
+	PaymentMethod   string
+	Total           float64
+	VatAmount       float64
+	Text            string
+	IssuedAt        time.Time
+}
+
+// StockAlertConsumeEvent - общее событие об остатке детали (PartStockLow или PartRestocked)
+type StockAlertConsumeEvent struct {
+	EventUUID        string
+	PartUUID         string
+	PartName         string
+	StockQuantity    int64
+	ReorderThreshold int64
+	OccurredAt       time.Time
+}
@@ -14,3 +14,17 @@ type OrderTemplateData struct {
 	PaymentMethod   string
 	TransactionUUID string
 }
+
+type ReceiptTemplateData struct {
+	OrderUUID       string
+	TransactionUUID string
+	Total           float64
+	Text            string
+}
+
+type StockAlertTemplateData struct {
+	PartUUID         string
+	PartName         string
+	StockQuantity    int64
+	ReorderThreshold int64
+}
@@ -0,0 +1,44 @@
+package receipt_issued_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	kafkaConverter "github.com/Daniil-Sakharov/RocketFactory/notification/internal/converter/kafka"
+	serv "github.com/Daniil-Sakharov/RocketFactory/notification/internal/service"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/kafka"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+var _ serv.ReceiptIssuedConsumerService = (*service)(nil)
+
+type service struct {
+	receiptIssuedConsumer kafka.Consumer
+	receiptIssuedDecoder  kafkaConverter.ReceiptDecoder
+	telegramService       serv.TelegramService
+}
+
+func NewService(
+	receiptIssuedConsumer kafka.Consumer,
+	receiptIssuedDecoder kafkaConverter.ReceiptDecoder,
+	telegramService serv.TelegramService,
+) *service {
+	return &service{
+		receiptIssuedConsumer: receiptIssuedConsumer,
+		receiptIssuedDecoder:  receiptIssuedDecoder,
+		telegramService:       telegramService,
+	}
+}
+
+func (s *service) RunReceiptConsumer(ctx context.Context) error {
+	logger.Info(ctx, "🚀 Starting ReceiptIssued consumer service")
+
+	err := s.receiptIssuedConsumer.Consume(ctx, s.handleReceiptIssued)
+	if err != nil {
+		logger.Error(ctx, "❌ Failed to consume from payment.receipt.issued topic", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
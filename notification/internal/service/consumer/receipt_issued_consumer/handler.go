@@ -0,0 +1,46 @@
+package receipt_issued_consumer
+
+import (
+	"context"
+	"errors"
+
+	"go.uber.org/zap"
+
+	converter "github.com/Daniil-Sakharov/RocketFactory/notification/internal/converter/telegram"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/kafka/consumer"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+func (s *service) handleReceiptIssued(ctx context.Context, msg consumer.Message) error {
+	event, err := s.receiptIssuedDecoder.ReceiptDecode(msg.Value)
+	if err != nil {
+		logger.Error(ctx, "Failed to decode ReceiptIssued event", zap.Error(err))
+		return err
+	}
+	if event.EventUUID == "" {
+		logger.Error(ctx, "Invalid event: empty event_uuid")
+		return errors.New("invalid event")
+	}
+
+	logger.Info(ctx, "📨 Received ReceiptIssued event",
+		zap.String("topic", msg.Topic),
+		zap.Any("partition", msg.Partition),
+		zap.Any("offset", msg.Offset),
+		zap.String("event_uuid", event.EventUUID),
+		zap.String("receipt_uuid", event.ReceiptUUID),
+		zap.String("transaction_uuid", event.TransactionUUID),
+		zap.String("order_uuid", event.OrderUUID),
+	)
+
+	err = s.telegramService.SendReceiptNotification(ctx, converter.ReceiptIssuedEventToTemplateData(&event))
+	if err != nil {
+		logger.Error(ctx, "Failed to send ReceiptIssued event to telegram", zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "✅ ReceiptIssued event processed successfully",
+		zap.String("receipt_uuid", event.ReceiptUUID),
+	)
+
+	return nil
+}
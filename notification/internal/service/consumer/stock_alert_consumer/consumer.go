@@ -0,0 +1,52 @@
+package stock_alert_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	kafkaConverter "github.com/Daniil-Sakharov/RocketFactory/notification/internal/converter/kafka"
+	serv "github.com/Daniil-Sakharov/RocketFactory/notification/internal/service"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/kafka"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+var _ serv.StockAlertConsumerService = (*service)(nil)
+
+type service struct {
+	stockAlertConsumer kafka.Consumer
+	stockAlertDecoder  kafkaConverter.StockAlertDecoder
+	telegramService    serv.TelegramService
+	stockLowTopic      string
+	restockedTopic     string
+}
+
+// NewService создает консьюмер оповещений об остатках. Один консьюмер читает оба топика,
+// тип события определяется по топику сообщения
+func NewService(
+	stockAlertConsumer kafka.Consumer,
+	stockAlertDecoder kafkaConverter.StockAlertDecoder,
+	telegramService serv.TelegramService,
+	stockLowTopic string,
+	restockedTopic string,
+) *service {
+	return &service{
+		stockAlertConsumer: stockAlertConsumer,
+		stockAlertDecoder:  stockAlertDecoder,
+		telegramService:    telegramService,
+		stockLowTopic:      stockLowTopic,
+		restockedTopic:     restockedTopic,
+	}
+}
+
+func (s *service) RunStockAlertConsumer(ctx context.Context) error {
+	logger.Info(ctx, "🚀 Starting StockAlert consumer service")
+
+	err := s.stockAlertConsumer.Consume(ctx, s.handleStockAlert)
+	if err != nil {
+		logger.Error(ctx, "❌ Failed to consume from inventory stock alert topics", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
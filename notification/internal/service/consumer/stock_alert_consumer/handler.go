@@ -0,0 +1,65 @@
+package stock_alert_consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	converter "github.com/Daniil-Sakharov/RocketFactory/notification/internal/converter/telegram"
+	"github.com/Daniil-Sakharov/RocketFactory/notification/internal/model/domain"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/kafka/consumer"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+func (s *service) handleStockAlert(ctx context.Context, msg consumer.Message) error {
+	var (
+		event domain.StockAlertConsumeEvent
+		err   error
+	)
+
+	switch msg.Topic {
+	case s.stockLowTopic:
+		event, err = s.stockAlertDecoder.StockLowDecode(msg.Value)
+	case s.restockedTopic:
+		event, err = s.stockAlertDecoder.PartRestockedDecode(msg.Value)
+	default:
+		logger.Error(ctx, "Unexpected stock alert topic", zap.String("topic", msg.Topic))
+		return fmt.Errorf("unexpected topic %q", msg.Topic)
+	}
+	if err != nil {
+		logger.Error(ctx, "Failed to decode stock alert event", zap.Error(err))
+		return err
+	}
+	if event.EventUUID == "" {
+		logger.Error(ctx, "Invalid event: empty event_uuid")
+		return errors.New("invalid event")
+	}
+
+	logger.Info(ctx, "📨 Received stock alert event",
+		zap.String("topic", msg.Topic),
+		zap.Any("partition", msg.Partition),
+		zap.Any("offset", msg.Offset),
+		zap.String("event_uuid", event.EventUUID),
+		zap.String("part_uuid", event.PartUUID),
+		zap.Int64("stock_quantity", event.StockQuantity),
+	)
+
+	templateData := converter.StockAlertEventToTemplateData(&event)
+	if msg.Topic == s.stockLowTopic {
+		err = s.telegramService.SendStockLowNotification(ctx, templateData)
+	} else {
+		err = s.telegramService.SendPartRestockedNotification(ctx, templateData)
+	}
+	if err != nil {
+		logger.Error(ctx, "Failed to send stock alert event to telegram", zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "✅ Stock alert event processed successfully",
+		zap.String("part_uuid", event.PartUUID),
+	)
+
+	return nil
+}
@@ -9,6 +9,9 @@ import (
 type TelegramService interface {
 	SendShipAssembledNotification(ctx context.Context, templateData *domain.AssembledTemplateData) error
 	SendOrderPaidNotification(ctx context.Context, templateData *domain.OrderTemplateData) error
+	SendReceiptNotification(ctx context.Context, templateData *domain.ReceiptTemplateData) error
+	SendStockLowNotification(ctx context.Context, templateData *domain.StockAlertTemplateData) error
+	SendPartRestockedNotification(ctx context.Context, templateData *domain.StockAlertTemplateData) error
 }
 
 type OrderPaidConsumerService interface {
@@ -19,6 +22,14 @@ type ShipAssemblyConsumerService interface {
 	RunAssemblyConsumer(ctx context.Context) error
 }
 
+type ReceiptIssuedConsumerService interface {
+	RunReceiptConsumer(ctx context.Context) error
+}
+
+type StockAlertConsumerService interface {
+	RunStockAlertConsumer(ctx context.Context) error
+}
+
 type BotService interface {
 	Start(ctx context.Context)
 }
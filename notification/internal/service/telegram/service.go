@@ -10,14 +10,22 @@ import (
 const chatID = 6871748022
 
 type service struct {
-	telegramClient http.TelegramClient
-	templateEngine *TemplateEngine
+	telegramClient  http.TelegramClient
+	templateEngine  *TemplateEngine
+	operatorsChatID int64
 }
 
-func NewService(telegramClient http.TelegramClient, templateEngine *TemplateEngine) *service {
+// NewService создает сервис уведомлений. Оповещения об остатках уходят в чат операторов,
+// если он не задан — в основной чат
+func NewService(telegramClient http.TelegramClient, templateEngine *TemplateEngine, operatorsChatID int64) *service {
+	if operatorsChatID == 0 {
+		operatorsChatID = chatID
+	}
+
 	return &service{
-		telegramClient: telegramClient,
-		templateEngine: templateEngine,
+		telegramClient:  telegramClient,
+		templateEngine:  templateEngine,
+		operatorsChatID: operatorsChatID,
 	}
 }
 
@@ -38,3 +46,30 @@ func (s *service) SendOrderPaidNotification(ctx context.Context, templateData *d
 
 	return s.telegramClient.SendMessage(ctx, chatID, message)
 }
+
+func (s *service) SendReceiptNotification(ctx context.Context, templateData *domain.ReceiptTemplateData) error {
+	message, err := s.templateEngine.Render("receipt_notification.tmpl", templateData)
+	if err != nil {
+		return err
+	}
+
+	return s.telegramClient.SendMessage(ctx, chatID, message)
+}
+
+func (s *service) SendStockLowNotification(ctx context.Context, templateData *domain.StockAlertTemplateData) error {
+	message, err := s.templateEngine.Render("stock_low_notification.tmpl", templateData)
+	if err != nil {
+		return err
+	}
+
+	return s.telegramClient.SendMessage(ctx, s.operatorsChatID, message)
+}
+
+func (s *service) SendPartRestockedNotification(ctx context.Context, templateData *domain.StockAlertTemplateData) error {
+	message, err := s.templateEngine.Render("restocked_notification.tmpl", templateData)
+	if err != nil {
+		return err
+	}
+
+	return s.telegramClient.SendMessage(ctx, s.operatorsChatID, message)
+}
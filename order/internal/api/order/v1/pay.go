@@ -5,11 +5,18 @@ import (
 
 	"github.com/Daniil-Sakharov/RocketFactory/order/internal/converter"
 	api2 "github.com/Daniil-Sakharov/RocketFactory/order/internal/converter/api"
+	httpMiddleware "github.com/Daniil-Sakharov/RocketFactory/platform/pkg/middleware/http"
 	orderV1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/openapi/order/v1"
 )
 
 func (a *api) PayOrder(ctx context.Context, req *orderV1.PayOrderRequest, params orderV1.PayOrderParams) (orderV1.PayOrderRes, error) {
-	serviceReq := converter.PayOrderRequestToServiceModel(*req, params.OrderUUID.String())
+	// Плательщик - пользователь сессии, проверенной auth middleware. Владелец заказа может отличаться
+	var payerUUID string
+	if user, ok := httpMiddleware.GetUserFromContext(ctx); ok {
+		payerUUID = user.GetUserUuid()
+	}
+
+	serviceReq := converter.PayOrderRequestToServiceModel(*req, params.OrderUUID.String(), payerUUID)
 
 	order, err := a.service.Pay(ctx, serviceReq)
 	if err != nil {
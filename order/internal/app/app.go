@@ -12,6 +12,7 @@ import (
 	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/closer"
 	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/http/health"
 	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+	httpMiddleware "github.com/Daniil-Sakharov/RocketFactory/platform/pkg/middleware/http"
 	orderV1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/openapi/order/v1"
 )
 
@@ -31,7 +32,7 @@ func New(ctx context.Context) (*App, error) {
 }
 
 func (a *App) Run(ctx context.Context) error {
-	errCh := make(chan error, 2)
+	errCh := make(chan error, 3)
 
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
@@ -46,6 +47,12 @@ func (a *App) Run(ctx context.Context) error {
 			errCh <- errors.Errorf("consumer crashed: %v", err)
 		}
 	}()
+	go func() {
+		if err := a.runPaymentConsumer(ctx); err != nil {
+			errCh <- errors.Errorf("payment consumer crashed: %v", err)
+		}
+	}()
+	go a.runClaimReleaser(ctx)
 
 	select {
 	case <-ctx.Done():
@@ -114,7 +121,8 @@ func (a *App) initHTTPServer(ctx context.Context) error {
 		ServiceName: "order-service",
 		Version:     "1.0.0",
 	}))
-	mux.Handle("/api/", server)
+	// Все ручки API требуют сессию: пользователь из Whoami нужен, например, как плательщик заказа
+	mux.Handle("/api/", httpMiddleware.NewAuthMiddleware(a.diContainer.AuthClient()).Handle(server))
 
 	a.httpServer = http.Server{
 		Addr:         config.AppConfig().OrderHTTP.Address(),
@@ -151,3 +159,14 @@ func (a *App) runConsumer(ctx context.Context) error {
 
 	return nil
 }
+
+func (a *App) runPaymentConsumer(ctx context.Context) error {
+	logger.Info(ctx, "🚀 Payment events Kafka consumer starting")
+
+	err := a.diContainer.PaymentConsumerService(ctx).RunConsumer(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/config"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+// runClaimReleaser периодически снимает закрепления транзакций, по которым payment не создал платеж, до отмены ctx
+func (a *App) runClaimReleaser(ctx context.Context) {
+	interval := config.AppConfig().PaymentClaim.CheckInterval()
+	timeout := config.AppConfig().PaymentClaim.Timeout()
+	orderService := a.diContainer.OrderService(ctx)
+
+	logger.Info(ctx, "🔓 Job проверки зависших оплат запущен", zap.Duration("interval", interval))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			released, err := orderService.ReleaseStaleClaims(ctx, now.Add(-timeout))
+			if err != nil {
+				logger.Error(ctx, "❌ Ошибка проверки зависших оплат", zap.Error(err))
+				continue
+			}
+			if released > 0 {
+				logger.Info(ctx, "🔓 Зависшие оплаты сняты", zap.Int("released", released))
+			}
+		}
+	}
+}
@@ -22,6 +22,7 @@ import (
 	orderRepo "github.com/Daniil-Sakharov/RocketFactory/order/internal/repository/order"
 	"github.com/Daniil-Sakharov/RocketFactory/order/internal/service"
 	assemblyConsumer "github.com/Daniil-Sakharov/RocketFactory/order/internal/service/consumer/assembly_consumer"
+	paymentConsumer "github.com/Daniil-Sakharov/RocketFactory/order/internal/service/consumer/payment_consumer"
 	orderService "github.com/Daniil-Sakharov/RocketFactory/order/internal/service/order"
 	orderProducer "github.com/Daniil-Sakharov/RocketFactory/order/internal/service/producer/order_producer"
 	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/closer"
@@ -29,10 +30,12 @@ import (
 	wrappedKafkaConsumer "github.com/Daniil-Sakharov/RocketFactory/platform/pkg/kafka/consumer"
 	wrappedKafkaProducer "github.com/Daniil-Sakharov/RocketFactory/platform/pkg/kafka/producer"
 	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+	httpMiddleware "github.com/Daniil-Sakharov/RocketFactory/platform/pkg/middleware/http"
 	kafkaMiddleware "github.com/Daniil-Sakharov/RocketFactory/platform/pkg/middleware/kafka"
 	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/migrator"
 	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/migrator/pg"
 	orderV1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/openapi/order/v1"
+	authV1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/auth/v1"
 	inventoryV1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
 	paymentV1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/payment/v1"
 )
@@ -40,8 +43,10 @@ import (
 type diContainer struct {
 	inventoryClient         grpcClient.InventoryClient
 	paymentClient           grpcClient.PaymentClient
+	authClient              httpMiddleware.AuthClient
 	orderService            service.OrderService
 	assemblyConsumerService service.AssemblyConsumerService
+	paymentConsumerService  service.PaymentConsumerService
 	orderProducerService    service.OrderProducerService
 	orderRepository         repository.OrderRepository
 	postgresDB              *sqlx.DB
@@ -49,6 +54,9 @@ type diContainer struct {
 	migrator                migrator.Migrator
 	consumerGroup           sarama.ConsumerGroup
 	assemblyConsumer        wrappedKafka.Consumer
+	paymentConsumerGroup    sarama.ConsumerGroup
+	paymentConsumer         wrappedKafka.Consumer
+	paymentDecoder          kafkaConverter.PaymentDecoder
 	orderProducer           wrappedKafka.Producer
 	assemblyDecoder         kafkaConverter.AssemblyDecoder
 	syncProducer            sarama.SyncProducer
@@ -71,7 +79,6 @@ func (d *diContainer) OrderService(ctx context.Context) service.OrderService {
 			d.OrderRepository(ctx),
 			d.InventoryClient(),
 			d.PaymentClient(),
-			d.OrderProducerService(),
 		)
 	}
 	return d.orderService
@@ -102,11 +109,25 @@ func (d *diContainer) InventoryClient() grpcClient.InventoryClient {
 		closer.AddNamed("InventoryClient", func(ctx context.Context) error {
 			return conn.Close()
 		})
-		d.inventoryClient = inventoryClient.NewClient(inventoryGRPCStub)
+		d.inventoryClient = inventoryClient.NewClient(inventoryGRPCStub, config.AppConfig().InventoryGRPC.AdminToken())
 	}
 	return d.inventoryClient
 }
 
+func (d *diContainer) AuthClient() httpMiddleware.AuthClient {
+	if d.authClient == nil {
+		conn, err := grpc.NewClient(config.AppConfig().AuthGRPC.Address(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			panic(fmt.Sprintf("Ошибка в подключении к Auth Service: %s\n", err.Error()))
+		}
+		closer.AddNamed("AuthClient", func(ctx context.Context) error {
+			return conn.Close()
+		})
+		d.authClient = authV1.NewAuthServiceClient(conn)
+	}
+	return d.authClient
+}
+
 func (d *diContainer) AssemblyConsumerService(ctx context.Context) service.AssemblyConsumerService {
 	if d.assemblyConsumerService == nil {
 		d.assemblyConsumerService = assemblyConsumer.NewService(
@@ -159,6 +180,62 @@ func (d *diContainer) AssemblyDecoder() kafkaConverter.AssemblyDecoder {
 	return d.assemblyDecoder
 }
 
+func (d *diContainer) PaymentConsumerService(ctx context.Context) service.PaymentConsumerService {
+	if d.paymentConsumerService == nil {
+		d.paymentConsumerService = paymentConsumer.NewService(
+			d.PaymentConsumer(),
+			d.PaymentDecoder(),
+			d.OrderRepository(ctx),
+			d.OrderProducerService(),
+			d.InventoryClient(),
+			config.AppConfig().PaymentConsumer.SucceededTopic(),
+			config.AppConfig().PaymentConsumer.FailedTopic(),
+		)
+	}
+	return d.paymentConsumerService
+}
+
+func (d *diContainer) PaymentConsumer() wrappedKafka.Consumer {
+	if d.paymentConsumer == nil {
+		d.paymentConsumer = wrappedKafkaConsumer.NewConsumer(
+			d.PaymentConsumerGroup(),
+			[]string{
+				config.AppConfig().PaymentConsumer.SucceededTopic(),
+				config.AppConfig().PaymentConsumer.FailedTopic(),
+			},
+			logger.Logger(),
+			kafkaMiddleware.Logging(logger.Logger()),
+		)
+	}
+	return d.paymentConsumer
+}
+
+func (d *diContainer) PaymentConsumerGroup() sarama.ConsumerGroup {
+	if d.paymentConsumerGroup == nil {
+		consumerGroup, err := sarama.NewConsumerGroup(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().PaymentConsumer.GroupID(),
+			config.AppConfig().PaymentConsumer.Config(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create payment consumer group: %s\n", err.Error()))
+		}
+		closer.AddNamed("Kafka payment consumer group", func(ctx context.Context) error {
+			return consumerGroup.Close()
+		})
+
+		d.paymentConsumerGroup = consumerGroup
+	}
+	return d.paymentConsumerGroup
+}
+
+func (d *diContainer) PaymentDecoder() kafkaConverter.PaymentDecoder {
+	if d.paymentDecoder == nil {
+		d.paymentDecoder = decoder.NewPaymentDecoder()
+	}
+	return d.paymentDecoder
+}
+
 func (d *diContainer) OrderProducerService() service.OrderProducerService {
 	if d.orderProducerService == nil {
 		d.orderProducerService = orderProducer.NewService(d.OrderProducer())
@@ -0,0 +1,23 @@
+package converter
+
+import (
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/domain"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+// ConfigurationValidationFromProto конвертирует результат проверки конфигурации в domain модель
+func ConfigurationValidationFromProto(response *inventoryv1.ValidateConfigurationResponse) *domain.ConfigurationValidation {
+	violations := make([]domain.ConfigurationViolation, 0, len(response.GetViolations()))
+	for _, violation := range response.GetViolations() {
+		violations = append(violations, domain.ConfigurationViolation{
+			RuleUUID:  violation.GetRuleUuid(),
+			PartUUIDs: violation.GetPartUuids(),
+			Message:   violation.GetMessage(),
+		})
+	}
+
+	return &domain.ConfigurationValidation{
+		Valid:      response.GetValid(),
+		Violations: violations,
+	}
+}
@@ -12,9 +12,26 @@ func PaymentRequestFromProto(req *paymentv1.PayOrderRequest) *dto.PayOrderClient
 		OrderUUID:     req.GetOrderUuid(),
 		UserUUID:      req.GetUserUuid(),
 		PaymentMethod: PaymentMethodFromProto(req.GetPaymentMethod()),
+		Amount:        req.GetAmount(),
+		OwnerUUID:     req.GetOrderOwnerUuid(),
+		Installments:  req.GetInstallments(),
 	}
 }
 
+// PaymentItemsToProto конвертирует позиции заказа в protobuf
+func PaymentItemsToProto(items []dto.PaymentItem) []*paymentv1.PaymentItem {
+	result := make([]*paymentv1.PaymentItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, &paymentv1.PaymentItem{
+			PartUuid:  item.PartUUID,
+			Name:      item.Name,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice,
+		})
+	}
+	return result
+}
+
 // PaymentResponseToProto конвертирует domain ответ в protobuf
 func PaymentResponseToProto(resp *dto.PayOrderClientResponse) *paymentv1.PayOrderResponse {
 	return &paymentv1.PayOrderResponse{
@@ -28,6 +45,20 @@ func PaymentResponseFromProto(resp *paymentv1.PayOrderResponse) *dto.PayOrderCli
 	}
 }
 
+// PaymentStatusFromProto конвертирует protobuf статус платежа в domain enum
+func PaymentStatusFromProto(protoStatus paymentv1.PaymentStatus) vo.PaymentStatus {
+	switch protoStatus {
+	case paymentv1.PaymentStatus_PAYMENT_STATUS_PENDING:
+		return vo.PaymentStatusPENDING
+	case paymentv1.PaymentStatus_PAYMENT_STATUS_SUCCEEDED:
+		return vo.PaymentStatusSUCCEEDED
+	case paymentv1.PaymentStatus_PAYMENT_STATUS_FAILED:
+		return vo.PaymentStatusFAILED
+	default:
+		return vo.PaymentStatusUNKNOWN
+	}
+}
+
 // PaymentMethodFromProto конвертирует protobuf enum в domain enum
 func PaymentMethodFromProto(protoMethod paymentv1.PaymentMethod) vo.PaymentMethod {
 	switch protoMethod {
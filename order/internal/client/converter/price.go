@@ -0,0 +1,23 @@
+package converter
+
+import (
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/domain"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+// PartPricesFromProto конвертирует цены деталей в domain модели
+func PartPricesFromProto(prices []*inventoryv1.PartPrice) []*domain.PartPrice {
+	result := make([]*domain.PartPrice, 0, len(prices))
+	for _, price := range prices {
+		partPrice := &domain.PartPrice{
+			PartUUID: price.GetPartUuid(),
+			Price:    price.GetPrice(),
+		}
+		if price.GetEffectiveFrom() != nil {
+			effectiveFrom := price.GetEffectiveFrom().AsTime()
+			partPrice.EffectiveFrom = &effectiveFrom
+		}
+		result = append(result, partPrice)
+	}
+	return result
+}
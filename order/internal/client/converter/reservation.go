@@ -0,0 +1,18 @@
+package converter
+
+import (
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/domain"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+// ReservationItemsToProto конвертирует строки заказа в позиции резерва
+func ReservationItemsToProto(lineItems []domain.LineItem) []*inventoryv1.ReservationItem {
+	items := make([]*inventoryv1.ReservationItem, 0, len(lineItems))
+	for _, item := range lineItems {
+		items = append(items, &inventoryv1.ReservationItem{
+			PartUuid: item.PartUUID,
+			Quantity: item.Quantity,
+		})
+	}
+	return items
+}
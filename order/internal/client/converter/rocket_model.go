@@ -0,0 +1,26 @@
+package converter
+
+import (
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/domain"
+	inventoryv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+// RocketModelExpansionFromProto конвертирует раскладку модели ракеты в domain модель
+func RocketModelExpansionFromProto(response *inventoryv1.ExpandRocketModelResponse) *domain.RocketModelExpansion {
+	lines := make([]domain.RocketModelLine, 0, len(response.GetLines()))
+	for _, line := range response.GetLines() {
+		lines = append(lines, domain.RocketModelLine{
+			PartUUID:  line.GetPart().GetUuid(),
+			Quantity:  line.GetQuantity(),
+			UnitPrice: line.GetPart().GetPrice(),
+			Available: line.GetAvailable(),
+		})
+	}
+
+	return &domain.RocketModelExpansion{
+		RocketModelUUID: response.GetModel().GetUuid(),
+		Lines:           lines,
+		TotalPrice:      response.GetTotalPrice(),
+		Available:       response.GetAvailable(),
+	}
+}
@@ -2,15 +2,31 @@ package grpc
 
 import (
 	"context"
+	"time"
 
 	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/domain"
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/vo"
 	"github.com/Daniil-Sakharov/RocketFactory/order/internal/service/dto"
 )
 
 type InventoryClient interface {
 	ListParts(ctx context.Context, filter *domain.PartsFilter) ([]*domain.Part, error)
+	// ValidateConfiguration проверяет набор деталей по правилам совместимости inventory
+	ValidateConfiguration(ctx context.Context, partUUIDs []string) (*domain.ConfigurationValidation, error)
+	// ExpandRocketModel раскладывает модель ракеты на детали для заданного количества ракет
+	ExpandRocketModel(ctx context.Context, rocketModelUUID string, quantity int64) (*domain.RocketModelExpansion, error)
+	// GetPartPrices возвращает цены деталей, действовавшие в момент at, в порядке partUUIDs
+	GetPartPrices(ctx context.Context, partUUIDs []string, at time.Time) ([]*domain.PartPrice, error)
+	// ReserveStock резервирует детали строк заказа на складах inventory, повтор с тем же заказом не резервирует дважды
+	ReserveStock(ctx context.Context, orderUUID string, lineItems []domain.LineItem) error
+	// ReleaseStock снимает резерв заказа
+	ReleaseStock(ctx context.Context, orderUUID string) error
+	// ConsumeStock переводит резерв оплаченного заказа в расход, повтор ничего не меняет
+	ConsumeStock(ctx context.Context, orderUUID string) error
 }
 
 type PaymentClient interface {
 	PayOrder(ctx context.Context, req *dto.PayOrderClientRequest) (*dto.PayOrderClientResponse, error)
+	// GetPaymentStatus возвращает статус платежа по UUID транзакции, ErrPaymentNotFound - платеж не создан
+	GetPaymentStatus(ctx context.Context, transactionUUID string) (vo.PaymentStatus, error)
 }
@@ -1,7 +1,12 @@
 package v1
 
 import (
+	"context"
+
+	"google.golang.org/grpc/metadata"
+
 	def "github.com/Daniil-Sakharov/RocketFactory/order/internal/client/grpc"
+	grpcAuth "github.com/Daniil-Sakharov/RocketFactory/platform/pkg/middleware/grpc"
 	generatedInventoryV1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
 )
 
@@ -9,10 +14,17 @@ var _ def.InventoryClient = (*client)(nil)
 
 type client struct {
 	generatedClient generatedInventoryV1.InventoryServiceClient
+	adminToken      string
 }
 
-func NewClient(generatedClient generatedInventoryV1.InventoryServiceClient) *client {
+func NewClient(generatedClient generatedInventoryV1.InventoryServiceClient, adminToken string) *client {
 	return &client{
 		generatedClient: generatedClient,
+		adminToken:      adminToken,
 	}
 }
+
+// withAdminToken добавляет admin-token для защищенных методов inventory
+func (c *client) withAdminToken(ctx context.Context) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, grpcAuth.AdminTokenMetadataKey, c.adminToken)
+}
@@ -0,0 +1,38 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	clientConverter "github.com/Daniil-Sakharov/RocketFactory/order/internal/client/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/domain"
+	grpcAuth "github.com/Daniil-Sakharov/RocketFactory/platform/pkg/middleware/grpc"
+	generatedInventoryV1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (c *client) ExpandRocketModel(ctx context.Context, rocketModelUUID string, quantity int64) (*domain.RocketModelExpansion, error) {
+	ctx = grpcAuth.ForwardSessionUUIDToGRPC(ctx)
+
+	response, err := c.generatedClient.ExpandRocketModel(ctx, &generatedInventoryV1.ExpandRocketModelRequest{
+		Uuid:     rocketModelUUID,
+		Quantity: quantity,
+	})
+	if err != nil {
+		// NotFound - модели нет, FailedPrecondition - спецификация ссылается на удаленные детали,
+		// InvalidArgument - некорректное количество ракет
+		switch status.Code(err) {
+		case codes.NotFound:
+			return nil, model.ErrRocketModelNotFound
+		case codes.FailedPrecondition:
+			return nil, fmt.Errorf("%w: %s", model.ErrPartsNotFound, status.Convert(err).Message())
+		case codes.InvalidArgument:
+			return nil, model.ErrInvalidQuantity
+		}
+		return nil, err
+	}
+	return clientConverter.RocketModelExpansionFromProto(response), nil
+}
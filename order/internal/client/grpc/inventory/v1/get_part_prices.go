@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	clientConverter "github.com/Daniil-Sakharov/RocketFactory/order/internal/client/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/domain"
+	grpcAuth "github.com/Daniil-Sakharov/RocketFactory/platform/pkg/middleware/grpc"
+	generatedInventoryV1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (c *client) GetPartPrices(ctx context.Context, partUUIDs []string, at time.Time) ([]*domain.PartPrice, error) {
+	ctx = grpcAuth.ForwardSessionUUIDToGRPC(ctx)
+
+	response, err := c.generatedClient.GetPartPrices(ctx, &generatedInventoryV1.GetPartPricesRequest{
+		PartUuids: partUUIDs,
+		At:        timestamppb.New(at),
+	})
+	if err != nil {
+		// NotFound - деталь удалили между получением и расчетом цены
+		if status.Code(err) == codes.NotFound {
+			return nil, fmt.Errorf("%w: %s", model.ErrPartsNotFound, status.Convert(err).Message())
+		}
+		return nil, err
+	}
+	return clientConverter.PartPricesFromProto(response.GetPrices()), nil
+}
@@ -3,6 +3,8 @@ package v1
 import (
 	"context"
 
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
 	clientConverter "github.com/Daniil-Sakharov/RocketFactory/order/internal/client/converter"
 	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/domain"
 	grpcAuth "github.com/Daniil-Sakharov/RocketFactory/platform/pkg/middleware/grpc"
@@ -12,9 +14,17 @@ import (
 func (c *client) ListParts(ctx context.Context, filter *domain.PartsFilter) ([]*domain.Part, error) {
 	ctx = grpcAuth.ForwardSessionUUIDToGRPC(ctx)
 
-	response, err := c.generatedClient.ListParts(ctx, &generatedInventoryV1.ListPartsRequest{
+	request := &generatedInventoryV1.ListPartsRequest{
 		Filter: clientConverter.FilterToProto(filter),
-	})
+		// Заказу и чеку нужны только название и цена, metadata и остальные поля не читаются из MongoDB
+		ReadMask: &fieldmaskpb.FieldMask{Paths: []string{"uuid", "name", "price"}},
+	}
+	// Заказу нужны все запрошенные детали одной страницей
+	if filter != nil && len(filter.Uuids) > 0 {
+		request.PageSize = int32(len(filter.Uuids)) //nolint:gosec // количество деталей в заказе невелико
+	}
+
+	response, err := c.generatedClient.ListParts(ctx, request)
 	if err != nil {
 		return nil, err
 	}
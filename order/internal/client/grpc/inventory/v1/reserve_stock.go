@@ -0,0 +1,52 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	clientConverter "github.com/Daniil-Sakharov/RocketFactory/order/internal/client/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/domain"
+	grpcAuth "github.com/Daniil-Sakharov/RocketFactory/platform/pkg/middleware/grpc"
+	generatedInventoryV1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (c *client) ReserveStock(ctx context.Context, orderUUID string, lineItems []domain.LineItem) error {
+	ctx = c.withAdminToken(grpcAuth.ForwardSessionUUIDToGRPC(ctx))
+
+	_, err := c.generatedClient.ReserveStock(ctx, &generatedInventoryV1.ReserveStockRequest{
+		Reference: orderUUID,
+		Items:     clientConverter.ReservationItemsToProto(lineItems),
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.FailedPrecondition:
+			return fmt.Errorf("%w: %s", model.ErrInsufficientStock, status.Convert(err).Message())
+		case codes.NotFound:
+			return fmt.Errorf("%w: %s", model.ErrPartsNotFound, status.Convert(err).Message())
+		}
+		return err
+	}
+	return nil
+}
+
+func (c *client) ReleaseStock(ctx context.Context, orderUUID string) error {
+	ctx = c.withAdminToken(grpcAuth.ForwardSessionUUIDToGRPC(ctx))
+
+	_, err := c.generatedClient.ReleaseStock(ctx, &generatedInventoryV1.ReleaseStockRequest{
+		Reference: orderUUID,
+	})
+	return err
+}
+
+func (c *client) ConsumeStock(ctx context.Context, orderUUID string) error {
+	ctx = c.withAdminToken(grpcAuth.ForwardSessionUUIDToGRPC(ctx))
+
+	_, err := c.generatedClient.ConsumeStock(ctx, &generatedInventoryV1.ConsumeStockRequest{
+		Reference: orderUUID,
+	})
+	return err
+}
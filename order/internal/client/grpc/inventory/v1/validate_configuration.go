@@ -0,0 +1,22 @@
+package v1
+
+import (
+	"context"
+
+	clientConverter "github.com/Daniil-Sakharov/RocketFactory/order/internal/client/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/domain"
+	grpcAuth "github.com/Daniil-Sakharov/RocketFactory/platform/pkg/middleware/grpc"
+	generatedInventoryV1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1"
+)
+
+func (c *client) ValidateConfiguration(ctx context.Context, partUUIDs []string) (*domain.ConfigurationValidation, error) {
+	ctx = grpcAuth.ForwardSessionUUIDToGRPC(ctx)
+
+	response, err := c.generatedClient.ValidateConfiguration(ctx, &generatedInventoryV1.ValidateConfigurationRequest{
+		PartUuids: partUUIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return clientConverter.ConfigurationValidationFromProto(response), nil
+}
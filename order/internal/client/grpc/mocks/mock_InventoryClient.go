@@ -10,6 +10,8 @@ import (
 	domain "github.com/Daniil-Sakharov/RocketFactory/order/internal/model/domain"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // InventoryClient is an autogenerated mock type for the InventoryClient type
@@ -25,6 +27,173 @@ func (_m *InventoryClient) EXPECT() *InventoryClient_Expecter {
 	return &InventoryClient_Expecter{mock: &_m.Mock}
 }
 
+// ConsumeStock provides a mock function with given fields: ctx, orderUUID
+func (_m *InventoryClient) ConsumeStock(ctx context.Context, orderUUID string) error {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumeStock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryClient_ConsumeStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumeStock'
+type InventoryClient_ConsumeStock_Call struct {
+	*mock.Call
+}
+
+// ConsumeStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *InventoryClient_Expecter) ConsumeStock(ctx interface{}, orderUUID interface{}) *InventoryClient_ConsumeStock_Call {
+	return &InventoryClient_ConsumeStock_Call{Call: _e.mock.On("ConsumeStock", ctx, orderUUID)}
+}
+
+func (_c *InventoryClient_ConsumeStock_Call) Run(run func(ctx context.Context, orderUUID string)) *InventoryClient_ConsumeStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InventoryClient_ConsumeStock_Call) Return(_a0 error) *InventoryClient_ConsumeStock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryClient_ConsumeStock_Call) RunAndReturn(run func(context.Context, string) error) *InventoryClient_ConsumeStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ExpandRocketModel provides a mock function with given fields: ctx, rocketModelUUID, quantity
+func (_m *InventoryClient) ExpandRocketModel(ctx context.Context, rocketModelUUID string, quantity int64) (*domain.RocketModelExpansion, error) {
+	ret := _m.Called(ctx, rocketModelUUID, quantity)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExpandRocketModel")
+	}
+
+	var r0 *domain.RocketModelExpansion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) (*domain.RocketModelExpansion, error)); ok {
+		return rf(ctx, rocketModelUUID, quantity)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) *domain.RocketModelExpansion); ok {
+		r0 = rf(ctx, rocketModelUUID, quantity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.RocketModelExpansion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, rocketModelUUID, quantity)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryClient_ExpandRocketModel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ExpandRocketModel'
+type InventoryClient_ExpandRocketModel_Call struct {
+	*mock.Call
+}
+
+// ExpandRocketModel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - rocketModelUUID string
+//   - quantity int64
+func (_e *InventoryClient_Expecter) ExpandRocketModel(ctx interface{}, rocketModelUUID interface{}, quantity interface{}) *InventoryClient_ExpandRocketModel_Call {
+	return &InventoryClient_ExpandRocketModel_Call{Call: _e.mock.On("ExpandRocketModel", ctx, rocketModelUUID, quantity)}
+}
+
+func (_c *InventoryClient_ExpandRocketModel_Call) Run(run func(ctx context.Context, rocketModelUUID string, quantity int64)) *InventoryClient_ExpandRocketModel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64))
+	})
+	return _c
+}
+
+func (_c *InventoryClient_ExpandRocketModel_Call) Return(_a0 *domain.RocketModelExpansion, _a1 error) *InventoryClient_ExpandRocketModel_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryClient_ExpandRocketModel_Call) RunAndReturn(run func(context.Context, string, int64) (*domain.RocketModelExpansion, error)) *InventoryClient_ExpandRocketModel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPartPrices provides a mock function with given fields: ctx, partUUIDs, at
+func (_m *InventoryClient) GetPartPrices(ctx context.Context, partUUIDs []string, at time.Time) ([]*domain.PartPrice, error) {
+	ret := _m.Called(ctx, partUUIDs, at)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPartPrices")
+	}
+
+	var r0 []*domain.PartPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string, time.Time) ([]*domain.PartPrice, error)); ok {
+		return rf(ctx, partUUIDs, at)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string, time.Time) []*domain.PartPrice); ok {
+		r0 = rf(ctx, partUUIDs, at)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.PartPrice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string, time.Time) error); ok {
+		r1 = rf(ctx, partUUIDs, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryClient_GetPartPrices_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPartPrices'
+type InventoryClient_GetPartPrices_Call struct {
+	*mock.Call
+}
+
+// GetPartPrices is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partUUIDs []string
+//   - at time.Time
+func (_e *InventoryClient_Expecter) GetPartPrices(ctx interface{}, partUUIDs interface{}, at interface{}) *InventoryClient_GetPartPrices_Call {
+	return &InventoryClient_GetPartPrices_Call{Call: _e.mock.On("GetPartPrices", ctx, partUUIDs, at)}
+}
+
+func (_c *InventoryClient_GetPartPrices_Call) Run(run func(ctx context.Context, partUUIDs []string, at time.Time)) *InventoryClient_GetPartPrices_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *InventoryClient_GetPartPrices_Call) Return(_a0 []*domain.PartPrice, _a1 error) *InventoryClient_GetPartPrices_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryClient_GetPartPrices_Call) RunAndReturn(run func(context.Context, []string, time.Time) ([]*domain.PartPrice, error)) *InventoryClient_GetPartPrices_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListParts provides a mock function with given fields: ctx, filter
 func (_m *InventoryClient) ListParts(ctx context.Context, filter *domain.PartsFilter) ([]*domain.Part, error) {
 	ret := _m.Called(ctx, filter)
@@ -84,6 +253,160 @@ func (_c *InventoryClient_ListParts_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ReleaseStock provides a mock function with given fields: ctx, orderUUID
+func (_m *InventoryClient) ReleaseStock(ctx context.Context, orderUUID string) error {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseStock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryClient_ReleaseStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseStock'
+type InventoryClient_ReleaseStock_Call struct {
+	*mock.Call
+}
+
+// ReleaseStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *InventoryClient_Expecter) ReleaseStock(ctx interface{}, orderUUID interface{}) *InventoryClient_ReleaseStock_Call {
+	return &InventoryClient_ReleaseStock_Call{Call: _e.mock.On("ReleaseStock", ctx, orderUUID)}
+}
+
+func (_c *InventoryClient_ReleaseStock_Call) Run(run func(ctx context.Context, orderUUID string)) *InventoryClient_ReleaseStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InventoryClient_ReleaseStock_Call) Return(_a0 error) *InventoryClient_ReleaseStock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryClient_ReleaseStock_Call) RunAndReturn(run func(context.Context, string) error) *InventoryClient_ReleaseStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReserveStock provides a mock function with given fields: ctx, orderUUID, lineItems
+func (_m *InventoryClient) ReserveStock(ctx context.Context, orderUUID string, lineItems []domain.LineItem) error {
+	ret := _m.Called(ctx, orderUUID, lineItems)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReserveStock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []domain.LineItem) error); ok {
+		r0 = rf(ctx, orderUUID, lineItems)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InventoryClient_ReserveStock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReserveStock'
+type InventoryClient_ReserveStock_Call struct {
+	*mock.Call
+}
+
+// ReserveStock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+//   - lineItems []domain.LineItem
+func (_e *InventoryClient_Expecter) ReserveStock(ctx interface{}, orderUUID interface{}, lineItems interface{}) *InventoryClient_ReserveStock_Call {
+	return &InventoryClient_ReserveStock_Call{Call: _e.mock.On("ReserveStock", ctx, orderUUID, lineItems)}
+}
+
+func (_c *InventoryClient_ReserveStock_Call) Run(run func(ctx context.Context, orderUUID string, lineItems []domain.LineItem)) *InventoryClient_ReserveStock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]domain.LineItem))
+	})
+	return _c
+}
+
+func (_c *InventoryClient_ReserveStock_Call) Return(_a0 error) *InventoryClient_ReserveStock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryClient_ReserveStock_Call) RunAndReturn(run func(context.Context, string, []domain.LineItem) error) *InventoryClient_ReserveStock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ValidateConfiguration provides a mock function with given fields: ctx, partUUIDs
+func (_m *InventoryClient) ValidateConfiguration(ctx context.Context, partUUIDs []string) (*domain.ConfigurationValidation, error) {
+	ret := _m.Called(ctx, partUUIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ValidateConfiguration")
+	}
+
+	var r0 *domain.ConfigurationValidation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) (*domain.ConfigurationValidation, error)); ok {
+		return rf(ctx, partUUIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) *domain.ConfigurationValidation); ok {
+		r0 = rf(ctx, partUUIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*domain.ConfigurationValidation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, partUUIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InventoryClient_ValidateConfiguration_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ValidateConfiguration'
+type InventoryClient_ValidateConfiguration_Call struct {
+	*mock.Call
+}
+
+// ValidateConfiguration is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partUUIDs []string
+func (_e *InventoryClient_Expecter) ValidateConfiguration(ctx interface{}, partUUIDs interface{}) *InventoryClient_ValidateConfiguration_Call {
+	return &InventoryClient_ValidateConfiguration_Call{Call: _e.mock.On("ValidateConfiguration", ctx, partUUIDs)}
+}
+
+func (_c *InventoryClient_ValidateConfiguration_Call) Run(run func(ctx context.Context, partUUIDs []string)) *InventoryClient_ValidateConfiguration_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *InventoryClient_ValidateConfiguration_Call) Return(_a0 *domain.ConfigurationValidation, _a1 error) *InventoryClient_ValidateConfiguration_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InventoryClient_ValidateConfiguration_Call) RunAndReturn(run func(context.Context, []string) (*domain.ConfigurationValidation, error)) *InventoryClient_ValidateConfiguration_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewInventoryClient creates a new instance of InventoryClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewInventoryClient(t interface {
@@ -10,6 +10,8 @@ import (
 	dto "github.com/Daniil-Sakharov/RocketFactory/order/internal/service/dto"
 
 	mock "github.com/stretchr/testify/mock"
+
+	vo "github.com/Daniil-Sakharov/RocketFactory/order/internal/model/vo"
 )
 
 // PaymentClient is an autogenerated mock type for the PaymentClient type
@@ -25,6 +27,63 @@ func (_m *PaymentClient) EXPECT() *PaymentClient_Expecter {
 	return &PaymentClient_Expecter{mock: &_m.Mock}
 }
 
+// GetPaymentStatus provides a mock function with given fields: ctx, transactionUUID
+func (_m *PaymentClient) GetPaymentStatus(ctx context.Context, transactionUUID string) (vo.PaymentStatus, error) {
+	ret := _m.Called(ctx, transactionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPaymentStatus")
+	}
+
+	var r0 vo.PaymentStatus
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (vo.PaymentStatus, error)); ok {
+		return rf(ctx, transactionUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) vo.PaymentStatus); ok {
+		r0 = rf(ctx, transactionUUID)
+	} else {
+		r0 = ret.Get(0).(vo.PaymentStatus)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, transactionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentClient_GetPaymentStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPaymentStatus'
+type PaymentClient_GetPaymentStatus_Call struct {
+	*mock.Call
+}
+
+// GetPaymentStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID string
+func (_e *PaymentClient_Expecter) GetPaymentStatus(ctx interface{}, transactionUUID interface{}) *PaymentClient_GetPaymentStatus_Call {
+	return &PaymentClient_GetPaymentStatus_Call{Call: _e.mock.On("GetPaymentStatus", ctx, transactionUUID)}
+}
+
+func (_c *PaymentClient_GetPaymentStatus_Call) Run(run func(ctx context.Context, transactionUUID string)) *PaymentClient_GetPaymentStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PaymentClient_GetPaymentStatus_Call) Return(_a0 vo.PaymentStatus, _a1 error) *PaymentClient_GetPaymentStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentClient_GetPaymentStatus_Call) RunAndReturn(run func(context.Context, string) (vo.PaymentStatus, error)) *PaymentClient_GetPaymentStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PayOrder provides a mock function with given fields: ctx, req
 func (_m *PaymentClient) PayOrder(ctx context.Context, req *dto.PayOrderClientRequest) (*dto.PayOrderClientResponse, error) {
 	ret := _m.Called(ctx, req)
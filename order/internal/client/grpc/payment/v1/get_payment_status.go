@@ -0,0 +1,26 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/client/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/vo"
+	generatedPayment "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/payment/v1"
+)
+
+func (c *client) GetPaymentStatus(ctx context.Context, transactionUUID string) (vo.PaymentStatus, error) {
+	response, err := c.generatedClient.GetPaymentStatus(ctx, &generatedPayment.GetPaymentStatusRequest{
+		TransactionUuid: transactionUUID,
+	})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return vo.PaymentStatusUNKNOWN, model.ErrPaymentNotFound
+		}
+		return vo.PaymentStatusUNKNOWN, err
+	}
+	return converter.PaymentStatusFromProto(response.GetStatus()), nil
+}
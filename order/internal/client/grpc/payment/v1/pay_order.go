@@ -3,7 +3,11 @@ package v1
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/Daniil-Sakharov/RocketFactory/order/internal/client/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model"
 	"github.com/Daniil-Sakharov/RocketFactory/order/internal/service/dto"
 	grpcAuth "github.com/Daniil-Sakharov/RocketFactory/platform/pkg/middleware/grpc"
 	generatedPayment "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/payment/v1"
@@ -13,11 +17,32 @@ func (c *client) PayOrder(ctx context.Context, req *dto.PayOrderClientRequest) (
 	ctx = grpcAuth.ForwardSessionUUIDToGRPC(ctx)
 
 	response, err := c.generatedClient.PayOrder(ctx, &generatedPayment.PayOrderRequest{
-		OrderUuid:     req.OrderUUID,
-		UserUuid:      req.UserUUID,
-		PaymentMethod: converter.PaymentMethodToProto(req.PaymentMethod),
+		OrderUuid:       req.OrderUUID,
+		UserUuid:        req.UserUUID,
+		PaymentMethod:   converter.PaymentMethodToProto(req.PaymentMethod),
+		Amount:          req.Amount,
+		OrderOwnerUuid:  req.OwnerUUID,
+		Items:           converter.PaymentItemsToProto(req.Items),
+		Installments:    req.Installments,
+		TransactionUuid: req.TransactionUUID,
 	})
 	if err != nil {
+		// FailedPrecondition - нехватка средств на счете инвестора, PermissionDenied - отказ антифрода,
+		// InvalidArgument при рассрочке - способ оплаты или сумма заказа не подходят под условия рассрочки,
+		// AlreadyExists - платеж с этим UUID транзакции уже принят
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			if req.Installments > 1 {
+				return nil, model.ErrInvalidInstallments
+			}
+			return nil, model.ErrInvalidPaymentRequest
+		case codes.FailedPrecondition:
+			return nil, model.ErrInsufficientFunds
+		case codes.PermissionDenied:
+			return nil, model.ErrPaymentRejected
+		case codes.AlreadyExists:
+			return nil, model.ErrPaymentInProgress
+		}
 		return nil, err
 	}
 	return converter.PaymentResponseFromProto(response), nil
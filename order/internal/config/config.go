@@ -15,10 +15,13 @@ type config struct {
 	OrderHTTP        OrderHTTPConfig
 	InventoryGRPC    InventoryGRPCConfig
 	PaymentGRPC      PaymentGRPCConfig
+	PaymentClaim     PaymentClaimConfig
+	AuthGRPC         AuthGRPCConfig
 	PostgresDB       PostgresConfig
 	Kafka            KafkaConfig
 	AssemblyConsumer AssemblyConsumerConfig
 	OrderProducer    OrderProducerConfig
+	PaymentConsumer  PaymentConsumerConfig
 }
 
 func Load(path ...string) error {
@@ -42,6 +45,16 @@ func Load(path ...string) error {
 		return err
 	}
 
+	paymentClaimCfg, err := env.NewPaymentClaimConfig()
+	if err != nil {
+		return err
+	}
+
+	authGRPCCfg, err := env.NewAuthGRPCConfig()
+	if err != nil {
+		return err
+	}
+
 	orderHHTPCfg, err := env.NewOrderHTTPConfig()
 	if err != nil {
 		return err
@@ -67,15 +80,23 @@ func Load(path ...string) error {
 		return err
 	}
 
+	paymentConsumerCfg, err := env.NewPaymentConsumerConfig()
+	if err != nil {
+		return err
+	}
+
 	appConfig = &config{
 		Logger:           loggerCfg,
 		OrderHTTP:        orderHHTPCfg,
 		InventoryGRPC:    inventoryGRPCCfg,
 		PaymentGRPC:      paymentGRPCCfg,
+		PaymentClaim:     paymentClaimCfg,
+		AuthGRPC:         authGRPCCfg,
 		PostgresDB:       postgresCfg,
 		Kafka:            kafkaCfg,
 		OrderProducer:    producerCfg,
 		AssemblyConsumer: consumerCfg,
+		PaymentConsumer:  paymentConsumerCfg,
 	}
 
 	return nil
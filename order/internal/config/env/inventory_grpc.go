@@ -9,6 +9,8 @@ import (
 type inventoryGRPCEnvConfig struct {
 	Host string `env:"INVENTORY_GRPC_HOST,required"`
 	Port string `env:"INVENTORY_GRPC_PORT,required"`
+	// AdminToken нужен для ReserveStock, ReleaseStock и ConsumeStock
+	AdminToken string `env:"INVENTORY_GRPC_ADMIN_TOKEN"`
 }
 
 type inventoryGRPCConfig struct {
@@ -27,3 +29,8 @@ func NewInventoryGRPCConfig() (*inventoryGRPCConfig, error) {
 func (cfg *inventoryGRPCConfig) Address() string {
 	return net.JoinHostPort(cfg.raw.Host, cfg.raw.Port)
 }
+
+// AdminToken - токен inventory (metadata admin-token) для операций с резервом
+func (cfg *inventoryGRPCConfig) AdminToken() string {
+	return cfg.raw.AdminToken
+}
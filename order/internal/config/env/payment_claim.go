@@ -0,0 +1,35 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type paymentClaimEnvConfig struct {
+	Timeout       time.Duration `env:"PAYMENT_CLAIM_TIMEOUT" envDefault:"10m"`
+	CheckInterval time.Duration `env:"PAYMENT_CLAIM_CHECK_INTERVAL" envDefault:"1m"`
+}
+
+type paymentClaimConfig struct {
+	raw paymentClaimEnvConfig
+}
+
+func NewPaymentClaimConfig() (*paymentClaimConfig, error) {
+	var raw paymentClaimEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &paymentClaimConfig{raw: raw}, nil
+}
+
+// Timeout - сколько закрепление транзакции ждет результата платежа, прежде чем его проверяет job
+func (cfg *paymentClaimConfig) Timeout() time.Duration {
+	return cfg.raw.Timeout
+}
+
+// CheckInterval - период запуска job'а проверки зависших закреплений
+func (cfg *paymentClaimConfig) CheckInterval() time.Duration {
+	return cfg.raw.CheckInterval
+}
@@ -0,0 +1,46 @@
+package env
+
+import (
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type paymentConsumerEnvConfig struct {
+	SucceededTopic string `env:"PAYMENT_SUCCEEDED_TOPIC_NAME,required"`
+	FailedTopic    string `env:"PAYMENT_FAILED_TOPIC_NAME,required"`
+	GroupID        string `env:"PAYMENT_CONSUMER_GROUP_ID,required"`
+}
+
+type paymentConsumerConfig struct {
+	raw paymentConsumerEnvConfig
+}
+
+func NewPaymentConsumerConfig() (*paymentConsumerConfig, error) {
+	var raw paymentConsumerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &paymentConsumerConfig{raw: raw}, nil
+}
+
+func (cfg *paymentConsumerConfig) SucceededTopic() string {
+	return cfg.raw.SucceededTopic
+}
+
+func (cfg *paymentConsumerConfig) FailedTopic() string {
+	return cfg.raw.FailedTopic
+}
+
+func (cfg *paymentConsumerConfig) GroupID() string {
+	return cfg.raw.GroupID
+}
+
+func (cfg *paymentConsumerConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Consumer.Group.Rebalance.GroupStrategies = []sarama.BalanceStrategy{sarama.NewBalanceStrategyRoundRobin()}
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
+
+	return config
+}
@@ -13,12 +13,22 @@ type LoggerConfig interface {
 
 type InventoryGRPCConfig interface {
 	Address() string
+	AdminToken() string
 }
 
 type PaymentGRPCConfig interface {
 	Address() string
 }
 
+type PaymentClaimConfig interface {
+	Timeout() time.Duration
+	CheckInterval() time.Duration
+}
+
+type AuthGRPCConfig interface {
+	Address() string
+}
+
 type OrderHTTPConfig interface {
 	Address() string
 	ReadTimeout() time.Duration
@@ -46,3 +56,10 @@ type AssemblyConsumerConfig interface {
 	GroupID() string
 	Config() *sarama.Config
 }
+
+type PaymentConsumerConfig interface {
+	SucceededTopic() string
+	FailedTopic() string
+	GroupID() string
+	Config() *sarama.Config
+}
@@ -64,6 +64,51 @@ func (_c *InventoryGRPCConfig_Address_Call) RunAndReturn(run func() string) *Inv
 	return _c
 }
 
+// AdminToken provides a mock function with no fields
+func (_m *InventoryGRPCConfig) AdminToken() string {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AdminToken")
+	}
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// InventoryGRPCConfig_AdminToken_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AdminToken'
+type InventoryGRPCConfig_AdminToken_Call struct {
+	*mock.Call
+}
+
+// AdminToken is a helper method to define mock.On call
+func (_e *InventoryGRPCConfig_Expecter) AdminToken() *InventoryGRPCConfig_AdminToken_Call {
+	return &InventoryGRPCConfig_AdminToken_Call{Call: _e.mock.On("AdminToken")}
+}
+
+func (_c *InventoryGRPCConfig_AdminToken_Call) Run(run func()) *InventoryGRPCConfig_AdminToken_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *InventoryGRPCConfig_AdminToken_Call) Return(_a0 string) *InventoryGRPCConfig_AdminToken_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InventoryGRPCConfig_AdminToken_Call) RunAndReturn(run func() string) *InventoryGRPCConfig_AdminToken_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewInventoryGRPCConfig creates a new instance of InventoryGRPCConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewInventoryGRPCConfig(t interface {
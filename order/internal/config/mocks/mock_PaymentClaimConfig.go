@@ -0,0 +1,128 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PaymentClaimConfig is an autogenerated mock type for the PaymentClaimConfig type
+type PaymentClaimConfig struct {
+	mock.Mock
+}
+
+type PaymentClaimConfig_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PaymentClaimConfig) EXPECT() *PaymentClaimConfig_Expecter {
+	return &PaymentClaimConfig_Expecter{mock: &_m.Mock}
+}
+
+// CheckInterval provides a mock function with no fields
+func (_m *PaymentClaimConfig) CheckInterval() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckInterval")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// PaymentClaimConfig_CheckInterval_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckInterval'
+type PaymentClaimConfig_CheckInterval_Call struct {
+	*mock.Call
+}
+
+// CheckInterval is a helper method to define mock.On call
+func (_e *PaymentClaimConfig_Expecter) CheckInterval() *PaymentClaimConfig_CheckInterval_Call {
+	return &PaymentClaimConfig_CheckInterval_Call{Call: _e.mock.On("CheckInterval")}
+}
+
+func (_c *PaymentClaimConfig_CheckInterval_Call) Run(run func()) *PaymentClaimConfig_CheckInterval_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PaymentClaimConfig_CheckInterval_Call) Return(_a0 time.Duration) *PaymentClaimConfig_CheckInterval_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentClaimConfig_CheckInterval_Call) RunAndReturn(run func() time.Duration) *PaymentClaimConfig_CheckInterval_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Timeout provides a mock function with no fields
+func (_m *PaymentClaimConfig) Timeout() time.Duration {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Timeout")
+	}
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// PaymentClaimConfig_Timeout_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Timeout'
+type PaymentClaimConfig_Timeout_Call struct {
+	*mock.Call
+}
+
+// Timeout is a helper method to define mock.On call
+func (_e *PaymentClaimConfig_Expecter) Timeout() *PaymentClaimConfig_Timeout_Call {
+	return &PaymentClaimConfig_Timeout_Call{Call: _e.mock.On("Timeout")}
+}
+
+func (_c *PaymentClaimConfig_Timeout_Call) Run(run func()) *PaymentClaimConfig_Timeout_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *PaymentClaimConfig_Timeout_Call) Return(_a0 time.Duration) *PaymentClaimConfig_Timeout_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentClaimConfig_Timeout_Call) RunAndReturn(run func() time.Duration) *PaymentClaimConfig_Timeout_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPaymentClaimConfig creates a new instance of PaymentClaimConfig. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPaymentClaimConfig(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PaymentClaimConfig {
+	mock := &PaymentClaimConfig{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -18,7 +18,10 @@ func MapToCreateOrderError(err error) orderV1.CreateOrderRes {
 	// Валидация → 400
 	if errors.Is(err, model.ErrEmptyUserUUID) ||
 		errors.Is(err, model.ErrEmptyPartUUIDs) ||
-		errors.Is(err, model.ErrInvalidPaymentMethod) {
+		errors.Is(err, model.ErrConflictingOrderItems) ||
+		errors.Is(err, model.ErrInvalidQuantity) ||
+		errors.Is(err, model.ErrInvalidPaymentMethod) ||
+		errors.Is(err, model.ErrInvalidConfiguration) {
 		return &orderV1.ValidationError{
 			Error:   "VALIDATION_ERROR",
 			Message: err.Error(),
@@ -27,7 +30,8 @@ func MapToCreateOrderError(err error) orderV1.CreateOrderRes {
 
 	// Not Found → 404
 	if errors.Is(err, model.ErrOrderNotFound) ||
-		errors.Is(err, model.ErrPartsNotFound) {
+		errors.Is(err, model.ErrPartsNotFound) ||
+		errors.Is(err, model.ErrRocketModelNotFound) {
 		return &orderV1.NotFoundError{
 			Error:   "NOT_FOUND",
 			Message: err.Error(),
@@ -36,7 +40,8 @@ func MapToCreateOrderError(err error) orderV1.CreateOrderRes {
 
 	// Conflict → 409
 	if errors.Is(err, model.ErrOrderAlreadyExist) ||
-		errors.Is(err, model.ErrOrderAlreadyPaid) {
+		errors.Is(err, model.ErrOrderAlreadyPaid) ||
+		errors.Is(err, model.ErrInsufficientStock) {
 		return &orderV1.ConflictError{
 			Error:   "CONFLICT",
 			Message: err.Error(),
@@ -87,7 +92,10 @@ func MapToPayOrderError(err error) orderV1.PayOrderRes {
 	}
 
 	// Валидация → 400
-	if errors.Is(err, model.ErrInvalidPaymentMethod) {
+	if errors.Is(err, model.ErrInvalidPaymentMethod) ||
+		errors.Is(err, model.ErrInvalidInstallments) ||
+		errors.Is(err, model.ErrInvalidPaymentRequest) ||
+		errors.Is(err, model.ErrEmptyUserUUID) {
 		return &orderV1.ValidationError{
 			Error:   "VALIDATION_ERROR",
 			Message: err.Error(),
@@ -103,7 +111,11 @@ func MapToPayOrderError(err error) orderV1.PayOrderRes {
 	}
 
 	// Conflict → 409
-	if errors.Is(err, model.ErrOrderAlreadyPaid) {
+	if errors.Is(err, model.ErrOrderAlreadyPaid) ||
+		errors.Is(err, model.ErrOrderAlreadyCancelled) ||
+		errors.Is(err, model.ErrInsufficientFunds) ||
+		errors.Is(err, model.ErrPaymentRejected) ||
+		errors.Is(err, model.ErrPaymentInProgress) {
 		return &orderV1.ConflictError{
 			Error:   "CONFLICT",
 			Message: err.Error(),
@@ -140,7 +152,8 @@ func MapToCancelOrderError(err error) orderV1.CancelOrderRes {
 	}
 
 	// Conflict → 409
-	if errors.Is(err, model.ErrOrderAlreadyPaid) {
+	if errors.Is(err, model.ErrOrderAlreadyPaid) ||
+		errors.Is(err, model.ErrPaymentInProgress) {
 		return &orderV1.ConflictError{
 			Error:   "CONFLICT",
 			Message: err.Error(),
@@ -160,5 +173,9 @@ func isExternalServiceError(err error) bool {
 	return strings.Contains(errMsg, "payment service") ||
 		strings.Contains(errMsg, "inventory service") ||
 		strings.Contains(errMsg, "failed to get parts") ||
+		strings.Contains(errMsg, "failed to validate configuration") ||
+		strings.Contains(errMsg, "failed to expand rocket model") ||
+		strings.Contains(errMsg, "failed to get part prices") ||
+		strings.Contains(errMsg, "failed to reserve stock") ||
 		strings.Contains(errMsg, "connection refused")
 }
@@ -0,0 +1,50 @@
+package decoder
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	def "github.com/Daniil-Sakharov/RocketFactory/order/internal/converter/kafka"
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/domain"
+	eventsv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/events/v1"
+)
+
+var _ def.PaymentDecoder = (*paymentDecoder)(nil)
+
+type paymentDecoder struct{}
+
+func NewPaymentDecoder() *paymentDecoder {
+	return &paymentDecoder{}
+}
+
+func (d *paymentDecoder) DecodeSucceeded(data []byte) (domain.PaymentSucceededConsumeEvent, error) {
+	var pb eventsv1.PaymentSucceeded
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return domain.PaymentSucceededConsumeEvent{}, fmt.Errorf("failed to unmarshal protobuf: %w", err)
+	}
+
+	return domain.PaymentSucceededConsumeEvent{
+		EventUUID:       pb.EventUuid,
+		TransactionUUID: pb.TransactionUuid,
+		OrderUUID:       pb.OrderUuid,
+		UserUUID:        pb.UserUuid,
+		PaymentMethod:   pb.PaymentMethod,
+	}, nil
+}
+
+func (d *paymentDecoder) DecodeFailed(data []byte) (domain.PaymentFailedConsumeEvent, error) {
+	var pb eventsv1.PaymentFailed
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return domain.PaymentFailedConsumeEvent{}, fmt.Errorf("failed to unmarshal protobuf: %w", err)
+	}
+
+	return domain.PaymentFailedConsumeEvent{
+		EventUUID:       pb.EventUuid,
+		TransactionUUID: pb.TransactionUuid,
+		OrderUUID:       pb.OrderUuid,
+		UserUUID:        pb.UserUuid,
+		PaymentMethod:   pb.PaymentMethod,
+		Reason:          pb.Reason,
+	}, nil
+}
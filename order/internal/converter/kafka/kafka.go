@@ -5,3 +5,8 @@ import "github.com/Daniil-Sakharov/RocketFactory/order/internal/model/domain"
 type AssemblyDecoder interface {
 	Decode(data []byte) (domain.AssemblyConsumeEvent, error)
 }
+
+type PaymentDecoder interface {
+	DecodeSucceeded(data []byte) (domain.PaymentSucceededConsumeEvent, error)
+	DecodeFailed(data []byte) (domain.PaymentFailedConsumeEvent, error)
+}
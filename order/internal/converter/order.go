@@ -10,9 +10,16 @@ import (
 )
 
 func CreateOrderRequestToServiceModel(req orderV1.CreateOrderRequest) *dto.CreateOrderRequest {
+	var rocketModelUUID string
+	if modelUUID, ok := req.RocketModelUUID.Get(); ok {
+		rocketModelUUID = modelUUID.String()
+	}
+
 	return &dto.CreateOrderRequest{
-		UserUUID:  req.UserUUID.String(),
-		PartUUIDs: UuidsToStrings(req.PartUuids),
+		UserUUID:        req.UserUUID.String(),
+		PartUUIDs:       UuidsToStrings(req.PartUuids),
+		RocketModelUUID: rocketModelUUID,
+		Quantity:        req.Quantity.Or(0),
 	}
 }
 
@@ -36,6 +43,24 @@ func GetOrderResponseFromEntity(order *domain.Order) *orderV1.GetOrderResponse {
 		}
 	}
 
+	var rocketModelUUID orderV1.OptUUID
+	if order.RocketModelUUID != "" {
+		if modelUUID, err := uuid.Parse(order.RocketModelUUID); err == nil {
+			rocketModelUUID.SetTo(modelUUID)
+		}
+	}
+
+	lineItems := make([]orderV1.LineItem, 0, len(order.LineItems))
+	for _, item := range order.LineItems {
+		if partUUID, err := uuid.Parse(item.PartUUID); err == nil {
+			lineItems = append(lineItems, orderV1.LineItem{
+				PartUUID:  partUUID,
+				Quantity:  item.Quantity,
+				UnitPrice: item.UnitPrice,
+			})
+		}
+	}
+
 	var transactionUUID orderV1.OptUUID
 	if order.TransactionUUID != "" {
 		if txUUID, err := uuid.Parse(order.TransactionUUID); err == nil {
@@ -52,6 +77,8 @@ func GetOrderResponseFromEntity(order *domain.Order) *orderV1.GetOrderResponse {
 		OrderUUID:       orderUUID,
 		UserUUID:        userUUID,
 		PartUuids:       partUUIDs,
+		RocketModelUUID: rocketModelUUID,
+		LineItems:       lineItems,
 		TotalPrice:      order.TotalPrice,
 		Status:          OrderStatusToOpenAPI(order.Status),
 		TransactionUUID: transactionUUID,
@@ -59,10 +86,12 @@ func GetOrderResponseFromEntity(order *domain.Order) *orderV1.GetOrderResponse {
 	}
 }
 
-func PayOrderRequestToServiceModel(req orderV1.PayOrderRequest, orderUUID string) *dto.PayOrderRequest {
+func PayOrderRequestToServiceModel(req orderV1.PayOrderRequest, orderUUID, payerUUID string) *dto.PayOrderRequest {
 	return &dto.PayOrderRequest{
 		OrderUUID:     orderUUID,
+		PayerUUID:     payerUUID,
 		PaymentMethod: PaymentMethodFromOpenAPI(req.PaymentMethod),
+		Installments:  req.Installments.Or(0),
 	}
 }
 
@@ -0,0 +1,28 @@
+package domain
+
+import "strings"
+
+// ConfigurationViolation - нарушенное правило совместимости деталей (из Inventory)
+type ConfigurationViolation struct {
+	// Уникальный идентификатор правила
+	RuleUUID string
+	// Детали, вызвавшие нарушение
+	PartUUIDs []string
+	// Пояснение нарушения
+	Message string
+}
+
+// ConfigurationValidation - результат проверки конфигурации ракеты
+type ConfigurationValidation struct {
+	Valid      bool
+	Violations []ConfigurationViolation
+}
+
+// Explain собирает пояснения всех нарушений в одну строку
+func (v *ConfigurationValidation) Explain() string {
+	messages := make([]string, 0, len(v.Violations))
+	for _, violation := range v.Violations {
+		messages = append(messages, violation.Message)
+	}
+	return strings.Join(messages, "; ")
+}
@@ -8,6 +8,7 @@ type OrderProduceEvent struct {
 	UserUUID        string
 	PaymentMethod   string
 	TransactionUUID string
+	TotalPrice      float64
 }
 
 type AssemblyConsumeEvent struct {
@@ -16,3 +17,20 @@ type AssemblyConsumeEvent struct {
 	UserUUID  string
 	BuildTime time.Duration
 }
+
+type PaymentSucceededConsumeEvent struct {
+	EventUUID       string
+	TransactionUUID string
+	OrderUUID       string
+	UserUUID        string
+	PaymentMethod   string
+}
+
+type PaymentFailedConsumeEvent struct {
+	EventUUID       string
+	TransactionUUID string
+	OrderUUID       string
+	UserUUID        string
+	PaymentMethod   string
+	Reason          string
+}
@@ -9,8 +9,17 @@ type Order struct {
 	OrderUUID       string           // UUID заказа
 	UserUUID        string           // UUID пользователя
 	PartUUIDs       []string         // Список UUID деталей
+	RocketModelUUID string           // UUID модели ракеты, если заказ создан по модели
+	LineItems       []LineItem       // Строки заказа с количеством и ценой
 	TotalPrice      float64          // Общая стоимость заказа
 	TransactionUUID string           // UUID транзакции (если оплачен)
 	PaymentMethod   vo.PaymentMethod // Способ оплаты
 	Status          vo.OrderStatus   // Статус заказа
 }
+
+// LineItem - строка заказа: деталь, ее количество и цена на момент создания заказа
+type LineItem struct {
+	PartUUID  string  // UUID детали
+	Quantity  int64   // Количество деталей
+	UnitPrice float64 // Цена за единицу
+}
@@ -81,3 +81,11 @@ type PartsFilter struct {
 	// Список тегов. Пусто — не фильтруем по тегам
 	Tags []string
 }
+
+// PartPrice - цена детали, действующая на заданный момент (из Inventory)
+type PartPrice struct {
+	PartUUID string
+	Price    float64
+	// С какого момента действует цена, nil — цена из карточки детали без истории
+	EffectiveFrom *time.Time
+}
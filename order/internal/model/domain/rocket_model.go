@@ -0,0 +1,25 @@
+package domain
+
+// RocketModelExpansion - модель ракеты, разложенная на детали (из Inventory)
+type RocketModelExpansion struct {
+	// Уникальный идентификатор модели
+	RocketModelUUID string
+	// Строки спецификации с выбранными деталями
+	Lines []RocketModelLine
+	// Стоимость всех строк
+	TotalPrice float64
+	// Все строки обеспечены остатком
+	Available bool
+}
+
+// RocketModelLine - строка раскладки модели: выбранная деталь и ее количество
+type RocketModelLine struct {
+	// Уникальный идентификатор детали
+	PartUUID string
+	// Количество с учетом количества ракет
+	Quantity int64
+	// Цена за единицу
+	UnitPrice float64
+	// Остатка детали хватает
+	Available bool
+}
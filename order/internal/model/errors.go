@@ -9,7 +9,18 @@ var (
 	ErrOrderAlreadyCancelled = errors.New("order already cancelled")
 	ErrEmptyUserUUID         = errors.New("user UUID is empty")
 	ErrEmptyPartUUIDs        = errors.New("part UUIDs are empty")
+	ErrConflictingOrderItems = errors.New("part UUIDs and rocket model are mutually exclusive")
+	ErrInvalidQuantity       = errors.New("quantity must be positive")
+	ErrRocketModelNotFound   = errors.New("rocket model not found")
 	ErrPartsNotFound         = errors.New("parts not found")
+	ErrInvalidConfiguration  = errors.New("invalid rocket configuration")
+	ErrInsufficientStock     = errors.New("insufficient stock")
 	ErrInvalidPaymentMethod  = errors.New("invalid payment method")
+	ErrInsufficientFunds     = errors.New("insufficient investor funds")
+	ErrPaymentRejected       = errors.New("payment rejected")
+	ErrInvalidInstallments   = errors.New("installments are not available for this order")
+	ErrPaymentInProgress     = errors.New("order payment is already in progress")
+	ErrInvalidPaymentRequest = errors.New("payment request rejected as invalid")
+	ErrPaymentNotFound       = errors.New("payment not found")
 	ErrUnknownError          = errors.New("unknown error")
 )
@@ -15,3 +15,17 @@ const (
 	// PaymentMethodINVESTORMONEY - деньги инвестора (внутренний метод)
 	PaymentMethodINVESTORMONEY PaymentMethod = "INVESTOR_MONEY"
 )
+
+// PaymentStatus - статус платежа в payment сервисе
+type PaymentStatus string
+
+const (
+	// PaymentStatusUNKNOWN - статус не распознан
+	PaymentStatusUNKNOWN PaymentStatus = "UNKNOWN"
+	// PaymentStatusPENDING - платеж ожидает подтверждения платежного шлюза
+	PaymentStatusPENDING PaymentStatus = "PENDING"
+	// PaymentStatusSUCCEEDED - платеж проведен
+	PaymentStatusSUCCEEDED PaymentStatus = "SUCCEEDED"
+	// PaymentStatusFAILED - платеж отклонен
+	PaymentStatusFAILED PaymentStatus = "FAILED"
+)
@@ -17,6 +17,8 @@ func RepoOrderToDomainModel(order *repoModel.Order) *domain.Order {
 		OrderUUID:       order.OrderUUID,
 		UserUUID:        order.UserUUID,
 		PartUUIDs:       order.PartUUIDs,
+		RocketModelUUID: order.RocketModelUUID.String,
+		LineItems:       lineItemsToDomain(order.LineItems),
 		TotalPrice:      order.TotalPrice.InexactFloat64(),
 		TransactionUUID: order.TransactionUUID.String,
 		PaymentMethod:   vo.PaymentMethod(order.PaymentMethod),
@@ -31,13 +33,45 @@ func DomainOrderToRepoModel(order *domain.Order) *repoModel.Order {
 		txUUID = sql.NullString{String: order.TransactionUUID, Valid: true}
 	}
 
+	// Конвертация RocketModelUUID: "" → NULL
+	var rocketModelUUID sql.NullString
+	if order.RocketModelUUID != "" {
+		rocketModelUUID = sql.NullString{String: order.RocketModelUUID, Valid: true}
+	}
+
 	return &repoModel.Order{
 		OrderUUID:       order.OrderUUID,
 		UserUUID:        order.UserUUID,
 		PartUUIDs:       pq.StringArray(order.PartUUIDs),
+		RocketModelUUID: rocketModelUUID,
+		LineItems:       lineItemsToRepo(order.LineItems),
 		TotalPrice:      decimal.NewFromFloat(order.TotalPrice),
 		TransactionUUID: txUUID,
 		PaymentMethod:   string(order.PaymentMethod),
 		Status:          string(order.Status),
 	}
 }
+
+func lineItemsToDomain(items repoModel.LineItems) []domain.LineItem {
+	result := make([]domain.LineItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, domain.LineItem{
+			PartUUID:  item.PartUUID,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice.InexactFloat64(),
+		})
+	}
+	return result
+}
+
+func lineItemsToRepo(items []domain.LineItem) repoModel.LineItems {
+	result := make(repoModel.LineItems, 0, len(items))
+	for _, item := range items {
+		result = append(result, repoModel.LineItem{
+			PartUUID:  item.PartUUID,
+			Quantity:  item.Quantity,
+			UnitPrice: decimal.NewFromFloat(item.UnitPrice),
+		})
+	}
+	return result
+}
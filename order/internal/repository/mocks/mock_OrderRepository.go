@@ -9,6 +9,10 @@ import (
 
 	domain "github.com/Daniil-Sakharov/RocketFactory/order/internal/model/domain"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	vo "github.com/Daniil-Sakharov/RocketFactory/order/internal/model/vo"
 )
 
 // OrderRepository is an autogenerated mock type for the OrderRepository type
@@ -24,6 +28,102 @@ func (_m *OrderRepository) EXPECT() *OrderRepository_Expecter {
 	return &OrderRepository_Expecter{mock: &_m.Mock}
 }
 
+// Cancel provides a mock function with given fields: ctx, orderUUID
+func (_m *OrderRepository) Cancel(ctx context.Context, orderUUID string) error {
+	ret := _m.Called(ctx, orderUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Cancel")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, orderUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderRepository_Cancel_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Cancel'
+type OrderRepository_Cancel_Call struct {
+	*mock.Call
+}
+
+// Cancel is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+func (_e *OrderRepository_Expecter) Cancel(ctx interface{}, orderUUID interface{}) *OrderRepository_Cancel_Call {
+	return &OrderRepository_Cancel_Call{Call: _e.mock.On("Cancel", ctx, orderUUID)}
+}
+
+func (_c *OrderRepository_Cancel_Call) Run(run func(ctx context.Context, orderUUID string)) *OrderRepository_Cancel_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_Cancel_Call) Return(_a0 error) *OrderRepository_Cancel_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderRepository_Cancel_Call) RunAndReturn(run func(context.Context, string) error) *OrderRepository_Cancel_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ClaimTransaction provides a mock function with given fields: ctx, orderUUID, transactionUUID, paymentMethod
+func (_m *OrderRepository) ClaimTransaction(ctx context.Context, orderUUID string, transactionUUID string, paymentMethod vo.PaymentMethod) error {
+	ret := _m.Called(ctx, orderUUID, transactionUUID, paymentMethod)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, vo.PaymentMethod) error); ok {
+		r0 = rf(ctx, orderUUID, transactionUUID, paymentMethod)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderRepository_ClaimTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimTransaction'
+type OrderRepository_ClaimTransaction_Call struct {
+	*mock.Call
+}
+
+// ClaimTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+//   - transactionUUID string
+//   - paymentMethod vo.PaymentMethod
+func (_e *OrderRepository_Expecter) ClaimTransaction(ctx interface{}, orderUUID interface{}, transactionUUID interface{}, paymentMethod interface{}) *OrderRepository_ClaimTransaction_Call {
+	return &OrderRepository_ClaimTransaction_Call{Call: _e.mock.On("ClaimTransaction", ctx, orderUUID, transactionUUID, paymentMethod)}
+}
+
+func (_c *OrderRepository_ClaimTransaction_Call) Run(run func(ctx context.Context, orderUUID string, transactionUUID string, paymentMethod vo.PaymentMethod)) *OrderRepository_ClaimTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(vo.PaymentMethod))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_ClaimTransaction_Call) Return(_a0 error) *OrderRepository_ClaimTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderRepository_ClaimTransaction_Call) RunAndReturn(run func(context.Context, string, string, vo.PaymentMethod) error) *OrderRepository_ClaimTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Create provides a mock function with given fields: ctx, order
 func (_m *OrderRepository) Create(ctx context.Context, order *domain.Order) error {
 	ret := _m.Called(ctx, order)
@@ -130,6 +230,114 @@ func (_c *OrderRepository_Get_Call) RunAndReturn(run func(context.Context, strin
 	return _c
 }
 
+// ListStaleClaims provides a mock function with given fields: ctx, claimedBefore, limit
+func (_m *OrderRepository) ListStaleClaims(ctx context.Context, claimedBefore time.Time, limit int) ([]*domain.Order, error) {
+	ret := _m.Called(ctx, claimedBefore, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListStaleClaims")
+	}
+
+	var r0 []*domain.Order
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]*domain.Order, error)); ok {
+		return rf(ctx, claimedBefore, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []*domain.Order); ok {
+		r0 = rf(ctx, claimedBefore, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.Order)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, claimedBefore, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderRepository_ListStaleClaims_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListStaleClaims'
+type OrderRepository_ListStaleClaims_Call struct {
+	*mock.Call
+}
+
+// ListStaleClaims is a helper method to define mock.On call
+//   - ctx context.Context
+//   - claimedBefore time.Time
+//   - limit int
+func (_e *OrderRepository_Expecter) ListStaleClaims(ctx interface{}, claimedBefore interface{}, limit interface{}) *OrderRepository_ListStaleClaims_Call {
+	return &OrderRepository_ListStaleClaims_Call{Call: _e.mock.On("ListStaleClaims", ctx, claimedBefore, limit)}
+}
+
+func (_c *OrderRepository_ListStaleClaims_Call) Run(run func(ctx context.Context, claimedBefore time.Time, limit int)) *OrderRepository_ListStaleClaims_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_ListStaleClaims_Call) Return(_a0 []*domain.Order, _a1 error) *OrderRepository_ListStaleClaims_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderRepository_ListStaleClaims_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]*domain.Order, error)) *OrderRepository_ListStaleClaims_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ReleaseTransaction provides a mock function with given fields: ctx, orderUUID, transactionUUID
+func (_m *OrderRepository) ReleaseTransaction(ctx context.Context, orderUUID string, transactionUUID string) error {
+	ret := _m.Called(ctx, orderUUID, transactionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseTransaction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, orderUUID, transactionUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderRepository_ReleaseTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseTransaction'
+type OrderRepository_ReleaseTransaction_Call struct {
+	*mock.Call
+}
+
+// ReleaseTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUID string
+//   - transactionUUID string
+func (_e *OrderRepository_Expecter) ReleaseTransaction(ctx interface{}, orderUUID interface{}, transactionUUID interface{}) *OrderRepository_ReleaseTransaction_Call {
+	return &OrderRepository_ReleaseTransaction_Call{Call: _e.mock.On("ReleaseTransaction", ctx, orderUUID, transactionUUID)}
+}
+
+func (_c *OrderRepository_ReleaseTransaction_Call) Run(run func(ctx context.Context, orderUUID string, transactionUUID string)) *OrderRepository_ReleaseTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *OrderRepository_ReleaseTransaction_Call) Return(_a0 error) *OrderRepository_ReleaseTransaction_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderRepository_ReleaseTransaction_Call) RunAndReturn(run func(context.Context, string, string) error) *OrderRepository_ReleaseTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, order
 func (_m *OrderRepository) Update(ctx context.Context, order *domain.Order) error {
 	ret := _m.Called(ctx, order)
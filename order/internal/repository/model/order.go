@@ -2,6 +2,9 @@ package model
 
 import (
 	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/lib/pq"
@@ -12,6 +15,8 @@ type Order struct {
 	OrderUUID       string          `db:"order_uuid"`
 	UserUUID        string          `db:"user_uuid"`
 	PartUUIDs       pq.StringArray  `db:"part_uuids"`
+	RocketModelUUID sql.NullString  `db:"rocket_model_uuid"`
+	LineItems       LineItems       `db:"line_items"`
 	TotalPrice      decimal.Decimal `db:"total_price"`
 	TransactionUUID sql.NullString  `db:"transaction_uuid"`
 	PaymentMethod   string          `db:"payment_method"`
@@ -20,6 +25,36 @@ type Order struct {
 	UpdatedAt       time.Time       `db:"updated_at"`
 }
 
+type LineItem struct {
+	PartUUID  string          `json:"part_uuid"`
+	Quantity  int64           `json:"quantity"`
+	UnitPrice decimal.Decimal `json:"unit_price"`
+}
+
+// LineItems хранится в JSONB колонке line_items
+type LineItems []LineItem
+
+func (l LineItems) Value() (driver.Value, error) {
+	if l == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(l)
+}
+
+func (l *LineItems) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*l = nil
+		return nil
+	case []byte:
+		return json.Unmarshal(v, l)
+	case string:
+		return json.Unmarshal([]byte(v), l)
+	default:
+		return fmt.Errorf("unsupported line items type %T", src)
+	}
+}
+
 type OrderStatus string
 
 const (
@@ -0,0 +1,57 @@
+package order
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/vo"
+)
+
+func (r *repository) Cancel(ctx context.Context, orderUUID string) error {
+	// Условие на transaction_uuid IS NULL не дает отменить заказ, пока Pay держит закрепление:
+	// иначе успешный платеж пришел бы по уже отмененному заказу
+	query := `
+		UPDATE orders
+		SET
+			order_status = 'CANCELLED',
+			updated_at = NOW()
+		WHERE order_uuid = $1
+		  AND order_status IN ('PENDING_PAYMENT', 'CANCELLED')
+		  AND transaction_uuid IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, orderUUID)
+	if err != nil {
+		return fmt.Errorf("failed to cancel order: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected > 0 {
+		return nil
+	}
+
+	var currentStatus string
+	err = r.db.GetContext(ctx, &currentStatus,
+		`SELECT order_status FROM orders WHERE order_uuid = $1`,
+		orderUUID,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.ErrOrderNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to check order status: %w", err)
+	}
+
+	switch vo.OrderStatus(currentStatus) {
+	case vo.OrderStatusPAID, vo.OrderStatusASSEMBLED:
+		return model.ErrOrderAlreadyPaid
+	default:
+		return model.ErrPaymentInProgress
+	}
+}
@@ -0,0 +1,60 @@
+package order
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/vo"
+)
+
+func (r *repository) ClaimTransaction(ctx context.Context, orderUUID, transactionUUID string, paymentMethod vo.PaymentMethod) error {
+	// Условие на transaction_uuid IS NULL делает закрепление атомарным: из параллельных Pay выигрывает один
+	query := `
+		UPDATE orders
+		SET
+			transaction_uuid = $2,
+			payment_method = $3,
+			transaction_claimed_at = NOW(),
+			updated_at = NOW()
+		WHERE order_uuid = $1
+		  AND order_status = 'PENDING_PAYMENT'
+		  AND transaction_uuid IS NULL
+	`
+
+	result, err := r.db.ExecContext(ctx, query, orderUUID, transactionUUID, string(paymentMethod))
+	if err != nil {
+		return fmt.Errorf("failed to claim transaction: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected > 0 {
+		return nil
+	}
+
+	var currentStatus string
+	err = r.db.GetContext(ctx, &currentStatus,
+		`SELECT order_status FROM orders WHERE order_uuid = $1`,
+		orderUUID,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return model.ErrOrderNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to check order status: %w", err)
+	}
+
+	switch vo.OrderStatus(currentStatus) {
+	case vo.OrderStatusPAID, vo.OrderStatusASSEMBLED:
+		return model.ErrOrderAlreadyPaid
+	case vo.OrderStatusCANCELLED:
+		return model.ErrOrderAlreadyCancelled
+	default:
+		return model.ErrPaymentInProgress
+	}
+}
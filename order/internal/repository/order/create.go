@@ -36,6 +36,8 @@ func (r *repository) Create(ctx context.Context, order *domain.Order) error {
             order_uuid,
             user_uuid,
             part_uuids,
+            rocket_model_uuid,
+            line_items,
             total_price,
             payment_method,
             order_status,
@@ -44,6 +46,8 @@ func (r *repository) Create(ctx context.Context, order *domain.Order) error {
             :order_uuid,
             :user_uuid,
             :part_uuids,
+            :rocket_model_uuid,
+            :line_items,
             :total_price,
             :payment_method,
             :order_status,
@@ -18,6 +18,8 @@ func (r *repository) Get(ctx context.Context, orderUUID string) (*domain.Order,
     		order_uuid,
     		user_uuid,
     		part_uuids,
+    		rocket_model_uuid,
+    		line_items,
     		total_price,
     		transaction_uuid,
     		payment_method,
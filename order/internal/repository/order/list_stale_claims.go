@@ -0,0 +1,44 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/domain"
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/repository/converter"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/order/internal/repository/model"
+)
+
+func (r *repository) ListStaleClaims(ctx context.Context, claimedBefore time.Time, limit int) ([]*domain.Order, error) {
+	query := `
+		SELECT
+    		order_uuid,
+    		user_uuid,
+    		part_uuids,
+    		rocket_model_uuid,
+    		line_items,
+    		total_price,
+    		transaction_uuid,
+    		payment_method,
+    		order_status
+		FROM orders
+		WHERE transaction_uuid IS NOT NULL
+		  AND order_status = 'PENDING_PAYMENT'
+		  AND transaction_claimed_at < $1
+		ORDER BY transaction_claimed_at
+		LIMIT $2;
+`
+
+	var repoOrders []repoModel.Order
+	if err := r.db.SelectContext(ctx, &repoOrders, query, claimedBefore, limit); err != nil {
+		return nil, fmt.Errorf("failed to list stale claims: %w", err)
+	}
+
+	orders := make([]*domain.Order, 0, len(repoOrders))
+	for i := range repoOrders {
+		orders = append(orders, converter.RepoOrderToDomainModel(&repoOrders[i]))
+	}
+
+	return orders, nil
+}
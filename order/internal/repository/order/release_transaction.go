@@ -0,0 +1,26 @@
+package order
+
+import (
+	"context"
+	"fmt"
+)
+
+func (r *repository) ReleaseTransaction(ctx context.Context, orderUUID, transactionUUID string) error {
+	// Снимаем только свое закрепление: заказ мог быть уже оплачен или отменен
+	query := `
+		UPDATE orders
+		SET
+			transaction_uuid = NULL,
+			transaction_claimed_at = NULL,
+			updated_at = NOW()
+		WHERE order_uuid = $1
+		  AND transaction_uuid = $2
+		  AND order_status = 'PENDING_PAYMENT'
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, orderUUID, transactionUUID); err != nil {
+		return fmt.Errorf("failed to release transaction: %w", err)
+	}
+
+	return nil
+}
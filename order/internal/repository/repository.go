@@ -2,12 +2,22 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/domain"
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/vo"
 )
 
 type OrderRepository interface {
 	Create(ctx context.Context, order *domain.Order) error
 	Get(ctx context.Context, orderUUID string) (*domain.Order, error)
 	Update(ctx context.Context, order *domain.Order) error
+	// Cancel отменяет заказ, если он не оплачен и оплата по нему не закреплена
+	Cancel(ctx context.Context, orderUUID string) error
+	// ClaimTransaction закрепляет транзакцию за неоплаченным заказом, если другой оплаты в процессе нет
+	ClaimTransaction(ctx context.Context, orderUUID, transactionUUID string, paymentMethod vo.PaymentMethod) error
+	// ReleaseTransaction снимает закрепление транзакции, если платеж по ней точно не проведен
+	ReleaseTransaction(ctx context.Context, orderUUID, transactionUUID string) error
+	// ListStaleClaims возвращает неоплаченные заказы, транзакция которых закреплена раньше claimedBefore
+	ListStaleClaims(ctx context.Context, claimedBefore time.Time, limit int) ([]*domain.Order, error)
 }
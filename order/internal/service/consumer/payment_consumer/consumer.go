@@ -0,0 +1,58 @@
+package payment_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	grpcClient "github.com/Daniil-Sakharov/RocketFactory/order/internal/client/grpc"
+	kafkaConverter "github.com/Daniil-Sakharov/RocketFactory/order/internal/converter/kafka"
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/repository"
+	def "github.com/Daniil-Sakharov/RocketFactory/order/internal/service"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/kafka"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+var _ def.PaymentConsumerService = (*service)(nil)
+
+type service struct {
+	paymentConsumer kafka.Consumer
+	paymentDecoder  kafkaConverter.PaymentDecoder
+	orderRepository repository.OrderRepository
+	orderProducer   def.OrderProducerService
+	inventoryClient grpcClient.InventoryClient
+	succeededTopic  string
+	failedTopic     string
+}
+
+func NewService(
+	paymentConsumer kafka.Consumer,
+	paymentDecoder kafkaConverter.PaymentDecoder,
+	orderRepository repository.OrderRepository,
+	orderProducer def.OrderProducerService,
+	inventoryClient grpcClient.InventoryClient,
+	succeededTopic string,
+	failedTopic string,
+) *service {
+	return &service{
+		paymentConsumer: paymentConsumer,
+		paymentDecoder:  paymentDecoder,
+		orderRepository: orderRepository,
+		orderProducer:   orderProducer,
+		inventoryClient: inventoryClient,
+		succeededTopic:  succeededTopic,
+		failedTopic:     failedTopic,
+	}
+}
+
+func (s *service) RunConsumer(ctx context.Context) error {
+	logger.Info(ctx, "Starting Payment events service")
+
+	err := s.paymentConsumer.Consume(ctx, s.paymentHandler)
+	if err != nil {
+		logger.Error(ctx, "Failed to consume from payment topics", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
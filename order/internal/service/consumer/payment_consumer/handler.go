@@ -0,0 +1,174 @@
+package payment_consumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/domain"
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/vo"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/kafka/consumer"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+func (s *service) paymentHandler(ctx context.Context, msg consumer.Message) error {
+	switch msg.Topic {
+	case s.succeededTopic:
+		return s.handlePaymentSucceeded(ctx, msg)
+	case s.failedTopic:
+		return s.handlePaymentFailed(ctx, msg)
+	default:
+		logger.Error(ctx, "Unexpected topic for payment consumer", zap.String("topic", msg.Topic))
+		return fmt.Errorf("unexpected topic: %s", msg.Topic)
+	}
+}
+
+func (s *service) handlePaymentSucceeded(ctx context.Context, msg consumer.Message) error {
+	event, err := s.paymentDecoder.DecodeSucceeded(msg.Value)
+	if err != nil {
+		logger.Error(ctx, "Failed to decode PaymentSucceeded event")
+		return err
+	}
+
+	if event.OrderUUID == "" {
+		logger.Error(ctx, "Invalid event: empty order_uuid")
+		return errors.New("invalid event")
+	}
+
+	logger.Info(ctx, "📨 Received PaymentSucceeded event",
+		zap.String("topic", msg.Topic),
+		zap.Any("partition", msg.Partition),
+		zap.Any("offset", msg.Offset),
+		zap.String("event_uuid", event.EventUUID),
+		zap.String("order_uuid", event.OrderUUID),
+		zap.String("transaction_uuid", event.TransactionUUID),
+	)
+
+	order, err := s.orderRepository.Get(ctx, event.OrderUUID)
+	if err != nil {
+		logger.Error(ctx, "Failed to get order", zap.Error(err))
+		return err
+	}
+
+	// Повторная доставка по оплаченному заказу: OrderPaid мог не уйти после смены статуса,
+	// поэтому событие публикуется заново, а не теряется вместе с коммитом оффсета
+	if order.Status == vo.OrderStatusPAID && order.TransactionUUID == event.TransactionUUID {
+		logger.Warn(ctx, "⚠️ PaymentSucceeded redelivered for paid order, republishing OrderPaid",
+			zap.String("order_uuid", order.OrderUUID),
+		)
+		return s.publishOrderPaid(ctx, order)
+	}
+
+	// Заказ уже собран или отменен — статус не трогаем
+	if order.Status != vo.OrderStatusPENDINGPAYMENT {
+		logger.Warn(ctx, "⚠️ PaymentSucceeded for order not awaiting payment, skipping",
+			zap.String("order_uuid", order.OrderUUID),
+			zap.String("status", string(order.Status)),
+		)
+		return nil
+	}
+
+	// Заказ оплачивается только той транзакцией, что за ним закреплена. Событие по чужой
+	// транзакции означает рассинхронизацию с payment — его разбирают вручную, а не засчитывают
+	if order.TransactionUUID != event.TransactionUUID {
+		logger.Error(ctx, "❌ PaymentSucceeded for transaction not attached to order, skipping",
+			zap.String("order_uuid", order.OrderUUID),
+			zap.String("order_transaction_uuid", order.TransactionUUID),
+			zap.String("event_transaction_uuid", event.TransactionUUID),
+		)
+		return nil
+	}
+
+	// Резерв оплаченного заказа становится расходом до смены статуса: при ошибке событие
+	// доставят повторно, а повторный расход по уже закрытому резерву ничего не записывает
+	if err = s.inventoryClient.ConsumeStock(ctx, order.OrderUUID); err != nil {
+		logger.Error(ctx, "Failed to consume reserved stock", zap.Error(err))
+		return fmt.Errorf("failed to consume stock: %w", err)
+	}
+
+	order.Status = vo.OrderStatusPAID
+	order.PaymentMethod = vo.PaymentMethod(event.PaymentMethod)
+
+	err = s.orderRepository.Update(ctx, order)
+	if err != nil {
+		logger.Error(ctx, "Failed to update order status to PAID", zap.Error(err))
+		return err
+	}
+
+	if err = s.publishOrderPaid(ctx, order); err != nil {
+		return err
+	}
+
+	logger.Info(ctx, "✅ Order status updated to PAID",
+		zap.String("order_uuid", order.OrderUUID),
+	)
+
+	return nil
+}
+
+// publishOrderPaid публикует OrderPaid. EventUUID выводится из транзакции заказа, поэтому
+// повторная публикация после redelivery несет тот же EventUUID и потребители могут ее отбросить
+func (s *service) publishOrderPaid(ctx context.Context, order *domain.Order) error {
+	err := s.orderProducer.PublishOrderPaid(ctx, &domain.OrderProduceEvent{
+		EventUUID:       uuid.NewSHA1(uuid.NameSpaceOID, []byte("order-paid:"+order.TransactionUUID)).String(),
+		OrderUUID:       order.OrderUUID,
+		UserUUID:        order.UserUUID,
+		PaymentMethod:   string(order.PaymentMethod),
+		TransactionUUID: order.TransactionUUID,
+		TotalPrice:      order.TotalPrice,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to produce order: %w", err)
+	}
+
+	return nil
+}
+
+func (s *service) handlePaymentFailed(ctx context.Context, msg consumer.Message) error {
+	event, err := s.paymentDecoder.DecodeFailed(msg.Value)
+	if err != nil {
+		logger.Error(ctx, "Failed to decode PaymentFailed event")
+		return err
+	}
+
+	if event.OrderUUID == "" {
+		logger.Error(ctx, "Invalid event: empty order_uuid")
+		return errors.New("invalid event")
+	}
+
+	logger.Info(ctx, "📨 Received PaymentFailed event",
+		zap.String("topic", msg.Topic),
+		zap.String("event_uuid", event.EventUUID),
+		zap.String("order_uuid", event.OrderUUID),
+		zap.String("transaction_uuid", event.TransactionUUID),
+		zap.String("reason", event.Reason),
+	)
+
+	order, err := s.orderRepository.Get(ctx, event.OrderUUID)
+	if err != nil {
+		logger.Error(ctx, "Failed to get order", zap.Error(err))
+		return err
+	}
+
+	// Сбрасываем транзакцию, только если она последняя по заказу — чтобы пользователь мог оплатить повторно
+	if order.Status != vo.OrderStatusPENDINGPAYMENT || order.TransactionUUID != event.TransactionUUID {
+		return nil
+	}
+
+	order.TransactionUUID = ""
+
+	err = s.orderRepository.Update(ctx, order)
+	if err != nil {
+		logger.Error(ctx, "Failed to reset order transaction", zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "↩️ Payment failed, order awaits new payment",
+		zap.String("order_uuid", order.OrderUUID),
+	)
+
+	return nil
+}
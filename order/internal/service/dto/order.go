@@ -3,13 +3,17 @@ package dto
 import "github.com/Daniil-Sakharov/RocketFactory/order/internal/model/vo"
 
 type CreateOrderRequest struct {
-	UserUUID  string   // UUID пользователя
-	PartUUIDs []string // Список UUID деталей
+	UserUUID        string   // UUID пользователя
+	PartUUIDs       []string // Список UUID деталей, деталь может повторяться
+	RocketModelUUID string   // UUID модели ракеты, указывается вместо PartUUIDs
+	Quantity        int64    // Количество ракет модели (0 - одна)
 }
 
 type PayOrderRequest struct {
 	OrderUUID     string           // UUID заказа
 	PaymentMethod vo.PaymentMethod // Метод оплаты
+	Installments  int32            // Количество платежей рассрочки (0 - без рассрочки)
+	PayerUUID     string           // UUID аутентифицированного пользователя, который оплачивает заказ
 }
 
 type GetOrderRequest struct {
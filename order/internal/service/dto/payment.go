@@ -3,9 +3,21 @@ package dto
 import "github.com/Daniil-Sakharov/RocketFactory/order/internal/model/vo"
 
 type PayOrderClientRequest struct {
-	OrderUUID     string           // UUID заказа
-	UserUUID      string           // UUID пользователя, который производит оплату
-	PaymentMethod vo.PaymentMethod // Метод оплаты
+	OrderUUID       string           // UUID заказа
+	UserUUID        string           // UUID пользователя, который производит оплату
+	PaymentMethod   vo.PaymentMethod // Метод оплаты
+	Amount          float64          // Сумма платежа
+	OwnerUUID       string           // UUID владельца заказа
+	Items           []PaymentItem    // Позиции заказа для чека
+	Installments    int32            // Количество платежей рассрочки
+	TransactionUUID string           // UUID транзакции, закрепленный за заказом
+}
+
+type PaymentItem struct {
+	PartUUID  string  // UUID детали
+	Name      string  // Название детали
+	Quantity  int32   // Количество
+	UnitPrice float64 // Цена за единицу
 }
 
 type PayOrderClientResponse struct {
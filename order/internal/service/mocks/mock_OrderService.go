@@ -11,6 +11,8 @@ import (
 	dto "github.com/Daniil-Sakharov/RocketFactory/order/internal/service/dto"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // OrderService is an autogenerated mock type for the OrderService type
@@ -250,6 +252,63 @@ func (_c *OrderService_Pay_Call) RunAndReturn(run func(context.Context, *dto.Pay
 	return _c
 }
 
+// ReleaseStaleClaims provides a mock function with given fields: ctx, claimedBefore
+func (_m *OrderService) ReleaseStaleClaims(ctx context.Context, claimedBefore time.Time) (int, error) {
+	ret := _m.Called(ctx, claimedBefore)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReleaseStaleClaims")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, claimedBefore)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, claimedBefore)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, claimedBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderService_ReleaseStaleClaims_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReleaseStaleClaims'
+type OrderService_ReleaseStaleClaims_Call struct {
+	*mock.Call
+}
+
+// ReleaseStaleClaims is a helper method to define mock.On call
+//   - ctx context.Context
+//   - claimedBefore time.Time
+func (_e *OrderService_Expecter) ReleaseStaleClaims(ctx interface{}, claimedBefore interface{}) *OrderService_ReleaseStaleClaims_Call {
+	return &OrderService_ReleaseStaleClaims_Call{Call: _e.mock.On("ReleaseStaleClaims", ctx, claimedBefore)}
+}
+
+func (_c *OrderService_ReleaseStaleClaims_Call) Run(run func(ctx context.Context, claimedBefore time.Time)) *OrderService_ReleaseStaleClaims_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *OrderService_ReleaseStaleClaims_Call) Return(_a0 int, _a1 error) *OrderService_ReleaseStaleClaims_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderService_ReleaseStaleClaims_Call) RunAndReturn(run func(context.Context, time.Time) (int, error)) *OrderService_ReleaseStaleClaims_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewOrderService creates a new instance of OrderService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewOrderService(t interface {
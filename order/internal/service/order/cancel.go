@@ -17,16 +17,23 @@ func (s *service) Cancel(ctx context.Context, req *dto.CancelOrderRequest) error
 		}
 		return model.ErrUnknownError
 	}
-	if order.Status == vo.OrderStatusPAID {
+	if order.Status == vo.OrderStatusPAID || order.Status == vo.OrderStatusASSEMBLED {
 		return model.ErrOrderAlreadyPaid
 	}
-	order.Status = vo.OrderStatusCANCELLED
-	err = s.orderRepository.Update(ctx, order)
+	// Пока транзакция закреплена, исход платежа неизвестен: отмена дождется PaymentFailed
+	// или снятия зависшего закрепления
+	if order.TransactionUUID != "" {
+		return model.ErrPaymentInProgress
+	}
+	err = s.orderRepository.Cancel(ctx, order.OrderUUID)
 	if err != nil {
-		if errors.Is(err, model.ErrOrderNotFound) {
+		if errors.Is(err, model.ErrOrderNotFound) ||
+			errors.Is(err, model.ErrOrderAlreadyPaid) ||
+			errors.Is(err, model.ErrPaymentInProgress) {
 			return err
 		}
 		return model.ErrUnknownError
 	}
+	s.releaseStock(ctx, order.OrderUUID)
 	return nil
 }
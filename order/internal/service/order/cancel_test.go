@@ -34,13 +34,8 @@ func (s *ServiceSuite) TestCancelOrderSuccess() {
 
 	s.orderRepository.On("Get", s.ctx, orderUUID).Return(orderFromDB, nil)
 
-	s.orderRepository.On("Update", s.ctx, mock.MatchedBy(func(order *domain.Order) bool {
-		return order.OrderUUID == orderUUID &&
-			order.UserUUID == userUUID &&
-			order.TransactionUUID == "" &&
-			order.PaymentMethod == "" &&
-			order.Status == vo.OrderStatusCANCELLED
-	})).Return(nil)
+	s.inventoryClient.On("ReleaseStock", s.ctx, orderUUID).Return(nil)
+	s.orderRepository.On("Cancel", s.ctx, orderUUID).Return(nil)
 
 	err := s.service.Cancel(s.ctx, cancelOrderRequest)
 
@@ -95,3 +90,54 @@ func (s *ServiceSuite) TestCancelOrderNotFound() {
 	s.Require().Error(err)
 	s.Require().ErrorIs(err, model.ErrOrderNotFound)
 }
+
+func (s *ServiceSuite) TestCancelOrderPaymentInProgress() {
+	var (
+		orderUUID = gofakeit.UUID()
+
+		cancelOrderRequest = &dto.CancelOrderRequest{OrderUUID: orderUUID}
+
+		claimedOrderFromDB = &domain.Order{
+			OrderUUID:       orderUUID,
+			UserUUID:        gofakeit.UUID(),
+			PartUUIDs:       []string{gofakeit.UUID()},
+			TotalPrice:      20_000.00,
+			TransactionUUID: gofakeit.UUID(),
+			PaymentMethod:   vo.PaymentMethodCARD,
+			Status:          vo.OrderStatusPENDINGPAYMENT,
+		}
+	)
+
+	s.orderRepository.On("Get", s.ctx, orderUUID).Return(claimedOrderFromDB, nil).Once()
+
+	err := s.service.Cancel(s.ctx, cancelOrderRequest)
+
+	s.Require().ErrorIs(err, model.ErrPaymentInProgress)
+	s.orderRepository.AssertNotCalled(s.T(), "Cancel", mock.Anything, mock.Anything)
+	s.inventoryClient.AssertNotCalled(s.T(), "ReleaseStock", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestCancelOrderClaimedConcurrently() {
+	var (
+		orderUUID = gofakeit.UUID()
+
+		cancelOrderRequest = &dto.CancelOrderRequest{OrderUUID: orderUUID}
+
+		orderFromDB = &domain.Order{
+			OrderUUID:  orderUUID,
+			UserUUID:   gofakeit.UUID(),
+			PartUUIDs:  []string{gofakeit.UUID()},
+			TotalPrice: 20_000.00,
+			Status:     vo.OrderStatusPENDINGPAYMENT,
+		}
+	)
+
+	// Pay закрепил транзакцию между чтением заказа и отменой
+	s.orderRepository.On("Get", s.ctx, orderUUID).Return(orderFromDB, nil).Once()
+	s.orderRepository.On("Cancel", s.ctx, orderUUID).Return(model.ErrPaymentInProgress).Once()
+
+	err := s.service.Cancel(s.ctx, cancelOrderRequest)
+
+	s.Require().ErrorIs(err, model.ErrPaymentInProgress)
+	s.inventoryClient.AssertNotCalled(s.T(), "ReleaseStock", mock.Anything, mock.Anything)
+}
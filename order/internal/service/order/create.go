@@ -4,47 +4,78 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 
 	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model"
 	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/domain"
 	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/vo"
 	"github.com/Daniil-Sakharov/RocketFactory/order/internal/service/dto"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
 )
 
 func (s *service) Create(ctx context.Context, req *dto.CreateOrderRequest) (*domain.Order, error) {
 	if req.UserUUID == "" {
 		return nil, model.ErrEmptyUserUUID
 	}
-	if len(req.PartUUIDs) == 0 {
+
+	var (
+		lineItems []domain.LineItem
+		err       error
+	)
+	switch {
+	case req.RocketModelUUID != "" && len(req.PartUUIDs) > 0:
+		return nil, model.ErrConflictingOrderItems
+	case req.RocketModelUUID != "":
+		lineItems, err = s.rocketModelLineItems(ctx, req.RocketModelUUID, req.Quantity)
+	case len(req.PartUUIDs) > 0:
+		lineItems, err = s.partsLineItems(ctx, req.PartUUIDs)
+	default:
 		return nil, model.ErrEmptyPartUUIDs
 	}
+	if err != nil {
+		return nil, err
+	}
 
-	parts, err := s.inventoryClient.ListParts(ctx, &domain.PartsFilter{
-		Uuids: req.PartUUIDs,
-	})
+	partUUIDs := lineItemPartUUIDs(lineItems)
+
+	validation, err := s.inventoryClient.ValidateConfiguration(ctx, partUUIDs)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get parts: %w", err)
+		return nil, fmt.Errorf("failed to validate configuration: %w", err)
+	}
+	if !validation.Valid {
+		return nil, fmt.Errorf("%w: %s", model.ErrInvalidConfiguration, validation.Explain())
 	}
 
-	if len(parts) == 0 {
-		return nil, model.ErrPartsNotFound
+	if err = s.applyEffectivePrices(ctx, lineItems, time.Now()); err != nil {
+		return nil, err
 	}
 
-	totalPrice := calculateTotalPrice(parts)
+	orderUUID := uuid.NewString()
+	if err = s.inventoryClient.ReserveStock(ctx, orderUUID, lineItems); err != nil {
+		if errors.Is(err, model.ErrInsufficientStock) || errors.Is(err, model.ErrPartsNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to reserve stock: %w", err)
+	}
 
 	newOrder := &domain.Order{
-		OrderUUID:       uuid.NewString(),
+		OrderUUID:       orderUUID,
 		UserUUID:        req.UserUUID,
-		PartUUIDs:       req.PartUUIDs,
-		TotalPrice:      totalPrice,
+		PartUUIDs:       partUUIDs,
+		RocketModelUUID: req.RocketModelUUID,
+		LineItems:       lineItems,
+		TotalPrice:      calculateTotalPrice(lineItems),
 		TransactionUUID: "",
 		PaymentMethod:   vo.PaymentMethodUNKNOWN,
 		Status:          vo.OrderStatusPENDINGPAYMENT,
 	}
 	err = s.orderRepository.Create(ctx, newOrder)
 	if err != nil {
+		// Заказ не сохранен — резерв под него никому не нужен
+		s.releaseStock(ctx, orderUUID)
 		if errors.Is(err, model.ErrOrderAlreadyExist) {
 			return nil, err
 		}
@@ -53,10 +84,112 @@ func (s *service) Create(ctx context.Context, req *dto.CreateOrderRequest) (*dom
 	return newOrder, nil
 }
 
-func calculateTotalPrice(parts []*domain.Part) float64 {
-	var total float64
+// partsLineItems собирает строки заказа из списка деталей: повтор детали увеличивает ее количество
+func (s *service) partsLineItems(ctx context.Context, partUUIDs []string) ([]domain.LineItem, error) {
+	parts, err := s.inventoryClient.ListParts(ctx, &domain.PartsFilter{
+		Uuids: partUUIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get parts: %w", err)
+	}
+
+	if len(parts) == 0 {
+		return nil, model.ErrPartsNotFound
+	}
+
+	quantities := make(map[string]int64, len(partUUIDs))
+	for _, partUUID := range partUUIDs {
+		quantities[partUUID]++
+	}
+	if len(parts) < len(quantities) {
+		return nil, model.ErrPartsNotFound
+	}
+
+	lineItems := make([]domain.LineItem, 0, len(parts))
 	for _, part := range parts {
-		total += part.Price
+		lineItems = append(lineItems, domain.LineItem{
+			PartUUID:  part.Uuid,
+			Quantity:  quantities[part.Uuid],
+			UnitPrice: part.Price,
+		})
+	}
+	return lineItems, nil
+}
+
+// rocketModelLineItems раскладывает модель ракеты на строки заказа по ее спецификации
+func (s *service) rocketModelLineItems(ctx context.Context, rocketModelUUID string, quantity int64) ([]domain.LineItem, error) {
+	if quantity < 0 {
+		return nil, model.ErrInvalidQuantity
+	}
+
+	expansion, err := s.inventoryClient.ExpandRocketModel(ctx, rocketModelUUID, quantity)
+	if err != nil {
+		if errors.Is(err, model.ErrRocketModelNotFound) ||
+			errors.Is(err, model.ErrPartsNotFound) ||
+			errors.Is(err, model.ErrInvalidQuantity) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to expand rocket model: %w", err)
+	}
+
+	lineItems := make([]domain.LineItem, 0, len(expansion.Lines))
+	for _, line := range expansion.Lines {
+		lineItems = append(lineItems, domain.LineItem{
+			PartUUID:  line.PartUUID,
+			Quantity:  line.Quantity,
+			UnitPrice: line.UnitPrice,
+		})
+	}
+	return lineItems, nil
+}
+
+// applyEffectivePrices проставляет в строки заказа цены, действующие на момент заказа.
+// Карточка детали может еще хранить старую цену, если запланированная цена уже вступила в силу
+func (s *service) applyEffectivePrices(ctx context.Context, lineItems []domain.LineItem, orderedAt time.Time) error {
+	prices, err := s.inventoryClient.GetPartPrices(ctx, lineItemPartUUIDs(lineItems), orderedAt)
+	if err != nil {
+		if errors.Is(err, model.ErrPartsNotFound) {
+			return err
+		}
+		return fmt.Errorf("failed to get part prices: %w", err)
+	}
+
+	unitPrices := make(map[string]float64, len(prices))
+	for _, price := range prices {
+		unitPrices[price.PartUUID] = price.Price
+	}
+
+	for i := range lineItems {
+		if price, ok := unitPrices[lineItems[i].PartUUID]; ok {
+			lineItems[i].UnitPrice = price
+		}
+	}
+	return nil
+}
+
+// releaseStock снимает резерв заказа. Ошибка только логируется: резерв по ссылке
+// можно снять повторно, а отменяемую операцию она не должна блокировать
+func (s *service) releaseStock(ctx context.Context, orderUUID string) {
+	if err := s.inventoryClient.ReleaseStock(ctx, orderUUID); err != nil {
+		logger.Warn(ctx, "⚠️ Не удалось снять резерв заказа",
+			zap.String("order_uuid", orderUUID),
+			zap.Error(err),
+		)
+	}
+}
+
+func lineItemPartUUIDs(lineItems []domain.LineItem) []string {
+	partUUIDs := make([]string, 0, len(lineItems))
+	for _, item := range lineItems {
+		partUUIDs = append(partUUIDs, item.PartUUID)
+	}
+	return partUUIDs
+}
+
+func calculateTotalPrice(lineItems []domain.LineItem) float64 {
+	var total float64
+	for _, item := range lineItems {
+		total += item.UnitPrice * float64(item.Quantity)
 	}
 	return total
 }
@@ -2,10 +2,12 @@ package order
 
 import (
 	"errors"
+	"time"
 
 	"github.com/brianvoe/gofakeit/v7"
 	"github.com/stretchr/testify/mock"
 
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model"
 	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/domain"
 	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/vo"
 	"github.com/Daniil-Sakharov/RocketFactory/order/internal/service/dto"
@@ -49,7 +51,15 @@ func (s *ServiceSuite) TestCreateOrderSuccess() {
 	)
 
 	s.inventoryClient.On("ListParts", s.ctx, filter).Return(partsFromInventory, nil)
+	s.inventoryClient.On("ValidateConfiguration", s.ctx, request.PartUUIDs).
+		Return(&domain.ConfigurationValidation{Valid: true}, nil)
+	s.inventoryClient.On("GetPartPrices", s.ctx, request.PartUUIDs, mock.AnythingOfType("time.Time")).
+		Return([]*domain.PartPrice{
+			{PartUUID: partUUID1, Price: 25000000.00},
+			{PartUUID: partUUID2, Price: 150.00},
+		}, nil)
 
+	s.inventoryClient.On("ReserveStock", s.ctx, mock.AnythingOfType("string"), mock.AnythingOfType("[]domain.LineItem")).Return(nil)
 	s.orderRepository.On("Create", s.ctx, mock.MatchedBy(func(order *domain.Order) bool {
 		return order.UserUUID == userUUID &&
 			len(order.PartUUIDs) == 2 &&
@@ -94,3 +104,300 @@ func (s *ServiceSuite) TestCreateOrderInventoryServiceError() {
 	s.Require().Nil(order)
 	s.Require().Contains(err.Error(), "failed to get parts")
 }
+
+func (s *ServiceSuite) TestCreateOrderInvalidConfiguration() {
+	var (
+		userUUID = gofakeit.UUID()
+		fuelUUID = gofakeit.UUID()
+
+		request = &dto.CreateOrderRequest{
+			UserUUID:  userUUID,
+			PartUUIDs: []string{fuelUUID},
+		}
+
+		partsFromInventory = []*domain.Part{
+			{Uuid: fuelUUID, Name: "LH2", Price: 150.00, Category: domain.CATEGORY_FUEL},
+		}
+	)
+
+	s.inventoryClient.On("ListParts", s.ctx, &domain.PartsFilter{Uuids: request.PartUUIDs}).
+		Return(partsFromInventory, nil)
+	s.inventoryClient.On("ValidateConfiguration", s.ctx, request.PartUUIDs).
+		Return(&domain.ConfigurationValidation{
+			Violations: []domain.ConfigurationViolation{
+				{Message: "configuration requires a part of category ENGINE"},
+			},
+		}, nil)
+
+	order, err := s.service.Create(s.ctx, request)
+
+	s.Require().ErrorIs(err, model.ErrInvalidConfiguration)
+	s.Require().Contains(err.Error(), "requires a part of category ENGINE")
+	s.Require().Nil(order)
+}
+
+func (s *ServiceSuite) TestCreateOrderDuplicatePartsQuantity() {
+	var (
+		userUUID   = gofakeit.UUID()
+		engineUUID = gofakeit.UUID()
+		fuelUUID   = gofakeit.UUID()
+
+		request = &dto.CreateOrderRequest{
+			UserUUID:  userUUID,
+			PartUUIDs: []string{engineUUID, fuelUUID, engineUUID},
+		}
+
+		partsFromInventory = []*domain.Part{
+			{Uuid: engineUUID, Name: "Merlin", Price: 1000.00, Category: domain.CATEGORY_ENGINE},
+			{Uuid: fuelUUID, Name: "LOX", Price: 50.00, Category: domain.CATEGORY_FUEL},
+		}
+	)
+
+	s.inventoryClient.On("ListParts", s.ctx, &domain.PartsFilter{Uuids: request.PartUUIDs}).
+		Return(partsFromInventory, nil)
+	s.inventoryClient.On("ValidateConfiguration", s.ctx, []string{engineUUID, fuelUUID}).
+		Return(&domain.ConfigurationValidation{Valid: true}, nil)
+	s.inventoryClient.On("GetPartPrices", s.ctx, []string{engineUUID, fuelUUID}, mock.AnythingOfType("time.Time")).
+		Return([]*domain.PartPrice{
+			{PartUUID: engineUUID, Price: 1000.00},
+			{PartUUID: fuelUUID, Price: 50.00},
+		}, nil)
+	s.inventoryClient.On("ReserveStock", s.ctx, mock.AnythingOfType("string"), mock.AnythingOfType("[]domain.LineItem")).Return(nil)
+	s.orderRepository.On("Create", s.ctx, mock.AnythingOfType("*domain.Order")).Return(nil)
+
+	order, err := s.service.Create(s.ctx, request)
+
+	s.Require().NoError(err)
+	s.Require().Equal(2050.00, order.TotalPrice)
+	s.Require().Equal([]domain.LineItem{
+		{PartUUID: engineUUID, Quantity: 2, UnitPrice: 1000.00},
+		{PartUUID: fuelUUID, Quantity: 1, UnitPrice: 50.00},
+	}, order.LineItems)
+}
+
+func (s *ServiceSuite) TestCreateOrderUsesEffectivePrice() {
+	var (
+		engineUUID    = gofakeit.UUID()
+		effectiveFrom = time.Now().Add(-time.Minute)
+
+		request = &dto.CreateOrderRequest{
+			UserUUID:  gofakeit.UUID(),
+			PartUUIDs: []string{engineUUID, engineUUID},
+		}
+	)
+
+	// Карточка детали еще хранит старую цену, но запланированная цена уже вступила в силу
+	s.inventoryClient.On("ListParts", s.ctx, &domain.PartsFilter{Uuids: request.PartUUIDs}).
+		Return([]*domain.Part{{Uuid: engineUUID, Price: 1000.00, Category: domain.CATEGORY_ENGINE}}, nil)
+	s.inventoryClient.On("ValidateConfiguration", s.ctx, []string{engineUUID}).
+		Return(&domain.ConfigurationValidation{Valid: true}, nil)
+	s.inventoryClient.On("GetPartPrices", s.ctx, []string{engineUUID}, mock.AnythingOfType("time.Time")).
+		Return([]*domain.PartPrice{{PartUUID: engineUUID, Price: 1200.00, EffectiveFrom: &effectiveFrom}}, nil)
+	s.inventoryClient.On("ReserveStock", s.ctx, mock.AnythingOfType("string"), mock.AnythingOfType("[]domain.LineItem")).Return(nil)
+	s.orderRepository.On("Create", s.ctx, mock.AnythingOfType("*domain.Order")).Return(nil)
+
+	order, err := s.service.Create(s.ctx, request)
+
+	s.Require().NoError(err)
+	s.Require().Equal(2400.00, order.TotalPrice)
+	s.Require().Equal(1200.00, order.LineItems[0].UnitPrice)
+}
+
+func (s *ServiceSuite) TestCreateOrderPriceLookupError() {
+	var (
+		engineUUID = gofakeit.UUID()
+		pricesErr  = errors.New("inventory unavailable")
+
+		request = &dto.CreateOrderRequest{
+			UserUUID:  gofakeit.UUID(),
+			PartUUIDs: []string{engineUUID},
+		}
+	)
+
+	s.inventoryClient.On("ListParts", s.ctx, &domain.PartsFilter{Uuids: request.PartUUIDs}).
+		Return([]*domain.Part{{Uuid: engineUUID, Price: 1000.00, Category: domain.CATEGORY_ENGINE}}, nil)
+	s.inventoryClient.On("ValidateConfiguration", s.ctx, []string{engineUUID}).
+		Return(&domain.ConfigurationValidation{Valid: true}, nil)
+	s.inventoryClient.On("GetPartPrices", s.ctx, []string{engineUUID}, mock.AnythingOfType("time.Time")).
+		Return(nil, pricesErr)
+
+	order, err := s.service.Create(s.ctx, request)
+
+	s.Require().ErrorIs(err, pricesErr)
+	s.Require().Nil(order)
+}
+
+func (s *ServiceSuite) TestCreateOrderInsufficientStock() {
+	var (
+		engineUUID = gofakeit.UUID()
+
+		request = &dto.CreateOrderRequest{
+			UserUUID:  gofakeit.UUID(),
+			PartUUIDs: []string{engineUUID},
+		}
+	)
+
+	s.inventoryClient.On("ListParts", s.ctx, &domain.PartsFilter{Uuids: request.PartUUIDs}).
+		Return([]*domain.Part{{Uuid: engineUUID, Price: 1000.00, Category: domain.CATEGORY_ENGINE}}, nil)
+	s.inventoryClient.On("ValidateConfiguration", s.ctx, []string{engineUUID}).
+		Return(&domain.ConfigurationValidation{Valid: true}, nil)
+	s.inventoryClient.On("GetPartPrices", s.ctx, []string{engineUUID}, mock.AnythingOfType("time.Time")).
+		Return([]*domain.PartPrice{{PartUUID: engineUUID, Price: 1000.00}}, nil)
+	s.inventoryClient.On("ReserveStock", s.ctx, mock.AnythingOfType("string"), []domain.LineItem{
+		{PartUUID: engineUUID, Quantity: 1, UnitPrice: 1000.00},
+	}).Return(model.ErrInsufficientStock)
+
+	order, err := s.service.Create(s.ctx, request)
+
+	s.Require().ErrorIs(err, model.ErrInsufficientStock)
+	s.Require().Nil(order)
+}
+
+func (s *ServiceSuite) TestCreateOrderReleasesStockWhenSaveFails() {
+	var (
+		engineUUID = gofakeit.UUID()
+		reserved   string
+
+		request = &dto.CreateOrderRequest{
+			UserUUID:  gofakeit.UUID(),
+			PartUUIDs: []string{engineUUID},
+		}
+	)
+
+	s.inventoryClient.On("ListParts", s.ctx, &domain.PartsFilter{Uuids: request.PartUUIDs}).
+		Return([]*domain.Part{{Uuid: engineUUID, Price: 1000.00, Category: domain.CATEGORY_ENGINE}}, nil)
+	s.inventoryClient.On("ValidateConfiguration", s.ctx, []string{engineUUID}).
+		Return(&domain.ConfigurationValidation{Valid: true}, nil)
+	s.inventoryClient.On("GetPartPrices", s.ctx, []string{engineUUID}, mock.AnythingOfType("time.Time")).
+		Return([]*domain.PartPrice{{PartUUID: engineUUID, Price: 1000.00}}, nil)
+	s.inventoryClient.On("ReserveStock", s.ctx, mock.AnythingOfType("string"), mock.AnythingOfType("[]domain.LineItem")).
+		Run(func(args mock.Arguments) { reserved = args.String(1) }).
+		Return(nil)
+	s.orderRepository.On("Create", s.ctx, mock.AnythingOfType("*domain.Order")).Return(errors.New("db down"))
+	s.inventoryClient.On("ReleaseStock", s.ctx, mock.MatchedBy(func(orderUUID string) bool {
+		return orderUUID == reserved
+	})).Return(nil)
+
+	order, err := s.service.Create(s.ctx, request)
+
+	s.Require().Error(err)
+	s.Require().Nil(order)
+}
+
+func (s *ServiceSuite) TestCreateOrderMissingPart() {
+	var (
+		engineUUID = gofakeit.UUID()
+
+		request = &dto.CreateOrderRequest{
+			UserUUID:  gofakeit.UUID(),
+			PartUUIDs: []string{engineUUID, gofakeit.UUID()},
+		}
+	)
+
+	s.inventoryClient.On("ListParts", s.ctx, &domain.PartsFilter{Uuids: request.PartUUIDs}).
+		Return([]*domain.Part{{Uuid: engineUUID, Price: 1000.00}}, nil)
+
+	order, err := s.service.Create(s.ctx, request)
+
+	s.Require().ErrorIs(err, model.ErrPartsNotFound)
+	s.Require().Nil(order)
+}
+
+func (s *ServiceSuite) TestCreateOrderFromRocketModel() {
+	var (
+		userUUID   = gofakeit.UUID()
+		modelUUID  = gofakeit.UUID()
+		engineUUID = gofakeit.UUID()
+		wingUUID   = gofakeit.UUID()
+
+		request = &dto.CreateOrderRequest{
+			UserUUID:        userUUID,
+			RocketModelUUID: modelUUID,
+			Quantity:        2,
+		}
+	)
+
+	s.inventoryClient.On("ExpandRocketModel", s.ctx, modelUUID, int64(2)).
+		Return(&domain.RocketModelExpansion{
+			RocketModelUUID: modelUUID,
+			Lines: []domain.RocketModelLine{
+				{PartUUID: engineUUID, Quantity: 18, UnitPrice: 1000.00, Available: true},
+				{PartUUID: wingUUID, Quantity: 8, UnitPrice: 250.00, Available: true},
+			},
+			TotalPrice: 20000.00,
+			Available:  true,
+		}, nil)
+	s.inventoryClient.On("ValidateConfiguration", s.ctx, []string{engineUUID, wingUUID}).
+		Return(&domain.ConfigurationValidation{Valid: true}, nil)
+	s.inventoryClient.On("GetPartPrices", s.ctx, []string{engineUUID, wingUUID}, mock.AnythingOfType("time.Time")).
+		Return([]*domain.PartPrice{
+			{PartUUID: engineUUID, Price: 1000.00},
+			{PartUUID: wingUUID, Price: 250.00},
+		}, nil)
+	s.inventoryClient.On("ReserveStock", s.ctx, mock.AnythingOfType("string"), mock.AnythingOfType("[]domain.LineItem")).Return(nil)
+	s.orderRepository.On("Create", s.ctx, mock.MatchedBy(func(order *domain.Order) bool {
+		return order.RocketModelUUID == modelUUID && len(order.LineItems) == 2
+	})).Return(nil)
+
+	order, err := s.service.Create(s.ctx, request)
+
+	s.Require().NoError(err)
+	s.Require().Equal(20000.00, order.TotalPrice)
+	s.Require().Equal([]string{engineUUID, wingUUID}, order.PartUUIDs)
+	s.Require().Equal(int64(18), order.LineItems[0].Quantity)
+}
+
+func (s *ServiceSuite) TestCreateOrderRocketModelNotFound() {
+	modelUUID := gofakeit.UUID()
+
+	s.inventoryClient.On("ExpandRocketModel", s.ctx, modelUUID, int64(0)).
+		Return(nil, model.ErrRocketModelNotFound)
+
+	order, err := s.service.Create(s.ctx, &dto.CreateOrderRequest{
+		UserUUID:        gofakeit.UUID(),
+		RocketModelUUID: modelUUID,
+	})
+
+	s.Require().ErrorIs(err, model.ErrRocketModelNotFound)
+	s.Require().Nil(order)
+}
+
+func (s *ServiceSuite) TestCreateOrderInvalidItems() {
+	tests := []struct {
+		name    string
+		request *dto.CreateOrderRequest
+		err     error
+	}{
+		{
+			name:    "no parts and no model",
+			request: &dto.CreateOrderRequest{UserUUID: gofakeit.UUID()},
+			err:     model.ErrEmptyPartUUIDs,
+		},
+		{
+			name: "parts and model together",
+			request: &dto.CreateOrderRequest{
+				UserUUID:        gofakeit.UUID(),
+				PartUUIDs:       []string{gofakeit.UUID()},
+				RocketModelUUID: gofakeit.UUID(),
+			},
+			err: model.ErrConflictingOrderItems,
+		},
+		{
+			name: "negative quantity",
+			request: &dto.CreateOrderRequest{
+				UserUUID:        gofakeit.UUID(),
+				RocketModelUUID: gofakeit.UUID(),
+				Quantity:        -1,
+			},
+			err: model.ErrInvalidQuantity,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			order, err := s.service.Create(s.ctx, tt.request)
+			s.Require().ErrorIs(err, tt.err)
+			s.Require().Nil(order)
+		})
+	}
+}
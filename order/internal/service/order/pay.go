@@ -6,14 +6,22 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 
 	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model"
 	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/domain"
 	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/vo"
 	"github.com/Daniil-Sakharov/RocketFactory/order/internal/service/dto"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
 )
 
+// Pay инициирует оплату заказа. Заказ остается в PENDING_PAYMENT до события
+// PaymentSucceeded от payment сервиса — статус PAID выставляет payment consumer
 func (s *service) Pay(ctx context.Context, req *dto.PayOrderRequest) (*domain.Order, error) {
+	if req.PayerUUID == "" {
+		return nil, model.ErrEmptyUserUUID
+	}
+
 	order, err := s.orderRepository.Get(ctx, req.OrderUUID)
 	if err != nil {
 		if errors.Is(err, model.ErrOrderNotFound) {
@@ -21,51 +29,120 @@ func (s *service) Pay(ctx context.Context, req *dto.PayOrderRequest) (*domain.Or
 		}
 		return nil, model.ErrUnknownError
 	}
-	response, err := s.paymentClient.PayOrder(ctx, &dto.PayOrderClientRequest{
-		OrderUUID:     order.OrderUUID,
-		UserUUID:      order.UserUUID,
-		PaymentMethod: req.PaymentMethod,
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to access pay")
+
+	switch order.Status {
+	case vo.OrderStatusPAID, vo.OrderStatusASSEMBLED:
+		return nil, model.ErrOrderAlreadyPaid
+	case vo.OrderStatusCANCELLED:
+		return nil, model.ErrOrderAlreadyCancelled
 	}
 
-	newOrder := &domain.Order{
-		OrderUUID:       order.OrderUUID,
-		UserUUID:        order.UserUUID,
-		PartUUIDs:       order.PartUUIDs,
-		TotalPrice:      order.TotalPrice,
-		TransactionUUID: response.TransactionUUID,
-		PaymentMethod:   req.PaymentMethod,
-		Status:          vo.OrderStatusPAID,
+	// Транзакция сбрасывается событием PaymentFailed — пока она есть, исход платежа неизвестен
+	if order.TransactionUUID != "" {
+		return nil, model.ErrPaymentInProgress
 	}
 
-	err = s.orderRepository.Update(ctx, newOrder)
+	// Позиции собираются до закрепления: без них чек не сойдется с суммой платежа
+	items, err := s.paymentItems(ctx, order)
 	if err != nil {
-		if errors.Is(err, model.ErrOrderNotFound) {
-			return nil, err
-		}
-		if errors.Is(err, model.ErrOrderAlreadyPaid) {
+		return nil, err
+	}
+
+	// Транзакция закрепляется за заказом до обращения к payment, поэтому параллельный
+	// Pay по тому же заказу получит ErrPaymentInProgress, а не проведет второй платеж
+	transactionUUID := uuid.NewString()
+	err = s.orderRepository.ClaimTransaction(ctx, order.OrderUUID, transactionUUID, req.PaymentMethod)
+	if err != nil {
+		if errors.Is(err, model.ErrOrderNotFound) ||
+			errors.Is(err, model.ErrOrderAlreadyPaid) ||
+			errors.Is(err, model.ErrOrderAlreadyCancelled) ||
+			errors.Is(err, model.ErrPaymentInProgress) {
 			return nil, err
 		}
-		if errors.Is(err, model.ErrOrderAlreadyCancelled) {
+		return nil, fmt.Errorf("failed to claim transaction: %w", err)
+	}
+
+	_, err = s.paymentClient.PayOrder(ctx, &dto.PayOrderClientRequest{
+		OrderUUID:       order.OrderUUID,
+		UserUUID:        req.PayerUUID,
+		PaymentMethod:   req.PaymentMethod,
+		Amount:          order.TotalPrice,
+		OwnerUUID:       order.UserUUID,
+		Items:           items,
+		Installments:    req.Installments,
+		TransactionUUID: transactionUUID,
+	})
+	if err != nil {
+		if errors.Is(err, model.ErrInsufficientFunds) ||
+			errors.Is(err, model.ErrPaymentRejected) ||
+			errors.Is(err, model.ErrInvalidInstallments) ||
+			errors.Is(err, model.ErrInvalidPaymentRequest) {
+			// Платеж точно не проведен — заказ можно оплатить снова
+			s.releaseTransaction(ctx, order.OrderUUID, transactionUUID)
 			return nil, err
 		}
-		return nil, fmt.Errorf("failed to update order: %w", err)
+		// Исход неизвестен: закрепление снимет событие PaymentFailed, а если payment так и не
+		// создал транзакцию - job ReleaseStaleClaims
+		return nil, fmt.Errorf("failed to access payment service: %w", err)
 	}
 
-	produceOrder := &domain.OrderProduceEvent{
-		EventUUID:       uuid.New().String(),
-		OrderUUID:       newOrder.OrderUUID,
-		UserUUID:        newOrder.UserUUID,
-		PaymentMethod:   string(newOrder.PaymentMethod),
-		TransactionUUID: newOrder.TransactionUUID,
+	order.TransactionUUID = transactionUUID
+	order.PaymentMethod = req.PaymentMethod
+
+	return order, nil
+}
+
+// releaseTransaction снимает закрепление транзакции. Ошибка только логируется:
+// в худшем случае закрепление снимет событие PaymentFailed
+func (s *service) releaseTransaction(ctx context.Context, orderUUID, transactionUUID string) {
+	if err := s.orderRepository.ReleaseTransaction(ctx, orderUUID, transactionUUID); err != nil {
+		logger.Error(ctx, "❌ Не удалось снять закрепление транзакции",
+			zap.String("order_uuid", orderUUID),
+			zap.String("transaction_uuid", transactionUUID),
+			zap.Error(err),
+		)
 	}
+}
 
-	err = s.orderProducer.PublishOrderPaid(ctx, produceOrder)
+// paymentItems собирает позиции заказа для чека. Каждая деталь заказа должна попасть в чек,
+// иначе сумма позиций разойдется с суммой платежа
+func (s *service) paymentItems(ctx context.Context, order *domain.Order) ([]dto.PaymentItem, error) {
+	parts, err := s.inventoryClient.ListParts(ctx, &domain.PartsFilter{
+		Uuids: order.PartUUIDs,
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to produce order: %w", err)
+		return nil, fmt.Errorf("failed to get parts: %w", err)
+	}
+
+	// Количество и цена берутся из строк заказа. У заказов без строк
+	// TotalPrice считается по одной единице каждой детали
+	lineItems := make(map[string]domain.LineItem, len(order.LineItems))
+	for _, item := range order.LineItems {
+		lineItems[item.PartUUID] = item
+	}
+
+	found := make(map[string]struct{}, len(parts))
+	items := make([]dto.PaymentItem, 0, len(parts))
+	for _, part := range parts {
+		item := dto.PaymentItem{
+			PartUUID:  part.Uuid,
+			Name:      part.Name,
+			Quantity:  1,
+			UnitPrice: part.Price,
+		}
+		if lineItem, ok := lineItems[part.Uuid]; ok {
+			item.Quantity = int32(lineItem.Quantity) //nolint:gosec // количество деталей в строке заказа невелико
+			item.UnitPrice = lineItem.UnitPrice
+		}
+		found[part.Uuid] = struct{}{}
+		items = append(items, item)
+	}
+
+	for _, partUUID := range order.PartUUIDs {
+		if _, ok := found[partUUID]; !ok {
+			return nil, model.ErrPartsNotFound
+		}
 	}
 
-	return newOrder, nil
+	return items, nil
 }
@@ -2,9 +2,13 @@ package order
 
 import (
 	"errors"
+	"time"
 
 	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model"
 	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/domain"
@@ -16,6 +20,7 @@ func (s *ServiceSuite) TestPayOrderSuccess() {
 	var (
 		orderUUID       = gofakeit.UUID()
 		userUUID        = gofakeit.UUID()
+		payerUUID       = gofakeit.UUID()
 		partUUID1       = gofakeit.UUID()
 		partUUID2       = gofakeit.UUID()
 		partsUUIDs      = []string{partUUID1, partUUID2}
@@ -25,17 +30,25 @@ func (s *ServiceSuite) TestPayOrderSuccess() {
 
 		payOrderRequest = &dto.PayOrderRequest{
 			OrderUUID:     orderUUID,
+			PayerUUID:     payerUUID,
 			PaymentMethod: paymentMethod,
 		}
 
+		partsFromInventory = []*domain.Part{
+			{Uuid: partUUID1, Name: "Двигатель", Price: 15_000.00},
+			{Uuid: partUUID2, Name: "Крыло", Price: 5_000.00},
+		}
+
 		payOrderClientRequest = &dto.PayOrderClientRequest{
 			OrderUUID:     orderUUID,
-			UserUUID:      userUUID,
+			UserUUID:      payerUUID,
 			PaymentMethod: paymentMethod,
-		}
-
-		payOrderClientResponse = &dto.PayOrderClientResponse{
-			TransactionUUID: transactionUUID,
+			Amount:        expectedPrice,
+			OwnerUUID:     userUUID,
+			Items: []dto.PaymentItem{
+				{PartUUID: partUUID1, Name: "Двигатель", Quantity: 1, UnitPrice: 15_000.00},
+				{PartUUID: partUUID2, Name: "Крыло", Quantity: 1, UnitPrice: 5_000.00},
+			},
 		}
 
 		orderFromDB = &domain.Order{
@@ -47,39 +60,123 @@ func (s *ServiceSuite) TestPayOrderSuccess() {
 			PaymentMethod:   "",
 			Status:          vo.OrderStatusPENDINGPAYMENT,
 		}
+	)
+
+	s.orderRepository.On("Get", s.ctx, orderUUID).Return(orderFromDB, nil)
+
+	var claimedUUID string
+	s.orderRepository.On("ClaimTransaction", s.ctx, orderUUID, mock.AnythingOfType("string"), paymentMethod).
+		Run(func(args mock.Arguments) { claimedUUID = args.String(2) }).
+		Return(nil).Once()
+
+	s.inventoryClient.On("ListParts", s.ctx, &domain.PartsFilter{Uuids: partsUUIDs}).Return(partsFromInventory, nil)
+
+	s.paymentClient.On("PayOrder", s.ctx, matchPayOrderClientRequest(payOrderClientRequest)).
+		Return(&dto.PayOrderClientResponse{TransactionUUID: transactionUUID}, nil)
 
-		expectedUpdatedOrder = &domain.Order{
+	order, err := s.service.Pay(s.ctx, payOrderRequest)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(order)
+	s.Require().Equal(orderUUID, order.OrderUUID)
+	s.Require().NotEmpty(order.TransactionUUID)
+	s.Require().Equal(claimedUUID, order.TransactionUUID)
+	s.Require().Equal(paymentMethod, order.PaymentMethod)
+	// PAID выставляется только после события PaymentSucceeded
+	s.Require().Equal(vo.OrderStatusPENDINGPAYMENT, order.Status)
+}
+
+func (s *ServiceSuite) TestPayOrderPaymentInProgress() {
+	var (
+		orderUUID = gofakeit.UUID()
+
+		orderFromDB = &domain.Order{
 			OrderUUID:       orderUUID,
-			UserUUID:        userUUID,
-			PartUUIDs:       partsUUIDs,
-			TotalPrice:      expectedPrice,
-			TransactionUUID: transactionUUID,
-			PaymentMethod:   paymentMethod,
-			Status:          vo.OrderStatusPAID,
+			UserUUID:        gofakeit.UUID(),
+			TransactionUUID: gofakeit.UUID(),
+			PaymentMethod:   vo.PaymentMethodCARD,
+			Status:          vo.OrderStatusPENDINGPAYMENT,
 		}
 	)
 
 	s.orderRepository.On("Get", s.ctx, orderUUID).Return(orderFromDB, nil)
 
-	s.paymentClient.On("PayOrder", s.ctx, payOrderClientRequest).Return(payOrderClientResponse, nil)
+	order, err := s.service.Pay(s.ctx, &dto.PayOrderRequest{
+		OrderUUID:     orderUUID,
+		PayerUUID:     gofakeit.UUID(),
+		PaymentMethod: vo.PaymentMethodCARD,
+	})
+
+	s.Require().ErrorIs(err, model.ErrPaymentInProgress)
+	s.Require().Nil(order)
+	s.paymentClient.AssertNotCalled(s.T(), "PayOrder", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestPayOrderConcurrentClaim() {
+	var (
+		orderUUID = gofakeit.UUID()
+
+		orderFromDB = &domain.Order{
+			OrderUUID:  orderUUID,
+			UserUUID:   gofakeit.UUID(),
+			TotalPrice: 20_000.00,
+			Status:     vo.OrderStatusPENDINGPAYMENT,
+		}
+	)
+
+	// Параллельный Pay успел закрепить свою транзакцию между чтением и закреплением
+	s.orderRepository.On("Get", s.ctx, orderUUID).Return(orderFromDB, nil)
+	s.inventoryClient.On("ListParts", s.ctx, mock.AnythingOfType("*domain.PartsFilter")).Return([]*domain.Part{}, nil)
+	s.orderRepository.On("ClaimTransaction", s.ctx, orderUUID, mock.AnythingOfType("string"), vo.PaymentMethodCARD).
+		Return(model.ErrPaymentInProgress).Once()
+
+	order, err := s.service.Pay(s.ctx, &dto.PayOrderRequest{
+		OrderUUID:     orderUUID,
+		PayerUUID:     gofakeit.UUID(),
+		PaymentMethod: vo.PaymentMethodCARD,
+	})
+
+	s.Require().ErrorIs(err, model.ErrPaymentInProgress)
+	s.Require().Nil(order)
+	s.paymentClient.AssertNotCalled(s.T(), "PayOrder", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestPayOrderEmptyPayer() {
+	order, err := s.service.Pay(s.ctx, &dto.PayOrderRequest{
+		OrderUUID:     gofakeit.UUID(),
+		PaymentMethod: vo.PaymentMethodCARD,
+	})
 
-	s.orderRepository.On("Update", s.ctx, mock.MatchedBy(func(order *domain.Order) bool {
-		return order.OrderUUID == orderUUID &&
-			order.TransactionUUID == transactionUUID &&
-			order.PaymentMethod == paymentMethod &&
-			order.Status == vo.OrderStatusPAID
-	})).Return(nil)
+	s.Require().ErrorIs(err, model.ErrEmptyUserUUID)
+	s.Require().Nil(order)
+	s.orderRepository.AssertNotCalled(s.T(), "Get", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestPayOrderAlreadyPaid() {
+	var (
+		orderUUID = gofakeit.UUID()
+
+		payOrderRequest = &dto.PayOrderRequest{
+			OrderUUID:     orderUUID,
+			PayerUUID:     gofakeit.UUID(),
+			PaymentMethod: vo.PaymentMethodCARD,
+		}
+
+		orderFromDB = &domain.Order{
+			OrderUUID:       orderUUID,
+			UserUUID:        gofakeit.UUID(),
+			TransactionUUID: gofakeit.UUID(),
+			PaymentMethod:   vo.PaymentMethodCARD,
+			Status:          vo.OrderStatusPAID,
+		}
+	)
 
-	s.orderProducer.On("PublishOrderPaid", s.ctx, mock.Anything).Return(nil)
+	s.orderRepository.On("Get", s.ctx, orderUUID).Return(orderFromDB, nil)
 
 	order, err := s.service.Pay(s.ctx, payOrderRequest)
 
-	s.Require().NoError(err)
-	s.Require().NotNil(order)
-	s.Require().Equal(expectedUpdatedOrder.OrderUUID, order.OrderUUID)
-	s.Require().Equal(expectedUpdatedOrder.TransactionUUID, order.TransactionUUID)
-	s.Require().Equal(expectedUpdatedOrder.PaymentMethod, order.PaymentMethod)
-	s.Require().Equal(vo.OrderStatusPAID, order.Status)
+	s.Require().ErrorIs(err, model.ErrOrderAlreadyPaid)
+	s.Require().Nil(order)
 }
 
 func (s *ServiceSuite) TestPayOrderNotFound() {
@@ -88,6 +185,7 @@ func (s *ServiceSuite) TestPayOrderNotFound() {
 
 		payOrderRequest = &dto.PayOrderRequest{
 			OrderUUID:     orderUUID,
+			PayerUUID:     gofakeit.UUID(),
 			PaymentMethod: vo.PaymentMethodCARD,
 		}
 	)
@@ -113,6 +211,7 @@ func (s *ServiceSuite) TestPayOrderPaymentClientError() {
 
 		payOrderRequest = &dto.PayOrderRequest{
 			OrderUUID:     orderUUID,
+			PayerUUID:     userUUID,
 			PaymentMethod: paymentMethod,
 		}
 
@@ -120,6 +219,12 @@ func (s *ServiceSuite) TestPayOrderPaymentClientError() {
 			OrderUUID:     orderUUID,
 			UserUUID:      userUUID,
 			PaymentMethod: paymentMethod,
+			Amount:        expectedPrice,
+			OwnerUUID:     userUUID,
+			Items: []dto.PaymentItem{
+				{PartUUID: partUUID1, Name: "Двигатель", Quantity: 1, UnitPrice: 15_000.00},
+				{PartUUID: partUUID2, Name: "Крыло", Quantity: 1, UnitPrice: 5_000.00},
+			},
 		}
 
 		orderFromDB = &domain.Order{
@@ -134,12 +239,292 @@ func (s *ServiceSuite) TestPayOrderPaymentClientError() {
 	)
 
 	s.orderRepository.On("Get", s.ctx, orderUUID).Return(orderFromDB, nil)
+	s.expectTransactionClaimed(orderUUID, paymentMethod)
 
-	s.paymentClient.On("PayOrder", s.ctx, payOrderClientRequest).Return(nil, errors.New("payment service unavailable"))
+	s.inventoryClient.On("ListParts", s.ctx, &domain.PartsFilter{Uuids: partsUUIDs}).Return([]*domain.Part{
+		{Uuid: partUUID1, Name: "Двигатель", Price: 15_000.00},
+		{Uuid: partUUID2, Name: "Крыло", Price: 5_000.00},
+	}, nil)
+
+	s.paymentClient.On("PayOrder", s.ctx, matchPayOrderClientRequest(payOrderClientRequest)).
+		Return(nil, errors.New("payment service unavailable"))
 
 	order, err := s.service.Pay(s.ctx, payOrderRequest)
 
 	s.Require().Error(err)
 	s.Require().Nil(order)
 	s.Require().Contains(err.Error(), "failed to access pay")
+	// Исход платежа неизвестен — закрепление остается до события от payment
+	s.orderRepository.AssertNotCalled(s.T(), "ReleaseTransaction", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestPayOrderInsufficientInvestorFunds() {
+	var (
+		orderUUID     = gofakeit.UUID()
+		userUUID      = gofakeit.UUID()
+		partUUID      = gofakeit.UUID()
+		expectedPrice = 20_000.00
+		paymentMethod = vo.PaymentMethodINVESTORMONEY
+
+		orderFromDB = &domain.Order{
+			OrderUUID:  orderUUID,
+			UserUUID:   userUUID,
+			PartUUIDs:  []string{partUUID},
+			TotalPrice: expectedPrice,
+			Status:     vo.OrderStatusPENDINGPAYMENT,
+		}
+	)
+
+	s.orderRepository.On("Get", s.ctx, orderUUID).Return(orderFromDB, nil)
+	s.expectTransactionClaimed(orderUUID, paymentMethod)
+	s.inventoryClient.On("ListParts", s.ctx, mock.AnythingOfType("*domain.PartsFilter")).
+		Return([]*domain.Part{{Uuid: partUUID, Name: "Двигатель", Price: expectedPrice}}, nil)
+	s.paymentClient.On("PayOrder", s.ctx, matchPayOrderClientRequest(&dto.PayOrderClientRequest{
+		OrderUUID:     orderUUID,
+		UserUUID:      userUUID,
+		PaymentMethod: paymentMethod,
+		Amount:        expectedPrice,
+		OwnerUUID:     userUUID,
+		Items:         []dto.PaymentItem{{PartUUID: partUUID, Name: "Двигатель", Quantity: 1, UnitPrice: expectedPrice}},
+	})).Return(nil, model.ErrInsufficientFunds)
+	s.orderRepository.On("ReleaseTransaction", s.ctx, orderUUID, mock.AnythingOfType("string")).Return(nil).Once()
+
+	order, err := s.service.Pay(s.ctx, &dto.PayOrderRequest{
+		OrderUUID:     orderUUID,
+		PayerUUID:     userUUID,
+		PaymentMethod: paymentMethod,
+	})
+
+	s.Require().ErrorIs(err, model.ErrInsufficientFunds)
+	s.Require().Nil(order)
+}
+
+func (s *ServiceSuite) TestPayOrderInstallmentsRejected() {
+	var (
+		orderUUID     = gofakeit.UUID()
+		userUUID      = gofakeit.UUID()
+		partUUID      = gofakeit.UUID()
+		expectedPrice = 20_000.00
+		paymentMethod = vo.PaymentMethodCREDITCARD
+
+		orderFromDB = &domain.Order{
+			OrderUUID:  orderUUID,
+			UserUUID:   userUUID,
+			PartUUIDs:  []string{partUUID},
+			TotalPrice: expectedPrice,
+			Status:     vo.OrderStatusPENDINGPAYMENT,
+		}
+	)
+
+	s.orderRepository.On("Get", s.ctx, orderUUID).Return(orderFromDB, nil)
+	s.expectTransactionClaimed(orderUUID, paymentMethod)
+	s.inventoryClient.On("ListParts", s.ctx, mock.AnythingOfType("*domain.PartsFilter")).
+		Return([]*domain.Part{{Uuid: partUUID, Name: "Двигатель", Price: expectedPrice}}, nil)
+	s.paymentClient.On("PayOrder", s.ctx, matchPayOrderClientRequest(&dto.PayOrderClientRequest{
+		OrderUUID:     orderUUID,
+		UserUUID:      userUUID,
+		PaymentMethod: paymentMethod,
+		Amount:        expectedPrice,
+		OwnerUUID:     userUUID,
+		Items:         []dto.PaymentItem{{PartUUID: partUUID, Name: "Двигатель", Quantity: 1, UnitPrice: expectedPrice}},
+		Installments:  12,
+	})).Return(nil, model.ErrInvalidInstallments)
+	s.orderRepository.On("ReleaseTransaction", s.ctx, orderUUID, mock.AnythingOfType("string")).Return(nil).Once()
+
+	order, err := s.service.Pay(s.ctx, &dto.PayOrderRequest{
+		OrderUUID:     orderUUID,
+		PayerUUID:     userUUID,
+		PaymentMethod: paymentMethod,
+		Installments:  12,
+	})
+
+	s.Require().ErrorIs(err, model.ErrInvalidInstallments)
+	s.Require().Nil(order)
+}
+
+func (s *ServiceSuite) TestPayOrderUnavailableThenRetrySucceeds() {
+	var (
+		orderUUID     = gofakeit.UUID()
+		userUUID      = gofakeit.UUID()
+		partUUID      = gofakeit.UUID()
+		expectedPrice = 20_000.00
+		paymentMethod = vo.PaymentMethodCARD
+
+		orderFromDB = &domain.Order{
+			OrderUUID:  orderUUID,
+			UserUUID:   userUUID,
+			PartUUIDs:  []string{partUUID},
+			TotalPrice: expectedPrice,
+			Status:     vo.OrderStatusPENDINGPAYMENT,
+		}
+
+		payOrderRequest = &dto.PayOrderRequest{
+			OrderUUID:     orderUUID,
+			PayerUUID:     userUUID,
+			PaymentMethod: paymentMethod,
+		}
+
+		payOrderClientRequest = matchPayOrderClientRequest(&dto.PayOrderClientRequest{
+			OrderUUID:     orderUUID,
+			UserUUID:      userUUID,
+			PaymentMethod: paymentMethod,
+			Amount:        expectedPrice,
+			OwnerUUID:     userUUID,
+			Items:         []dto.PaymentItem{{PartUUID: partUUID, Name: "Двигатель", Quantity: 1, UnitPrice: expectedPrice}},
+		})
+	)
+
+	s.inventoryClient.On("ListParts", s.ctx, mock.AnythingOfType("*domain.PartsFilter")).
+		Return([]*domain.Part{{Uuid: partUUID, Name: "Двигатель", Price: expectedPrice}}, nil)
+
+	// Первая попытка: payment недоступен, исход неизвестен, закрепление остается
+	var claimedUUID string
+	s.orderRepository.On("Get", s.ctx, orderUUID).Return(orderFromDB, nil).Once()
+	s.orderRepository.On("ClaimTransaction", s.ctx, orderUUID, mock.AnythingOfType("string"), paymentMethod).
+		Run(func(args mock.Arguments) { claimedUUID = args.String(2) }).
+		Return(nil).Once()
+	s.paymentClient.On("PayOrder", s.ctx, payOrderClientRequest).
+		Return(nil, status.Error(codes.Unavailable, "connection refused")).Once()
+
+	order, err := s.service.Pay(s.ctx, payOrderRequest)
+	s.Require().Error(err)
+	s.Require().Nil(order)
+	s.orderRepository.AssertNotCalled(s.T(), "ReleaseTransaction", mock.Anything, mock.Anything, mock.Anything)
+
+	// Job видит, что payment транзакцию не создал, и снимает закрепление
+	claimedBefore := time.Now()
+	claimed := *orderFromDB
+	claimed.TransactionUUID = claimedUUID
+	s.orderRepository.On("ListStaleClaims", s.ctx, claimedBefore, staleClaimsBatchSize).
+		Return([]*domain.Order{&claimed}, nil).Once()
+	s.paymentClient.On("GetPaymentStatus", s.ctx, claimedUUID).
+		Return(vo.PaymentStatusUNKNOWN, model.ErrPaymentNotFound).Once()
+	s.orderRepository.On("ReleaseTransaction", s.ctx, orderUUID, claimedUUID).Return(nil).Once()
+
+	released, err := s.service.ReleaseStaleClaims(s.ctx, claimedBefore)
+	s.Require().NoError(err)
+	s.Require().Equal(1, released)
+
+	// Повторная оплата проходит с новой транзакцией
+	s.orderRepository.On("Get", s.ctx, orderUUID).Return(orderFromDB, nil).Once()
+	s.expectTransactionClaimed(orderUUID, paymentMethod)
+	s.paymentClient.On("PayOrder", s.ctx, payOrderClientRequest).
+		Return(&dto.PayOrderClientResponse{TransactionUUID: gofakeit.UUID()}, nil).Once()
+
+	order, err = s.service.Pay(s.ctx, payOrderRequest)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(order.TransactionUUID)
+	s.Require().NotEqual(claimedUUID, order.TransactionUUID)
+}
+
+func (s *ServiceSuite) TestPayOrderInvalidRequestReleasesClaim() {
+	var (
+		orderUUID = gofakeit.UUID()
+		userUUID  = gofakeit.UUID()
+		partUUID  = gofakeit.UUID()
+
+		orderFromDB = &domain.Order{
+			OrderUUID:  orderUUID,
+			UserUUID:   userUUID,
+			PartUUIDs:  []string{partUUID},
+			TotalPrice: 20_000.00,
+			Status:     vo.OrderStatusPENDINGPAYMENT,
+		}
+	)
+
+	s.orderRepository.On("Get", s.ctx, orderUUID).Return(orderFromDB, nil)
+	s.expectTransactionClaimed(orderUUID, vo.PaymentMethodCARD)
+	s.inventoryClient.On("ListParts", s.ctx, mock.AnythingOfType("*domain.PartsFilter")).
+		Return([]*domain.Part{{Uuid: partUUID, Name: "Двигатель", Price: 20_000.00}}, nil)
+	s.paymentClient.On("PayOrder", s.ctx, mock.AnythingOfType("*dto.PayOrderClientRequest")).
+		Return(nil, model.ErrInvalidPaymentRequest)
+	s.orderRepository.On("ReleaseTransaction", s.ctx, orderUUID, mock.AnythingOfType("string")).Return(nil).Once()
+
+	order, err := s.service.Pay(s.ctx, &dto.PayOrderRequest{
+		OrderUUID:     orderUUID,
+		PayerUUID:     userUUID,
+		PaymentMethod: vo.PaymentMethodCARD,
+	})
+
+	s.Require().ErrorIs(err, model.ErrInvalidPaymentRequest)
+	s.Require().Nil(order)
+}
+
+// expectTransactionClaimed ожидает закрепление новой транзакции за заказом
+func (s *ServiceSuite) expectTransactionClaimed(orderUUID string, paymentMethod vo.PaymentMethod) {
+	s.orderRepository.On("ClaimTransaction", s.ctx, orderUUID, mock.AnythingOfType("string"), paymentMethod).
+		Return(nil).Once()
+}
+
+// matchPayOrderClientRequest сравнивает запрос в payment с ожидаемым без учета сгенерированного UUID транзакции
+func matchPayOrderClientRequest(expected *dto.PayOrderClientRequest) interface{} {
+	return mock.MatchedBy(func(req *dto.PayOrderClientRequest) bool {
+		if req.TransactionUUID == "" {
+			return false
+		}
+		withUUID := *expected
+		withUUID.TransactionUUID = req.TransactionUUID
+		return assert.ObjectsAreEqual(&withUUID, req)
+	})
+}
+
+func (s *ServiceSuite) TestPayOrderInventoryError() {
+	var (
+		orderUUID = gofakeit.UUID()
+
+		orderFromDB = &domain.Order{
+			OrderUUID:  orderUUID,
+			UserUUID:   gofakeit.UUID(),
+			PartUUIDs:  []string{gofakeit.UUID()},
+			TotalPrice: 20_000.00,
+			Status:     vo.OrderStatusPENDINGPAYMENT,
+		}
+	)
+
+	s.orderRepository.On("Get", s.ctx, orderUUID).Return(orderFromDB, nil)
+	s.inventoryClient.On("ListParts", s.ctx, mock.AnythingOfType("*domain.PartsFilter")).
+		Return(nil, errors.New("inventory unavailable"))
+
+	order, err := s.service.Pay(s.ctx, &dto.PayOrderRequest{
+		OrderUUID:     orderUUID,
+		PayerUUID:     gofakeit.UUID(),
+		PaymentMethod: vo.PaymentMethodCARD,
+	})
+
+	// Без позиций чек не сойдется с суммой платежа — оплата не начинается
+	s.Require().Error(err)
+	s.Require().Contains(err.Error(), "failed to get parts")
+	s.Require().Nil(order)
+	s.orderRepository.AssertNotCalled(s.T(), "ClaimTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	s.paymentClient.AssertNotCalled(s.T(), "PayOrder", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestPayOrderPartMissing() {
+	var (
+		orderUUID = gofakeit.UUID()
+		partUUID1 = gofakeit.UUID()
+		partUUID2 = gofakeit.UUID()
+
+		orderFromDB = &domain.Order{
+			OrderUUID:  orderUUID,
+			UserUUID:   gofakeit.UUID(),
+			PartUUIDs:  []string{partUUID1, partUUID2},
+			TotalPrice: 20_000.00,
+			Status:     vo.OrderStatusPENDINGPAYMENT,
+		}
+	)
+
+	s.orderRepository.On("Get", s.ctx, orderUUID).Return(orderFromDB, nil)
+	s.inventoryClient.On("ListParts", s.ctx, mock.AnythingOfType("*domain.PartsFilter")).
+		Return([]*domain.Part{{Uuid: partUUID1, Name: "Двигатель", Price: 15_000.00}}, nil)
+
+	order, err := s.service.Pay(s.ctx, &dto.PayOrderRequest{
+		OrderUUID:     orderUUID,
+		PayerUUID:     gofakeit.UUID(),
+		PaymentMethod: vo.PaymentMethodCARD,
+	})
+
+	s.Require().ErrorIs(err, model.ErrPartsNotFound)
+	s.Require().Nil(order)
+	s.paymentClient.AssertNotCalled(s.T(), "PayOrder", mock.Anything, mock.Anything)
 }
@@ -0,0 +1,56 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/vo"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+// staleClaimsBatchSize - сколько закреплений проверяется за один прогон job'а
+const staleClaimsBatchSize = 100
+
+// ReleaseStaleClaims проверяет в payment закрепления, оставшиеся после PayOrder с неизвестным
+// исходом (таймаут, Unavailable, Internal). Если транзакции нет или она отклонена, закрепление
+// снимается и заказ можно оплатить снова. PENDING ждет callback шлюза, по SUCCEEDED статус
+// выставит payment consumer
+func (s *service) ReleaseStaleClaims(ctx context.Context, claimedBefore time.Time) (int, error) {
+	orders, err := s.orderRepository.ListStaleClaims(ctx, claimedBefore, staleClaimsBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list stale claims: %w", err)
+	}
+
+	released := 0
+	for _, order := range orders {
+		status, err := s.paymentClient.GetPaymentStatus(ctx, order.TransactionUUID)
+		if err != nil && !errors.Is(err, model.ErrPaymentNotFound) {
+			logger.Error(ctx, "❌ Не удалось получить статус платежа",
+				zap.String("order_uuid", order.OrderUUID),
+				zap.String("transaction_uuid", order.TransactionUUID),
+				zap.Error(err),
+			)
+			continue
+		}
+		if err == nil && status != vo.PaymentStatusFAILED {
+			continue
+		}
+
+		if err = s.orderRepository.ReleaseTransaction(ctx, order.OrderUUID, order.TransactionUUID); err != nil {
+			logger.Error(ctx, "❌ Не удалось снять закрепление транзакции",
+				zap.String("order_uuid", order.OrderUUID),
+				zap.String("transaction_uuid", order.TransactionUUID),
+				zap.Error(err),
+			)
+			continue
+		}
+		released++
+	}
+
+	return released, nil
+}
@@ -0,0 +1,38 @@
+package order
+
+import (
+	"errors"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/domain"
+	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/vo"
+)
+
+func (s *ServiceSuite) TestReleaseStaleClaimsByPaymentStatus() {
+	var (
+		claimedBefore = time.Now()
+
+		pending = &domain.Order{OrderUUID: gofakeit.UUID(), TransactionUUID: gofakeit.UUID()}
+		failed  = &domain.Order{OrderUUID: gofakeit.UUID(), TransactionUUID: gofakeit.UUID()}
+		broken  = &domain.Order{OrderUUID: gofakeit.UUID(), TransactionUUID: gofakeit.UUID()}
+	)
+
+	s.orderRepository.On("ListStaleClaims", s.ctx, claimedBefore, staleClaimsBatchSize).
+		Return([]*domain.Order{pending, failed, broken}, nil)
+	s.paymentClient.On("GetPaymentStatus", s.ctx, pending.TransactionUUID).Return(vo.PaymentStatusPENDING, nil)
+	s.paymentClient.On("GetPaymentStatus", s.ctx, failed.TransactionUUID).Return(vo.PaymentStatusFAILED, nil)
+	s.paymentClient.On("GetPaymentStatus", s.ctx, broken.TransactionUUID).
+		Return(vo.PaymentStatusUNKNOWN, errors.New("payment service unavailable"))
+	s.orderRepository.On("ReleaseTransaction", s.ctx, failed.OrderUUID, failed.TransactionUUID).Return(nil).Once()
+
+	released, err := s.service.ReleaseStaleClaims(s.ctx, claimedBefore)
+
+	s.Require().NoError(err)
+	s.Require().Equal(1, released)
+	// PENDING ждет callback шлюза, а при ошибке payment закрепление проверят в следующий прогон
+	s.orderRepository.AssertNotCalled(s.T(), "ReleaseTransaction", mock.Anything, pending.OrderUUID, mock.Anything)
+	s.orderRepository.AssertNotCalled(s.T(), "ReleaseTransaction", mock.Anything, broken.OrderUUID, mock.Anything)
+}
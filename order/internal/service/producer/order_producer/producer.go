@@ -32,6 +32,7 @@ func (s *service) PublishOrderPaid(ctx context.Context, event *domain.OrderProdu
 		UserUuid:        event.UserUUID,
 		PaymentMethod:   event.PaymentMethod,
 		TransactionUuid: event.TransactionUUID,
+		TotalPrice:      event.TotalPrice,
 	}
 
 	payload, err := proto.Marshal(msg)
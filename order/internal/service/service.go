@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/Daniil-Sakharov/RocketFactory/order/internal/model/domain"
 	"github.com/Daniil-Sakharov/RocketFactory/order/internal/service/dto"
@@ -12,12 +13,18 @@ type OrderService interface {
 	Pay(ctx context.Context, req *dto.PayOrderRequest) (*domain.Order, error)
 	Get(ctx context.Context, req *dto.GetOrderRequest) (*domain.Order, error)
 	Cancel(ctx context.Context, req *dto.CancelOrderRequest) error
+	// ReleaseStaleClaims снимает закрепления транзакций старше claimedBefore, по которым платеж не создан
+	ReleaseStaleClaims(ctx context.Context, claimedBefore time.Time) (int, error)
 }
 
 type AssemblyConsumerService interface {
 	RunConsumer(ctx context.Context) error
 }
 
+type PaymentConsumerService interface {
+	RunConsumer(ctx context.Context) error
+}
+
 type OrderProducerService interface {
 	PublishOrderPaid(ctx context.Context, event *domain.OrderProduceEvent) error
 }
@@ -0,0 +1,3 @@
+-- +goose Up
+ALTER TABLE orders ADD COLUMN rocket_model_uuid UUID;
+ALTER TABLE orders ADD COLUMN line_items JSONB NOT NULL DEFAULT '[]';
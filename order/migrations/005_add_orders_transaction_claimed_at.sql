@@ -0,0 +1,10 @@
+-- +goose Up
+ALTER TABLE orders ADD COLUMN transaction_claimed_at TIMESTAMPTZ;
+
+UPDATE orders
+SET transaction_claimed_at = updated_at
+WHERE transaction_uuid IS NOT NULL
+  AND order_status = 'PENDING_PAYMENT';
+
+CREATE INDEX idx_orders_transaction_claimed_at ON orders (transaction_claimed_at)
+    WHERE transaction_uuid IS NOT NULL AND order_status = 'PENDING_PAYMENT';
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/app"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/config"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/closer"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+const configPath = "./deploy/compose/payment/.env"
+
+func main() {
+	since := flag.Duration("since", 24*time.Hour, "период сверки, отсчитываемый от текущего момента")
+	format := flag.String("format", "json", "формат отчета: json или csv")
+	output := flag.String("output", "", "файл для отчета (по умолчанию stdout)")
+	publish := flag.Bool("publish", false, "публиковать расхождения в Kafka")
+	flag.Parse()
+
+	if *format != "json" && *format != "csv" {
+		panic(fmt.Errorf("unknown report format %q: expected json or csv", *format))
+	}
+
+	err := config.Load(configPath)
+	if err != nil {
+		panic(fmt.Errorf("error to load config: %w", err))
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+	defer gracefulShutdown()
+
+	r, err := app.NewReconciler(ctx)
+	if err != nil {
+		logger.Error(ctx, "❌ Не удалось инициализировать сверку", zap.Error(err))
+		return
+	}
+
+	until := time.Now()
+	report, err := r.Run(ctx, &model.ReconciliationRequest{
+		Since:   until.Add(-*since),
+		Until:   until,
+		Publish: *publish,
+	})
+	if err != nil {
+		logger.Error(ctx, "❌ Ошибка при сверке платежей", zap.Error(err))
+		return
+	}
+
+	if err = writeReport(*output, *format, report); err != nil {
+		logger.Error(ctx, "❌ Не удалось записать отчет сверки", zap.Error(err))
+		return
+	}
+}
+
+func writeReport(path, format string, report *model.ReconciliationReport) error {
+	var w io.Writer = os.Stdout
+	if path != "" {
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if format == "csv" {
+		return converter.WriteReportCSV(w, report)
+	}
+	return converter.WriteReportJSON(w, report)
+}
+
+func gracefulShutdown() {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if err := closer.CloseAll(ctx); err != nil {
+		logger.Error(ctx, "❌ Ошибка при завершении работы", zap.Error(err))
+	}
+}
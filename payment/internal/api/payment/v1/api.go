@@ -8,12 +8,29 @@ import (
 // api - реализация gRPC сервера для PaymentService
 type api struct {
 	paymentv1.UnimplementedPaymentServiceServer
-	paymentService service.PaymentService
+	paymentService     service.PaymentService
+	ledgerService      service.LedgerService
+	fraudService       service.FraudService
+	receiptService     service.ReceiptService
+	installmentService service.InstallmentService
+	webhookSecret      string
 }
 
 // New создает новый экземпляр API
-func New(paymentService service.PaymentService) *api {
+func New(
+	paymentService service.PaymentService,
+	ledgerService service.LedgerService,
+	fraudService service.FraudService,
+	receiptService service.ReceiptService,
+	installmentService service.InstallmentService,
+	webhookSecret string,
+) *api {
 	return &api{
-		paymentService: paymentService,
+		paymentService:     paymentService,
+		ledgerService:      ledgerService,
+		fraudService:       fraudService,
+		receiptService:     receiptService,
+		installmentService: installmentService,
+		webhookSecret:      webhookSecret,
 	}
 }
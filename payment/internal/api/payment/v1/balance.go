@@ -0,0 +1,30 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	paymentv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/payment/v1"
+)
+
+// GetInvestorBalance возвращает баланс счета инвестора, которому принадлежит сессия
+func (a *api) GetInvestorBalance(ctx context.Context, req *paymentv1.GetInvestorBalanceRequest) (*paymentv1.GetInvestorBalanceResponse, error) {
+	userUUID, err := callerUserUUID(ctx, req.GetUserUuid())
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := a.ledgerService.GetBalance(ctx, userUUID)
+	if err != nil {
+		if errors.Is(err, model.ErrEmptyUserUUID) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &paymentv1.GetInvestorBalanceResponse{Balance: balance}, nil
+}
@@ -0,0 +1,25 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	grpcMiddleware "github.com/Daniil-Sakharov/RocketFactory/platform/pkg/middleware/grpc"
+)
+
+// callerUserUUID возвращает UUID пользователя сессии. Счет другого инвестора читать нельзя:
+// если в запросе указан чужой user_uuid, вызов отклоняется
+func callerUserUUID(ctx context.Context, requested string) (string, error) {
+	user, ok := grpcMiddleware.GetUserFromContext(ctx)
+	if !ok || user.GetUserUuid() == "" {
+		return "", status.Error(codes.Unauthenticated, "missing session user")
+	}
+
+	if requested != "" && requested != user.GetUserUuid() {
+		return "", status.Error(codes.PermissionDenied, "access to another investor account is denied")
+	}
+
+	return user.GetUserUuid(), nil
+}
@@ -0,0 +1,33 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	grpcMiddleware "github.com/Daniil-Sakharov/RocketFactory/platform/pkg/middleware/grpc"
+	commonv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/common/v1"
+)
+
+func TestCallerUserUUID(t *testing.T) {
+	userUUID := gofakeit.UUID()
+	ctx := context.WithValue(context.Background(), grpcMiddleware.GetUserContextKey(), &commonv1.User{UserUuid: userUUID})
+
+	got, err := callerUserUUID(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, userUUID, got)
+
+	got, err = callerUserUUID(ctx, userUUID)
+	require.NoError(t, err)
+	require.Equal(t, userUUID, got)
+
+	_, err = callerUserUUID(ctx, gofakeit.UUID())
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = callerUserUUID(context.Background(), userUUID)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+}
@@ -0,0 +1,37 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	paymentv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/payment/v1"
+)
+
+// ConfirmPayment обрабатывает callback платежного шлюза по платежу в статусе PENDING
+func (a *api) ConfirmPayment(ctx context.Context, req *paymentv1.ConfirmPaymentRequest) (*paymentv1.ConfirmPaymentResponse, error) {
+	if err := a.verifyWebhookSignature(ctx, req); err != nil {
+		return nil, err
+	}
+
+	tx, err := a.paymentService.ConfirmPayment(ctx, converter.ConfirmPaymentRequestFromProto(req))
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrEmptyTransactionUUID),
+			errors.Is(err, model.ErrInvalidPaymentStatus):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, model.ErrTransactionNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, model.ErrPaymentAlreadyProcessed),
+			errors.Is(err, model.ErrPaymentNotConfirmable):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return converter.ConfirmPaymentResponseToProto(tx), nil
+}
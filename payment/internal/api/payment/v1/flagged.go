@@ -0,0 +1,23 @@
+package v1
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/converter"
+	paymentv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/payment/v1"
+)
+
+// ListFlaggedPayments возвращает платежи, отмеченные антифрод-проверкой для ручного разбора
+func (a *api) ListFlaggedPayments(ctx context.Context, req *paymentv1.ListFlaggedPaymentsRequest) (*paymentv1.ListFlaggedPaymentsResponse, error) {
+	decisions, err := a.fraudService.ListFlagged(ctx, int(req.GetLimit()), int(req.GetOffset()))
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &paymentv1.ListFlaggedPaymentsResponse{
+		Decisions: converter.FraudDecisionsToProto(decisions),
+	}, nil
+}
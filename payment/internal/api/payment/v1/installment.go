@@ -0,0 +1,43 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	paymentv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/payment/v1"
+)
+
+// GetInstallmentPlan возвращает план рассрочки по UUID транзакции
+func (a *api) GetInstallmentPlan(ctx context.Context, req *paymentv1.GetInstallmentPlanRequest) (*paymentv1.GetInstallmentPlanResponse, error) {
+	plan, err := a.installmentService.GetByTransaction(ctx, req.GetTransactionUuid())
+	if err != nil {
+		if errors.Is(err, model.ErrInstallmentPlanNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &paymentv1.GetInstallmentPlanResponse{
+		Plan: converter.InstallmentPlanToProto(plan),
+	}, nil
+}
+
+// ListInstallmentPlans возвращает планы рассрочки пользователя
+func (a *api) ListInstallmentPlans(ctx context.Context, req *paymentv1.ListInstallmentPlansRequest) (*paymentv1.ListInstallmentPlansResponse, error) {
+	plans, err := a.installmentService.ListByUser(ctx, req.GetUserUuid(), int(req.GetLimit()), int(req.GetOffset()))
+	if err != nil {
+		if errors.Is(err, model.ErrEmptyUserUUID) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &paymentv1.ListInstallmentPlansResponse{
+		Plans: converter.InstallmentPlansToProto(plans),
+	}, nil
+}
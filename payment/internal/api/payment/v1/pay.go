@@ -23,9 +23,23 @@ func (a *api) PayOrder(ctx context.Context, req *paymentv1.PayOrderRequest) (*pa
 		// Обработка domain ошибок и конвертация в gRPC статусы
 		if errors.Is(err, model.ErrEmptyOrderUUID) ||
 			errors.Is(err, model.ErrEmptyUserUUID) ||
-			errors.Is(err, model.ErrInvalidPaymentMethod) {
+			errors.Is(err, model.ErrInvalidPaymentMethod) ||
+			errors.Is(err, model.ErrInvalidAmount) ||
+			errors.Is(err, model.ErrInvalidPaymentItem) ||
+			errors.Is(err, model.ErrInstallmentsNotAllowed) ||
+			errors.Is(err, model.ErrInvalidInstallments) ||
+			errors.Is(err, model.ErrInvalidTransactionUUID) {
 			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
+		if errors.Is(err, model.ErrTransactionAlreadyExists) {
+			return nil, status.Error(codes.AlreadyExists, err.Error())
+		}
+		if errors.Is(err, model.ErrInsufficientFunds) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		if errors.Is(err, model.ErrPaymentRejected) {
+			return nil, status.Error(codes.PermissionDenied, err.Error())
+		}
 		return nil, status.Error(codes.Internal, "internal error")
 	}
 
@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	paymentv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/payment/v1"
+)
+
+// GetPaymentStatus возвращает текущий статус платежа по UUID транзакции
+func (a *api) GetPaymentStatus(ctx context.Context, req *paymentv1.GetPaymentStatusRequest) (*paymentv1.GetPaymentStatusResponse, error) {
+	tx, err := a.paymentService.GetPayment(ctx, req.GetTransactionUuid())
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrEmptyTransactionUUID):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, model.ErrTransactionNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return converter.PaymentStatusResponseToProto(tx), nil
+}
@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	paymentv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/payment/v1"
+)
+
+// GetReceipt возвращает чек по UUID транзакции
+func (a *api) GetReceipt(ctx context.Context, req *paymentv1.GetReceiptRequest) (*paymentv1.GetReceiptResponse, error) {
+	receipt, err := a.receiptService.Get(ctx, req.GetTransactionUuid())
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrEmptyTransactionUUID):
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		case errors.Is(err, model.ErrReceiptNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &paymentv1.GetReceiptResponse{
+		Receipt: converter.ReceiptToProto(receipt),
+	}, nil
+}
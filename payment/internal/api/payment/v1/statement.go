@@ -0,0 +1,34 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	paymentv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/payment/v1"
+)
+
+// GetInvestorStatement возвращает выписку по счету инвестора, которому принадлежит сессия
+func (a *api) GetInvestorStatement(ctx context.Context, req *paymentv1.GetInvestorStatementRequest) (*paymentv1.GetInvestorStatementResponse, error) {
+	userUUID, err := callerUserUUID(ctx, req.GetUserUuid())
+	if err != nil {
+		return nil, err
+	}
+
+	statementReq := converter.StatementRequestFromProto(req)
+	statementReq.UserUUID = userUUID
+
+	statement, err := a.ledgerService.GetStatement(ctx, statementReq)
+	if err != nil {
+		if errors.Is(err, model.ErrEmptyUserUUID) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return converter.StatementToProto(statement), nil
+}
@@ -0,0 +1,26 @@
+package v1
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	paymentv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/payment/v1"
+)
+
+// TopUpInvestorAccount пополняет счет инвестора
+func (a *api) TopUpInvestorAccount(ctx context.Context, req *paymentv1.TopUpInvestorAccountRequest) (*paymentv1.TopUpInvestorAccountResponse, error) {
+	result, err := a.ledgerService.TopUp(ctx, converter.TopUpRequestFromProto(req))
+	if err != nil {
+		if errors.Is(err, model.ErrEmptyUserUUID) || errors.Is(err, model.ErrInvalidAmount) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return converter.TopUpResultToProto(result), nil
+}
@@ -0,0 +1,49 @@
+package v1
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	paymentv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/payment/v1"
+)
+
+// WebhookSignatureMetadataKey - ключ metadata с подписью callback'а платежного шлюза
+const WebhookSignatureMetadataKey = "x-webhook-signature"
+
+// verifyWebhookSignature проверяет подпись callback'а: hex HMAC-SHA256 от
+// "transaction_uuid:status:failure_reason" на общем со шлюзом ключе WEBHOOK_SECRET
+func (a *api) verifyWebhookSignature(ctx context.Context, req *paymentv1.ConfirmPaymentRequest) error {
+	if a.webhookSecret == "" {
+		return status.Error(codes.PermissionDenied, "payment callbacks are disabled")
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing metadata")
+	}
+
+	signatures := md.Get(WebhookSignatureMetadataKey)
+	if len(signatures) == 0 || signatures[0] == "" {
+		return status.Error(codes.Unauthenticated, "missing webhook signature in metadata")
+	}
+
+	signature, err := hex.DecodeString(signatures[0])
+	if err != nil || !hmac.Equal(signature, WebhookSignature(a.webhookSecret, req)) {
+		return status.Error(codes.PermissionDenied, "invalid webhook signature")
+	}
+
+	return nil
+}
+
+// WebhookSignature вычисляет подпись callback'а, которую шлюз передает в metadata
+func WebhookSignature(secret string, req *paymentv1.ConfirmPaymentRequest) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(req.GetTransactionUuid() + ":" + req.GetStatus().String() + ":" + req.GetFailureReason()))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,46 @@
+package v1
+
+import (
+	"context"
+	"encoding/hex"
+	"testing"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	paymentv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/payment/v1"
+)
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	const secret = "gateway_webhook_secret"
+
+	req := &paymentv1.ConfirmPaymentRequest{
+		TransactionUuid: gofakeit.UUID(),
+		Status:          paymentv1.PaymentStatus_PAYMENT_STATUS_SUCCEEDED,
+	}
+	signed := func(signature string) context.Context {
+		return metadata.NewIncomingContext(context.Background(), metadata.Pairs(WebhookSignatureMetadataKey, signature))
+	}
+	valid := hex.EncodeToString(WebhookSignature(secret, req))
+
+	a := &api{webhookSecret: secret}
+
+	require.NoError(t, a.verifyWebhookSignature(signed(valid), req))
+
+	err := a.verifyWebhookSignature(context.Background(), req)
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	// Подпись от другого статуса не подходит: callback нельзя перевернуть из FAILED в SUCCEEDED
+	failed := &paymentv1.ConfirmPaymentRequest{
+		TransactionUuid: req.GetTransactionUuid(),
+		Status:          paymentv1.PaymentStatus_PAYMENT_STATUS_FAILED,
+	}
+	err = a.verifyWebhookSignature(signed(hex.EncodeToString(WebhookSignature(secret, failed))), req)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	err = (&api{}).verifyWebhookSignature(signed(valid), req)
+	require.Equal(t, codes.PermissionDenied, status.Code(err))
+}
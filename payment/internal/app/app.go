@@ -14,6 +14,7 @@ import (
 	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/closer"
 	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/grpc/health"
 	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+	grpcMiddleware "github.com/Daniil-Sakharov/RocketFactory/platform/pkg/middleware/grpc"
 	paymentv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/payment/v1"
 )
 
@@ -35,6 +36,10 @@ func New(ctx context.Context) (*App, error) {
 }
 
 func (a *App) Run(ctx context.Context) error {
+	go a.runInstallmentCharger(ctx)
+	go a.runResultPublisher(ctx)
+	go a.runOrderConsumer(ctx)
+
 	return a.runGRPCServer(ctx)
 }
 
@@ -43,6 +48,7 @@ func (a *App) InitDeps(ctx context.Context) error {
 		a.initDI,
 		a.initLogger,
 		a.initCloser,
+		a.StartMigrations,
 		a.initListener,
 		a.initGRPCServer,
 	}
@@ -62,6 +68,14 @@ func (a *App) initDI(_ context.Context) error {
 	return nil
 }
 
+func (a *App) StartMigrations(ctx context.Context) error {
+	migrator := a.diContainer.Migrator(ctx)
+	if err := migrator.Up(ctx); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (a *App) initLogger(_ context.Context) error {
 	return logger.Init(
 		config.AppConfig().Logger.Level(),
@@ -94,7 +108,23 @@ func (a *App) initListener(_ context.Context) error {
 }
 
 func (a *App) initGRPCServer(ctx context.Context) error {
-	a.grpcServer = grpc.NewServer(grpc.Creds(insecure.NewCredentials()))
+	// Пополнение счета инвестора доступно только по служебному токену
+	adminInterceptor := grpcMiddleware.NewAdminInterceptor(
+		config.AppConfig().Admin.Token(),
+		paymentv1.PaymentService_TopUpInvestorAccount_FullMethodName,
+	)
+
+	// Баланс и выписка отдаются только владельцу сессии
+	authInterceptor := grpcMiddleware.NewAuthInterceptor(
+		a.diContainer.AuthClient(),
+		paymentv1.PaymentService_GetInvestorBalance_FullMethodName,
+		paymentv1.PaymentService_GetInvestorStatement_FullMethodName,
+	)
+
+	a.grpcServer = grpc.NewServer(
+		grpc.Creds(insecure.NewCredentials()),
+		grpc.ChainUnaryInterceptor(adminInterceptor.Unary(), authInterceptor.Unary()),
+	)
 	closer.AddNamed("gRPC server", func(ctx context.Context) error {
 		a.grpcServer.GracefulStop()
 		return nil
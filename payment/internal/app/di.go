@@ -2,16 +2,87 @@ package app
 
 import (
 	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM/sarama"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/jmoiron/sqlx"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 
 	api "github.com/Daniil-Sakharov/RocketFactory/payment/internal/api/payment/v1"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/client/gateway"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/client/gateway/simulator"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/config"
+	kafkaConverter "github.com/Daniil-Sakharov/RocketFactory/payment/internal/converter/kafka"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/converter/kafka/decoder"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository"
+	fraudRepo "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/fraud"
+	installmentRepo "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/installment"
+	ledgerRepo "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/ledger"
+	orderSnapshotRepo "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/order_snapshot"
+	receiptRepo "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/receipt"
+	transactionRepo "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/transaction"
 	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/service"
+	orderConsumer "github.com/Daniil-Sakharov/RocketFactory/payment/internal/service/consumer/order_consumer"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/service/fraud"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/service/installment"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/service/ledger"
 	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/service/payment"
+	installmentProducer "github.com/Daniil-Sakharov/RocketFactory/payment/internal/service/producer/installment_producer"
+	paymentProducer "github.com/Daniil-Sakharov/RocketFactory/payment/internal/service/producer/payment_producer"
+	receiptProducer "github.com/Daniil-Sakharov/RocketFactory/payment/internal/service/producer/receipt_producer"
+	reconciliationProducer "github.com/Daniil-Sakharov/RocketFactory/payment/internal/service/producer/reconciliation_producer"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/service/receipt"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/service/reconciliation"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/closer"
+	wrappedKafka "github.com/Daniil-Sakharov/RocketFactory/platform/pkg/kafka"
+	wrappedKafkaConsumer "github.com/Daniil-Sakharov/RocketFactory/platform/pkg/kafka/consumer"
+	wrappedKafkaProducer "github.com/Daniil-Sakharov/RocketFactory/platform/pkg/kafka/producer"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+	grpcMiddleware "github.com/Daniil-Sakharov/RocketFactory/platform/pkg/middleware/grpc"
+	kafkaMiddleware "github.com/Daniil-Sakharov/RocketFactory/platform/pkg/middleware/kafka"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/migrator"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/migrator/pg"
+	authV1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/auth/v1"
 	paymentv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/payment/v1"
 )
 
 type diContainer struct {
-	paymentV1API   paymentv1.PaymentServiceServer
-	paymentService service.PaymentService
+	paymentV1API           paymentv1.PaymentServiceServer
+	paymentService         service.PaymentService
+	ledgerService          service.LedgerService
+	fraudService           service.FraudService
+	paymentProducerService service.PaymentProducerService
+	receiptService         service.ReceiptService
+	installmentService     service.InstallmentService
+	installmentProducer    service.InstallmentProducerService
+	receiptProducer        service.ReceiptProducerService
+	reconciliationService  service.ReconciliationService
+	reconciliationProducer service.ReconciliationProducerService
+	orderConsumerService   service.OrderConsumerService
+	orderPaidDecoder       kafkaConverter.OrderPaidDecoder
+	orderPaidConsumerGroup sarama.ConsumerGroup
+	orderPaidConsumer      wrappedKafka.Consumer
+	transactionRepository  repository.TransactionRepository
+	ledgerRepository       repository.LedgerRepository
+	fraudRepository        repository.FraudDecisionRepository
+	receiptRepository      repository.ReceiptRepository
+	installmentRepository  repository.InstallmentRepository
+	orderSnapshotRepo      repository.OrderSnapshotRepository
+	paymentGateway         gateway.PaymentGateway
+	postgresDB             *sqlx.DB
+	migrator               migrator.Migrator
+	succeededProducer      wrappedKafka.Producer
+	failedProducer         wrappedKafka.Producer
+	receiptIssuedProducer  wrappedKafka.Producer
+	installmentMissed      wrappedKafka.Producer
+	installmentDefaulted   wrappedKafka.Producer
+	discrepancyProducer    wrappedKafka.Producer
+	syncProducer           sarama.SyncProducer
+	authClient             grpcMiddleware.AuthClient
 }
 
 func NewDiContainer() *diContainer {
@@ -20,14 +91,362 @@ func NewDiContainer() *diContainer {
 
 func (d *diContainer) PaymentAPI(ctx context.Context) paymentv1.PaymentServiceServer {
 	if d.paymentV1API == nil {
-		d.paymentV1API = api.New(d.PaymentService(ctx))
+		d.paymentV1API = api.New(
+			d.PaymentService(ctx),
+			d.LedgerService(ctx),
+			d.FraudService(ctx),
+			d.ReceiptService(ctx),
+			d.InstallmentService(ctx),
+			config.AppConfig().Webhook.Secret(),
+		)
 	}
 	return d.paymentV1API
 }
 
-func (d *diContainer) PaymentService(_ context.Context) service.PaymentService {
+func (d *diContainer) PaymentService(ctx context.Context) service.PaymentService {
 	if d.paymentService == nil {
-		d.paymentService = payment.New()
+		d.paymentService = payment.New(
+			d.TransactionRepository(ctx),
+			d.LedgerRepository(ctx),
+			d.PaymentGateway(),
+			d.PaymentProducerService(),
+			d.FraudService(ctx),
+			d.ReceiptService(ctx),
+			d.InstallmentService(ctx),
+		)
 	}
 	return d.paymentService
 }
+
+func (d *diContainer) LedgerService(ctx context.Context) service.LedgerService {
+	if d.ledgerService == nil {
+		d.ledgerService = ledger.New(d.LedgerRepository(ctx))
+	}
+	return d.ledgerService
+}
+
+func (d *diContainer) FraudService(ctx context.Context) service.FraudService {
+	if d.fraudService == nil {
+		cfg := config.AppConfig().Fraud
+
+		rules := []fraud.Rule{
+			fraud.NewOwnerMismatchRule(cfg.OwnerMismatchVerdict()),
+		}
+		// Нулевой лимит отключает правило
+		if cfg.MaxPaymentsPerHour() > 0 {
+			rules = append(rules, fraud.NewVelocityRule(d.TransactionRepository(ctx), cfg.MaxPaymentsPerHour(), cfg.VelocityVerdict()))
+		}
+		if cfg.CreditCardAmountLimit() > 0 {
+			rules = append(rules, fraud.NewAmountLimitRule(model.PaymentMethodCreditCard, cfg.CreditCardAmountLimit(), cfg.CreditCardLimitVerdict()))
+		}
+
+		d.fraudService = fraud.New(d.FraudDecisionRepository(ctx), rules...)
+	}
+	return d.fraudService
+}
+
+func (d *diContainer) ReceiptService(ctx context.Context) service.ReceiptService {
+	if d.receiptService == nil {
+		engine, err := receipt.NewTemplateEngine()
+		if err != nil {
+			panic(fmt.Sprintf("Ошибка загрузки шаблонов чека: %s\n", err.Error()))
+		}
+
+		d.receiptService = receipt.New(
+			d.ReceiptRepository(ctx),
+			d.ReceiptProducerService(),
+			engine,
+			config.AppConfig().Receipt.VatRate(),
+			config.AppConfig().Receipt.SellerName(),
+		)
+	}
+	return d.receiptService
+}
+
+func (d *diContainer) InstallmentService(ctx context.Context) service.InstallmentService {
+	if d.installmentService == nil {
+		d.installmentService = installment.New(
+			d.InstallmentRepository(ctx),
+			d.PaymentGateway(),
+			d.InstallmentProducerService(),
+			config.AppConfig().Installment.MaxCount(),
+			config.AppConfig().Installment.MinAmount(),
+			config.AppConfig().Installment.MaxAttempts(),
+			config.AppConfig().Installment.RetryInterval(),
+		)
+	}
+	return d.installmentService
+}
+
+func (d *diContainer) ReconciliationService(ctx context.Context) service.ReconciliationService {
+	if d.reconciliationService == nil {
+		d.reconciliationService = reconciliation.New(
+			d.TransactionRepository(ctx),
+			d.OrderSnapshotRepository(ctx),
+			d.ReconciliationProducerService(),
+		)
+	}
+	return d.reconciliationService
+}
+
+func (d *diContainer) AuthClient() grpcMiddleware.AuthClient {
+	if d.authClient == nil {
+		conn, err := grpc.NewClient(config.AppConfig().AuthGRPC.Address(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+		if err != nil {
+			panic(fmt.Sprintf("Ошибка в подключении к Auth Service: %s\n", err.Error()))
+		}
+		closer.AddNamed("AuthClient", func(ctx context.Context) error {
+			return conn.Close()
+		})
+		d.authClient = authV1.NewAuthServiceClient(conn)
+	}
+	return d.authClient
+}
+
+func (d *diContainer) PaymentGateway() gateway.PaymentGateway {
+	if d.paymentGateway == nil {
+		d.paymentGateway = simulator.NewClient()
+	}
+	return d.paymentGateway
+}
+
+func (d *diContainer) PaymentProducerService() service.PaymentProducerService {
+	if d.paymentProducerService == nil {
+		d.paymentProducerService = paymentProducer.NewService(
+			d.PaymentSucceededProducer(),
+			d.PaymentFailedProducer(),
+		)
+	}
+	return d.paymentProducerService
+}
+
+func (d *diContainer) ReceiptProducerService() service.ReceiptProducerService {
+	if d.receiptProducer == nil {
+		d.receiptProducer = receiptProducer.NewService(d.ReceiptIssuedProducer())
+	}
+	return d.receiptProducer
+}
+
+func (d *diContainer) ReceiptIssuedProducer() wrappedKafka.Producer {
+	if d.receiptIssuedProducer == nil {
+		d.receiptIssuedProducer = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().Receipt.IssuedTopic(),
+			logger.Logger(),
+		)
+	}
+	return d.receiptIssuedProducer
+}
+
+func (d *diContainer) InstallmentProducerService() service.InstallmentProducerService {
+	if d.installmentProducer == nil {
+		d.installmentProducer = installmentProducer.NewService(d.InstallmentMissedProducer(), d.InstallmentDefaultedProducer())
+	}
+	return d.installmentProducer
+}
+
+func (d *diContainer) InstallmentMissedProducer() wrappedKafka.Producer {
+	if d.installmentMissed == nil {
+		d.installmentMissed = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().Installment.MissedTopic(),
+			logger.Logger(),
+		)
+	}
+	return d.installmentMissed
+}
+
+func (d *diContainer) InstallmentDefaultedProducer() wrappedKafka.Producer {
+	if d.installmentDefaulted == nil {
+		d.installmentDefaulted = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().Installment.DefaultedTopic(),
+			logger.Logger(),
+		)
+	}
+	return d.installmentDefaulted
+}
+
+func (d *diContainer) ReconciliationProducerService() service.ReconciliationProducerService {
+	if d.reconciliationProducer == nil {
+		d.reconciliationProducer = reconciliationProducer.NewService(d.DiscrepancyProducer())
+	}
+	return d.reconciliationProducer
+}
+
+func (d *diContainer) DiscrepancyProducer() wrappedKafka.Producer {
+	if d.discrepancyProducer == nil {
+		d.discrepancyProducer = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().Reconciliation.DiscrepancyTopic(),
+			logger.Logger(),
+		)
+	}
+	return d.discrepancyProducer
+}
+
+func (d *diContainer) PaymentSucceededProducer() wrappedKafka.Producer {
+	if d.succeededProducer == nil {
+		d.succeededProducer = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().PaymentProducer.SucceededTopic(),
+			logger.Logger(),
+		)
+	}
+	return d.succeededProducer
+}
+
+func (d *diContainer) PaymentFailedProducer() wrappedKafka.Producer {
+	if d.failedProducer == nil {
+		d.failedProducer = wrappedKafkaProducer.NewProducer(
+			d.SyncProducer(),
+			config.AppConfig().PaymentProducer.FailedTopic(),
+			logger.Logger(),
+		)
+	}
+	return d.failedProducer
+}
+
+func (d *diContainer) SyncProducer() sarama.SyncProducer {
+	if d.syncProducer == nil {
+		p, err := sarama.NewSyncProducer(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().PaymentProducer.Config(),
+		)
+		if err != nil {
+			panic("failed to create sync producer: " + err.Error())
+		}
+		closer.AddNamed("Kafka sync producer", func(ctx context.Context) error {
+			return p.Close()
+		})
+
+		d.syncProducer = p
+	}
+	return d.syncProducer
+}
+
+func (d *diContainer) TransactionRepository(ctx context.Context) repository.TransactionRepository {
+	if d.transactionRepository == nil {
+		d.transactionRepository = transactionRepo.NewRepository(d.PostgresDB(ctx))
+	}
+	return d.transactionRepository
+}
+
+func (d *diContainer) LedgerRepository(ctx context.Context) repository.LedgerRepository {
+	if d.ledgerRepository == nil {
+		d.ledgerRepository = ledgerRepo.NewRepository(d.PostgresDB(ctx))
+	}
+	return d.ledgerRepository
+}
+
+func (d *diContainer) FraudDecisionRepository(ctx context.Context) repository.FraudDecisionRepository {
+	if d.fraudRepository == nil {
+		d.fraudRepository = fraudRepo.NewRepository(d.PostgresDB(ctx))
+	}
+	return d.fraudRepository
+}
+
+func (d *diContainer) ReceiptRepository(ctx context.Context) repository.ReceiptRepository {
+	if d.receiptRepository == nil {
+		d.receiptRepository = receiptRepo.NewRepository(d.PostgresDB(ctx))
+	}
+	return d.receiptRepository
+}
+
+func (d *diContainer) InstallmentRepository(ctx context.Context) repository.InstallmentRepository {
+	if d.installmentRepository == nil {
+		d.installmentRepository = installmentRepo.NewRepository(d.PostgresDB(ctx))
+	}
+	return d.installmentRepository
+}
+
+func (d *diContainer) OrderConsumerService(ctx context.Context) service.OrderConsumerService {
+	if d.orderConsumerService == nil {
+		d.orderConsumerService = orderConsumer.NewService(
+			d.OrderPaidConsumer(),
+			d.OrderPaidDecoder(),
+			d.OrderSnapshotRepository(ctx),
+		)
+	}
+	return d.orderConsumerService
+}
+
+func (d *diContainer) OrderPaidDecoder() kafkaConverter.OrderPaidDecoder {
+	if d.orderPaidDecoder == nil {
+		d.orderPaidDecoder = decoder.NewOrderPaidDecoder()
+	}
+	return d.orderPaidDecoder
+}
+
+func (d *diContainer) OrderPaidConsumerGroup() sarama.ConsumerGroup {
+	if d.orderPaidConsumerGroup == nil {
+		consumerGroup, err := sarama.NewConsumerGroup(
+			config.AppConfig().Kafka.Brokers(),
+			config.AppConfig().OrderConsumer.GroupID(),
+			config.AppConfig().OrderConsumer.Config(),
+		)
+		if err != nil {
+			panic(fmt.Sprintf("failed to create order_paid consumer group: %s", err.Error()))
+		}
+
+		closer.AddNamed("Kafka OrderPaid consumer group", func(ctx context.Context) error {
+			return consumerGroup.Close()
+		})
+
+		d.orderPaidConsumerGroup = consumerGroup
+	}
+	return d.orderPaidConsumerGroup
+}
+
+func (d *diContainer) OrderPaidConsumer() wrappedKafka.Consumer {
+	if d.orderPaidConsumer == nil {
+		d.orderPaidConsumer = wrappedKafkaConsumer.NewConsumer(
+			d.OrderPaidConsumerGroup(),
+			[]string{config.AppConfig().OrderConsumer.Topic()},
+			logger.Logger(),
+			kafkaMiddleware.Logging(logger.Logger()),
+		)
+	}
+	return d.orderPaidConsumer
+}
+
+func (d *diContainer) OrderSnapshotRepository(ctx context.Context) repository.OrderSnapshotRepository {
+	if d.orderSnapshotRepo == nil {
+		d.orderSnapshotRepo = orderSnapshotRepo.NewRepository(d.PostgresDB(ctx))
+	}
+	return d.orderSnapshotRepo
+}
+
+func (d *diContainer) Migrator(ctx context.Context) migrator.Migrator {
+	if d.migrator == nil {
+		db := d.PostgresDB(ctx)
+		d.migrator = pg.NewMigrator(db.DB, config.AppConfig().PostgresDB.MigrationsDir())
+	}
+	return d.migrator
+}
+
+func (d *diContainer) PostgresDB(_ context.Context) *sqlx.DB {
+	if d.postgresDB == nil {
+		db, err := sqlx.Connect("pgx", config.AppConfig().PostgresDB.URI())
+		if err != nil {
+			panic(fmt.Sprintf("Ошибка в подключении к PostgreSQL: %s\n", err.Error()))
+		}
+
+		db.SetMaxOpenConns(25)
+		db.SetMaxIdleConns(5)
+		db.SetConnMaxLifetime(5 * time.Minute)
+		db.SetConnMaxIdleTime(1 * time.Minute)
+
+		err = db.Ping()
+		if err != nil {
+			panic(fmt.Sprintf("Ошибка в соединении с PostgreSQL: %s\n", err.Error()))
+		}
+
+		closer.AddNamed("PostgreSQL", func(ctx context.Context) error {
+			return db.Close()
+		})
+
+		d.postgresDB = db
+	}
+	return d.postgresDB
+}
@@ -0,0 +1,42 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/config"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+// runInstallmentCharger периодически списывает наступившие платежи по рассрочке до отмены ctx
+func (a *App) runInstallmentCharger(ctx context.Context) {
+	interval := config.AppConfig().Installment.ChargeInterval()
+	installmentService := a.diContainer.InstallmentService(ctx)
+
+	logger.Info(ctx, "📆 Job списаний по рассрочке запущен", zap.Duration("interval", interval))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			result, err := installmentService.ChargeDue(ctx, now)
+			if err != nil {
+				logger.Error(ctx, "❌ Ошибка списания платежей по рассрочке", zap.Error(err))
+				continue
+			}
+			if result.Charged > 0 || result.Missed > 0 || result.Failed > 0 {
+				logger.Info(ctx, "📆 Платежи по рассрочке обработаны",
+					zap.Int("charged", result.Charged),
+					zap.Int("missed", result.Missed),
+					zap.Int("failed", result.Failed),
+				)
+			}
+		}
+	}
+}
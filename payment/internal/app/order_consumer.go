@@ -0,0 +1,16 @@
+package app
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+// runOrderConsumer читает события OrderPaid для сверки. Остановка consumer'а не прерывает прием платежей
+func (a *App) runOrderConsumer(ctx context.Context) {
+	if err := a.diContainer.OrderConsumerService(ctx).RunConsumer(ctx); err != nil {
+		logger.Error(ctx, "❌ OrderPaid consumer остановлен", zap.Error(err))
+	}
+}
@@ -0,0 +1,35 @@
+package app
+
+import (
+	"context"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+// Reconciler - одноразовый запуск сверки платежей без gRPC сервера и миграций
+type Reconciler struct {
+	diContainer *diContainer
+}
+
+func NewReconciler(ctx context.Context) (*Reconciler, error) {
+	a := &App{}
+
+	inits := []func(context.Context) error{
+		a.initDI,
+		a.initLogger,
+		a.initCloser,
+	}
+
+	for _, f := range inits {
+		err := f(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &Reconciler{diContainer: a.diContainer}, nil
+}
+
+func (r *Reconciler) Run(ctx context.Context, req *model.ReconciliationRequest) (*model.ReconciliationReport, error) {
+	return r.diContainer.ReconciliationService(ctx).Reconcile(ctx, req)
+}
@@ -0,0 +1,38 @@
+package app
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/config"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+// runResultPublisher периодически досылает неопубликованные результаты платежей до отмены ctx
+func (a *App) runResultPublisher(ctx context.Context) {
+	interval := config.AppConfig().PaymentProducer.ResultRetryInterval()
+	paymentService := a.diContainer.PaymentService(ctx)
+
+	logger.Info(ctx, "📨 Job досылки результатов платежей запущен", zap.Duration("interval", interval))
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			published, err := paymentService.PublishPendingResults(ctx, now)
+			if err != nil {
+				logger.Error(ctx, "❌ Ошибка досылки результатов платежей", zap.Error(err))
+				continue
+			}
+			if published > 0 {
+				logger.Info(ctx, "📨 Результаты платежей досланы", zap.Int("published", published))
+			}
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package gateway
+
+import (
+	"context"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+// PaymentGateway - абстракция внешнего платежного шлюза
+type PaymentGateway interface {
+	// Charge инициирует списание по транзакции. Для асинхронных методов возвращает PENDING,
+	// итоговый результат приходит позже через ConfirmPayment
+	Charge(ctx context.Context, tx *model.Transaction) (*model.ChargeResult, error)
+	// ChargeInstallment списывает очередной платеж по рассрочке с карты исходной транзакции
+	ChargeInstallment(ctx context.Context, charge *model.InstallmentCharge) (*model.ChargeResult, error)
+}
@@ -0,0 +1,158 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+// PaymentGateway is an autogenerated mock type for the PaymentGateway type
+type PaymentGateway struct {
+	mock.Mock
+}
+
+type PaymentGateway_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PaymentGateway) EXPECT() *PaymentGateway_Expecter {
+	return &PaymentGateway_Expecter{mock: &_m.Mock}
+}
+
+// Charge provides a mock function with given fields: ctx, tx
+func (_m *PaymentGateway) Charge(ctx context.Context, tx *model.Transaction) (*model.ChargeResult, error) {
+	ret := _m.Called(ctx, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Charge")
+	}
+
+	var r0 *model.ChargeResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Transaction) (*model.ChargeResult, error)); ok {
+		return rf(ctx, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Transaction) *model.ChargeResult); ok {
+		r0 = rf(ctx, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ChargeResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Transaction) error); ok {
+		r1 = rf(ctx, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentGateway_Charge_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Charge'
+type PaymentGateway_Charge_Call struct {
+	*mock.Call
+}
+
+// Charge is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx *model.Transaction
+func (_e *PaymentGateway_Expecter) Charge(ctx interface{}, tx interface{}) *PaymentGateway_Charge_Call {
+	return &PaymentGateway_Charge_Call{Call: _e.mock.On("Charge", ctx, tx)}
+}
+
+func (_c *PaymentGateway_Charge_Call) Run(run func(ctx context.Context, tx *model.Transaction)) *PaymentGateway_Charge_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Transaction))
+	})
+	return _c
+}
+
+func (_c *PaymentGateway_Charge_Call) Return(_a0 *model.ChargeResult, _a1 error) *PaymentGateway_Charge_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentGateway_Charge_Call) RunAndReturn(run func(context.Context, *model.Transaction) (*model.ChargeResult, error)) *PaymentGateway_Charge_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChargeInstallment provides a mock function with given fields: ctx, charge
+func (_m *PaymentGateway) ChargeInstallment(ctx context.Context, charge *model.InstallmentCharge) (*model.ChargeResult, error) {
+	ret := _m.Called(ctx, charge)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChargeInstallment")
+	}
+
+	var r0 *model.ChargeResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.InstallmentCharge) (*model.ChargeResult, error)); ok {
+		return rf(ctx, charge)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.InstallmentCharge) *model.ChargeResult); ok {
+		r0 = rf(ctx, charge)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ChargeResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.InstallmentCharge) error); ok {
+		r1 = rf(ctx, charge)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentGateway_ChargeInstallment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChargeInstallment'
+type PaymentGateway_ChargeInstallment_Call struct {
+	*mock.Call
+}
+
+// ChargeInstallment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - charge *model.InstallmentCharge
+func (_e *PaymentGateway_Expecter) ChargeInstallment(ctx interface{}, charge interface{}) *PaymentGateway_ChargeInstallment_Call {
+	return &PaymentGateway_ChargeInstallment_Call{Call: _e.mock.On("ChargeInstallment", ctx, charge)}
+}
+
+func (_c *PaymentGateway_ChargeInstallment_Call) Run(run func(ctx context.Context, charge *model.InstallmentCharge)) *PaymentGateway_ChargeInstallment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.InstallmentCharge))
+	})
+	return _c
+}
+
+func (_c *PaymentGateway_ChargeInstallment_Call) Return(_a0 *model.ChargeResult, _a1 error) *PaymentGateway_ChargeInstallment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentGateway_ChargeInstallment_Call) RunAndReturn(run func(context.Context, *model.InstallmentCharge) (*model.ChargeResult, error)) *PaymentGateway_ChargeInstallment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPaymentGateway creates a new instance of PaymentGateway. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPaymentGateway(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PaymentGateway {
+	mock := &PaymentGateway{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
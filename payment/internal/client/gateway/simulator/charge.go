@@ -0,0 +1,24 @@
+package simulator
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+// Charge эмулирует обращение к шлюзу: карта и СБП подтверждаются асинхронно через callback,
+// остальные методы проводятся сразу
+func (c *client) Charge(ctx context.Context, tx *model.Transaction) (*model.ChargeResult, error) {
+	switch tx.PaymentMethod {
+	case model.PaymentMethodCard, model.PaymentMethodSBP:
+		logger.Info(ctx, "⏳ Платеж отправлен в шлюз, ожидаем подтверждения",
+			zap.String("transaction_uuid", tx.TransactionUUID),
+		)
+		return &model.ChargeResult{Status: model.PaymentStatusPending}, nil
+	default:
+		return &model.ChargeResult{Status: model.PaymentStatusSucceeded}, nil
+	}
+}
@@ -0,0 +1,21 @@
+package simulator
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+// ChargeInstallment эмулирует списание платежа по рассрочке: кредитная карта проводится сразу
+func (c *client) ChargeInstallment(ctx context.Context, charge *model.InstallmentCharge) (*model.ChargeResult, error) {
+	logger.Info(ctx, "📆 Списание платежа по рассрочке",
+		zap.String("installment_uuid", charge.InstallmentUUID),
+		zap.String("transaction_uuid", charge.TransactionUUID),
+		zap.Int32("number", charge.Number),
+		zap.Float64("amount", charge.Amount),
+	)
+	return &model.ChargeResult{Status: model.PaymentStatusSucceeded}, nil
+}
@@ -0,0 +1,14 @@
+package simulator
+
+import (
+	def "github.com/Daniil-Sakharov/RocketFactory/payment/internal/client/gateway"
+)
+
+var _ def.PaymentGateway = (*client)(nil)
+
+// client - эмулятор платежного шлюза, пока нет интеграции с реальным провайдером
+type client struct{}
+
+func NewClient() *client {
+	return &client{}
+}
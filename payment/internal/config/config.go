@@ -9,8 +9,19 @@ import (
 var appConfig *config
 
 type config struct {
-	Payment PaymentConfig
-	Logger  LoggerConfig
+	Payment         PaymentConfig
+	Logger          LoggerConfig
+	PostgresDB      PostgresConfig
+	Kafka           KafkaConfig
+	PaymentProducer PaymentProducerConfig
+	OrderConsumer   OrderConsumerConfig
+	Fraud           FraudConfig
+	Receipt         ReceiptConfig
+	Installment     InstallmentConfig
+	Reconciliation  ReconciliationConfig
+	Webhook         WebhookConfig
+	Admin           AdminConfig
+	AuthGRPC        AuthGRPCConfig
 }
 
 func Load(path ...string) error {
@@ -28,9 +39,75 @@ func Load(path ...string) error {
 		return err
 	}
 
+	postgresCfg, err := env.NewPostgresConfig()
+	if err != nil {
+		return err
+	}
+
+	kafkaCfg, err := env.NewKafkaConfig()
+	if err != nil {
+		return err
+	}
+
+	producerCfg, err := env.NewPaymentProducerConfig()
+	if err != nil {
+		return err
+	}
+
+	fraudCfg, err := env.NewFraudConfig()
+	if err != nil {
+		return err
+	}
+
+	receiptCfg, err := env.NewReceiptConfig()
+	if err != nil {
+		return err
+	}
+
+	installmentCfg, err := env.NewInstallmentConfig()
+	if err != nil {
+		return err
+	}
+
+	orderConsumerCfg, err := env.NewOrderConsumerConfig()
+	if err != nil {
+		return err
+	}
+
+	reconciliationCfg, err := env.NewReconciliationConfig()
+	if err != nil {
+		return err
+	}
+
+	webhookCfg, err := env.NewWebhookConfig()
+	if err != nil {
+		return err
+	}
+
+	adminCfg, err := env.NewAdminConfig()
+	if err != nil {
+		return err
+	}
+
+	authGRPCCfg, err := env.NewAuthGRPCConfig()
+	if err != nil {
+		return err
+	}
+
 	appConfig = &config{
-		Payment: paymentCfg,
-		Logger:  loggerCfg,
+		Payment:         paymentCfg,
+		Logger:          loggerCfg,
+		PostgresDB:      postgresCfg,
+		Kafka:           kafkaCfg,
+		PaymentProducer: producerCfg,
+		Fraud:           fraudCfg,
+		Receipt:         receiptCfg,
+		Installment:     installmentCfg,
+		OrderConsumer:   orderConsumerCfg,
+		Reconciliation:  reconciliationCfg,
+		Webhook:         webhookCfg,
+		Admin:           adminCfg,
+		AuthGRPC:        authGRPCCfg,
 	}
 
 	return nil
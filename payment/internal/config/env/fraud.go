@@ -0,0 +1,83 @@
+package env
+
+import (
+	"fmt"
+
+	"github.com/caarlos0/env/v11"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+type fraudEnvConfig struct {
+	MaxPaymentsPerHour     int     `env:"FRAUD_MAX_PAYMENTS_PER_HOUR" envDefault:"5"`
+	VelocityVerdict        string  `env:"FRAUD_VELOCITY_VERDICT" envDefault:"REVIEW"`
+	CreditCardAmountLimit  float64 `env:"FRAUD_CREDIT_CARD_AMOUNT_LIMIT" envDefault:"500000"`
+	CreditCardLimitVerdict string  `env:"FRAUD_CREDIT_CARD_LIMIT_VERDICT" envDefault:"REJECT"`
+	OwnerMismatchVerdict   string  `env:"FRAUD_OWNER_MISMATCH_VERDICT" envDefault:"REVIEW"`
+}
+
+type fraudConfig struct {
+	raw                    fraudEnvConfig
+	velocityVerdict        model.FraudVerdict
+	creditCardLimitVerdict model.FraudVerdict
+	ownerMismatchVerdict   model.FraudVerdict
+}
+
+func NewFraudConfig() (*fraudConfig, error) {
+	var raw fraudEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	velocityVerdict, err := parseFraudVerdict(raw.VelocityVerdict)
+	if err != nil {
+		return nil, err
+	}
+	creditCardLimitVerdict, err := parseFraudVerdict(raw.CreditCardLimitVerdict)
+	if err != nil {
+		return nil, err
+	}
+	ownerMismatchVerdict, err := parseFraudVerdict(raw.OwnerMismatchVerdict)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fraudConfig{
+		raw:                    raw,
+		velocityVerdict:        velocityVerdict,
+		creditCardLimitVerdict: creditCardLimitVerdict,
+		ownerMismatchVerdict:   ownerMismatchVerdict,
+	}, nil
+}
+
+// parseFraudVerdict разбирает решение, которое правило выносит при срабатывании
+func parseFraudVerdict(s string) (model.FraudVerdict, error) {
+	switch s {
+	case "REVIEW":
+		return model.FraudVerdictReview, nil
+	case "REJECT":
+		return model.FraudVerdictReject, nil
+	default:
+		return model.FraudVerdictUnspecified, fmt.Errorf("invalid fraud verdict %q: expected REVIEW or REJECT", s)
+	}
+}
+
+func (cfg *fraudConfig) MaxPaymentsPerHour() int {
+	return cfg.raw.MaxPaymentsPerHour
+}
+
+func (cfg *fraudConfig) VelocityVerdict() model.FraudVerdict {
+	return cfg.velocityVerdict
+}
+
+func (cfg *fraudConfig) CreditCardAmountLimit() float64 {
+	return cfg.raw.CreditCardAmountLimit
+}
+
+func (cfg *fraudConfig) CreditCardLimitVerdict() model.FraudVerdict {
+	return cfg.creditCardLimitVerdict
+}
+
+func (cfg *fraudConfig) OwnerMismatchVerdict() model.FraudVerdict {
+	return cfg.ownerMismatchVerdict
+}
@@ -0,0 +1,64 @@
+package env
+
+import (
+	"time"
+
+	"github.com/caarlos0/env/v11"
+)
+
+type installmentEnvConfig struct {
+	MaxCount           int32         `env:"INSTALLMENT_MAX_COUNT" envDefault:"24"`
+	MinAmount          float64       `env:"INSTALLMENT_MIN_AMOUNT" envDefault:"100000"`
+	ChargeInterval     time.Duration `env:"INSTALLMENT_CHARGE_INTERVAL" envDefault:"1h"`
+	MaxAttempts        int32         `env:"INSTALLMENT_MAX_ATTEMPTS" envDefault:"5"`
+	RetryInterval      time.Duration `env:"INSTALLMENT_RETRY_INTERVAL" envDefault:"24h"`
+	MissedTopicName    string        `env:"INSTALLMENT_MISSED_TOPIC_NAME" envDefault:"payment.installment.missed"`
+	DefaultedTopicName string        `env:"INSTALLMENT_DEFAULTED_TOPIC_NAME" envDefault:"payment.installment.defaulted"`
+}
+
+type installmentConfig struct {
+	raw installmentEnvConfig
+}
+
+func NewInstallmentConfig() (*installmentConfig, error) {
+	var raw installmentEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &installmentConfig{raw: raw}, nil
+}
+
+// MaxCount - максимальное количество платежей в плане рассрочки
+func (cfg *installmentConfig) MaxCount() int32 {
+	return cfg.raw.MaxCount
+}
+
+// MinAmount - минимальная сумма заказа, которую можно оформить в рассрочку
+func (cfg *installmentConfig) MinAmount() float64 {
+	return cfg.raw.MinAmount
+}
+
+// ChargeInterval - период запуска job'а списания платежей по графику
+func (cfg *installmentConfig) ChargeInterval() time.Duration {
+	return cfg.raw.ChargeInterval
+}
+
+// MaxAttempts - число попыток списания одного платежа, после которого он переходит в FAILED
+func (cfg *installmentConfig) MaxAttempts() int32 {
+	return cfg.raw.MaxAttempts
+}
+
+// RetryInterval - пауза перед повторным списанием пропущенного платежа
+func (cfg *installmentConfig) RetryInterval() time.Duration {
+	return cfg.raw.RetryInterval
+}
+
+func (cfg *installmentConfig) MissedTopic() string {
+	return cfg.raw.MissedTopicName
+}
+
+// DefaultedTopic - топик событий о планах, прекращенных после исчерпания попыток списания
+func (cfg *installmentConfig) DefaultedTopic() string {
+	return cfg.raw.DefaultedTopicName
+}
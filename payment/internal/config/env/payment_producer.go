@@ -0,0 +1,48 @@
+package env
+
+import (
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/caarlos0/env/v11"
+)
+
+type paymentProducerEnvConfig struct {
+	SucceededTopicName  string        `env:"PAYMENT_SUCCEEDED_TOPIC_NAME,required"`
+	FailedTopicName     string        `env:"PAYMENT_FAILED_TOPIC_NAME,required"`
+	ResultRetryInterval time.Duration `env:"PAYMENT_RESULT_RETRY_INTERVAL" envDefault:"30s"`
+}
+
+type paymentProducerConfig struct {
+	raw paymentProducerEnvConfig
+}
+
+func NewPaymentProducerConfig() (*paymentProducerConfig, error) {
+	var raw paymentProducerEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &paymentProducerConfig{raw: raw}, nil
+}
+
+func (cfg *paymentProducerConfig) SucceededTopic() string {
+	return cfg.raw.SucceededTopicName
+}
+
+func (cfg *paymentProducerConfig) FailedTopic() string {
+	return cfg.raw.FailedTopicName
+}
+
+// ResultRetryInterval - период запуска job'а, досылающего неопубликованные результаты платежей
+func (cfg *paymentProducerConfig) ResultRetryInterval() time.Duration {
+	return cfg.raw.ResultRetryInterval
+}
+
+func (cfg *paymentProducerConfig) Config() *sarama.Config {
+	config := sarama.NewConfig()
+	config.Version = sarama.V4_0_0_0
+	config.Producer.Return.Successes = true
+
+	return config
+}
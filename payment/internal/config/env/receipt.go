@@ -0,0 +1,37 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type receiptEnvConfig struct {
+	VatRate         float64 `env:"RECEIPT_VAT_RATE" envDefault:"20"`
+	SellerName      string  `env:"RECEIPT_SELLER_NAME" envDefault:"Rocket Factory"`
+	IssuedTopicName string  `env:"RECEIPT_ISSUED_TOPIC_NAME" envDefault:"payment.receipt.issued"`
+}
+
+type receiptConfig struct {
+	raw receiptEnvConfig
+}
+
+func NewReceiptConfig() (*receiptConfig, error) {
+	var raw receiptEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &receiptConfig{raw: raw}, nil
+}
+
+// VatRate - ставка НДС в процентах, цены позиций включают НДС
+func (cfg *receiptConfig) VatRate() float64 {
+	return cfg.raw.VatRate
+}
+
+func (cfg *receiptConfig) SellerName() string {
+	return cfg.raw.SellerName
+}
+
+func (cfg *receiptConfig) IssuedTopic() string {
+	return cfg.raw.IssuedTopicName
+}
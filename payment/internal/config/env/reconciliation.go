@@ -0,0 +1,26 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type reconciliationEnvConfig struct {
+	DiscrepancyTopicName string `env:"RECONCILIATION_DISCREPANCY_TOPIC_NAME" envDefault:"payment.discrepancy"`
+}
+
+type reconciliationConfig struct {
+	raw reconciliationEnvConfig
+}
+
+func NewReconciliationConfig() (*reconciliationConfig, error) {
+	var raw reconciliationEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &reconciliationConfig{raw: raw}, nil
+}
+
+func (cfg *reconciliationConfig) DiscrepancyTopic() string {
+	return cfg.raw.DiscrepancyTopicName
+}
@@ -0,0 +1,28 @@
+package env
+
+import (
+	"github.com/caarlos0/env/v11"
+)
+
+type webhookEnvConfig struct {
+	Secret string `env:"WEBHOOK_SECRET"`
+}
+
+type webhookConfig struct {
+	raw webhookEnvConfig
+}
+
+func NewWebhookConfig() (*webhookConfig, error) {
+	var raw webhookEnvConfig
+	if err := env.Parse(&raw); err != nil {
+		return nil, err
+	}
+
+	return &webhookConfig{raw: raw}, nil
+}
+
+// Secret - общий с платежным шлюзом ключ подписи callback'ов ConfirmPayment.
+// Пустой ключ отклоняет все callback'и
+func (cfg *webhookConfig) Secret() string {
+	return cfg.raw.Secret
+}
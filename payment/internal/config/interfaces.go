@@ -1,5 +1,13 @@
 package config
 
+import (
+	"time"
+
+	"github.com/IBM/sarama"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
 type PaymentConfig interface {
 	Address() string
 }
@@ -8,3 +16,66 @@ type LoggerConfig interface {
 	Level() string
 	AsJson() bool
 }
+
+type PostgresConfig interface {
+	URI() string
+	DatabaseName() string
+	MigrationsDir() string
+}
+
+type KafkaConfig interface {
+	Brokers() []string
+}
+
+type OrderConsumerConfig interface {
+	Topic() string
+	GroupID() string
+	Config() *sarama.Config
+}
+
+type PaymentProducerConfig interface {
+	SucceededTopic() string
+	FailedTopic() string
+	ResultRetryInterval() time.Duration
+	Config() *sarama.Config
+}
+
+type FraudConfig interface {
+	MaxPaymentsPerHour() int
+	VelocityVerdict() model.FraudVerdict
+	CreditCardAmountLimit() float64
+	CreditCardLimitVerdict() model.FraudVerdict
+	OwnerMismatchVerdict() model.FraudVerdict
+}
+
+type ReceiptConfig interface {
+	VatRate() float64
+	SellerName() string
+	IssuedTopic() string
+}
+
+type InstallmentConfig interface {
+	MaxCount() int32
+	MinAmount() float64
+	ChargeInterval() time.Duration
+	MaxAttempts() int32
+	RetryInterval() time.Duration
+	MissedTopic() string
+	DefaultedTopic() string
+}
+
+type ReconciliationConfig interface {
+	DiscrepancyTopic() string
+}
+
+type WebhookConfig interface {
+	Secret() string
+}
+
+type AdminConfig interface {
+	Token() string
+}
+
+type AuthGRPCConfig interface {
+	Address() string
+}
@@ -0,0 +1,31 @@
+package converter
+
+import (
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	paymentv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/payment/v1"
+)
+
+// ConfirmPaymentRequestFromProto конвертирует protobuf callback в domain модель
+func ConfirmPaymentRequestFromProto(req *paymentv1.ConfirmPaymentRequest) *model.ConfirmPaymentRequest {
+	return &model.ConfirmPaymentRequest{
+		TransactionUUID: req.GetTransactionUuid(),
+		Status:          PaymentStatusFromProto(req.GetStatus()),
+		FailureReason:   req.GetFailureReason(),
+	}
+}
+
+// PaymentStatusResponseToProto конвертирует транзакцию в ответ на запрос статуса платежа
+func PaymentStatusResponseToProto(tx *model.Transaction) *paymentv1.GetPaymentStatusResponse {
+	return &paymentv1.GetPaymentStatusResponse{
+		TransactionUuid: tx.TransactionUUID,
+		Status:          PaymentStatusToProto(tx.Status),
+	}
+}
+
+// ConfirmPaymentResponseToProto конвертирует транзакцию в ответ на callback
+func ConfirmPaymentResponseToProto(tx *model.Transaction) *paymentv1.ConfirmPaymentResponse {
+	return &paymentv1.ConfirmPaymentResponse{
+		TransactionUuid: tx.TransactionUUID,
+		Status:          PaymentStatusToProto(tx.Status),
+	}
+}
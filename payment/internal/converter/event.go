@@ -0,0 +1,101 @@
+package converter
+
+import (
+	"strings"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	eventsv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/events/v1"
+)
+
+// PaymentSucceededToProto конвертирует domain событие в protobuf PaymentSucceeded
+func PaymentSucceededToProto(event *model.PaymentEvent) *eventsv1.PaymentSucceeded {
+	return &eventsv1.PaymentSucceeded{
+		EventUuid:       event.EventUUID,
+		TransactionUuid: event.TransactionUUID,
+		OrderUuid:       event.OrderUUID,
+		UserUuid:        event.UserUUID,
+		PaymentMethod:   PaymentMethodToString(event.PaymentMethod),
+	}
+}
+
+// PaymentFailedToProto конвертирует domain событие в protobuf PaymentFailed
+func PaymentFailedToProto(event *model.PaymentEvent) *eventsv1.PaymentFailed {
+	return &eventsv1.PaymentFailed{
+		EventUuid:       event.EventUUID,
+		TransactionUuid: event.TransactionUUID,
+		OrderUuid:       event.OrderUUID,
+		UserUuid:        event.UserUUID,
+		PaymentMethod:   PaymentMethodToString(event.PaymentMethod),
+		Reason:          event.Reason,
+	}
+}
+
+// PaymentMethodToString возвращает метод оплаты в формате событий (CARD, SBP, ...)
+func PaymentMethodToString(method model.PaymentMethod) string {
+	return strings.TrimPrefix(PaymentMethodToProto(method).String(), "PAYMENT_METHOD_")
+}
+
+// PaymentDiscrepancyToProto конвертирует расхождение сверки в protobuf PaymentDiscrepancy
+func PaymentDiscrepancyToProto(eventUUID string, d *model.Discrepancy) *eventsv1.PaymentDiscrepancy {
+	return &eventsv1.PaymentDiscrepancy{
+		EventUuid:       eventUUID,
+		DiscrepancyType: DiscrepancyTypeToString(d.Type),
+		OrderUuid:       d.OrderUUID,
+		TransactionUuid: d.TransactionUUID,
+		OrderAmount:     d.OrderAmount,
+		PaymentAmount:   d.PaymentAmount,
+		Details:         d.Details,
+		DetectedAt:      timestamppb.Now(),
+	}
+}
+
+// ReceiptIssuedToProto конвертирует выданный чек в protobuf ReceiptIssued
+func ReceiptIssuedToProto(eventUUID string, receipt *model.Receipt) *eventsv1.ReceiptIssued {
+	return &eventsv1.ReceiptIssued{
+		EventUuid:       eventUUID,
+		ReceiptUuid:     receipt.ReceiptUUID,
+		TransactionUuid: receipt.TransactionUUID,
+		OrderUuid:       receipt.OrderUUID,
+		UserUuid:        receipt.UserUUID,
+		PaymentMethod:   PaymentMethodToString(receipt.PaymentMethod),
+		Total:           receipt.Total,
+		VatAmount:       receipt.VatAmount,
+		Text:            receipt.Text,
+		IssuedAt:        timestamppb.New(receipt.IssuedAt),
+	}
+}
+
+// InstallmentMissedToProto конвертирует пропущенный платеж по рассрочке в protobuf InstallmentMissed
+func InstallmentMissedToProto(eventUUID string, due *model.DueInstallment) *eventsv1.InstallmentMissed {
+	return &eventsv1.InstallmentMissed{
+		EventUuid:       eventUUID,
+		PlanUuid:        due.Plan.PlanUUID,
+		InstallmentUuid: due.Installment.InstallmentUUID,
+		TransactionUuid: due.Plan.TransactionUUID,
+		OrderUuid:       due.Plan.OrderUUID,
+		UserUuid:        due.Plan.UserUUID,
+		Number:          due.Installment.Number,
+		Amount:          due.Installment.Amount,
+		DueDate:         timestamppb.New(due.Installment.DueDate),
+		Reason:          due.Installment.FailureReason,
+	}
+}
+
+// InstallmentPlanDefaultedToProto конвертирует платеж, попытки списания которого исчерпаны,
+// в protobuf InstallmentPlanDefaulted
+func InstallmentPlanDefaultedToProto(eventUUID string, due *model.DueInstallment) *eventsv1.InstallmentPlanDefaulted {
+	return &eventsv1.InstallmentPlanDefaulted{
+		EventUuid:       eventUUID,
+		PlanUuid:        due.Plan.PlanUUID,
+		InstallmentUuid: due.Installment.InstallmentUUID,
+		TransactionUuid: due.Plan.TransactionUUID,
+		OrderUuid:       due.Plan.OrderUUID,
+		UserUuid:        due.Plan.UserUUID,
+		Number:          due.Installment.Number,
+		Amount:          due.Installment.Amount,
+		Attempts:        due.Installment.Attempts,
+		Reason:          due.Installment.FailureReason,
+	}
+}
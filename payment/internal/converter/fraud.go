@@ -0,0 +1,42 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	paymentv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/payment/v1"
+)
+
+// FraudDecisionsToProto конвертирует решения антифрод-проверки в protobuf
+func FraudDecisionsToProto(decisions []*model.FraudDecision) []*paymentv1.FraudDecision {
+	result := make([]*paymentv1.FraudDecision, 0, len(decisions))
+	for _, decision := range decisions {
+		result = append(result, &paymentv1.FraudDecision{
+			DecisionUuid:    decision.DecisionUUID,
+			TransactionUuid: decision.TransactionUUID,
+			OrderUuid:       decision.OrderUUID,
+			UserUuid:        decision.UserUUID,
+			PaymentMethod:   PaymentMethodToProto(decision.PaymentMethod),
+			Amount:          decision.Amount,
+			Verdict:         FraudVerdictToProto(decision.Verdict),
+			Reasons:         decision.Reasons,
+			CreatedAt:       timestamppb.New(decision.CreatedAt),
+		})
+	}
+
+	return result
+}
+
+// FraudVerdictToProto конвертирует domain enum решения в protobuf enum
+func FraudVerdictToProto(verdict model.FraudVerdict) paymentv1.FraudVerdict {
+	switch verdict {
+	case model.FraudVerdictApprove:
+		return paymentv1.FraudVerdict_FRAUD_VERDICT_APPROVE
+	case model.FraudVerdictReview:
+		return paymentv1.FraudVerdict_FRAUD_VERDICT_REVIEW
+	case model.FraudVerdictReject:
+		return paymentv1.FraudVerdict_FRAUD_VERDICT_REJECT
+	default:
+		return paymentv1.FraudVerdict_FRAUD_VERDICT_UNSPECIFIED
+	}
+}
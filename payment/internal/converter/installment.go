@@ -0,0 +1,81 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	paymentv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/payment/v1"
+)
+
+// InstallmentPlansToProto конвертирует планы рассрочки в protobuf
+func InstallmentPlansToProto(plans []*model.InstallmentPlan) []*paymentv1.InstallmentPlan {
+	result := make([]*paymentv1.InstallmentPlan, 0, len(plans))
+	for _, plan := range plans {
+		result = append(result, InstallmentPlanToProto(plan))
+	}
+
+	return result
+}
+
+// InstallmentPlanToProto конвертирует план рассрочки с графиком платежей в protobuf
+func InstallmentPlanToProto(plan *model.InstallmentPlan) *paymentv1.InstallmentPlan {
+	installments := make([]*paymentv1.Installment, 0, len(plan.Installments))
+	for _, installment := range plan.Installments {
+		protoInstallment := &paymentv1.Installment{
+			InstallmentUuid: installment.InstallmentUUID,
+			Number:          installment.Number,
+			Amount:          installment.Amount,
+			DueDate:         timestamppb.New(installment.DueDate),
+			Status:          InstallmentStatusToProto(installment.Status),
+			Attempts:        installment.Attempts,
+			FailureReason:   installment.FailureReason,
+		}
+		if installment.PaidAt != nil {
+			protoInstallment.PaidAt = timestamppb.New(*installment.PaidAt)
+		}
+		installments = append(installments, protoInstallment)
+	}
+
+	return &paymentv1.InstallmentPlan{
+		PlanUuid:        plan.PlanUUID,
+		TransactionUuid: plan.TransactionUUID,
+		OrderUuid:       plan.OrderUUID,
+		UserUuid:        plan.UserUUID,
+		TotalAmount:     plan.TotalAmount,
+		Status:          InstallmentPlanStatusToProto(plan.Status),
+		Installments:    installments,
+		CreatedAt:       timestamppb.New(plan.CreatedAt),
+	}
+}
+
+// InstallmentPlanStatusToProto конвертирует domain enum статуса плана в protobuf enum
+func InstallmentPlanStatusToProto(status model.InstallmentPlanStatus) paymentv1.InstallmentPlanStatus {
+	switch status {
+	case model.InstallmentPlanStatusActive:
+		return paymentv1.InstallmentPlanStatus_INSTALLMENT_PLAN_STATUS_ACTIVE
+	case model.InstallmentPlanStatusCompleted:
+		return paymentv1.InstallmentPlanStatus_INSTALLMENT_PLAN_STATUS_COMPLETED
+	case model.InstallmentPlanStatusCancelled:
+		return paymentv1.InstallmentPlanStatus_INSTALLMENT_PLAN_STATUS_CANCELLED
+	case model.InstallmentPlanStatusDefaulted:
+		return paymentv1.InstallmentPlanStatus_INSTALLMENT_PLAN_STATUS_DEFAULTED
+	default:
+		return paymentv1.InstallmentPlanStatus_INSTALLMENT_PLAN_STATUS_UNSPECIFIED
+	}
+}
+
+// InstallmentStatusToProto конвертирует domain enum статуса платежа в protobuf enum
+func InstallmentStatusToProto(status model.InstallmentStatus) paymentv1.InstallmentStatus {
+	switch status {
+	case model.InstallmentStatusScheduled:
+		return paymentv1.InstallmentStatus_INSTALLMENT_STATUS_SCHEDULED
+	case model.InstallmentStatusPaid:
+		return paymentv1.InstallmentStatus_INSTALLMENT_STATUS_PAID
+	case model.InstallmentStatusMissed:
+		return paymentv1.InstallmentStatus_INSTALLMENT_STATUS_MISSED
+	case model.InstallmentStatusFailed:
+		return paymentv1.InstallmentStatus_INSTALLMENT_STATUS_FAILED
+	default:
+		return paymentv1.InstallmentStatus_INSTALLMENT_STATUS_UNSPECIFIED
+	}
+}
@@ -0,0 +1,34 @@
+package decoder
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	def "github.com/Daniil-Sakharov/RocketFactory/payment/internal/converter/kafka"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	eventsv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/events/v1"
+)
+
+var _ def.OrderPaidDecoder = (*orderPaidDecoder)(nil)
+
+type orderPaidDecoder struct{}
+
+func NewOrderPaidDecoder() *orderPaidDecoder {
+	return &orderPaidDecoder{}
+}
+
+func (d *orderPaidDecoder) Decode(data []byte) (*model.OrderPaidEvent, error) {
+	var pb eventsv1.OrderPaid
+	if err := proto.Unmarshal(data, &pb); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal protobuf: %w", err)
+	}
+
+	return &model.OrderPaidEvent{
+		EventUUID:       pb.GetEventUuid(),
+		OrderUUID:       pb.GetOrderUuid(),
+		UserUUID:        pb.GetUserUuid(),
+		TransactionUUID: pb.GetTransactionUuid(),
+		TotalPrice:      pb.GetTotalPrice(),
+	}, nil
+}
@@ -0,0 +1,7 @@
+package kafka
+
+import "github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+
+type OrderPaidDecoder interface {
+	Decode(data []byte) (*model.OrderPaidEvent, error)
+}
@@ -0,0 +1,66 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	paymentv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/payment/v1"
+)
+
+// TopUpRequestFromProto конвертирует protobuf запрос пополнения в domain модель
+func TopUpRequestFromProto(req *paymentv1.TopUpInvestorAccountRequest) *model.TopUpRequest {
+	return &model.TopUpRequest{
+		UserUUID:  req.GetUserUuid(),
+		Amount:    req.GetAmount(),
+		Reference: req.GetReference(),
+	}
+}
+
+// TopUpResultToProto конвертирует результат пополнения в protobuf
+func TopUpResultToProto(result *model.TopUpResult) *paymentv1.TopUpInvestorAccountResponse {
+	return &paymentv1.TopUpInvestorAccountResponse{
+		EntryUuid: result.EntryUUID,
+		Balance:   result.Balance,
+	}
+}
+
+// StatementRequestFromProto конвертирует protobuf запрос выписки в domain модель
+func StatementRequestFromProto(req *paymentv1.GetInvestorStatementRequest) *model.StatementRequest {
+	return &model.StatementRequest{
+		UserUUID: req.GetUserUuid(),
+		Limit:    int(req.GetLimit()),
+		Offset:   int(req.GetOffset()),
+	}
+}
+
+// StatementToProto конвертирует выписку в protobuf
+func StatementToProto(statement *model.Statement) *paymentv1.GetInvestorStatementResponse {
+	lines := make([]*paymentv1.StatementLine, 0, len(statement.Lines))
+	for _, line := range statement.Lines {
+		lines = append(lines, &paymentv1.StatementLine{
+			EntryUuid:    line.EntryUUID,
+			EntryType:    LedgerEntryTypeToProto(line.EntryType),
+			Amount:       line.Amount,
+			BalanceAfter: line.BalanceAfter,
+			Reference:    line.Reference,
+			CreatedAt:    timestamppb.New(line.CreatedAt),
+		})
+	}
+
+	return &paymentv1.GetInvestorStatementResponse{
+		Balance: statement.Balance,
+		Lines:   lines,
+	}
+}
+
+// LedgerEntryTypeToProto конвертирует domain enum типа проводки в protobuf enum
+func LedgerEntryTypeToProto(entryType model.LedgerEntryType) paymentv1.LedgerEntryType {
+	switch entryType {
+	case model.LedgerEntryTypeTopUp:
+		return paymentv1.LedgerEntryType_LEDGER_ENTRY_TYPE_TOP_UP
+	case model.LedgerEntryTypePayment:
+		return paymentv1.LedgerEntryType_LEDGER_ENTRY_TYPE_PAYMENT
+	default:
+		return paymentv1.LedgerEntryType_LEDGER_ENTRY_TYPE_UNSPECIFIED
+	}
+}
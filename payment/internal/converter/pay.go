@@ -8,16 +8,41 @@ import (
 // PaymentRequestFromProto конвертирует protobuf запрос в domain модель
 func PaymentRequestFromProto(req *paymentv1.PayOrderRequest) *model.PayOrderRequest {
 	return &model.PayOrderRequest{
-		OrderUUID:     req.GetOrderUuid(),
-		UserUUID:      req.GetUserUuid(),
-		PaymentMethod: PaymentMethodFromProto(req.GetPaymentMethod()),
+		OrderUUID:       req.GetOrderUuid(),
+		UserUUID:        req.GetUserUuid(),
+		PaymentMethod:   PaymentMethodFromProto(req.GetPaymentMethod()),
+		Amount:          req.GetAmount(),
+		OrderOwnerUUID:  req.GetOrderOwnerUuid(),
+		Items:           PaymentItemsFromProto(req.GetItems()),
+		Installments:    req.GetInstallments(),
+		TransactionUUID: req.GetTransactionUuid(),
 	}
 }
 
+// PaymentItemsFromProto конвертирует позиции заказа из protobuf в domain модель
+func PaymentItemsFromProto(items []*paymentv1.PaymentItem) []model.PaymentItem {
+	if len(items) == 0 {
+		return nil
+	}
+
+	result := make([]model.PaymentItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, model.PaymentItem{
+			PartUUID:  item.GetPartUuid(),
+			Name:      item.GetName(),
+			Quantity:  item.GetQuantity(),
+			UnitPrice: item.GetUnitPrice(),
+		})
+	}
+	return result
+}
+
 // PaymentResponseToProto конвертирует domain ответ в protobuf
 func PaymentResponseToProto(resp *model.PayOrderResponse) *paymentv1.PayOrderResponse {
 	return &paymentv1.PayOrderResponse{
-		TransactionUuid: resp.TransactionUUID,
+		TransactionUuid:     resp.TransactionUUID,
+		Status:              PaymentStatusToProto(resp.Status),
+		InstallmentPlanUuid: resp.InstallmentPlanUUID,
 	}
 }
 
@@ -52,3 +77,31 @@ func PaymentMethodToProto(method model.PaymentMethod) paymentv1.PaymentMethod {
 		return paymentv1.PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
 	}
 }
+
+// PaymentStatusFromProto конвертирует protobuf enum статуса в domain enum
+func PaymentStatusFromProto(protoStatus paymentv1.PaymentStatus) model.PaymentStatus {
+	switch protoStatus {
+	case paymentv1.PaymentStatus_PAYMENT_STATUS_PENDING:
+		return model.PaymentStatusPending
+	case paymentv1.PaymentStatus_PAYMENT_STATUS_SUCCEEDED:
+		return model.PaymentStatusSucceeded
+	case paymentv1.PaymentStatus_PAYMENT_STATUS_FAILED:
+		return model.PaymentStatusFailed
+	default:
+		return model.PaymentStatusUnspecified
+	}
+}
+
+// PaymentStatusToProto конвертирует domain enum статуса в protobuf enum
+func PaymentStatusToProto(status model.PaymentStatus) paymentv1.PaymentStatus {
+	switch status {
+	case model.PaymentStatusPending:
+		return paymentv1.PaymentStatus_PAYMENT_STATUS_PENDING
+	case model.PaymentStatusSucceeded:
+		return paymentv1.PaymentStatus_PAYMENT_STATUS_SUCCEEDED
+	case model.PaymentStatusFailed:
+		return paymentv1.PaymentStatus_PAYMENT_STATUS_FAILED
+	default:
+		return paymentv1.PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+	}
+}
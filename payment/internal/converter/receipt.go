@@ -0,0 +1,38 @@
+package converter
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	paymentv1 "github.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/payment/v1"
+)
+
+// ReceiptToProto конвертирует чек в protobuf
+func ReceiptToProto(receipt *model.Receipt) *paymentv1.Receipt {
+	items := make([]*paymentv1.ReceiptItem, 0, len(receipt.Items))
+	for _, item := range receipt.Items {
+		items = append(items, &paymentv1.ReceiptItem{
+			PartUuid:  item.PartUUID,
+			Name:      item.Name,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice,
+			Total:     item.Total,
+			VatAmount: item.VatAmount,
+		})
+	}
+
+	return &paymentv1.Receipt{
+		ReceiptUuid:     receipt.ReceiptUUID,
+		TransactionUuid: receipt.TransactionUUID,
+		OrderUuid:       receipt.OrderUUID,
+		UserUuid:        receipt.UserUUID,
+		PaymentMethod:   PaymentMethodToProto(receipt.PaymentMethod),
+		Items:           items,
+		VatRate:         receipt.VatRate,
+		VatAmount:       receipt.VatAmount,
+		Total:           receipt.Total,
+		Text:            receipt.Text,
+		Html:            receipt.HTML,
+		IssuedAt:        timestamppb.New(receipt.IssuedAt),
+	}
+}
@@ -0,0 +1,98 @@
+package converter
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+type reportJSON struct {
+	GeneratedAt         time.Time         `json:"generated_at"`
+	Since               time.Time         `json:"since"`
+	Until               time.Time         `json:"until"`
+	CheckedOrders       int               `json:"checked_orders"`
+	CheckedTransactions int               `json:"checked_transactions"`
+	Discrepancies       []discrepancyJSON `json:"discrepancies"`
+}
+
+type discrepancyJSON struct {
+	Type            string  `json:"type"`
+	OrderUUID       string  `json:"order_uuid"`
+	TransactionUUID string  `json:"transaction_uuid"`
+	OrderAmount     float64 `json:"order_amount"`
+	PaymentAmount   float64 `json:"payment_amount"`
+	Details         string  `json:"details"`
+}
+
+var reportCSVHeader = []string{"type", "order_uuid", "transaction_uuid", "order_amount", "payment_amount", "details"}
+
+// WriteReportJSON записывает отчет сверки в формате JSON
+func WriteReportJSON(w io.Writer, report *model.ReconciliationReport) error {
+	out := reportJSON{
+		GeneratedAt:         report.GeneratedAt,
+		Since:               report.Since,
+		Until:               report.Until,
+		CheckedOrders:       report.CheckedOrders,
+		CheckedTransactions: report.CheckedTransactions,
+		Discrepancies:       make([]discrepancyJSON, 0, len(report.Discrepancies)),
+	}
+	for _, d := range report.Discrepancies {
+		out.Discrepancies = append(out.Discrepancies, discrepancyJSON{
+			Type:            DiscrepancyTypeToString(d.Type),
+			OrderUUID:       d.OrderUUID,
+			TransactionUUID: d.TransactionUUID,
+			OrderAmount:     d.OrderAmount,
+			PaymentAmount:   d.PaymentAmount,
+			Details:         d.Details,
+		})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(out)
+}
+
+// WriteReportCSV записывает расхождения отчета сверки в формате CSV (одна строка на расхождение)
+func WriteReportCSV(w io.Writer, report *model.ReconciliationReport) error {
+	writer := csv.NewWriter(w)
+
+	if err := writer.Write(reportCSVHeader); err != nil {
+		return err
+	}
+	for _, d := range report.Discrepancies {
+		err := writer.Write([]string{
+			DiscrepancyTypeToString(d.Type),
+			d.OrderUUID,
+			d.TransactionUUID,
+			strconv.FormatFloat(d.OrderAmount, 'f', 2, 64),
+			strconv.FormatFloat(d.PaymentAmount, 'f', 2, 64),
+			d.Details,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// DiscrepancyTypeToString возвращает тип расхождения в формате отчета и событий
+func DiscrepancyTypeToString(t model.DiscrepancyType) string {
+	switch t {
+	case model.DiscrepancyTypeOrphanPayment:
+		return "ORPHAN_PAYMENT"
+	case model.DiscrepancyTypePaidOrderWithoutTransaction:
+		return "PAID_ORDER_WITHOUT_TRANSACTION"
+	case model.DiscrepancyTypeAmountMismatch:
+		return "AMOUNT_MISMATCH"
+	case model.DiscrepancyTypeMissingOrderSnapshot:
+		return "MISSING_ORDER_SNAPSHOT"
+	default:
+		return "UNSPECIFIED"
+	}
+}
@@ -11,4 +11,52 @@ var (
 
 	// ErrInvalidPaymentMethod - ошибка когда метод оплаты не указан
 	ErrInvalidPaymentMethod = errors.New("invalid payment method")
+
+	// ErrEmptyTransactionUUID - ошибка когда UUID транзакции пустой
+	ErrEmptyTransactionUUID = errors.New("transaction UUID is empty")
+
+	// ErrInvalidPaymentStatus - ошибка когда в callback передан недопустимый статус
+	ErrInvalidPaymentStatus = errors.New("invalid payment status")
+
+	// ErrInvalidTransactionUUID - ошибка когда переданный UUID транзакции имеет неверный формат
+	ErrInvalidTransactionUUID = errors.New("invalid transaction UUID")
+
+	// ErrTransactionAlreadyExists - ошибка когда транзакция с переданным UUID уже создана
+	ErrTransactionAlreadyExists = errors.New("transaction already exists")
+
+	// ErrTransactionNotFound - ошибка когда транзакция не найдена
+	ErrTransactionNotFound = errors.New("transaction not found")
+
+	// ErrPaymentAlreadyProcessed - ошибка когда платеж уже подтвержден или отклонен
+	ErrPaymentAlreadyProcessed = errors.New("payment already processed")
+
+	// ErrPaymentNotConfirmable - ошибка когда callback шлюза пришел по платежу, который проводит сам payment сервис
+	ErrPaymentNotConfirmable = errors.New("payment is not confirmed by gateway")
+
+	// ErrInvalidAmount - ошибка когда сумма платежа или пополнения не положительная
+	ErrInvalidAmount = errors.New("amount must be positive")
+
+	// ErrInsufficientFunds - ошибка когда на счете инвестора недостаточно средств
+	ErrInsufficientFunds = errors.New("insufficient investor funds")
+
+	// ErrPaymentRejected - ошибка когда платеж отклонен антифрод-проверкой
+	ErrPaymentRejected = errors.New("payment rejected by fraud screening")
+
+	// ErrInvalidPaymentItem - ошибка когда у позиции заказа неположительное количество или отрицательная цена
+	ErrInvalidPaymentItem = errors.New("invalid payment item")
+
+	// ErrReceiptNotFound - ошибка когда чек по транзакции не найден
+	ErrReceiptNotFound = errors.New("receipt not found")
+
+	// ErrReceiptAlreadyExists - ошибка когда чек по транзакции уже выдан
+	ErrReceiptAlreadyExists = errors.New("receipt already exists")
+
+	// ErrInstallmentsNotAllowed - ошибка когда рассрочка запрошена не для кредитной карты
+	ErrInstallmentsNotAllowed = errors.New("installments are available only for credit card payments")
+
+	// ErrInvalidInstallments - ошибка когда количество платежей или сумма не подходят для рассрочки
+	ErrInvalidInstallments = errors.New("invalid installments count or amount")
+
+	// ErrInstallmentPlanNotFound - ошибка когда план рассрочки не найден
+	ErrInstallmentPlanNotFound = errors.New("installment plan not found")
 )
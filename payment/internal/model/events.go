@@ -0,0 +1,20 @@
+package model
+
+// PaymentEvent - событие об изменении статуса платежа для публикации в Kafka
+type PaymentEvent struct {
+	EventUUID       string        // UUID события
+	TransactionUUID string        // UUID транзакции
+	OrderUUID       string        // UUID заказа
+	UserUUID        string        // UUID пользователя
+	PaymentMethod   PaymentMethod // Метод оплаты
+	Reason          string        // Причина отказа (для PaymentFailed)
+}
+
+// OrderPaidEvent - событие order сервиса об оплате заказа
+type OrderPaidEvent struct {
+	EventUUID       string  // UUID события
+	OrderUUID       string  // UUID заказа
+	UserUUID        string  // UUID пользователя
+	TransactionUUID string  // UUID транзакции оплаты
+	TotalPrice      float64 // Стоимость заказа (0 в событиях до появления total_price)
+}
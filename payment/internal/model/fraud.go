@@ -0,0 +1,44 @@
+package model
+
+import "time"
+
+// FraudVerdict - решение антифрод-проверки. Значения упорядочены по строгости
+type FraudVerdict int32
+
+const (
+	FraudVerdictUnspecified FraudVerdict = 0 // Неизвестное решение
+	FraudVerdictApprove     FraudVerdict = 1 // Платеж разрешен
+	FraudVerdictReview      FraudVerdict = 2 // Платеж проводится, но отмечен для ручного разбора
+	FraudVerdictReject      FraudVerdict = 3 // Платеж отклонен
+)
+
+// FraudCheck - данные платежа для антифрод-проверки
+type FraudCheck struct {
+	TransactionUUID string        // UUID транзакции
+	OrderUUID       string        // UUID заказа
+	UserUUID        string        // UUID пользователя, который производит оплату
+	OrderOwnerUUID  string        // UUID владельца заказа (может быть пустым)
+	PaymentMethod   PaymentMethod // Метод оплаты
+	Amount          float64       // Сумма платежа
+	Installments    int32         // Количество платежей рассрочки (0 или 1 - без рассрочки)
+}
+
+// FraudRuleResult - срабатывание одного антифрод-правила
+type FraudRuleResult struct {
+	Rule    string       // Название правила
+	Verdict FraudVerdict // Решение правила
+	Reason  string       // Причина срабатывания
+}
+
+// FraudDecision - итоговое решение антифрод-проверки по платежу
+type FraudDecision struct {
+	DecisionUUID    string        // UUID решения
+	TransactionUUID string        // UUID транзакции
+	OrderUUID       string        // UUID заказа
+	UserUUID        string        // UUID пользователя
+	PaymentMethod   PaymentMethod // Метод оплаты
+	Amount          float64       // Сумма платежа
+	Verdict         FraudVerdict  // Итоговое решение
+	Reasons         []string      // Причины (сработавшие правила)
+	CreatedAt       time.Time     // Дата проверки
+}
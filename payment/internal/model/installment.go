@@ -0,0 +1,72 @@
+package model
+
+import "time"
+
+// InstallmentPlanStatus - статус плана рассрочки
+type InstallmentPlanStatus int32
+
+const (
+	InstallmentPlanStatusUnspecified InstallmentPlanStatus = 0 // Неизвестный статус
+	InstallmentPlanStatusActive      InstallmentPlanStatus = 1 // План действует, остались неоплаченные платежи
+	InstallmentPlanStatusCompleted   InstallmentPlanStatus = 2 // Все платежи списаны
+	InstallmentPlanStatusCancelled   InstallmentPlanStatus = 3 // Первый платеж не прошел, план не вступил в силу
+	InstallmentPlanStatusDefaulted   InstallmentPlanStatus = 4 // Попытки списания платежа исчерпаны, план прекращен
+)
+
+// InstallmentStatus - статус платежа по графику рассрочки
+type InstallmentStatus int32
+
+const (
+	InstallmentStatusUnspecified InstallmentStatus = 0 // Неизвестный статус
+	InstallmentStatusScheduled   InstallmentStatus = 1 // Ожидает даты списания
+	InstallmentStatusPaid        InstallmentStatus = 2 // Списан
+	InstallmentStatusMissed      InstallmentStatus = 3 // Списание не прошло, будет повторено
+	InstallmentStatusFailed      InstallmentStatus = 4 // Попытки списания исчерпаны
+)
+
+// InstallmentPlan - план рассрочки с графиком платежей
+type InstallmentPlan struct {
+	PlanUUID        string                // UUID плана
+	TransactionUUID string                // UUID транзакции, оформленной в рассрочку
+	OrderUUID       string                // UUID заказа
+	UserUUID        string                // UUID пользователя
+	TotalAmount     float64               // Полная сумма
+	Status          InstallmentPlanStatus // Статус плана
+	Installments    []*Installment        // График платежей
+	CreatedAt       time.Time             // Дата создания
+}
+
+// Installment - платеж по графику рассрочки
+type Installment struct {
+	InstallmentUUID string            // UUID платежа
+	PlanUUID        string            // UUID плана
+	Number          int32             // Порядковый номер (с 1)
+	Amount          float64           // Сумма платежа
+	DueDate         time.Time         // Дата, когда платеж должен быть списан
+	Status          InstallmentStatus // Статус платежа
+	Attempts        int32             // Количество попыток списания
+	FailureReason   string            // Причина последнего отказа
+	PaidAt          *time.Time        // Дата успешного списания
+}
+
+// DueInstallment - платеж, срок которого наступил, вместе с планом
+type DueInstallment struct {
+	Installment *Installment
+	Plan        *InstallmentPlan // План без графика платежей
+}
+
+// InstallmentCharge - запрос на списание платежа по рассрочке через шлюз
+type InstallmentCharge struct {
+	InstallmentUUID string  // UUID платежа
+	TransactionUUID string  // UUID исходной транзакции (привязка к карте)
+	UserUUID        string  // UUID пользователя
+	Number          int32   // Порядковый номер платежа
+	Amount          float64 // Сумма списания
+}
+
+// InstallmentChargeResult - итог прогона списаний по рассрочке
+type InstallmentChargeResult struct {
+	Charged int // Успешно списано
+	Missed  int // Не удалось списать, будет повторено
+	Failed  int // Не удалось списать, попытки исчерпаны
+}
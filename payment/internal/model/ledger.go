@@ -0,0 +1,48 @@
+package model
+
+import "time"
+
+// LedgerEntryType - тип проводки в журнале
+type LedgerEntryType int32
+
+const (
+	LedgerEntryTypeUnspecified LedgerEntryType = 0 // Неизвестный тип
+	LedgerEntryTypeTopUp       LedgerEntryType = 1 // Пополнение счета инвестора
+	LedgerEntryTypePayment     LedgerEntryType = 2 // Оплата заказа деньгами инвестора
+)
+
+// TopUpRequest - запрос на пополнение счета инвестора
+type TopUpRequest struct {
+	UserUUID  string  // UUID пользователя-инвестора
+	Amount    float64 // Сумма пополнения
+	Reference string  // Внешний идентификатор пополнения (ключ идемпотентности)
+}
+
+// TopUpResult - результат пополнения счета инвестора
+type TopUpResult struct {
+	EntryUUID string  // UUID проводки
+	Balance   float64 // Баланс после пополнения
+}
+
+// StatementRequest - запрос выписки по счету инвестора
+type StatementRequest struct {
+	UserUUID string // UUID пользователя-инвестора
+	Limit    int    // Максимальное количество строк
+	Offset   int    // Смещение от последней операции
+}
+
+// StatementLine - движение по счету инвестора в рамках одной проводки
+type StatementLine struct {
+	EntryUUID    string          // UUID проводки
+	EntryType    LedgerEntryType // Тип проводки
+	Amount       float64         // Сумма: положительная - зачисление, отрицательная - списание
+	BalanceAfter float64         // Баланс после операции
+	Reference    string          // UUID транзакции или reference пополнения
+	CreatedAt    time.Time       // Дата операции
+}
+
+// Statement - выписка по счету инвестора
+type Statement struct {
+	Balance float64          // Текущий баланс
+	Lines   []*StatementLine // Операции от новых к старым
+}
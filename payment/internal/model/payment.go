@@ -1,5 +1,7 @@
 package model
 
+import "time"
+
 // PaymentMethod - способ оплаты
 type PaymentMethod int32
 
@@ -11,14 +13,62 @@ const (
 	PaymentMethodInvestorMoney PaymentMethod = 4 // Деньги инвестора (внутренний метод)
 )
 
+// PaymentStatus - статус платежа
+type PaymentStatus int32
+
+const (
+	PaymentStatusUnspecified PaymentStatus = 0 // Неизвестный статус
+	PaymentStatusPending     PaymentStatus = 1 // Ожидает подтверждения от платежного шлюза
+	PaymentStatusSucceeded   PaymentStatus = 2 // Платеж проведен
+	PaymentStatusFailed      PaymentStatus = 3 // Платеж отклонен
+)
+
 // PayOrderRequest - запрос на оплату заказа
 type PayOrderRequest struct {
-	OrderUUID     string        // UUID заказа
-	UserUUID      string        // UUID пользователя, который производит оплату
-	PaymentMethod PaymentMethod // Метод оплаты
+	OrderUUID       string        // UUID заказа
+	UserUUID        string        // UUID пользователя, который производит оплату
+	PaymentMethod   PaymentMethod // Метод оплаты
+	Amount          float64       // Сумма платежа (обязательна для INVESTOR_MONEY)
+	OrderOwnerUUID  string        // UUID владельца заказа (для антифрод-проверки)
+	Items           []PaymentItem // Позиции заказа для чека
+	Installments    int32         // Количество платежей рассрочки (0 или 1 - без рассрочки)
+	TransactionUUID string        // UUID транзакции, закрепленный за заказом (если пустой - генерируется)
 }
 
 // PayOrderResponse - ответ на оплату заказа
 type PayOrderResponse struct {
-	TransactionUUID string // UUID транзакции
+	TransactionUUID     string        // UUID транзакции
+	Status              PaymentStatus // Статус платежа
+	InstallmentPlanUUID string        // UUID плана рассрочки
+}
+
+// ConfirmPaymentRequest - callback платежного шлюза с результатом платежа
+type ConfirmPaymentRequest struct {
+	TransactionUUID string        // UUID транзакции
+	Status          PaymentStatus // Итоговый статус (SUCCEEDED или FAILED)
+	FailureReason   string        // Причина отказа
+}
+
+// Transaction - платежная транзакция
+type Transaction struct {
+	TransactionUUID string        // UUID транзакции
+	OrderUUID       string        // UUID заказа
+	UserUUID        string        // UUID пользователя
+	PaymentMethod   PaymentMethod // Метод оплаты
+	Amount          float64       // Сумма платежа
+	AmountUnknown   bool          // Сумма не сохранена (транзакции до появления колонки amount)
+	Items           []PaymentItem // Позиции заказа для чека
+	Status          PaymentStatus // Статус платежа
+	FailureReason   string        // Причина отказа (для FAILED)
+	Installments    int32         // Количество платежей рассрочки (0 - без рассрочки)
+	CreatedAt       time.Time     // Дата создания
+	UpdatedAt       time.Time     // Дата последнего обновления
+	// ResultPublishedAt - когда опубликовано событие об итоговом статусе, nil - еще не опубликовано
+	ResultPublishedAt *time.Time
+}
+
+// ChargeResult - результат списания через платежный шлюз
+type ChargeResult struct {
+	Status        PaymentStatus // Статус платежа после обращения к шлюзу
+	FailureReason string        // Причина отказа
 }
@@ -0,0 +1,37 @@
+package model
+
+import "time"
+
+// PaymentItem - позиция заказа, за которую производится оплата
+type PaymentItem struct {
+	PartUUID  string  // UUID детали
+	Name      string  // Название детали
+	Quantity  int32   // Количество
+	UnitPrice float64 // Цена за единицу (с НДС)
+}
+
+// ReceiptItem - позиция чека
+type ReceiptItem struct {
+	PartUUID  string  // UUID детали
+	Name      string  // Наименование
+	Quantity  int32   // Количество
+	UnitPrice float64 // Цена за единицу (с НДС)
+	Total     float64 // Сумма позиции (с НДС)
+	VatAmount float64 // НДС в сумме позиции
+}
+
+// Receipt - чек успешного платежа
+type Receipt struct {
+	ReceiptUUID     string        // UUID чека
+	TransactionUUID string        // UUID транзакции
+	OrderUUID       string        // UUID заказа
+	UserUUID        string        // UUID пользователя
+	PaymentMethod   PaymentMethod // Метод оплаты
+	Items           []ReceiptItem // Позиции чека
+	VatRate         float64       // Ставка НДС, %
+	VatAmount       float64       // Сумма НДС
+	Total           float64       // Итоговая сумма (с НДС)
+	Text            string        // Текстовое представление чека
+	HTML            string        // HTML представление чека
+	IssuedAt        time.Time     // Дата выдачи чека
+}
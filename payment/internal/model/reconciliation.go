@@ -0,0 +1,61 @@
+package model
+
+import "time"
+
+// DiscrepancyType - тип расхождения между заказами и платежами
+type DiscrepancyType int32
+
+const (
+	DiscrepancyTypeUnspecified                 DiscrepancyType = 0 // Неизвестный тип
+	DiscrepancyTypeOrphanPayment               DiscrepancyType = 1 // Успешный платеж без соответствующего оплаченного заказа
+	DiscrepancyTypePaidOrderWithoutTransaction DiscrepancyType = 2 // Оплаченный заказ без успешной транзакции
+	DiscrepancyTypeAmountMismatch              DiscrepancyType = 3 // Сумма транзакции отличается от стоимости заказа
+	DiscrepancyTypeMissingOrderSnapshot        DiscrepancyType = 4 // Снимка заказа нет: OrderPaid не получен, платеж сверить не с чем
+)
+
+// OrderSnapshot - состояние заказа в order сервисе, необходимое для сверки.
+// Собирается из событий OrderPaid, поэтому содержит только оплаченные заказы
+type OrderSnapshot struct {
+	OrderUUID       string    // UUID заказа
+	UserUUID        string    // UUID пользователя
+	TotalPrice      float64   // Стоимость заказа
+	PriceUnknown    bool      // Стоимость не пришла в событии (события до появления total_price)
+	TransactionUUID string    // UUID транзакции оплаты (может быть пустым)
+	Status          string    // Статус заказа
+	UpdatedAt       time.Time // Дата последнего обновления
+}
+
+// OrderSnapshotStatusPaid - статус снимка, сохраненного по событию OrderPaid
+const OrderSnapshotStatusPaid = "PAID"
+
+// IsPaid возвращает true для заказов, которые должны иметь успешную транзакцию
+func (o *OrderSnapshot) IsPaid() bool {
+	return o.Status == "PAID" || o.Status == "ASSEMBLED"
+}
+
+// ReconciliationRequest - параметры сверки
+type ReconciliationRequest struct {
+	Since   time.Time // Начало периода сверки
+	Until   time.Time // Конец периода сверки
+	Publish bool      // Публиковать расхождения в Kafka
+}
+
+// Discrepancy - расхождение, найденное при сверке
+type Discrepancy struct {
+	Type            DiscrepancyType // Тип расхождения
+	OrderUUID       string          // UUID заказа
+	TransactionUUID string          // UUID транзакции
+	OrderAmount     float64         // Стоимость заказа
+	PaymentAmount   float64         // Сумма транзакции
+	Details         string          // Описание расхождения
+}
+
+// ReconciliationReport - результат сверки
+type ReconciliationReport struct {
+	GeneratedAt         time.Time      // Дата формирования отчета
+	Since               time.Time      // Начало периода сверки
+	Until               time.Time      // Конец периода сверки
+	CheckedOrders       int            // Количество проверенных заказов
+	CheckedTransactions int            // Количество проверенных транзакций
+	Discrepancies       []*Discrepancy // Найденные расхождения
+}
@@ -0,0 +1,67 @@
+package converter
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/model"
+)
+
+func FraudDecisionToRepoModel(decision *model.FraudDecision) *repoModel.FraudDecision {
+	reasons := decision.Reasons
+	if reasons == nil {
+		reasons = []string{}
+	}
+
+	return &repoModel.FraudDecision{
+		DecisionUUID:    decision.DecisionUUID,
+		TransactionUUID: decision.TransactionUUID,
+		OrderUUID:       decision.OrderUUID,
+		UserUUID:        decision.UserUUID,
+		PaymentMethod:   PaymentMethodToRepo(decision.PaymentMethod),
+		Amount:          decimal.NewFromFloat(decision.Amount).Round(2),
+		Verdict:         FraudVerdictToRepo(decision.Verdict),
+		Reasons:         reasons,
+		CreatedAt:       decision.CreatedAt,
+	}
+}
+
+func RepoFraudDecisionToModel(decision *repoModel.FraudDecision) *model.FraudDecision {
+	return &model.FraudDecision{
+		DecisionUUID:    decision.DecisionUUID,
+		TransactionUUID: decision.TransactionUUID,
+		OrderUUID:       decision.OrderUUID,
+		UserUUID:        decision.UserUUID,
+		PaymentMethod:   PaymentMethodToModel(decision.PaymentMethod),
+		Amount:          decision.Amount.InexactFloat64(),
+		Verdict:         FraudVerdictToModel(decision.Verdict),
+		Reasons:         decision.Reasons,
+		CreatedAt:       decision.CreatedAt,
+	}
+}
+
+func FraudVerdictToRepo(verdict model.FraudVerdict) string {
+	switch verdict {
+	case model.FraudVerdictApprove:
+		return "APPROVE"
+	case model.FraudVerdictReview:
+		return "REVIEW"
+	case model.FraudVerdictReject:
+		return "REJECT"
+	default:
+		return ""
+	}
+}
+
+func FraudVerdictToModel(s string) model.FraudVerdict {
+	switch s {
+	case "APPROVE":
+		return model.FraudVerdictApprove
+	case "REVIEW":
+		return model.FraudVerdictReview
+	case "REJECT":
+		return model.FraudVerdictReject
+	default:
+		return model.FraudVerdictUnspecified
+	}
+}
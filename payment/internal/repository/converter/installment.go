@@ -0,0 +1,159 @@
+package converter
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/model"
+)
+
+func InstallmentPlanToRepoModel(plan *model.InstallmentPlan) *repoModel.InstallmentPlan {
+	return &repoModel.InstallmentPlan{
+		PlanUUID:        plan.PlanUUID,
+		TransactionUUID: plan.TransactionUUID,
+		OrderUUID:       plan.OrderUUID,
+		UserUUID:        plan.UserUUID,
+		TotalAmount:     decimal.NewFromFloat(plan.TotalAmount).Round(2),
+		Status:          InstallmentPlanStatusToRepo(plan.Status),
+		CreatedAt:       plan.CreatedAt,
+	}
+}
+
+func RepoInstallmentPlanToModel(plan *repoModel.InstallmentPlan, installments []*repoModel.Installment) *model.InstallmentPlan {
+	modelInstallments := make([]*model.Installment, 0, len(installments))
+	for _, installment := range installments {
+		modelInstallments = append(modelInstallments, RepoInstallmentToModel(installment))
+	}
+
+	return &model.InstallmentPlan{
+		PlanUUID:        plan.PlanUUID,
+		TransactionUUID: plan.TransactionUUID,
+		OrderUUID:       plan.OrderUUID,
+		UserUUID:        plan.UserUUID,
+		TotalAmount:     plan.TotalAmount.InexactFloat64(),
+		Status:          InstallmentPlanStatusToModel(plan.Status),
+		Installments:    modelInstallments,
+		CreatedAt:       plan.CreatedAt,
+	}
+}
+
+func InstallmentToRepoModel(installment *model.Installment) *repoModel.Installment {
+	var reason sql.NullString
+	if installment.FailureReason != "" {
+		reason = sql.NullString{String: installment.FailureReason, Valid: true}
+	}
+
+	var paidAt sql.NullTime
+	if installment.PaidAt != nil {
+		paidAt = sql.NullTime{Time: *installment.PaidAt, Valid: true}
+	}
+
+	return &repoModel.Installment{
+		InstallmentUUID: installment.InstallmentUUID,
+		PlanUUID:        installment.PlanUUID,
+		Number:          installment.Number,
+		Amount:          decimal.NewFromFloat(installment.Amount).Round(2),
+		DueDate:         installment.DueDate,
+		Status:          InstallmentStatusToRepo(installment.Status),
+		Attempts:        installment.Attempts,
+		FailureReason:   reason,
+		PaidAt:          paidAt,
+	}
+}
+
+func RepoInstallmentToModel(installment *repoModel.Installment) *model.Installment {
+	var paidAt *time.Time
+	if installment.PaidAt.Valid {
+		paidAt = &installment.PaidAt.Time
+	}
+
+	return &model.Installment{
+		InstallmentUUID: installment.InstallmentUUID,
+		PlanUUID:        installment.PlanUUID,
+		Number:          installment.Number,
+		Amount:          installment.Amount.InexactFloat64(),
+		DueDate:         installment.DueDate,
+		Status:          InstallmentStatusToModel(installment.Status),
+		Attempts:        installment.Attempts,
+		FailureReason:   installment.FailureReason.String,
+		PaidAt:          paidAt,
+	}
+}
+
+func RepoDueInstallmentToModel(due *repoModel.DueInstallment) *model.DueInstallment {
+	return &model.DueInstallment{
+		Installment: RepoInstallmentToModel(&due.Installment),
+		Plan: &model.InstallmentPlan{
+			PlanUUID:        due.PlanUUID,
+			TransactionUUID: due.TransactionUUID,
+			OrderUUID:       due.OrderUUID,
+			UserUUID:        due.UserUUID,
+			TotalAmount:     due.TotalAmount.InexactFloat64(),
+			Status:          InstallmentPlanStatusToModel(due.PlanStatus),
+			CreatedAt:       due.PlanCreatedAt,
+		},
+	}
+}
+
+func InstallmentPlanStatusToRepo(status model.InstallmentPlanStatus) string {
+	switch status {
+	case model.InstallmentPlanStatusActive:
+		return "ACTIVE"
+	case model.InstallmentPlanStatusCompleted:
+		return "COMPLETED"
+	case model.InstallmentPlanStatusCancelled:
+		return "CANCELLED"
+	case model.InstallmentPlanStatusDefaulted:
+		return "DEFAULTED"
+	default:
+		return ""
+	}
+}
+
+func InstallmentPlanStatusToModel(s string) model.InstallmentPlanStatus {
+	switch s {
+	case "ACTIVE":
+		return model.InstallmentPlanStatusActive
+	case "COMPLETED":
+		return model.InstallmentPlanStatusCompleted
+	case "CANCELLED":
+		return model.InstallmentPlanStatusCancelled
+	case "DEFAULTED":
+		return model.InstallmentPlanStatusDefaulted
+	default:
+		return model.InstallmentPlanStatusUnspecified
+	}
+}
+
+func InstallmentStatusToRepo(status model.InstallmentStatus) string {
+	switch status {
+	case model.InstallmentStatusScheduled:
+		return "SCHEDULED"
+	case model.InstallmentStatusPaid:
+		return "PAID"
+	case model.InstallmentStatusMissed:
+		return "MISSED"
+	case model.InstallmentStatusFailed:
+		return "FAILED"
+	default:
+		return ""
+	}
+}
+
+func InstallmentStatusToModel(s string) model.InstallmentStatus {
+	switch s {
+	case "SCHEDULED":
+		return model.InstallmentStatusScheduled
+	case "PAID":
+		return model.InstallmentStatusPaid
+	case "MISSED":
+		return model.InstallmentStatusMissed
+	case "FAILED":
+		return model.InstallmentStatusFailed
+	default:
+		return model.InstallmentStatusUnspecified
+	}
+}
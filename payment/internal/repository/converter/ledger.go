@@ -0,0 +1,39 @@
+package converter
+
+import (
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/model"
+)
+
+func RepoStatementLineToModel(line *repoModel.StatementLine) *model.StatementLine {
+	return &model.StatementLine{
+		EntryUUID:    line.EntryUUID,
+		EntryType:    LedgerEntryTypeToModel(line.EntryType),
+		Amount:       line.Amount.InexactFloat64(),
+		BalanceAfter: line.BalanceAfter.InexactFloat64(),
+		Reference:    line.Reference,
+		CreatedAt:    line.CreatedAt,
+	}
+}
+
+func LedgerEntryTypeToRepo(entryType model.LedgerEntryType) string {
+	switch entryType {
+	case model.LedgerEntryTypeTopUp:
+		return "TOP_UP"
+	case model.LedgerEntryTypePayment:
+		return "PAYMENT"
+	default:
+		return ""
+	}
+}
+
+func LedgerEntryTypeToModel(s string) model.LedgerEntryType {
+	switch s {
+	case "TOP_UP":
+		return model.LedgerEntryTypeTopUp
+	case "PAYMENT":
+		return model.LedgerEntryTypePayment
+	default:
+		return model.LedgerEntryTypeUnspecified
+	}
+}
@@ -0,0 +1,35 @@
+package converter
+
+import (
+	"database/sql"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/model"
+)
+
+func RepoOrderSnapshotToModel(order *repoModel.OrderSnapshot) *model.OrderSnapshot {
+	return &model.OrderSnapshot{
+		OrderUUID:       order.OrderUUID,
+		UserUUID:        order.UserUUID,
+		TotalPrice:      order.TotalPrice.Decimal.InexactFloat64(),
+		PriceUnknown:    !order.TotalPrice.Valid,
+		TransactionUUID: order.TransactionUUID.String,
+		Status:          order.OrderStatus,
+		UpdatedAt:       order.UpdatedAt,
+	}
+}
+
+func ModelOrderSnapshotToRepo(order *model.OrderSnapshot) *repoModel.OrderSnapshot {
+	return &repoModel.OrderSnapshot{
+		OrderUUID: order.OrderUUID,
+		UserUUID:  order.UserUUID,
+		TotalPrice: decimal.NullDecimal{
+			Decimal: decimal.NewFromFloat(order.TotalPrice),
+			Valid:   !order.PriceUnknown,
+		},
+		TransactionUUID: sql.NullString{String: order.TransactionUUID, Valid: order.TransactionUUID != ""},
+		OrderStatus:     order.Status,
+	}
+}
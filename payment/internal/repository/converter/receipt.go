@@ -0,0 +1,96 @@
+package converter
+
+import (
+	"github.com/shopspring/decimal"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/model"
+)
+
+func ReceiptToRepoModel(receipt *model.Receipt) *repoModel.Receipt {
+	items := make(repoModel.ReceiptItems, 0, len(receipt.Items))
+	for _, item := range receipt.Items {
+		items = append(items, repoModel.ReceiptItem{
+			PartUUID:  item.PartUUID,
+			Name:      item.Name,
+			Quantity:  item.Quantity,
+			UnitPrice: decimal.NewFromFloat(item.UnitPrice).Round(2),
+			Total:     decimal.NewFromFloat(item.Total).Round(2),
+			VatAmount: decimal.NewFromFloat(item.VatAmount).Round(2),
+		})
+	}
+
+	return &repoModel.Receipt{
+		ReceiptUUID:     receipt.ReceiptUUID,
+		TransactionUUID: receipt.TransactionUUID,
+		OrderUUID:       receipt.OrderUUID,
+		UserUUID:        receipt.UserUUID,
+		PaymentMethod:   PaymentMethodToRepo(receipt.PaymentMethod),
+		Items:           items,
+		VatRate:         decimal.NewFromFloat(receipt.VatRate).Round(2),
+		VatAmount:       decimal.NewFromFloat(receipt.VatAmount).Round(2),
+		Total:           decimal.NewFromFloat(receipt.Total).Round(2),
+		TextBody:        receipt.Text,
+		HTMLBody:        receipt.HTML,
+		IssuedAt:        receipt.IssuedAt,
+	}
+}
+
+func RepoReceiptToModel(receipt *repoModel.Receipt) *model.Receipt {
+	items := make([]model.ReceiptItem, 0, len(receipt.Items))
+	for _, item := range receipt.Items {
+		items = append(items, model.ReceiptItem{
+			PartUUID:  item.PartUUID,
+			Name:      item.Name,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice.InexactFloat64(),
+			Total:     item.Total.InexactFloat64(),
+			VatAmount: item.VatAmount.InexactFloat64(),
+		})
+	}
+
+	return &model.Receipt{
+		ReceiptUUID:     receipt.ReceiptUUID,
+		TransactionUUID: receipt.TransactionUUID,
+		OrderUUID:       receipt.OrderUUID,
+		UserUUID:        receipt.UserUUID,
+		PaymentMethod:   PaymentMethodToModel(receipt.PaymentMethod),
+		Items:           items,
+		VatRate:         receipt.VatRate.InexactFloat64(),
+		VatAmount:       receipt.VatAmount.InexactFloat64(),
+		Total:           receipt.Total.InexactFloat64(),
+		Text:            receipt.TextBody,
+		HTML:            receipt.HTMLBody,
+		IssuedAt:        receipt.IssuedAt,
+	}
+}
+
+func PaymentItemsToRepoModel(items []model.PaymentItem) repoModel.PaymentItems {
+	repoItems := make(repoModel.PaymentItems, 0, len(items))
+	for _, item := range items {
+		repoItems = append(repoItems, repoModel.PaymentItem{
+			PartUUID:  item.PartUUID,
+			Name:      item.Name,
+			Quantity:  item.Quantity,
+			UnitPrice: decimal.NewFromFloat(item.UnitPrice).Round(2),
+		})
+	}
+	return repoItems
+}
+
+func RepoPaymentItemsToModel(items repoModel.PaymentItems) []model.PaymentItem {
+	if len(items) == 0 {
+		return nil
+	}
+
+	modelItems := make([]model.PaymentItem, 0, len(items))
+	for _, item := range items {
+		modelItems = append(modelItems, model.PaymentItem{
+			PartUUID:  item.PartUUID,
+			Name:      item.Name,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice.InexactFloat64(),
+		})
+	}
+	return modelItems
+}
@@ -0,0 +1,116 @@
+package converter
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/model"
+)
+
+func RepoTransactionToModel(tx *repoModel.Transaction) *model.Transaction {
+	var resultPublishedAt *time.Time
+	if tx.ResultPublishedAt.Valid {
+		resultPublishedAt = &tx.ResultPublishedAt.Time
+	}
+
+	return &model.Transaction{
+		TransactionUUID: tx.TransactionUUID,
+		OrderUUID:       tx.OrderUUID,
+		UserUUID:        tx.UserUUID,
+		PaymentMethod:   PaymentMethodToModel(tx.PaymentMethod),
+		Amount:          tx.Amount.Decimal.InexactFloat64(),
+		AmountUnknown:   !tx.Amount.Valid,
+		Items:           RepoPaymentItemsToModel(tx.Items),
+		Status:          PaymentStatusToModel(tx.Status),
+		FailureReason:   tx.FailureReason.String,
+		Installments:    tx.Installments,
+		CreatedAt:       tx.CreatedAt,
+		UpdatedAt:       tx.UpdatedAt,
+
+		ResultPublishedAt: resultPublishedAt,
+	}
+}
+
+func TransactionToRepoModel(tx *model.Transaction) *repoModel.Transaction {
+	// Конвертация FailureReason: "" → NULL
+	var reason sql.NullString
+	if tx.FailureReason != "" {
+		reason = sql.NullString{String: tx.FailureReason, Valid: true}
+	}
+
+	return &repoModel.Transaction{
+		TransactionUUID: tx.TransactionUUID,
+		OrderUUID:       tx.OrderUUID,
+		UserUUID:        tx.UserUUID,
+		PaymentMethod:   PaymentMethodToRepo(tx.PaymentMethod),
+		Amount: decimal.NullDecimal{
+			Decimal: decimal.NewFromFloat(tx.Amount).Round(2),
+			Valid:   !tx.AmountUnknown,
+		},
+		Items:         PaymentItemsToRepoModel(tx.Items),
+		Status:        PaymentStatusToRepo(tx.Status),
+		FailureReason: reason,
+		Installments:  tx.Installments,
+		CreatedAt:     tx.CreatedAt,
+		UpdatedAt:     tx.UpdatedAt,
+	}
+}
+
+func PaymentMethodToRepo(method model.PaymentMethod) string {
+	switch method {
+	case model.PaymentMethodCard:
+		return "CARD"
+	case model.PaymentMethodSBP:
+		return "SBP"
+	case model.PaymentMethodCreditCard:
+		return "CREDIT_CARD"
+	case model.PaymentMethodInvestorMoney:
+		return "INVESTOR_MONEY"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+func PaymentMethodToModel(s string) model.PaymentMethod {
+	switch s {
+	case "CARD":
+		return model.PaymentMethodCard
+	case "SBP":
+		return model.PaymentMethodSBP
+	case "CREDIT_CARD":
+		return model.PaymentMethodCreditCard
+	case "INVESTOR_MONEY":
+		return model.PaymentMethodInvestorMoney
+	default:
+		return model.PaymentMethodUnspecified
+	}
+}
+
+func PaymentStatusToRepo(status model.PaymentStatus) string {
+	switch status {
+	case model.PaymentStatusPending:
+		return "PENDING"
+	case model.PaymentStatusSucceeded:
+		return "SUCCEEDED"
+	case model.PaymentStatusFailed:
+		return "FAILED"
+	default:
+		return "UNKNOWN"
+	}
+}
+
+func PaymentStatusToModel(s string) model.PaymentStatus {
+	switch s {
+	case "PENDING":
+		return model.PaymentStatusPending
+	case "SUCCEEDED":
+		return model.PaymentStatusSucceeded
+	case "FAILED":
+		return model.PaymentStatusFailed
+	default:
+		return model.PaymentStatusUnspecified
+	}
+}
@@ -0,0 +1,42 @@
+package fraud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/converter"
+)
+
+func (r *repository) Create(ctx context.Context, decision *model.FraudDecision) error {
+	repoDecision := converter.FraudDecisionToRepoModel(decision)
+
+	query := `
+        INSERT INTO fraud_decisions (
+            decision_uuid,
+            transaction_uuid,
+            order_uuid,
+            user_uuid,
+            payment_method,
+            amount,
+            verdict,
+            reasons
+        ) VALUES (
+            :decision_uuid,
+            :transaction_uuid,
+            :order_uuid,
+            :user_uuid,
+            :payment_method,
+            :amount,
+            :verdict,
+            :reasons
+        )
+    `
+
+	_, err := r.db.NamedExecContext(ctx, query, repoDecision)
+	if err != nil {
+		return fmt.Errorf("failed to insert fraud decision: %w", err)
+	}
+
+	return nil
+}
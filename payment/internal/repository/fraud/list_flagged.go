@@ -0,0 +1,41 @@
+package fraud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/converter"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/model"
+)
+
+func (r *repository) ListFlagged(ctx context.Context, limit, offset int) ([]*model.FraudDecision, error) {
+	query := `
+		SELECT
+			decision_uuid,
+			transaction_uuid,
+			order_uuid,
+			user_uuid,
+			payment_method,
+			amount,
+			verdict,
+			reasons,
+			created_at
+		FROM fraud_decisions
+		WHERE verdict = 'REVIEW'
+		ORDER BY created_at DESC
+		LIMIT $1 OFFSET $2
+	`
+
+	var repoDecisions []*repoModel.FraudDecision
+	if err := r.db.SelectContext(ctx, &repoDecisions, query, limit, offset); err != nil {
+		return nil, fmt.Errorf("failed to list flagged payments: %w", err)
+	}
+
+	decisions := make([]*model.FraudDecision, 0, len(repoDecisions))
+	for _, decision := range repoDecisions {
+		decisions = append(decisions, converter.RepoFraudDecisionToModel(decision))
+	}
+
+	return decisions, nil
+}
@@ -0,0 +1,19 @@
+package fraud
+
+import (
+	"github.com/jmoiron/sqlx"
+
+	def "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository"
+)
+
+var _ def.FraudDecisionRepository = (*repository)(nil)
+
+type repository struct {
+	db *sqlx.DB
+}
+
+func NewRepository(db *sqlx.DB) *repository {
+	return &repository{
+		db: db,
+	}
+}
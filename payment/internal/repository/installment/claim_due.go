@@ -0,0 +1,65 @@
+package installment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/converter"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/model"
+)
+
+func (r *repository) ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*model.DueInstallment, error) {
+	query := `
+		WITH due AS (
+			SELECT i.installment_uuid
+			FROM installments i
+			JOIN installment_plans p ON p.plan_uuid = i.plan_uuid
+			WHERE i.status IN ('SCHEDULED', 'MISSED')
+				AND i.due_date <= $1
+				AND (i.next_attempt_at IS NULL OR i.next_attempt_at <= $1)
+				AND p.status = 'ACTIVE'
+			ORDER BY i.due_date
+			LIMIT $3
+			FOR UPDATE OF i SKIP LOCKED
+		), claimed AS (
+			UPDATE installments i
+			SET next_attempt_at = $2
+			FROM due
+			WHERE i.installment_uuid = due.installment_uuid
+			RETURNING i.*
+		)
+		SELECT
+			i.installment_uuid,
+			i.plan_uuid,
+			i.number,
+			i.amount,
+			i.due_date,
+			i.status,
+			i.attempts,
+			i.failure_reason,
+			i.paid_at,
+			p.transaction_uuid,
+			p.order_uuid,
+			p.user_uuid,
+			p.total_amount,
+			p.status AS plan_status,
+			p.created_at AS plan_created_at
+		FROM claimed i
+		JOIN installment_plans p ON p.plan_uuid = i.plan_uuid
+		ORDER BY i.due_date
+	`
+
+	var repoDue []*repoModel.DueInstallment
+	if err := r.db.SelectContext(ctx, &repoDue, query, now, leaseUntil, limit); err != nil {
+		return nil, fmt.Errorf("failed to claim due installments: %w", err)
+	}
+
+	due := make([]*model.DueInstallment, 0, len(repoDue))
+	for _, installment := range repoDue {
+		due = append(due, converter.RepoDueInstallmentToModel(installment))
+	}
+
+	return due, nil
+}
@@ -0,0 +1,31 @@
+package installment
+
+import (
+	"context"
+	"fmt"
+)
+
+func (r *repository) CompleteIfAllPaid(ctx context.Context, planUUID string) (bool, error) {
+	query := `
+		UPDATE installment_plans
+		SET status = 'COMPLETED'
+		WHERE plan_uuid = $1
+			AND status = 'ACTIVE'
+			AND NOT EXISTS (
+				SELECT 1 FROM installments
+				WHERE plan_uuid = $1 AND status <> 'PAID'
+			)
+	`
+
+	result, err := r.db.ExecContext(ctx, query, planUUID)
+	if err != nil {
+		return false, fmt.Errorf("failed to complete installment plan: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
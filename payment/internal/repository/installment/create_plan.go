@@ -0,0 +1,81 @@
+package installment
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/converter"
+)
+
+func (r *repository) CreatePlan(ctx context.Context, plan *model.InstallmentPlan) error {
+	tx, err := r.db.BeginTxx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelReadCommitted,
+		ReadOnly:  false,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer rollback(tx)
+
+	planQuery := `
+		INSERT INTO installment_plans (
+			plan_uuid,
+			transaction_uuid,
+			order_uuid,
+			user_uuid,
+			total_amount,
+			status,
+			created_at
+		) VALUES (
+			:plan_uuid,
+			:transaction_uuid,
+			:order_uuid,
+			:user_uuid,
+			:total_amount,
+			:status,
+			:created_at
+		)
+	`
+
+	if _, err = tx.NamedExecContext(ctx, planQuery, converter.InstallmentPlanToRepoModel(plan)); err != nil {
+		return fmt.Errorf("failed to insert installment plan: %w", err)
+	}
+
+	installmentQuery := `
+		INSERT INTO installments (
+			installment_uuid,
+			plan_uuid,
+			number,
+			amount,
+			due_date,
+			status,
+			attempts,
+			failure_reason,
+			paid_at
+		) VALUES (
+			:installment_uuid,
+			:plan_uuid,
+			:number,
+			:amount,
+			:due_date,
+			:status,
+			:attempts,
+			:failure_reason,
+			:paid_at
+		)
+	`
+
+	for _, installment := range plan.Installments {
+		if _, err = tx.NamedExecContext(ctx, installmentQuery, converter.InstallmentToRepoModel(installment)); err != nil {
+			return fmt.Errorf("failed to insert installment: %w", err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
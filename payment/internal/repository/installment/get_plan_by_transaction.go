@@ -0,0 +1,43 @@
+package installment
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/converter"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/model"
+)
+
+func (r *repository) GetPlanByTransaction(ctx context.Context, transactionUUID string) (*model.InstallmentPlan, error) {
+	query := `
+		SELECT
+			plan_uuid,
+			transaction_uuid,
+			order_uuid,
+			user_uuid,
+			total_amount,
+			status,
+			created_at
+		FROM installment_plans
+		WHERE transaction_uuid = $1
+	`
+
+	var repoPlan repoModel.InstallmentPlan
+	err := r.db.QueryRowxContext(ctx, query, transactionUUID).StructScan(&repoPlan)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrInstallmentPlanNotFound
+		}
+		return nil, fmt.Errorf("failed to get installment plan: %w", err)
+	}
+
+	installments, err := r.listInstallments(ctx, []string{repoPlan.PlanUUID})
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.RepoInstallmentPlanToModel(&repoPlan, installments[repoPlan.PlanUUID]), nil
+}
@@ -0,0 +1,41 @@
+package installment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/model"
+)
+
+// listInstallments загружает графики платежей для нескольких планов одним запросом
+func (r *repository) listInstallments(ctx context.Context, planUUIDs []string) (map[string][]*repoModel.Installment, error) {
+	query := `
+		SELECT
+			installment_uuid,
+			plan_uuid,
+			number,
+			amount,
+			due_date,
+			status,
+			attempts,
+			failure_reason,
+			paid_at
+		FROM installments
+		WHERE plan_uuid::text = ANY($1)
+		ORDER BY plan_uuid, number
+	`
+
+	var repoInstallments []*repoModel.Installment
+	if err := r.db.SelectContext(ctx, &repoInstallments, query, pq.StringArray(planUUIDs)); err != nil {
+		return nil, fmt.Errorf("failed to list installments: %w", err)
+	}
+
+	byPlan := make(map[string][]*repoModel.Installment, len(planUUIDs))
+	for _, installment := range repoInstallments {
+		byPlan[installment.PlanUUID] = append(byPlan[installment.PlanUUID], installment)
+	}
+
+	return byPlan, nil
+}
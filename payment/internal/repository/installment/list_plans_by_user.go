@@ -0,0 +1,52 @@
+package installment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/converter"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/model"
+)
+
+func (r *repository) ListPlansByUser(ctx context.Context, userUUID string, limit, offset int) ([]*model.InstallmentPlan, error) {
+	query := `
+		SELECT
+			plan_uuid,
+			transaction_uuid,
+			order_uuid,
+			user_uuid,
+			total_amount,
+			status,
+			created_at
+		FROM installment_plans
+		WHERE user_uuid = $1
+		ORDER BY created_at DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	var repoPlans []*repoModel.InstallmentPlan
+	if err := r.db.SelectContext(ctx, &repoPlans, query, userUUID, limit, offset); err != nil {
+		return nil, fmt.Errorf("failed to list installment plans: %w", err)
+	}
+	if len(repoPlans) == 0 {
+		return []*model.InstallmentPlan{}, nil
+	}
+
+	planUUIDs := make([]string, 0, len(repoPlans))
+	for _, plan := range repoPlans {
+		planUUIDs = append(planUUIDs, plan.PlanUUID)
+	}
+
+	installments, err := r.listInstallments(ctx, planUUIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	plans := make([]*model.InstallmentPlan, 0, len(repoPlans))
+	for _, plan := range repoPlans {
+		plans = append(plans, converter.RepoInstallmentPlanToModel(plan, installments[plan.PlanUUID]))
+	}
+
+	return plans, nil
+}
@@ -0,0 +1,29 @@
+package installment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/converter"
+)
+
+func (r *repository) MarkMissed(ctx context.Context, installmentUUID string, status model.InstallmentStatus, reason string, nextAttemptAt time.Time) error {
+	query := `
+		UPDATE installments
+		SET
+			status = $2,
+			attempts = attempts + 1,
+			failure_reason = $3,
+			next_attempt_at = $4
+		WHERE installment_uuid = $1
+	`
+
+	_, err := r.db.ExecContext(ctx, query, installmentUUID, converter.InstallmentStatusToRepo(status), reason, nextAttemptAt)
+	if err != nil {
+		return fmt.Errorf("failed to mark installment missed: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package installment
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+func (r *repository) MarkPaid(ctx context.Context, installmentUUID string, paidAt time.Time) error {
+	query := `
+		UPDATE installments
+		SET
+			status = 'PAID',
+			attempts = attempts + 1,
+			failure_reason = NULL,
+			paid_at = $2,
+			next_attempt_at = NULL
+		WHERE installment_uuid = $1
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, installmentUUID, paidAt); err != nil {
+		return fmt.Errorf("failed to mark installment paid: %w", err)
+	}
+
+	return nil
+}
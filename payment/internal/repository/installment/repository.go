@@ -0,0 +1,29 @@
+package installment
+
+import (
+	"database/sql"
+	"errors"
+	"log"
+
+	"github.com/jmoiron/sqlx"
+
+	def "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository"
+)
+
+var _ def.InstallmentRepository = (*repository)(nil)
+
+type repository struct {
+	db *sqlx.DB
+}
+
+func NewRepository(db *sqlx.DB) *repository {
+	return &repository{
+		db: db,
+	}
+}
+
+func rollback(tx *sqlx.Tx) {
+	if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+		log.Printf("rollback error: %v\n", err)
+	}
+}
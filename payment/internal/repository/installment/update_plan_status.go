@@ -0,0 +1,32 @@
+package installment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/converter"
+)
+
+func (r *repository) UpdatePlanStatus(ctx context.Context, planUUID string, status model.InstallmentPlanStatus) error {
+	query := `
+		UPDATE installment_plans
+		SET status = $2
+		WHERE plan_uuid = $1
+	`
+
+	result, err := r.db.ExecContext(ctx, query, planUUID, converter.InstallmentPlanStatusToRepo(status))
+	if err != nil {
+		return fmt.Errorf("failed to update installment plan status: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return model.ErrInstallmentPlanNotFound
+	}
+
+	return nil
+}
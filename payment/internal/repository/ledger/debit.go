@@ -0,0 +1,69 @@
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/model"
+)
+
+// Debit атомарно списывает сумму со счета инвестора на системный счет выручки.
+// reference - UUID платежной транзакции, повторное списание по нему не проводится
+func (r *repository) Debit(ctx context.Context, userUUID string, amount float64, reference string) error {
+	value := decimal.NewFromFloat(amount).Round(2)
+
+	tx, err := r.db.BeginTxx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelReadCommitted,
+		ReadOnly:  false,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer rollback(tx)
+
+	entryUUID, created, err := createEntry(ctx, tx, model.LedgerEntryTypePayment, reference)
+	if err != nil {
+		return err
+	}
+	if !created {
+		return nil
+	}
+
+	// UPDATE блокирует строку счета, поэтому параллельные списания проверяют баланс последовательно
+	var investor repoModel.AccountBalance
+	err = tx.GetContext(ctx, &investor, `
+		UPDATE ledger_accounts
+		SET balance = balance - $2, updated_at = NOW()
+		WHERE owner_uuid = $1 AND account_type = 'INVESTOR' AND balance >= $2
+		RETURNING account_uuid, balance
+	`, userUUID, value)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return model.ErrInsufficientFunds
+		}
+		return fmt.Errorf("failed to debit investor account: %w", err)
+	}
+
+	revenue, err := applyToSystemAccount(ctx, tx, accountTypeRevenue, value)
+	if err != nil {
+		return err
+	}
+
+	if err = createPosting(ctx, tx, entryUUID, &investor, value.Neg()); err != nil {
+		return err
+	}
+	if err = createPosting(ctx, tx, entryUUID, revenue, value); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// GetBalance возвращает баланс счета инвестора. Счета без пополнений нет, его баланс равен нулю
+func (r *repository) GetBalance(ctx context.Context, userUUID string) (float64, error) {
+	query := `
+		SELECT balance
+		FROM ledger_accounts
+		WHERE owner_uuid = $1 AND account_type = 'INVESTOR'
+	`
+
+	var balance decimal.Decimal
+	err := r.db.GetContext(ctx, &balance, query, userUUID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("failed to get investor balance: %w", err)
+	}
+
+	return balance.InexactFloat64(), nil
+}
@@ -0,0 +1,41 @@
+package ledger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/converter"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/model"
+)
+
+func (r *repository) GetStatement(ctx context.Context, req *model.StatementRequest) ([]*model.StatementLine, error) {
+	query := `
+		SELECT
+			p.entry_uuid,
+			e.entry_type,
+			p.amount,
+			p.balance_after,
+			e.reference,
+			p.created_at
+		FROM ledger_postings p
+		JOIN ledger_accounts a ON a.account_uuid = p.account_uuid
+		JOIN ledger_entries e ON e.entry_uuid = p.entry_uuid
+		WHERE a.owner_uuid = $1 AND a.account_type = 'INVESTOR'
+		ORDER BY p.posting_id DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	var repoLines []*repoModel.StatementLine
+	err := r.db.SelectContext(ctx, &repoLines, query, req.UserUUID, req.Limit, req.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get investor statement: %w", err)
+	}
+
+	lines := make([]*model.StatementLine, 0, len(repoLines))
+	for _, line := range repoLines {
+		lines = append(lines, converter.RepoStatementLineToModel(line))
+	}
+
+	return lines, nil
+}
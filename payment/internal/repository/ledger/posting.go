@@ -0,0 +1,85 @@
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/shopspring/decimal"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/converter"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/model"
+)
+
+const (
+	accountTypeFunding = "SYSTEM_FUNDING"
+	accountTypeRevenue = "SYSTEM_REVENUE"
+)
+
+// createEntry добавляет проводку в журнал. Если проводка с таким типом и reference уже есть,
+// возвращает ее UUID и created=false
+func createEntry(ctx context.Context, tx *sqlx.Tx, entryType model.LedgerEntryType, reference string) (string, bool, error) {
+	var entryUUID string
+	err := tx.GetContext(ctx, &entryUUID, `
+		INSERT INTO ledger_entries (entry_uuid, entry_type, reference)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (entry_type, reference) DO NOTHING
+		RETURNING entry_uuid
+	`, uuid.NewString(), converter.LedgerEntryTypeToRepo(entryType), reference)
+	if err == nil {
+		return entryUUID, true, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return "", false, fmt.Errorf("failed to insert ledger entry: %w", err)
+	}
+
+	err = tx.GetContext(ctx, &entryUUID, `
+		SELECT entry_uuid FROM ledger_entries WHERE entry_type = $1 AND reference = $2
+	`, converter.LedgerEntryTypeToRepo(entryType), reference)
+	if err != nil {
+		return "", false, fmt.Errorf("failed to get ledger entry: %w", err)
+	}
+
+	return entryUUID, false, nil
+}
+
+// applyToSystemAccount изменяет баланс системного счета на delta
+func applyToSystemAccount(ctx context.Context, tx *sqlx.Tx, accountType string, delta decimal.Decimal) (*repoModel.AccountBalance, error) {
+	var account repoModel.AccountBalance
+	err := tx.GetContext(ctx, &account, `
+		UPDATE ledger_accounts
+		SET balance = balance + $2, updated_at = NOW()
+		WHERE owner_uuid IS NULL AND account_type = $1
+		RETURNING account_uuid, balance
+	`, accountType, delta)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update system account %s: %w", accountType, err)
+	}
+
+	return &account, nil
+}
+
+// createPosting записывает движение по счету в рамках проводки
+func createPosting(ctx context.Context, tx *sqlx.Tx, entryUUID string, account *repoModel.AccountBalance, amount decimal.Decimal) error {
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO ledger_postings (entry_uuid, account_uuid, amount, balance_after)
+		VALUES ($1, $2, $3, $4)
+	`, entryUUID, account.AccountUUID, amount, account.Balance)
+	if err != nil {
+		return fmt.Errorf("failed to insert ledger posting: %w", err)
+	}
+
+	return nil
+}
+
+// rollback откатывает транзакцию, если она не была закоммичена
+func rollback(tx *sqlx.Tx) {
+	if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+		log.Printf("rollback error: %v\n", err)
+	}
+}
@@ -0,0 +1,75 @@
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/model"
+)
+
+// TopUp зачисляет сумму на счет инвестора (счет создается при первом пополнении)
+// с контрпроводкой по системному счету фондирования
+func (r *repository) TopUp(ctx context.Context, req *model.TopUpRequest) (*model.TopUpResult, error) {
+	amount := decimal.NewFromFloat(req.Amount).Round(2)
+
+	tx, err := r.db.BeginTxx(ctx, &sql.TxOptions{
+		Isolation: sql.LevelReadCommitted,
+		ReadOnly:  false,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer rollback(tx)
+
+	entryUUID, created, err := createEntry(ctx, tx, model.LedgerEntryTypeTopUp, req.Reference)
+	if err != nil {
+		return nil, err
+	}
+
+	// Повторное пополнение с тем же reference уже проведено
+	if !created {
+		balance, err := r.GetBalance(ctx, req.UserUUID)
+		if err != nil {
+			return nil, err
+		}
+		return &model.TopUpResult{EntryUUID: entryUUID, Balance: balance}, nil
+	}
+
+	var investor repoModel.AccountBalance
+	err = tx.GetContext(ctx, &investor, `
+		INSERT INTO ledger_accounts (account_uuid, owner_uuid, account_type, balance)
+		VALUES ($1, $2, 'INVESTOR', $3)
+		ON CONFLICT (owner_uuid, account_type)
+		DO UPDATE SET balance = ledger_accounts.balance + EXCLUDED.balance, updated_at = NOW()
+		RETURNING account_uuid, balance
+	`, uuid.NewString(), req.UserUUID, amount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to credit investor account: %w", err)
+	}
+
+	funding, err := applyToSystemAccount(ctx, tx, accountTypeFunding, amount.Neg())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = createPosting(ctx, tx, entryUUID, &investor, amount); err != nil {
+		return nil, err
+	}
+	if err = createPosting(ctx, tx, entryUUID, funding, amount.Neg()); err != nil {
+		return nil, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return &model.TopUpResult{
+		EntryUUID: entryUUID,
+		Balance:   investor.Balance.InexactFloat64(),
+	}, nil
+}
@@ -0,0 +1,146 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FraudDecisionRepository is an autogenerated mock type for the FraudDecisionRepository type
+type FraudDecisionRepository struct {
+	mock.Mock
+}
+
+type FraudDecisionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FraudDecisionRepository) EXPECT() *FraudDecisionRepository_Expecter {
+	return &FraudDecisionRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, decision
+func (_m *FraudDecisionRepository) Create(ctx context.Context, decision *model.FraudDecision) error {
+	ret := _m.Called(ctx, decision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.FraudDecision) error); ok {
+		r0 = rf(ctx, decision)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FraudDecisionRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type FraudDecisionRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - decision *model.FraudDecision
+func (_e *FraudDecisionRepository_Expecter) Create(ctx interface{}, decision interface{}) *FraudDecisionRepository_Create_Call {
+	return &FraudDecisionRepository_Create_Call{Call: _e.mock.On("Create", ctx, decision)}
+}
+
+func (_c *FraudDecisionRepository_Create_Call) Run(run func(ctx context.Context, decision *model.FraudDecision)) *FraudDecisionRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.FraudDecision))
+	})
+	return _c
+}
+
+func (_c *FraudDecisionRepository_Create_Call) Return(_a0 error) *FraudDecisionRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *FraudDecisionRepository_Create_Call) RunAndReturn(run func(context.Context, *model.FraudDecision) error) *FraudDecisionRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFlagged provides a mock function with given fields: ctx, limit, offset
+func (_m *FraudDecisionRepository) ListFlagged(ctx context.Context, limit int, offset int) ([]*model.FraudDecision, error) {
+	ret := _m.Called(ctx, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFlagged")
+	}
+
+	var r0 []*model.FraudDecision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]*model.FraudDecision, error)); ok {
+		return rf(ctx, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []*model.FraudDecision); ok {
+		r0 = rf(ctx, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.FraudDecision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FraudDecisionRepository_ListFlagged_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFlagged'
+type FraudDecisionRepository_ListFlagged_Call struct {
+	*mock.Call
+}
+
+// ListFlagged is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - offset int
+func (_e *FraudDecisionRepository_Expecter) ListFlagged(ctx interface{}, limit interface{}, offset interface{}) *FraudDecisionRepository_ListFlagged_Call {
+	return &FraudDecisionRepository_ListFlagged_Call{Call: _e.mock.On("ListFlagged", ctx, limit, offset)}
+}
+
+func (_c *FraudDecisionRepository_ListFlagged_Call) Run(run func(ctx context.Context, limit int, offset int)) *FraudDecisionRepository_ListFlagged_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *FraudDecisionRepository_ListFlagged_Call) Return(_a0 []*model.FraudDecision, _a1 error) *FraudDecisionRepository_ListFlagged_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FraudDecisionRepository_ListFlagged_Call) RunAndReturn(run func(context.Context, int, int) ([]*model.FraudDecision, error)) *FraudDecisionRepository_ListFlagged_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFraudDecisionRepository creates a new instance of FraudDecisionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFraudDecisionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FraudDecisionRepository {
+	mock := &FraudDecisionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,472 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// InstallmentRepository is an autogenerated mock type for the InstallmentRepository type
+type InstallmentRepository struct {
+	mock.Mock
+}
+
+type InstallmentRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *InstallmentRepository) EXPECT() *InstallmentRepository_Expecter {
+	return &InstallmentRepository_Expecter{mock: &_m.Mock}
+}
+
+// ClaimDue provides a mock function with given fields: ctx, now, leaseUntil, limit
+func (_m *InstallmentRepository) ClaimDue(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]*model.DueInstallment, error) {
+	ret := _m.Called(ctx, now, leaseUntil, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ClaimDue")
+	}
+
+	var r0 []*model.DueInstallment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) ([]*model.DueInstallment, error)); ok {
+		return rf(ctx, now, leaseUntil, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time, int) []*model.DueInstallment); ok {
+		r0 = rf(ctx, now, leaseUntil, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.DueInstallment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time, int) error); ok {
+		r1 = rf(ctx, now, leaseUntil, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InstallmentRepository_ClaimDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ClaimDue'
+type InstallmentRepository_ClaimDue_Call struct {
+	*mock.Call
+}
+
+// ClaimDue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+//   - leaseUntil time.Time
+//   - limit int
+func (_e *InstallmentRepository_Expecter) ClaimDue(ctx interface{}, now interface{}, leaseUntil interface{}, limit interface{}) *InstallmentRepository_ClaimDue_Call {
+	return &InstallmentRepository_ClaimDue_Call{Call: _e.mock.On("ClaimDue", ctx, now, leaseUntil, limit)}
+}
+
+func (_c *InstallmentRepository_ClaimDue_Call) Run(run func(ctx context.Context, now time.Time, leaseUntil time.Time, limit int)) *InstallmentRepository_ClaimDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *InstallmentRepository_ClaimDue_Call) Return(_a0 []*model.DueInstallment, _a1 error) *InstallmentRepository_ClaimDue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InstallmentRepository_ClaimDue_Call) RunAndReturn(run func(context.Context, time.Time, time.Time, int) ([]*model.DueInstallment, error)) *InstallmentRepository_ClaimDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompleteIfAllPaid provides a mock function with given fields: ctx, planUUID
+func (_m *InstallmentRepository) CompleteIfAllPaid(ctx context.Context, planUUID string) (bool, error) {
+	ret := _m.Called(ctx, planUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteIfAllPaid")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, planUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, planUUID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, planUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InstallmentRepository_CompleteIfAllPaid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteIfAllPaid'
+type InstallmentRepository_CompleteIfAllPaid_Call struct {
+	*mock.Call
+}
+
+// CompleteIfAllPaid is a helper method to define mock.On call
+//   - ctx context.Context
+//   - planUUID string
+func (_e *InstallmentRepository_Expecter) CompleteIfAllPaid(ctx interface{}, planUUID interface{}) *InstallmentRepository_CompleteIfAllPaid_Call {
+	return &InstallmentRepository_CompleteIfAllPaid_Call{Call: _e.mock.On("CompleteIfAllPaid", ctx, planUUID)}
+}
+
+func (_c *InstallmentRepository_CompleteIfAllPaid_Call) Run(run func(ctx context.Context, planUUID string)) *InstallmentRepository_CompleteIfAllPaid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InstallmentRepository_CompleteIfAllPaid_Call) Return(_a0 bool, _a1 error) *InstallmentRepository_CompleteIfAllPaid_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InstallmentRepository_CompleteIfAllPaid_Call) RunAndReturn(run func(context.Context, string) (bool, error)) *InstallmentRepository_CompleteIfAllPaid_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePlan provides a mock function with given fields: ctx, plan
+func (_m *InstallmentRepository) CreatePlan(ctx context.Context, plan *model.InstallmentPlan) error {
+	ret := _m.Called(ctx, plan)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePlan")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.InstallmentPlan) error); ok {
+		r0 = rf(ctx, plan)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InstallmentRepository_CreatePlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePlan'
+type InstallmentRepository_CreatePlan_Call struct {
+	*mock.Call
+}
+
+// CreatePlan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - plan *model.InstallmentPlan
+func (_e *InstallmentRepository_Expecter) CreatePlan(ctx interface{}, plan interface{}) *InstallmentRepository_CreatePlan_Call {
+	return &InstallmentRepository_CreatePlan_Call{Call: _e.mock.On("CreatePlan", ctx, plan)}
+}
+
+func (_c *InstallmentRepository_CreatePlan_Call) Run(run func(ctx context.Context, plan *model.InstallmentPlan)) *InstallmentRepository_CreatePlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.InstallmentPlan))
+	})
+	return _c
+}
+
+func (_c *InstallmentRepository_CreatePlan_Call) Return(_a0 error) *InstallmentRepository_CreatePlan_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InstallmentRepository_CreatePlan_Call) RunAndReturn(run func(context.Context, *model.InstallmentPlan) error) *InstallmentRepository_CreatePlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPlanByTransaction provides a mock function with given fields: ctx, transactionUUID
+func (_m *InstallmentRepository) GetPlanByTransaction(ctx context.Context, transactionUUID string) (*model.InstallmentPlan, error) {
+	ret := _m.Called(ctx, transactionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPlanByTransaction")
+	}
+
+	var r0 *model.InstallmentPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.InstallmentPlan, error)); ok {
+		return rf(ctx, transactionUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.InstallmentPlan); ok {
+		r0 = rf(ctx, transactionUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.InstallmentPlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, transactionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InstallmentRepository_GetPlanByTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPlanByTransaction'
+type InstallmentRepository_GetPlanByTransaction_Call struct {
+	*mock.Call
+}
+
+// GetPlanByTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID string
+func (_e *InstallmentRepository_Expecter) GetPlanByTransaction(ctx interface{}, transactionUUID interface{}) *InstallmentRepository_GetPlanByTransaction_Call {
+	return &InstallmentRepository_GetPlanByTransaction_Call{Call: _e.mock.On("GetPlanByTransaction", ctx, transactionUUID)}
+}
+
+func (_c *InstallmentRepository_GetPlanByTransaction_Call) Run(run func(ctx context.Context, transactionUUID string)) *InstallmentRepository_GetPlanByTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InstallmentRepository_GetPlanByTransaction_Call) Return(_a0 *model.InstallmentPlan, _a1 error) *InstallmentRepository_GetPlanByTransaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InstallmentRepository_GetPlanByTransaction_Call) RunAndReturn(run func(context.Context, string) (*model.InstallmentPlan, error)) *InstallmentRepository_GetPlanByTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPlansByUser provides a mock function with given fields: ctx, userUUID, limit, offset
+func (_m *InstallmentRepository) ListPlansByUser(ctx context.Context, userUUID string, limit int, offset int) ([]*model.InstallmentPlan, error) {
+	ret := _m.Called(ctx, userUUID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPlansByUser")
+	}
+
+	var r0 []*model.InstallmentPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) ([]*model.InstallmentPlan, error)); ok {
+		return rf(ctx, userUUID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) []*model.InstallmentPlan); ok {
+		r0 = rf(ctx, userUUID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.InstallmentPlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
+		r1 = rf(ctx, userUUID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InstallmentRepository_ListPlansByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPlansByUser'
+type InstallmentRepository_ListPlansByUser_Call struct {
+	*mock.Call
+}
+
+// ListPlansByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - limit int
+//   - offset int
+func (_e *InstallmentRepository_Expecter) ListPlansByUser(ctx interface{}, userUUID interface{}, limit interface{}, offset interface{}) *InstallmentRepository_ListPlansByUser_Call {
+	return &InstallmentRepository_ListPlansByUser_Call{Call: _e.mock.On("ListPlansByUser", ctx, userUUID, limit, offset)}
+}
+
+func (_c *InstallmentRepository_ListPlansByUser_Call) Run(run func(ctx context.Context, userUUID string, limit int, offset int)) *InstallmentRepository_ListPlansByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *InstallmentRepository_ListPlansByUser_Call) Return(_a0 []*model.InstallmentPlan, _a1 error) *InstallmentRepository_ListPlansByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InstallmentRepository_ListPlansByUser_Call) RunAndReturn(run func(context.Context, string, int, int) ([]*model.InstallmentPlan, error)) *InstallmentRepository_ListPlansByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkMissed provides a mock function with given fields: ctx, installmentUUID, status, reason, nextAttemptAt
+func (_m *InstallmentRepository) MarkMissed(ctx context.Context, installmentUUID string, status model.InstallmentStatus, reason string, nextAttemptAt time.Time) error {
+	ret := _m.Called(ctx, installmentUUID, status, reason, nextAttemptAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkMissed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.InstallmentStatus, string, time.Time) error); ok {
+		r0 = rf(ctx, installmentUUID, status, reason, nextAttemptAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InstallmentRepository_MarkMissed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkMissed'
+type InstallmentRepository_MarkMissed_Call struct {
+	*mock.Call
+}
+
+// MarkMissed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - installmentUUID string
+//   - status model.InstallmentStatus
+//   - reason string
+//   - nextAttemptAt time.Time
+func (_e *InstallmentRepository_Expecter) MarkMissed(ctx interface{}, installmentUUID interface{}, status interface{}, reason interface{}, nextAttemptAt interface{}) *InstallmentRepository_MarkMissed_Call {
+	return &InstallmentRepository_MarkMissed_Call{Call: _e.mock.On("MarkMissed", ctx, installmentUUID, status, reason, nextAttemptAt)}
+}
+
+func (_c *InstallmentRepository_MarkMissed_Call) Run(run func(ctx context.Context, installmentUUID string, status model.InstallmentStatus, reason string, nextAttemptAt time.Time)) *InstallmentRepository_MarkMissed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(model.InstallmentStatus), args[3].(string), args[4].(time.Time))
+	})
+	return _c
+}
+
+func (_c *InstallmentRepository_MarkMissed_Call) Return(_a0 error) *InstallmentRepository_MarkMissed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InstallmentRepository_MarkMissed_Call) RunAndReturn(run func(context.Context, string, model.InstallmentStatus, string, time.Time) error) *InstallmentRepository_MarkMissed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkPaid provides a mock function with given fields: ctx, installmentUUID, paidAt
+func (_m *InstallmentRepository) MarkPaid(ctx context.Context, installmentUUID string, paidAt time.Time) error {
+	ret := _m.Called(ctx, installmentUUID, paidAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkPaid")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) error); ok {
+		r0 = rf(ctx, installmentUUID, paidAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InstallmentRepository_MarkPaid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkPaid'
+type InstallmentRepository_MarkPaid_Call struct {
+	*mock.Call
+}
+
+// MarkPaid is a helper method to define mock.On call
+//   - ctx context.Context
+//   - installmentUUID string
+//   - paidAt time.Time
+func (_e *InstallmentRepository_Expecter) MarkPaid(ctx interface{}, installmentUUID interface{}, paidAt interface{}) *InstallmentRepository_MarkPaid_Call {
+	return &InstallmentRepository_MarkPaid_Call{Call: _e.mock.On("MarkPaid", ctx, installmentUUID, paidAt)}
+}
+
+func (_c *InstallmentRepository_MarkPaid_Call) Run(run func(ctx context.Context, installmentUUID string, paidAt time.Time)) *InstallmentRepository_MarkPaid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *InstallmentRepository_MarkPaid_Call) Return(_a0 error) *InstallmentRepository_MarkPaid_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InstallmentRepository_MarkPaid_Call) RunAndReturn(run func(context.Context, string, time.Time) error) *InstallmentRepository_MarkPaid_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePlanStatus provides a mock function with given fields: ctx, planUUID, status
+func (_m *InstallmentRepository) UpdatePlanStatus(ctx context.Context, planUUID string, status model.InstallmentPlanStatus) error {
+	ret := _m.Called(ctx, planUUID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePlanStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.InstallmentPlanStatus) error); ok {
+		r0 = rf(ctx, planUUID, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InstallmentRepository_UpdatePlanStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePlanStatus'
+type InstallmentRepository_UpdatePlanStatus_Call struct {
+	*mock.Call
+}
+
+// UpdatePlanStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - planUUID string
+//   - status model.InstallmentPlanStatus
+func (_e *InstallmentRepository_Expecter) UpdatePlanStatus(ctx interface{}, planUUID interface{}, status interface{}) *InstallmentRepository_UpdatePlanStatus_Call {
+	return &InstallmentRepository_UpdatePlanStatus_Call{Call: _e.mock.On("UpdatePlanStatus", ctx, planUUID, status)}
+}
+
+func (_c *InstallmentRepository_UpdatePlanStatus_Call) Run(run func(ctx context.Context, planUUID string, status model.InstallmentPlanStatus)) *InstallmentRepository_UpdatePlanStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(model.InstallmentPlanStatus))
+	})
+	return _c
+}
+
+func (_c *InstallmentRepository_UpdatePlanStatus_Call) Return(_a0 error) *InstallmentRepository_UpdatePlanStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InstallmentRepository_UpdatePlanStatus_Call) RunAndReturn(run func(context.Context, string, model.InstallmentPlanStatus) error) *InstallmentRepository_UpdatePlanStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewInstallmentRepository creates a new instance of InstallmentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInstallmentRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InstallmentRepository {
+	mock := &InstallmentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,263 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LedgerRepository is an autogenerated mock type for the LedgerRepository type
+type LedgerRepository struct {
+	mock.Mock
+}
+
+type LedgerRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LedgerRepository) EXPECT() *LedgerRepository_Expecter {
+	return &LedgerRepository_Expecter{mock: &_m.Mock}
+}
+
+// Debit provides a mock function with given fields: ctx, userUUID, amount, reference
+func (_m *LedgerRepository) Debit(ctx context.Context, userUUID string, amount float64, reference string) error {
+	ret := _m.Called(ctx, userUUID, amount, reference)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Debit")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64, string) error); ok {
+		r0 = rf(ctx, userUUID, amount, reference)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LedgerRepository_Debit_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Debit'
+type LedgerRepository_Debit_Call struct {
+	*mock.Call
+}
+
+// Debit is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - amount float64
+//   - reference string
+func (_e *LedgerRepository_Expecter) Debit(ctx interface{}, userUUID interface{}, amount interface{}, reference interface{}) *LedgerRepository_Debit_Call {
+	return &LedgerRepository_Debit_Call{Call: _e.mock.On("Debit", ctx, userUUID, amount, reference)}
+}
+
+func (_c *LedgerRepository_Debit_Call) Run(run func(ctx context.Context, userUUID string, amount float64, reference string)) *LedgerRepository_Debit_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(float64), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *LedgerRepository_Debit_Call) Return(_a0 error) *LedgerRepository_Debit_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LedgerRepository_Debit_Call) RunAndReturn(run func(context.Context, string, float64, string) error) *LedgerRepository_Debit_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBalance provides a mock function with given fields: ctx, userUUID
+func (_m *LedgerRepository) GetBalance(ctx context.Context, userUUID string) (float64, error) {
+	ret := _m.Called(ctx, userUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBalance")
+	}
+
+	var r0 float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (float64, error)); ok {
+		return rf(ctx, userUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) float64); ok {
+		r0 = rf(ctx, userUUID)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LedgerRepository_GetBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBalance'
+type LedgerRepository_GetBalance_Call struct {
+	*mock.Call
+}
+
+// GetBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+func (_e *LedgerRepository_Expecter) GetBalance(ctx interface{}, userUUID interface{}) *LedgerRepository_GetBalance_Call {
+	return &LedgerRepository_GetBalance_Call{Call: _e.mock.On("GetBalance", ctx, userUUID)}
+}
+
+func (_c *LedgerRepository_GetBalance_Call) Run(run func(ctx context.Context, userUUID string)) *LedgerRepository_GetBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *LedgerRepository_GetBalance_Call) Return(_a0 float64, _a1 error) *LedgerRepository_GetBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LedgerRepository_GetBalance_Call) RunAndReturn(run func(context.Context, string) (float64, error)) *LedgerRepository_GetBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatement provides a mock function with given fields: ctx, req
+func (_m *LedgerRepository) GetStatement(ctx context.Context, req *model.StatementRequest) ([]*model.StatementLine, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatement")
+	}
+
+	var r0 []*model.StatementLine
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StatementRequest) ([]*model.StatementLine, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StatementRequest) []*model.StatementLine); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.StatementLine)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.StatementRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LedgerRepository_GetStatement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatement'
+type LedgerRepository_GetStatement_Call struct {
+	*mock.Call
+}
+
+// GetStatement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *model.StatementRequest
+func (_e *LedgerRepository_Expecter) GetStatement(ctx interface{}, req interface{}) *LedgerRepository_GetStatement_Call {
+	return &LedgerRepository_GetStatement_Call{Call: _e.mock.On("GetStatement", ctx, req)}
+}
+
+func (_c *LedgerRepository_GetStatement_Call) Run(run func(ctx context.Context, req *model.StatementRequest)) *LedgerRepository_GetStatement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.StatementRequest))
+	})
+	return _c
+}
+
+func (_c *LedgerRepository_GetStatement_Call) Return(_a0 []*model.StatementLine, _a1 error) *LedgerRepository_GetStatement_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LedgerRepository_GetStatement_Call) RunAndReturn(run func(context.Context, *model.StatementRequest) ([]*model.StatementLine, error)) *LedgerRepository_GetStatement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TopUp provides a mock function with given fields: ctx, req
+func (_m *LedgerRepository) TopUp(ctx context.Context, req *model.TopUpRequest) (*model.TopUpResult, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TopUp")
+	}
+
+	var r0 *model.TopUpResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.TopUpRequest) (*model.TopUpResult, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.TopUpRequest) *model.TopUpResult); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TopUpResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.TopUpRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LedgerRepository_TopUp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TopUp'
+type LedgerRepository_TopUp_Call struct {
+	*mock.Call
+}
+
+// TopUp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *model.TopUpRequest
+func (_e *LedgerRepository_Expecter) TopUp(ctx interface{}, req interface{}) *LedgerRepository_TopUp_Call {
+	return &LedgerRepository_TopUp_Call{Call: _e.mock.On("TopUp", ctx, req)}
+}
+
+func (_c *LedgerRepository_TopUp_Call) Run(run func(ctx context.Context, req *model.TopUpRequest)) *LedgerRepository_TopUp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.TopUpRequest))
+	})
+	return _c
+}
+
+func (_c *LedgerRepository_TopUp_Call) Return(_a0 *model.TopUpResult, _a1 error) *LedgerRepository_TopUp_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LedgerRepository_TopUp_Call) RunAndReturn(run func(context.Context, *model.TopUpRequest) (*model.TopUpResult, error)) *LedgerRepository_TopUp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLedgerRepository creates a new instance of LedgerRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLedgerRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LedgerRepository {
+	mock := &LedgerRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
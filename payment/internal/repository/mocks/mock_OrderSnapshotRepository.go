@@ -0,0 +1,207 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// OrderSnapshotRepository is an autogenerated mock type for the OrderSnapshotRepository type
+type OrderSnapshotRepository struct {
+	mock.Mock
+}
+
+type OrderSnapshotRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *OrderSnapshotRepository) EXPECT() *OrderSnapshotRepository_Expecter {
+	return &OrderSnapshotRepository_Expecter{mock: &_m.Mock}
+}
+
+// ListByUUIDs provides a mock function with given fields: ctx, orderUUIDs
+func (_m *OrderSnapshotRepository) ListByUUIDs(ctx context.Context, orderUUIDs []string) ([]*model.OrderSnapshot, error) {
+	ret := _m.Called(ctx, orderUUIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByUUIDs")
+	}
+
+	var r0 []*model.OrderSnapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*model.OrderSnapshot, error)); ok {
+		return rf(ctx, orderUUIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*model.OrderSnapshot); ok {
+		r0 = rf(ctx, orderUUIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.OrderSnapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, orderUUIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderSnapshotRepository_ListByUUIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUUIDs'
+type OrderSnapshotRepository_ListByUUIDs_Call struct {
+	*mock.Call
+}
+
+// ListByUUIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - orderUUIDs []string
+func (_e *OrderSnapshotRepository_Expecter) ListByUUIDs(ctx interface{}, orderUUIDs interface{}) *OrderSnapshotRepository_ListByUUIDs_Call {
+	return &OrderSnapshotRepository_ListByUUIDs_Call{Call: _e.mock.On("ListByUUIDs", ctx, orderUUIDs)}
+}
+
+func (_c *OrderSnapshotRepository_ListByUUIDs_Call) Run(run func(ctx context.Context, orderUUIDs []string)) *OrderSnapshotRepository_ListByUUIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *OrderSnapshotRepository_ListByUUIDs_Call) Return(_a0 []*model.OrderSnapshot, _a1 error) *OrderSnapshotRepository_ListByUUIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderSnapshotRepository_ListByUUIDs_Call) RunAndReturn(run func(context.Context, []string) ([]*model.OrderSnapshot, error)) *OrderSnapshotRepository_ListByUUIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPaid provides a mock function with given fields: ctx, since, until
+func (_m *OrderSnapshotRepository) ListPaid(ctx context.Context, since time.Time, until time.Time) ([]*model.OrderSnapshot, error) {
+	ret := _m.Called(ctx, since, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPaid")
+	}
+
+	var r0 []*model.OrderSnapshot
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]*model.OrderSnapshot, error)); ok {
+		return rf(ctx, since, until)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []*model.OrderSnapshot); ok {
+		r0 = rf(ctx, since, until)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.OrderSnapshot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, since, until)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrderSnapshotRepository_ListPaid_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPaid'
+type OrderSnapshotRepository_ListPaid_Call struct {
+	*mock.Call
+}
+
+// ListPaid is a helper method to define mock.On call
+//   - ctx context.Context
+//   - since time.Time
+//   - until time.Time
+func (_e *OrderSnapshotRepository_Expecter) ListPaid(ctx interface{}, since interface{}, until interface{}) *OrderSnapshotRepository_ListPaid_Call {
+	return &OrderSnapshotRepository_ListPaid_Call{Call: _e.mock.On("ListPaid", ctx, since, until)}
+}
+
+func (_c *OrderSnapshotRepository_ListPaid_Call) Run(run func(ctx context.Context, since time.Time, until time.Time)) *OrderSnapshotRepository_ListPaid_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *OrderSnapshotRepository_ListPaid_Call) Return(_a0 []*model.OrderSnapshot, _a1 error) *OrderSnapshotRepository_ListPaid_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *OrderSnapshotRepository_ListPaid_Call) RunAndReturn(run func(context.Context, time.Time, time.Time) ([]*model.OrderSnapshot, error)) *OrderSnapshotRepository_ListPaid_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Save provides a mock function with given fields: ctx, order
+func (_m *OrderSnapshotRepository) Save(ctx context.Context, order *model.OrderSnapshot) error {
+	ret := _m.Called(ctx, order)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Save")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.OrderSnapshot) error); ok {
+		r0 = rf(ctx, order)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OrderSnapshotRepository_Save_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Save'
+type OrderSnapshotRepository_Save_Call struct {
+	*mock.Call
+}
+
+// Save is a helper method to define mock.On call
+//   - ctx context.Context
+//   - order *model.OrderSnapshot
+func (_e *OrderSnapshotRepository_Expecter) Save(ctx interface{}, order interface{}) *OrderSnapshotRepository_Save_Call {
+	return &OrderSnapshotRepository_Save_Call{Call: _e.mock.On("Save", ctx, order)}
+}
+
+func (_c *OrderSnapshotRepository_Save_Call) Run(run func(ctx context.Context, order *model.OrderSnapshot)) *OrderSnapshotRepository_Save_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.OrderSnapshot))
+	})
+	return _c
+}
+
+func (_c *OrderSnapshotRepository_Save_Call) Return(_a0 error) *OrderSnapshotRepository_Save_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *OrderSnapshotRepository_Save_Call) RunAndReturn(run func(context.Context, *model.OrderSnapshot) error) *OrderSnapshotRepository_Save_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewOrderSnapshotRepository creates a new instance of OrderSnapshotRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOrderSnapshotRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OrderSnapshotRepository {
+	mock := &OrderSnapshotRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
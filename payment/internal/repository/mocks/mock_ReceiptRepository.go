@@ -0,0 +1,145 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReceiptRepository is an autogenerated mock type for the ReceiptRepository type
+type ReceiptRepository struct {
+	mock.Mock
+}
+
+type ReceiptRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReceiptRepository) EXPECT() *ReceiptRepository_Expecter {
+	return &ReceiptRepository_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function with given fields: ctx, receipt
+func (_m *ReceiptRepository) Create(ctx context.Context, receipt *model.Receipt) error {
+	ret := _m.Called(ctx, receipt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Receipt) error); ok {
+		r0 = rf(ctx, receipt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReceiptRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type ReceiptRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - receipt *model.Receipt
+func (_e *ReceiptRepository_Expecter) Create(ctx interface{}, receipt interface{}) *ReceiptRepository_Create_Call {
+	return &ReceiptRepository_Create_Call{Call: _e.mock.On("Create", ctx, receipt)}
+}
+
+func (_c *ReceiptRepository_Create_Call) Run(run func(ctx context.Context, receipt *model.Receipt)) *ReceiptRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Receipt))
+	})
+	return _c
+}
+
+func (_c *ReceiptRepository_Create_Call) Return(_a0 error) *ReceiptRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReceiptRepository_Create_Call) RunAndReturn(run func(context.Context, *model.Receipt) error) *ReceiptRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByTransaction provides a mock function with given fields: ctx, transactionUUID
+func (_m *ReceiptRepository) GetByTransaction(ctx context.Context, transactionUUID string) (*model.Receipt, error) {
+	ret := _m.Called(ctx, transactionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByTransaction")
+	}
+
+	var r0 *model.Receipt
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Receipt, error)); ok {
+		return rf(ctx, transactionUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Receipt); ok {
+		r0 = rf(ctx, transactionUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Receipt)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, transactionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReceiptRepository_GetByTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByTransaction'
+type ReceiptRepository_GetByTransaction_Call struct {
+	*mock.Call
+}
+
+// GetByTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID string
+func (_e *ReceiptRepository_Expecter) GetByTransaction(ctx interface{}, transactionUUID interface{}) *ReceiptRepository_GetByTransaction_Call {
+	return &ReceiptRepository_GetByTransaction_Call{Call: _e.mock.On("GetByTransaction", ctx, transactionUUID)}
+}
+
+func (_c *ReceiptRepository_GetByTransaction_Call) Run(run func(ctx context.Context, transactionUUID string)) *ReceiptRepository_GetByTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ReceiptRepository_GetByTransaction_Call) Return(_a0 *model.Receipt, _a1 error) *ReceiptRepository_GetByTransaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReceiptRepository_GetByTransaction_Call) RunAndReturn(run func(context.Context, string) (*model.Receipt, error)) *ReceiptRepository_GetByTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReceiptRepository creates a new instance of ReceiptRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReceiptRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReceiptRepository {
+	mock := &ReceiptRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,480 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// TransactionRepository is an autogenerated mock type for the TransactionRepository type
+type TransactionRepository struct {
+	mock.Mock
+}
+
+type TransactionRepository_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TransactionRepository) EXPECT() *TransactionRepository_Expecter {
+	return &TransactionRepository_Expecter{mock: &_m.Mock}
+}
+
+// CountByUserSince provides a mock function with given fields: ctx, userUUID, since
+func (_m *TransactionRepository) CountByUserSince(ctx context.Context, userUUID string, since time.Time) (int, error) {
+	ret := _m.Called(ctx, userUUID, since)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByUserSince")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) (int, error)); ok {
+		return rf(ctx, userUUID, since)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) int); ok {
+		r0 = rf(ctx, userUUID, since)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, userUUID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransactionRepository_CountByUserSince_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CountByUserSince'
+type TransactionRepository_CountByUserSince_Call struct {
+	*mock.Call
+}
+
+// CountByUserSince is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - since time.Time
+func (_e *TransactionRepository_Expecter) CountByUserSince(ctx interface{}, userUUID interface{}, since interface{}) *TransactionRepository_CountByUserSince_Call {
+	return &TransactionRepository_CountByUserSince_Call{Call: _e.mock.On("CountByUserSince", ctx, userUUID, since)}
+}
+
+func (_c *TransactionRepository_CountByUserSince_Call) Run(run func(ctx context.Context, userUUID string, since time.Time)) *TransactionRepository_CountByUserSince_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_CountByUserSince_Call) Return(_a0 int, _a1 error) *TransactionRepository_CountByUserSince_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransactionRepository_CountByUserSince_Call) RunAndReturn(run func(context.Context, string, time.Time) (int, error)) *TransactionRepository_CountByUserSince_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Create provides a mock function with given fields: ctx, tx
+func (_m *TransactionRepository) Create(ctx context.Context, tx *model.Transaction) error {
+	ret := _m.Called(ctx, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Transaction) error); ok {
+		r0 = rf(ctx, tx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TransactionRepository_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type TransactionRepository_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx *model.Transaction
+func (_e *TransactionRepository_Expecter) Create(ctx interface{}, tx interface{}) *TransactionRepository_Create_Call {
+	return &TransactionRepository_Create_Call{Call: _e.mock.On("Create", ctx, tx)}
+}
+
+func (_c *TransactionRepository_Create_Call) Run(run func(ctx context.Context, tx *model.Transaction)) *TransactionRepository_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Transaction))
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_Create_Call) Return(_a0 error) *TransactionRepository_Create_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TransactionRepository_Create_Call) RunAndReturn(run func(context.Context, *model.Transaction) error) *TransactionRepository_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, transactionUUID
+func (_m *TransactionRepository) Get(ctx context.Context, transactionUUID string) (*model.Transaction, error) {
+	ret := _m.Called(ctx, transactionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Transaction, error)); ok {
+		return rf(ctx, transactionUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Transaction); ok {
+		r0 = rf(ctx, transactionUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, transactionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransactionRepository_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type TransactionRepository_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID string
+func (_e *TransactionRepository_Expecter) Get(ctx interface{}, transactionUUID interface{}) *TransactionRepository_Get_Call {
+	return &TransactionRepository_Get_Call{Call: _e.mock.On("Get", ctx, transactionUUID)}
+}
+
+func (_c *TransactionRepository_Get_Call) Run(run func(ctx context.Context, transactionUUID string)) *TransactionRepository_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_Get_Call) Return(_a0 *model.Transaction, _a1 error) *TransactionRepository_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransactionRepository_Get_Call) RunAndReturn(run func(context.Context, string) (*model.Transaction, error)) *TransactionRepository_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByUUIDs provides a mock function with given fields: ctx, transactionUUIDs
+func (_m *TransactionRepository) ListByUUIDs(ctx context.Context, transactionUUIDs []string) ([]*model.Transaction, error) {
+	ret := _m.Called(ctx, transactionUUIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByUUIDs")
+	}
+
+	var r0 []*model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]*model.Transaction, error)); ok {
+		return rf(ctx, transactionUUIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*model.Transaction); ok {
+		r0 = rf(ctx, transactionUUIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, transactionUUIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransactionRepository_ListByUUIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUUIDs'
+type TransactionRepository_ListByUUIDs_Call struct {
+	*mock.Call
+}
+
+// ListByUUIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUIDs []string
+func (_e *TransactionRepository_Expecter) ListByUUIDs(ctx interface{}, transactionUUIDs interface{}) *TransactionRepository_ListByUUIDs_Call {
+	return &TransactionRepository_ListByUUIDs_Call{Call: _e.mock.On("ListByUUIDs", ctx, transactionUUIDs)}
+}
+
+func (_c *TransactionRepository_ListByUUIDs_Call) Run(run func(ctx context.Context, transactionUUIDs []string)) *TransactionRepository_ListByUUIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_ListByUUIDs_Call) Return(_a0 []*model.Transaction, _a1 error) *TransactionRepository_ListByUUIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransactionRepository_ListByUUIDs_Call) RunAndReturn(run func(context.Context, []string) ([]*model.Transaction, error)) *TransactionRepository_ListByUUIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSucceeded provides a mock function with given fields: ctx, since, until
+func (_m *TransactionRepository) ListSucceeded(ctx context.Context, since time.Time, until time.Time) ([]*model.Transaction, error) {
+	ret := _m.Called(ctx, since, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSucceeded")
+	}
+
+	var r0 []*model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) ([]*model.Transaction, error)); ok {
+		return rf(ctx, since, until)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, time.Time) []*model.Transaction); ok {
+		r0 = rf(ctx, since, until)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, time.Time) error); ok {
+		r1 = rf(ctx, since, until)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransactionRepository_ListSucceeded_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSucceeded'
+type TransactionRepository_ListSucceeded_Call struct {
+	*mock.Call
+}
+
+// ListSucceeded is a helper method to define mock.On call
+//   - ctx context.Context
+//   - since time.Time
+//   - until time.Time
+func (_e *TransactionRepository_Expecter) ListSucceeded(ctx interface{}, since interface{}, until interface{}) *TransactionRepository_ListSucceeded_Call {
+	return &TransactionRepository_ListSucceeded_Call{Call: _e.mock.On("ListSucceeded", ctx, since, until)}
+}
+
+func (_c *TransactionRepository_ListSucceeded_Call) Run(run func(ctx context.Context, since time.Time, until time.Time)) *TransactionRepository_ListSucceeded_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(time.Time))
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_ListSucceeded_Call) Return(_a0 []*model.Transaction, _a1 error) *TransactionRepository_ListSucceeded_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransactionRepository_ListSucceeded_Call) RunAndReturn(run func(context.Context, time.Time, time.Time) ([]*model.Transaction, error)) *TransactionRepository_ListSucceeded_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUnpublished provides a mock function with given fields: ctx, before, limit
+func (_m *TransactionRepository) ListUnpublished(ctx context.Context, before time.Time, limit int) ([]*model.Transaction, error) {
+	ret := _m.Called(ctx, before, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListUnpublished")
+	}
+
+	var r0 []*model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) ([]*model.Transaction, error)); ok {
+		return rf(ctx, before, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, int) []*model.Transaction); ok {
+		r0 = rf(ctx, before, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, int) error); ok {
+		r1 = rf(ctx, before, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransactionRepository_ListUnpublished_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUnpublished'
+type TransactionRepository_ListUnpublished_Call struct {
+	*mock.Call
+}
+
+// ListUnpublished is a helper method to define mock.On call
+//   - ctx context.Context
+//   - before time.Time
+//   - limit int
+func (_e *TransactionRepository_Expecter) ListUnpublished(ctx interface{}, before interface{}, limit interface{}) *TransactionRepository_ListUnpublished_Call {
+	return &TransactionRepository_ListUnpublished_Call{Call: _e.mock.On("ListUnpublished", ctx, before, limit)}
+}
+
+func (_c *TransactionRepository_ListUnpublished_Call) Run(run func(ctx context.Context, before time.Time, limit int)) *TransactionRepository_ListUnpublished_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_ListUnpublished_Call) Return(_a0 []*model.Transaction, _a1 error) *TransactionRepository_ListUnpublished_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TransactionRepository_ListUnpublished_Call) RunAndReturn(run func(context.Context, time.Time, int) ([]*model.Transaction, error)) *TransactionRepository_ListUnpublished_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MarkResultPublished provides a mock function with given fields: ctx, transactionUUID
+func (_m *TransactionRepository) MarkResultPublished(ctx context.Context, transactionUUID string) error {
+	ret := _m.Called(ctx, transactionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MarkResultPublished")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, transactionUUID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TransactionRepository_MarkResultPublished_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkResultPublished'
+type TransactionRepository_MarkResultPublished_Call struct {
+	*mock.Call
+}
+
+// MarkResultPublished is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID string
+func (_e *TransactionRepository_Expecter) MarkResultPublished(ctx interface{}, transactionUUID interface{}) *TransactionRepository_MarkResultPublished_Call {
+	return &TransactionRepository_MarkResultPublished_Call{Call: _e.mock.On("MarkResultPublished", ctx, transactionUUID)}
+}
+
+func (_c *TransactionRepository_MarkResultPublished_Call) Run(run func(ctx context.Context, transactionUUID string)) *TransactionRepository_MarkResultPublished_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_MarkResultPublished_Call) Return(_a0 error) *TransactionRepository_MarkResultPublished_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TransactionRepository_MarkResultPublished_Call) RunAndReturn(run func(context.Context, string) error) *TransactionRepository_MarkResultPublished_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStatus provides a mock function with given fields: ctx, transactionUUID, status, failureReason
+func (_m *TransactionRepository) UpdateStatus(ctx context.Context, transactionUUID string, status model.PaymentStatus, failureReason string) error {
+	ret := _m.Called(ctx, transactionUUID, status, failureReason)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.PaymentStatus, string) error); ok {
+		r0 = rf(ctx, transactionUUID, status, failureReason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TransactionRepository_UpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatus'
+type TransactionRepository_UpdateStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID string
+//   - status model.PaymentStatus
+//   - failureReason string
+func (_e *TransactionRepository_Expecter) UpdateStatus(ctx interface{}, transactionUUID interface{}, status interface{}, failureReason interface{}) *TransactionRepository_UpdateStatus_Call {
+	return &TransactionRepository_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", ctx, transactionUUID, status, failureReason)}
+}
+
+func (_c *TransactionRepository_UpdateStatus_Call) Run(run func(ctx context.Context, transactionUUID string, status model.PaymentStatus, failureReason string)) *TransactionRepository_UpdateStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(model.PaymentStatus), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *TransactionRepository_UpdateStatus_Call) Return(_a0 error) *TransactionRepository_UpdateStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TransactionRepository_UpdateStatus_Call) RunAndReturn(run func(context.Context, string, model.PaymentStatus, string) error) *TransactionRepository_UpdateStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewTransactionRepository creates a new instance of TransactionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTransactionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TransactionRepository {
+	mock := &TransactionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
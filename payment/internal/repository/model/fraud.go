@@ -0,0 +1,20 @@
+package model
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/shopspring/decimal"
+)
+
+type FraudDecision struct {
+	DecisionUUID    string          `db:"decision_uuid"`
+	TransactionUUID string          `db:"transaction_uuid"`
+	OrderUUID       string          `db:"order_uuid"`
+	UserUUID        string          `db:"user_uuid"`
+	PaymentMethod   string          `db:"payment_method"`
+	Amount          decimal.Decimal `db:"amount"`
+	Verdict         string          `db:"verdict"`
+	Reasons         pq.StringArray  `db:"reasons"`
+	CreatedAt       time.Time       `db:"created_at"`
+}
@@ -0,0 +1,41 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type InstallmentPlan struct {
+	PlanUUID        string          `db:"plan_uuid"`
+	TransactionUUID string          `db:"transaction_uuid"`
+	OrderUUID       string          `db:"order_uuid"`
+	UserUUID        string          `db:"user_uuid"`
+	TotalAmount     decimal.Decimal `db:"total_amount"`
+	Status          string          `db:"status"`
+	CreatedAt       time.Time       `db:"created_at"`
+}
+
+type Installment struct {
+	InstallmentUUID string          `db:"installment_uuid"`
+	PlanUUID        string          `db:"plan_uuid"`
+	Number          int32           `db:"number"`
+	Amount          decimal.Decimal `db:"amount"`
+	DueDate         time.Time       `db:"due_date"`
+	Status          string          `db:"status"`
+	Attempts        int32           `db:"attempts"`
+	FailureReason   sql.NullString  `db:"failure_reason"`
+	PaidAt          sql.NullTime    `db:"paid_at"`
+}
+
+// DueInstallment - платеж к списанию вместе с полями плана (результат JOIN)
+type DueInstallment struct {
+	Installment
+	TransactionUUID string          `db:"transaction_uuid"`
+	OrderUUID       string          `db:"order_uuid"`
+	UserUUID        string          `db:"user_uuid"`
+	TotalAmount     decimal.Decimal `db:"total_amount"`
+	PlanStatus      string          `db:"plan_status"`
+	PlanCreatedAt   time.Time       `db:"plan_created_at"`
+}
@@ -0,0 +1,21 @@
+package model
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type AccountBalance struct {
+	AccountUUID string          `db:"account_uuid"`
+	Balance     decimal.Decimal `db:"balance"`
+}
+
+type StatementLine struct {
+	EntryUUID    string          `db:"entry_uuid"`
+	EntryType    string          `db:"entry_type"`
+	Amount       decimal.Decimal `db:"amount"`
+	BalanceAfter decimal.Decimal `db:"balance_after"`
+	Reference    string          `db:"reference"`
+	CreatedAt    time.Time       `db:"created_at"`
+}
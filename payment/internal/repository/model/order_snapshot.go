@@ -0,0 +1,17 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type OrderSnapshot struct {
+	OrderUUID       string              `db:"order_uuid"`
+	UserUUID        string              `db:"user_uuid"`
+	TotalPrice      decimal.NullDecimal `db:"total_price"`
+	TransactionUUID sql.NullString      `db:"transaction_uuid"`
+	OrderStatus     string              `db:"order_status"`
+	UpdatedAt       time.Time           `db:"updated_at"`
+}
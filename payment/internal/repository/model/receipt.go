@@ -0,0 +1,83 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type Receipt struct {
+	ReceiptUUID     string          `db:"receipt_uuid"`
+	TransactionUUID string          `db:"transaction_uuid"`
+	OrderUUID       string          `db:"order_uuid"`
+	UserUUID        string          `db:"user_uuid"`
+	PaymentMethod   string          `db:"payment_method"`
+	Items           ReceiptItems    `db:"items"`
+	VatRate         decimal.Decimal `db:"vat_rate"`
+	VatAmount       decimal.Decimal `db:"vat_amount"`
+	Total           decimal.Decimal `db:"total"`
+	TextBody        string          `db:"text_body"`
+	HTMLBody        string          `db:"html_body"`
+	IssuedAt        time.Time       `db:"issued_at"`
+}
+
+type PaymentItem struct {
+	PartUUID  string          `json:"part_uuid"`
+	Name      string          `json:"name"`
+	Quantity  int32           `json:"quantity"`
+	UnitPrice decimal.Decimal `json:"unit_price"`
+}
+
+type ReceiptItem struct {
+	PartUUID  string          `json:"part_uuid"`
+	Name      string          `json:"name"`
+	Quantity  int32           `json:"quantity"`
+	UnitPrice decimal.Decimal `json:"unit_price"`
+	Total     decimal.Decimal `json:"total"`
+	VatAmount decimal.Decimal `json:"vat_amount"`
+}
+
+// PaymentItems - позиции заказа, хранятся в JSONB колонке
+type PaymentItems []PaymentItem
+
+func (i PaymentItems) Value() (driver.Value, error) {
+	return marshalJSONB(i)
+}
+
+func (i *PaymentItems) Scan(src any) error {
+	return unmarshalJSONB(src, i)
+}
+
+// ReceiptItems - позиции чека, хранятся в JSONB колонке
+type ReceiptItems []ReceiptItem
+
+func (i ReceiptItems) Value() (driver.Value, error) {
+	return marshalJSONB(i)
+}
+
+func (i *ReceiptItems) Scan(src any) error {
+	return unmarshalJSONB(src, i)
+}
+
+func marshalJSONB[T any](items []T) (driver.Value, error) {
+	if items == nil {
+		return []byte("[]"), nil
+	}
+	return json.Marshal(items)
+}
+
+func unmarshalJSONB(src, dst any) error {
+	switch v := src.(type) {
+	case nil:
+		return nil
+	case []byte:
+		return json.Unmarshal(v, dst)
+	case string:
+		return json.Unmarshal([]byte(v), dst)
+	default:
+		return fmt.Errorf("unsupported JSONB source type %T", src)
+	}
+}
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type Transaction struct {
+	TransactionUUID string              `db:"transaction_uuid"`
+	OrderUUID       string              `db:"order_uuid"`
+	UserUUID        string              `db:"user_uuid"`
+	PaymentMethod   string              `db:"payment_method"`
+	Amount          decimal.NullDecimal `db:"amount"`
+	Items           PaymentItems        `db:"items"`
+	Status          string              `db:"status"`
+	FailureReason   sql.NullString      `db:"failure_reason"`
+	Installments    int32               `db:"installments"`
+	CreatedAt       time.Time           `db:"created_at"`
+	UpdatedAt       time.Time           `db:"updated_at"`
+
+	ResultPublishedAt sql.NullTime `db:"result_published_at"`
+}
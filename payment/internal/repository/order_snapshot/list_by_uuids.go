@@ -0,0 +1,42 @@
+package order_snapshot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/converter"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/model"
+)
+
+func (r *repository) ListByUUIDs(ctx context.Context, orderUUIDs []string) ([]*model.OrderSnapshot, error) {
+	if len(orderUUIDs) == 0 {
+		return []*model.OrderSnapshot{}, nil
+	}
+
+	query := `
+		SELECT
+			order_uuid,
+			user_uuid,
+			total_price,
+			transaction_uuid,
+			order_status,
+			updated_at
+		FROM order_snapshots
+		WHERE order_uuid::text = ANY($1)
+	`
+
+	var repoOrders []*repoModel.OrderSnapshot
+	if err := r.db.SelectContext(ctx, &repoOrders, query, pq.StringArray(orderUUIDs)); err != nil {
+		return nil, fmt.Errorf("failed to list order snapshots: %w", err)
+	}
+
+	orders := make([]*model.OrderSnapshot, 0, len(repoOrders))
+	for _, order := range repoOrders {
+		orders = append(orders, converter.RepoOrderSnapshotToModel(order))
+	}
+
+	return orders, nil
+}
@@ -0,0 +1,38 @@
+package order_snapshot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/converter"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/model"
+)
+
+func (r *repository) ListPaid(ctx context.Context, since, until time.Time) ([]*model.OrderSnapshot, error) {
+	query := `
+		SELECT
+			order_uuid,
+			user_uuid,
+			total_price,
+			transaction_uuid,
+			order_status,
+			updated_at
+		FROM order_snapshots
+		WHERE updated_at >= $1 AND updated_at < $2
+		ORDER BY updated_at
+	`
+
+	var repoOrders []*repoModel.OrderSnapshot
+	if err := r.db.SelectContext(ctx, &repoOrders, query, since, until); err != nil {
+		return nil, fmt.Errorf("failed to list paid order snapshots: %w", err)
+	}
+
+	orders := make([]*model.OrderSnapshot, 0, len(repoOrders))
+	for _, order := range repoOrders {
+		orders = append(orders, converter.RepoOrderSnapshotToModel(order))
+	}
+
+	return orders, nil
+}
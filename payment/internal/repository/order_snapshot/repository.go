@@ -0,0 +1,20 @@
+package order_snapshot
+
+import (
+	"github.com/jmoiron/sqlx"
+
+	def "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository"
+)
+
+var _ def.OrderSnapshotRepository = (*repository)(nil)
+
+// repository хранит снимки оплаченных заказов, собранные из событий OrderPaid
+type repository struct {
+	db *sqlx.DB
+}
+
+func NewRepository(db *sqlx.DB) *repository {
+	return &repository{
+		db: db,
+	}
+}
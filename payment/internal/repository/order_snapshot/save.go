@@ -0,0 +1,34 @@
+package order_snapshot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/converter"
+)
+
+func (r *repository) Save(ctx context.Context, order *model.OrderSnapshot) error {
+	query := `
+		INSERT INTO order_snapshots (
+			order_uuid,
+			user_uuid,
+			total_price,
+			transaction_uuid,
+			order_status
+		) VALUES (
+			:order_uuid,
+			:user_uuid,
+			:total_price,
+			:transaction_uuid,
+			:order_status
+		)
+		ON CONFLICT (order_uuid) DO NOTHING
+	`
+
+	if _, err := r.db.NamedExecContext(ctx, query, converter.ModelOrderSnapshotToRepo(order)); err != nil {
+		return fmt.Errorf("failed to save order snapshot: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package receipt
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/converter"
+)
+
+func (r *repository) Create(ctx context.Context, receipt *model.Receipt) error {
+	repoReceipt := converter.ReceiptToRepoModel(receipt)
+
+	query := `
+        INSERT INTO receipts (
+            receipt_uuid,
+            transaction_uuid,
+            order_uuid,
+            user_uuid,
+            payment_method,
+            items,
+            vat_rate,
+            vat_amount,
+            total,
+            text_body,
+            html_body,
+            issued_at
+        ) VALUES (
+            :receipt_uuid,
+            :transaction_uuid,
+            :order_uuid,
+            :user_uuid,
+            :payment_method,
+            :items,
+            :vat_rate,
+            :vat_amount,
+            :total,
+            :text_body,
+            :html_body,
+            :issued_at
+        )
+        ON CONFLICT (transaction_uuid) DO NOTHING
+    `
+
+	result, err := r.db.NamedExecContext(ctx, query, repoReceipt)
+	if err != nil {
+		return fmt.Errorf("failed to insert receipt: %w", err)
+	}
+
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get affected rows: %w", err)
+	}
+	if rows == 0 {
+		return model.ErrReceiptAlreadyExists
+	}
+
+	return nil
+}
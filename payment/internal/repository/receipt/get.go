@@ -0,0 +1,43 @@
+package receipt
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/converter"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/model"
+)
+
+func (r *repository) GetByTransaction(ctx context.Context, transactionUUID string) (*model.Receipt, error) {
+	query := `
+		SELECT
+			receipt_uuid,
+			transaction_uuid,
+			order_uuid,
+			user_uuid,
+			payment_method,
+			items,
+			vat_rate,
+			vat_amount,
+			total,
+			text_body,
+			html_body,
+			issued_at
+		FROM receipts
+		WHERE transaction_uuid = $1;
+`
+
+	var repoReceipt repoModel.Receipt
+	err := r.db.QueryRowxContext(ctx, query, transactionUUID).StructScan(&repoReceipt)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrReceiptNotFound
+		}
+		return nil, fmt.Errorf("failed to get receipt: %w", err)
+	}
+
+	return converter.RepoReceiptToModel(&repoReceipt), nil
+}
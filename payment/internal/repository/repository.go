@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+type TransactionRepository interface {
+	Create(ctx context.Context, tx *model.Transaction) error
+	Get(ctx context.Context, transactionUUID string) (*model.Transaction, error)
+	// UpdateStatus переводит транзакцию из PENDING в итоговый статус
+	UpdateStatus(ctx context.Context, transactionUUID string, status model.PaymentStatus, failureReason string) error
+	// CountByUserSince возвращает количество транзакций пользователя, созданных после since
+	CountByUserSince(ctx context.Context, userUUID string, since time.Time) (int, error)
+	// ListSucceeded возвращает успешные транзакции, созданные в периоде [since, until)
+	ListSucceeded(ctx context.Context, since, until time.Time) ([]*model.Transaction, error)
+	ListByUUIDs(ctx context.Context, transactionUUIDs []string) ([]*model.Transaction, error)
+	// ListUnpublished возвращает завершенные транзакции, событие о которых еще не опубликовано,
+	// со сменой статуса раньше before
+	ListUnpublished(ctx context.Context, before time.Time, limit int) ([]*model.Transaction, error)
+	// MarkResultPublished отмечает, что событие об итоговом статусе транзакции опубликовано
+	MarkResultPublished(ctx context.Context, transactionUUID string) error
+}
+
+type LedgerRepository interface {
+	// TopUp пополняет счет инвестора; повтор с тем же reference возвращает исходную проводку
+	TopUp(ctx context.Context, req *model.TopUpRequest) (*model.TopUpResult, error)
+	// Debit атомарно списывает сумму со счета инвестора, ErrInsufficientFunds при нехватке средств
+	Debit(ctx context.Context, userUUID string, amount float64, reference string) error
+	GetBalance(ctx context.Context, userUUID string) (float64, error)
+	GetStatement(ctx context.Context, req *model.StatementRequest) ([]*model.StatementLine, error)
+}
+
+type FraudDecisionRepository interface {
+	Create(ctx context.Context, decision *model.FraudDecision) error
+	// ListFlagged возвращает решения REVIEW от новых к старым
+	ListFlagged(ctx context.Context, limit, offset int) ([]*model.FraudDecision, error)
+}
+
+type ReceiptRepository interface {
+	// Create сохраняет чек, ErrReceiptAlreadyExists если чек по транзакции уже выдан
+	Create(ctx context.Context, receipt *model.Receipt) error
+	GetByTransaction(ctx context.Context, transactionUUID string) (*model.Receipt, error)
+}
+
+type InstallmentRepository interface {
+	// CreatePlan сохраняет план вместе с графиком платежей в одной транзакции
+	CreatePlan(ctx context.Context, plan *model.InstallmentPlan) error
+	GetPlanByTransaction(ctx context.Context, transactionUUID string) (*model.InstallmentPlan, error)
+	// ListPlansByUser возвращает планы пользователя от новых к старым
+	ListPlansByUser(ctx context.Context, userUUID string, limit, offset int) ([]*model.InstallmentPlan, error)
+	// ClaimDue закрепляет неоплаченные платежи активных планов со сроком не позже now:
+	// строки блокируются с SKIP LOCKED, следующая попытка сдвигается на leaseUntil,
+	// поэтому другая реплика не спишет тот же платеж
+	ClaimDue(ctx context.Context, now, leaseUntil time.Time, limit int) ([]*model.DueInstallment, error)
+	MarkPaid(ctx context.Context, installmentUUID string, paidAt time.Time) error
+	// MarkMissed фиксирует неудачную попытку списания: MISSED с повтором не раньше nextAttemptAt
+	// или FAILED, если попытки исчерпаны
+	MarkMissed(ctx context.Context, installmentUUID string, status model.InstallmentStatus, reason string, nextAttemptAt time.Time) error
+	UpdatePlanStatus(ctx context.Context, planUUID string, status model.InstallmentPlanStatus) error
+	// CompleteIfAllPaid переводит план в COMPLETED, если все платежи списаны
+	CompleteIfAllPaid(ctx context.Context, planUUID string) (bool, error)
+}
+
+// OrderSnapshotRepository хранит снимки оплаченных заказов order сервиса для сверки
+type OrderSnapshotRepository interface {
+	// Save сохраняет снимок оплаченного заказа; повторное событие по тому же заказу игнорируется
+	Save(ctx context.Context, order *model.OrderSnapshot) error
+	// ListPaid возвращает оплаченные заказы, обновленные в периоде [since, until)
+	ListPaid(ctx context.Context, since, until time.Time) ([]*model.OrderSnapshot, error)
+	ListByUUIDs(ctx context.Context, orderUUIDs []string) ([]*model.OrderSnapshot, error)
+}
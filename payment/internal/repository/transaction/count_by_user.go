@@ -0,0 +1,22 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+func (r *repository) CountByUserSince(ctx context.Context, userUUID string, since time.Time) (int, error) {
+	query := `
+		SELECT COUNT(*)
+		FROM transactions
+		WHERE user_uuid = $1 AND created_at >= $2
+	`
+
+	var count int
+	if err := r.db.GetContext(ctx, &count, query, userUUID, since); err != nil {
+		return 0, fmt.Errorf("failed to count user transactions: %w", err)
+	}
+
+	return count, nil
+}
@@ -0,0 +1,44 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/converter"
+)
+
+func (r *repository) Create(ctx context.Context, tx *model.Transaction) error {
+	repoTx := converter.TransactionToRepoModel(tx)
+
+	query := `
+        INSERT INTO transactions (
+            transaction_uuid,
+            order_uuid,
+            user_uuid,
+            payment_method,
+            amount,
+            items,
+            status,
+            failure_reason,
+            installments
+        ) VALUES (
+            :transaction_uuid,
+            :order_uuid,
+            :user_uuid,
+            :payment_method,
+            :amount,
+            :items,
+            :status,
+            :failure_reason,
+            :installments
+        )
+    `
+
+	_, err := r.db.NamedExecContext(ctx, query, repoTx)
+	if err != nil {
+		return fmt.Errorf("failed to insert transaction: %w", err)
+	}
+
+	return nil
+}
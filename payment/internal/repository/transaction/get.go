@@ -0,0 +1,43 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/converter"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/model"
+)
+
+func (r *repository) Get(ctx context.Context, transactionUUID string) (*model.Transaction, error) {
+	query := `
+		SELECT
+			transaction_uuid,
+			order_uuid,
+			user_uuid,
+			payment_method,
+			amount,
+			items,
+			status,
+			failure_reason,
+			installments,
+			created_at,
+			updated_at,
+			result_published_at
+		FROM transactions
+		WHERE transaction_uuid = $1;
+`
+
+	var repoTx repoModel.Transaction
+	err := r.db.QueryRowxContext(ctx, query, transactionUUID).StructScan(&repoTx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, model.ErrTransactionNotFound
+		}
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	return converter.RepoTransactionToModel(&repoTx), nil
+}
@@ -0,0 +1,46 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lib/pq"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/converter"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/model"
+)
+
+func (r *repository) ListByUUIDs(ctx context.Context, transactionUUIDs []string) ([]*model.Transaction, error) {
+	if len(transactionUUIDs) == 0 {
+		return []*model.Transaction{}, nil
+	}
+
+	query := `
+		SELECT
+			transaction_uuid,
+			order_uuid,
+			user_uuid,
+			payment_method,
+			amount,
+			status,
+			failure_reason,
+			installments,
+			created_at,
+			updated_at
+		FROM transactions
+		WHERE transaction_uuid::text = ANY($1)
+	`
+
+	var repoTxs []*repoModel.Transaction
+	if err := r.db.SelectContext(ctx, &repoTxs, query, pq.StringArray(transactionUUIDs)); err != nil {
+		return nil, fmt.Errorf("failed to list transactions: %w", err)
+	}
+
+	txs := make([]*model.Transaction, 0, len(repoTxs))
+	for _, tx := range repoTxs {
+		txs = append(txs, converter.RepoTransactionToModel(tx))
+	}
+
+	return txs, nil
+}
@@ -0,0 +1,42 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/converter"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/model"
+)
+
+func (r *repository) ListSucceeded(ctx context.Context, since, until time.Time) ([]*model.Transaction, error) {
+	query := `
+		SELECT
+			transaction_uuid,
+			order_uuid,
+			user_uuid,
+			payment_method,
+			amount,
+			status,
+			failure_reason,
+			installments,
+			created_at,
+			updated_at
+		FROM transactions
+		WHERE status = 'SUCCEEDED' AND created_at >= $1 AND created_at < $2
+		ORDER BY created_at
+	`
+
+	var repoTxs []*repoModel.Transaction
+	if err := r.db.SelectContext(ctx, &repoTxs, query, since, until); err != nil {
+		return nil, fmt.Errorf("failed to list succeeded transactions: %w", err)
+	}
+
+	txs := make([]*model.Transaction, 0, len(repoTxs))
+	for _, tx := range repoTxs {
+		txs = append(txs, converter.RepoTransactionToModel(tx))
+	}
+
+	return txs, nil
+}
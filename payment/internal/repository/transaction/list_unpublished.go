@@ -0,0 +1,45 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/converter"
+	repoModel "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/model"
+)
+
+func (r *repository) ListUnpublished(ctx context.Context, before time.Time, limit int) ([]*model.Transaction, error) {
+	query := `
+		SELECT
+			transaction_uuid,
+			order_uuid,
+			user_uuid,
+			payment_method,
+			amount,
+			items,
+			status,
+			failure_reason,
+			installments,
+			created_at,
+			updated_at,
+			result_published_at
+		FROM transactions
+		WHERE status <> 'PENDING' AND result_published_at IS NULL AND updated_at < $1
+		ORDER BY updated_at
+		LIMIT $2
+	`
+
+	var repoTxs []*repoModel.Transaction
+	if err := r.db.SelectContext(ctx, &repoTxs, query, before, limit); err != nil {
+		return nil, fmt.Errorf("failed to list unpublished transactions: %w", err)
+	}
+
+	txs := make([]*model.Transaction, 0, len(repoTxs))
+	for _, tx := range repoTxs {
+		txs = append(txs, converter.RepoTransactionToModel(tx))
+	}
+
+	return txs, nil
+}
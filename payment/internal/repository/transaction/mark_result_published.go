@@ -0,0 +1,20 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+)
+
+func (r *repository) MarkResultPublished(ctx context.Context, transactionUUID string) error {
+	query := `
+		UPDATE transactions
+		SET result_published_at = NOW()
+		WHERE transaction_uuid = $1 AND result_published_at IS NULL
+	`
+
+	if _, err := r.db.ExecContext(ctx, query, transactionUUID); err != nil {
+		return fmt.Errorf("failed to mark transaction result published: %w", err)
+	}
+
+	return nil
+}
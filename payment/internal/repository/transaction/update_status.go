@@ -0,0 +1,51 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/converter"
+)
+
+func (r *repository) UpdateStatus(ctx context.Context, transactionUUID string, status model.PaymentStatus, failureReason string) error {
+	var reason sql.NullString
+	if failureReason != "" {
+		reason = sql.NullString{String: failureReason, Valid: true}
+	}
+
+	// Условие на PENDING защищает от гонки двух callback'ов по одной транзакции
+	query := `
+		UPDATE transactions
+		SET
+			status = $2,
+			failure_reason = $3,
+			updated_at = NOW()
+		WHERE transaction_uuid = $1 AND status = 'PENDING'
+	`
+
+	result, err := r.db.ExecContext(ctx, query, transactionUUID, converter.PaymentStatusToRepo(status), reason)
+	if err != nil {
+		return fmt.Errorf("failed to update transaction status: %w", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected > 0 {
+		return nil
+	}
+
+	var exists bool
+	err = r.db.GetContext(ctx, &exists, `SELECT EXISTS(SELECT 1 FROM transactions WHERE transaction_uuid = $1)`, transactionUUID)
+	if err != nil {
+		return fmt.Errorf("failed to check transaction: %w", err)
+	}
+	if !exists {
+		return model.ErrTransactionNotFound
+	}
+
+	return model.ErrPaymentAlreadyProcessed
+}
@@ -0,0 +1,46 @@
+package order_consumer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	kafkaConverter "github.com/Daniil-Sakharov/RocketFactory/payment/internal/converter/kafka"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository"
+	def "github.com/Daniil-Sakharov/RocketFactory/payment/internal/service"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/kafka"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+var _ def.OrderConsumerService = (*service)(nil)
+
+// service собирает снимки оплаченных заказов для сверки из событий OrderPaid
+type service struct {
+	orderPaidConsumer       kafka.Consumer
+	orderPaidDecoder        kafkaConverter.OrderPaidDecoder
+	orderSnapshotRepository repository.OrderSnapshotRepository
+}
+
+func NewService(
+	orderPaidConsumer kafka.Consumer,
+	orderPaidDecoder kafkaConverter.OrderPaidDecoder,
+	orderSnapshotRepository repository.OrderSnapshotRepository,
+) *service {
+	return &service{
+		orderPaidConsumer:       orderPaidConsumer,
+		orderPaidDecoder:        orderPaidDecoder,
+		orderSnapshotRepository: orderSnapshotRepository,
+	}
+}
+
+func (s *service) RunConsumer(ctx context.Context) error {
+	logger.Info(ctx, "🚀 Starting OrderPaid consumer service")
+
+	err := s.orderPaidConsumer.Consume(ctx, s.handleOrderPaid)
+	if err != nil {
+		logger.Error(ctx, "❌ Failed to consume from order.paid topic", zap.Error(err))
+		return err
+	}
+
+	return nil
+}
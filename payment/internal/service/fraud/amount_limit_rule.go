@@ -0,0 +1,58 @@
+package fraud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+type amountLimitRule struct {
+	method  model.PaymentMethod
+	limit   float64
+	verdict model.FraudVerdict
+}
+
+// NewAmountLimitRule срабатывает, если сумма, списываемая указанным методом сразу, превышает limit.
+// Для рассрочки сразу списывается только первый платеж, поэтому лимит сравнивается с ним
+func NewAmountLimitRule(method model.PaymentMethod, limit float64, verdict model.FraudVerdict) *amountLimitRule {
+	return &amountLimitRule{
+		method:  method,
+		limit:   limit,
+		verdict: verdict,
+	}
+}
+
+func (r *amountLimitRule) Name() string {
+	return "amount_limit"
+}
+
+func (r *amountLimitRule) Evaluate(_ context.Context, check *model.FraudCheck) (*model.FraudRuleResult, error) {
+	if check.PaymentMethod != r.method {
+		return nil, nil
+	}
+
+	amount := chargedAmount(check)
+	if amount <= r.limit {
+		return nil, nil
+	}
+
+	return &model.FraudRuleResult{
+		Rule:    r.Name(),
+		Verdict: r.verdict,
+		Reason:  fmt.Sprintf("amount %.2f exceeds limit %.2f", amount, r.limit),
+	}, nil
+}
+
+// chargedAmount возвращает сумму, которая списывается сразу. Первый платеж рассрочки
+// считается так же, как в графике: сумма делится на количество платежей с округлением вниз до копейки
+func chargedAmount(check *model.FraudCheck) float64 {
+	if check.Installments <= 1 {
+		return check.Amount
+	}
+
+	total := decimal.NewFromFloat(check.Amount).Round(2)
+	return total.Div(decimal.NewFromInt32(check.Installments)).RoundDown(2).InexactFloat64()
+}
@@ -0,0 +1,59 @@
+package fraud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+// Evaluate применяет все правила к платежу. Итоговое решение - самое строгое из сработавших,
+// если ни одно правило не сработало, платеж разрешается
+func (s *svc) Evaluate(ctx context.Context, check *model.FraudCheck) (*model.FraudDecision, error) {
+	decision := &model.FraudDecision{
+		DecisionUUID:    uuid.NewString(),
+		TransactionUUID: check.TransactionUUID,
+		OrderUUID:       check.OrderUUID,
+		UserUUID:        check.UserUUID,
+		PaymentMethod:   check.PaymentMethod,
+		Amount:          check.Amount,
+		Verdict:         model.FraudVerdictApprove,
+		Reasons:         []string{},
+		CreatedAt:       time.Now(),
+	}
+
+	for _, rule := range s.rules {
+		result, err := rule.Evaluate(ctx, check)
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate fraud rule %s: %w", rule.Name(), err)
+		}
+		if result == nil {
+			continue
+		}
+
+		decision.Reasons = append(decision.Reasons, fmt.Sprintf("%s: %s", result.Rule, result.Reason))
+		if result.Verdict > decision.Verdict {
+			decision.Verdict = result.Verdict
+		}
+	}
+
+	if err := s.decisionRepository.Create(ctx, decision); err != nil {
+		return nil, fmt.Errorf("failed to save fraud decision: %w", err)
+	}
+
+	if decision.Verdict != model.FraudVerdictApprove {
+		logger.Warn(ctx, "🚨 Антифрод-проверка сработала",
+			zap.String("transaction_uuid", decision.TransactionUUID),
+			zap.String("user_uuid", decision.UserUUID),
+			zap.Int32("verdict", int32(decision.Verdict)),
+			zap.Strings("reasons", decision.Reasons),
+		)
+	}
+
+	return decision, nil
+}
@@ -0,0 +1,134 @@
+package fraud
+
+import (
+	"errors"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+func (s *ServiceSuite) TestEvaluateApprove() {
+	var (
+		check = &model.FraudCheck{
+			TransactionUUID: gofakeit.UUID(),
+			OrderUUID:       gofakeit.UUID(),
+			UserUUID:        gofakeit.UUID(),
+			PaymentMethod:   model.PaymentMethodCreditCard,
+			Amount:          1_000,
+		}
+
+		service = New(s.decisionRepository,
+			NewAmountLimitRule(model.PaymentMethodCreditCard, 5_000, model.FraudVerdictReject),
+			NewOwnerMismatchRule(model.FraudVerdictReview),
+		)
+	)
+
+	s.decisionRepository.On("Create", s.ctx, mock.MatchedBy(func(decision *model.FraudDecision) bool {
+		return decision.TransactionUUID == check.TransactionUUID &&
+			decision.Verdict == model.FraudVerdictApprove &&
+			len(decision.Reasons) == 0
+	})).Return(nil)
+
+	decision, err := service.Evaluate(s.ctx, check)
+
+	s.Require().NoError(err)
+	s.Require().Equal(model.FraudVerdictApprove, decision.Verdict)
+}
+
+func (s *ServiceSuite) TestEvaluateStrictestVerdictWins() {
+	var (
+		check = &model.FraudCheck{
+			TransactionUUID: gofakeit.UUID(),
+			OrderUUID:       gofakeit.UUID(),
+			UserUUID:        gofakeit.UUID(),
+			OrderOwnerUUID:  gofakeit.UUID(),
+			PaymentMethod:   model.PaymentMethodCreditCard,
+			Amount:          10_000,
+		}
+
+		service = New(s.decisionRepository,
+			NewOwnerMismatchRule(model.FraudVerdictReview),
+			NewAmountLimitRule(model.PaymentMethodCreditCard, 5_000, model.FraudVerdictReject),
+		)
+	)
+
+	s.decisionRepository.On("Create", s.ctx, mock.AnythingOfType("*model.FraudDecision")).Return(nil)
+
+	decision, err := service.Evaluate(s.ctx, check)
+
+	s.Require().NoError(err)
+	s.Require().Equal(model.FraudVerdictReject, decision.Verdict)
+	s.Require().Len(decision.Reasons, 2)
+	s.Require().Contains(decision.Reasons[0], "owner_mismatch")
+	s.Require().Contains(decision.Reasons[1], "amount_limit")
+}
+
+func (s *ServiceSuite) TestEvaluateRuleError() {
+	var (
+		check = &model.FraudCheck{
+			TransactionUUID: gofakeit.UUID(),
+			UserUUID:        gofakeit.UUID(),
+			PaymentMethod:   model.PaymentMethodCard,
+		}
+
+		service = New(s.decisionRepository,
+			NewVelocityRule(s.transactionRepository, 3, model.FraudVerdictReview),
+		)
+	)
+
+	s.transactionRepository.On("CountByUserSince", s.ctx, check.UserUUID, mock.AnythingOfType("time.Time")).
+		Return(0, errors.New("db error"))
+
+	decision, err := service.Evaluate(s.ctx, check)
+
+	s.Require().Error(err)
+	s.Require().Nil(decision)
+	s.decisionRepository.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestListFlaggedDefaultLimit() {
+	service := New(s.decisionRepository)
+
+	s.decisionRepository.On("ListFlagged", s.ctx, defaultFlaggedLimit, 0).
+		Return([]*model.FraudDecision{{DecisionUUID: gofakeit.UUID(), Verdict: model.FraudVerdictReview}}, nil)
+
+	decisions, err := service.ListFlagged(s.ctx, 0, -1)
+
+	s.Require().NoError(err)
+	s.Require().Len(decisions, 1)
+}
+
+func (s *ServiceSuite) TestEvaluateInstallmentsUnderDefaultCreditCardLimit() {
+	// Лимит по умолчанию из FRAUD_CREDIT_CARD_AMOUNT_LIMIT
+	const defaultCreditCardLimit = 500_000
+
+	service := New(s.decisionRepository,
+		NewAmountLimitRule(model.PaymentMethodCreditCard, defaultCreditCardLimit, model.FraudVerdictReject),
+	)
+	s.decisionRepository.On("Create", s.ctx, mock.AnythingOfType("*model.FraudDecision")).Return(nil)
+
+	// Заказ на 1 200 000 в три платежа: сразу списывается 400 000, это в пределах лимита
+	decision, err := service.Evaluate(s.ctx, &model.FraudCheck{
+		TransactionUUID: gofakeit.UUID(),
+		OrderUUID:       gofakeit.UUID(),
+		UserUUID:        gofakeit.UUID(),
+		PaymentMethod:   model.PaymentMethodCreditCard,
+		Amount:          1_200_000,
+		Installments:    3,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(model.FraudVerdictApprove, decision.Verdict)
+
+	// Та же сумма одним платежом превышает лимит
+	decision, err = service.Evaluate(s.ctx, &model.FraudCheck{
+		TransactionUUID: gofakeit.UUID(),
+		OrderUUID:       gofakeit.UUID(),
+		UserUUID:        gofakeit.UUID(),
+		PaymentMethod:   model.PaymentMethodCreditCard,
+		Amount:          1_200_000,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(model.FraudVerdictReject, decision.Verdict)
+}
@@ -0,0 +1,28 @@
+package fraud
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+// ListFlagged возвращает решения REVIEW от новых к старым
+func (s *svc) ListFlagged(ctx context.Context, limit, offset int) ([]*model.FraudDecision, error) {
+	switch {
+	case limit <= 0:
+		limit = defaultFlaggedLimit
+	case limit > maxFlaggedLimit:
+		limit = maxFlaggedLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	decisions, err := s.decisionRepository.ListFlagged(ctx, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list flagged payments: %w", err)
+	}
+
+	return decisions, nil
+}
@@ -0,0 +1,34 @@
+package fraud
+
+import (
+	"context"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+type ownerMismatchRule struct {
+	verdict model.FraudVerdict
+}
+
+// NewOwnerMismatchRule срабатывает, если заказ оплачивает не его владелец
+func NewOwnerMismatchRule(verdict model.FraudVerdict) *ownerMismatchRule {
+	return &ownerMismatchRule{
+		verdict: verdict,
+	}
+}
+
+func (r *ownerMismatchRule) Name() string {
+	return "owner_mismatch"
+}
+
+func (r *ownerMismatchRule) Evaluate(_ context.Context, check *model.FraudCheck) (*model.FraudRuleResult, error) {
+	if check.OrderOwnerUUID == "" || check.OrderOwnerUUID == check.UserUUID {
+		return nil, nil
+	}
+
+	return &model.FraudRuleResult{
+		Rule:    r.Name(),
+		Verdict: r.verdict,
+		Reason:  "payer differs from order owner",
+	}, nil
+}
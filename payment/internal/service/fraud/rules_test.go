@@ -0,0 +1,65 @@
+package fraud
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+func (s *ServiceSuite) TestVelocityRule() {
+	var (
+		userUUID = gofakeit.UUID()
+		rule     = NewVelocityRule(s.transactionRepository, 3, model.FraudVerdictReview)
+		check    = &model.FraudCheck{UserUUID: userUUID}
+	)
+
+	s.transactionRepository.On("CountByUserSince", s.ctx, userUUID, mock.AnythingOfType("time.Time")).Return(2, nil).Once()
+	result, err := rule.Evaluate(s.ctx, check)
+	s.Require().NoError(err)
+	s.Require().Nil(result)
+
+	s.transactionRepository.On("CountByUserSince", s.ctx, userUUID, mock.AnythingOfType("time.Time")).Return(3, nil).Once()
+	result, err = rule.Evaluate(s.ctx, check)
+	s.Require().NoError(err)
+	s.Require().NotNil(result)
+	s.Require().Equal(model.FraudVerdictReview, result.Verdict)
+}
+
+func (s *ServiceSuite) TestAmountLimitRule() {
+	rule := NewAmountLimitRule(model.PaymentMethodCreditCard, 5_000, model.FraudVerdictReject)
+
+	result, err := rule.Evaluate(s.ctx, &model.FraudCheck{PaymentMethod: model.PaymentMethodCreditCard, Amount: 5_000})
+	s.Require().NoError(err)
+	s.Require().Nil(result)
+
+	// Лимит действует только для своего метода оплаты
+	result, err = rule.Evaluate(s.ctx, &model.FraudCheck{PaymentMethod: model.PaymentMethodCard, Amount: 50_000})
+	s.Require().NoError(err)
+	s.Require().Nil(result)
+
+	result, err = rule.Evaluate(s.ctx, &model.FraudCheck{PaymentMethod: model.PaymentMethodCreditCard, Amount: 5_000.01})
+	s.Require().NoError(err)
+	s.Require().NotNil(result)
+	s.Require().Equal(model.FraudVerdictReject, result.Verdict)
+}
+
+func (s *ServiceSuite) TestOwnerMismatchRule() {
+	var (
+		userUUID = gofakeit.UUID()
+		rule     = NewOwnerMismatchRule(model.FraudVerdictReview)
+	)
+
+	result, err := rule.Evaluate(s.ctx, &model.FraudCheck{UserUUID: userUUID, OrderOwnerUUID: userUUID})
+	s.Require().NoError(err)
+	s.Require().Nil(result)
+
+	result, err = rule.Evaluate(s.ctx, &model.FraudCheck{UserUUID: userUUID})
+	s.Require().NoError(err)
+	s.Require().Nil(result)
+
+	result, err = rule.Evaluate(s.ctx, &model.FraudCheck{UserUUID: userUUID, OrderOwnerUUID: gofakeit.UUID()})
+	s.Require().NoError(err)
+	s.Require().NotNil(result)
+	s.Require().Equal(model.FraudVerdictReview, result.Verdict)
+}
@@ -0,0 +1,37 @@
+package fraud
+
+import (
+	"context"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/service"
+)
+
+// Проверка, что svc реализует интерфейс FraudService на этапе компиляции
+var _ service.FraudService = (*svc)(nil)
+
+const (
+	defaultFlaggedLimit = 50
+	maxFlaggedLimit     = 500
+)
+
+// Rule - антифрод-правило. Возвращает nil, если платеж под правило не попадает
+type Rule interface {
+	Name() string
+	Evaluate(ctx context.Context, check *model.FraudCheck) (*model.FraudRuleResult, error)
+}
+
+// svc - реализация FraudService
+type svc struct {
+	decisionRepository repository.FraudDecisionRepository
+	rules              []Rule
+}
+
+// New создает новый экземпляр FraudService с набором правил
+func New(decisionRepository repository.FraudDecisionRepository, rules ...Rule) *svc {
+	return &svc{
+		decisionRepository: decisionRepository,
+		rules:              rules,
+	}
+}
@@ -0,0 +1,30 @@
+package fraud
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	repoMocks "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/mocks"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	ctx                   context.Context
+	decisionRepository    *repoMocks.FraudDecisionRepository
+	transactionRepository *repoMocks.TransactionRepository
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctx = context.Background()
+
+	s.decisionRepository = repoMocks.NewFraudDecisionRepository(s.T())
+	s.transactionRepository = repoMocks.NewTransactionRepository(s.T())
+}
+
+func (s *ServiceSuite) TearDownTest() {}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
@@ -0,0 +1,47 @@
+package fraud
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository"
+)
+
+const velocityWindow = time.Hour
+
+type velocityRule struct {
+	transactionRepository repository.TransactionRepository
+	maxPerHour            int
+	verdict               model.FraudVerdict
+}
+
+// NewVelocityRule срабатывает, если пользователь за последний час уже совершил maxPerHour платежей
+func NewVelocityRule(transactionRepository repository.TransactionRepository, maxPerHour int, verdict model.FraudVerdict) *velocityRule {
+	return &velocityRule{
+		transactionRepository: transactionRepository,
+		maxPerHour:            maxPerHour,
+		verdict:               verdict,
+	}
+}
+
+func (r *velocityRule) Name() string {
+	return "velocity"
+}
+
+func (r *velocityRule) Evaluate(ctx context.Context, check *model.FraudCheck) (*model.FraudRuleResult, error) {
+	count, err := r.transactionRepository.CountByUserSince(ctx, check.UserUUID, time.Now().Add(-velocityWindow))
+	if err != nil {
+		return nil, err
+	}
+	if count < r.maxPerHour {
+		return nil, nil
+	}
+
+	return &model.FraudRuleResult{
+		Rule:    r.Name(),
+		Verdict: r.verdict,
+		Reason:  fmt.Sprintf("%d payments in the last hour, limit is %d", count, r.maxPerHour),
+	}, nil
+}
@@ -0,0 +1,141 @@
+package installment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+// ChargeDue списывает наступившие платежи активных планов. Платежи закрепляются до списания,
+// поэтому параллельный прогон на другой реплике их не возьмет. Ошибка по одному платежу
+// не прерывает прогон: закрепление истечет через retryInterval, и платеж будет повторен
+func (s *svc) ChargeDue(ctx context.Context, now time.Time) (*model.InstallmentChargeResult, error) {
+	due, err := s.installmentRepository.ClaimDue(ctx, now, now.Add(s.retryInterval), dueBatchSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim due installments: %w", err)
+	}
+
+	result := &model.InstallmentChargeResult{}
+	for _, d := range due {
+		status, err := s.chargeDue(ctx, d, now)
+		if err != nil {
+			logger.Error(ctx, "❌ Не удалось списать платеж по рассрочке",
+				zap.String("installment_uuid", d.Installment.InstallmentUUID),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		switch status {
+		case model.InstallmentStatusPaid:
+			result.Charged++
+		case model.InstallmentStatusFailed:
+			result.Failed++
+		default:
+			result.Missed++
+		}
+	}
+
+	return result, nil
+}
+
+// chargeDue списывает один платеж и возвращает его новый статус. Событие InstallmentMissed
+// публикуется только при первом пропуске, повторные неудачные попытки откладываются
+// на retryInterval, а после maxAttempts попыток платеж переходит в FAILED, а план - в DEFAULTED
+func (s *svc) chargeDue(ctx context.Context, due *model.DueInstallment, now time.Time) (model.InstallmentStatus, error) {
+	installment := due.Installment
+
+	result, err := s.paymentGateway.ChargeInstallment(ctx, chargeFor(due.Plan, installment))
+	if err != nil {
+		return model.InstallmentStatusUnspecified, fmt.Errorf("failed to charge installment: %w", err)
+	}
+
+	if result.Status == model.PaymentStatusSucceeded {
+		if err = s.installmentRepository.MarkPaid(ctx, installment.InstallmentUUID, now); err != nil {
+			return model.InstallmentStatusUnspecified, fmt.Errorf("failed to mark installment paid: %w", err)
+		}
+
+		completed, err := s.installmentRepository.CompleteIfAllPaid(ctx, due.Plan.PlanUUID)
+		if err != nil {
+			return model.InstallmentStatusUnspecified, fmt.Errorf("failed to complete installment plan: %w", err)
+		}
+		if completed {
+			logger.Info(ctx, "✅ План рассрочки полностью оплачен",
+				zap.String("plan_uuid", due.Plan.PlanUUID),
+				zap.String("transaction_uuid", due.Plan.TransactionUUID),
+			)
+		}
+
+		return model.InstallmentStatusPaid, nil
+	}
+
+	firstMiss := installment.Status == model.InstallmentStatusScheduled
+	installment.FailureReason = failureReason(result)
+
+	// Событие публикуется до смены статуса: если публикация не прошла, платеж останется
+	// SCHEDULED и событие будет отправлено при следующей попытке
+	if firstMiss {
+		if err = s.installmentProducer.PublishInstallmentMissed(ctx, due); err != nil {
+			return model.InstallmentStatusUnspecified, fmt.Errorf("failed to publish installment missed: %w", err)
+		}
+	}
+
+	status := model.InstallmentStatusMissed
+	if installment.Attempts+1 >= s.maxAttempts {
+		status = model.InstallmentStatusFailed
+	}
+
+	if status == model.InstallmentStatusFailed {
+		if err = s.defaultPlan(ctx, due); err != nil {
+			return model.InstallmentStatusUnspecified, err
+		}
+	}
+
+	err = s.installmentRepository.MarkMissed(ctx, installment.InstallmentUUID, status, installment.FailureReason, now.Add(s.retryInterval))
+	if err != nil {
+		return model.InstallmentStatusUnspecified, fmt.Errorf("failed to mark installment missed: %w", err)
+	}
+
+	if status == model.InstallmentStatusFailed {
+		logger.Error(ctx, "❌ Попытки списания платежа по рассрочке исчерпаны, план прекращен",
+			zap.String("installment_uuid", installment.InstallmentUUID),
+			zap.String("plan_uuid", due.Plan.PlanUUID),
+			zap.Int32("number", installment.Number),
+			zap.Int32("attempts", installment.Attempts),
+			zap.String("reason", installment.FailureReason),
+		)
+		return status, nil
+	}
+
+	logger.Warn(ctx, "⚠️ Платеж по рассрочке пропущен",
+		zap.String("installment_uuid", installment.InstallmentUUID),
+		zap.String("plan_uuid", due.Plan.PlanUUID),
+		zap.Int32("number", installment.Number),
+		zap.String("reason", installment.FailureReason),
+	)
+
+	return status, nil
+}
+
+// defaultPlan публикует InstallmentPlanDefaulted и переводит план в DEFAULTED, после чего job
+// не списывает по нему оставшиеся платежи. Как и с InstallmentMissed, событие уходит до смены статуса:
+// если что-то не прошло, платеж будет закреплен повторно и план прекратится при следующей попытке
+func (s *svc) defaultPlan(ctx context.Context, due *model.DueInstallment) error {
+	due.Installment.Attempts++
+
+	if err := s.installmentProducer.PublishInstallmentPlanDefaulted(ctx, due); err != nil {
+		return fmt.Errorf("failed to publish installment plan defaulted: %w", err)
+	}
+
+	if err := s.installmentRepository.UpdatePlanStatus(ctx, due.Plan.PlanUUID, model.InstallmentPlanStatusDefaulted); err != nil {
+		return fmt.Errorf("failed to default installment plan: %w", err)
+	}
+	due.Plan.Status = model.InstallmentPlanStatusDefaulted
+
+	return nil
+}
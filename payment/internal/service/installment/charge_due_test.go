@@ -0,0 +1,162 @@
+package installment
+
+import (
+	"errors"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+func newDueInstallment(status model.InstallmentStatus) *model.DueInstallment {
+	planUUID := gofakeit.UUID()
+	return &model.DueInstallment{
+		Installment: &model.Installment{
+			InstallmentUUID: gofakeit.UUID(),
+			PlanUUID:        planUUID,
+			Number:          2,
+			Amount:          100_000,
+			Status:          status,
+		},
+		Plan: &model.InstallmentPlan{
+			PlanUUID:        planUUID,
+			TransactionUUID: gofakeit.UUID(),
+			OrderUUID:       gofakeit.UUID(),
+			UserUUID:        gofakeit.UUID(),
+			Status:          model.InstallmentPlanStatusActive,
+		},
+	}
+}
+
+func (s *ServiceSuite) TestChargeDuePaid() {
+	var (
+		now = time.Now()
+		due = newDueInstallment(model.InstallmentStatusScheduled)
+	)
+
+	s.installmentRepository.On("ClaimDue", s.ctx, now, now.Add(testRetryInterval), dueBatchSize).Return([]*model.DueInstallment{due}, nil)
+	s.paymentGateway.On("ChargeInstallment", s.ctx, mock.AnythingOfType("*model.InstallmentCharge")).
+		Return(&model.ChargeResult{Status: model.PaymentStatusSucceeded}, nil)
+	s.installmentRepository.On("MarkPaid", s.ctx, due.Installment.InstallmentUUID, now).Return(nil)
+	s.installmentRepository.On("CompleteIfAllPaid", s.ctx, due.Plan.PlanUUID).Return(true, nil)
+
+	result, err := s.service.ChargeDue(s.ctx, now)
+
+	s.Require().NoError(err)
+	s.Require().Equal(1, result.Charged)
+	s.Require().Equal(0, result.Missed)
+}
+
+func (s *ServiceSuite) TestChargeDueMissedPublishesEvent() {
+	var (
+		now = time.Now()
+		due = newDueInstallment(model.InstallmentStatusScheduled)
+	)
+
+	s.installmentRepository.On("ClaimDue", s.ctx, now, now.Add(testRetryInterval), dueBatchSize).Return([]*model.DueInstallment{due}, nil)
+	s.paymentGateway.On("ChargeInstallment", s.ctx, mock.AnythingOfType("*model.InstallmentCharge")).
+		Return(&model.ChargeResult{Status: model.PaymentStatusFailed, FailureReason: "insufficient credit"}, nil)
+	s.installmentProducer.On("PublishInstallmentMissed", s.ctx, mock.MatchedBy(func(d *model.DueInstallment) bool {
+		return d.Installment.FailureReason == "insufficient credit"
+	})).Return(nil)
+	s.installmentRepository.On("MarkMissed", s.ctx, due.Installment.InstallmentUUID, model.InstallmentStatusMissed,
+		"insufficient credit", now.Add(testRetryInterval)).Return(nil)
+
+	result, err := s.service.ChargeDue(s.ctx, now)
+
+	s.Require().NoError(err)
+	s.Require().Equal(0, result.Charged)
+	s.Require().Equal(1, result.Missed)
+}
+
+func (s *ServiceSuite) TestChargeDueRetryMissedWithoutEvent() {
+	var (
+		now = time.Now()
+		due = newDueInstallment(model.InstallmentStatusMissed)
+	)
+
+	s.installmentRepository.On("ClaimDue", s.ctx, now, now.Add(testRetryInterval), dueBatchSize).Return([]*model.DueInstallment{due}, nil)
+	s.paymentGateway.On("ChargeInstallment", s.ctx, mock.AnythingOfType("*model.InstallmentCharge")).
+		Return(&model.ChargeResult{Status: model.PaymentStatusFailed}, nil)
+	s.installmentRepository.On("MarkMissed", s.ctx, due.Installment.InstallmentUUID, model.InstallmentStatusMissed,
+		mock.AnythingOfType("string"), now.Add(testRetryInterval)).Return(nil)
+
+	result, err := s.service.ChargeDue(s.ctx, now)
+
+	s.Require().NoError(err)
+	s.Require().Equal(1, result.Missed)
+	s.installmentProducer.AssertNotCalled(s.T(), "PublishInstallmentMissed", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestChargeDueLastAttemptFails() {
+	var (
+		now = time.Now()
+		due = newDueInstallment(model.InstallmentStatusMissed)
+	)
+	due.Installment.Attempts = testMaxAttempts - 1
+
+	s.installmentRepository.On("ClaimDue", s.ctx, now, now.Add(testRetryInterval), dueBatchSize).Return([]*model.DueInstallment{due}, nil)
+	s.paymentGateway.On("ChargeInstallment", s.ctx, mock.AnythingOfType("*model.InstallmentCharge")).
+		Return(&model.ChargeResult{Status: model.PaymentStatusFailed}, nil)
+	s.installmentProducer.On("PublishInstallmentPlanDefaulted", s.ctx, mock.MatchedBy(func(d *model.DueInstallment) bool {
+		return d.Installment.Attempts == testMaxAttempts
+	})).Return(nil).Once()
+	s.installmentRepository.On("UpdatePlanStatus", s.ctx, due.Plan.PlanUUID, model.InstallmentPlanStatusDefaulted).Return(nil).Once()
+	s.installmentRepository.On("MarkMissed", s.ctx, due.Installment.InstallmentUUID, model.InstallmentStatusFailed,
+		mock.AnythingOfType("string"), now.Add(testRetryInterval)).Return(nil).Once()
+
+	result, err := s.service.ChargeDue(s.ctx, now)
+
+	s.Require().NoError(err)
+	s.Require().Equal(0, result.Missed)
+	s.Require().Equal(1, result.Failed)
+	s.Require().Equal(model.InstallmentPlanStatusDefaulted, due.Plan.Status)
+}
+
+func (s *ServiceSuite) TestChargeDueLastAttemptPublishErrorKeepsInstallment() {
+	var (
+		now = time.Now()
+		due = newDueInstallment(model.InstallmentStatusMissed)
+	)
+	due.Installment.Attempts = testMaxAttempts - 1
+
+	s.installmentRepository.On("ClaimDue", s.ctx, now, now.Add(testRetryInterval), dueBatchSize).Return([]*model.DueInstallment{due}, nil)
+	s.paymentGateway.On("ChargeInstallment", s.ctx, mock.AnythingOfType("*model.InstallmentCharge")).
+		Return(&model.ChargeResult{Status: model.PaymentStatusFailed}, nil)
+	s.installmentProducer.On("PublishInstallmentPlanDefaulted", s.ctx, mock.AnythingOfType("*model.DueInstallment")).
+		Return(errors.New("kafka unavailable")).Once()
+
+	result, err := s.service.ChargeDue(s.ctx, now)
+
+	// Платеж остается MISSED: после истечения закрепления план прекратится при следующей попытке
+	s.Require().NoError(err)
+	s.Require().Equal(0, result.Failed)
+	s.installmentRepository.AssertNotCalled(s.T(), "UpdatePlanStatus", mock.Anything, mock.Anything, mock.Anything)
+	s.installmentRepository.AssertNotCalled(s.T(), "MarkMissed", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestChargeDueGatewayErrorSkipsInstallment() {
+	var (
+		now    = time.Now()
+		failed = newDueInstallment(model.InstallmentStatusScheduled)
+		paid   = newDueInstallment(model.InstallmentStatusScheduled)
+	)
+
+	s.installmentRepository.On("ClaimDue", s.ctx, now, now.Add(testRetryInterval), dueBatchSize).Return([]*model.DueInstallment{failed, paid}, nil)
+	s.paymentGateway.On("ChargeInstallment", s.ctx, mock.MatchedBy(func(charge *model.InstallmentCharge) bool {
+		return charge.InstallmentUUID == failed.Installment.InstallmentUUID
+	})).Return(nil, errors.New("gateway unavailable"))
+	s.paymentGateway.On("ChargeInstallment", s.ctx, mock.MatchedBy(func(charge *model.InstallmentCharge) bool {
+		return charge.InstallmentUUID == paid.Installment.InstallmentUUID
+	})).Return(&model.ChargeResult{Status: model.PaymentStatusSucceeded}, nil)
+	s.installmentRepository.On("MarkPaid", s.ctx, paid.Installment.InstallmentUUID, now).Return(nil)
+	s.installmentRepository.On("CompleteIfAllPaid", s.ctx, paid.Plan.PlanUUID).Return(false, nil)
+
+	result, err := s.service.ChargeDue(s.ctx, now)
+
+	s.Require().NoError(err)
+	s.Require().Equal(1, result.Charged)
+	s.Require().Equal(0, result.Missed)
+}
@@ -0,0 +1,142 @@
+package installment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+// CheckEligibility проверяет ограничения рассрочки по количеству платежей и сумме
+func (s *svc) CheckEligibility(amount float64, count int32) error {
+	if count < 2 || count > s.maxCount || amount < s.minAmount {
+		return model.ErrInvalidInstallments
+	}
+	return nil
+}
+
+// CreatePlan сохраняет план с графиком ежемесячных платежей и сразу списывает первый.
+// Если первый платеж не прошел или шлюз вернул ошибку, план отменяется - вызывающий переводит транзакцию в FAILED
+func (s *svc) CreatePlan(ctx context.Context, tx *model.Transaction, count int32) (*model.InstallmentPlan, error) {
+	if err := s.CheckEligibility(tx.Amount, count); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	plan := buildPlan(tx, count, now)
+
+	if err := s.installmentRepository.CreatePlan(ctx, plan); err != nil {
+		return nil, fmt.Errorf("failed to save installment plan: %w", err)
+	}
+
+	first := plan.Installments[0]
+	result, err := s.paymentGateway.ChargeInstallment(ctx, chargeFor(plan, first))
+	if err != nil {
+		// Без отмены активный план подхватит job и спишет первый платеж у уже проваленной транзакции
+		s.cancelPlan(ctx, plan.PlanUUID)
+		return nil, fmt.Errorf("failed to charge first installment: %w", err)
+	}
+
+	if result.Status == model.PaymentStatusSucceeded {
+		if err = s.installmentRepository.MarkPaid(ctx, first.InstallmentUUID, now); err != nil {
+			return nil, fmt.Errorf("failed to mark installment paid: %w", err)
+		}
+		first.Status = model.InstallmentStatusPaid
+		first.Attempts++
+		first.PaidAt = &now
+	} else {
+		reason := failureReason(result)
+		// План отменяется, поэтому первый платеж не повторяется
+		err = s.installmentRepository.MarkMissed(ctx, first.InstallmentUUID, model.InstallmentStatusMissed, reason, now.Add(s.retryInterval))
+		if err != nil {
+			return nil, fmt.Errorf("failed to mark installment missed: %w", err)
+		}
+		if err = s.installmentRepository.UpdatePlanStatus(ctx, plan.PlanUUID, model.InstallmentPlanStatusCancelled); err != nil {
+			return nil, fmt.Errorf("failed to cancel installment plan: %w", err)
+		}
+		first.Status = model.InstallmentStatusMissed
+		first.Attempts++
+		first.FailureReason = reason
+		plan.Status = model.InstallmentPlanStatusCancelled
+	}
+
+	logger.Info(ctx, "📆 План рассрочки создан",
+		zap.String("plan_uuid", plan.PlanUUID),
+		zap.String("transaction_uuid", plan.TransactionUUID),
+		zap.Int32("installments", count),
+		zap.Int32("status", int32(plan.Status)),
+	)
+
+	return plan, nil
+}
+
+// cancelPlan отменяет план, первый платеж по которому не удалось списать. Ошибка только логируется:
+// исходная ошибка списания важнее
+func (s *svc) cancelPlan(ctx context.Context, planUUID string) {
+	if err := s.installmentRepository.UpdatePlanStatus(ctx, planUUID, model.InstallmentPlanStatusCancelled); err != nil {
+		logger.Error(ctx, "❌ Не удалось отменить план рассрочки",
+			zap.String("plan_uuid", planUUID),
+			zap.Error(err),
+		)
+	}
+}
+
+// buildPlan делит сумму на count платежей с точностью до копейки, остаток уходит в последний платеж.
+// Первый платеж списывается сразу, остальные - через каждый месяц от даты создания
+func buildPlan(tx *model.Transaction, count int32, now time.Time) *model.InstallmentPlan {
+	total := decimal.NewFromFloat(tx.Amount).Round(2)
+	part := total.Div(decimal.NewFromInt32(count)).RoundDown(2)
+	last := total.Sub(part.Mul(decimal.NewFromInt32(count - 1)))
+
+	plan := &model.InstallmentPlan{
+		PlanUUID:        uuid.NewString(),
+		TransactionUUID: tx.TransactionUUID,
+		OrderUUID:       tx.OrderUUID,
+		UserUUID:        tx.UserUUID,
+		TotalAmount:     total.InexactFloat64(),
+		Status:          model.InstallmentPlanStatusActive,
+		Installments:    make([]*model.Installment, 0, count),
+		CreatedAt:       now,
+	}
+
+	for i := int32(0); i < count; i++ {
+		amount := part
+		if i == count-1 {
+			amount = last
+		}
+
+		plan.Installments = append(plan.Installments, &model.Installment{
+			InstallmentUUID: uuid.NewString(),
+			PlanUUID:        plan.PlanUUID,
+			Number:          i + 1,
+			Amount:          amount.InexactFloat64(),
+			DueDate:         now.AddDate(0, int(i), 0),
+			Status:          model.InstallmentStatusScheduled,
+		})
+	}
+
+	return plan
+}
+
+func chargeFor(plan *model.InstallmentPlan, installment *model.Installment) *model.InstallmentCharge {
+	return &model.InstallmentCharge{
+		InstallmentUUID: installment.InstallmentUUID,
+		TransactionUUID: plan.TransactionUUID,
+		UserUUID:        plan.UserUUID,
+		Number:          installment.Number,
+		Amount:          installment.Amount,
+	}
+}
+
+func failureReason(result *model.ChargeResult) string {
+	if result.FailureReason != "" {
+		return result.FailureReason
+	}
+	return "installment charge declined"
+}
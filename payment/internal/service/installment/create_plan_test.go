@@ -0,0 +1,91 @@
+package installment
+
+import (
+	"errors"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+func (s *ServiceSuite) TestCreatePlanSplitsAmount() {
+	tx := &model.Transaction{
+		TransactionUUID: gofakeit.UUID(),
+		OrderUUID:       gofakeit.UUID(),
+		UserUUID:        gofakeit.UUID(),
+		PaymentMethod:   model.PaymentMethodCreditCard,
+		Amount:          1_000_000.00,
+	}
+
+	s.installmentRepository.On("CreatePlan", s.ctx, mock.AnythingOfType("*model.InstallmentPlan")).Return(nil)
+	s.paymentGateway.On("ChargeInstallment", s.ctx, mock.MatchedBy(func(charge *model.InstallmentCharge) bool {
+		return charge.Number == 1 && charge.TransactionUUID == tx.TransactionUUID
+	})).Return(&model.ChargeResult{Status: model.PaymentStatusSucceeded}, nil)
+	s.installmentRepository.On("MarkPaid", s.ctx, mock.AnythingOfType("string"), mock.AnythingOfType("time.Time")).Return(nil)
+
+	plan, err := s.service.CreatePlan(s.ctx, tx, 3)
+
+	s.Require().NoError(err)
+	s.Require().Equal(model.InstallmentPlanStatusActive, plan.Status)
+	s.Require().Len(plan.Installments, 3)
+
+	// 1 000 000 / 3: копеечный остаток уходит в последний платеж
+	s.Require().Equal(333_333.33, plan.Installments[0].Amount)
+	s.Require().Equal(333_333.33, plan.Installments[1].Amount)
+	s.Require().Equal(333_333.34, plan.Installments[2].Amount)
+
+	s.Require().Equal(model.InstallmentStatusPaid, plan.Installments[0].Status)
+	s.Require().NotNil(plan.Installments[0].PaidAt)
+	s.Require().Equal(model.InstallmentStatusScheduled, plan.Installments[1].Status)
+	s.Require().Equal(plan.CreatedAt.AddDate(0, 2, 0), plan.Installments[2].DueDate)
+}
+
+func (s *ServiceSuite) TestCreatePlanFirstChargeDeclined() {
+	tx := &model.Transaction{
+		TransactionUUID: gofakeit.UUID(),
+		UserUUID:        gofakeit.UUID(),
+		Amount:          600_000.00,
+	}
+
+	s.installmentRepository.On("CreatePlan", s.ctx, mock.AnythingOfType("*model.InstallmentPlan")).Return(nil)
+	s.paymentGateway.On("ChargeInstallment", s.ctx, mock.AnythingOfType("*model.InstallmentCharge")).
+		Return(&model.ChargeResult{Status: model.PaymentStatusFailed, FailureReason: "card declined"}, nil)
+	s.installmentRepository.On("MarkMissed", s.ctx, mock.AnythingOfType("string"), model.InstallmentStatusMissed,
+		"card declined", mock.AnythingOfType("time.Time")).Return(nil)
+	s.installmentRepository.On("UpdatePlanStatus", s.ctx, mock.AnythingOfType("string"), model.InstallmentPlanStatusCancelled).Return(nil)
+
+	plan, err := s.service.CreatePlan(s.ctx, tx, 6)
+
+	s.Require().NoError(err)
+	s.Require().Equal(model.InstallmentPlanStatusCancelled, plan.Status)
+	s.Require().Equal("card declined", plan.Installments[0].FailureReason)
+	s.installmentProducer.AssertNotCalled(s.T(), "PublishInstallmentMissed", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestCreatePlanFirstChargeErrorCancelsPlan() {
+	tx := &model.Transaction{
+		TransactionUUID: gofakeit.UUID(),
+		UserUUID:        gofakeit.UUID(),
+		Amount:          600_000.00,
+	}
+
+	s.installmentRepository.On("CreatePlan", s.ctx, mock.AnythingOfType("*model.InstallmentPlan")).Return(nil)
+	s.paymentGateway.On("ChargeInstallment", s.ctx, mock.AnythingOfType("*model.InstallmentCharge")).
+		Return(nil, errors.New("gateway timeout"))
+	s.installmentRepository.On("UpdatePlanStatus", s.ctx, mock.AnythingOfType("string"), model.InstallmentPlanStatusCancelled).
+		Return(nil).Once()
+
+	plan, err := s.service.CreatePlan(s.ctx, tx, 6)
+
+	s.Require().Error(err)
+	s.Require().Nil(plan)
+	s.installmentRepository.AssertNotCalled(s.T(), "MarkMissed", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestCheckEligibility() {
+	s.Require().NoError(s.service.CheckEligibility(15_000_000, 24))
+	s.Require().ErrorIs(s.service.CheckEligibility(15_000_000, 1), model.ErrInvalidInstallments)
+	s.Require().ErrorIs(s.service.CheckEligibility(15_000_000, 36), model.ErrInvalidInstallments)
+	s.Require().ErrorIs(s.service.CheckEligibility(50_000, 6), model.ErrInvalidInstallments)
+}
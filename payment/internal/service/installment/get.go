@@ -0,0 +1,46 @@
+package installment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+// GetByTransaction возвращает план рассрочки с графиком платежей
+func (s *svc) GetByTransaction(ctx context.Context, transactionUUID string) (*model.InstallmentPlan, error) {
+	plan, err := s.installmentRepository.GetPlanByTransaction(ctx, transactionUUID)
+	if err != nil {
+		if errors.Is(err, model.ErrInstallmentPlanNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get installment plan: %w", err)
+	}
+
+	return plan, nil
+}
+
+// ListByUser возвращает планы рассрочки пользователя от новых к старым
+func (s *svc) ListByUser(ctx context.Context, userUUID string, limit, offset int) ([]*model.InstallmentPlan, error) {
+	if userUUID == "" {
+		return nil, model.ErrEmptyUserUUID
+	}
+
+	switch {
+	case limit <= 0:
+		limit = defaultPlansLimit
+	case limit > maxPlansLimit:
+		limit = maxPlansLimit
+	}
+	if offset < 0 {
+		offset = 0
+	}
+
+	plans, err := s.installmentRepository.ListPlansByUser(ctx, userUUID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list installment plans: %w", err)
+	}
+
+	return plans, nil
+}
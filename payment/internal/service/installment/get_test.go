@@ -0,0 +1,30 @@
+package installment
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+func (s *ServiceSuite) TestGetByTransactionNotFound() {
+	transactionUUID := gofakeit.UUID()
+
+	s.installmentRepository.On("GetPlanByTransaction", s.ctx, transactionUUID).Return(nil, model.ErrInstallmentPlanNotFound)
+
+	plan, err := s.service.GetByTransaction(s.ctx, transactionUUID)
+
+	s.Require().ErrorIs(err, model.ErrInstallmentPlanNotFound)
+	s.Require().Nil(plan)
+}
+
+func (s *ServiceSuite) TestListByUserClampsLimit() {
+	userUUID := gofakeit.UUID()
+
+	s.installmentRepository.On("ListPlansByUser", s.ctx, userUUID, maxPlansLimit, 0).
+		Return([]*model.InstallmentPlan{}, nil)
+
+	plans, err := s.service.ListByUser(s.ctx, userUUID, 10_000, -1)
+
+	s.Require().NoError(err)
+	s.Require().Empty(plans)
+}
@@ -0,0 +1,54 @@
+package installment
+
+import (
+	"time"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/client/gateway"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/service"
+)
+
+// Проверка, что svc реализует интерфейс InstallmentService на этапе компиляции
+var _ service.InstallmentService = (*svc)(nil)
+
+const (
+	defaultPlansLimit = 50
+	maxPlansLimit     = 500
+
+	// dueBatchSize - сколько платежей списывается за один прогон job'а
+	dueBatchSize = 100
+)
+
+// svc - реализация InstallmentService
+type svc struct {
+	installmentRepository repository.InstallmentRepository
+	paymentGateway        gateway.PaymentGateway
+	installmentProducer   service.InstallmentProducerService
+	maxCount              int32
+	minAmount             float64
+	maxAttempts           int32
+	retryInterval         time.Duration
+}
+
+// New создает новый экземпляр InstallmentService. maxCount - максимальное число платежей,
+// minAmount - минимальная сумма заказа для рассрочки, maxAttempts - число попыток списания
+// одного платежа, retryInterval - пауза между попытками
+func New(
+	installmentRepository repository.InstallmentRepository,
+	paymentGateway gateway.PaymentGateway,
+	installmentProducer service.InstallmentProducerService,
+	maxCount int32,
+	minAmount float64,
+	maxAttempts int32,
+	retryInterval time.Duration,
+) *svc {
+	return &svc{
+		installmentRepository: installmentRepository,
+		paymentGateway:        paymentGateway,
+		installmentProducer:   installmentProducer,
+		maxCount:              maxCount,
+		minAmount:             minAmount,
+		maxAttempts:           maxAttempts,
+		retryInterval:         retryInterval,
+	}
+}
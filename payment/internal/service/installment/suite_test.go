@@ -0,0 +1,54 @@
+package installment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	gatewayMocks "github.com/Daniil-Sakharov/RocketFactory/payment/internal/client/gateway/mocks"
+	repoMocks "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/mocks"
+	serviceMocks "github.com/Daniil-Sakharov/RocketFactory/payment/internal/service/mocks"
+)
+
+const (
+	testMaxCount  = 24
+	testMinAmount = 100_000
+
+	testMaxAttempts   = 5
+	testRetryInterval = 24 * time.Hour
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	ctx                   context.Context
+	installmentRepository *repoMocks.InstallmentRepository
+	paymentGateway        *gatewayMocks.PaymentGateway
+	installmentProducer   *serviceMocks.InstallmentProducerService
+	service               *svc
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctx = context.Background()
+
+	s.installmentRepository = repoMocks.NewInstallmentRepository(s.T())
+	s.paymentGateway = gatewayMocks.NewPaymentGateway(s.T())
+	s.installmentProducer = serviceMocks.NewInstallmentProducerService(s.T())
+
+	s.service = New(
+		s.installmentRepository,
+		s.paymentGateway,
+		s.installmentProducer,
+		testMaxCount,
+		testMinAmount,
+		testMaxAttempts,
+		testRetryInterval,
+	)
+}
+
+func (s *ServiceSuite) TearDownTest() {}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
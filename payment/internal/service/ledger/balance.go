@@ -0,0 +1,22 @@
+package ledger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+// GetBalance возвращает текущий баланс счета инвестора
+func (s *svc) GetBalance(ctx context.Context, userUUID string) (float64, error) {
+	if userUUID == "" {
+		return 0, model.ErrEmptyUserUUID
+	}
+
+	balance, err := s.ledgerRepository.GetBalance(ctx, userUUID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get investor balance: %w", err)
+	}
+
+	return balance, nil
+}
@@ -0,0 +1,26 @@
+package ledger
+
+import (
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/service"
+)
+
+// Проверка, что svc реализует интерфейс LedgerService на этапе компиляции
+var _ service.LedgerService = (*svc)(nil)
+
+const (
+	defaultStatementLimit = 50
+	maxStatementLimit     = 500
+)
+
+// svc - реализация LedgerService
+type svc struct {
+	ledgerRepository repository.LedgerRepository
+}
+
+// New создает новый экземпляр LedgerService
+func New(ledgerRepository repository.LedgerRepository) *svc {
+	return &svc{
+		ledgerRepository: ledgerRepository,
+	}
+}
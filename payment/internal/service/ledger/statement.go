@@ -0,0 +1,40 @@
+package ledger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+// GetStatement возвращает баланс и операции по счету инвестора от новых к старым
+func (s *svc) GetStatement(ctx context.Context, req *model.StatementRequest) (*model.Statement, error) {
+	if req.UserUUID == "" {
+		return nil, model.ErrEmptyUserUUID
+	}
+
+	switch {
+	case req.Limit <= 0:
+		req.Limit = defaultStatementLimit
+	case req.Limit > maxStatementLimit:
+		req.Limit = maxStatementLimit
+	}
+	if req.Offset < 0 {
+		req.Offset = 0
+	}
+
+	balance, err := s.ledgerRepository.GetBalance(ctx, req.UserUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get investor balance: %w", err)
+	}
+
+	lines, err := s.ledgerRepository.GetStatement(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get investor statement: %w", err)
+	}
+
+	return &model.Statement{
+		Balance: balance,
+		Lines:   lines,
+	}, nil
+}
@@ -0,0 +1,87 @@
+package ledger
+
+import (
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+func (s *ServiceSuite) TestGetStatementSuccess() {
+	var (
+		userUUID = gofakeit.UUID()
+
+		lines = []*model.StatementLine{
+			{
+				EntryUUID:    gofakeit.UUID(),
+				EntryType:    model.LedgerEntryTypePayment,
+				Amount:       -300,
+				BalanceAfter: 700,
+				Reference:    gofakeit.UUID(),
+				CreatedAt:    time.Now(),
+			},
+			{
+				EntryUUID:    gofakeit.UUID(),
+				EntryType:    model.LedgerEntryTypeTopUp,
+				Amount:       1000,
+				BalanceAfter: 1000,
+				Reference:    "wire-1",
+				CreatedAt:    time.Now().Add(-time.Hour),
+			},
+		}
+	)
+
+	s.ledgerRepository.On("GetBalance", s.ctx, userUUID).Return(700.0, nil)
+	s.ledgerRepository.On("GetStatement", s.ctx, mock.MatchedBy(func(req *model.StatementRequest) bool {
+		return req.UserUUID == userUUID && req.Limit == defaultStatementLimit && req.Offset == 0
+	})).Return(lines, nil)
+
+	statement, err := s.service.GetStatement(s.ctx, &model.StatementRequest{UserUUID: userUUID})
+
+	s.Require().NoError(err)
+	s.Require().Equal(700.0, statement.Balance)
+	s.Require().Len(statement.Lines, 2)
+}
+
+func (s *ServiceSuite) TestGetStatementLimitCapped() {
+	userUUID := gofakeit.UUID()
+
+	s.ledgerRepository.On("GetBalance", s.ctx, userUUID).Return(0.0, nil)
+	s.ledgerRepository.On("GetStatement", s.ctx, mock.MatchedBy(func(req *model.StatementRequest) bool {
+		return req.Limit == maxStatementLimit
+	})).Return([]*model.StatementLine{}, nil)
+
+	statement, err := s.service.GetStatement(s.ctx, &model.StatementRequest{
+		UserUUID: userUUID,
+		Limit:    10_000,
+	})
+
+	s.Require().NoError(err)
+	s.Require().Empty(statement.Lines)
+}
+
+func (s *ServiceSuite) TestGetStatementEmptyUserUUID() {
+	statement, err := s.service.GetStatement(s.ctx, &model.StatementRequest{})
+
+	s.Require().ErrorIs(err, model.ErrEmptyUserUUID)
+	s.Require().Nil(statement)
+}
+
+func (s *ServiceSuite) TestGetBalanceSuccess() {
+	userUUID := gofakeit.UUID()
+
+	s.ledgerRepository.On("GetBalance", s.ctx, userUUID).Return(1_250.75, nil)
+
+	balance, err := s.service.GetBalance(s.ctx, userUUID)
+
+	s.Require().NoError(err)
+	s.Require().Equal(1_250.75, balance)
+}
+
+func (s *ServiceSuite) TestGetBalanceEmptyUserUUID() {
+	_, err := s.service.GetBalance(s.ctx, "")
+
+	s.Require().ErrorIs(err, model.ErrEmptyUserUUID)
+}
@@ -0,0 +1,31 @@
+package ledger
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	repoMocks "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/mocks"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	ctx              context.Context
+	ledgerRepository *repoMocks.LedgerRepository
+	service          *svc
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctx = context.Background()
+
+	s.ledgerRepository = repoMocks.NewLedgerRepository(s.T())
+
+	s.service = New(s.ledgerRepository)
+}
+
+func (s *ServiceSuite) TearDownTest() {}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
@@ -0,0 +1,39 @@
+package ledger
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+// TopUp пополняет счет инвестора. Без reference каждое пополнение проводится как новое
+func (s *svc) TopUp(ctx context.Context, req *model.TopUpRequest) (*model.TopUpResult, error) {
+	if req.UserUUID == "" {
+		return nil, model.ErrEmptyUserUUID
+	}
+	if req.Amount <= 0 {
+		return nil, model.ErrInvalidAmount
+	}
+
+	if req.Reference == "" {
+		req.Reference = uuid.NewString()
+	}
+
+	result, err := s.ledgerRepository.TopUp(ctx, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to top up investor account: %w", err)
+	}
+
+	logger.Info(ctx, "💰 Счет инвестора пополнен",
+		zap.String("user_uuid", req.UserUUID),
+		zap.String("entry_uuid", result.EntryUUID),
+		zap.Float64("amount", req.Amount),
+	)
+
+	return result, nil
+}
@@ -0,0 +1,82 @@
+package ledger
+
+import (
+	"errors"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+func (s *ServiceSuite) TestTopUpSuccess() {
+	var (
+		userUUID  = gofakeit.UUID()
+		entryUUID = gofakeit.UUID()
+
+		request = &model.TopUpRequest{
+			UserUUID:  userUUID,
+			Amount:    50_000,
+			Reference: "wire-42",
+		}
+	)
+
+	s.ledgerRepository.On("TopUp", s.ctx, request).
+		Return(&model.TopUpResult{EntryUUID: entryUUID, Balance: 50_000}, nil)
+
+	result, err := s.service.TopUp(s.ctx, request)
+
+	s.Require().NoError(err)
+	s.Require().Equal(entryUUID, result.EntryUUID)
+	s.Require().Equal(50_000.0, result.Balance)
+}
+
+func (s *ServiceSuite) TestTopUpGeneratesReference() {
+	var (
+		request = &model.TopUpRequest{
+			UserUUID: gofakeit.UUID(),
+			Amount:   100,
+		}
+	)
+
+	s.ledgerRepository.On("TopUp", s.ctx, mock.MatchedBy(func(req *model.TopUpRequest) bool {
+		return req.Reference != ""
+	})).Return(&model.TopUpResult{EntryUUID: gofakeit.UUID(), Balance: 100}, nil)
+
+	_, err := s.service.TopUp(s.ctx, request)
+
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TestTopUpInvalidAmount() {
+	for _, amount := range []float64{0, -10} {
+		result, err := s.service.TopUp(s.ctx, &model.TopUpRequest{
+			UserUUID: gofakeit.UUID(),
+			Amount:   amount,
+		})
+
+		s.Require().ErrorIs(err, model.ErrInvalidAmount)
+		s.Require().Nil(result)
+	}
+}
+
+func (s *ServiceSuite) TestTopUpEmptyUserUUID() {
+	result, err := s.service.TopUp(s.ctx, &model.TopUpRequest{Amount: 100})
+
+	s.Require().ErrorIs(err, model.ErrEmptyUserUUID)
+	s.Require().Nil(result)
+}
+
+func (s *ServiceSuite) TestTopUpRepositoryError() {
+	request := &model.TopUpRequest{
+		UserUUID: gofakeit.UUID(),
+		Amount:   100,
+	}
+
+	s.ledgerRepository.On("TopUp", s.ctx, request).Return(nil, errors.New("db error"))
+
+	result, err := s.service.TopUp(s.ctx, request)
+
+	s.Require().Error(err)
+	s.Require().Nil(result)
+}
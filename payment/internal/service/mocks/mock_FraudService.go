@@ -0,0 +1,158 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FraudService is an autogenerated mock type for the FraudService type
+type FraudService struct {
+	mock.Mock
+}
+
+type FraudService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *FraudService) EXPECT() *FraudService_Expecter {
+	return &FraudService_Expecter{mock: &_m.Mock}
+}
+
+// Evaluate provides a mock function with given fields: ctx, check
+func (_m *FraudService) Evaluate(ctx context.Context, check *model.FraudCheck) (*model.FraudDecision, error) {
+	ret := _m.Called(ctx, check)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Evaluate")
+	}
+
+	var r0 *model.FraudDecision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.FraudCheck) (*model.FraudDecision, error)); ok {
+		return rf(ctx, check)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.FraudCheck) *model.FraudDecision); ok {
+		r0 = rf(ctx, check)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.FraudDecision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.FraudCheck) error); ok {
+		r1 = rf(ctx, check)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FraudService_Evaluate_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Evaluate'
+type FraudService_Evaluate_Call struct {
+	*mock.Call
+}
+
+// Evaluate is a helper method to define mock.On call
+//   - ctx context.Context
+//   - check *model.FraudCheck
+func (_e *FraudService_Expecter) Evaluate(ctx interface{}, check interface{}) *FraudService_Evaluate_Call {
+	return &FraudService_Evaluate_Call{Call: _e.mock.On("Evaluate", ctx, check)}
+}
+
+func (_c *FraudService_Evaluate_Call) Run(run func(ctx context.Context, check *model.FraudCheck)) *FraudService_Evaluate_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.FraudCheck))
+	})
+	return _c
+}
+
+func (_c *FraudService_Evaluate_Call) Return(_a0 *model.FraudDecision, _a1 error) *FraudService_Evaluate_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FraudService_Evaluate_Call) RunAndReturn(run func(context.Context, *model.FraudCheck) (*model.FraudDecision, error)) *FraudService_Evaluate_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListFlagged provides a mock function with given fields: ctx, limit, offset
+func (_m *FraudService) ListFlagged(ctx context.Context, limit int, offset int) ([]*model.FraudDecision, error) {
+	ret := _m.Called(ctx, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListFlagged")
+	}
+
+	var r0 []*model.FraudDecision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) ([]*model.FraudDecision, error)); ok {
+		return rf(ctx, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, int) []*model.FraudDecision); ok {
+		r0 = rf(ctx, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.FraudDecision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, int) error); ok {
+		r1 = rf(ctx, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FraudService_ListFlagged_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListFlagged'
+type FraudService_ListFlagged_Call struct {
+	*mock.Call
+}
+
+// ListFlagged is a helper method to define mock.On call
+//   - ctx context.Context
+//   - limit int
+//   - offset int
+func (_e *FraudService_Expecter) ListFlagged(ctx interface{}, limit interface{}, offset interface{}) *FraudService_ListFlagged_Call {
+	return &FraudService_ListFlagged_Call{Call: _e.mock.On("ListFlagged", ctx, limit, offset)}
+}
+
+func (_c *FraudService_ListFlagged_Call) Run(run func(ctx context.Context, limit int, offset int)) *FraudService_ListFlagged_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *FraudService_ListFlagged_Call) Return(_a0 []*model.FraudDecision, _a1 error) *FraudService_ListFlagged_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *FraudService_ListFlagged_Call) RunAndReturn(run func(context.Context, int, int) ([]*model.FraudDecision, error)) *FraudService_ListFlagged_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewFraudService creates a new instance of FraudService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFraudService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FraudService {
+	mock := &FraudService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
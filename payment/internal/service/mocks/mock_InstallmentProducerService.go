@@ -0,0 +1,133 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// InstallmentProducerService is an autogenerated mock type for the InstallmentProducerService type
+type InstallmentProducerService struct {
+	mock.Mock
+}
+
+type InstallmentProducerService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *InstallmentProducerService) EXPECT() *InstallmentProducerService_Expecter {
+	return &InstallmentProducerService_Expecter{mock: &_m.Mock}
+}
+
+// PublishInstallmentMissed provides a mock function with given fields: ctx, due
+func (_m *InstallmentProducerService) PublishInstallmentMissed(ctx context.Context, due *model.DueInstallment) error {
+	ret := _m.Called(ctx, due)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishInstallmentMissed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.DueInstallment) error); ok {
+		r0 = rf(ctx, due)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InstallmentProducerService_PublishInstallmentMissed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishInstallmentMissed'
+type InstallmentProducerService_PublishInstallmentMissed_Call struct {
+	*mock.Call
+}
+
+// PublishInstallmentMissed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - due *model.DueInstallment
+func (_e *InstallmentProducerService_Expecter) PublishInstallmentMissed(ctx interface{}, due interface{}) *InstallmentProducerService_PublishInstallmentMissed_Call {
+	return &InstallmentProducerService_PublishInstallmentMissed_Call{Call: _e.mock.On("PublishInstallmentMissed", ctx, due)}
+}
+
+func (_c *InstallmentProducerService_PublishInstallmentMissed_Call) Run(run func(ctx context.Context, due *model.DueInstallment)) *InstallmentProducerService_PublishInstallmentMissed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.DueInstallment))
+	})
+	return _c
+}
+
+func (_c *InstallmentProducerService_PublishInstallmentMissed_Call) Return(_a0 error) *InstallmentProducerService_PublishInstallmentMissed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InstallmentProducerService_PublishInstallmentMissed_Call) RunAndReturn(run func(context.Context, *model.DueInstallment) error) *InstallmentProducerService_PublishInstallmentMissed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishInstallmentPlanDefaulted provides a mock function with given fields: ctx, due
+func (_m *InstallmentProducerService) PublishInstallmentPlanDefaulted(ctx context.Context, due *model.DueInstallment) error {
+	ret := _m.Called(ctx, due)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishInstallmentPlanDefaulted")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.DueInstallment) error); ok {
+		r0 = rf(ctx, due)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InstallmentProducerService_PublishInstallmentPlanDefaulted_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishInstallmentPlanDefaulted'
+type InstallmentProducerService_PublishInstallmentPlanDefaulted_Call struct {
+	*mock.Call
+}
+
+// PublishInstallmentPlanDefaulted is a helper method to define mock.On call
+//   - ctx context.Context
+//   - due *model.DueInstallment
+func (_e *InstallmentProducerService_Expecter) PublishInstallmentPlanDefaulted(ctx interface{}, due interface{}) *InstallmentProducerService_PublishInstallmentPlanDefaulted_Call {
+	return &InstallmentProducerService_PublishInstallmentPlanDefaulted_Call{Call: _e.mock.On("PublishInstallmentPlanDefaulted", ctx, due)}
+}
+
+func (_c *InstallmentProducerService_PublishInstallmentPlanDefaulted_Call) Run(run func(ctx context.Context, due *model.DueInstallment)) *InstallmentProducerService_PublishInstallmentPlanDefaulted_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.DueInstallment))
+	})
+	return _c
+}
+
+func (_c *InstallmentProducerService_PublishInstallmentPlanDefaulted_Call) Return(_a0 error) *InstallmentProducerService_PublishInstallmentPlanDefaulted_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InstallmentProducerService_PublishInstallmentPlanDefaulted_Call) RunAndReturn(run func(context.Context, *model.DueInstallment) error) *InstallmentProducerService_PublishInstallmentPlanDefaulted_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewInstallmentProducerService creates a new instance of InstallmentProducerService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInstallmentProducerService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InstallmentProducerService {
+	mock := &InstallmentProducerService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
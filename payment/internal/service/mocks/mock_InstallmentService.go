@@ -0,0 +1,327 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// InstallmentService is an autogenerated mock type for the InstallmentService type
+type InstallmentService struct {
+	mock.Mock
+}
+
+type InstallmentService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *InstallmentService) EXPECT() *InstallmentService_Expecter {
+	return &InstallmentService_Expecter{mock: &_m.Mock}
+}
+
+// ChargeDue provides a mock function with given fields: ctx, now
+func (_m *InstallmentService) ChargeDue(ctx context.Context, now time.Time) (*model.InstallmentChargeResult, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChargeDue")
+	}
+
+	var r0 *model.InstallmentChargeResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (*model.InstallmentChargeResult, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) *model.InstallmentChargeResult); ok {
+		r0 = rf(ctx, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.InstallmentChargeResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InstallmentService_ChargeDue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChargeDue'
+type InstallmentService_ChargeDue_Call struct {
+	*mock.Call
+}
+
+// ChargeDue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *InstallmentService_Expecter) ChargeDue(ctx interface{}, now interface{}) *InstallmentService_ChargeDue_Call {
+	return &InstallmentService_ChargeDue_Call{Call: _e.mock.On("ChargeDue", ctx, now)}
+}
+
+func (_c *InstallmentService_ChargeDue_Call) Run(run func(ctx context.Context, now time.Time)) *InstallmentService_ChargeDue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *InstallmentService_ChargeDue_Call) Return(_a0 *model.InstallmentChargeResult, _a1 error) *InstallmentService_ChargeDue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InstallmentService_ChargeDue_Call) RunAndReturn(run func(context.Context, time.Time) (*model.InstallmentChargeResult, error)) *InstallmentService_ChargeDue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CheckEligibility provides a mock function with given fields: amount, count
+func (_m *InstallmentService) CheckEligibility(amount float64, count int32) error {
+	ret := _m.Called(amount, count)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckEligibility")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(float64, int32) error); ok {
+		r0 = rf(amount, count)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InstallmentService_CheckEligibility_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CheckEligibility'
+type InstallmentService_CheckEligibility_Call struct {
+	*mock.Call
+}
+
+// CheckEligibility is a helper method to define mock.On call
+//   - amount float64
+//   - count int32
+func (_e *InstallmentService_Expecter) CheckEligibility(amount interface{}, count interface{}) *InstallmentService_CheckEligibility_Call {
+	return &InstallmentService_CheckEligibility_Call{Call: _e.mock.On("CheckEligibility", amount, count)}
+}
+
+func (_c *InstallmentService_CheckEligibility_Call) Run(run func(amount float64, count int32)) *InstallmentService_CheckEligibility_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(float64), args[1].(int32))
+	})
+	return _c
+}
+
+func (_c *InstallmentService_CheckEligibility_Call) Return(_a0 error) *InstallmentService_CheckEligibility_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *InstallmentService_CheckEligibility_Call) RunAndReturn(run func(float64, int32) error) *InstallmentService_CheckEligibility_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreatePlan provides a mock function with given fields: ctx, tx, count
+func (_m *InstallmentService) CreatePlan(ctx context.Context, tx *model.Transaction, count int32) (*model.InstallmentPlan, error) {
+	ret := _m.Called(ctx, tx, count)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePlan")
+	}
+
+	var r0 *model.InstallmentPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Transaction, int32) (*model.InstallmentPlan, error)); ok {
+		return rf(ctx, tx, count)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Transaction, int32) *model.InstallmentPlan); ok {
+		r0 = rf(ctx, tx, count)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.InstallmentPlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Transaction, int32) error); ok {
+		r1 = rf(ctx, tx, count)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InstallmentService_CreatePlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePlan'
+type InstallmentService_CreatePlan_Call struct {
+	*mock.Call
+}
+
+// CreatePlan is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx *model.Transaction
+//   - count int32
+func (_e *InstallmentService_Expecter) CreatePlan(ctx interface{}, tx interface{}, count interface{}) *InstallmentService_CreatePlan_Call {
+	return &InstallmentService_CreatePlan_Call{Call: _e.mock.On("CreatePlan", ctx, tx, count)}
+}
+
+func (_c *InstallmentService_CreatePlan_Call) Run(run func(ctx context.Context, tx *model.Transaction, count int32)) *InstallmentService_CreatePlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Transaction), args[2].(int32))
+	})
+	return _c
+}
+
+func (_c *InstallmentService_CreatePlan_Call) Return(_a0 *model.InstallmentPlan, _a1 error) *InstallmentService_CreatePlan_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InstallmentService_CreatePlan_Call) RunAndReturn(run func(context.Context, *model.Transaction, int32) (*model.InstallmentPlan, error)) *InstallmentService_CreatePlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetByTransaction provides a mock function with given fields: ctx, transactionUUID
+func (_m *InstallmentService) GetByTransaction(ctx context.Context, transactionUUID string) (*model.InstallmentPlan, error) {
+	ret := _m.Called(ctx, transactionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByTransaction")
+	}
+
+	var r0 *model.InstallmentPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.InstallmentPlan, error)); ok {
+		return rf(ctx, transactionUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.InstallmentPlan); ok {
+		r0 = rf(ctx, transactionUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.InstallmentPlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, transactionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InstallmentService_GetByTransaction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByTransaction'
+type InstallmentService_GetByTransaction_Call struct {
+	*mock.Call
+}
+
+// GetByTransaction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID string
+func (_e *InstallmentService_Expecter) GetByTransaction(ctx interface{}, transactionUUID interface{}) *InstallmentService_GetByTransaction_Call {
+	return &InstallmentService_GetByTransaction_Call{Call: _e.mock.On("GetByTransaction", ctx, transactionUUID)}
+}
+
+func (_c *InstallmentService_GetByTransaction_Call) Run(run func(ctx context.Context, transactionUUID string)) *InstallmentService_GetByTransaction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *InstallmentService_GetByTransaction_Call) Return(_a0 *model.InstallmentPlan, _a1 error) *InstallmentService_GetByTransaction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InstallmentService_GetByTransaction_Call) RunAndReturn(run func(context.Context, string) (*model.InstallmentPlan, error)) *InstallmentService_GetByTransaction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListByUser provides a mock function with given fields: ctx, userUUID, limit, offset
+func (_m *InstallmentService) ListByUser(ctx context.Context, userUUID string, limit int, offset int) ([]*model.InstallmentPlan, error) {
+	ret := _m.Called(ctx, userUUID, limit, offset)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListByUser")
+	}
+
+	var r0 []*model.InstallmentPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) ([]*model.InstallmentPlan, error)); ok {
+		return rf(ctx, userUUID, limit, offset)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) []*model.InstallmentPlan); ok {
+		r0 = rf(ctx, userUUID, limit, offset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.InstallmentPlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
+		r1 = rf(ctx, userUUID, limit, offset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InstallmentService_ListByUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListByUser'
+type InstallmentService_ListByUser_Call struct {
+	*mock.Call
+}
+
+// ListByUser is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+//   - limit int
+//   - offset int
+func (_e *InstallmentService_Expecter) ListByUser(ctx interface{}, userUUID interface{}, limit interface{}, offset interface{}) *InstallmentService_ListByUser_Call {
+	return &InstallmentService_ListByUser_Call{Call: _e.mock.On("ListByUser", ctx, userUUID, limit, offset)}
+}
+
+func (_c *InstallmentService_ListByUser_Call) Run(run func(ctx context.Context, userUUID string, limit int, offset int)) *InstallmentService_ListByUser_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *InstallmentService_ListByUser_Call) Return(_a0 []*model.InstallmentPlan, _a1 error) *InstallmentService_ListByUser_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *InstallmentService_ListByUser_Call) RunAndReturn(run func(context.Context, string, int, int) ([]*model.InstallmentPlan, error)) *InstallmentService_ListByUser_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewInstallmentService creates a new instance of InstallmentService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInstallmentService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InstallmentService {
+	mock := &InstallmentService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
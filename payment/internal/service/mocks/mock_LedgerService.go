@@ -0,0 +1,214 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// LedgerService is an autogenerated mock type for the LedgerService type
+type LedgerService struct {
+	mock.Mock
+}
+
+type LedgerService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *LedgerService) EXPECT() *LedgerService_Expecter {
+	return &LedgerService_Expecter{mock: &_m.Mock}
+}
+
+// GetBalance provides a mock function with given fields: ctx, userUUID
+func (_m *LedgerService) GetBalance(ctx context.Context, userUUID string) (float64, error) {
+	ret := _m.Called(ctx, userUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBalance")
+	}
+
+	var r0 float64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (float64, error)); ok {
+		return rf(ctx, userUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) float64); ok {
+		r0 = rf(ctx, userUUID)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LedgerService_GetBalance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBalance'
+type LedgerService_GetBalance_Call struct {
+	*mock.Call
+}
+
+// GetBalance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userUUID string
+func (_e *LedgerService_Expecter) GetBalance(ctx interface{}, userUUID interface{}) *LedgerService_GetBalance_Call {
+	return &LedgerService_GetBalance_Call{Call: _e.mock.On("GetBalance", ctx, userUUID)}
+}
+
+func (_c *LedgerService_GetBalance_Call) Run(run func(ctx context.Context, userUUID string)) *LedgerService_GetBalance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *LedgerService_GetBalance_Call) Return(_a0 float64, _a1 error) *LedgerService_GetBalance_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LedgerService_GetBalance_Call) RunAndReturn(run func(context.Context, string) (float64, error)) *LedgerService_GetBalance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetStatement provides a mock function with given fields: ctx, req
+func (_m *LedgerService) GetStatement(ctx context.Context, req *model.StatementRequest) (*model.Statement, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetStatement")
+	}
+
+	var r0 *model.Statement
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StatementRequest) (*model.Statement, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.StatementRequest) *model.Statement); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Statement)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.StatementRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LedgerService_GetStatement_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetStatement'
+type LedgerService_GetStatement_Call struct {
+	*mock.Call
+}
+
+// GetStatement is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *model.StatementRequest
+func (_e *LedgerService_Expecter) GetStatement(ctx interface{}, req interface{}) *LedgerService_GetStatement_Call {
+	return &LedgerService_GetStatement_Call{Call: _e.mock.On("GetStatement", ctx, req)}
+}
+
+func (_c *LedgerService_GetStatement_Call) Run(run func(ctx context.Context, req *model.StatementRequest)) *LedgerService_GetStatement_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.StatementRequest))
+	})
+	return _c
+}
+
+func (_c *LedgerService_GetStatement_Call) Return(_a0 *model.Statement, _a1 error) *LedgerService_GetStatement_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LedgerService_GetStatement_Call) RunAndReturn(run func(context.Context, *model.StatementRequest) (*model.Statement, error)) *LedgerService_GetStatement_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// TopUp provides a mock function with given fields: ctx, req
+func (_m *LedgerService) TopUp(ctx context.Context, req *model.TopUpRequest) (*model.TopUpResult, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for TopUp")
+	}
+
+	var r0 *model.TopUpResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.TopUpRequest) (*model.TopUpResult, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.TopUpRequest) *model.TopUpResult); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TopUpResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.TopUpRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LedgerService_TopUp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'TopUp'
+type LedgerService_TopUp_Call struct {
+	*mock.Call
+}
+
+// TopUp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *model.TopUpRequest
+func (_e *LedgerService_Expecter) TopUp(ctx interface{}, req interface{}) *LedgerService_TopUp_Call {
+	return &LedgerService_TopUp_Call{Call: _e.mock.On("TopUp", ctx, req)}
+}
+
+func (_c *LedgerService_TopUp_Call) Run(run func(ctx context.Context, req *model.TopUpRequest)) *LedgerService_TopUp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.TopUpRequest))
+	})
+	return _c
+}
+
+func (_c *LedgerService_TopUp_Call) Return(_a0 *model.TopUpResult, _a1 error) *LedgerService_TopUp_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LedgerService_TopUp_Call) RunAndReturn(run func(context.Context, *model.TopUpRequest) (*model.TopUpResult, error)) *LedgerService_TopUp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewLedgerService creates a new instance of LedgerService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewLedgerService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *LedgerService {
+	mock := &LedgerService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
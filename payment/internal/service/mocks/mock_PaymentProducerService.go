@@ -0,0 +1,133 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PaymentProducerService is an autogenerated mock type for the PaymentProducerService type
+type PaymentProducerService struct {
+	mock.Mock
+}
+
+type PaymentProducerService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *PaymentProducerService) EXPECT() *PaymentProducerService_Expecter {
+	return &PaymentProducerService_Expecter{mock: &_m.Mock}
+}
+
+// PublishPaymentFailed provides a mock function with given fields: ctx, event
+func (_m *PaymentProducerService) PublishPaymentFailed(ctx context.Context, event *model.PaymentEvent) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishPaymentFailed")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PaymentEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PaymentProducerService_PublishPaymentFailed_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishPaymentFailed'
+type PaymentProducerService_PublishPaymentFailed_Call struct {
+	*mock.Call
+}
+
+// PublishPaymentFailed is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *model.PaymentEvent
+func (_e *PaymentProducerService_Expecter) PublishPaymentFailed(ctx interface{}, event interface{}) *PaymentProducerService_PublishPaymentFailed_Call {
+	return &PaymentProducerService_PublishPaymentFailed_Call{Call: _e.mock.On("PublishPaymentFailed", ctx, event)}
+}
+
+func (_c *PaymentProducerService_PublishPaymentFailed_Call) Run(run func(ctx context.Context, event *model.PaymentEvent)) *PaymentProducerService_PublishPaymentFailed_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.PaymentEvent))
+	})
+	return _c
+}
+
+func (_c *PaymentProducerService_PublishPaymentFailed_Call) Return(_a0 error) *PaymentProducerService_PublishPaymentFailed_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentProducerService_PublishPaymentFailed_Call) RunAndReturn(run func(context.Context, *model.PaymentEvent) error) *PaymentProducerService_PublishPaymentFailed_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PublishPaymentSucceeded provides a mock function with given fields: ctx, event
+func (_m *PaymentProducerService) PublishPaymentSucceeded(ctx context.Context, event *model.PaymentEvent) error {
+	ret := _m.Called(ctx, event)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishPaymentSucceeded")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PaymentEvent) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PaymentProducerService_PublishPaymentSucceeded_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishPaymentSucceeded'
+type PaymentProducerService_PublishPaymentSucceeded_Call struct {
+	*mock.Call
+}
+
+// PublishPaymentSucceeded is a helper method to define mock.On call
+//   - ctx context.Context
+//   - event *model.PaymentEvent
+func (_e *PaymentProducerService_Expecter) PublishPaymentSucceeded(ctx interface{}, event interface{}) *PaymentProducerService_PublishPaymentSucceeded_Call {
+	return &PaymentProducerService_PublishPaymentSucceeded_Call{Call: _e.mock.On("PublishPaymentSucceeded", ctx, event)}
+}
+
+func (_c *PaymentProducerService_PublishPaymentSucceeded_Call) Run(run func(ctx context.Context, event *model.PaymentEvent)) *PaymentProducerService_PublishPaymentSucceeded_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.PaymentEvent))
+	})
+	return _c
+}
+
+func (_c *PaymentProducerService_PublishPaymentSucceeded_Call) Return(_a0 error) *PaymentProducerService_PublishPaymentSucceeded_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *PaymentProducerService_PublishPaymentSucceeded_Call) RunAndReturn(run func(context.Context, *model.PaymentEvent) error) *PaymentProducerService_PublishPaymentSucceeded_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewPaymentProducerService creates a new instance of PaymentProducerService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPaymentProducerService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PaymentProducerService {
+	mock := &PaymentProducerService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
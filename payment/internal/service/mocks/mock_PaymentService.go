@@ -9,6 +9,8 @@ import (
 
 	model "github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // PaymentService is an autogenerated mock type for the PaymentService type
@@ -24,6 +26,124 @@ func (_m *PaymentService) EXPECT() *PaymentService_Expecter {
 	return &PaymentService_Expecter{mock: &_m.Mock}
 }
 
+// ConfirmPayment provides a mock function with given fields: ctx, req
+func (_m *PaymentService) ConfirmPayment(ctx context.Context, req *model.ConfirmPaymentRequest) (*model.Transaction, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmPayment")
+	}
+
+	var r0 *model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ConfirmPaymentRequest) (*model.Transaction, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ConfirmPaymentRequest) *model.Transaction); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.ConfirmPaymentRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentService_ConfirmPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmPayment'
+type PaymentService_ConfirmPayment_Call struct {
+	*mock.Call
+}
+
+// ConfirmPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *model.ConfirmPaymentRequest
+func (_e *PaymentService_Expecter) ConfirmPayment(ctx interface{}, req interface{}) *PaymentService_ConfirmPayment_Call {
+	return &PaymentService_ConfirmPayment_Call{Call: _e.mock.On("ConfirmPayment", ctx, req)}
+}
+
+func (_c *PaymentService_ConfirmPayment_Call) Run(run func(ctx context.Context, req *model.ConfirmPaymentRequest)) *PaymentService_ConfirmPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.ConfirmPaymentRequest))
+	})
+	return _c
+}
+
+func (_c *PaymentService_ConfirmPayment_Call) Return(_a0 *model.Transaction, _a1 error) *PaymentService_ConfirmPayment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentService_ConfirmPayment_Call) RunAndReturn(run func(context.Context, *model.ConfirmPaymentRequest) (*model.Transaction, error)) *PaymentService_ConfirmPayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPayment provides a mock function with given fields: ctx, transactionUUID
+func (_m *PaymentService) GetPayment(ctx context.Context, transactionUUID string) (*model.Transaction, error) {
+	ret := _m.Called(ctx, transactionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPayment")
+	}
+
+	var r0 *model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Transaction, error)); ok {
+		return rf(ctx, transactionUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Transaction); ok {
+		r0 = rf(ctx, transactionUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, transactionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentService_GetPayment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPayment'
+type PaymentService_GetPayment_Call struct {
+	*mock.Call
+}
+
+// GetPayment is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID string
+func (_e *PaymentService_Expecter) GetPayment(ctx interface{}, transactionUUID interface{}) *PaymentService_GetPayment_Call {
+	return &PaymentService_GetPayment_Call{Call: _e.mock.On("GetPayment", ctx, transactionUUID)}
+}
+
+func (_c *PaymentService_GetPayment_Call) Run(run func(ctx context.Context, transactionUUID string)) *PaymentService_GetPayment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *PaymentService_GetPayment_Call) Return(_a0 *model.Transaction, _a1 error) *PaymentService_GetPayment_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentService_GetPayment_Call) RunAndReturn(run func(context.Context, string) (*model.Transaction, error)) *PaymentService_GetPayment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // PayOrder provides a mock function with given fields: ctx, req
 func (_m *PaymentService) PayOrder(ctx context.Context, req *model.PayOrderRequest) (*model.PayOrderResponse, error) {
 	ret := _m.Called(ctx, req)
@@ -83,6 +203,63 @@ func (_c *PaymentService_PayOrder_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// PublishPendingResults provides a mock function with given fields: ctx, now
+func (_m *PaymentService) PublishPendingResults(ctx context.Context, now time.Time) (int, error) {
+	ret := _m.Called(ctx, now)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishPendingResults")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int, error)); ok {
+		return rf(ctx, now)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int); ok {
+		r0 = rf(ctx, now)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PaymentService_PublishPendingResults_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishPendingResults'
+type PaymentService_PublishPendingResults_Call struct {
+	*mock.Call
+}
+
+// PublishPendingResults is a helper method to define mock.On call
+//   - ctx context.Context
+//   - now time.Time
+func (_e *PaymentService_Expecter) PublishPendingResults(ctx interface{}, now interface{}) *PaymentService_PublishPendingResults_Call {
+	return &PaymentService_PublishPendingResults_Call{Call: _e.mock.On("PublishPendingResults", ctx, now)}
+}
+
+func (_c *PaymentService_PublishPendingResults_Call) Run(run func(ctx context.Context, now time.Time)) *PaymentService_PublishPendingResults_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(time.Time))
+	})
+	return _c
+}
+
+func (_c *PaymentService_PublishPendingResults_Call) Return(_a0 int, _a1 error) *PaymentService_PublishPendingResults_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *PaymentService_PublishPendingResults_Call) RunAndReturn(run func(context.Context, time.Time) (int, error)) *PaymentService_PublishPendingResults_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewPaymentService creates a new instance of PaymentService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewPaymentService(t interface {
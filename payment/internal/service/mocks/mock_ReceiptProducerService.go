@@ -0,0 +1,86 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReceiptProducerService is an autogenerated mock type for the ReceiptProducerService type
+type ReceiptProducerService struct {
+	mock.Mock
+}
+
+type ReceiptProducerService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReceiptProducerService) EXPECT() *ReceiptProducerService_Expecter {
+	return &ReceiptProducerService_Expecter{mock: &_m.Mock}
+}
+
+// PublishReceiptIssued provides a mock function with given fields: ctx, receipt
+func (_m *ReceiptProducerService) PublishReceiptIssued(ctx context.Context, receipt *model.Receipt) error {
+	ret := _m.Called(ctx, receipt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishReceiptIssued")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Receipt) error); ok {
+		r0 = rf(ctx, receipt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReceiptProducerService_PublishReceiptIssued_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishReceiptIssued'
+type ReceiptProducerService_PublishReceiptIssued_Call struct {
+	*mock.Call
+}
+
+// PublishReceiptIssued is a helper method to define mock.On call
+//   - ctx context.Context
+//   - receipt *model.Receipt
+func (_e *ReceiptProducerService_Expecter) PublishReceiptIssued(ctx interface{}, receipt interface{}) *ReceiptProducerService_PublishReceiptIssued_Call {
+	return &ReceiptProducerService_PublishReceiptIssued_Call{Call: _e.mock.On("PublishReceiptIssued", ctx, receipt)}
+}
+
+func (_c *ReceiptProducerService_PublishReceiptIssued_Call) Run(run func(ctx context.Context, receipt *model.Receipt)) *ReceiptProducerService_PublishReceiptIssued_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Receipt))
+	})
+	return _c
+}
+
+func (_c *ReceiptProducerService_PublishReceiptIssued_Call) Return(_a0 error) *ReceiptProducerService_PublishReceiptIssued_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReceiptProducerService_PublishReceiptIssued_Call) RunAndReturn(run func(context.Context, *model.Receipt) error) *ReceiptProducerService_PublishReceiptIssued_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReceiptProducerService creates a new instance of ReceiptProducerService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReceiptProducerService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReceiptProducerService {
+	mock := &ReceiptProducerService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
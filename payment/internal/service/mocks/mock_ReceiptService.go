@@ -0,0 +1,157 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReceiptService is an autogenerated mock type for the ReceiptService type
+type ReceiptService struct {
+	mock.Mock
+}
+
+type ReceiptService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReceiptService) EXPECT() *ReceiptService_Expecter {
+	return &ReceiptService_Expecter{mock: &_m.Mock}
+}
+
+// Get provides a mock function with given fields: ctx, transactionUUID
+func (_m *ReceiptService) Get(ctx context.Context, transactionUUID string) (*model.Receipt, error) {
+	ret := _m.Called(ctx, transactionUUID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *model.Receipt
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*model.Receipt, error)); ok {
+		return rf(ctx, transactionUUID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Receipt); ok {
+		r0 = rf(ctx, transactionUUID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Receipt)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, transactionUUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReceiptService_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type ReceiptService_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - transactionUUID string
+func (_e *ReceiptService_Expecter) Get(ctx interface{}, transactionUUID interface{}) *ReceiptService_Get_Call {
+	return &ReceiptService_Get_Call{Call: _e.mock.On("Get", ctx, transactionUUID)}
+}
+
+func (_c *ReceiptService_Get_Call) Run(run func(ctx context.Context, transactionUUID string)) *ReceiptService_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ReceiptService_Get_Call) Return(_a0 *model.Receipt, _a1 error) *ReceiptService_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReceiptService_Get_Call) RunAndReturn(run func(context.Context, string) (*model.Receipt, error)) *ReceiptService_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Issue provides a mock function with given fields: ctx, tx
+func (_m *ReceiptService) Issue(ctx context.Context, tx *model.Transaction) (*model.Receipt, error) {
+	ret := _m.Called(ctx, tx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Issue")
+	}
+
+	var r0 *model.Receipt
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Transaction) (*model.Receipt, error)); ok {
+		return rf(ctx, tx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Transaction) *model.Receipt); ok {
+		r0 = rf(ctx, tx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Receipt)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.Transaction) error); ok {
+		r1 = rf(ctx, tx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReceiptService_Issue_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Issue'
+type ReceiptService_Issue_Call struct {
+	*mock.Call
+}
+
+// Issue is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tx *model.Transaction
+func (_e *ReceiptService_Expecter) Issue(ctx interface{}, tx interface{}) *ReceiptService_Issue_Call {
+	return &ReceiptService_Issue_Call{Call: _e.mock.On("Issue", ctx, tx)}
+}
+
+func (_c *ReceiptService_Issue_Call) Run(run func(ctx context.Context, tx *model.Transaction)) *ReceiptService_Issue_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Transaction))
+	})
+	return _c
+}
+
+func (_c *ReceiptService_Issue_Call) Return(_a0 *model.Receipt, _a1 error) *ReceiptService_Issue_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReceiptService_Issue_Call) RunAndReturn(run func(context.Context, *model.Transaction) (*model.Receipt, error)) *ReceiptService_Issue_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReceiptService creates a new instance of ReceiptService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReceiptService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReceiptService {
+	mock := &ReceiptService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
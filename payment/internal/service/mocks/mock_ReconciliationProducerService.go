@@ -0,0 +1,86 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReconciliationProducerService is an autogenerated mock type for the ReconciliationProducerService type
+type ReconciliationProducerService struct {
+	mock.Mock
+}
+
+type ReconciliationProducerService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReconciliationProducerService) EXPECT() *ReconciliationProducerService_Expecter {
+	return &ReconciliationProducerService_Expecter{mock: &_m.Mock}
+}
+
+// PublishDiscrepancy provides a mock function with given fields: ctx, discrepancy
+func (_m *ReconciliationProducerService) PublishDiscrepancy(ctx context.Context, discrepancy *model.Discrepancy) error {
+	ret := _m.Called(ctx, discrepancy)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PublishDiscrepancy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Discrepancy) error); ok {
+		r0 = rf(ctx, discrepancy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReconciliationProducerService_PublishDiscrepancy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PublishDiscrepancy'
+type ReconciliationProducerService_PublishDiscrepancy_Call struct {
+	*mock.Call
+}
+
+// PublishDiscrepancy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - discrepancy *model.Discrepancy
+func (_e *ReconciliationProducerService_Expecter) PublishDiscrepancy(ctx interface{}, discrepancy interface{}) *ReconciliationProducerService_PublishDiscrepancy_Call {
+	return &ReconciliationProducerService_PublishDiscrepancy_Call{Call: _e.mock.On("PublishDiscrepancy", ctx, discrepancy)}
+}
+
+func (_c *ReconciliationProducerService_PublishDiscrepancy_Call) Run(run func(ctx context.Context, discrepancy *model.Discrepancy)) *ReconciliationProducerService_PublishDiscrepancy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Discrepancy))
+	})
+	return _c
+}
+
+func (_c *ReconciliationProducerService_PublishDiscrepancy_Call) Return(_a0 error) *ReconciliationProducerService_PublishDiscrepancy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ReconciliationProducerService_PublishDiscrepancy_Call) RunAndReturn(run func(context.Context, *model.Discrepancy) error) *ReconciliationProducerService_PublishDiscrepancy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReconciliationProducerService creates a new instance of ReconciliationProducerService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReconciliationProducerService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReconciliationProducerService {
+	mock := &ReconciliationProducerService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
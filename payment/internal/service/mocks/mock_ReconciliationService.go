@@ -0,0 +1,98 @@
+// Code generated by mockery. DO NOT EDIT.
+// © Daniil-Sakharov 2025
+// Code generated by mockery v2.53.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReconciliationService is an autogenerated mock type for the ReconciliationService type
+type ReconciliationService struct {
+	mock.Mock
+}
+
+type ReconciliationService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *ReconciliationService) EXPECT() *ReconciliationService_Expecter {
+	return &ReconciliationService_Expecter{mock: &_m.Mock}
+}
+
+// Reconcile provides a mock function with given fields: ctx, req
+func (_m *ReconciliationService) Reconcile(ctx context.Context, req *model.ReconciliationRequest) (*model.ReconciliationReport, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Reconcile")
+	}
+
+	var r0 *model.ReconciliationReport
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ReconciliationRequest) (*model.ReconciliationReport, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ReconciliationRequest) *model.ReconciliationReport); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ReconciliationReport)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *model.ReconciliationRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReconciliationService_Reconcile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Reconcile'
+type ReconciliationService_Reconcile_Call struct {
+	*mock.Call
+}
+
+// Reconcile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req *model.ReconciliationRequest
+func (_e *ReconciliationService_Expecter) Reconcile(ctx interface{}, req interface{}) *ReconciliationService_Reconcile_Call {
+	return &ReconciliationService_Reconcile_Call{Call: _e.mock.On("Reconcile", ctx, req)}
+}
+
+func (_c *ReconciliationService_Reconcile_Call) Run(run func(ctx context.Context, req *model.ReconciliationRequest)) *ReconciliationService_Reconcile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.ReconciliationRequest))
+	})
+	return _c
+}
+
+func (_c *ReconciliationService_Reconcile_Call) Return(_a0 *model.ReconciliationReport, _a1 error) *ReconciliationService_Reconcile_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ReconciliationService_Reconcile_Call) RunAndReturn(run func(context.Context, *model.ReconciliationRequest) (*model.ReconciliationReport, error)) *ReconciliationService_Reconcile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewReconciliationService creates a new instance of ReconciliationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewReconciliationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ReconciliationService {
+	mock := &ReconciliationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
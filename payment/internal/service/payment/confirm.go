@@ -0,0 +1,60 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+// ConfirmPayment фиксирует итоговый статус платежа по callback'у платежного шлюза
+func (s *svc) ConfirmPayment(ctx context.Context, req *model.ConfirmPaymentRequest) (*model.Transaction, error) {
+	if req.TransactionUUID == "" {
+		return nil, model.ErrEmptyTransactionUUID
+	}
+	if req.Status != model.PaymentStatusSucceeded && req.Status != model.PaymentStatusFailed {
+		return nil, model.ErrInvalidPaymentStatus
+	}
+
+	tx, err := s.transactionRepository.Get(ctx, req.TransactionUUID)
+	if err != nil {
+		if errors.Is(err, model.ErrTransactionNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	// Callback подтверждает только платежи, ушедшие в шлюз. Деньги инвестора и рассрочку проводит
+	// сам payment сервис, и их PENDING означает, что списание еще не выполнено
+	if tx.PaymentMethod == model.PaymentMethodInvestorMoney || tx.Installments > 1 {
+		return nil, model.ErrPaymentNotConfirmable
+	}
+
+	// Шлюз может повторять callback — повтор с тем же результатом не ошибка.
+	// Если событие о результате еще не ушло, повтор дает повод опубликовать его снова
+	if tx.Status == req.Status {
+		if tx.ResultPublishedAt == nil {
+			s.publishPaymentResult(ctx, tx)
+		}
+		return tx, nil
+	}
+	if tx.Status != model.PaymentStatusPending {
+		return nil, model.ErrPaymentAlreadyProcessed
+	}
+
+	err = s.transactionRepository.UpdateStatus(ctx, tx.TransactionUUID, req.Status, req.FailureReason)
+	if err != nil {
+		if errors.Is(err, model.ErrPaymentAlreadyProcessed) || errors.Is(err, model.ErrTransactionNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to update transaction: %w", err)
+	}
+
+	tx.Status = req.Status
+	tx.FailureReason = req.FailureReason
+
+	s.publishPaymentResult(ctx, tx)
+
+	return tx, nil
+}
@@ -0,0 +1,211 @@
+package payment
+
+import (
+	"errors"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+func (s *ServiceSuite) TestConfirmPaymentSucceeded() {
+	var (
+		transactionUUID = gofakeit.UUID()
+		orderUUID       = gofakeit.UUID()
+
+		request = &model.ConfirmPaymentRequest{
+			TransactionUUID: transactionUUID,
+			Status:          model.PaymentStatusSucceeded,
+		}
+
+		txFromDB = &model.Transaction{
+			TransactionUUID: transactionUUID,
+			OrderUUID:       orderUUID,
+			UserUUID:        gofakeit.UUID(),
+			PaymentMethod:   model.PaymentMethodSBP,
+			Status:          model.PaymentStatusPending,
+		}
+	)
+
+	s.transactionRepository.On("Get", s.ctx, transactionUUID).Return(txFromDB, nil)
+	s.transactionRepository.On("UpdateStatus", s.ctx, transactionUUID, model.PaymentStatusSucceeded, "").Return(nil)
+	s.paymentProducer.On("PublishPaymentSucceeded", s.ctx, mock.MatchedBy(func(event *model.PaymentEvent) bool {
+		return event.TransactionUUID == transactionUUID && event.OrderUUID == orderUUID
+	})).Return(nil)
+	s.expectResultPublished()
+	s.expectReceiptIssued()
+
+	tx, err := s.service.ConfirmPayment(s.ctx, request)
+
+	s.Require().NoError(err)
+	s.Require().Equal(model.PaymentStatusSucceeded, tx.Status)
+}
+
+func (s *ServiceSuite) TestConfirmPaymentFailed() {
+	var (
+		transactionUUID = gofakeit.UUID()
+
+		request = &model.ConfirmPaymentRequest{
+			TransactionUUID: transactionUUID,
+			Status:          model.PaymentStatusFailed,
+			FailureReason:   "insufficient funds",
+		}
+
+		txFromDB = &model.Transaction{
+			TransactionUUID: transactionUUID,
+			OrderUUID:       gofakeit.UUID(),
+			Status:          model.PaymentStatusPending,
+		}
+	)
+
+	s.transactionRepository.On("Get", s.ctx, transactionUUID).Return(txFromDB, nil)
+	s.transactionRepository.On("UpdateStatus", s.ctx, transactionUUID, model.PaymentStatusFailed, "insufficient funds").Return(nil)
+	s.paymentProducer.On("PublishPaymentFailed", s.ctx, mock.MatchedBy(func(event *model.PaymentEvent) bool {
+		return event.Reason == "insufficient funds"
+	})).Return(nil)
+	s.expectResultPublished()
+
+	tx, err := s.service.ConfirmPayment(s.ctx, request)
+
+	s.Require().NoError(err)
+	s.Require().Equal(model.PaymentStatusFailed, tx.Status)
+}
+
+func (s *ServiceSuite) TestConfirmPaymentRepeatedCallback() {
+	var (
+		transactionUUID = gofakeit.UUID()
+
+		request = &model.ConfirmPaymentRequest{
+			TransactionUUID: transactionUUID,
+			Status:          model.PaymentStatusSucceeded,
+		}
+
+		publishedAt = time.Now()
+
+		txFromDB = &model.Transaction{
+			TransactionUUID:   transactionUUID,
+			Status:            model.PaymentStatusSucceeded,
+			ResultPublishedAt: &publishedAt,
+		}
+	)
+
+	s.transactionRepository.On("Get", s.ctx, transactionUUID).Return(txFromDB, nil)
+
+	tx, err := s.service.ConfirmPayment(s.ctx, request)
+
+	s.Require().NoError(err)
+	s.Require().Equal(model.PaymentStatusSucceeded, tx.Status)
+	s.paymentProducer.AssertNotCalled(s.T(), "PublishPaymentSucceeded", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestConfirmPaymentRepeatedCallbackRepublishes() {
+	var (
+		transactionUUID = gofakeit.UUID()
+
+		request = &model.ConfirmPaymentRequest{
+			TransactionUUID: transactionUUID,
+			Status:          model.PaymentStatusSucceeded,
+		}
+
+		txFromDB = &model.Transaction{
+			TransactionUUID: transactionUUID,
+			Status:          model.PaymentStatusSucceeded,
+		}
+	)
+
+	s.transactionRepository.On("Get", s.ctx, transactionUUID).Return(txFromDB, nil)
+	s.paymentProducer.On("PublishPaymentSucceeded", s.ctx, mock.MatchedBy(func(event *model.PaymentEvent) bool {
+		return event.TransactionUUID == transactionUUID
+	})).Return(nil).Once()
+	s.expectReceiptIssued()
+	s.transactionRepository.On("MarkResultPublished", s.ctx, transactionUUID).Return(nil).Once()
+
+	tx, err := s.service.ConfirmPayment(s.ctx, request)
+
+	s.Require().NoError(err)
+	s.Require().Equal(model.PaymentStatusSucceeded, tx.Status)
+}
+
+func (s *ServiceSuite) TestConfirmPaymentPublishError() {
+	var (
+		transactionUUID = gofakeit.UUID()
+
+		request = &model.ConfirmPaymentRequest{
+			TransactionUUID: transactionUUID,
+			Status:          model.PaymentStatusSucceeded,
+		}
+
+		txFromDB = &model.Transaction{
+			TransactionUUID: transactionUUID,
+			Status:          model.PaymentStatusPending,
+		}
+	)
+
+	s.transactionRepository.On("Get", s.ctx, transactionUUID).Return(txFromDB, nil)
+	s.transactionRepository.On("UpdateStatus", s.ctx, transactionUUID, model.PaymentStatusSucceeded, "").Return(nil)
+	s.paymentProducer.On("PublishPaymentSucceeded", s.ctx, mock.AnythingOfType("*model.PaymentEvent")).
+		Return(errors.New("kafka unavailable")).Once()
+
+	tx, err := s.service.ConfirmPayment(s.ctx, request)
+
+	// Статус уже сохранен, событие дошлет PublishPendingResults
+	s.Require().NoError(err)
+	s.Require().Equal(model.PaymentStatusSucceeded, tx.Status)
+	s.transactionRepository.AssertNotCalled(s.T(), "MarkResultPublished", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestConfirmPaymentAlreadyProcessed() {
+	var (
+		transactionUUID = gofakeit.UUID()
+
+		request = &model.ConfirmPaymentRequest{
+			TransactionUUID: transactionUUID,
+			Status:          model.PaymentStatusFailed,
+		}
+
+		txFromDB = &model.Transaction{
+			TransactionUUID: transactionUUID,
+			Status:          model.PaymentStatusSucceeded,
+		}
+	)
+
+	s.transactionRepository.On("Get", s.ctx, transactionUUID).Return(txFromDB, nil)
+
+	tx, err := s.service.ConfirmPayment(s.ctx, request)
+
+	s.Require().ErrorIs(err, model.ErrPaymentAlreadyProcessed)
+	s.Require().Nil(tx)
+}
+
+func (s *ServiceSuite) TestConfirmPaymentInvalidStatus() {
+	request := &model.ConfirmPaymentRequest{
+		TransactionUUID: gofakeit.UUID(),
+		Status:          model.PaymentStatusPending,
+	}
+
+	tx, err := s.service.ConfirmPayment(s.ctx, request)
+
+	s.Require().ErrorIs(err, model.ErrInvalidPaymentStatus)
+	s.Require().Nil(tx)
+}
+
+func (s *ServiceSuite) TestConfirmPaymentInternalMethodsNotConfirmable() {
+	for _, txFromDB := range []*model.Transaction{
+		{TransactionUUID: gofakeit.UUID(), PaymentMethod: model.PaymentMethodInvestorMoney, Status: model.PaymentStatusPending},
+		{TransactionUUID: gofakeit.UUID(), PaymentMethod: model.PaymentMethodCreditCard, Installments: 12, Status: model.PaymentStatusPending},
+	} {
+		s.transactionRepository.On("Get", s.ctx, txFromDB.TransactionUUID).Return(txFromDB, nil)
+
+		tx, err := s.service.ConfirmPayment(s.ctx, &model.ConfirmPaymentRequest{
+			TransactionUUID: txFromDB.TransactionUUID,
+			Status:          model.PaymentStatusSucceeded,
+		})
+
+		// PENDING до списания со счета или первого платежа рассрочки нельзя закрыть callback'ом
+		s.Require().ErrorIs(err, model.ErrPaymentNotConfirmable)
+		s.Require().Nil(tx)
+	}
+	s.transactionRepository.AssertNotCalled(s.T(), "UpdateStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,27 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+// GetPayment возвращает транзакцию по UUID. Order сервис по ней решает судьбу закрепления,
+// если ответ на PayOrder не дошел
+func (s *svc) GetPayment(ctx context.Context, transactionUUID string) (*model.Transaction, error) {
+	if transactionUUID == "" {
+		return nil, model.ErrEmptyTransactionUUID
+	}
+
+	tx, err := s.transactionRepository.Get(ctx, transactionUUID)
+	if err != nil {
+		if errors.Is(err, model.ErrTransactionNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get transaction: %w", err)
+	}
+
+	return tx, nil
+}
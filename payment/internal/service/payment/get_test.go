@@ -0,0 +1,30 @@
+package payment
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+func (s *ServiceSuite) TestGetPayment() {
+	transactionUUID := gofakeit.UUID()
+
+	s.transactionRepository.On("Get", s.ctx, transactionUUID).Return(&model.Transaction{
+		TransactionUUID: transactionUUID,
+		Status:          model.PaymentStatusPending,
+	}, nil)
+
+	tx, err := s.service.GetPayment(s.ctx, transactionUUID)
+	s.Require().NoError(err)
+	s.Require().Equal(model.PaymentStatusPending, tx.Status)
+}
+
+func (s *ServiceSuite) TestGetPaymentNotFound() {
+	transactionUUID := gofakeit.UUID()
+
+	s.transactionRepository.On("Get", s.ctx, transactionUUID).Return(nil, model.ErrTransactionNotFound)
+
+	tx, err := s.service.GetPayment(s.ctx, transactionUUID)
+	s.Require().ErrorIs(err, model.ErrTransactionNotFound)
+	s.Require().Nil(tx)
+}
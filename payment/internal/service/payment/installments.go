@@ -0,0 +1,85 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+// payWithInstallments оформляет оплату кредитной картой в рассрочку.
+// Транзакция сохраняется в PENDING до создания плана: план ссылается на транзакцию,
+// а итоговый статус определяется списанием первого платежа. Если план создать или списать первый платеж
+// не удалось, транзакция переводится в FAILED
+func (s *svc) payWithInstallments(ctx context.Context, tx *model.Transaction, count int32) (*model.PayOrderResponse, error) {
+	tx.Status = model.PaymentStatusPending
+	tx.Installments = count
+	if err := s.transactionRepository.Create(ctx, tx); err != nil {
+		return nil, fmt.Errorf("failed to save transaction: %w", err)
+	}
+
+	plan, err := s.installmentService.CreatePlan(ctx, tx, count)
+	if err != nil {
+		err = fmt.Errorf("failed to create installment plan: %w", err)
+		s.failTransaction(ctx, tx, err)
+		return nil, err
+	}
+
+	if plan.Status == model.InstallmentPlanStatusCancelled {
+		tx.Status = model.PaymentStatusFailed
+		tx.FailureReason = plan.Installments[0].FailureReason
+	} else {
+		tx.Status = model.PaymentStatusSucceeded
+	}
+
+	if err = s.transactionRepository.UpdateStatus(ctx, tx.TransactionUUID, tx.Status, tx.FailureReason); err != nil {
+		return nil, fmt.Errorf("failed to update transaction: %w", err)
+	}
+
+	logger.Info(ctx, "📆 Оплата в рассрочку",
+		zap.String("transaction_uuid", tx.TransactionUUID),
+		zap.String("order_uuid", tx.OrderUUID),
+		zap.String("plan_uuid", plan.PlanUUID),
+		zap.Int32("installments", count),
+		zap.Int32("status", int32(tx.Status)),
+	)
+
+	s.publishPaymentResult(ctx, tx)
+
+	return &model.PayOrderResponse{
+		TransactionUUID:     tx.TransactionUUID,
+		Status:              tx.Status,
+		InstallmentPlanUUID: plan.PlanUUID,
+	}, nil
+}
+
+// chargedTransaction возвращает транзакцию с фактически списанной суммой для чека.
+// При оплате в рассрочку сразу списывается только первый платеж, поэтому чек выдается на него,
+// а не на полную сумму заказа
+func (s *svc) chargedTransaction(ctx context.Context, tx *model.Transaction) (*model.Transaction, error) {
+	if tx.Installments <= 1 {
+		return tx, nil
+	}
+
+	plan, err := s.installmentService.GetByTransaction(ctx, tx.TransactionUUID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get installment plan: %w", err)
+	}
+	if len(plan.Installments) == 0 {
+		return nil, fmt.Errorf("installment plan %s has no installments", plan.PlanUUID)
+	}
+	first := plan.Installments[0]
+
+	charged := *tx
+	charged.Amount = first.Amount
+	charged.Items = []model.PaymentItem{{
+		Name:      fmt.Sprintf("Платеж %d из %d по рассрочке за заказ %s", first.Number, len(plan.Installments), tx.OrderUUID),
+		Quantity:  1,
+		UnitPrice: first.Amount,
+	}}
+
+	return &charged, nil
+}
@@ -0,0 +1,156 @@
+package payment
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+func (s *ServiceSuite) TestPayOrderWithInstallments() {
+	var (
+		orderUUID = gofakeit.UUID()
+		userUUID  = gofakeit.UUID()
+		planUUID  = gofakeit.UUID()
+		amount    = 15_000_000.00
+
+		request = &model.PayOrderRequest{
+			OrderUUID:     orderUUID,
+			UserUUID:      userUUID,
+			PaymentMethod: model.PaymentMethodCreditCard,
+			Amount:        amount,
+			Installments:  12,
+		}
+	)
+
+	s.installmentService.On("CheckEligibility", amount, int32(12)).Return(nil)
+	s.expectFraudVerdict(model.FraudVerdictApprove)
+	s.transactionRepository.On("Create", s.ctx, mock.MatchedBy(func(tx *model.Transaction) bool {
+		return tx.OrderUUID == orderUUID && tx.Status == model.PaymentStatusPending
+	})).Return(nil)
+	s.installmentService.On("CreatePlan", s.ctx, mock.AnythingOfType("*model.Transaction"), int32(12)).
+		Return(&model.InstallmentPlan{
+			PlanUUID: planUUID,
+			Status:   model.InstallmentPlanStatusActive,
+		}, nil)
+	s.transactionRepository.On("UpdateStatus", s.ctx, mock.AnythingOfType("string"), model.PaymentStatusSucceeded, "").Return(nil)
+	s.paymentProducer.On("PublishPaymentSucceeded", s.ctx, mock.AnythingOfType("*model.PaymentEvent")).Return(nil)
+	s.installmentService.On("GetByTransaction", s.ctx, mock.AnythingOfType("string")).
+		Return(&model.InstallmentPlan{
+			PlanUUID: planUUID,
+			Installments: []*model.Installment{
+				{Number: 1, Amount: 1_250_000.00, Status: model.InstallmentStatusPaid},
+				{Number: 2, Amount: 1_250_000.00, Status: model.InstallmentStatusScheduled},
+			},
+		}, nil).Once()
+	// Чек выдается на списанный первый платеж, а не на всю сумму заказа
+	s.receiptService.On("Issue", s.ctx, mock.MatchedBy(func(tx *model.Transaction) bool {
+		return tx.Amount == 1_250_000.00 && len(tx.Items) == 1 && tx.Items[0].UnitPrice == 1_250_000.00
+	})).Return(&model.Receipt{}, nil).Once()
+	s.expectResultPublished()
+
+	response, err := s.service.PayOrder(s.ctx, request)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(response)
+	s.Require().Equal(model.PaymentStatusSucceeded, response.Status)
+	s.Require().Equal(planUUID, response.InstallmentPlanUUID)
+	s.paymentGateway.AssertNotCalled(s.T(), "Charge", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestPayOrderWithInstallmentsFirstChargeDeclined() {
+	var (
+		reason = "card declined"
+
+		request = &model.PayOrderRequest{
+			OrderUUID:     gofakeit.UUID(),
+			UserUUID:      gofakeit.UUID(),
+			PaymentMethod: model.PaymentMethodCreditCard,
+			Amount:        1_200_000.00,
+			Installments:  6,
+		}
+	)
+
+	s.installmentService.On("CheckEligibility", request.Amount, int32(6)).Return(nil)
+	s.expectFraudVerdict(model.FraudVerdictApprove)
+	s.transactionRepository.On("Create", s.ctx, mock.AnythingOfType("*model.Transaction")).Return(nil)
+	s.installmentService.On("CreatePlan", s.ctx, mock.AnythingOfType("*model.Transaction"), int32(6)).
+		Return(&model.InstallmentPlan{
+			PlanUUID: gofakeit.UUID(),
+			Status:   model.InstallmentPlanStatusCancelled,
+			Installments: []*model.Installment{
+				{Number: 1, Status: model.InstallmentStatusMissed, FailureReason: reason},
+			},
+		}, nil)
+	s.transactionRepository.On("UpdateStatus", s.ctx, mock.AnythingOfType("string"), model.PaymentStatusFailed, reason).Return(nil)
+	s.paymentProducer.On("PublishPaymentFailed", s.ctx, mock.MatchedBy(func(event *model.PaymentEvent) bool {
+		return event.Reason == reason
+	})).Return(nil)
+	s.expectResultPublished()
+
+	response, err := s.service.PayOrder(s.ctx, request)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(response)
+	s.Require().Equal(model.PaymentStatusFailed, response.Status)
+}
+
+func (s *ServiceSuite) TestPayOrderWithInstallmentsPlanError() {
+	request := &model.PayOrderRequest{
+		OrderUUID:     gofakeit.UUID(),
+		UserUUID:      gofakeit.UUID(),
+		PaymentMethod: model.PaymentMethodCreditCard,
+		Amount:        1_200_000.00,
+		Installments:  6,
+	}
+
+	s.installmentService.On("CheckEligibility", request.Amount, int32(6)).Return(nil)
+	s.expectFraudVerdict(model.FraudVerdictApprove)
+	s.transactionRepository.On("Create", s.ctx, mock.AnythingOfType("*model.Transaction")).Return(nil)
+	s.installmentService.On("CreatePlan", s.ctx, mock.AnythingOfType("*model.Transaction"), int32(6)).
+		Return(nil, errors.New("gateway timeout"))
+	// Транзакция не остается в PENDING: PaymentFailed по ней дошлет job
+	s.transactionRepository.On("UpdateStatus", s.ctx, mock.AnythingOfType("string"), model.PaymentStatusFailed,
+		mock.MatchedBy(func(reason string) bool { return strings.Contains(reason, "gateway timeout") })).Return(nil).Once()
+
+	response, err := s.service.PayOrder(s.ctx, request)
+
+	s.Require().Error(err)
+	s.Require().Nil(response)
+}
+
+func (s *ServiceSuite) TestPayOrderInstallmentsNotAllowed() {
+	request := &model.PayOrderRequest{
+		OrderUUID:     gofakeit.UUID(),
+		UserUUID:      gofakeit.UUID(),
+		PaymentMethod: model.PaymentMethodCard,
+		Amount:        1_200_000.00,
+		Installments:  6,
+	}
+
+	response, err := s.service.PayOrder(s.ctx, request)
+
+	s.Require().ErrorIs(err, model.ErrInstallmentsNotAllowed)
+	s.Require().Nil(response)
+	s.fraudService.AssertNotCalled(s.T(), "Evaluate", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestPayOrderInstallmentsNotEligible() {
+	request := &model.PayOrderRequest{
+		OrderUUID:     gofakeit.UUID(),
+		UserUUID:      gofakeit.UUID(),
+		PaymentMethod: model.PaymentMethodCreditCard,
+		Amount:        500.00,
+		Installments:  6,
+	}
+
+	s.installmentService.On("CheckEligibility", request.Amount, int32(6)).Return(model.ErrInvalidInstallments)
+
+	response, err := s.service.PayOrder(s.ctx, request)
+
+	s.Require().ErrorIs(err, model.ErrInvalidInstallments)
+	s.Require().Nil(response)
+}
@@ -0,0 +1,57 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+// payWithInvestorMoney проводит оплату с внутреннего счета инвестора.
+// Транзакция сохраняется в PENDING до списания, чтобы проводка в журнале всегда ссылалась на существующую транзакцию.
+// Debit проводится в собственной транзакции БД, поэтому при ошибке списания деньги не списаны и транзакция переводится в FAILED
+func (s *svc) payWithInvestorMoney(ctx context.Context, tx *model.Transaction, amount float64) (*model.PayOrderResponse, error) {
+	tx.Status = model.PaymentStatusPending
+	if err := s.transactionRepository.Create(ctx, tx); err != nil {
+		return nil, fmt.Errorf("failed to save transaction: %w", err)
+	}
+
+	err := s.ledgerRepository.Debit(ctx, tx.UserUUID, amount, tx.TransactionUUID)
+	switch {
+	case err == nil:
+		tx.Status = model.PaymentStatusSucceeded
+	case errors.Is(err, model.ErrInsufficientFunds):
+		tx.Status = model.PaymentStatusFailed
+		tx.FailureReason = err.Error()
+	default:
+		err = fmt.Errorf("failed to debit investor account: %w", err)
+		s.failTransaction(ctx, tx, err)
+		return nil, err
+	}
+
+	if err = s.transactionRepository.UpdateStatus(ctx, tx.TransactionUUID, tx.Status, tx.FailureReason); err != nil {
+		return nil, fmt.Errorf("failed to update transaction: %w", err)
+	}
+
+	logger.Info(ctx, "🏦 Оплата деньгами инвестора",
+		zap.String("transaction_uuid", tx.TransactionUUID),
+		zap.String("order_uuid", tx.OrderUUID),
+		zap.Float64("amount", amount),
+		zap.Int32("status", int32(tx.Status)),
+	)
+
+	s.publishPaymentResult(ctx, tx)
+
+	if tx.Status == model.PaymentStatusFailed {
+		return nil, model.ErrInsufficientFunds
+	}
+
+	return &model.PayOrderResponse{
+		TransactionUUID: tx.TransactionUUID,
+		Status:          tx.Status,
+	}, nil
+}
@@ -2,11 +2,14 @@ package payment
 
 import (
 	"context"
-	"log"
+	"errors"
+	"fmt"
 
 	"github.com/google/uuid"
+	"go.uber.org/zap"
 
 	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
 )
 
 // PayOrder обрабатывает платеж заказа
@@ -16,22 +19,90 @@ func (s *svc) PayOrder(ctx context.Context, req *model.PayOrderRequest) (*model.
 		return nil, err
 	}
 
-	// 2. Генерация UUID транзакции
-	transactionUUID := uuid.NewString()
-	log.Printf("Оплата прошла успешно, transaction_uuid: %s", transactionUUID)
+	// Заказ закрепляет UUID транзакции заранее: повтор с тем же UUID не проводит платеж второй раз
+	transactionUUID := req.TransactionUUID
+	if transactionUUID == "" {
+		transactionUUID = uuid.NewString()
+	} else if err := s.ensureNewTransaction(ctx, transactionUUID); err != nil {
+		return nil, err
+	}
+
+	tx := &model.Transaction{
+		TransactionUUID: transactionUUID,
+		OrderUUID:       req.OrderUUID,
+		UserUUID:        req.UserUUID,
+		PaymentMethod:   req.PaymentMethod,
+		Amount:          req.Amount,
+		Items:           req.Items,
+	}
+
+	// 2. Антифрод-проверка: REJECT завершает платеж, REVIEW проводится и попадает на ручной разбор
+	decision, err := s.fraudService.Evaluate(ctx, &model.FraudCheck{
+		TransactionUUID: tx.TransactionUUID,
+		OrderUUID:       tx.OrderUUID,
+		UserUUID:        tx.UserUUID,
+		OrderOwnerUUID:  req.OrderOwnerUUID,
+		PaymentMethod:   tx.PaymentMethod,
+		Amount:          req.Amount,
+		Installments:    req.Installments,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to screen payment: %w", err)
+	}
+	if decision.Verdict == model.FraudVerdictReject {
+		return s.rejectPayment(ctx, tx, decision)
+	}
+
+	// 3. Деньги инвестора списываются с внутреннего счета, остальные методы идут через шлюз
+	if req.PaymentMethod == model.PaymentMethodInvestorMoney {
+		return s.payWithInvestorMoney(ctx, tx, req.Amount)
+	}
+
+	// Рассрочка: первый платеж списывается сразу, остальные - job'ом по графику
+	if req.Installments > 1 {
+		return s.payWithInstallments(ctx, tx, req.Installments)
+	}
+
+	// 4. Обращение к платежному шлюзу
+	result, err := s.paymentGateway.Charge(ctx, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to charge payment: %w", err)
+	}
+	tx.Status = result.Status
+	tx.FailureReason = result.FailureReason
+
+	// 5. Сохранение транзакции
+	if err = s.transactionRepository.Create(ctx, tx); err != nil {
+		return nil, fmt.Errorf("failed to save transaction: %w", err)
+	}
+
+	logger.Info(ctx, "💳 Платеж создан",
+		zap.String("transaction_uuid", tx.TransactionUUID),
+		zap.String("order_uuid", tx.OrderUUID),
+		zap.Int32("status", int32(tx.Status)),
+	)
 
-	// 3. Здесь могла бы быть логика:
-	// - Вызов платежного шлюза (Stripe, PayPal)
-	// - Проверка баланса пользователя
-	// - Сохранение транзакции в БД
-	// - Отправка события "PaymentProcessed"
+	// 6. Синхронно завершенные платежи сразу публикуем, PENDING ждет callback
+	s.publishPaymentResult(ctx, tx)
 
-	// 4. Возврат результата
 	return &model.PayOrderResponse{
-		TransactionUUID: transactionUUID,
+		TransactionUUID: tx.TransactionUUID,
+		Status:          tx.Status,
 	}, nil
 }
 
+// ensureNewTransaction проверяет, что транзакция с переданным UUID еще не создана
+func (s *svc) ensureNewTransaction(ctx context.Context, transactionUUID string) error {
+	_, err := s.transactionRepository.Get(ctx, transactionUUID)
+	if err == nil {
+		return model.ErrTransactionAlreadyExists
+	}
+	if !errors.Is(err, model.ErrTransactionNotFound) {
+		return fmt.Errorf("failed to get transaction: %w", err)
+	}
+	return nil
+}
+
 // validatePaymentRequest проверяет корректность запроса на оплату
 func (s *svc) validatePaymentRequest(req *model.PayOrderRequest) error {
 	if req.OrderUUID == "" {
@@ -46,5 +117,34 @@ func (s *svc) validatePaymentRequest(req *model.PayOrderRequest) error {
 		return model.ErrInvalidPaymentMethod
 	}
 
+	if req.TransactionUUID != "" {
+		if _, err := uuid.Parse(req.TransactionUUID); err != nil {
+			return model.ErrInvalidTransactionUUID
+		}
+	}
+
+	if req.Amount < 0 || (req.PaymentMethod == model.PaymentMethodInvestorMoney && req.Amount == 0) {
+		return model.ErrInvalidAmount
+	}
+
+	if req.Installments < 0 {
+		return model.ErrInvalidInstallments
+	}
+
+	if req.Installments > 1 {
+		if req.PaymentMethod != model.PaymentMethodCreditCard {
+			return model.ErrInstallmentsNotAllowed
+		}
+		if err := s.installmentService.CheckEligibility(req.Amount, req.Installments); err != nil {
+			return err
+		}
+	}
+
+	for _, item := range req.Items {
+		if item.Quantity <= 0 || item.UnitPrice < 0 {
+			return model.ErrInvalidPaymentItem
+		}
+	}
+
 	return nil
 }
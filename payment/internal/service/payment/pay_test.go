@@ -1,12 +1,16 @@
 package payment
 
 import (
+	"errors"
+	"strings"
+
 	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
 )
 
-func (s *ServiceSuite) TestPayOrderSuccess() {
+func (s *ServiceSuite) TestPayOrderCardPending() {
 	var (
 		orderUUID = gofakeit.UUID()
 		userUUID  = gofakeit.UUID()
@@ -18,11 +22,70 @@ func (s *ServiceSuite) TestPayOrderSuccess() {
 		}
 	)
 
+	s.expectFraudVerdict(model.FraudVerdictApprove)
+	s.paymentGateway.On("Charge", s.ctx, mock.AnythingOfType("*model.Transaction")).
+		Return(&model.ChargeResult{Status: model.PaymentStatusPending}, nil)
+	s.transactionRepository.On("Create", s.ctx, mock.MatchedBy(func(tx *model.Transaction) bool {
+		return tx.OrderUUID == orderUUID &&
+			tx.UserUUID == userUUID &&
+			tx.Status == model.PaymentStatusPending
+	})).Return(nil)
+
 	response, err := s.service.PayOrder(s.ctx, request)
 
 	s.Require().NoError(err)
 	s.Require().NotNil(response)
 	s.Require().NotEmpty(response.TransactionUUID)
+	s.Require().Equal(model.PaymentStatusPending, response.Status)
+	s.paymentProducer.AssertNotCalled(s.T(), "PublishPaymentSucceeded", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestPayOrderWithTransactionUUID() {
+	var (
+		transactionUUID = gofakeit.UUID()
+
+		request = &model.PayOrderRequest{
+			OrderUUID:       gofakeit.UUID(),
+			UserUUID:        gofakeit.UUID(),
+			PaymentMethod:   model.PaymentMethodCard,
+			TransactionUUID: transactionUUID,
+		}
+	)
+
+	s.transactionRepository.On("Get", s.ctx, transactionUUID).Return(nil, model.ErrTransactionNotFound).Once()
+	s.expectFraudVerdict(model.FraudVerdictApprove)
+	s.paymentGateway.On("Charge", s.ctx, mock.AnythingOfType("*model.Transaction")).
+		Return(&model.ChargeResult{Status: model.PaymentStatusPending}, nil)
+	s.transactionRepository.On("Create", s.ctx, mock.MatchedBy(func(tx *model.Transaction) bool {
+		return tx.TransactionUUID == transactionUUID
+	})).Return(nil)
+
+	response, err := s.service.PayOrder(s.ctx, request)
+
+	s.Require().NoError(err)
+	s.Require().Equal(transactionUUID, response.TransactionUUID)
+}
+
+func (s *ServiceSuite) TestPayOrderTransactionAlreadyExists() {
+	var (
+		transactionUUID = gofakeit.UUID()
+
+		request = &model.PayOrderRequest{
+			OrderUUID:       gofakeit.UUID(),
+			UserUUID:        gofakeit.UUID(),
+			PaymentMethod:   model.PaymentMethodCard,
+			TransactionUUID: transactionUUID,
+		}
+	)
+
+	s.transactionRepository.On("Get", s.ctx, transactionUUID).
+		Return(&model.Transaction{TransactionUUID: transactionUUID, Status: model.PaymentStatusPending}, nil).Once()
+
+	response, err := s.service.PayOrder(s.ctx, request)
+
+	s.Require().ErrorIs(err, model.ErrTransactionAlreadyExists)
+	s.Require().Nil(response)
+	s.paymentGateway.AssertNotCalled(s.T(), "Charge", mock.Anything, mock.Anything)
 }
 
 func (s *ServiceSuite) TestPayOrderEmptyOrderUUID() {
@@ -92,11 +155,17 @@ func (s *ServiceSuite) TestPayOrderWithSBP() {
 		}
 	)
 
+	s.expectFraudVerdict(model.FraudVerdictApprove)
+	s.paymentGateway.On("Charge", s.ctx, mock.AnythingOfType("*model.Transaction")).
+		Return(&model.ChargeResult{Status: model.PaymentStatusPending}, nil)
+	s.transactionRepository.On("Create", s.ctx, mock.AnythingOfType("*model.Transaction")).Return(nil)
+
 	response, err := s.service.PayOrder(s.ctx, request)
 
 	s.Require().NoError(err)
 	s.Require().NotNil(response)
 	s.Require().NotEmpty(response.TransactionUUID)
+	s.Require().Equal(model.PaymentStatusPending, response.Status)
 }
 
 func (s *ServiceSuite) TestPayOrderWithCreditCard() {
@@ -111,28 +180,290 @@ func (s *ServiceSuite) TestPayOrderWithCreditCard() {
 		}
 	)
 
+	s.expectFraudVerdict(model.FraudVerdictApprove)
+	s.paymentGateway.On("Charge", s.ctx, mock.AnythingOfType("*model.Transaction")).
+		Return(&model.ChargeResult{Status: model.PaymentStatusSucceeded}, nil)
+	s.transactionRepository.On("Create", s.ctx, mock.AnythingOfType("*model.Transaction")).Return(nil)
+	s.paymentProducer.On("PublishPaymentSucceeded", s.ctx, mock.MatchedBy(func(event *model.PaymentEvent) bool {
+		return event.OrderUUID == orderUUID && event.PaymentMethod == model.PaymentMethodCreditCard
+	})).Return(nil)
+	s.expectResultPublished()
+	s.expectReceiptIssued()
+
 	response, err := s.service.PayOrder(s.ctx, request)
 
 	s.Require().NoError(err)
 	s.Require().NotNil(response)
 	s.Require().NotEmpty(response.TransactionUUID)
+	s.Require().Equal(model.PaymentStatusSucceeded, response.Status)
+}
+
+func (s *ServiceSuite) TestPayOrderGatewayDeclined() {
+	var (
+		orderUUID = gofakeit.UUID()
+		userUUID  = gofakeit.UUID()
+
+		request = &model.PayOrderRequest{
+			OrderUUID:     orderUUID,
+			UserUUID:      userUUID,
+			PaymentMethod: model.PaymentMethodCreditCard,
+		}
+	)
+
+	s.expectFraudVerdict(model.FraudVerdictApprove)
+	s.paymentGateway.On("Charge", s.ctx, mock.AnythingOfType("*model.Transaction")).
+		Return(&model.ChargeResult{Status: model.PaymentStatusFailed, FailureReason: "card declined"}, nil)
+	s.transactionRepository.On("Create", s.ctx, mock.AnythingOfType("*model.Transaction")).Return(nil)
+	s.paymentProducer.On("PublishPaymentFailed", s.ctx, mock.MatchedBy(func(event *model.PaymentEvent) bool {
+		return event.OrderUUID == orderUUID && event.Reason == "card declined"
+	})).Return(nil)
+	s.expectResultPublished()
+
+	response, err := s.service.PayOrder(s.ctx, request)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(response)
+	s.Require().Equal(model.PaymentStatusFailed, response.Status)
+}
+
+func (s *ServiceSuite) TestPayOrderGatewayError() {
+	var (
+		request = &model.PayOrderRequest{
+			OrderUUID:     gofakeit.UUID(),
+			UserUUID:      gofakeit.UUID(),
+			PaymentMethod: model.PaymentMethodCard,
+		}
+	)
+
+	s.expectFraudVerdict(model.FraudVerdictApprove)
+	s.paymentGateway.On("Charge", s.ctx, mock.AnythingOfType("*model.Transaction")).
+		Return(nil, errors.New("gateway unavailable"))
+
+	response, err := s.service.PayOrder(s.ctx, request)
+
+	s.Require().Error(err)
+	s.Require().Nil(response)
 }
 
 func (s *ServiceSuite) TestPayOrderWithInvestorMoney() {
 	var (
 		orderUUID = gofakeit.UUID()
 		userUUID  = gofakeit.UUID()
+		amount    = 15_000.50
 
 		request = &model.PayOrderRequest{
 			OrderUUID:     orderUUID,
 			UserUUID:      userUUID,
 			PaymentMethod: model.PaymentMethodInvestorMoney,
+			Amount:        amount,
 		}
 	)
 
+	s.expectFraudVerdict(model.FraudVerdictApprove)
+	s.transactionRepository.On("Create", s.ctx, mock.MatchedBy(func(tx *model.Transaction) bool {
+		return tx.OrderUUID == orderUUID && tx.Status == model.PaymentStatusPending
+	})).Return(nil)
+	s.ledgerRepository.On("Debit", s.ctx, userUUID, amount, mock.AnythingOfType("string")).Return(nil)
+	s.transactionRepository.On("UpdateStatus", s.ctx, mock.AnythingOfType("string"), model.PaymentStatusSucceeded, "").Return(nil)
+	s.paymentProducer.On("PublishPaymentSucceeded", s.ctx, mock.AnythingOfType("*model.PaymentEvent")).Return(nil)
+	s.expectResultPublished()
+	s.expectReceiptIssued()
+
 	response, err := s.service.PayOrder(s.ctx, request)
 
 	s.Require().NoError(err)
 	s.Require().NotNil(response)
 	s.Require().NotEmpty(response.TransactionUUID)
+	s.Require().Equal(model.PaymentStatusSucceeded, response.Status)
+	s.paymentGateway.AssertNotCalled(s.T(), "Charge", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestPayOrderWithInvestorMoneyInsufficientFunds() {
+	var (
+		orderUUID = gofakeit.UUID()
+		userUUID  = gofakeit.UUID()
+		amount    = 15_000.00
+
+		request = &model.PayOrderRequest{
+			OrderUUID:     orderUUID,
+			UserUUID:      userUUID,
+			PaymentMethod: model.PaymentMethodInvestorMoney,
+			Amount:        amount,
+		}
+	)
+
+	s.expectFraudVerdict(model.FraudVerdictApprove)
+	s.transactionRepository.On("Create", s.ctx, mock.AnythingOfType("*model.Transaction")).Return(nil)
+	s.ledgerRepository.On("Debit", s.ctx, userUUID, amount, mock.AnythingOfType("string")).Return(model.ErrInsufficientFunds)
+	s.transactionRepository.On("UpdateStatus", s.ctx, mock.AnythingOfType("string"), model.PaymentStatusFailed, model.ErrInsufficientFunds.Error()).Return(nil)
+	s.paymentProducer.On("PublishPaymentFailed", s.ctx, mock.MatchedBy(func(event *model.PaymentEvent) bool {
+		return event.OrderUUID == orderUUID && event.Reason == model.ErrInsufficientFunds.Error()
+	})).Return(nil)
+	s.expectResultPublished()
+
+	response, err := s.service.PayOrder(s.ctx, request)
+
+	s.Require().ErrorIs(err, model.ErrInsufficientFunds)
+	s.Require().Nil(response)
+}
+
+func (s *ServiceSuite) TestPayOrderWithInvestorMoneyLedgerError() {
+	var (
+		request = &model.PayOrderRequest{
+			OrderUUID:     gofakeit.UUID(),
+			UserUUID:      gofakeit.UUID(),
+			PaymentMethod: model.PaymentMethodInvestorMoney,
+			Amount:        100,
+		}
+	)
+
+	s.expectFraudVerdict(model.FraudVerdictApprove)
+	s.transactionRepository.On("Create", s.ctx, mock.AnythingOfType("*model.Transaction")).Return(nil)
+	s.ledgerRepository.On("Debit", s.ctx, request.UserUUID, request.Amount, mock.AnythingOfType("string")).
+		Return(errors.New("connection reset"))
+	s.transactionRepository.On("UpdateStatus", s.ctx, mock.AnythingOfType("string"), model.PaymentStatusFailed,
+		mock.MatchedBy(func(reason string) bool { return strings.Contains(reason, "connection reset") })).Return(nil).Once()
+
+	response, err := s.service.PayOrder(s.ctx, request)
+
+	s.Require().Error(err)
+	s.Require().Nil(response)
+	s.paymentProducer.AssertNotCalled(s.T(), "PublishPaymentSucceeded", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestPayOrderWithInvestorMoneyWithoutAmount() {
+	var (
+		request = &model.PayOrderRequest{
+			OrderUUID:     gofakeit.UUID(),
+			UserUUID:      gofakeit.UUID(),
+			PaymentMethod: model.PaymentMethodInvestorMoney,
+		}
+	)
+
+	response, err := s.service.PayOrder(s.ctx, request)
+
+	s.Require().ErrorIs(err, model.ErrInvalidAmount)
+	s.Require().Nil(response)
+}
+
+func (s *ServiceSuite) TestPayOrderFraudReview() {
+	var (
+		request = &model.PayOrderRequest{
+			OrderUUID:      gofakeit.UUID(),
+			UserUUID:       gofakeit.UUID(),
+			OrderOwnerUUID: gofakeit.UUID(),
+			PaymentMethod:  model.PaymentMethodCard,
+			Amount:         1_000,
+		}
+	)
+
+	s.expectFraudVerdict(model.FraudVerdictReview, "owner_mismatch: payer differs from order owner")
+	s.paymentGateway.On("Charge", s.ctx, mock.AnythingOfType("*model.Transaction")).
+		Return(&model.ChargeResult{Status: model.PaymentStatusPending}, nil)
+	s.transactionRepository.On("Create", s.ctx, mock.AnythingOfType("*model.Transaction")).Return(nil)
+
+	response, err := s.service.PayOrder(s.ctx, request)
+
+	s.Require().NoError(err)
+	s.Require().Equal(model.PaymentStatusPending, response.Status)
+}
+
+func (s *ServiceSuite) TestPayOrderFraudReject() {
+	var (
+		orderUUID = gofakeit.UUID()
+
+		request = &model.PayOrderRequest{
+			OrderUUID:     orderUUID,
+			UserUUID:      gofakeit.UUID(),
+			PaymentMethod: model.PaymentMethodCreditCard,
+			Amount:        10_000_000,
+		}
+	)
+
+	s.expectFraudVerdict(model.FraudVerdictReject, "amount_limit: amount 10000000.00 exceeds limit 500000.00")
+	s.transactionRepository.On("Create", s.ctx, mock.MatchedBy(func(tx *model.Transaction) bool {
+		return tx.OrderUUID == orderUUID &&
+			tx.Status == model.PaymentStatusFailed &&
+			strings.Contains(tx.FailureReason, "amount_limit")
+	})).Return(nil)
+	s.paymentProducer.On("PublishPaymentFailed", s.ctx, mock.AnythingOfType("*model.PaymentEvent")).Return(nil)
+	s.expectResultPublished()
+
+	response, err := s.service.PayOrder(s.ctx, request)
+
+	s.Require().ErrorIs(err, model.ErrPaymentRejected)
+	s.Require().Nil(response)
+	s.paymentGateway.AssertNotCalled(s.T(), "Charge", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestPayOrderFraudCheckError() {
+	var (
+		request = &model.PayOrderRequest{
+			OrderUUID:     gofakeit.UUID(),
+			UserUUID:      gofakeit.UUID(),
+			PaymentMethod: model.PaymentMethodCard,
+		}
+	)
+
+	s.fraudService.On("Evaluate", s.ctx, mock.AnythingOfType("*model.FraudCheck")).
+		Return(nil, errors.New("db error"))
+
+	response, err := s.service.PayOrder(s.ctx, request)
+
+	s.Require().Error(err)
+	s.Require().Nil(response)
+	s.transactionRepository.AssertNotCalled(s.T(), "Create", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestPayOrderInvalidItem() {
+	var (
+		request = &model.PayOrderRequest{
+			OrderUUID:     gofakeit.UUID(),
+			UserUUID:      gofakeit.UUID(),
+			PaymentMethod: model.PaymentMethodCard,
+			Items: []model.PaymentItem{
+				{PartUUID: gofakeit.UUID(), Name: "Двигатель", Quantity: 0, UnitPrice: 1000},
+			},
+		}
+	)
+
+	response, err := s.service.PayOrder(s.ctx, request)
+
+	s.Require().ErrorIs(err, model.ErrInvalidPaymentItem)
+	s.Require().Nil(response)
+}
+
+func (s *ServiceSuite) TestPayOrderReceiptErrorDoesNotFailPayment() {
+	var (
+		orderUUID = gofakeit.UUID()
+		items     = []model.PaymentItem{
+			{PartUUID: gofakeit.UUID(), Name: "Двигатель", Quantity: 1, UnitPrice: 1500},
+		}
+
+		request = &model.PayOrderRequest{
+			OrderUUID:     orderUUID,
+			UserUUID:      gofakeit.UUID(),
+			PaymentMethod: model.PaymentMethodCreditCard,
+			Amount:        1500,
+			Items:         items,
+		}
+	)
+
+	s.expectFraudVerdict(model.FraudVerdictApprove)
+	s.paymentGateway.On("Charge", s.ctx, mock.AnythingOfType("*model.Transaction")).
+		Return(&model.ChargeResult{Status: model.PaymentStatusSucceeded}, nil)
+	s.transactionRepository.On("Create", s.ctx, mock.MatchedBy(func(tx *model.Transaction) bool {
+		return tx.OrderUUID == orderUUID && len(tx.Items) == 1
+	})).Return(nil)
+	s.paymentProducer.On("PublishPaymentSucceeded", s.ctx, mock.AnythingOfType("*model.PaymentEvent")).Return(nil)
+	s.receiptService.On("Issue", s.ctx, mock.AnythingOfType("*model.Transaction")).
+		Return(nil, errors.New("template error"))
+
+	response, err := s.service.PayOrder(s.ctx, request)
+
+	s.Require().NoError(err)
+	s.Require().NotNil(response)
+	s.Require().Equal(model.PaymentStatusSucceeded, response.Status)
+	// Без чека результат не отмечается опубликованным и будет дослан повторно
+	s.transactionRepository.AssertNotCalled(s.T(), "MarkResultPublished", mock.Anything, mock.Anything)
 }
@@ -0,0 +1,92 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+// publishPaymentResult публикует событие об итоговом статусе транзакции. Статус к этому моменту
+// уже сохранен, поэтому ошибка публикации только логируется: событие дошлет PublishPendingResults
+func (s *svc) publishPaymentResult(ctx context.Context, tx *model.Transaction) {
+	if tx.Status == model.PaymentStatusPending {
+		return
+	}
+
+	if err := s.sendPaymentResult(ctx, tx); err != nil {
+		logger.Error(ctx, "❌ Не удалось опубликовать результат платежа, событие будет отправлено повторно",
+			zap.String("transaction_uuid", tx.TransactionUUID),
+			zap.Error(err),
+		)
+	}
+}
+
+// PublishPendingResults досылает события по завершенным транзакциям, которые не удалось
+// опубликовать при смене статуса. Транзакции, завершенные позже now - resultPublishDelay,
+// пропускаются: их событие в этот момент может публиковать сам платеж
+func (s *svc) PublishPendingResults(ctx context.Context, now time.Time) (int, error) {
+	txs, err := s.transactionRepository.ListUnpublished(ctx, now.Add(-resultPublishDelay), pendingResultsBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list unpublished transactions: %w", err)
+	}
+
+	published := 0
+	for _, tx := range txs {
+		if err = s.sendPaymentResult(ctx, tx); err != nil {
+			logger.Error(ctx, "❌ Не удалось дослать результат платежа",
+				zap.String("transaction_uuid", tx.TransactionUUID),
+				zap.Error(err),
+			)
+			continue
+		}
+		published++
+	}
+
+	return published, nil
+}
+
+// sendPaymentResult публикует PaymentSucceeded/PaymentFailed и отмечает транзакцию опубликованной.
+// Доставка at-least-once: при повторе событие уходит с новым event_uuid, потребители сверяют транзакцию
+func (s *svc) sendPaymentResult(ctx context.Context, tx *model.Transaction) error {
+	event := &model.PaymentEvent{
+		EventUUID:       uuid.NewString(),
+		TransactionUUID: tx.TransactionUUID,
+		OrderUUID:       tx.OrderUUID,
+		UserUUID:        tx.UserUUID,
+		PaymentMethod:   tx.PaymentMethod,
+		Reason:          tx.FailureReason,
+	}
+
+	switch tx.Status {
+	case model.PaymentStatusSucceeded:
+		if err := s.paymentProducer.PublishPaymentSucceeded(ctx, event); err != nil {
+			return fmt.Errorf("failed to publish payment succeeded: %w", err)
+		}
+		// Без чека транзакция остается неопубликованной и попадет в повторную отправку
+		charged, err := s.chargedTransaction(ctx, tx)
+		if err != nil {
+			return err
+		}
+		if _, err = s.receiptService.Issue(ctx, charged); err != nil {
+			return fmt.Errorf("failed to issue receipt: %w", err)
+		}
+	case model.PaymentStatusFailed:
+		if err := s.paymentProducer.PublishPaymentFailed(ctx, event); err != nil {
+			return fmt.Errorf("failed to publish payment failed: %w", err)
+		}
+	default:
+		return fmt.Errorf("transaction %s is not completed", tx.TransactionUUID)
+	}
+
+	if err := s.transactionRepository.MarkResultPublished(ctx, tx.TransactionUUID); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package payment
+
+import (
+	"errors"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+func (s *ServiceSuite) TestPublishPendingResults() {
+	var (
+		now = time.Now()
+
+		succeeded = &model.Transaction{
+			TransactionUUID: gofakeit.UUID(),
+			Status:          model.PaymentStatusSucceeded,
+		}
+		failed = &model.Transaction{
+			TransactionUUID: gofakeit.UUID(),
+			Status:          model.PaymentStatusFailed,
+		}
+	)
+
+	s.transactionRepository.On("ListUnpublished", s.ctx, now.Add(-resultPublishDelay), pendingResultsBatchSize).
+		Return([]*model.Transaction{succeeded, failed}, nil).Once()
+	s.paymentProducer.On("PublishPaymentSucceeded", s.ctx, mock.MatchedBy(func(event *model.PaymentEvent) bool {
+		return event.TransactionUUID == succeeded.TransactionUUID
+	})).Return(nil).Once()
+	s.expectReceiptIssued()
+	s.transactionRepository.On("MarkResultPublished", s.ctx, succeeded.TransactionUUID).Return(nil).Once()
+	s.paymentProducer.On("PublishPaymentFailed", s.ctx, mock.MatchedBy(func(event *model.PaymentEvent) bool {
+		return event.TransactionUUID == failed.TransactionUUID
+	})).Return(errors.New("kafka unavailable")).Once()
+
+	published, err := s.service.PublishPendingResults(s.ctx, now)
+
+	// Неудачная отправка не помечается и будет повторена на следующем прогоне
+	s.Require().NoError(err)
+	s.Require().Equal(1, published)
+}
+
+func (s *ServiceSuite) TestPublishPendingResultsListError() {
+	now := time.Now()
+
+	s.transactionRepository.On("ListUnpublished", s.ctx, now.Add(-resultPublishDelay), pendingResultsBatchSize).
+		Return(nil, errors.New("db unavailable")).Once()
+
+	published, err := s.service.PublishPendingResults(s.ctx, now)
+
+	s.Require().Error(err)
+	s.Require().Zero(published)
+}
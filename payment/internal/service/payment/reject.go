@@ -0,0 +1,41 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+// rejectPayment сохраняет отклоненную антифрод-проверкой транзакцию и публикует PaymentFailed
+func (s *svc) rejectPayment(ctx context.Context, tx *model.Transaction, decision *model.FraudDecision) (*model.PayOrderResponse, error) {
+	tx.Status = model.PaymentStatusFailed
+	tx.FailureReason = fmt.Sprintf("%s: %s", model.ErrPaymentRejected, strings.Join(decision.Reasons, "; "))
+
+	if err := s.transactionRepository.Create(ctx, tx); err != nil {
+		return nil, fmt.Errorf("failed to save transaction: %w", err)
+	}
+
+	s.publishPaymentResult(ctx, tx)
+
+	return nil, model.ErrPaymentRejected
+}
+
+// failTransaction переводит сохраненную в PENDING транзакцию в FAILED, когда оплата оборвалась ошибкой.
+// Иначе транзакция навсегда остается в PENDING, а заказ не получает результата.
+// PaymentFailed досылает PublishPendingResults, ошибка обновления статуса только логируется
+func (s *svc) failTransaction(ctx context.Context, tx *model.Transaction, cause error) {
+	tx.Status = model.PaymentStatusFailed
+	tx.FailureReason = cause.Error()
+
+	if err := s.transactionRepository.UpdateStatus(ctx, tx.TransactionUUID, tx.Status, tx.FailureReason); err != nil {
+		logger.Error(ctx, "❌ Не удалось перевести транзакцию в FAILED",
+			zap.String("transaction_uuid", tx.TransactionUUID),
+			zap.Error(err),
+		)
+	}
+}
@@ -1,22 +1,51 @@
 package payment
 
 import (
+	"time"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/client/gateway"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository"
 	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/service"
 )
 
 // Проверка, что service реализует интерфейс PaymentService на этапе компиляции
 var _ service.PaymentService = (*svc)(nil)
 
+const (
+	// resultPublishDelay - сколько завершенная транзакция ждет, прежде чем событие по ней досылает job
+	resultPublishDelay = time.Minute
+	// pendingResultsBatchSize - сколько событий досылается за один прогон job'а
+	pendingResultsBatchSize = 100
+)
+
 // svc - реализация PaymentService
 type svc struct {
-	// Пока пустая структура
-	// В будущем можно добавить:
-	// - logger Logger
-	// - metrics MetricsCollector
-	// - paymentGateway PaymentGateway
+	transactionRepository repository.TransactionRepository
+	ledgerRepository      repository.LedgerRepository
+	paymentGateway        gateway.PaymentGateway
+	paymentProducer       service.PaymentProducerService
+	fraudService          service.FraudService
+	receiptService        service.ReceiptService
+	installmentService    service.InstallmentService
 }
 
 // New создает новый экземпляр PaymentService
-func New() *svc {
-	return &svc{}
+func New(
+	transactionRepository repository.TransactionRepository,
+	ledgerRepository repository.LedgerRepository,
+	paymentGateway gateway.PaymentGateway,
+	paymentProducer service.PaymentProducerService,
+	fraudService service.FraudService,
+	receiptService service.ReceiptService,
+	installmentService service.InstallmentService,
+) *svc {
+	return &svc{
+		transactionRepository: transactionRepository,
+		ledgerRepository:      ledgerRepository,
+		paymentGateway:        paymentGateway,
+		paymentProducer:       paymentProducer,
+		fraudService:          fraudService,
+		receiptService:        receiptService,
+		installmentService:    installmentService,
+	}
 }
@@ -4,22 +4,70 @@ import (
 	"context"
 	"testing"
 
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+
+	gatewayMocks "github.com/Daniil-Sakharov/RocketFactory/payment/internal/client/gateway/mocks"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	repoMocks "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/mocks"
+	serviceMocks "github.com/Daniil-Sakharov/RocketFactory/payment/internal/service/mocks"
 )
 
 type ServiceSuite struct {
 	suite.Suite
-	ctx     context.Context
-	service *svc
+	ctx                   context.Context
+	transactionRepository *repoMocks.TransactionRepository
+	ledgerRepository      *repoMocks.LedgerRepository
+	paymentGateway        *gatewayMocks.PaymentGateway
+	paymentProducer       *serviceMocks.PaymentProducerService
+	fraudService          *serviceMocks.FraudService
+	receiptService        *serviceMocks.ReceiptService
+	installmentService    *serviceMocks.InstallmentService
+	service               *svc
 }
 
 func (s *ServiceSuite) SetupTest() {
 	s.ctx = context.Background()
-	s.service = New()
+
+	s.transactionRepository = repoMocks.NewTransactionRepository(s.T())
+	s.ledgerRepository = repoMocks.NewLedgerRepository(s.T())
+	s.paymentGateway = gatewayMocks.NewPaymentGateway(s.T())
+	s.paymentProducer = serviceMocks.NewPaymentProducerService(s.T())
+	s.fraudService = serviceMocks.NewFraudService(s.T())
+	s.receiptService = serviceMocks.NewReceiptService(s.T())
+	s.installmentService = serviceMocks.NewInstallmentService(s.T())
+
+	s.service = New(
+		s.transactionRepository,
+		s.ledgerRepository,
+		s.paymentGateway,
+		s.paymentProducer,
+		s.fraudService,
+		s.receiptService,
+		s.installmentService,
+	)
 }
 
 func (s *ServiceSuite) TearDownTest() {}
 
+// expectFraudVerdict настраивает решение антифрод-проверки для следующего платежа
+func (s *ServiceSuite) expectFraudVerdict(verdict model.FraudVerdict, reasons ...string) {
+	s.fraudService.On("Evaluate", s.ctx, mock.AnythingOfType("*model.FraudCheck")).
+		Return(&model.FraudDecision{Verdict: verdict, Reasons: reasons}, nil).Once()
+}
+
+// expectReceiptIssued ожидает выдачу чека по успешной транзакции
+func (s *ServiceSuite) expectReceiptIssued() {
+	s.receiptService.On("Issue", s.ctx, mock.MatchedBy(func(tx *model.Transaction) bool {
+		return tx.Status == model.PaymentStatusSucceeded
+	})).Return(&model.Receipt{}, nil).Once()
+}
+
+// expectResultPublished ожидает отметку о публикации результата транзакции
+func (s *ServiceSuite) expectResultPublished() {
+	s.transactionRepository.On("MarkResultPublished", s.ctx, mock.AnythingOfType("string")).Return(nil).Once()
+}
+
 func TestServiceIntegration(t *testing.T) {
 	suite.Run(t, new(ServiceSuite))
 }
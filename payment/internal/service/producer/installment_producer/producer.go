@@ -0,0 +1,77 @@
+package installment_producer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	def "github.com/Daniil-Sakharov/RocketFactory/payment/internal/service"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/kafka"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+var _ def.InstallmentProducerService = (*service)(nil)
+
+type service struct {
+	installmentMissedProducer    kafka.Producer
+	installmentDefaultedProducer kafka.Producer
+}
+
+func NewService(installmentMissedProducer, installmentDefaultedProducer kafka.Producer) *service {
+	return &service{
+		installmentMissedProducer:    installmentMissedProducer,
+		installmentDefaultedProducer: installmentDefaultedProducer,
+	}
+}
+
+func (s *service) PublishInstallmentMissed(ctx context.Context, due *model.DueInstallment) error {
+	eventUUID := uuid.NewString()
+
+	payload, err := proto.Marshal(converter.InstallmentMissedToProto(eventUUID, due))
+	if err != nil {
+		logger.Error(ctx, "Failed to marshal InstallmentMissed event", zap.Error(err))
+		return err
+	}
+
+	err = s.installmentMissedProducer.Send(ctx, []byte(due.Plan.OrderUUID), payload)
+	if err != nil {
+		logger.Error(ctx, "Failed to publish InstallmentMissed event", zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "📤 InstallmentMissed event published",
+		zap.String("event_uuid", eventUUID),
+		zap.String("plan_uuid", due.Plan.PlanUUID),
+		zap.String("installment_uuid", due.Installment.InstallmentUUID),
+	)
+
+	return nil
+}
+
+func (s *service) PublishInstallmentPlanDefaulted(ctx context.Context, due *model.DueInstallment) error {
+	eventUUID := uuid.NewString()
+
+	payload, err := proto.Marshal(converter.InstallmentPlanDefaultedToProto(eventUUID, due))
+	if err != nil {
+		logger.Error(ctx, "Failed to marshal InstallmentPlanDefaulted event", zap.Error(err))
+		return err
+	}
+
+	err = s.installmentDefaultedProducer.Send(ctx, []byte(due.Plan.OrderUUID), payload)
+	if err != nil {
+		logger.Error(ctx, "Failed to publish InstallmentPlanDefaulted event", zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "📤 InstallmentPlanDefaulted event published",
+		zap.String("event_uuid", eventUUID),
+		zap.String("plan_uuid", due.Plan.PlanUUID),
+		zap.String("installment_uuid", due.Installment.InstallmentUUID),
+	)
+
+	return nil
+}
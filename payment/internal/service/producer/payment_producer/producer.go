@@ -0,0 +1,73 @@
+package payment_producer
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	def "github.com/Daniil-Sakharov/RocketFactory/payment/internal/service"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/kafka"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+var _ def.PaymentProducerService = (*service)(nil)
+
+type service struct {
+	succeededProducer kafka.Producer
+	failedProducer    kafka.Producer
+}
+
+func NewService(succeededProducer, failedProducer kafka.Producer) *service {
+	return &service{
+		succeededProducer: succeededProducer,
+		failedProducer:    failedProducer,
+	}
+}
+
+func (s *service) PublishPaymentSucceeded(ctx context.Context, event *model.PaymentEvent) error {
+	payload, err := proto.Marshal(converter.PaymentSucceededToProto(event))
+	if err != nil {
+		logger.Error(ctx, "Failed to marshal PaymentSucceeded event", zap.Error(err))
+		return err
+	}
+
+	err = s.succeededProducer.Send(ctx, []byte(event.OrderUUID), payload)
+	if err != nil {
+		logger.Error(ctx, "Failed to publish PaymentSucceeded event", zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "📤 PaymentSucceeded event published",
+		zap.String("event_uuid", event.EventUUID),
+		zap.String("transaction_uuid", event.TransactionUUID),
+		zap.String("order_uuid", event.OrderUUID),
+	)
+
+	return nil
+}
+
+func (s *service) PublishPaymentFailed(ctx context.Context, event *model.PaymentEvent) error {
+	payload, err := proto.Marshal(converter.PaymentFailedToProto(event))
+	if err != nil {
+		logger.Error(ctx, "Failed to marshal PaymentFailed event", zap.Error(err))
+		return err
+	}
+
+	err = s.failedProducer.Send(ctx, []byte(event.OrderUUID), payload)
+	if err != nil {
+		logger.Error(ctx, "Failed to publish PaymentFailed event", zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "📤 PaymentFailed event published",
+		zap.String("event_uuid", event.EventUUID),
+		zap.String("transaction_uuid", event.TransactionUUID),
+		zap.String("order_uuid", event.OrderUUID),
+		zap.String("reason", event.Reason),
+	)
+
+	return nil
+}
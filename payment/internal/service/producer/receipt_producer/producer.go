@@ -0,0 +1,51 @@
+package receipt_producer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	def "github.com/Daniil-Sakharov/RocketFactory/payment/internal/service"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/kafka"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+var _ def.ReceiptProducerService = (*service)(nil)
+
+type service struct {
+	receiptIssuedProducer kafka.Producer
+}
+
+func NewService(receiptIssuedProducer kafka.Producer) *service {
+	return &service{
+		receiptIssuedProducer: receiptIssuedProducer,
+	}
+}
+
+func (s *service) PublishReceiptIssued(ctx context.Context, receipt *model.Receipt) error {
+	eventUUID := uuid.NewString()
+
+	payload, err := proto.Marshal(converter.ReceiptIssuedToProto(eventUUID, receipt))
+	if err != nil {
+		logger.Error(ctx, "Failed to marshal ReceiptIssued event", zap.Error(err))
+		return err
+	}
+
+	err = s.receiptIssuedProducer.Send(ctx, []byte(receipt.OrderUUID), payload)
+	if err != nil {
+		logger.Error(ctx, "Failed to publish ReceiptIssued event", zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "📤 ReceiptIssued event published",
+		zap.String("event_uuid", eventUUID),
+		zap.String("receipt_uuid", receipt.ReceiptUUID),
+		zap.String("transaction_uuid", receipt.TransactionUUID),
+	)
+
+	return nil
+}
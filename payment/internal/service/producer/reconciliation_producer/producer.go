@@ -0,0 +1,51 @@
+package reconciliation_producer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/converter"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	def "github.com/Daniil-Sakharov/RocketFactory/payment/internal/service"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/kafka"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+var _ def.ReconciliationProducerService = (*service)(nil)
+
+type service struct {
+	discrepancyProducer kafka.Producer
+}
+
+func NewService(discrepancyProducer kafka.Producer) *service {
+	return &service{
+		discrepancyProducer: discrepancyProducer,
+	}
+}
+
+func (s *service) PublishDiscrepancy(ctx context.Context, discrepancy *model.Discrepancy) error {
+	eventUUID := uuid.NewString()
+
+	payload, err := proto.Marshal(converter.PaymentDiscrepancyToProto(eventUUID, discrepancy))
+	if err != nil {
+		logger.Error(ctx, "Failed to marshal PaymentDiscrepancy event", zap.Error(err))
+		return err
+	}
+
+	err = s.discrepancyProducer.Send(ctx, []byte(discrepancy.OrderUUID), payload)
+	if err != nil {
+		logger.Error(ctx, "Failed to publish PaymentDiscrepancy event", zap.Error(err))
+		return err
+	}
+
+	logger.Info(ctx, "📤 PaymentDiscrepancy event published",
+		zap.String("event_uuid", eventUUID),
+		zap.String("order_uuid", discrepancy.OrderUUID),
+		zap.String("transaction_uuid", discrepancy.TransactionUUID),
+	)
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package receipt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+// Get возвращает чек по UUID транзакции
+func (s *svc) Get(ctx context.Context, transactionUUID string) (*model.Receipt, error) {
+	if transactionUUID == "" {
+		return nil, model.ErrEmptyTransactionUUID
+	}
+
+	receipt, err := s.receiptRepository.GetByTransaction(ctx, transactionUUID)
+	if err != nil {
+		if errors.Is(err, model.ErrReceiptNotFound) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get receipt: %w", err)
+	}
+
+	return receipt, nil
+}
@@ -0,0 +1,37 @@
+package receipt
+
+import (
+	"github.com/brianvoe/gofakeit/v7"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+func (s *ServiceSuite) TestGetSuccess() {
+	transactionUUID := gofakeit.UUID()
+	expected := &model.Receipt{ReceiptUUID: gofakeit.UUID(), TransactionUUID: transactionUUID}
+
+	s.receiptRepository.On("GetByTransaction", s.ctx, transactionUUID).Return(expected, nil)
+
+	receipt, err := s.service.Get(s.ctx, transactionUUID)
+
+	s.Require().NoError(err)
+	s.Require().Equal(expected, receipt)
+}
+
+func (s *ServiceSuite) TestGetNotFound() {
+	transactionUUID := gofakeit.UUID()
+
+	s.receiptRepository.On("GetByTransaction", s.ctx, transactionUUID).Return(nil, model.ErrReceiptNotFound)
+
+	receipt, err := s.service.Get(s.ctx, transactionUUID)
+
+	s.Require().ErrorIs(err, model.ErrReceiptNotFound)
+	s.Require().Nil(receipt)
+}
+
+func (s *ServiceSuite) TestGetEmptyTransactionUUID() {
+	receipt, err := s.service.Get(s.ctx, "")
+
+	s.Require().ErrorIs(err, model.ErrEmptyTransactionUUID)
+	s.Require().Nil(receipt)
+}
@@ -0,0 +1,129 @@
+package receipt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+// Issue формирует чек успешной транзакции, сохраняет его и публикует ReceiptIssued.
+// Чек по транзакции выдается один раз: повторный вызов публикует сохраненный чек заново,
+// чтобы ReceiptIssued дошел, если прошлая публикация не удалась
+func (s *svc) Issue(ctx context.Context, tx *model.Transaction) (*model.Receipt, error) {
+	existing, err := s.receiptRepository.GetByTransaction(ctx, tx.TransactionUUID)
+	switch {
+	case err == nil:
+		if err = s.receiptProducer.PublishReceiptIssued(ctx, existing); err != nil {
+			return nil, fmt.Errorf("failed to publish receipt issued: %w", err)
+		}
+		return existing, nil
+	case !errors.Is(err, model.ErrReceiptNotFound):
+		return nil, fmt.Errorf("failed to get receipt: %w", err)
+	}
+
+	receipt := s.buildReceipt(ctx, tx)
+
+	data := &TemplateData{SellerName: s.sellerName, Receipt: receipt}
+	if receipt.Text, err = s.renderer.RenderText(data); err != nil {
+		return nil, err
+	}
+	if receipt.HTML, err = s.renderer.RenderHTML(data); err != nil {
+		return nil, err
+	}
+
+	err = s.receiptRepository.Create(ctx, receipt)
+	if err != nil {
+		// Чек успел выдать параллельный вызов (повторный callback шлюза), он же его и публикует
+		if errors.Is(err, model.ErrReceiptAlreadyExists) {
+			return s.receiptRepository.GetByTransaction(ctx, tx.TransactionUUID)
+		}
+		return nil, fmt.Errorf("failed to save receipt: %w", err)
+	}
+
+	logger.Info(ctx, "🧾 Чек выдан",
+		zap.String("receipt_uuid", receipt.ReceiptUUID),
+		zap.String("transaction_uuid", receipt.TransactionUUID),
+		zap.Float64("total", receipt.Total),
+	)
+
+	if err = s.receiptProducer.PublishReceiptIssued(ctx, receipt); err != nil {
+		return nil, fmt.Errorf("failed to publish receipt issued: %w", err)
+	}
+
+	return receipt, nil
+}
+
+// buildReceipt считает позиции и НДС. Цены включают НДС, поэтому налог выделяется из суммы позиции.
+// Итог чека равен сумме платежа: если заказ не передал позиции или их сумма расходится
+// со списанной, чек содержит одну позицию на сумму платежа
+func (s *svc) buildReceipt(ctx context.Context, tx *model.Transaction) *model.Receipt {
+	items := tx.Items
+	if len(items) == 0 || (!tx.AmountUnknown && !itemsMatchAmount(items, tx.Amount)) {
+		if len(items) > 0 {
+			logger.Warn(ctx, "⚠️ Сумма позиций не совпадает с суммой платежа, чек выдается одной позицией",
+				zap.String("transaction_uuid", tx.TransactionUUID),
+				zap.Float64("amount", tx.Amount),
+			)
+		}
+		items = []model.PaymentItem{{
+			Name:      fmt.Sprintf("Оплата заказа %s", tx.OrderUUID),
+			Quantity:  1,
+			UnitPrice: tx.Amount,
+		}}
+	}
+
+	rate := decimal.NewFromFloat(s.vatRate)
+	hundred := decimal.NewFromInt(100)
+
+	receipt := &model.Receipt{
+		ReceiptUUID:     uuid.NewString(),
+		TransactionUUID: tx.TransactionUUID,
+		OrderUUID:       tx.OrderUUID,
+		UserUUID:        tx.UserUUID,
+		PaymentMethod:   tx.PaymentMethod,
+		Items:           make([]model.ReceiptItem, 0, len(items)),
+		VatRate:         s.vatRate,
+		IssuedAt:        time.Now(),
+	}
+
+	total := decimal.Zero
+	vatAmount := decimal.Zero
+	for _, item := range items {
+		itemTotal := decimal.NewFromFloat(item.UnitPrice).Mul(decimal.NewFromInt32(item.Quantity)).Round(2)
+		itemVat := itemTotal.Mul(rate).Div(hundred.Add(rate)).Round(2)
+
+		receipt.Items = append(receipt.Items, model.ReceiptItem{
+			PartUUID:  item.PartUUID,
+			Name:      item.Name,
+			Quantity:  item.Quantity,
+			UnitPrice: item.UnitPrice,
+			Total:     itemTotal.InexactFloat64(),
+			VatAmount: itemVat.InexactFloat64(),
+		})
+
+		total = total.Add(itemTotal)
+		vatAmount = vatAmount.Add(itemVat)
+	}
+
+	receipt.Total = total.InexactFloat64()
+	receipt.VatAmount = vatAmount.InexactFloat64()
+
+	return receipt
+}
+
+// itemsMatchAmount проверяет, что сумма позиций совпадает с суммой платежа
+func itemsMatchAmount(items []model.PaymentItem, amount float64) bool {
+	total := decimal.Zero
+	for _, item := range items {
+		total = total.Add(decimal.NewFromFloat(item.UnitPrice).Mul(decimal.NewFromInt32(item.Quantity)).Round(2))
+	}
+	return total.Equal(decimal.NewFromFloat(amount).Round(2))
+}
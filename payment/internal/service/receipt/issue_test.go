@@ -0,0 +1,135 @@
+package receipt
+
+import (
+	"errors"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+func newSucceededTransaction(items ...model.PaymentItem) *model.Transaction {
+	return &model.Transaction{
+		TransactionUUID: gofakeit.UUID(),
+		OrderUUID:       gofakeit.UUID(),
+		UserUUID:        gofakeit.UUID(),
+		PaymentMethod:   model.PaymentMethodCard,
+		Amount:          3600,
+		Items:           items,
+		Status:          model.PaymentStatusSucceeded,
+	}
+}
+
+func (s *ServiceSuite) TestIssueWithItems() {
+	tx := newSucceededTransaction(
+		model.PaymentItem{PartUUID: gofakeit.UUID(), Name: "Двигатель", Quantity: 2, UnitPrice: 1200},
+		model.PaymentItem{PartUUID: gofakeit.UUID(), Name: "Иллюминатор <круглый>", Quantity: 1, UnitPrice: 1200},
+	)
+
+	s.receiptRepository.On("GetByTransaction", s.ctx, tx.TransactionUUID).Return(nil, model.ErrReceiptNotFound)
+	s.receiptRepository.On("Create", s.ctx, mock.AnythingOfType("*model.Receipt")).Return(nil)
+	s.receiptProducer.On("PublishReceiptIssued", s.ctx, mock.AnythingOfType("*model.Receipt")).Return(nil)
+
+	receipt, err := s.service.Issue(s.ctx, tx)
+
+	s.Require().NoError(err)
+	s.Require().Len(receipt.Items, 2)
+	s.Require().Equal(2400.0, receipt.Items[0].Total)
+	s.Require().Equal(400.0, receipt.Items[0].VatAmount)
+	s.Require().Equal(3600.0, receipt.Total)
+	s.Require().Equal(600.0, receipt.VatAmount)
+	s.Require().Contains(receipt.Text, "ИТОГО: 3600.00")
+	s.Require().Contains(receipt.Text, tx.TransactionUUID)
+	s.Require().Contains(receipt.Text, "Банковская карта")
+	s.Require().Contains(receipt.HTML, "Иллюминатор &lt;круглый&gt;")
+}
+
+func (s *ServiceSuite) TestIssueWithoutItems() {
+	tx := newSucceededTransaction()
+
+	s.receiptRepository.On("GetByTransaction", s.ctx, tx.TransactionUUID).Return(nil, model.ErrReceiptNotFound)
+	s.receiptRepository.On("Create", s.ctx, mock.AnythingOfType("*model.Receipt")).Return(nil)
+	s.receiptProducer.On("PublishReceiptIssued", s.ctx, mock.AnythingOfType("*model.Receipt")).Return(nil)
+
+	receipt, err := s.service.Issue(s.ctx, tx)
+
+	s.Require().NoError(err)
+	s.Require().Len(receipt.Items, 1)
+	s.Require().Contains(receipt.Items[0].Name, tx.OrderUUID)
+	s.Require().Equal(tx.Amount, receipt.Total)
+}
+
+func (s *ServiceSuite) TestIssueItemsAmountMismatch() {
+	// Позиции посчитаны по текущим ценам и расходятся со списанной суммой
+	tx := newSucceededTransaction(
+		model.PaymentItem{PartUUID: gofakeit.UUID(), Name: "Двигатель", Quantity: 2, UnitPrice: 1500},
+	)
+
+	s.receiptRepository.On("GetByTransaction", s.ctx, tx.TransactionUUID).Return(nil, model.ErrReceiptNotFound)
+	s.receiptRepository.On("Create", s.ctx, mock.AnythingOfType("*model.Receipt")).Return(nil)
+	s.receiptProducer.On("PublishReceiptIssued", s.ctx, mock.AnythingOfType("*model.Receipt")).Return(nil)
+
+	receipt, err := s.service.Issue(s.ctx, tx)
+
+	s.Require().NoError(err)
+	s.Require().Len(receipt.Items, 1)
+	s.Require().Contains(receipt.Items[0].Name, tx.OrderUUID)
+	s.Require().Equal(tx.Amount, receipt.Total)
+}
+
+func (s *ServiceSuite) TestIssueAlreadyIssued() {
+	tx := newSucceededTransaction()
+	existing := &model.Receipt{ReceiptUUID: gofakeit.UUID(), TransactionUUID: tx.TransactionUUID}
+
+	s.receiptRepository.On("GetByTransaction", s.ctx, tx.TransactionUUID).Return(existing, nil)
+	// Повторная выдача заново публикует сохраненный чек
+	s.receiptProducer.On("PublishReceiptIssued", s.ctx, existing).Return(nil).Once()
+
+	receipt, err := s.service.Issue(s.ctx, tx)
+
+	s.Require().NoError(err)
+	s.Require().Equal(existing, receipt)
+}
+
+func (s *ServiceSuite) TestIssueAlreadyIssuedPublishError() {
+	tx := newSucceededTransaction()
+	existing := &model.Receipt{ReceiptUUID: gofakeit.UUID(), TransactionUUID: tx.TransactionUUID}
+	publishErr := errors.New("kafka unavailable")
+
+	s.receiptRepository.On("GetByTransaction", s.ctx, tx.TransactionUUID).Return(existing, nil)
+	s.receiptProducer.On("PublishReceiptIssued", s.ctx, existing).Return(publishErr).Once()
+
+	receipt, err := s.service.Issue(s.ctx, tx)
+
+	s.Require().ErrorIs(err, publishErr)
+	s.Require().Nil(receipt)
+}
+
+func (s *ServiceSuite) TestIssueConcurrentlyIssued() {
+	tx := newSucceededTransaction()
+	existing := &model.Receipt{ReceiptUUID: gofakeit.UUID(), TransactionUUID: tx.TransactionUUID}
+
+	s.receiptRepository.On("GetByTransaction", s.ctx, tx.TransactionUUID).Return(nil, model.ErrReceiptNotFound).Once()
+	s.receiptRepository.On("Create", s.ctx, mock.AnythingOfType("*model.Receipt")).Return(model.ErrReceiptAlreadyExists)
+	s.receiptRepository.On("GetByTransaction", s.ctx, tx.TransactionUUID).Return(existing, nil).Once()
+
+	receipt, err := s.service.Issue(s.ctx, tx)
+
+	s.Require().NoError(err)
+	s.Require().Equal(existing, receipt)
+	s.receiptProducer.AssertNotCalled(s.T(), "PublishReceiptIssued", mock.Anything, mock.Anything)
+}
+
+func (s *ServiceSuite) TestIssueRepositoryError() {
+	tx := newSucceededTransaction()
+	repoErr := errors.New("db error")
+
+	s.receiptRepository.On("GetByTransaction", s.ctx, tx.TransactionUUID).Return(nil, model.ErrReceiptNotFound)
+	s.receiptRepository.On("Create", s.ctx, mock.AnythingOfType("*model.Receipt")).Return(repoErr)
+
+	receipt, err := s.service.Issue(s.ctx, tx)
+
+	s.Require().ErrorIs(err, repoErr)
+	s.Require().Nil(receipt)
+}
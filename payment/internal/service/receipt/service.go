@@ -0,0 +1,35 @@
+package receipt
+
+import (
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/service"
+)
+
+// Проверка, что svc реализует интерфейс ReceiptService на этапе компиляции
+var _ service.ReceiptService = (*svc)(nil)
+
+// svc - реализация ReceiptService
+type svc struct {
+	receiptRepository repository.ReceiptRepository
+	receiptProducer   service.ReceiptProducerService
+	renderer          TemplateRenderer
+	vatRate           float64
+	sellerName        string
+}
+
+// New создает новый экземпляр ReceiptService. vatRate - ставка НДС в процентах, цены позиций включают НДС
+func New(
+	receiptRepository repository.ReceiptRepository,
+	receiptProducer service.ReceiptProducerService,
+	renderer TemplateRenderer,
+	vatRate float64,
+	sellerName string,
+) *svc {
+	return &svc{
+		receiptRepository: receiptRepository,
+		receiptProducer:   receiptProducer,
+		renderer:          renderer,
+		vatRate:           vatRate,
+		sellerName:        sellerName,
+	}
+}
@@ -0,0 +1,45 @@
+package receipt
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	repoMocks "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/mocks"
+	serviceMocks "github.com/Daniil-Sakharov/RocketFactory/payment/internal/service/mocks"
+)
+
+const testVatRate = 20
+
+type ServiceSuite struct {
+	suite.Suite
+	ctx               context.Context
+	receiptRepository *repoMocks.ReceiptRepository
+	receiptProducer   *serviceMocks.ReceiptProducerService
+	service           *svc
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctx = context.Background()
+
+	s.receiptRepository = repoMocks.NewReceiptRepository(s.T())
+	s.receiptProducer = serviceMocks.NewReceiptProducerService(s.T())
+
+	engine, err := NewTemplateEngine()
+	s.Require().NoError(err)
+
+	s.service = New(
+		s.receiptRepository,
+		s.receiptProducer,
+		engine,
+		testVatRate,
+		"Rocket Factory",
+	)
+}
+
+func (s *ServiceSuite) TearDownTest() {}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
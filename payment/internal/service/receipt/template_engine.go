@@ -0,0 +1,95 @@
+package receipt
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	htmlTemplate "html/template"
+	textTemplate "text/template"
+	"time"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+//go:embed templates/*.tmpl
+var templateFS embed.FS
+
+const (
+	textTemplateName = "receipt.txt.tmpl"
+	htmlTemplateName = "receipt.html.tmpl"
+)
+
+// TemplateRenderer отрисовывает чек в текстовом и HTML виде
+type TemplateRenderer interface {
+	RenderText(data *TemplateData) (string, error)
+	RenderHTML(data *TemplateData) (string, error)
+}
+
+// TemplateData - данные для шаблонов чека
+type TemplateData struct {
+	SellerName string
+	Receipt    *model.Receipt
+}
+
+type TemplateEngine struct {
+	text *textTemplate.Template
+	html *htmlTemplate.Template
+}
+
+func NewTemplateEngine() (*TemplateEngine, error) {
+	text, err := textTemplate.New("").Funcs(textTemplate.FuncMap(templateFuncs)).ParseFS(templateFS, "templates/"+textTemplateName)
+	if err != nil {
+		return nil, err
+	}
+
+	html, err := htmlTemplate.New("").Funcs(htmlTemplate.FuncMap(templateFuncs)).ParseFS(templateFS, "templates/"+htmlTemplateName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TemplateEngine{
+		text: text,
+		html: html,
+	}, nil
+}
+
+func (e *TemplateEngine) RenderText(data *TemplateData) (string, error) {
+	var buf bytes.Buffer
+	if err := e.text.ExecuteTemplate(&buf, textTemplateName, data); err != nil {
+		return "", fmt.Errorf("failed to render template %s: %w", textTemplateName, err)
+	}
+	return buf.String(), nil
+}
+
+func (e *TemplateEngine) RenderHTML(data *TemplateData) (string, error) {
+	var buf bytes.Buffer
+	if err := e.html.ExecuteTemplate(&buf, htmlTemplateName, data); err != nil {
+		return "", fmt.Errorf("failed to render template %s: %w", htmlTemplateName, err)
+	}
+	return buf.String(), nil
+}
+
+var templateFuncs = map[string]any{
+	"money": func(v float64) string {
+		return fmt.Sprintf("%.2f", v)
+	},
+	"paymentMethod": paymentMethodTitle,
+	"date": func(t time.Time) string {
+		return t.Format("02.01.2006 15:04:05")
+	},
+}
+
+func paymentMethodTitle(method model.PaymentMethod) string {
+	switch method {
+	case model.PaymentMethodCard:
+		return "Банковская карта"
+	case model.PaymentMethodSBP:
+		return "СБП"
+	case model.PaymentMethodCreditCard:
+		return "Кредитная карта"
+	case model.PaymentMethodInvestorMoney:
+		return "Деньги инвестора"
+	default:
+		return "Неизвестный способ"
+	}
+}
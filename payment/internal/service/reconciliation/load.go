@@ -0,0 +1,57 @@
+package reconciliation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+// loadMissingTransactions догружает транзакции заказов, созданные вне периода сверки
+func (s *svc) loadMissingTransactions(ctx context.Context, orders []*model.OrderSnapshot, txsByUUID map[string]*model.Transaction) error {
+	missing := make([]string, 0)
+	for _, order := range orders {
+		if order.TransactionUUID == "" {
+			continue
+		}
+		if _, ok := txsByUUID[order.TransactionUUID]; !ok {
+			missing = append(missing, order.TransactionUUID)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	txs, err := s.transactionRepository.ListByUUIDs(ctx, missing)
+	if err != nil {
+		return fmt.Errorf("failed to load transactions: %w", err)
+	}
+	for _, tx := range txs {
+		txsByUUID[tx.TransactionUUID] = tx
+	}
+
+	return nil
+}
+
+// loadMissingOrders догружает заказы транзакций, которые не попали в выборку оплаченных за период
+func (s *svc) loadMissingOrders(ctx context.Context, txs []*model.Transaction, ordersByUUID map[string]*model.OrderSnapshot) error {
+	missing := make([]string, 0)
+	for _, tx := range txs {
+		if _, ok := ordersByUUID[tx.OrderUUID]; !ok {
+			missing = append(missing, tx.OrderUUID)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	orders, err := s.orderSnapshotRepository.ListByUUIDs(ctx, missing)
+	if err != nil {
+		return fmt.Errorf("failed to load orders: %w", err)
+	}
+	for _, order := range orders {
+		ordersByUUID[order.OrderUUID] = order
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+package reconciliation
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+	"github.com/Daniil-Sakharov/RocketFactory/platform/pkg/logger"
+)
+
+// Reconcile сверяет оплаченные заказы и успешные транзакции за период.
+// Парные записи за пределами периода догружаются, чтобы граница окна не давала ложных расхождений
+func (s *svc) Reconcile(ctx context.Context, req *model.ReconciliationRequest) (*model.ReconciliationReport, error) {
+	paidOrders, err := s.orderSnapshotRepository.ListPaid(ctx, req.Since, req.Until)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load paid orders: %w", err)
+	}
+
+	transactions, err := s.transactionRepository.ListSucceeded(ctx, req.Since, req.Until)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load transactions: %w", err)
+	}
+
+	ordersByUUID := make(map[string]*model.OrderSnapshot, len(paidOrders))
+	for _, order := range paidOrders {
+		ordersByUUID[order.OrderUUID] = order
+	}
+	txsByUUID := make(map[string]*model.Transaction, len(transactions))
+	for _, tx := range transactions {
+		txsByUUID[tx.TransactionUUID] = tx
+	}
+
+	if err = s.loadMissingTransactions(ctx, paidOrders, txsByUUID); err != nil {
+		return nil, err
+	}
+	if err = s.loadMissingOrders(ctx, transactions, ordersByUUID); err != nil {
+		return nil, err
+	}
+
+	report := &model.ReconciliationReport{
+		GeneratedAt:         time.Now(),
+		Since:               req.Since,
+		Until:               req.Until,
+		CheckedOrders:       len(paidOrders),
+		CheckedTransactions: len(transactions),
+		Discrepancies:       []*model.Discrepancy{},
+	}
+
+	// Сумма сверяется один раз на пару заказ-транзакция
+	checkedPairs := make(map[string]struct{})
+
+	for _, order := range paidOrders {
+		if d := checkPaidOrder(order, txsByUUID[order.TransactionUUID]); d != nil {
+			report.Discrepancies = append(report.Discrepancies, d)
+		}
+		checkedPairs[order.OrderUUID+order.TransactionUUID] = struct{}{}
+	}
+
+	for _, tx := range transactions {
+		order := ordersByUUID[tx.OrderUUID]
+		if _, ok := checkedPairs[tx.OrderUUID+tx.TransactionUUID]; ok {
+			continue
+		}
+		if d := checkTransaction(tx, order); d != nil {
+			report.Discrepancies = append(report.Discrepancies, d)
+		}
+	}
+
+	logger.Info(ctx, "🔍 Сверка платежей завершена",
+		zap.Time("since", req.Since),
+		zap.Time("until", req.Until),
+		zap.Int("checked_orders", report.CheckedOrders),
+		zap.Int("checked_transactions", report.CheckedTransactions),
+		zap.Int("discrepancies", len(report.Discrepancies)),
+	)
+
+	if req.Publish {
+		for _, d := range report.Discrepancies {
+			if err = s.reconciliationProducer.PublishDiscrepancy(ctx, d); err != nil {
+				return nil, fmt.Errorf("failed to publish discrepancy: %w", err)
+			}
+		}
+	}
+
+	return report, nil
+}
+
+// checkPaidOrder проверяет, что оплаченный заказ подтвержден успешной транзакцией на ту же сумму
+func checkPaidOrder(order *model.OrderSnapshot, tx *model.Transaction) *model.Discrepancy {
+	d := &model.Discrepancy{
+		Type:            model.DiscrepancyTypePaidOrderWithoutTransaction,
+		OrderUUID:       order.OrderUUID,
+		TransactionUUID: order.TransactionUUID,
+		OrderAmount:     order.TotalPrice,
+	}
+
+	switch {
+	case order.TransactionUUID == "":
+		d.Details = "order has no transaction_uuid"
+	case tx == nil:
+		d.Details = "transaction not found"
+	case tx.OrderUUID != order.OrderUUID:
+		d.PaymentAmount = tx.Amount
+		d.Details = fmt.Sprintf("transaction belongs to order %s", tx.OrderUUID)
+	case tx.Status != model.PaymentStatusSucceeded:
+		d.PaymentAmount = tx.Amount
+		d.Details = fmt.Sprintf("transaction status is %d", tx.Status)
+	case !order.PriceUnknown && !tx.AmountUnknown && !amountsEqual(order.TotalPrice, tx.Amount):
+		d.Type = model.DiscrepancyTypeAmountMismatch
+		d.PaymentAmount = tx.Amount
+		d.Details = fmt.Sprintf("order total %.2f, transaction amount %.2f", order.TotalPrice, tx.Amount)
+	default:
+		return nil
+	}
+
+	return d
+}
+
+// checkTransaction проверяет, что успешной транзакцией оплачен существующий заказ.
+// Снимки собираются из OrderPaid, поэтому отсутствие снимка не доказывает, что заказ не оплачен:
+// событие могло еще не дойти или потеряться. Такой платеж отчитывается отдельным типом,
+// а не как платеж без заказа
+func checkTransaction(tx *model.Transaction, order *model.OrderSnapshot) *model.Discrepancy {
+	d := &model.Discrepancy{
+		Type:            model.DiscrepancyTypeOrphanPayment,
+		OrderUUID:       tx.OrderUUID,
+		TransactionUUID: tx.TransactionUUID,
+		PaymentAmount:   tx.Amount,
+	}
+
+	switch {
+	case order == nil:
+		d.Type = model.DiscrepancyTypeMissingOrderSnapshot
+		d.Details = "order snapshot not found: OrderPaid not received"
+	case !order.IsPaid():
+		d.OrderAmount = order.TotalPrice
+		d.Details = fmt.Sprintf("order status is %s", order.Status)
+	case order.TransactionUUID != tx.TransactionUUID:
+		d.OrderAmount = order.TotalPrice
+		d.Details = fmt.Sprintf("order is paid by transaction %s", order.TransactionUUID)
+	case !order.PriceUnknown && !tx.AmountUnknown && !amountsEqual(order.TotalPrice, tx.Amount):
+		d.Type = model.DiscrepancyTypeAmountMismatch
+		d.OrderAmount = order.TotalPrice
+		d.Details = fmt.Sprintf("order total %.2f, transaction amount %.2f", order.TotalPrice, tx.Amount)
+	default:
+		return nil
+	}
+
+	return d
+}
+
+func amountsEqual(a, b float64) bool {
+	return math.Abs(a-b) < amountTolerance
+}
@@ -0,0 +1,196 @@
+package reconciliation
+
+import (
+	"errors"
+	"time"
+
+	"github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
+)
+
+func (s *ServiceSuite) newRequest(publish bool) *model.ReconciliationRequest {
+	until := time.Now()
+	return &model.ReconciliationRequest{
+		Since:   until.Add(-24 * time.Hour),
+		Until:   until,
+		Publish: publish,
+	}
+}
+
+func newPair(amount float64) (*model.OrderSnapshot, *model.Transaction) {
+	order := &model.OrderSnapshot{
+		OrderUUID:       gofakeit.UUID(),
+		UserUUID:        gofakeit.UUID(),
+		TotalPrice:      amount,
+		TransactionUUID: gofakeit.UUID(),
+		Status:          model.OrderSnapshotStatusPaid,
+	}
+	tx := &model.Transaction{
+		TransactionUUID: order.TransactionUUID,
+		OrderUUID:       order.OrderUUID,
+		UserUUID:        order.UserUUID,
+		Amount:          amount,
+		Status:          model.PaymentStatusSucceeded,
+	}
+	return order, tx
+}
+
+func (s *ServiceSuite) TestReconcileNoDiscrepancies() {
+	req := s.newRequest(true)
+	order, tx := newPair(1500)
+
+	s.orderSnapshotRepository.On("ListPaid", s.ctx, req.Since, req.Until).Return([]*model.OrderSnapshot{order}, nil)
+	s.transactionRepository.On("ListSucceeded", s.ctx, req.Since, req.Until).Return([]*model.Transaction{tx}, nil)
+
+	report, err := s.service.Reconcile(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().Equal(1, report.CheckedOrders)
+	s.Require().Equal(1, report.CheckedTransactions)
+	s.Require().Empty(report.Discrepancies)
+}
+
+func (s *ServiceSuite) TestReconcileUnknownOrderPrice() {
+	req := s.newRequest(false)
+	order, tx := newPair(1500)
+	// Снимок из события без total_price: сумму сравнить не с чем
+	order.TotalPrice = 0
+	order.PriceUnknown = true
+
+	s.orderSnapshotRepository.On("ListPaid", s.ctx, req.Since, req.Until).Return([]*model.OrderSnapshot{order}, nil)
+	s.transactionRepository.On("ListSucceeded", s.ctx, req.Since, req.Until).Return([]*model.Transaction{tx}, nil)
+
+	report, err := s.service.Reconcile(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().Empty(report.Discrepancies)
+}
+
+func (s *ServiceSuite) TestReconcileUnknownTransactionAmount() {
+	req := s.newRequest(false)
+	order, tx := newPair(1500)
+	// Транзакция создана до появления колонки amount: сумма в базе NULL
+	tx.Amount = 0
+	tx.AmountUnknown = true
+
+	s.orderSnapshotRepository.On("ListPaid", s.ctx, req.Since, req.Until).Return([]*model.OrderSnapshot{order}, nil)
+	s.transactionRepository.On("ListSucceeded", s.ctx, req.Since, req.Until).Return([]*model.Transaction{tx}, nil)
+
+	report, err := s.service.Reconcile(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().Empty(report.Discrepancies)
+}
+
+func (s *ServiceSuite) TestReconcileAmountMismatch() {
+	req := s.newRequest(false)
+	order, tx := newPair(1500)
+	tx.Amount = 1400
+
+	s.orderSnapshotRepository.On("ListPaid", s.ctx, req.Since, req.Until).Return([]*model.OrderSnapshot{order}, nil)
+	s.transactionRepository.On("ListSucceeded", s.ctx, req.Since, req.Until).Return([]*model.Transaction{tx}, nil)
+
+	report, err := s.service.Reconcile(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().Len(report.Discrepancies, 1)
+	s.Require().Equal(model.DiscrepancyTypeAmountMismatch, report.Discrepancies[0].Type)
+	s.Require().Equal(1500.0, report.Discrepancies[0].OrderAmount)
+	s.Require().Equal(1400.0, report.Discrepancies[0].PaymentAmount)
+}
+
+func (s *ServiceSuite) TestReconcilePaidOrderWithoutTransaction() {
+	req := s.newRequest(true)
+	order, _ := newPair(1500)
+
+	s.orderSnapshotRepository.On("ListPaid", s.ctx, req.Since, req.Until).Return([]*model.OrderSnapshot{order}, nil)
+	s.transactionRepository.On("ListSucceeded", s.ctx, req.Since, req.Until).Return([]*model.Transaction{}, nil)
+	s.transactionRepository.On("ListByUUIDs", s.ctx, []string{order.TransactionUUID}).Return([]*model.Transaction{}, nil)
+	s.reconciliationProducer.On("PublishDiscrepancy", s.ctx, mock.MatchedBy(func(d *model.Discrepancy) bool {
+		return d.Type == model.DiscrepancyTypePaidOrderWithoutTransaction && d.OrderUUID == order.OrderUUID
+	})).Return(nil)
+
+	report, err := s.service.Reconcile(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().Len(report.Discrepancies, 1)
+}
+
+func (s *ServiceSuite) TestReconcileTransactionOutsideWindow() {
+	req := s.newRequest(false)
+	order, tx := newPair(1500)
+
+	s.orderSnapshotRepository.On("ListPaid", s.ctx, req.Since, req.Until).Return([]*model.OrderSnapshot{order}, nil)
+	s.transactionRepository.On("ListSucceeded", s.ctx, req.Since, req.Until).Return([]*model.Transaction{}, nil)
+	s.transactionRepository.On("ListByUUIDs", s.ctx, []string{order.TransactionUUID}).Return([]*model.Transaction{tx}, nil)
+
+	report, err := s.service.Reconcile(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().Empty(report.Discrepancies)
+}
+
+func (s *ServiceSuite) TestReconcileMissingOrderSnapshot() {
+	req := s.newRequest(false)
+	_, tx := newPair(1500)
+
+	// OrderPaid по заказу не дошел: снимка нет ни в периоде, ни за его пределами
+	s.orderSnapshotRepository.On("ListPaid", s.ctx, req.Since, req.Until).Return([]*model.OrderSnapshot{}, nil)
+	s.transactionRepository.On("ListSucceeded", s.ctx, req.Since, req.Until).Return([]*model.Transaction{tx}, nil)
+	s.orderSnapshotRepository.On("ListByUUIDs", s.ctx, []string{tx.OrderUUID}).Return([]*model.OrderSnapshot{}, nil)
+
+	report, err := s.service.Reconcile(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().Len(report.Discrepancies, 1)
+	s.Require().Equal(model.DiscrepancyTypeMissingOrderSnapshot, report.Discrepancies[0].Type)
+	s.Require().Equal(tx.TransactionUUID, report.Discrepancies[0].TransactionUUID)
+}
+
+func (s *ServiceSuite) TestReconcileOrphanPayment() {
+	req := s.newRequest(false)
+	order, tx := newPair(1500)
+	order.Status = "CANCELLED"
+
+	s.orderSnapshotRepository.On("ListPaid", s.ctx, req.Since, req.Until).Return([]*model.OrderSnapshot{}, nil)
+	s.transactionRepository.On("ListSucceeded", s.ctx, req.Since, req.Until).Return([]*model.Transaction{tx}, nil)
+	s.orderSnapshotRepository.On("ListByUUIDs", s.ctx, []string{tx.OrderUUID}).Return([]*model.OrderSnapshot{order}, nil)
+
+	report, err := s.service.Reconcile(s.ctx, req)
+
+	s.Require().NoError(err)
+	s.Require().Len(report.Discrepancies, 1)
+	s.Require().Equal(model.DiscrepancyTypeOrphanPayment, report.Discrepancies[0].Type)
+	s.Require().Equal(tx.TransactionUUID, report.Discrepancies[0].TransactionUUID)
+}
+
+func (s *ServiceSuite) TestReconcileRepositoryError() {
+	req := s.newRequest(false)
+	repoErr := errors.New("connection refused")
+
+	s.orderSnapshotRepository.On("ListPaid", s.ctx, req.Since, req.Until).Return(nil, repoErr)
+
+	report, err := s.service.Reconcile(s.ctx, req)
+
+	s.Require().Error(err)
+	s.Require().ErrorIs(err, repoErr)
+	s.Require().Nil(report)
+}
+
+func (s *ServiceSuite) TestReconcilePublishError() {
+	req := s.newRequest(true)
+	order, tx := newPair(1500)
+	tx.Amount = 10
+	publishErr := errors.New("kafka unavailable")
+
+	s.orderSnapshotRepository.On("ListPaid", s.ctx, req.Since, req.Until).Return([]*model.OrderSnapshot{order}, nil)
+	s.transactionRepository.On("ListSucceeded", s.ctx, req.Since, req.Until).Return([]*model.Transaction{tx}, nil)
+	s.reconciliationProducer.On("PublishDiscrepancy", s.ctx, mock.Anything).Return(publishErr)
+
+	report, err := s.service.Reconcile(s.ctx, req)
+
+	s.Require().ErrorIs(err, publishErr)
+	s.Require().Nil(report)
+}
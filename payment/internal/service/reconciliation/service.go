@@ -0,0 +1,32 @@
+package reconciliation
+
+import (
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository"
+	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/service"
+)
+
+// Проверка, что svc реализует интерфейс ReconciliationService на этапе компиляции
+var _ service.ReconciliationService = (*svc)(nil)
+
+// amountTolerance - допустимая разница сумм (округление до копеек)
+const amountTolerance = 0.005
+
+// svc - реализация ReconciliationService
+type svc struct {
+	transactionRepository   repository.TransactionRepository
+	orderSnapshotRepository repository.OrderSnapshotRepository
+	reconciliationProducer  service.ReconciliationProducerService
+}
+
+// New создает новый экземпляр ReconciliationService
+func New(
+	transactionRepository repository.TransactionRepository,
+	orderSnapshotRepository repository.OrderSnapshotRepository,
+	reconciliationProducer service.ReconciliationProducerService,
+) *svc {
+	return &svc{
+		transactionRepository:   transactionRepository,
+		orderSnapshotRepository: orderSnapshotRepository,
+		reconciliationProducer:  reconciliationProducer,
+	}
+}
@@ -0,0 +1,40 @@
+package reconciliation
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	repoMocks "github.com/Daniil-Sakharov/RocketFactory/payment/internal/repository/mocks"
+	serviceMocks "github.com/Daniil-Sakharov/RocketFactory/payment/internal/service/mocks"
+)
+
+type ServiceSuite struct {
+	suite.Suite
+	ctx                     context.Context
+	transactionRepository   *repoMocks.TransactionRepository
+	orderSnapshotRepository *repoMocks.OrderSnapshotRepository
+	reconciliationProducer  *serviceMocks.ReconciliationProducerService
+	service                 *svc
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ctx = context.Background()
+
+	s.transactionRepository = repoMocks.NewTransactionRepository(s.T())
+	s.orderSnapshotRepository = repoMocks.NewOrderSnapshotRepository(s.T())
+	s.reconciliationProducer = serviceMocks.NewReconciliationProducerService(s.T())
+
+	s.service = New(
+		s.transactionRepository,
+		s.orderSnapshotRepository,
+		s.reconciliationProducer,
+	)
+}
+
+func (s *ServiceSuite) TearDownTest() {}
+
+func TestServiceIntegration(t *testing.T) {
+	suite.Run(t, new(ServiceSuite))
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"github.com/Daniil-Sakharov/RocketFactory/payment/internal/model"
 )
@@ -9,4 +10,74 @@ import (
 type PaymentService interface {
 	// PayOrder обрабатывает платеж и возвращает UUID транзакции
 	PayOrder(ctx context.Context, req *model.PayOrderRequest) (*model.PayOrderResponse, error)
+	// ConfirmPayment фиксирует результат платежа, пришедший от платежного шлюза
+	ConfirmPayment(ctx context.Context, req *model.ConfirmPaymentRequest) (*model.Transaction, error)
+	// GetPayment возвращает транзакцию по UUID
+	GetPayment(ctx context.Context, transactionUUID string) (*model.Transaction, error)
+	// PublishPendingResults досылает события по завершенным транзакциям, которые не удалось опубликовать
+	PublishPendingResults(ctx context.Context, now time.Time) (int, error)
+}
+
+type LedgerService interface {
+	// TopUp пополняет счет инвестора
+	TopUp(ctx context.Context, req *model.TopUpRequest) (*model.TopUpResult, error)
+	// GetBalance возвращает текущий баланс счета инвестора
+	GetBalance(ctx context.Context, userUUID string) (float64, error)
+	// GetStatement возвращает выписку по счету инвестора
+	GetStatement(ctx context.Context, req *model.StatementRequest) (*model.Statement, error)
+}
+
+type FraudService interface {
+	// Evaluate прогоняет платеж через антифрод-правила и сохраняет решение
+	Evaluate(ctx context.Context, check *model.FraudCheck) (*model.FraudDecision, error)
+	// ListFlagged возвращает платежи, отмеченные для ручного разбора
+	ListFlagged(ctx context.Context, limit, offset int) ([]*model.FraudDecision, error)
+}
+
+type ReceiptService interface {
+	// Issue формирует и сохраняет чек успешной транзакции; повторный вызов возвращает уже выданный чек
+	Issue(ctx context.Context, tx *model.Transaction) (*model.Receipt, error)
+	// Get возвращает чек по UUID транзакции
+	Get(ctx context.Context, transactionUUID string) (*model.Receipt, error)
+}
+
+type InstallmentService interface {
+	// CheckEligibility проверяет, что сумму можно разбить на count платежей
+	CheckEligibility(amount float64, count int32) error
+	// CreatePlan разбивает сумму транзакции на ежемесячные платежи, сохраняет план и списывает первый платеж
+	CreatePlan(ctx context.Context, tx *model.Transaction, count int32) (*model.InstallmentPlan, error)
+	// ChargeDue списывает платежи, срок которых наступил к моменту now
+	ChargeDue(ctx context.Context, now time.Time) (*model.InstallmentChargeResult, error)
+	// GetByTransaction возвращает план рассрочки по UUID транзакции
+	GetByTransaction(ctx context.Context, transactionUUID string) (*model.InstallmentPlan, error)
+	// ListByUser возвращает планы рассрочки пользователя
+	ListByUser(ctx context.Context, userUUID string, limit, offset int) ([]*model.InstallmentPlan, error)
+}
+
+type ReconciliationService interface {
+	// Reconcile сверяет оплаченные заказы order сервиса с транзакциями за период
+	Reconcile(ctx context.Context, req *model.ReconciliationRequest) (*model.ReconciliationReport, error)
+}
+
+type OrderConsumerService interface {
+	// RunConsumer читает события OrderPaid и сохраняет снимки оплаченных заказов для сверки
+	RunConsumer(ctx context.Context) error
+}
+
+type PaymentProducerService interface {
+	PublishPaymentSucceeded(ctx context.Context, event *model.PaymentEvent) error
+	PublishPaymentFailed(ctx context.Context, event *model.PaymentEvent) error
+}
+
+type ReceiptProducerService interface {
+	PublishReceiptIssued(ctx context.Context, receipt *model.Receipt) error
+}
+
+type InstallmentProducerService interface {
+	PublishInstallmentMissed(ctx context.Context, due *model.DueInstallment) error
+	PublishInstallmentPlanDefaulted(ctx context.Context, due *model.DueInstallment) error
+}
+
+type ReconciliationProducerService interface {
+	PublishDiscrepancy(ctx context.Context, discrepancy *model.Discrepancy) error
 }
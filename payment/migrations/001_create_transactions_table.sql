@@ -0,0 +1,27 @@
+-- +goose Up
+CREATE TYPE payment_method AS ENUM (
+    'UNKNOWN',
+    'CARD',
+    'SBP',
+    'CREDIT_CARD',
+    'INVESTOR_MONEY'
+);
+
+CREATE TYPE payment_status AS ENUM (
+    'PENDING',
+    'SUCCEEDED',
+    'FAILED'
+);
+
+CREATE TABLE transactions (
+    transaction_uuid UUID PRIMARY KEY,
+    order_uuid UUID NOT NULL,
+    user_uuid UUID NOT NULL,
+    payment_method payment_method NOT NULL,
+    status payment_status NOT NULL,
+    failure_reason TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_transactions_order_uuid ON transactions (order_uuid);
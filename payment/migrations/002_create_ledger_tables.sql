@@ -0,0 +1,55 @@
+-- +goose Up
+CREATE TYPE ledger_account_type AS ENUM (
+    'INVESTOR',
+    'SYSTEM_FUNDING',
+    'SYSTEM_REVENUE'
+);
+
+CREATE TYPE ledger_entry_type AS ENUM (
+    'TOP_UP',
+    'PAYMENT'
+);
+
+-- Счета: у инвестора баланс не может уйти в минус, системные счета - контрсчета проводок
+CREATE TABLE ledger_accounts (
+    account_uuid UUID PRIMARY KEY,
+    owner_uuid UUID,
+    account_type ledger_account_type NOT NULL,
+    balance DECIMAL(12,2) NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT ledger_accounts_investor_balance_check CHECK (account_type <> 'INVESTOR' OR balance >= 0),
+    CONSTRAINT ledger_accounts_investor_owner_check CHECK (account_type <> 'INVESTOR' OR owner_uuid IS NOT NULL)
+);
+
+CREATE UNIQUE INDEX idx_ledger_accounts_owner_type ON ledger_accounts (owner_uuid, account_type);
+
+-- Системные счета существуют в единственном экземпляре
+CREATE UNIQUE INDEX idx_ledger_accounts_system_type ON ledger_accounts (account_type) WHERE owner_uuid IS NULL;
+
+INSERT INTO ledger_accounts (account_uuid, owner_uuid, account_type) VALUES
+    ('00000000-0000-0000-0000-000000000001', NULL, 'SYSTEM_FUNDING'),
+    ('00000000-0000-0000-0000-000000000002', NULL, 'SYSTEM_REVENUE');
+
+-- Журнал проводок: reference уникален в рамках типа, что делает проводки идемпотентными
+CREATE TABLE ledger_entries (
+    entry_uuid UUID PRIMARY KEY,
+    entry_type ledger_entry_type NOT NULL,
+    reference TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT ledger_entries_type_reference_key UNIQUE (entry_type, reference)
+);
+
+-- Движения по счетам: сумма postings одной проводки всегда равна нулю
+CREATE TABLE ledger_postings (
+    posting_id BIGSERIAL PRIMARY KEY,
+    entry_uuid UUID NOT NULL REFERENCES ledger_entries (entry_uuid),
+    account_uuid UUID NOT NULL REFERENCES ledger_accounts (account_uuid),
+    amount DECIMAL(12,2) NOT NULL,
+    balance_after DECIMAL(12,2) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_ledger_postings_account ON ledger_postings (account_uuid, posting_id DESC);
+CREATE INDEX idx_ledger_postings_entry ON ledger_postings (entry_uuid);
+
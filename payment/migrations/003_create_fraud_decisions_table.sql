@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TYPE fraud_verdict AS ENUM (
+    'APPROVE',
+    'REVIEW',
+    'REJECT'
+);
+
+CREATE TABLE fraud_decisions (
+    decision_uuid UUID PRIMARY KEY,
+    transaction_uuid UUID NOT NULL,
+    order_uuid UUID NOT NULL,
+    user_uuid UUID NOT NULL,
+    payment_method payment_method NOT NULL,
+    amount DECIMAL(12,2) NOT NULL,
+    verdict fraud_verdict NOT NULL,
+    reasons TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_fraud_decisions_transaction_uuid ON fraud_decisions (transaction_uuid);
+CREATE INDEX idx_fraud_decisions_review ON fraud_decisions (created_at DESC) WHERE verdict = 'REVIEW';
+
+-- Для правила на частоту платежей пользователя
+CREATE INDEX idx_transactions_user_created ON transactions (user_uuid, created_at);
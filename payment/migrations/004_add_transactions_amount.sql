@@ -0,0 +1,4 @@
+-- +goose Up
+-- Сумма неизвестна для транзакций, созданных до появления колонки: такие строки остаются NULL,
+-- и сверка не сравнивает их с суммой заказа
+ALTER TABLE transactions ADD COLUMN amount DECIMAL(12,2);
@@ -0,0 +1,18 @@
+-- +goose Up
+-- Позиции заказа хранятся вместе с транзакцией: чек по асинхронному платежу выдается после callback'а шлюза
+ALTER TABLE transactions ADD COLUMN items JSONB NOT NULL DEFAULT '[]';
+
+CREATE TABLE receipts (
+    receipt_uuid UUID PRIMARY KEY,
+    transaction_uuid UUID NOT NULL UNIQUE REFERENCES transactions (transaction_uuid),
+    order_uuid UUID NOT NULL,
+    user_uuid UUID NOT NULL,
+    payment_method payment_method NOT NULL,
+    items JSONB NOT NULL,
+    vat_rate DECIMAL(5,2) NOT NULL,
+    vat_amount DECIMAL(12,2) NOT NULL,
+    total DECIMAL(12,2) NOT NULL,
+    text_body TEXT NOT NULL,
+    html_body TEXT NOT NULL,
+    issued_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
@@ -0,0 +1,40 @@
+-- +goose Up
+CREATE TYPE installment_plan_status AS ENUM (
+    'ACTIVE',
+    'COMPLETED',
+    'CANCELLED'
+);
+
+CREATE TYPE installment_status AS ENUM (
+    'SCHEDULED',
+    'PAID',
+    'MISSED'
+);
+
+CREATE TABLE installment_plans (
+    plan_uuid UUID PRIMARY KEY,
+    transaction_uuid UUID NOT NULL UNIQUE REFERENCES transactions (transaction_uuid),
+    order_uuid UUID NOT NULL,
+    user_uuid UUID NOT NULL,
+    total_amount DECIMAL(12,2) NOT NULL,
+    status installment_plan_status NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_installment_plans_user ON installment_plans (user_uuid, created_at DESC);
+
+CREATE TABLE installments (
+    installment_uuid UUID PRIMARY KEY,
+    plan_uuid UUID NOT NULL REFERENCES installment_plans (plan_uuid),
+    number INT NOT NULL,
+    amount DECIMAL(12,2) NOT NULL,
+    due_date TIMESTAMPTZ NOT NULL,
+    status installment_status NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    failure_reason TEXT,
+    paid_at TIMESTAMPTZ,
+    UNIQUE (plan_uuid, number)
+);
+
+-- Для job'а списаний: неоплаченные платежи по сроку
+CREATE INDEX idx_installments_due ON installments (due_date) WHERE status <> 'PAID';
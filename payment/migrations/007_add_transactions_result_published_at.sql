@@ -0,0 +1,10 @@
+-- +goose Up
+-- Итоговый статус и отметка о публикации PaymentSucceeded/PaymentFailed хранятся в одной строке:
+-- если Kafka недоступна в момент смены статуса, событие дошлет job публикации результатов
+ALTER TABLE transactions ADD COLUMN result_published_at TIMESTAMPTZ;
+
+-- События по уже завершенным транзакциям отправлены до появления колонки
+UPDATE transactions SET result_published_at = updated_at WHERE status <> 'PENDING';
+
+CREATE INDEX idx_transactions_unpublished ON transactions (updated_at)
+    WHERE status <> 'PENDING' AND result_published_at IS NULL;
@@ -0,0 +1,15 @@
+-- +goose Up
+-- Снимки оплаченных заказов для сверки. Наполняются из событий OrderPaid order сервиса,
+-- чтобы payment не читал базу order сервиса напрямую
+CREATE TABLE order_snapshots (
+    order_uuid UUID PRIMARY KEY,
+    user_uuid UUID NOT NULL,
+    -- NULL для событий, опубликованных до появления total_price в OrderPaid
+    total_price DECIMAL(12,2),
+    transaction_uuid UUID,
+    order_status VARCHAR(32) NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Для выборки оплаченных заказов за период сверки
+CREATE INDEX idx_order_snapshots_updated_at ON order_snapshots (updated_at);
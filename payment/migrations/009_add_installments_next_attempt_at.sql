@@ -0,0 +1,10 @@
+-- +goose Up
+-- Платеж, попытки списания которого исчерпаны (INSTALLMENT_MAX_ATTEMPTS), больше не повторяется
+ALTER TYPE installment_status ADD VALUE 'FAILED';
+
+-- Время следующей попытки списания: job закрепляет платеж, сдвигая его вперед,
+-- а после отказа откладывает повтор на INSTALLMENT_RETRY_INTERVAL
+ALTER TABLE installments ADD COLUMN next_attempt_at TIMESTAMPTZ;
+
+DROP INDEX idx_installments_due;
+CREATE INDEX idx_installments_due ON installments (due_date) WHERE status IN ('SCHEDULED', 'MISSED');
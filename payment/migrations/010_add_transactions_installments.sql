@@ -0,0 +1,9 @@
+-- +goose Up
+-- Количество платежей рассрочки: такие транзакции проводит сам payment сервис,
+-- и callback шлюза не должен менять их статус
+ALTER TABLE transactions ADD COLUMN installments INT NOT NULL DEFAULT 0;
+
+UPDATE transactions t
+SET installments = (SELECT COUNT(*) FROM installments i WHERE i.plan_uuid = p.plan_uuid)
+FROM installment_plans p
+WHERE p.transaction_uuid = t.transaction_uuid;
@@ -0,0 +1,4 @@
+-- +goose Up
+-- План, платеж по которому не списан за INSTALLMENT_MAX_ATTEMPTS попыток, прекращается:
+-- job больше не списывает по нему оставшиеся платежи
+ALTER TYPE installment_plan_status ADD VALUE 'DEFAULTED';
@@ -0,0 +1,89 @@
+package grpc
+
+import (
+	"context"
+	"crypto/subtle"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	AdminTokenMetadataKey = "admin-token"
+)
+
+// AdminInterceptor пропускает вызовы административных методов только с корректным admin-token в metadata.
+// Остальные методы вызываются без проверки
+type AdminInterceptor struct {
+	token   string
+	methods map[string]struct{}
+}
+
+// NewAdminInterceptor создает интерцептор для перечисленных полных имен методов.
+// Пустой token запрещает вызов административных методов
+func NewAdminInterceptor(token string, methods ...string) *AdminInterceptor {
+	set := make(map[string]struct{}, len(methods))
+	for _, method := range methods {
+		set[method] = struct{}{}
+	}
+
+	return &AdminInterceptor{token: token, methods: set}
+}
+
+func (a *AdminInterceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req any,
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (any, error) {
+		if _, ok := a.methods[info.FullMethod]; ok {
+			if err := a.authorize(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func (a *AdminInterceptor) Stream() grpc.StreamServerInterceptor {
+	return func(
+		srv any,
+		stream grpc.ServerStream,
+		info *grpc.StreamServerInfo,
+		handler grpc.StreamHandler,
+	) error {
+		if _, ok := a.methods[info.FullMethod]; ok {
+			if err := a.authorize(stream.Context()); err != nil {
+				return err
+			}
+		}
+
+		return handler(srv, stream)
+	}
+}
+
+func (a *AdminInterceptor) authorize(ctx context.Context) error {
+	if a.token == "" {
+		return status.Error(codes.PermissionDenied, "admin methods are disabled")
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return status.Error(codes.Unauthenticated, "missing metadata")
+	}
+
+	tokens := md.Get(AdminTokenMetadataKey)
+	if len(tokens) == 0 || tokens[0] == "" {
+		return status.Error(codes.Unauthenticated, "missing admin-token in metadata")
+	}
+
+	if subtle.ConstantTimeCompare([]byte(tokens[0]), []byte(a.token)) != 1 {
+		return status.Error(codes.PermissionDenied, "invalid admin token")
+	}
+
+	return nil
+}
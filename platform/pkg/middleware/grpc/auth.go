@@ -28,10 +28,21 @@ type AuthClient = authv1.AuthServiceClient
 
 type AuthInterceptor struct {
 	authClient AuthClient
+	methods    map[string]struct{}
 }
 
-func NewAuthInterceptor(authClient AuthClient) *AuthInterceptor {
-	return &AuthInterceptor{authClient: authClient}
+// NewAuthInterceptor создает интерцептор, проверяющий сессию у перечисленных полных имен методов.
+// Без списка методов сессия проверяется у всех вызовов
+func NewAuthInterceptor(authClient AuthClient, methods ...string) *AuthInterceptor {
+	var set map[string]struct{}
+	if len(methods) > 0 {
+		set = make(map[string]struct{}, len(methods))
+		for _, method := range methods {
+			set[method] = struct{}{}
+		}
+	}
+
+	return &AuthInterceptor{authClient: authClient, methods: set}
 }
 
 func (a *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
@@ -41,6 +52,12 @@ func (a *AuthInterceptor) Unary() grpc.UnaryServerInterceptor {
 		info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler,
 	) (any, error) {
+		if a.methods != nil {
+			if _, ok := a.methods[info.FullMethod]; !ok {
+				return handler(ctx, req)
+			}
+		}
+
 		authCtx, err := a.authenticate(ctx)
 		if err != nil {
 			return nil, err
@@ -2,7 +2,6 @@ package mongo
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/docker/docker/api/types/container"
 	"go.uber.org/zap"
@@ -23,6 +22,7 @@ type Config struct {
 	Username      string
 	Password      string
 	AuthDB        string
+	ReplicaSet    string
 	Logger        Logger
 
 	Host string
@@ -56,6 +56,5 @@ func defaultHostConfig() func(hc *container.HostConfig) {
 
 // URI возвращает строку подключения к MongoDB
 func (c *Config) URI() string {
-	return fmt.Sprintf("mongodb://%s:%s@%s:%s/%s?authSource=%s",
-		c.Username, c.Password, c.Host, c.Port, c.Database, c.AuthDB)
+	return buildMongoURI(c)
 }
@@ -3,6 +3,7 @@ package mongo
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/testcontainers/testcontainers-go"
@@ -25,6 +26,16 @@ func startMongoContainer(ctx context.Context, cfg *Config) (testcontainers.Conta
 		HostConfigModifier: defaultHostConfig(),
 	}
 
+	// С авторизацией участникам replica set нужен общий keyfile
+	if cfg.ReplicaSet != "" {
+		req.Entrypoint = []string{"bash", "-c", fmt.Sprintf(
+			"head -c 756 /dev/urandom | base64 -w0 > /data/keyfile && "+
+				"chmod 400 /data/keyfile && chown mongodb:mongodb /data/keyfile && "+
+				"exec docker-entrypoint.sh mongod --replSet %s --bind_ip_all --keyFile /data/keyfile",
+			cfg.ReplicaSet,
+		)}
+	}
+
 	container, err := testcontainers.GenericContainer(ctx, testcontainers.GenericContainerRequest{
 		ContainerRequest: req,
 		Started:          true,
@@ -50,8 +61,44 @@ func getContainerHostPort(ctx context.Context, container testcontainers.Containe
 	return host, port.Port(), nil
 }
 
+// initiateReplicaSet инициализирует одноузловой replica set. Пока entrypoint создает
+// пользователя, mongod перезапускается, поэтому команда повторяется
+func initiateReplicaSet(ctx context.Context, container testcontainers.Container, cfg *Config) error {
+	script := fmt.Sprintf(
+		`try { rs.status().ok } catch (e) { rs.initiate({ _id: "%s", members: [{ _id: 0, host: "localhost:%s" }] }).ok }`,
+		cfg.ReplicaSet, mongoPort,
+	)
+	cmd := []string{
+		"mongosh", "--quiet",
+		"-u", cfg.Username,
+		"-p", cfg.Password,
+		"--authenticationDatabase", cfg.AuthDB,
+		"--eval", script,
+	}
+
+	maxRetries := 15
+	retryDelay := 2 * time.Second
+
+	var (
+		exitCode int
+		err      error
+	)
+	for attempt := 1; attempt <= maxRetries; attempt++ {
+		exitCode, _, err = container.Exec(ctx, cmd)
+		if err == nil && exitCode == 0 {
+			return nil
+		}
+
+		if attempt < maxRetries {
+			time.Sleep(retryDelay) //nolint:forbidigo // Допустимо для testcontainers - ждем инициализации MongoDB
+		}
+	}
+
+	return errors.Errorf("failed to initiate replica set after %d attempts: exit code %d, %v", maxRetries, exitCode, err)
+}
+
 func buildMongoURI(cfg *Config) string {
-	return fmt.Sprintf(
+	uri := fmt.Sprintf(
 		"mongodb://%s:%s@%s:%s/%s?authSource=%s",
 		cfg.Username,
 		cfg.Password,
@@ -60,4 +107,10 @@ func buildMongoURI(cfg *Config) string {
 		cfg.Database,
 		cfg.AuthDB,
 	)
+	// Участник replica set объявлен как localhost внутри контейнера, поэтому
+	// подключаемся напрямую, без обнаружения топологии
+	if cfg.ReplicaSet != "" {
+		uri += "&directConnection=true"
+	}
+	return uri
 }
@@ -43,6 +43,12 @@ func NewContainer(ctx context.Context, opts ...Option) (*Container, error) {
 		return nil, err
 	}
 
+	if cfg.ReplicaSet != "" {
+		if err = initiateReplicaSet(ctx, container, cfg); err != nil {
+			return nil, err
+		}
+	}
+
 	uri := buildMongoURI(cfg)
 
 	client, err := connectMongoClient(ctx, uri)
@@ -39,6 +39,14 @@ func WithAuthDB(authDB string) Option {
 	}
 }
 
+// WithReplicaSet запускает MongoDB одноузловым replica set с указанным именем.
+// Replica set нужен для транзакций и change stream
+func WithReplicaSet(name string) Option {
+	return func(c *Config) {
+		c.ReplicaSet = name
+	}
+}
+
 func WithLogger(logger Logger) Option {
 	return func(c *Config) {
 		c.Logger = logger
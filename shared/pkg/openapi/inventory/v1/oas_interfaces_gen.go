@@ -0,0 +1,18 @@
+// Code generated by ogen, DO NOT EDIT.
+package inventory_v1
+
+type GetPartRes interface {
+	getPartRes()
+}
+
+type ListCategoriesRes interface {
+	listCategoriesRes()
+}
+
+type ListPartsRes interface {
+	listPartsRes()
+}
+
+type SearchPartsRes interface {
+	searchPartsRes()
+}
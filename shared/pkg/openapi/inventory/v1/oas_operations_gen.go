@@ -0,0 +1,13 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package inventory_v1
+
+// OperationName is the ogen operation name
+type OperationName = string
+
+const (
+	GetPartOperation        OperationName = "GetPart"
+	ListCategoriesOperation OperationName = "ListCategories"
+	ListPartsOperation      OperationName = "ListParts"
+	SearchPartsOperation    OperationName = "SearchParts"
+)
@@ -0,0 +1,1613 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package inventory_v1
+
+import (
+	"time"
+
+	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
+	"github.com/google/uuid"
+)
+
+// Ref: #/components/schemas/bad_request_error
+type BadRequestError struct {
+	// Код ошибки.
+	Error string `json:"error"`
+	// Описание ошибки.
+	Message string `json:"message"`
+}
+
+// GetError returns the value of Error.
+func (s *BadRequestError) GetError() string {
+	return s.Error
+}
+
+// GetMessage returns the value of Message.
+func (s *BadRequestError) GetMessage() string {
+	return s.Message
+}
+
+// SetError sets the value of Error.
+func (s *BadRequestError) SetError(val string) {
+	s.Error = val
+}
+
+// SetMessage sets the value of Message.
+func (s *BadRequestError) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*BadRequestError) listPartsRes()   {}
+func (*BadRequestError) searchPartsRes() {}
+
+// Категория каталога.
+// Ref: #/components/schemas/category
+type Category struct {
+	// Код категории.
+	Code string `json:"code"`
+	// Код родительской категории, нет у корневой.
+	ParentCode OptString `json:"parent_code"`
+	// Отображаемое название.
+	DisplayName string `json:"display_name"`
+	// Описание категории.
+	Description OptString `json:"description"`
+	// Собственные поля схемы метаданных. Поля родительских
+	// категорий наследуются, их схемы — у родителей в том
+	// же списке.
+	MetadataSchema []MetadataField `json:"metadata_schema"`
+}
+
+// GetCode returns the value of Code.
+func (s *Category) GetCode() string {
+	return s.Code
+}
+
+// GetParentCode returns the value of ParentCode.
+func (s *Category) GetParentCode() OptString {
+	return s.ParentCode
+}
+
+// GetDisplayName returns the value of DisplayName.
+func (s *Category) GetDisplayName() string {
+	return s.DisplayName
+}
+
+// GetDescription returns the value of Description.
+func (s *Category) GetDescription() OptString {
+	return s.Description
+}
+
+// GetMetadataSchema returns the value of MetadataSchema.
+func (s *Category) GetMetadataSchema() []MetadataField {
+	return s.MetadataSchema
+}
+
+// SetCode sets the value of Code.
+func (s *Category) SetCode(val string) {
+	s.Code = val
+}
+
+// SetParentCode sets the value of ParentCode.
+func (s *Category) SetParentCode(val OptString) {
+	s.ParentCode = val
+}
+
+// SetDisplayName sets the value of DisplayName.
+func (s *Category) SetDisplayName(val string) {
+	s.DisplayName = val
+}
+
+// SetDescription sets the value of Description.
+func (s *Category) SetDescription(val OptString) {
+	s.Description = val
+}
+
+// SetMetadataSchema sets the value of MetadataSchema.
+func (s *Category) SetMetadataSchema(val []MetadataField) {
+	s.MetadataSchema = val
+}
+
+// Размеры и вес детали.
+// Ref: #/components/schemas/dimensions
+type Dimensions struct {
+	// Длина в сантиметрах.
+	Length float64 `json:"length"`
+	// Ширина в сантиметрах.
+	Width float64 `json:"width"`
+	// Высота в сантиметрах.
+	Height float64 `json:"height"`
+	// Вес в килограммах.
+	Weight float64 `json:"weight"`
+}
+
+// GetLength returns the value of Length.
+func (s *Dimensions) GetLength() float64 {
+	return s.Length
+}
+
+// GetWidth returns the value of Width.
+func (s *Dimensions) GetWidth() float64 {
+	return s.Width
+}
+
+// GetHeight returns the value of Height.
+func (s *Dimensions) GetHeight() float64 {
+	return s.Height
+}
+
+// GetWeight returns the value of Weight.
+func (s *Dimensions) GetWeight() float64 {
+	return s.Weight
+}
+
+// SetLength sets the value of Length.
+func (s *Dimensions) SetLength(val float64) {
+	s.Length = val
+}
+
+// SetWidth sets the value of Width.
+func (s *Dimensions) SetWidth(val float64) {
+	s.Width = val
+}
+
+// SetHeight sets the value of Height.
+func (s *Dimensions) SetHeight(val float64) {
+	s.Height = val
+}
+
+// SetWeight sets the value of Weight.
+func (s *Dimensions) SetWeight(val float64) {
+	s.Weight = val
+}
+
+// GetPartNotModified is response for GetPart operation.
+type GetPartNotModified struct {
+	CacheControl string
+	ETag         string
+}
+
+// GetCacheControl returns the value of CacheControl.
+func (s *GetPartNotModified) GetCacheControl() string {
+	return s.CacheControl
+}
+
+// GetETag returns the value of ETag.
+func (s *GetPartNotModified) GetETag() string {
+	return s.ETag
+}
+
+// SetCacheControl sets the value of CacheControl.
+func (s *GetPartNotModified) SetCacheControl(val string) {
+	s.CacheControl = val
+}
+
+// SetETag sets the value of ETag.
+func (s *GetPartNotModified) SetETag(val string) {
+	s.ETag = val
+}
+
+func (*GetPartNotModified) getPartRes() {}
+
+// Ref: #/components/schemas/get_part_response
+type GetPartResponse struct {
+	Part Part `json:"part"`
+}
+
+// GetPart returns the value of Part.
+func (s *GetPartResponse) GetPart() Part {
+	return s.Part
+}
+
+// SetPart sets the value of Part.
+func (s *GetPartResponse) SetPart(val Part) {
+	s.Part = val
+}
+
+// GetPartResponseHeaders wraps GetPartResponse with response headers.
+type GetPartResponseHeaders struct {
+	CacheControl string
+	ETag         string
+	Response     GetPartResponse
+}
+
+// GetCacheControl returns the value of CacheControl.
+func (s *GetPartResponseHeaders) GetCacheControl() string {
+	return s.CacheControl
+}
+
+// GetETag returns the value of ETag.
+func (s *GetPartResponseHeaders) GetETag() string {
+	return s.ETag
+}
+
+// GetResponse returns the value of Response.
+func (s *GetPartResponseHeaders) GetResponse() GetPartResponse {
+	return s.Response
+}
+
+// SetCacheControl sets the value of CacheControl.
+func (s *GetPartResponseHeaders) SetCacheControl(val string) {
+	s.CacheControl = val
+}
+
+// SetETag sets the value of ETag.
+func (s *GetPartResponseHeaders) SetETag(val string) {
+	s.ETag = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GetPartResponseHeaders) SetResponse(val GetPartResponse) {
+	s.Response = val
+}
+
+func (*GetPartResponseHeaders) getPartRes() {}
+
+// Основное изображение детали.
+// Ref: #/components/schemas/image
+type Image struct {
+	// UUID вложения, содержимое скачивается через DownloadAttachment.
+	UUID uuid.UUID `json:"uuid"`
+	// Имя файла.
+	FileName string `json:"file_name"`
+	// MIME-тип изображения.
+	ContentType string `json:"content_type"`
+}
+
+// GetUUID returns the value of UUID.
+func (s *Image) GetUUID() uuid.UUID {
+	return s.UUID
+}
+
+// GetFileName returns the value of FileName.
+func (s *Image) GetFileName() string {
+	return s.FileName
+}
+
+// GetContentType returns the value of ContentType.
+func (s *Image) GetContentType() string {
+	return s.ContentType
+}
+
+// SetUUID sets the value of UUID.
+func (s *Image) SetUUID(val uuid.UUID) {
+	s.UUID = val
+}
+
+// SetFileName sets the value of FileName.
+func (s *Image) SetFileName(val string) {
+	s.FileName = val
+}
+
+// SetContentType sets the value of ContentType.
+func (s *Image) SetContentType(val string) {
+	s.ContentType = val
+}
+
+// Ref: #/components/schemas/internal_server_error
+type InternalServerError struct {
+	// Код ошибки.
+	Error string `json:"error"`
+	// Описание ошибки.
+	Message string `json:"message"`
+}
+
+// GetError returns the value of Error.
+func (s *InternalServerError) GetError() string {
+	return s.Error
+}
+
+// GetMessage returns the value of Message.
+func (s *InternalServerError) GetMessage() string {
+	return s.Message
+}
+
+// SetError sets the value of Error.
+func (s *InternalServerError) SetError(val string) {
+	s.Error = val
+}
+
+// SetMessage sets the value of Message.
+func (s *InternalServerError) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*InternalServerError) getPartRes()        {}
+func (*InternalServerError) listCategoriesRes() {}
+func (*InternalServerError) listPartsRes()      {}
+func (*InternalServerError) searchPartsRes()    {}
+
+// ListCategoriesNotModified is response for ListCategories operation.
+type ListCategoriesNotModified struct {
+	CacheControl string
+	ETag         string
+}
+
+// GetCacheControl returns the value of CacheControl.
+func (s *ListCategoriesNotModified) GetCacheControl() string {
+	return s.CacheControl
+}
+
+// GetETag returns the value of ETag.
+func (s *ListCategoriesNotModified) GetETag() string {
+	return s.ETag
+}
+
+// SetCacheControl sets the value of CacheControl.
+func (s *ListCategoriesNotModified) SetCacheControl(val string) {
+	s.CacheControl = val
+}
+
+// SetETag sets the value of ETag.
+func (s *ListCategoriesNotModified) SetETag(val string) {
+	s.ETag = val
+}
+
+func (*ListCategoriesNotModified) listCategoriesRes() {}
+
+// Ref: #/components/schemas/list_categories_response
+type ListCategoriesResponse struct {
+	// Категории, родительская раньше подкатегорий.
+	Categories []Category `json:"categories"`
+}
+
+// GetCategories returns the value of Categories.
+func (s *ListCategoriesResponse) GetCategories() []Category {
+	return s.Categories
+}
+
+// SetCategories sets the value of Categories.
+func (s *ListCategoriesResponse) SetCategories(val []Category) {
+	s.Categories = val
+}
+
+// ListCategoriesResponseHeaders wraps ListCategoriesResponse with response headers.
+type ListCategoriesResponseHeaders struct {
+	CacheControl string
+	ETag         string
+	Response     ListCategoriesResponse
+}
+
+// GetCacheControl returns the value of CacheControl.
+func (s *ListCategoriesResponseHeaders) GetCacheControl() string {
+	return s.CacheControl
+}
+
+// GetETag returns the value of ETag.
+func (s *ListCategoriesResponseHeaders) GetETag() string {
+	return s.ETag
+}
+
+// GetResponse returns the value of Response.
+func (s *ListCategoriesResponseHeaders) GetResponse() ListCategoriesResponse {
+	return s.Response
+}
+
+// SetCacheControl sets the value of CacheControl.
+func (s *ListCategoriesResponseHeaders) SetCacheControl(val string) {
+	s.CacheControl = val
+}
+
+// SetETag sets the value of ETag.
+func (s *ListCategoriesResponseHeaders) SetETag(val string) {
+	s.ETag = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ListCategoriesResponseHeaders) SetResponse(val ListCategoriesResponse) {
+	s.Response = val
+}
+
+func (*ListCategoriesResponseHeaders) listCategoriesRes() {}
+
+// ListPartsNotModified is response for ListParts operation.
+type ListPartsNotModified struct {
+	CacheControl string
+	ETag         string
+}
+
+// GetCacheControl returns the value of CacheControl.
+func (s *ListPartsNotModified) GetCacheControl() string {
+	return s.CacheControl
+}
+
+// GetETag returns the value of ETag.
+func (s *ListPartsNotModified) GetETag() string {
+	return s.ETag
+}
+
+// SetCacheControl sets the value of CacheControl.
+func (s *ListPartsNotModified) SetCacheControl(val string) {
+	s.CacheControl = val
+}
+
+// SetETag sets the value of ETag.
+func (s *ListPartsNotModified) SetETag(val string) {
+	s.ETag = val
+}
+
+func (*ListPartsNotModified) listPartsRes() {}
+
+// Ref: #/components/schemas/list_parts_response
+type ListPartsResponse struct {
+	// Детали страницы.
+	Parts []Part `json:"parts"`
+	// Токен следующей страницы. Нет — страниц больше нет.
+	NextPageToken OptString `json:"next_page_token"`
+	// Оценка общего количества деталей под фильтром.
+	TotalSize int64 `json:"total_size"`
+}
+
+// GetParts returns the value of Parts.
+func (s *ListPartsResponse) GetParts() []Part {
+	return s.Parts
+}
+
+// GetNextPageToken returns the value of NextPageToken.
+func (s *ListPartsResponse) GetNextPageToken() OptString {
+	return s.NextPageToken
+}
+
+// GetTotalSize returns the value of TotalSize.
+func (s *ListPartsResponse) GetTotalSize() int64 {
+	return s.TotalSize
+}
+
+// SetParts sets the value of Parts.
+func (s *ListPartsResponse) SetParts(val []Part) {
+	s.Parts = val
+}
+
+// SetNextPageToken sets the value of NextPageToken.
+func (s *ListPartsResponse) SetNextPageToken(val OptString) {
+	s.NextPageToken = val
+}
+
+// SetTotalSize sets the value of TotalSize.
+func (s *ListPartsResponse) SetTotalSize(val int64) {
+	s.TotalSize = val
+}
+
+// ListPartsResponseHeaders wraps ListPartsResponse with response headers.
+type ListPartsResponseHeaders struct {
+	CacheControl string
+	ETag         string
+	Response     ListPartsResponse
+}
+
+// GetCacheControl returns the value of CacheControl.
+func (s *ListPartsResponseHeaders) GetCacheControl() string {
+	return s.CacheControl
+}
+
+// GetETag returns the value of ETag.
+func (s *ListPartsResponseHeaders) GetETag() string {
+	return s.ETag
+}
+
+// GetResponse returns the value of Response.
+func (s *ListPartsResponseHeaders) GetResponse() ListPartsResponse {
+	return s.Response
+}
+
+// SetCacheControl sets the value of CacheControl.
+func (s *ListPartsResponseHeaders) SetCacheControl(val string) {
+	s.CacheControl = val
+}
+
+// SetETag sets the value of ETag.
+func (s *ListPartsResponseHeaders) SetETag(val string) {
+	s.ETag = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ListPartsResponseHeaders) SetResponse(val ListPartsResponse) {
+	s.Response = val
+}
+
+func (*ListPartsResponseHeaders) listPartsRes() {}
+
+// Производитель детали.
+// Ref: #/components/schemas/manufacturer
+type Manufacturer struct {
+	// Название производителя.
+	Name string `json:"name"`
+	// Страна производства.
+	Country string `json:"country"`
+	// Сайт производителя.
+	Website OptString `json:"website"`
+}
+
+// GetName returns the value of Name.
+func (s *Manufacturer) GetName() string {
+	return s.Name
+}
+
+// GetCountry returns the value of Country.
+func (s *Manufacturer) GetCountry() string {
+	return s.Country
+}
+
+// GetWebsite returns the value of Website.
+func (s *Manufacturer) GetWebsite() OptString {
+	return s.Website
+}
+
+// SetName sets the value of Name.
+func (s *Manufacturer) SetName(val string) {
+	s.Name = val
+}
+
+// SetCountry sets the value of Country.
+func (s *Manufacturer) SetCountry(val string) {
+	s.Country = val
+}
+
+// SetWebsite sets the value of Website.
+func (s *Manufacturer) SetWebsite(val OptString) {
+	s.Website = val
+}
+
+// Поле схемы метаданных категории.
+// Ref: #/components/schemas/metadata_field
+type MetadataField struct {
+	// Ключ в metadata детали.
+	Key string `json:"key"`
+	// Отображаемое название поля.
+	DisplayName OptString         `json:"display_name"`
+	Type        MetadataFieldType `json:"type"`
+	// Единица измерения.
+	Unit OptString `json:"unit"`
+	// Поле обязательно для деталей категории.
+	Required bool `json:"required"`
+	// Минимальное значение числового поля.
+	Min OptFloat64 `json:"min"`
+	// Максимальное значение числового поля.
+	Max OptFloat64 `json:"max"`
+}
+
+// GetKey returns the value of Key.
+func (s *MetadataField) GetKey() string {
+	return s.Key
+}
+
+// GetDisplayName returns the value of DisplayName.
+func (s *MetadataField) GetDisplayName() OptString {
+	return s.DisplayName
+}
+
+// GetType returns the value of Type.
+func (s *MetadataField) GetType() MetadataFieldType {
+	return s.Type
+}
+
+// GetUnit returns the value of Unit.
+func (s *MetadataField) GetUnit() OptString {
+	return s.Unit
+}
+
+// GetRequired returns the value of Required.
+func (s *MetadataField) GetRequired() bool {
+	return s.Required
+}
+
+// GetMin returns the value of Min.
+func (s *MetadataField) GetMin() OptFloat64 {
+	return s.Min
+}
+
+// GetMax returns the value of Max.
+func (s *MetadataField) GetMax() OptFloat64 {
+	return s.Max
+}
+
+// SetKey sets the value of Key.
+func (s *MetadataField) SetKey(val string) {
+	s.Key = val
+}
+
+// SetDisplayName sets the value of DisplayName.
+func (s *MetadataField) SetDisplayName(val OptString) {
+	s.DisplayName = val
+}
+
+// SetType sets the value of Type.
+func (s *MetadataField) SetType(val MetadataFieldType) {
+	s.Type = val
+}
+
+// SetUnit sets the value of Unit.
+func (s *MetadataField) SetUnit(val OptString) {
+	s.Unit = val
+}
+
+// SetRequired sets the value of Required.
+func (s *MetadataField) SetRequired(val bool) {
+	s.Required = val
+}
+
+// SetMin sets the value of Min.
+func (s *MetadataField) SetMin(val OptFloat64) {
+	s.Min = val
+}
+
+// SetMax sets the value of Max.
+func (s *MetadataField) SetMax(val OptFloat64) {
+	s.Max = val
+}
+
+// Тип значения поля метаданных.
+// Ref: #/components/schemas/metadata_field_type
+type MetadataFieldType string
+
+const (
+	MetadataFieldTypeSTRING  MetadataFieldType = "STRING"
+	MetadataFieldTypeNUMBER  MetadataFieldType = "NUMBER"
+	MetadataFieldTypeINTEGER MetadataFieldType = "INTEGER"
+	MetadataFieldTypeBOOL    MetadataFieldType = "BOOL"
+)
+
+// AllValues returns all MetadataFieldType values.
+func (MetadataFieldType) AllValues() []MetadataFieldType {
+	return []MetadataFieldType{
+		MetadataFieldTypeSTRING,
+		MetadataFieldTypeNUMBER,
+		MetadataFieldTypeINTEGER,
+		MetadataFieldTypeBOOL,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s MetadataFieldType) MarshalText() ([]byte, error) {
+	switch s {
+	case MetadataFieldTypeSTRING:
+		return []byte(s), nil
+	case MetadataFieldTypeNUMBER:
+		return []byte(s), nil
+	case MetadataFieldTypeINTEGER:
+		return []byte(s), nil
+	case MetadataFieldTypeBOOL:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *MetadataFieldType) UnmarshalText(data []byte) error {
+	switch MetadataFieldType(data) {
+	case MetadataFieldTypeSTRING:
+		*s = MetadataFieldTypeSTRING
+		return nil
+	case MetadataFieldTypeNUMBER:
+		*s = MetadataFieldTypeNUMBER
+		return nil
+	case MetadataFieldTypeINTEGER:
+		*s = MetadataFieldTypeINTEGER
+		return nil
+	case MetadataFieldTypeBOOL:
+		*s = MetadataFieldTypeBOOL
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/not_found_error
+type NotFoundError struct {
+	// Код ошибки.
+	Error string `json:"error"`
+	// Описание ошибки.
+	Message string `json:"message"`
+}
+
+// GetError returns the value of Error.
+func (s *NotFoundError) GetError() string {
+	return s.Error
+}
+
+// GetMessage returns the value of Message.
+func (s *NotFoundError) GetMessage() string {
+	return s.Message
+}
+
+// SetError sets the value of Error.
+func (s *NotFoundError) SetError(val string) {
+	s.Error = val
+}
+
+// SetMessage sets the value of Message.
+func (s *NotFoundError) SetMessage(val string) {
+	s.Message = val
+}
+
+func (*NotFoundError) getPartRes() {}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDimensions returns new OptDimensions with value set to v.
+func NewOptDimensions(v Dimensions) OptDimensions {
+	return OptDimensions{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDimensions is optional Dimensions.
+type OptDimensions struct {
+	Value Dimensions
+	Set   bool
+}
+
+// IsSet returns true if OptDimensions was set.
+func (o OptDimensions) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDimensions) Reset() {
+	var v Dimensions
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDimensions) SetTo(v Dimensions) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDimensions) Get() (v Dimensions, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDimensions) Or(d Dimensions) Dimensions {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptFloat64 returns new OptFloat64 with value set to v.
+func NewOptFloat64(v float64) OptFloat64 {
+	return OptFloat64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptFloat64 is optional float64.
+type OptFloat64 struct {
+	Value float64
+	Set   bool
+}
+
+// IsSet returns true if OptFloat64 was set.
+func (o OptFloat64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptFloat64) Reset() {
+	var v float64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptFloat64) SetTo(v float64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptFloat64) Get() (v float64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptFloat64) Or(d float64) float64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptImage returns new OptImage with value set to v.
+func NewOptImage(v Image) OptImage {
+	return OptImage{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptImage is optional Image.
+type OptImage struct {
+	Value Image
+	Set   bool
+}
+
+// IsSet returns true if OptImage was set.
+func (o OptImage) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptImage) Reset() {
+	var v Image
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptImage) SetTo(v Image) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptImage) Get() (v Image, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptImage) Or(d Image) Image {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt32 returns new OptInt32 with value set to v.
+func NewOptInt32(v int32) OptInt32 {
+	return OptInt32{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt32 is optional int32.
+type OptInt32 struct {
+	Value int32
+	Set   bool
+}
+
+// IsSet returns true if OptInt32 was set.
+func (o OptInt32) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt32) Reset() {
+	var v int32
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt32) SetTo(v int32) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt32) Get() (v int32, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt32) Or(d int32) int32 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptManufacturer returns new OptManufacturer with value set to v.
+func NewOptManufacturer(v Manufacturer) OptManufacturer {
+	return OptManufacturer{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptManufacturer is optional Manufacturer.
+type OptManufacturer struct {
+	Value Manufacturer
+	Set   bool
+}
+
+// IsSet returns true if OptManufacturer was set.
+func (o OptManufacturer) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptManufacturer) Reset() {
+	var v Manufacturer
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptManufacturer) SetTo(v Manufacturer) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptManufacturer) Get() (v Manufacturer, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptManufacturer) Or(d Manufacturer) Manufacturer {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPartMetadata returns new OptPartMetadata with value set to v.
+func NewOptPartMetadata(v PartMetadata) OptPartMetadata {
+	return OptPartMetadata{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPartMetadata is optional PartMetadata.
+type OptPartMetadata struct {
+	Value PartMetadata
+	Set   bool
+}
+
+// IsSet returns true if OptPartMetadata was set.
+func (o OptPartMetadata) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPartMetadata) Reset() {
+	var v PartMetadata
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPartMetadata) SetTo(v PartMetadata) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPartMetadata) Get() (v PartMetadata, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPartMetadata) Or(d PartMetadata) PartMetadata {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPartsSortField returns new OptPartsSortField with value set to v.
+func NewOptPartsSortField(v PartsSortField) OptPartsSortField {
+	return OptPartsSortField{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPartsSortField is optional PartsSortField.
+type OptPartsSortField struct {
+	Value PartsSortField
+	Set   bool
+}
+
+// IsSet returns true if OptPartsSortField was set.
+func (o OptPartsSortField) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPartsSortField) Reset() {
+	var v PartsSortField
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPartsSortField) SetTo(v PartsSortField) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPartsSortField) Get() (v PartsSortField, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPartsSortField) Or(d PartsSortField) PartsSortField {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSearchLanguage returns new OptSearchLanguage with value set to v.
+func NewOptSearchLanguage(v SearchLanguage) OptSearchLanguage {
+	return OptSearchLanguage{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSearchLanguage is optional SearchLanguage.
+type OptSearchLanguage struct {
+	Value SearchLanguage
+	Set   bool
+}
+
+// IsSet returns true if OptSearchLanguage was set.
+func (o OptSearchLanguage) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSearchLanguage) Reset() {
+	var v SearchLanguage
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSearchLanguage) SetTo(v SearchLanguage) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSearchLanguage) Get() (v SearchLanguage, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSearchLanguage) Or(d SearchLanguage) SearchLanguage {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Деталь каталога.
+// Ref: #/components/schemas/part
+type Part struct {
+	// UUID детали.
+	UUID uuid.UUID `json:"uuid"`
+	// Название детали.
+	Name string `json:"name"`
+	// Описание детали.
+	Description string `json:"description"`
+	// Цена за единицу.
+	Price float64 `json:"price"`
+	// Деталь есть на складе.
+	InStock bool `json:"in_stock"`
+	// Код категории.
+	Category     string          `json:"category"`
+	Dimensions   OptDimensions   `json:"dimensions"`
+	Manufacturer OptManufacturer `json:"manufacturer"`
+	// Теги.
+	Tags []string `json:"tags"`
+	// Характеристики детали по схеме ее категории.
+	Metadata     OptPartMetadata `json:"metadata"`
+	PrimaryImage OptImage        `json:"primary_image"`
+	// Дата последнего изменения карточки.
+	UpdatedAt OptDateTime `json:"updated_at"`
+}
+
+// GetUUID returns the value of UUID.
+func (s *Part) GetUUID() uuid.UUID {
+	return s.UUID
+}
+
+// GetName returns the value of Name.
+func (s *Part) GetName() string {
+	return s.Name
+}
+
+// GetDescription returns the value of Description.
+func (s *Part) GetDescription() string {
+	return s.Description
+}
+
+// GetPrice returns the value of Price.
+func (s *Part) GetPrice() float64 {
+	return s.Price
+}
+
+// GetInStock returns the value of InStock.
+func (s *Part) GetInStock() bool {
+	return s.InStock
+}
+
+// GetCategory returns the value of Category.
+func (s *Part) GetCategory() string {
+	return s.Category
+}
+
+// GetDimensions returns the value of Dimensions.
+func (s *Part) GetDimensions() OptDimensions {
+	return s.Dimensions
+}
+
+// GetManufacturer returns the value of Manufacturer.
+func (s *Part) GetManufacturer() OptManufacturer {
+	return s.Manufacturer
+}
+
+// GetTags returns the value of Tags.
+func (s *Part) GetTags() []string {
+	return s.Tags
+}
+
+// GetMetadata returns the value of Metadata.
+func (s *Part) GetMetadata() OptPartMetadata {
+	return s.Metadata
+}
+
+// GetPrimaryImage returns the value of PrimaryImage.
+func (s *Part) GetPrimaryImage() OptImage {
+	return s.PrimaryImage
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Part) GetUpdatedAt() OptDateTime {
+	return s.UpdatedAt
+}
+
+// SetUUID sets the value of UUID.
+func (s *Part) SetUUID(val uuid.UUID) {
+	s.UUID = val
+}
+
+// SetName sets the value of Name.
+func (s *Part) SetName(val string) {
+	s.Name = val
+}
+
+// SetDescription sets the value of Description.
+func (s *Part) SetDescription(val string) {
+	s.Description = val
+}
+
+// SetPrice sets the value of Price.
+func (s *Part) SetPrice(val float64) {
+	s.Price = val
+}
+
+// SetInStock sets the value of InStock.
+func (s *Part) SetInStock(val bool) {
+	s.InStock = val
+}
+
+// SetCategory sets the value of Category.
+func (s *Part) SetCategory(val string) {
+	s.Category = val
+}
+
+// SetDimensions sets the value of Dimensions.
+func (s *Part) SetDimensions(val OptDimensions) {
+	s.Dimensions = val
+}
+
+// SetManufacturer sets the value of Manufacturer.
+func (s *Part) SetManufacturer(val OptManufacturer) {
+	s.Manufacturer = val
+}
+
+// SetTags sets the value of Tags.
+func (s *Part) SetTags(val []string) {
+	s.Tags = val
+}
+
+// SetMetadata sets the value of Metadata.
+func (s *Part) SetMetadata(val OptPartMetadata) {
+	s.Metadata = val
+}
+
+// SetPrimaryImage sets the value of PrimaryImage.
+func (s *Part) SetPrimaryImage(val OptImage) {
+	s.PrimaryImage = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Part) SetUpdatedAt(val OptDateTime) {
+	s.UpdatedAt = val
+}
+
+// Характеристики детали по схеме ее категории.
+type PartMetadata map[string]jx.Raw
+
+func (s *PartMetadata) init() PartMetadata {
+	m := *s
+	if m == nil {
+		m = map[string]jx.Raw{}
+		*s = m
+	}
+	return m
+}
+
+// Поле сортировки каталога:
+// * CREATED_AT - по дате создания
+// * NAME - по названию
+// * PRICE - по цене
+// * STOCK_QUANTITY - по количеству на складе.
+// Ref: #/components/schemas/parts_sort_field
+type PartsSortField string
+
+const (
+	PartsSortFieldCREATEDAT     PartsSortField = "CREATED_AT"
+	PartsSortFieldNAME          PartsSortField = "NAME"
+	PartsSortFieldPRICE         PartsSortField = "PRICE"
+	PartsSortFieldSTOCKQUANTITY PartsSortField = "STOCK_QUANTITY"
+)
+
+// AllValues returns all PartsSortField values.
+func (PartsSortField) AllValues() []PartsSortField {
+	return []PartsSortField{
+		PartsSortFieldCREATEDAT,
+		PartsSortFieldNAME,
+		PartsSortFieldPRICE,
+		PartsSortFieldSTOCKQUANTITY,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PartsSortField) MarshalText() ([]byte, error) {
+	switch s {
+	case PartsSortFieldCREATEDAT:
+		return []byte(s), nil
+	case PartsSortFieldNAME:
+		return []byte(s), nil
+	case PartsSortFieldPRICE:
+		return []byte(s), nil
+	case PartsSortFieldSTOCKQUANTITY:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PartsSortField) UnmarshalText(data []byte) error {
+	switch PartsSortField(data) {
+	case PartsSortFieldCREATEDAT:
+		*s = PartsSortFieldCREATEDAT
+		return nil
+	case PartsSortFieldNAME:
+		*s = PartsSortFieldNAME
+		return nil
+	case PartsSortFieldPRICE:
+		*s = PartsSortFieldPRICE
+		return nil
+	case PartsSortFieldSTOCKQUANTITY:
+		*s = PartsSortFieldSTOCKQUANTITY
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Найденная деталь.
+// Ref: #/components/schemas/search_hit
+type SearchHit struct {
+	Part Part `json:"part"`
+	// Оценка релевантности, больше — точнее.
+	Score float64 `json:"score"`
+}
+
+// GetPart returns the value of Part.
+func (s *SearchHit) GetPart() Part {
+	return s.Part
+}
+
+// GetScore returns the value of Score.
+func (s *SearchHit) GetScore() float64 {
+	return s.Score
+}
+
+// SetPart sets the value of Part.
+func (s *SearchHit) SetPart(val Part) {
+	s.Part = val
+}
+
+// SetScore sets the value of Score.
+func (s *SearchHit) SetScore(val float64) {
+	s.Score = val
+}
+
+// Язык поискового запроса:
+// * AUTO - определить автоматически
+// * RUSSIAN - русский
+// * ENGLISH - английский.
+// Ref: #/components/schemas/search_language
+type SearchLanguage string
+
+const (
+	SearchLanguageAUTO    SearchLanguage = "AUTO"
+	SearchLanguageRUSSIAN SearchLanguage = "RUSSIAN"
+	SearchLanguageENGLISH SearchLanguage = "ENGLISH"
+)
+
+// AllValues returns all SearchLanguage values.
+func (SearchLanguage) AllValues() []SearchLanguage {
+	return []SearchLanguage{
+		SearchLanguageAUTO,
+		SearchLanguageRUSSIAN,
+		SearchLanguageENGLISH,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SearchLanguage) MarshalText() ([]byte, error) {
+	switch s {
+	case SearchLanguageAUTO:
+		return []byte(s), nil
+	case SearchLanguageRUSSIAN:
+		return []byte(s), nil
+	case SearchLanguageENGLISH:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SearchLanguage) UnmarshalText(data []byte) error {
+	switch SearchLanguage(data) {
+	case SearchLanguageAUTO:
+		*s = SearchLanguageAUTO
+		return nil
+	case SearchLanguageRUSSIAN:
+		*s = SearchLanguageRUSSIAN
+		return nil
+	case SearchLanguageENGLISH:
+		*s = SearchLanguageENGLISH
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// SearchPartsNotModified is response for SearchParts operation.
+type SearchPartsNotModified struct {
+	CacheControl string
+	ETag         string
+}
+
+// GetCacheControl returns the value of CacheControl.
+func (s *SearchPartsNotModified) GetCacheControl() string {
+	return s.CacheControl
+}
+
+// GetETag returns the value of ETag.
+func (s *SearchPartsNotModified) GetETag() string {
+	return s.ETag
+}
+
+// SetCacheControl sets the value of CacheControl.
+func (s *SearchPartsNotModified) SetCacheControl(val string) {
+	s.CacheControl = val
+}
+
+// SetETag sets the value of ETag.
+func (s *SearchPartsNotModified) SetETag(val string) {
+	s.ETag = val
+}
+
+func (*SearchPartsNotModified) searchPartsRes() {}
+
+// Ref: #/components/schemas/search_parts_response
+type SearchPartsResponse struct {
+	// Найденные детали по убыванию релевантности.
+	Hits []SearchHit `json:"hits"`
+}
+
+// GetHits returns the value of Hits.
+func (s *SearchPartsResponse) GetHits() []SearchHit {
+	return s.Hits
+}
+
+// SetHits sets the value of Hits.
+func (s *SearchPartsResponse) SetHits(val []SearchHit) {
+	s.Hits = val
+}
+
+// SearchPartsResponseHeaders wraps SearchPartsResponse with response headers.
+type SearchPartsResponseHeaders struct {
+	CacheControl string
+	ETag         string
+	Response     SearchPartsResponse
+}
+
+// GetCacheControl returns the value of CacheControl.
+func (s *SearchPartsResponseHeaders) GetCacheControl() string {
+	return s.CacheControl
+}
+
+// GetETag returns the value of ETag.
+func (s *SearchPartsResponseHeaders) GetETag() string {
+	return s.ETag
+}
+
+// GetResponse returns the value of Response.
+func (s *SearchPartsResponseHeaders) GetResponse() SearchPartsResponse {
+	return s.Response
+}
+
+// SetCacheControl sets the value of CacheControl.
+func (s *SearchPartsResponseHeaders) SetCacheControl(val string) {
+	s.CacheControl = val
+}
+
+// SetETag sets the value of ETag.
+func (s *SearchPartsResponseHeaders) SetETag(val string) {
+	s.ETag = val
+}
+
+// SetResponse sets the value of Response.
+func (s *SearchPartsResponseHeaders) SetResponse(val SearchPartsResponse) {
+	s.Response = val
+}
+
+func (*SearchPartsResponseHeaders) searchPartsRes() {}
@@ -0,0 +1,63 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package inventory_v1
+
+import (
+	"context"
+)
+
+// Handler handles operations described by OpenAPI v3 specification.
+type Handler interface {
+	// GetPart implements GetPart operation.
+	//
+	// Возвращает деталь по UUID. При совпадении If-None-Match с ETag
+	// возвращается 304 без тела.
+	//
+	// GET /api/v1/parts/{part_uuid}
+	GetPart(ctx context.Context, params GetPartParams) (GetPartRes, error)
+	// ListCategories implements ListCategories operation.
+	//
+	// Возвращает категории так, что родительская идет
+	// раньше подкатегорий, вместе со схемами метаданных.
+	//
+	// GET /api/v1/categories
+	ListCategories(ctx context.Context, params ListCategoriesParams) (ListCategoriesRes, error)
+	// ListParts implements ListParts operation.
+	//
+	// Возвращает страницу каталога с фильтрацией и
+	// сортировкой. Фильтр по категории включает
+	// подкатегории.
+	// Ответ кэшируется: при совпадении If-None-Match с ETag
+	// возвращается 304 без тела.
+	//
+	// GET /api/v1/parts
+	ListParts(ctx context.Context, params ListPartsParams) (ListPartsRes, error)
+	// SearchParts implements SearchParts operation.
+	//
+	// Полнотекстовый поиск по названию, описанию, тегам и
+	// производителю. Результаты упорядочены по
+	// релевантности
+	// и комбинируются с фильтрами каталога.
+	//
+	// GET /api/v1/parts/search
+	SearchParts(ctx context.Context, params SearchPartsParams) (SearchPartsRes, error)
+}
+
+// Server implements http server based on OpenAPI v3 specification and
+// calls Handler to handle requests.
+type Server struct {
+	h Handler
+	baseServer
+}
+
+// NewServer creates new Server.
+func NewServer(h Handler, opts ...ServerOption) (*Server, error) {
+	s, err := newServerConfig(opts...).baseServer()
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		h:          h,
+		baseServer: s,
+	}, nil
+}
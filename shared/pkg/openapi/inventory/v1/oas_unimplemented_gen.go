@@ -0,0 +1,59 @@
+// Code generated by ogen, DO NOT EDIT.
+
+package inventory_v1
+
+import (
+	"context"
+
+	ht "github.com/ogen-go/ogen/http"
+)
+
+// UnimplementedHandler is no-op Handler which returns http.ErrNotImplemented.
+type UnimplementedHandler struct{}
+
+var _ Handler = UnimplementedHandler{}
+
+// GetPart implements GetPart operation.
+//
+// Возвращает деталь по UUID. При совпадении If-None-Match с ETag
+// возвращается 304 без тела.
+//
+// GET /api/v1/parts/{part_uuid}
+func (UnimplementedHandler) GetPart(ctx context.Context, params GetPartParams) (r GetPartRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListCategories implements ListCategories operation.
+//
+// Возвращает категории так, что родительская идет
+// раньше подкатегорий, вместе со схемами метаданных.
+//
+// GET /api/v1/categories
+func (UnimplementedHandler) ListCategories(ctx context.Context, params ListCategoriesParams) (r ListCategoriesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListParts implements ListParts operation.
+//
+// Возвращает страницу каталога с фильтрацией и
+// сортировкой. Фильтр по категории включает
+// подкатегории.
+// Ответ кэшируется: при совпадении If-None-Match с ETag
+// возвращается 304 без тела.
+//
+// GET /api/v1/parts
+func (UnimplementedHandler) ListParts(ctx context.Context, params ListPartsParams) (r ListPartsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SearchParts implements SearchParts operation.
+//
+// Полнотекстовый поиск по названию, описанию, тегам и
+// производителю. Результаты упорядочены по
+// релевантности
+// и комбинируются с фильтрами каталога.
+//
+// GET /api/v1/parts/search
+func (UnimplementedHandler) SearchParts(ctx context.Context, params SearchPartsParams) (r SearchPartsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
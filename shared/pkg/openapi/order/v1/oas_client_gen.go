@@ -48,7 +48,8 @@ type Invoker interface {
 	GetOrder(ctx context.Context, params GetOrderParams) (GetOrderRes, error)
 	// PayOrder invokes payOrder operation.
 	//
-	// Проводит оплату ранее созданного заказа.
+	// Инициирует оплату заказа, статус PAID выставляется
+	// после подтверждения платежа.
 	//
 	// POST /api/v1/orders/{order_uuid}/pay
 	PayOrder(ctx context.Context, request *PayOrderRequest, params PayOrderParams) (PayOrderRes, error)
@@ -397,7 +398,8 @@ func (c *Client) sendGetOrder(ctx context.Context, params GetOrderParams) (res G
 
 // PayOrder invokes payOrder operation.
 //
-// Проводит оплату ранее созданного заказа.
+// Инициирует оплату заказа, статус PAID выставляется
+// после подтверждения платежа.
 //
 // POST /api/v1/orders/{order_uuid}/pay
 func (c *Client) PayOrder(ctx context.Context, request *PayOrderRequest, params PayOrderParams) (PayOrderRes, error) {
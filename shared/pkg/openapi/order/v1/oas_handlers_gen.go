@@ -469,7 +469,8 @@ func (s *Server) handleGetOrderRequest(args [1]string, argsEscaped bool, w http.
 
 // handlePayOrderRequest handles payOrder operation.
 //
-// Проводит оплату ранее созданного заказа.
+// Инициирует оплату заказа, статус PAID выставляется
+// после подтверждения платежа.
 //
 // POST /api/v1/orders/{order_uuid}/pay
 func (s *Server) handlePayOrderRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
@@ -254,18 +254,34 @@ func (s *CreateOrderRequest) encodeFields(e *jx.Encoder) {
 		json.EncodeUUID(e, s.UserUUID)
 	}
 	{
-		e.FieldStart("part_uuids")
-		e.ArrStart()
-		for _, elem := range s.PartUuids {
-			json.EncodeUUID(e, elem)
+		if s.PartUuids != nil {
+			e.FieldStart("part_uuids")
+			e.ArrStart()
+			for _, elem := range s.PartUuids {
+				json.EncodeUUID(e, elem)
+			}
+			e.ArrEnd()
+		}
+	}
+	{
+		if s.RocketModelUUID.Set {
+			e.FieldStart("rocket_model_uuid")
+			s.RocketModelUUID.Encode(e)
+		}
+	}
+	{
+		if s.Quantity.Set {
+			e.FieldStart("quantity")
+			s.Quantity.Encode(e)
 		}
-		e.ArrEnd()
 	}
 }
 
-var jsonFieldsNameOfCreateOrderRequest = [2]string{
+var jsonFieldsNameOfCreateOrderRequest = [4]string{
 	0: "user_uuid",
 	1: "part_uuids",
+	2: "rocket_model_uuid",
+	3: "quantity",
 }
 
 // Decode decodes CreateOrderRequest from json.
@@ -290,7 +306,6 @@ func (s *CreateOrderRequest) Decode(d *jx.Decoder) error {
 				return errors.Wrap(err, "decode field \"user_uuid\"")
 			}
 		case "part_uuids":
-			requiredBitSet[0] |= 1 << 1
 			if err := func() error {
 				s.PartUuids = make([]uuid.UUID, 0)
 				if err := d.Arr(func(d *jx.Decoder) error {
@@ -309,6 +324,26 @@ func (s *CreateOrderRequest) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"part_uuids\"")
 			}
+		case "rocket_model_uuid":
+			if err := func() error {
+				s.RocketModelUUID.Reset()
+				if err := s.RocketModelUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rocket_model_uuid\"")
+			}
+		case "quantity":
+			if err := func() error {
+				s.Quantity.Reset()
+				if err := s.Quantity.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"quantity\"")
+			}
 		default:
 			return d.Skip()
 		}
@@ -319,7 +354,7 @@ func (s *CreateOrderRequest) Decode(d *jx.Decoder) error {
 	// Validate required fields.
 	var failures []validate.FieldError
 	for i, mask := range [1]uint8{
-		0b00000011,
+		0b00000001,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -503,6 +538,22 @@ func (s *GetOrderResponse) encodeFields(e *jx.Encoder) {
 		}
 		e.ArrEnd()
 	}
+	{
+		if s.RocketModelUUID.Set {
+			e.FieldStart("rocket_model_uuid")
+			s.RocketModelUUID.Encode(e)
+		}
+	}
+	{
+		if s.LineItems != nil {
+			e.FieldStart("line_items")
+			e.ArrStart()
+			for _, elem := range s.LineItems {
+				elem.Encode(e)
+			}
+			e.ArrEnd()
+		}
+	}
 	{
 		e.FieldStart("total_price")
 		e.Float64(s.TotalPrice)
@@ -525,14 +576,16 @@ func (s *GetOrderResponse) encodeFields(e *jx.Encoder) {
 	}
 }
 
-var jsonFieldsNameOfGetOrderResponse = [7]string{
+var jsonFieldsNameOfGetOrderResponse = [9]string{
 	0: "order_uuid",
 	1: "user_uuid",
 	2: "part_uuids",
-	3: "total_price",
-	4: "status",
-	5: "transaction_uuid",
-	6: "payment_method",
+	3: "rocket_model_uuid",
+	4: "line_items",
+	5: "total_price",
+	6: "status",
+	7: "transaction_uuid",
+	8: "payment_method",
 }
 
 // Decode decodes GetOrderResponse from json.
@@ -540,7 +593,7 @@ func (s *GetOrderResponse) Decode(d *jx.Decoder) error {
 	if s == nil {
 		return errors.New("invalid: unable to decode GetOrderResponse to nil")
 	}
-	var requiredBitSet [1]uint8
+	var requiredBitSet [2]uint8
 	s.setDefaults()
 
 	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
@@ -589,8 +642,35 @@ func (s *GetOrderResponse) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"part_uuids\"")
 			}
+		case "rocket_model_uuid":
+			if err := func() error {
+				s.RocketModelUUID.Reset()
+				if err := s.RocketModelUUID.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"rocket_model_uuid\"")
+			}
+		case "line_items":
+			if err := func() error {
+				s.LineItems = make([]LineItem, 0)
+				if err := d.Arr(func(d *jx.Decoder) error {
+					var elem LineItem
+					if err := elem.Decode(d); err != nil {
+						return err
+					}
+					s.LineItems = append(s.LineItems, elem)
+					return nil
+				}); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"line_items\"")
+			}
 		case "total_price":
-			requiredBitSet[0] |= 1 << 3
+			requiredBitSet[0] |= 1 << 5
 			if err := func() error {
 				v, err := d.Float64()
 				s.TotalPrice = float64(v)
@@ -602,7 +682,7 @@ func (s *GetOrderResponse) Decode(d *jx.Decoder) error {
 				return errors.Wrap(err, "decode field \"total_price\"")
 			}
 		case "status":
-			requiredBitSet[0] |= 1 << 4
+			requiredBitSet[0] |= 1 << 6
 			if err := func() error {
 				if err := s.Status.Decode(d); err != nil {
 					return err
@@ -640,8 +720,9 @@ func (s *GetOrderResponse) Decode(d *jx.Decoder) error {
 	}
 	// Validate required fields.
 	var failures []validate.FieldError
-	for i, mask := range [1]uint8{
-		0b00011111,
+	for i, mask := range [2]uint8{
+		0b01100111,
+		0b00000000,
 	} {
 		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
 			// Mask only required fields and check equality to mask using XOR.
@@ -800,6 +881,136 @@ func (s *InternalServerError) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode implements json.Marshaler.
+func (s *LineItem) Encode(e *jx.Encoder) {
+	e.ObjStart()
+	s.encodeFields(e)
+	e.ObjEnd()
+}
+
+// encodeFields encodes fields.
+func (s *LineItem) encodeFields(e *jx.Encoder) {
+	{
+		e.FieldStart("part_uuid")
+		json.EncodeUUID(e, s.PartUUID)
+	}
+	{
+		e.FieldStart("quantity")
+		e.Int64(s.Quantity)
+	}
+	{
+		e.FieldStart("unit_price")
+		e.Float64(s.UnitPrice)
+	}
+}
+
+var jsonFieldsNameOfLineItem = [3]string{
+	0: "part_uuid",
+	1: "quantity",
+	2: "unit_price",
+}
+
+// Decode decodes LineItem from json.
+func (s *LineItem) Decode(d *jx.Decoder) error {
+	if s == nil {
+		return errors.New("invalid: unable to decode LineItem to nil")
+	}
+	var requiredBitSet [1]uint8
+
+	if err := d.ObjBytes(func(d *jx.Decoder, k []byte) error {
+		switch string(k) {
+		case "part_uuid":
+			requiredBitSet[0] |= 1 << 0
+			if err := func() error {
+				v, err := json.DecodeUUID(d)
+				s.PartUUID = v
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"part_uuid\"")
+			}
+		case "quantity":
+			requiredBitSet[0] |= 1 << 1
+			if err := func() error {
+				v, err := d.Int64()
+				s.Quantity = int64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"quantity\"")
+			}
+		case "unit_price":
+			requiredBitSet[0] |= 1 << 2
+			if err := func() error {
+				v, err := d.Float64()
+				s.UnitPrice = float64(v)
+				if err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"unit_price\"")
+			}
+		default:
+			return d.Skip()
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "decode LineItem")
+	}
+	// Validate required fields.
+	var failures []validate.FieldError
+	for i, mask := range [1]uint8{
+		0b00000111,
+	} {
+		if result := (requiredBitSet[i] & mask) ^ mask; result != 0 {
+			// Mask only required fields and check equality to mask using XOR.
+			//
+			// If XOR result is not zero, result is not equal to expected, so some fields are missed.
+			// Bits of fields which would be set are actually bits of missed fields.
+			missed := bits.OnesCount8(result)
+			for bitN := 0; bitN < missed; bitN++ {
+				bitIdx := bits.TrailingZeros8(result)
+				fieldIdx := i*8 + bitIdx
+				var name string
+				if fieldIdx < len(jsonFieldsNameOfLineItem) {
+					name = jsonFieldsNameOfLineItem[fieldIdx]
+				} else {
+					name = strconv.Itoa(fieldIdx)
+				}
+				failures = append(failures, validate.FieldError{
+					Name:  name,
+					Error: validate.ErrFieldRequired,
+				})
+				// Reset bit.
+				result &^= 1 << bitIdx
+			}
+		}
+	}
+	if len(failures) > 0 {
+		return &validate.Error{Fields: failures}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s *LineItem) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *LineItem) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode implements json.Marshaler.
 func (s *NotFoundError) Encode(e *jx.Encoder) {
 	e.ObjStart()
@@ -913,6 +1124,76 @@ func (s *NotFoundError) UnmarshalJSON(data []byte) error {
 	return s.Decode(d)
 }
 
+// Encode encodes int32 as json.
+func (o OptInt32) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Int32(int32(o.Value))
+}
+
+// Decode decodes int32 from json.
+func (o *OptInt32) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptInt32 to nil")
+	}
+	o.Set = true
+	v, err := d.Int32()
+	if err != nil {
+		return err
+	}
+	o.Value = int32(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptInt32) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptInt32) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
+// Encode encodes int64 as json.
+func (o OptInt64) Encode(e *jx.Encoder) {
+	if !o.Set {
+		return
+	}
+	e.Int64(int64(o.Value))
+}
+
+// Decode decodes int64 from json.
+func (o *OptInt64) Decode(d *jx.Decoder) error {
+	if o == nil {
+		return errors.New("invalid: unable to decode OptInt64 to nil")
+	}
+	o.Set = true
+	v, err := d.Int64()
+	if err != nil {
+		return err
+	}
+	o.Value = int64(v)
+	return nil
+}
+
+// MarshalJSON implements stdjson.Marshaler.
+func (s OptInt64) MarshalJSON() ([]byte, error) {
+	e := jx.Encoder{}
+	s.Encode(&e)
+	return e.Bytes(), nil
+}
+
+// UnmarshalJSON implements stdjson.Unmarshaler.
+func (s *OptInt64) UnmarshalJSON(data []byte) error {
+	d := jx.DecodeBytes(data)
+	return s.Decode(d)
+}
+
 // Encode encodes PaymentMethod as json.
 func (o OptPaymentMethod) Encode(e *jx.Encoder) {
 	if !o.Set {
@@ -1038,10 +1319,17 @@ func (s *PayOrderRequest) encodeFields(e *jx.Encoder) {
 		e.FieldStart("payment_method")
 		s.PaymentMethod.Encode(e)
 	}
+	{
+		if s.Installments.Set {
+			e.FieldStart("installments")
+			s.Installments.Encode(e)
+		}
+	}
 }
 
-var jsonFieldsNameOfPayOrderRequest = [1]string{
+var jsonFieldsNameOfPayOrderRequest = [2]string{
 	0: "payment_method",
+	1: "installments",
 }
 
 // Decode decodes PayOrderRequest from json.
@@ -1064,6 +1352,16 @@ func (s *PayOrderRequest) Decode(d *jx.Decoder) error {
 			}(); err != nil {
 				return errors.Wrap(err, "decode field \"payment_method\"")
 			}
+		case "installments":
+			if err := func() error {
+				s.Installments.Reset()
+				if err := s.Installments.Decode(d); err != nil {
+					return err
+				}
+				return nil
+			}(); err != nil {
+				return errors.Wrap(err, "decode field \"installments\"")
+			}
 		default:
 			return d.Skip()
 		}
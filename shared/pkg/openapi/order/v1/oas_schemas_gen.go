@@ -75,14 +75,20 @@ func (*ConflictError) cancelOrderRes() {}
 func (*ConflictError) createOrderRes() {}
 func (*ConflictError) payOrderRes()    {}
 
-// Запрос на создание нового заказа.
+// Запрос на создание нового заказа. Нужно указать part_uuids
+// или rocket_model_uuid.
 // Ref: #/components/schemas/create_order_request
 type CreateOrderRequest struct {
 	// UUID пользователя, создающего заказ.
 	UserUUID uuid.UUID `json:"user_uuid"`
 	// Список UUID деталей, которые пользователь хочет
-	// заказать.
+	// заказать. Деталь можно указать несколько раз.
 	PartUuids []uuid.UUID `json:"part_uuids"`
+	// UUID модели ракеты. Модель раскладывается на детали по
+	// ее спецификации; указывается вместо part_uuids.
+	RocketModelUUID OptUUID `json:"rocket_model_uuid"`
+	// Количество ракет модели. По умолчанию одна.
+	Quantity OptInt64 `json:"quantity"`
 }
 
 // GetUserUUID returns the value of UserUUID.
@@ -95,6 +101,16 @@ func (s *CreateOrderRequest) GetPartUuids() []uuid.UUID {
 	return s.PartUuids
 }
 
+// GetRocketModelUUID returns the value of RocketModelUUID.
+func (s *CreateOrderRequest) GetRocketModelUUID() OptUUID {
+	return s.RocketModelUUID
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *CreateOrderRequest) GetQuantity() OptInt64 {
+	return s.Quantity
+}
+
 // SetUserUUID sets the value of UserUUID.
 func (s *CreateOrderRequest) SetUserUUID(val uuid.UUID) {
 	s.UserUUID = val
@@ -105,6 +121,16 @@ func (s *CreateOrderRequest) SetPartUuids(val []uuid.UUID) {
 	s.PartUuids = val
 }
 
+// SetRocketModelUUID sets the value of RocketModelUUID.
+func (s *CreateOrderRequest) SetRocketModelUUID(val OptUUID) {
+	s.RocketModelUUID = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *CreateOrderRequest) SetQuantity(val OptInt64) {
+	s.Quantity = val
+}
+
 // Ответ на созданный заказ.
 // Ref: #/components/schemas/create_order_response
 type CreateOrderResponse struct {
@@ -144,6 +170,10 @@ type GetOrderResponse struct {
 	UserUUID uuid.UUID `json:"user_uuid"`
 	// Список UUID деталей.
 	PartUuids []uuid.UUID `json:"part_uuids"`
+	// UUID модели ракеты, если заказ создан по модели.
+	RocketModelUUID OptUUID `json:"rocket_model_uuid"`
+	// Строки заказа с количеством и ценой каждой детали.
+	LineItems []LineItem `json:"line_items"`
 	// Общая стоимость заказа.
 	TotalPrice float64     `json:"total_price"`
 	Status     OrderStatus `json:"status"`
@@ -167,6 +197,16 @@ func (s *GetOrderResponse) GetPartUuids() []uuid.UUID {
 	return s.PartUuids
 }
 
+// GetRocketModelUUID returns the value of RocketModelUUID.
+func (s *GetOrderResponse) GetRocketModelUUID() OptUUID {
+	return s.RocketModelUUID
+}
+
+// GetLineItems returns the value of LineItems.
+func (s *GetOrderResponse) GetLineItems() []LineItem {
+	return s.LineItems
+}
+
 // GetTotalPrice returns the value of TotalPrice.
 func (s *GetOrderResponse) GetTotalPrice() float64 {
 	return s.TotalPrice
@@ -202,6 +242,16 @@ func (s *GetOrderResponse) SetPartUuids(val []uuid.UUID) {
 	s.PartUuids = val
 }
 
+// SetRocketModelUUID sets the value of RocketModelUUID.
+func (s *GetOrderResponse) SetRocketModelUUID(val OptUUID) {
+	s.RocketModelUUID = val
+}
+
+// SetLineItems sets the value of LineItems.
+func (s *GetOrderResponse) SetLineItems(val []LineItem) {
+	s.LineItems = val
+}
+
 // SetTotalPrice sets the value of TotalPrice.
 func (s *GetOrderResponse) SetTotalPrice(val float64) {
 	s.TotalPrice = val
@@ -257,6 +307,47 @@ func (*InternalServerError) createOrderRes() {}
 func (*InternalServerError) getOrderRes()    {}
 func (*InternalServerError) payOrderRes()    {}
 
+// Строка заказа.
+// Ref: #/components/schemas/line_item
+type LineItem struct {
+	// UUID детали.
+	PartUUID uuid.UUID `json:"part_uuid"`
+	// Количество деталей.
+	Quantity int64 `json:"quantity"`
+	// Цена за единицу на момент создания заказа.
+	UnitPrice float64 `json:"unit_price"`
+}
+
+// GetPartUUID returns the value of PartUUID.
+func (s *LineItem) GetPartUUID() uuid.UUID {
+	return s.PartUUID
+}
+
+// GetQuantity returns the value of Quantity.
+func (s *LineItem) GetQuantity() int64 {
+	return s.Quantity
+}
+
+// GetUnitPrice returns the value of UnitPrice.
+func (s *LineItem) GetUnitPrice() float64 {
+	return s.UnitPrice
+}
+
+// SetPartUUID sets the value of PartUUID.
+func (s *LineItem) SetPartUUID(val uuid.UUID) {
+	s.PartUUID = val
+}
+
+// SetQuantity sets the value of Quantity.
+func (s *LineItem) SetQuantity(val int64) {
+	s.Quantity = val
+}
+
+// SetUnitPrice sets the value of UnitPrice.
+func (s *LineItem) SetUnitPrice(val float64) {
+	s.UnitPrice = val
+}
+
 // Ref: #/components/schemas/not_found_error
 type NotFoundError struct {
 	// Код ошибки.
@@ -290,6 +381,98 @@ func (*NotFoundError) createOrderRes() {}
 func (*NotFoundError) getOrderRes()    {}
 func (*NotFoundError) payOrderRes()    {}
 
+// NewOptInt32 returns new OptInt32 with value set to v.
+func NewOptInt32(v int32) OptInt32 {
+	return OptInt32{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt32 is optional int32.
+type OptInt32 struct {
+	Value int32
+	Set   bool
+}
+
+// IsSet returns true if OptInt32 was set.
+func (o OptInt32) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt32) Reset() {
+	var v int32
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt32) SetTo(v int32) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt32) Get() (v int32, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt32) Or(d int32) int32 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt64 returns new OptInt64 with value set to v.
+func NewOptInt64(v int64) OptInt64 {
+	return OptInt64{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt64 is optional int64.
+type OptInt64 struct {
+	Value int64
+	Set   bool
+}
+
+// IsSet returns true if OptInt64 was set.
+func (o OptInt64) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt64) Reset() {
+	var v int64
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt64) SetTo(v int64) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt64) Get() (v int64, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // NewOptPaymentMethod returns new OptPaymentMethod with value set to v.
 func NewOptPaymentMethod(v PaymentMethod) OptPaymentMethod {
 	return OptPaymentMethod{
@@ -447,6 +630,9 @@ func (s *OrderStatus) UnmarshalText(data []byte) error {
 // Ref: #/components/schemas/pay_order_request
 type PayOrderRequest struct {
 	PaymentMethod PaymentMethod `json:"payment_method"`
+	// Количество ежемесячных платежей рассрочки (только
+	// для CREDIT_CARD, 0 или 1 - без рассрочки).
+	Installments OptInt32 `json:"installments"`
 }
 
 // GetPaymentMethod returns the value of PaymentMethod.
@@ -454,12 +640,23 @@ func (s *PayOrderRequest) GetPaymentMethod() PaymentMethod {
 	return s.PaymentMethod
 }
 
+// GetInstallments returns the value of Installments.
+func (s *PayOrderRequest) GetInstallments() OptInt32 {
+	return s.Installments
+}
+
 // SetPaymentMethod sets the value of PaymentMethod.
 func (s *PayOrderRequest) SetPaymentMethod(val PaymentMethod) {
 	s.PaymentMethod = val
 }
 
-// Ответ на оплаченный заказ.
+// SetInstallments sets the value of Installments.
+func (s *PayOrderRequest) SetInstallments(val OptInt32) {
+	s.Installments = val
+}
+
+// Ответ на оплату заказа (платеж может ожидать
+// подтверждения).
 // Ref: #/components/schemas/pay_order_response
 type PayOrderResponse struct {
 	// UUID транзакции.
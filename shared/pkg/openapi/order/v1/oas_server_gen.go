@@ -28,7 +28,8 @@ type Handler interface {
 	GetOrder(ctx context.Context, params GetOrderParams) (GetOrderRes, error)
 	// PayOrder implements payOrder operation.
 	//
-	// Проводит оплату ранее созданного заказа.
+	// Инициирует оплату заказа, статус PAID выставляется
+	// после подтверждения платежа.
 	//
 	// POST /api/v1/orders/{order_uuid}/pay
 	PayOrder(ctx context.Context, req *PayOrderRequest, params PayOrderParams) (PayOrderRes, error)
@@ -42,7 +42,8 @@ func (UnimplementedHandler) GetOrder(ctx context.Context, params GetOrderParams)
 
 // PayOrder implements payOrder operation.
 //
-// Проводит оплату ранее созданного заказа.
+// Инициирует оплату заказа, статус PAID выставляется
+// после подтверждения платежа.
 //
 // POST /api/v1/orders/{order_uuid}/pay
 func (UnimplementedHandler) PayOrder(ctx context.Context, req *PayOrderRequest, params PayOrderParams) (r PayOrderRes, _ error) {
@@ -0,0 +1,365 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: events/v1/installment.proto
+
+package eventsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Исходящее(из payment сервиса) событие о пропущенном платеже по рассрочке в Kafka
+type InstallmentMissed struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// uuid события (для идемпотентности)
+	EventUuid string `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`
+	// uuid плана рассрочки
+	PlanUuid string `protobuf:"bytes,2,opt,name=plan_uuid,json=planUuid,proto3" json:"plan_uuid,omitempty"`
+	// uuid платежа по графику
+	InstallmentUuid string `protobuf:"bytes,3,opt,name=installment_uuid,json=installmentUuid,proto3" json:"installment_uuid,omitempty"`
+	// uuid транзакции, оформленной в рассрочку
+	TransactionUuid string `protobuf:"bytes,4,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	// uuid заказа
+	OrderUuid string `protobuf:"bytes,5,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	// uuid пользователя
+	UserUuid string `protobuf:"bytes,6,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	// порядковый номер платежа
+	Number int32 `protobuf:"varint,7,opt,name=number,proto3" json:"number,omitempty"`
+	// сумма платежа
+	Amount float64 `protobuf:"fixed64,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	// дата, когда платеж должен был быть списан
+	DueDate *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	// причина отказа
+	Reason        string `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstallmentMissed) Reset() {
+	*x = InstallmentMissed{}
+	mi := &file_events_v1_installment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallmentMissed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallmentMissed) ProtoMessage() {}
+
+func (x *InstallmentMissed) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_installment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallmentMissed.ProtoReflect.Descriptor instead.
+func (*InstallmentMissed) Descriptor() ([]byte, []int) {
+	return file_events_v1_installment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InstallmentMissed) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *InstallmentMissed) GetPlanUuid() string {
+	if x != nil {
+		return x.PlanUuid
+	}
+	return ""
+}
+
+func (x *InstallmentMissed) GetInstallmentUuid() string {
+	if x != nil {
+		return x.InstallmentUuid
+	}
+	return ""
+}
+
+func (x *InstallmentMissed) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *InstallmentMissed) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *InstallmentMissed) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *InstallmentMissed) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *InstallmentMissed) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *InstallmentMissed) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *InstallmentMissed) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Исходящее(из payment сервиса) событие о прекращении плана рассрочки в Kafka:
+// попытки списания платежа исчерпаны (INSTALLMENT_MAX_ATTEMPTS)
+type InstallmentPlanDefaulted struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// uuid события (для идемпотентности)
+	EventUuid string `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`
+	// uuid плана рассрочки
+	PlanUuid string `protobuf:"bytes,2,opt,name=plan_uuid,json=planUuid,proto3" json:"plan_uuid,omitempty"`
+	// uuid платежа, который не удалось списать
+	InstallmentUuid string `protobuf:"bytes,3,opt,name=installment_uuid,json=installmentUuid,proto3" json:"installment_uuid,omitempty"`
+	// uuid транзакции, оформленной в рассрочку
+	TransactionUuid string `protobuf:"bytes,4,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	// uuid заказа
+	OrderUuid string `protobuf:"bytes,5,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	// uuid пользователя
+	UserUuid string `protobuf:"bytes,6,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	// порядковый номер платежа
+	Number int32 `protobuf:"varint,7,opt,name=number,proto3" json:"number,omitempty"`
+	// сумма платежа
+	Amount float64 `protobuf:"fixed64,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	// число попыток списания
+	Attempts int32 `protobuf:"varint,9,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// причина последнего отказа
+	Reason        string `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstallmentPlanDefaulted) Reset() {
+	*x = InstallmentPlanDefaulted{}
+	mi := &file_events_v1_installment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallmentPlanDefaulted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallmentPlanDefaulted) ProtoMessage() {}
+
+func (x *InstallmentPlanDefaulted) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_installment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallmentPlanDefaulted.ProtoReflect.Descriptor instead.
+func (*InstallmentPlanDefaulted) Descriptor() ([]byte, []int) {
+	return file_events_v1_installment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *InstallmentPlanDefaulted) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *InstallmentPlanDefaulted) GetPlanUuid() string {
+	if x != nil {
+		return x.PlanUuid
+	}
+	return ""
+}
+
+func (x *InstallmentPlanDefaulted) GetInstallmentUuid() string {
+	if x != nil {
+		return x.InstallmentUuid
+	}
+	return ""
+}
+
+func (x *InstallmentPlanDefaulted) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *InstallmentPlanDefaulted) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *InstallmentPlanDefaulted) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *InstallmentPlanDefaulted) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *InstallmentPlanDefaulted) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *InstallmentPlanDefaulted) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *InstallmentPlanDefaulted) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+var File_events_v1_installment_proto protoreflect.FileDescriptor
+
+const file_events_v1_installment_proto_rawDesc = "" +
+	"\n" +
+	"\x1bevents/v1/installment.proto\x12\tevents.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe0\x02\n" +
+	"\x11InstallmentMissed\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1b\n" +
+	"\tplan_uuid\x18\x02 \x01(\tR\bplanUuid\x12)\n" +
+	"\x10installment_uuid\x18\x03 \x01(\tR\x0finstallmentUuid\x12)\n" +
+	"\x10transaction_uuid\x18\x04 \x01(\tR\x0ftransactionUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x05 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x06 \x01(\tR\buserUuid\x12\x16\n" +
+	"\x06number\x18\a \x01(\x05R\x06number\x12\x16\n" +
+	"\x06amount\x18\b \x01(\x01R\x06amount\x125\n" +
+	"\bdue_date\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x12\x16\n" +
+	"\x06reason\x18\n" +
+	" \x01(\tR\x06reason\"\xcc\x02\n" +
+	"\x18InstallmentPlanDefaulted\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1b\n" +
+	"\tplan_uuid\x18\x02 \x01(\tR\bplanUuid\x12)\n" +
+	"\x10installment_uuid\x18\x03 \x01(\tR\x0finstallmentUuid\x12)\n" +
+	"\x10transaction_uuid\x18\x04 \x01(\tR\x0ftransactionUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x05 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x06 \x01(\tR\buserUuid\x12\x16\n" +
+	"\x06number\x18\a \x01(\x05R\x06number\x12\x16\n" +
+	"\x06amount\x18\b \x01(\x01R\x06amount\x12\x1a\n" +
+	"\battempts\x18\t \x01(\x05R\battempts\x12\x16\n" +
+	"\x06reason\x18\n" +
+	" \x01(\tR\x06reasonB\xb4\x01\n" +
+	"\rcom.events.v1B\x10InstallmentProtoP\x01ZLgithub.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/events/v1;eventsv1\xa2\x02\x03EXX\xaa\x02\tEvents.V1\xca\x02\tEvents\\V1\xe2\x02\x15Events\\V1\\GPBMetadata\xea\x02\n" +
+	"Events::V1b\x06proto3"
+
+var (
+	file_events_v1_installment_proto_rawDescOnce sync.Once
+	file_events_v1_installment_proto_rawDescData []byte
+)
+
+func file_events_v1_installment_proto_rawDescGZIP() []byte {
+	file_events_v1_installment_proto_rawDescOnce.Do(func() {
+		file_events_v1_installment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_v1_installment_proto_rawDesc), len(file_events_v1_installment_proto_rawDesc)))
+	})
+	return file_events_v1_installment_proto_rawDescData
+}
+
+var file_events_v1_installment_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_events_v1_installment_proto_goTypes = []any{
+	(*InstallmentMissed)(nil),        // 0: events.v1.InstallmentMissed
+	(*InstallmentPlanDefaulted)(nil), // 1: events.v1.InstallmentPlanDefaulted
+	(*timestamppb.Timestamp)(nil),    // 2: google.protobuf.Timestamp
+}
+var file_events_v1_installment_proto_depIdxs = []int32{
+	2, // 0: events.v1.InstallmentMissed.due_date:type_name -> google.protobuf.Timestamp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_events_v1_installment_proto_init() }
+func file_events_v1_installment_proto_init() {
+	if File_events_v1_installment_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_v1_installment_proto_rawDesc), len(file_events_v1_installment_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_v1_installment_proto_goTypes,
+		DependencyIndexes: file_events_v1_installment_proto_depIdxs,
+		MessageInfos:      file_events_v1_installment_proto_msgTypes,
+	}.Build()
+	File_events_v1_installment_proto = out.File
+	file_events_v1_installment_proto_goTypes = nil
+	file_events_v1_installment_proto_depIdxs = nil
+}
@@ -0,0 +1,748 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: events/v1/inventory.proto
+
+package eventsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Исходящее(из inventory сервиса) и входящее(в notification сервис) событие
+// об остатке детали ниже порога дозаказа
+type PartStockLow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// uuid события (для идемпотентности)
+	EventUuid string `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`
+	// uuid детали
+	PartUuid string `protobuf:"bytes,2,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// название детали
+	PartName string `protobuf:"bytes,3,opt,name=part_name,json=partName,proto3" json:"part_name,omitempty"`
+	// текущий остаток
+	StockQuantity int64 `protobuf:"varint,4,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
+	// порог дозаказа
+	ReorderThreshold int64 `protobuf:"varint,5,opt,name=reorder_threshold,json=reorderThreshold,proto3" json:"reorder_threshold,omitempty"`
+	// время события
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartStockLow) Reset() {
+	*x = PartStockLow{}
+	mi := &file_events_v1_inventory_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartStockLow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartStockLow) ProtoMessage() {}
+
+func (x *PartStockLow) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_inventory_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartStockLow.ProtoReflect.Descriptor instead.
+func (*PartStockLow) Descriptor() ([]byte, []int) {
+	return file_events_v1_inventory_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PartStockLow) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *PartStockLow) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *PartStockLow) GetPartName() string {
+	if x != nil {
+		return x.PartName
+	}
+	return ""
+}
+
+func (x *PartStockLow) GetStockQuantity() int64 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+func (x *PartStockLow) GetReorderThreshold() int64 {
+	if x != nil {
+		return x.ReorderThreshold
+	}
+	return 0
+}
+
+func (x *PartStockLow) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// Исходящее(из inventory сервиса) и входящее(в notification сервис) событие
+// о восстановлении остатка детали до порога дозаказа
+type PartRestocked struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// uuid события (для идемпотентности)
+	EventUuid string `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`
+	// uuid детали
+	PartUuid string `protobuf:"bytes,2,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// название детали
+	PartName string `protobuf:"bytes,3,opt,name=part_name,json=partName,proto3" json:"part_name,omitempty"`
+	// текущий остаток
+	StockQuantity int64 `protobuf:"varint,4,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
+	// порог дозаказа
+	ReorderThreshold int64 `protobuf:"varint,5,opt,name=reorder_threshold,json=reorderThreshold,proto3" json:"reorder_threshold,omitempty"`
+	// время события
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartRestocked) Reset() {
+	*x = PartRestocked{}
+	mi := &file_events_v1_inventory_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartRestocked) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartRestocked) ProtoMessage() {}
+
+func (x *PartRestocked) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_inventory_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartRestocked.ProtoReflect.Descriptor instead.
+func (*PartRestocked) Descriptor() ([]byte, []int) {
+	return file_events_v1_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PartRestocked) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *PartRestocked) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *PartRestocked) GetPartName() string {
+	if x != nil {
+		return x.PartName
+	}
+	return ""
+}
+
+func (x *PartRestocked) GetStockQuantity() int64 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+func (x *PartRestocked) GetReorderThreshold() int64 {
+	if x != nil {
+		return x.ReorderThreshold
+	}
+	return 0
+}
+
+func (x *PartRestocked) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// Состояние детали в событиях об изменении каталога
+type PartSnapshot struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// uuid детали
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// название детали
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// описание детали
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// цена за единицу
+	Price float64 `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	// остаток на всех складах
+	StockQuantity int64 `protobuf:"varint,5,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
+	// категория детали: ENGINE, FUEL, PORTHOLE, WING
+	Category string `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`
+	// теги детали
+	Tags []string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	// время последнего изменения детали
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartSnapshot) Reset() {
+	*x = PartSnapshot{}
+	mi := &file_events_v1_inventory_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartSnapshot) ProtoMessage() {}
+
+func (x *PartSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_inventory_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartSnapshot.ProtoReflect.Descriptor instead.
+func (*PartSnapshot) Descriptor() ([]byte, []int) {
+	return file_events_v1_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PartSnapshot) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *PartSnapshot) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PartSnapshot) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PartSnapshot) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PartSnapshot) GetStockQuantity() int64 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+func (x *PartSnapshot) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *PartSnapshot) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PartSnapshot) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Исходящее(из inventory сервиса) событие о создании детали
+type PartCreated struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// uuid события (для идемпотентности)
+	EventUuid string `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`
+	// созданная деталь
+	Part *PartSnapshot `protobuf:"bytes,2,opt,name=part,proto3" json:"part,omitempty"`
+	// время изменения в MongoDB
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartCreated) Reset() {
+	*x = PartCreated{}
+	mi := &file_events_v1_inventory_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartCreated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartCreated) ProtoMessage() {}
+
+func (x *PartCreated) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_inventory_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartCreated.ProtoReflect.Descriptor instead.
+func (*PartCreated) Descriptor() ([]byte, []int) {
+	return file_events_v1_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PartCreated) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *PartCreated) GetPart() *PartSnapshot {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+func (x *PartCreated) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// Исходящее(из inventory сервиса) событие об изменении детали
+type PartUpdated struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// uuid события (для идемпотентности)
+	EventUuid string `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`
+	// деталь после изменения
+	Part *PartSnapshot `protobuf:"bytes,2,opt,name=part,proto3" json:"part,omitempty"`
+	// измененные и удаленные поля документа, например stock_quantity
+	UpdatedFields []string `protobuf:"bytes,3,rep,name=updated_fields,json=updatedFields,proto3" json:"updated_fields,omitempty"`
+	// время изменения в MongoDB
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartUpdated) Reset() {
+	*x = PartUpdated{}
+	mi := &file_events_v1_inventory_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartUpdated) ProtoMessage() {}
+
+func (x *PartUpdated) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_inventory_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartUpdated.ProtoReflect.Descriptor instead.
+func (*PartUpdated) Descriptor() ([]byte, []int) {
+	return file_events_v1_inventory_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PartUpdated) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *PartUpdated) GetPart() *PartSnapshot {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+func (x *PartUpdated) GetUpdatedFields() []string {
+	if x != nil {
+		return x.UpdatedFields
+	}
+	return nil
+}
+
+func (x *PartUpdated) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// Исходящее(из inventory сервиса) событие об удалении детали
+type PartDeleted struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// uuid события (для идемпотентности)
+	EventUuid string `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`
+	// uuid детали
+	PartUuid string `protobuf:"bytes,2,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// время изменения в MongoDB
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartDeleted) Reset() {
+	*x = PartDeleted{}
+	mi := &file_events_v1_inventory_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartDeleted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartDeleted) ProtoMessage() {}
+
+func (x *PartDeleted) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_inventory_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartDeleted.ProtoReflect.Descriptor instead.
+func (*PartDeleted) Descriptor() ([]byte, []int) {
+	return file_events_v1_inventory_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *PartDeleted) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *PartDeleted) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *PartDeleted) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// Событие об изменении детали. События одной детали публикуются в один топик с ключом uuid
+// детали, поэтому потребитель получает их в порядке изменений
+type PartChangeEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*PartChangeEvent_Created
+	//	*PartChangeEvent_Updated
+	//	*PartChangeEvent_Deleted
+	Event         isPartChangeEvent_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartChangeEvent) Reset() {
+	*x = PartChangeEvent{}
+	mi := &file_events_v1_inventory_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartChangeEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartChangeEvent) ProtoMessage() {}
+
+func (x *PartChangeEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_inventory_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartChangeEvent.ProtoReflect.Descriptor instead.
+func (*PartChangeEvent) Descriptor() ([]byte, []int) {
+	return file_events_v1_inventory_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PartChangeEvent) GetEvent() isPartChangeEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *PartChangeEvent) GetCreated() *PartCreated {
+	if x != nil {
+		if x, ok := x.Event.(*PartChangeEvent_Created); ok {
+			return x.Created
+		}
+	}
+	return nil
+}
+
+func (x *PartChangeEvent) GetUpdated() *PartUpdated {
+	if x != nil {
+		if x, ok := x.Event.(*PartChangeEvent_Updated); ok {
+			return x.Updated
+		}
+	}
+	return nil
+}
+
+func (x *PartChangeEvent) GetDeleted() *PartDeleted {
+	if x != nil {
+		if x, ok := x.Event.(*PartChangeEvent_Deleted); ok {
+			return x.Deleted
+		}
+	}
+	return nil
+}
+
+type isPartChangeEvent_Event interface {
+	isPartChangeEvent_Event()
+}
+
+type PartChangeEvent_Created struct {
+	Created *PartCreated `protobuf:"bytes,1,opt,name=created,proto3,oneof"`
+}
+
+type PartChangeEvent_Updated struct {
+	Updated *PartUpdated `protobuf:"bytes,2,opt,name=updated,proto3,oneof"`
+}
+
+type PartChangeEvent_Deleted struct {
+	Deleted *PartDeleted `protobuf:"bytes,3,opt,name=deleted,proto3,oneof"`
+}
+
+func (*PartChangeEvent_Created) isPartChangeEvent_Event() {}
+
+func (*PartChangeEvent_Updated) isPartChangeEvent_Event() {}
+
+func (*PartChangeEvent_Deleted) isPartChangeEvent_Event() {}
+
+var File_events_v1_inventory_proto protoreflect.FileDescriptor
+
+const file_events_v1_inventory_proto_rawDesc = "" +
+	"\n" +
+	"\x19events/v1/inventory.proto\x12\tevents.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xf8\x01\n" +
+	"\fPartStockLow\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1b\n" +
+	"\tpart_uuid\x18\x02 \x01(\tR\bpartUuid\x12\x1b\n" +
+	"\tpart_name\x18\x03 \x01(\tR\bpartName\x12%\n" +
+	"\x0estock_quantity\x18\x04 \x01(\x03R\rstockQuantity\x12+\n" +
+	"\x11reorder_threshold\x18\x05 \x01(\x03R\x10reorderThreshold\x12;\n" +
+	"\voccurred_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\xf9\x01\n" +
+	"\rPartRestocked\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1b\n" +
+	"\tpart_uuid\x18\x02 \x01(\tR\bpartUuid\x12\x1b\n" +
+	"\tpart_name\x18\x03 \x01(\tR\bpartName\x12%\n" +
+	"\x0estock_quantity\x18\x04 \x01(\x03R\rstockQuantity\x12+\n" +
+	"\x11reorder_threshold\x18\x05 \x01(\x03R\x10reorderThreshold\x12;\n" +
+	"\voccurred_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\x80\x02\n" +
+	"\fPartSnapshot\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x14\n" +
+	"\x05price\x18\x04 \x01(\x01R\x05price\x12%\n" +
+	"\x0estock_quantity\x18\x05 \x01(\x03R\rstockQuantity\x12\x1a\n" +
+	"\bcategory\x18\x06 \x01(\tR\bcategory\x12\x12\n" +
+	"\x04tags\x18\a \x03(\tR\x04tags\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x96\x01\n" +
+	"\vPartCreated\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12+\n" +
+	"\x04part\x18\x02 \x01(\v2\x17.events.v1.PartSnapshotR\x04part\x12;\n" +
+	"\voccurred_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\xbd\x01\n" +
+	"\vPartUpdated\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12+\n" +
+	"\x04part\x18\x02 \x01(\v2\x17.events.v1.PartSnapshotR\x04part\x12%\n" +
+	"\x0eupdated_fields\x18\x03 \x03(\tR\rupdatedFields\x12;\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\x86\x01\n" +
+	"\vPartDeleted\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1b\n" +
+	"\tpart_uuid\x18\x02 \x01(\tR\bpartUuid\x12;\n" +
+	"\voccurred_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"\xb6\x01\n" +
+	"\x0fPartChangeEvent\x122\n" +
+	"\acreated\x18\x01 \x01(\v2\x16.events.v1.PartCreatedH\x00R\acreated\x122\n" +
+	"\aupdated\x18\x02 \x01(\v2\x16.events.v1.PartUpdatedH\x00R\aupdated\x122\n" +
+	"\adeleted\x18\x03 \x01(\v2\x16.events.v1.PartDeletedH\x00R\adeletedB\a\n" +
+	"\x05eventB\xb2\x01\n" +
+	"\rcom.events.v1B\x0eInventoryProtoP\x01ZLgithub.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/events/v1;eventsv1\xa2\x02\x03EXX\xaa\x02\tEvents.V1\xca\x02\tEvents\\V1\xe2\x02\x15Events\\V1\\GPBMetadata\xea\x02\n" +
+	"Events::V1b\x06proto3"
+
+var (
+	file_events_v1_inventory_proto_rawDescOnce sync.Once
+	file_events_v1_inventory_proto_rawDescData []byte
+)
+
+func file_events_v1_inventory_proto_rawDescGZIP() []byte {
+	file_events_v1_inventory_proto_rawDescOnce.Do(func() {
+		file_events_v1_inventory_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_v1_inventory_proto_rawDesc), len(file_events_v1_inventory_proto_rawDesc)))
+	})
+	return file_events_v1_inventory_proto_rawDescData
+}
+
+var file_events_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_events_v1_inventory_proto_goTypes = []any{
+	(*PartStockLow)(nil),          // 0: events.v1.PartStockLow
+	(*PartRestocked)(nil),         // 1: events.v1.PartRestocked
+	(*PartSnapshot)(nil),          // 2: events.v1.PartSnapshot
+	(*PartCreated)(nil),           // 3: events.v1.PartCreated
+	(*PartUpdated)(nil),           // 4: events.v1.PartUpdated
+	(*PartDeleted)(nil),           // 5: events.v1.PartDeleted
+	(*PartChangeEvent)(nil),       // 6: events.v1.PartChangeEvent
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+}
+var file_events_v1_inventory_proto_depIdxs = []int32{
+	7,  // 0: events.v1.PartStockLow.occurred_at:type_name -> google.protobuf.Timestamp
+	7,  // 1: events.v1.PartRestocked.occurred_at:type_name -> google.protobuf.Timestamp
+	7,  // 2: events.v1.PartSnapshot.updated_at:type_name -> google.protobuf.Timestamp
+	2,  // 3: events.v1.PartCreated.part:type_name -> events.v1.PartSnapshot
+	7,  // 4: events.v1.PartCreated.occurred_at:type_name -> google.protobuf.Timestamp
+	2,  // 5: events.v1.PartUpdated.part:type_name -> events.v1.PartSnapshot
+	7,  // 6: events.v1.PartUpdated.occurred_at:type_name -> google.protobuf.Timestamp
+	7,  // 7: events.v1.PartDeleted.occurred_at:type_name -> google.protobuf.Timestamp
+	3,  // 8: events.v1.PartChangeEvent.created:type_name -> events.v1.PartCreated
+	4,  // 9: events.v1.PartChangeEvent.updated:type_name -> events.v1.PartUpdated
+	5,  // 10: events.v1.PartChangeEvent.deleted:type_name -> events.v1.PartDeleted
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_events_v1_inventory_proto_init() }
+func file_events_v1_inventory_proto_init() {
+	if File_events_v1_inventory_proto != nil {
+		return
+	}
+	file_events_v1_inventory_proto_msgTypes[6].OneofWrappers = []any{
+		(*PartChangeEvent_Created)(nil),
+		(*PartChangeEvent_Updated)(nil),
+		(*PartChangeEvent_Deleted)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_v1_inventory_proto_rawDesc), len(file_events_v1_inventory_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_v1_inventory_proto_goTypes,
+		DependencyIndexes: file_events_v1_inventory_proto_depIdxs,
+		MessageInfos:      file_events_v1_inventory_proto_msgTypes,
+	}.Build()
+	File_events_v1_inventory_proto = out.File
+	file_events_v1_inventory_proto_goTypes = nil
+	file_events_v1_inventory_proto_depIdxs = nil
+}
@@ -34,8 +34,10 @@ type OrderPaid struct {
 	PaymentMethod string `protobuf:"bytes,4,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
 	// uuid транзакции
 	TransactionUuid string `protobuf:"bytes,5,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// стоимость заказа
+	TotalPrice    float64 `protobuf:"fixed64,6,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *OrderPaid) Reset() {
@@ -103,6 +105,13 @@ func (x *OrderPaid) GetTransactionUuid() string {
 	return ""
 }
 
+func (x *OrderPaid) GetTotalPrice() float64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
 // Исходящее событие в assembly сервис в Kafka
 type ShipAssembled struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -180,7 +189,7 @@ var File_events_v1_order_proto protoreflect.FileDescriptor
 
 const file_events_v1_order_proto_rawDesc = "" +
 	"\n" +
-	"\x15events/v1/order.proto\x12\tevents.v1\"\xb8\x01\n" +
+	"\x15events/v1/order.proto\x12\tevents.v1\"\xd9\x01\n" +
 	"\tOrderPaid\x12\x1d\n" +
 	"\n" +
 	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1d\n" +
@@ -188,7 +197,9 @@ const file_events_v1_order_proto_rawDesc = "" +
 	"order_uuid\x18\x02 \x01(\tR\torderUuid\x12\x1b\n" +
 	"\tuser_uuid\x18\x03 \x01(\tR\buserUuid\x12%\n" +
 	"\x0epayment_method\x18\x04 \x01(\tR\rpaymentMethod\x12)\n" +
-	"\x10transaction_uuid\x18\x05 \x01(\tR\x0ftransactionUuid\"\x90\x01\n" +
+	"\x10transaction_uuid\x18\x05 \x01(\tR\x0ftransactionUuid\x12\x1f\n" +
+	"\vtotal_price\x18\x06 \x01(\x01R\n" +
+	"totalPrice\"\x90\x01\n" +
 	"\rShipAssembled\x12\x1d\n" +
 	"\n" +
 	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12\x1d\n" +
@@ -0,0 +1,269 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: events/v1/payment.proto
+
+package eventsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Исходящее(из payment сервиса) и входящее(в order сервис) событие об успешном платеже в Kafka
+type PaymentSucceeded struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// uuid события (для идемпотентности)
+	EventUuid string `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`
+	// uuid транзакции
+	TransactionUuid string `protobuf:"bytes,2,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	// uuid заказа
+	OrderUuid string `protobuf:"bytes,3,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	// uuid пользователя
+	UserUuid string `protobuf:"bytes,4,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	// метод оплаты
+	PaymentMethod string `protobuf:"bytes,5,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentSucceeded) Reset() {
+	*x = PaymentSucceeded{}
+	mi := &file_events_v1_payment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentSucceeded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentSucceeded) ProtoMessage() {}
+
+func (x *PaymentSucceeded) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_payment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentSucceeded.ProtoReflect.Descriptor instead.
+func (*PaymentSucceeded) Descriptor() ([]byte, []int) {
+	return file_events_v1_payment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PaymentSucceeded) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *PaymentSucceeded) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *PaymentSucceeded) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *PaymentSucceeded) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *PaymentSucceeded) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+// Исходящее(из payment сервиса) и входящее(в order сервис) событие об отклоненном платеже в Kafka
+type PaymentFailed struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// uuid события (для идемпотентности)
+	EventUuid string `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`
+	// uuid транзакции
+	TransactionUuid string `protobuf:"bytes,2,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	// uuid заказа
+	OrderUuid string `protobuf:"bytes,3,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	// uuid пользователя
+	UserUuid string `protobuf:"bytes,4,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	// метод оплаты
+	PaymentMethod string `protobuf:"bytes,5,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	// причина отказа
+	Reason        string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentFailed) Reset() {
+	*x = PaymentFailed{}
+	mi := &file_events_v1_payment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentFailed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentFailed) ProtoMessage() {}
+
+func (x *PaymentFailed) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_payment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentFailed.ProtoReflect.Descriptor instead.
+func (*PaymentFailed) Descriptor() ([]byte, []int) {
+	return file_events_v1_payment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PaymentFailed) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *PaymentFailed) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *PaymentFailed) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *PaymentFailed) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *PaymentFailed) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *PaymentFailed) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+var File_events_v1_payment_proto protoreflect.FileDescriptor
+
+const file_events_v1_payment_proto_rawDesc = "" +
+	"\n" +
+	"\x17events/v1/payment.proto\x12\tevents.v1\"\xbf\x01\n" +
+	"\x10PaymentSucceeded\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12)\n" +
+	"\x10transaction_uuid\x18\x02 \x01(\tR\x0ftransactionUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x03 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x04 \x01(\tR\buserUuid\x12%\n" +
+	"\x0epayment_method\x18\x05 \x01(\tR\rpaymentMethod\"\xd4\x01\n" +
+	"\rPaymentFailed\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12)\n" +
+	"\x10transaction_uuid\x18\x02 \x01(\tR\x0ftransactionUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x03 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x04 \x01(\tR\buserUuid\x12%\n" +
+	"\x0epayment_method\x18\x05 \x01(\tR\rpaymentMethod\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reasonB\xb0\x01\n" +
+	"\rcom.events.v1B\fPaymentProtoP\x01ZLgithub.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/events/v1;eventsv1\xa2\x02\x03EXX\xaa\x02\tEvents.V1\xca\x02\tEvents\\V1\xe2\x02\x15Events\\V1\\GPBMetadata\xea\x02\n" +
+	"Events::V1b\x06proto3"
+
+var (
+	file_events_v1_payment_proto_rawDescOnce sync.Once
+	file_events_v1_payment_proto_rawDescData []byte
+)
+
+func file_events_v1_payment_proto_rawDescGZIP() []byte {
+	file_events_v1_payment_proto_rawDescOnce.Do(func() {
+		file_events_v1_payment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_v1_payment_proto_rawDesc), len(file_events_v1_payment_proto_rawDesc)))
+	})
+	return file_events_v1_payment_proto_rawDescData
+}
+
+var file_events_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_events_v1_payment_proto_goTypes = []any{
+	(*PaymentSucceeded)(nil), // 0: events.v1.PaymentSucceeded
+	(*PaymentFailed)(nil),    // 1: events.v1.PaymentFailed
+}
+var file_events_v1_payment_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_events_v1_payment_proto_init() }
+func file_events_v1_payment_proto_init() {
+	if File_events_v1_payment_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_v1_payment_proto_rawDesc), len(file_events_v1_payment_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_v1_payment_proto_goTypes,
+		DependencyIndexes: file_events_v1_payment_proto_depIdxs,
+		MessageInfos:      file_events_v1_payment_proto_msgTypes,
+	}.Build()
+	File_events_v1_payment_proto = out.File
+	file_events_v1_payment_proto_goTypes = nil
+	file_events_v1_payment_proto_depIdxs = nil
+}
@@ -0,0 +1,223 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: events/v1/receipt.proto
+
+package eventsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Исходящее(из payment сервиса) и входящее(в notification сервис) событие о выдаче чека в Kafka
+type ReceiptIssued struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// uuid события (для идемпотентности)
+	EventUuid string `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`
+	// uuid чека
+	ReceiptUuid string `protobuf:"bytes,2,opt,name=receipt_uuid,json=receiptUuid,proto3" json:"receipt_uuid,omitempty"`
+	// uuid транзакции
+	TransactionUuid string `protobuf:"bytes,3,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	// uuid заказа
+	OrderUuid string `protobuf:"bytes,4,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	// uuid пользователя
+	UserUuid string `protobuf:"bytes,5,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	// метод оплаты
+	PaymentMethod string `protobuf:"bytes,6,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	// итоговая сумма (с НДС)
+	Total float64 `protobuf:"fixed64,7,opt,name=total,proto3" json:"total,omitempty"`
+	// сумма НДС
+	VatAmount float64 `protobuf:"fixed64,8,opt,name=vat_amount,json=vatAmount,proto3" json:"vat_amount,omitempty"`
+	// текстовое представление чека
+	Text string `protobuf:"bytes,9,opt,name=text,proto3" json:"text,omitempty"`
+	// дата выдачи чека
+	IssuedAt      *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiptIssued) Reset() {
+	*x = ReceiptIssued{}
+	mi := &file_events_v1_receipt_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiptIssued) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptIssued) ProtoMessage() {}
+
+func (x *ReceiptIssued) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_receipt_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptIssued.ProtoReflect.Descriptor instead.
+func (*ReceiptIssued) Descriptor() ([]byte, []int) {
+	return file_events_v1_receipt_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ReceiptIssued) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *ReceiptIssued) GetReceiptUuid() string {
+	if x != nil {
+		return x.ReceiptUuid
+	}
+	return ""
+}
+
+func (x *ReceiptIssued) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *ReceiptIssued) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *ReceiptIssued) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *ReceiptIssued) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *ReceiptIssued) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ReceiptIssued) GetVatAmount() float64 {
+	if x != nil {
+		return x.VatAmount
+	}
+	return 0
+}
+
+func (x *ReceiptIssued) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ReceiptIssued) GetIssuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return nil
+}
+
+var File_events_v1_receipt_proto protoreflect.FileDescriptor
+
+const file_events_v1_receipt_proto_rawDesc = "" +
+	"\n" +
+	"\x17events/v1/receipt.proto\x12\tevents.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xe1\x02\n" +
+	"\rReceiptIssued\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12!\n" +
+	"\freceipt_uuid\x18\x02 \x01(\tR\vreceiptUuid\x12)\n" +
+	"\x10transaction_uuid\x18\x03 \x01(\tR\x0ftransactionUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x04 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x05 \x01(\tR\buserUuid\x12%\n" +
+	"\x0epayment_method\x18\x06 \x01(\tR\rpaymentMethod\x12\x14\n" +
+	"\x05total\x18\a \x01(\x01R\x05total\x12\x1d\n" +
+	"\n" +
+	"vat_amount\x18\b \x01(\x01R\tvatAmount\x12\x12\n" +
+	"\x04text\x18\t \x01(\tR\x04text\x127\n" +
+	"\tissued_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\bissuedAtB\xb0\x01\n" +
+	"\rcom.events.v1B\fReceiptProtoP\x01ZLgithub.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/events/v1;eventsv1\xa2\x02\x03EXX\xaa\x02\tEvents.V1\xca\x02\tEvents\\V1\xe2\x02\x15Events\\V1\\GPBMetadata\xea\x02\n" +
+	"Events::V1b\x06proto3"
+
+var (
+	file_events_v1_receipt_proto_rawDescOnce sync.Once
+	file_events_v1_receipt_proto_rawDescData []byte
+)
+
+func file_events_v1_receipt_proto_rawDescGZIP() []byte {
+	file_events_v1_receipt_proto_rawDescOnce.Do(func() {
+		file_events_v1_receipt_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_v1_receipt_proto_rawDesc), len(file_events_v1_receipt_proto_rawDesc)))
+	})
+	return file_events_v1_receipt_proto_rawDescData
+}
+
+var file_events_v1_receipt_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_events_v1_receipt_proto_goTypes = []any{
+	(*ReceiptIssued)(nil),         // 0: events.v1.ReceiptIssued
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_events_v1_receipt_proto_depIdxs = []int32{
+	1, // 0: events.v1.ReceiptIssued.issued_at:type_name -> google.protobuf.Timestamp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_events_v1_receipt_proto_init() }
+func file_events_v1_receipt_proto_init() {
+	if File_events_v1_receipt_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_v1_receipt_proto_rawDesc), len(file_events_v1_receipt_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_v1_receipt_proto_goTypes,
+		DependencyIndexes: file_events_v1_receipt_proto_depIdxs,
+		MessageInfos:      file_events_v1_receipt_proto_msgTypes,
+	}.Build()
+	File_events_v1_receipt_proto = out.File
+	file_events_v1_receipt_proto_goTypes = nil
+	file_events_v1_receipt_proto_depIdxs = nil
+}
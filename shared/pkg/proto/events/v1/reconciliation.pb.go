@@ -0,0 +1,202 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: events/v1/reconciliation.proto
+
+package eventsv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Исходящее(из payment сервиса) событие о расхождении, найденном при сверке заказов и платежей
+type PaymentDiscrepancy struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// uuid события (для идемпотентности)
+	EventUuid string `protobuf:"bytes,1,opt,name=event_uuid,json=eventUuid,proto3" json:"event_uuid,omitempty"`
+	// тип расхождения (ORPHAN_PAYMENT, PAID_ORDER_WITHOUT_TRANSACTION, AMOUNT_MISMATCH, MISSING_ORDER_SNAPSHOT)
+	DiscrepancyType string `protobuf:"bytes,2,opt,name=discrepancy_type,json=discrepancyType,proto3" json:"discrepancy_type,omitempty"`
+	// uuid заказа
+	OrderUuid string `protobuf:"bytes,3,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	// uuid транзакции
+	TransactionUuid string `protobuf:"bytes,4,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	// стоимость заказа
+	OrderAmount float64 `protobuf:"fixed64,5,opt,name=order_amount,json=orderAmount,proto3" json:"order_amount,omitempty"`
+	// сумма транзакции
+	PaymentAmount float64 `protobuf:"fixed64,6,opt,name=payment_amount,json=paymentAmount,proto3" json:"payment_amount,omitempty"`
+	// описание расхождения
+	Details string `protobuf:"bytes,7,opt,name=details,proto3" json:"details,omitempty"`
+	// время обнаружения
+	DetectedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentDiscrepancy) Reset() {
+	*x = PaymentDiscrepancy{}
+	mi := &file_events_v1_reconciliation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentDiscrepancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentDiscrepancy) ProtoMessage() {}
+
+func (x *PaymentDiscrepancy) ProtoReflect() protoreflect.Message {
+	mi := &file_events_v1_reconciliation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentDiscrepancy.ProtoReflect.Descriptor instead.
+func (*PaymentDiscrepancy) Descriptor() ([]byte, []int) {
+	return file_events_v1_reconciliation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PaymentDiscrepancy) GetEventUuid() string {
+	if x != nil {
+		return x.EventUuid
+	}
+	return ""
+}
+
+func (x *PaymentDiscrepancy) GetDiscrepancyType() string {
+	if x != nil {
+		return x.DiscrepancyType
+	}
+	return ""
+}
+
+func (x *PaymentDiscrepancy) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *PaymentDiscrepancy) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *PaymentDiscrepancy) GetOrderAmount() float64 {
+	if x != nil {
+		return x.OrderAmount
+	}
+	return 0
+}
+
+func (x *PaymentDiscrepancy) GetPaymentAmount() float64 {
+	if x != nil {
+		return x.PaymentAmount
+	}
+	return 0
+}
+
+func (x *PaymentDiscrepancy) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *PaymentDiscrepancy) GetDetectedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return nil
+}
+
+var File_events_v1_reconciliation_proto protoreflect.FileDescriptor
+
+const file_events_v1_reconciliation_proto_rawDesc = "" +
+	"\n" +
+	"\x1eevents/v1/reconciliation.proto\x12\tevents.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc9\x02\n" +
+	"\x12PaymentDiscrepancy\x12\x1d\n" +
+	"\n" +
+	"event_uuid\x18\x01 \x01(\tR\teventUuid\x12)\n" +
+	"\x10discrepancy_type\x18\x02 \x01(\tR\x0fdiscrepancyType\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x03 \x01(\tR\torderUuid\x12)\n" +
+	"\x10transaction_uuid\x18\x04 \x01(\tR\x0ftransactionUuid\x12!\n" +
+	"\forder_amount\x18\x05 \x01(\x01R\vorderAmount\x12%\n" +
+	"\x0epayment_amount\x18\x06 \x01(\x01R\rpaymentAmount\x12\x18\n" +
+	"\adetails\x18\a \x01(\tR\adetails\x12;\n" +
+	"\vdetected_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"detectedAtB\xb7\x01\n" +
+	"\rcom.events.v1B\x13ReconciliationProtoP\x01ZLgithub.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/events/v1;eventsv1\xa2\x02\x03EXX\xaa\x02\tEvents.V1\xca\x02\tEvents\\V1\xe2\x02\x15Events\\V1\\GPBMetadata\xea\x02\n" +
+	"Events::V1b\x06proto3"
+
+var (
+	file_events_v1_reconciliation_proto_rawDescOnce sync.Once
+	file_events_v1_reconciliation_proto_rawDescData []byte
+)
+
+func file_events_v1_reconciliation_proto_rawDescGZIP() []byte {
+	file_events_v1_reconciliation_proto_rawDescOnce.Do(func() {
+		file_events_v1_reconciliation_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_events_v1_reconciliation_proto_rawDesc), len(file_events_v1_reconciliation_proto_rawDesc)))
+	})
+	return file_events_v1_reconciliation_proto_rawDescData
+}
+
+var file_events_v1_reconciliation_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_events_v1_reconciliation_proto_goTypes = []any{
+	(*PaymentDiscrepancy)(nil),    // 0: events.v1.PaymentDiscrepancy
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_events_v1_reconciliation_proto_depIdxs = []int32{
+	1, // 0: events.v1.PaymentDiscrepancy.detected_at:type_name -> google.protobuf.Timestamp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_events_v1_reconciliation_proto_init() }
+func file_events_v1_reconciliation_proto_init() {
+	if File_events_v1_reconciliation_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_events_v1_reconciliation_proto_rawDesc), len(file_events_v1_reconciliation_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_events_v1_reconciliation_proto_goTypes,
+		DependencyIndexes: file_events_v1_reconciliation_proto_depIdxs,
+		MessageInfos:      file_events_v1_reconciliation_proto_msgTypes,
+	}.Build()
+	File_events_v1_reconciliation_proto = out.File
+	file_events_v1_reconciliation_proto_goTypes = nil
+	file_events_v1_reconciliation_proto_depIdxs = nil
+}
@@ -9,6 +9,7 @@ package inventoryv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
@@ -22,6 +23,437 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// Тип движения остатка
+type StockMovementType int32
+
+const (
+	// Не задан
+	StockMovementType_STOCK_MOVEMENT_TYPE_UNSPECIFIED StockMovementType = 0
+	// Поступление на склад
+	StockMovementType_STOCK_MOVEMENT_TYPE_RECEIPT StockMovementType = 1
+	// Резервирование под заказ
+	StockMovementType_STOCK_MOVEMENT_TYPE_RESERVATION StockMovementType = 2
+	// Снятие резерва
+	StockMovementType_STOCK_MOVEMENT_TYPE_RELEASE StockMovementType = 3
+	// Списание в производство
+	StockMovementType_STOCK_MOVEMENT_TYPE_CONSUMPTION StockMovementType = 4
+	// Ручная корректировка
+	StockMovementType_STOCK_MOVEMENT_TYPE_ADJUSTMENT StockMovementType = 5
+	// Перемещение между складами
+	StockMovementType_STOCK_MOVEMENT_TYPE_TRANSFER StockMovementType = 6
+)
+
+// Enum value maps for StockMovementType.
+var (
+	StockMovementType_name = map[int32]string{
+		0: "STOCK_MOVEMENT_TYPE_UNSPECIFIED",
+		1: "STOCK_MOVEMENT_TYPE_RECEIPT",
+		2: "STOCK_MOVEMENT_TYPE_RESERVATION",
+		3: "STOCK_MOVEMENT_TYPE_RELEASE",
+		4: "STOCK_MOVEMENT_TYPE_CONSUMPTION",
+		5: "STOCK_MOVEMENT_TYPE_ADJUSTMENT",
+		6: "STOCK_MOVEMENT_TYPE_TRANSFER",
+	}
+	StockMovementType_value = map[string]int32{
+		"STOCK_MOVEMENT_TYPE_UNSPECIFIED": 0,
+		"STOCK_MOVEMENT_TYPE_RECEIPT":     1,
+		"STOCK_MOVEMENT_TYPE_RESERVATION": 2,
+		"STOCK_MOVEMENT_TYPE_RELEASE":     3,
+		"STOCK_MOVEMENT_TYPE_CONSUMPTION": 4,
+		"STOCK_MOVEMENT_TYPE_ADJUSTMENT":  5,
+		"STOCK_MOVEMENT_TYPE_TRANSFER":    6,
+	}
+)
+
+func (x StockMovementType) Enum() *StockMovementType {
+	p := new(StockMovementType)
+	*p = x
+	return p
+}
+
+func (x StockMovementType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StockMovementType) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[0].Descriptor()
+}
+
+func (StockMovementType) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[0]
+}
+
+func (x StockMovementType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StockMovementType.Descriptor instead.
+func (StockMovementType) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{0}
+}
+
+// Формат файла каталога деталей
+type CatalogFormat int32
+
+const (
+	// Формат не указан
+	CatalogFormat_CATALOG_FORMAT_UNSPECIFIED CatalogFormat = 0
+	// CSV с заголовком
+	CatalogFormat_CATALOG_FORMAT_CSV CatalogFormat = 1
+	// JSON-массив деталей. При импорте принимается и NDJSON
+	CatalogFormat_CATALOG_FORMAT_JSON CatalogFormat = 2
+	// Одна деталь в формате JSON на строку
+	CatalogFormat_CATALOG_FORMAT_NDJSON CatalogFormat = 3
+)
+
+// Enum value maps for CatalogFormat.
+var (
+	CatalogFormat_name = map[int32]string{
+		0: "CATALOG_FORMAT_UNSPECIFIED",
+		1: "CATALOG_FORMAT_CSV",
+		2: "CATALOG_FORMAT_JSON",
+		3: "CATALOG_FORMAT_NDJSON",
+	}
+	CatalogFormat_value = map[string]int32{
+		"CATALOG_FORMAT_UNSPECIFIED": 0,
+		"CATALOG_FORMAT_CSV":         1,
+		"CATALOG_FORMAT_JSON":        2,
+		"CATALOG_FORMAT_NDJSON":      3,
+	}
+)
+
+func (x CatalogFormat) Enum() *CatalogFormat {
+	p := new(CatalogFormat)
+	*p = x
+	return p
+}
+
+func (x CatalogFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CatalogFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[1].Descriptor()
+}
+
+func (CatalogFormat) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[1]
+}
+
+func (x CatalogFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CatalogFormat.Descriptor instead.
+func (CatalogFormat) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{1}
+}
+
+// Тип вложения детали
+type AttachmentKind int32
+
+const (
+	// Тип не указан
+	AttachmentKind_ATTACHMENT_KIND_UNSPECIFIED AttachmentKind = 0
+	// Фотография детали
+	AttachmentKind_ATTACHMENT_KIND_IMAGE AttachmentKind = 1
+	// Чертеж
+	AttachmentKind_ATTACHMENT_KIND_DRAWING AttachmentKind = 2
+	// Техническая документация
+	AttachmentKind_ATTACHMENT_KIND_DATASHEET AttachmentKind = 3
+)
+
+// Enum value maps for AttachmentKind.
+var (
+	AttachmentKind_name = map[int32]string{
+		0: "ATTACHMENT_KIND_UNSPECIFIED",
+		1: "ATTACHMENT_KIND_IMAGE",
+		2: "ATTACHMENT_KIND_DRAWING",
+		3: "ATTACHMENT_KIND_DATASHEET",
+	}
+	AttachmentKind_value = map[string]int32{
+		"ATTACHMENT_KIND_UNSPECIFIED": 0,
+		"ATTACHMENT_KIND_IMAGE":       1,
+		"ATTACHMENT_KIND_DRAWING":     2,
+		"ATTACHMENT_KIND_DATASHEET":   3,
+	}
+)
+
+func (x AttachmentKind) Enum() *AttachmentKind {
+	p := new(AttachmentKind)
+	*p = x
+	return p
+}
+
+func (x AttachmentKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttachmentKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[2].Descriptor()
+}
+
+func (AttachmentKind) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[2]
+}
+
+func (x AttachmentKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttachmentKind.Descriptor instead.
+func (AttachmentKind) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{2}
+}
+
+// Тип события подписки на детали
+type PartEventType int32
+
+const (
+	// Тип не указан
+	PartEventType_PART_EVENT_TYPE_UNSPECIFIED PartEventType = 0
+	// Деталь из начального снимка
+	PartEventType_PART_EVENT_TYPE_SNAPSHOT PartEventType = 1
+	// Начальный снимок отправлен полностью, дальше идут только изменения
+	PartEventType_PART_EVENT_TYPE_SNAPSHOT_COMPLETE PartEventType = 2
+	// Деталь создана или стала подходить под фильтр
+	PartEventType_PART_EVENT_TYPE_CREATED PartEventType = 3
+	// Деталь изменена и по-прежнему подходит под фильтр
+	PartEventType_PART_EVENT_TYPE_UPDATED PartEventType = 4
+	// Деталь удалена или перестала подходить под фильтр
+	PartEventType_PART_EVENT_TYPE_DELETED PartEventType = 5
+)
+
+// Enum value maps for PartEventType.
+var (
+	PartEventType_name = map[int32]string{
+		0: "PART_EVENT_TYPE_UNSPECIFIED",
+		1: "PART_EVENT_TYPE_SNAPSHOT",
+		2: "PART_EVENT_TYPE_SNAPSHOT_COMPLETE",
+		3: "PART_EVENT_TYPE_CREATED",
+		4: "PART_EVENT_TYPE_UPDATED",
+		5: "PART_EVENT_TYPE_DELETED",
+	}
+	PartEventType_value = map[string]int32{
+		"PART_EVENT_TYPE_UNSPECIFIED":       0,
+		"PART_EVENT_TYPE_SNAPSHOT":          1,
+		"PART_EVENT_TYPE_SNAPSHOT_COMPLETE": 2,
+		"PART_EVENT_TYPE_CREATED":           3,
+		"PART_EVENT_TYPE_UPDATED":           4,
+		"PART_EVENT_TYPE_DELETED":           5,
+	}
+)
+
+func (x PartEventType) Enum() *PartEventType {
+	p := new(PartEventType)
+	*p = x
+	return p
+}
+
+func (x PartEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PartEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[3].Descriptor()
+}
+
+func (PartEventType) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[3]
+}
+
+func (x PartEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PartEventType.Descriptor instead.
+func (PartEventType) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+// Тип правила совместимости
+type CompatibilityRuleType int32
+
+const (
+	// Не задан
+	CompatibilityRuleType_COMPATIBILITY_RULE_TYPE_UNSPECIFIED CompatibilityRuleType = 0
+	// Субъект требует наличия объекта в конфигурации
+	CompatibilityRuleType_COMPATIBILITY_RULE_TYPE_REQUIRES CompatibilityRuleType = 1
+	// Субъект не может быть в одной конфигурации с объектом
+	CompatibilityRuleType_COMPATIBILITY_RULE_TYPE_EXCLUDES CompatibilityRuleType = 2
+	// Из категории объекта с субъектом допустимы только перечисленные объекты
+	CompatibilityRuleType_COMPATIBILITY_RULE_TYPE_COMPATIBLE_WITH CompatibilityRuleType = 3
+)
+
+// Enum value maps for CompatibilityRuleType.
+var (
+	CompatibilityRuleType_name = map[int32]string{
+		0: "COMPATIBILITY_RULE_TYPE_UNSPECIFIED",
+		1: "COMPATIBILITY_RULE_TYPE_REQUIRES",
+		2: "COMPATIBILITY_RULE_TYPE_EXCLUDES",
+		3: "COMPATIBILITY_RULE_TYPE_COMPATIBLE_WITH",
+	}
+	CompatibilityRuleType_value = map[string]int32{
+		"COMPATIBILITY_RULE_TYPE_UNSPECIFIED":     0,
+		"COMPATIBILITY_RULE_TYPE_REQUIRES":        1,
+		"COMPATIBILITY_RULE_TYPE_EXCLUDES":        2,
+		"COMPATIBILITY_RULE_TYPE_COMPATIBLE_WITH": 3,
+	}
+)
+
+func (x CompatibilityRuleType) Enum() *CompatibilityRuleType {
+	p := new(CompatibilityRuleType)
+	*p = x
+	return p
+}
+
+func (x CompatibilityRuleType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CompatibilityRuleType) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[4].Descriptor()
+}
+
+func (CompatibilityRuleType) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[4]
+}
+
+func (x CompatibilityRuleType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CompatibilityRuleType.Descriptor instead.
+func (CompatibilityRuleType) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{4}
+}
+
+// Язык поискового запроса
+type SearchLanguage int32
+
+const (
+	// Определить автоматически
+	SearchLanguage_SEARCH_LANGUAGE_UNSPECIFIED SearchLanguage = 0
+	// Русский
+	SearchLanguage_SEARCH_LANGUAGE_RUSSIAN SearchLanguage = 1
+	// Английский
+	SearchLanguage_SEARCH_LANGUAGE_ENGLISH SearchLanguage = 2
+)
+
+// Enum value maps for SearchLanguage.
+var (
+	SearchLanguage_name = map[int32]string{
+		0: "SEARCH_LANGUAGE_UNSPECIFIED",
+		1: "SEARCH_LANGUAGE_RUSSIAN",
+		2: "SEARCH_LANGUAGE_ENGLISH",
+	}
+	SearchLanguage_value = map[string]int32{
+		"SEARCH_LANGUAGE_UNSPECIFIED": 0,
+		"SEARCH_LANGUAGE_RUSSIAN":     1,
+		"SEARCH_LANGUAGE_ENGLISH":     2,
+	}
+)
+
+func (x SearchLanguage) Enum() *SearchLanguage {
+	p := new(SearchLanguage)
+	*p = x
+	return p
+}
+
+func (x SearchLanguage) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SearchLanguage) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[5].Descriptor()
+}
+
+func (SearchLanguage) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[5]
+}
+
+func (x SearchLanguage) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SearchLanguage.Descriptor instead.
+func (SearchLanguage) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{5}
+}
+
+// Оператор сравнения для метаданных
+type MetadataOperator int32
+
+const (
+	// Не задан
+	MetadataOperator_METADATA_OPERATOR_UNSPECIFIED MetadataOperator = 0
+	// Равно
+	MetadataOperator_METADATA_OPERATOR_EQ MetadataOperator = 1
+	// Не равно
+	MetadataOperator_METADATA_OPERATOR_NE MetadataOperator = 2
+	// Больше
+	MetadataOperator_METADATA_OPERATOR_GT MetadataOperator = 3
+	// Больше или равно
+	MetadataOperator_METADATA_OPERATOR_GTE MetadataOperator = 4
+	// Меньше
+	MetadataOperator_METADATA_OPERATOR_LT MetadataOperator = 5
+	// Меньше или равно
+	MetadataOperator_METADATA_OPERATOR_LTE MetadataOperator = 6
+	// Ключ присутствует
+	MetadataOperator_METADATA_OPERATOR_EXISTS MetadataOperator = 7
+)
+
+// Enum value maps for MetadataOperator.
+var (
+	MetadataOperator_name = map[int32]string{
+		0: "METADATA_OPERATOR_UNSPECIFIED",
+		1: "METADATA_OPERATOR_EQ",
+		2: "METADATA_OPERATOR_NE",
+		3: "METADATA_OPERATOR_GT",
+		4: "METADATA_OPERATOR_GTE",
+		5: "METADATA_OPERATOR_LT",
+		6: "METADATA_OPERATOR_LTE",
+		7: "METADATA_OPERATOR_EXISTS",
+	}
+	MetadataOperator_value = map[string]int32{
+		"METADATA_OPERATOR_UNSPECIFIED": 0,
+		"METADATA_OPERATOR_EQ":          1,
+		"METADATA_OPERATOR_NE":          2,
+		"METADATA_OPERATOR_GT":          3,
+		"METADATA_OPERATOR_GTE":         4,
+		"METADATA_OPERATOR_LT":          5,
+		"METADATA_OPERATOR_LTE":         6,
+		"METADATA_OPERATOR_EXISTS":      7,
+	}
+)
+
+func (x MetadataOperator) Enum() *MetadataOperator {
+	p := new(MetadataOperator)
+	*p = x
+	return p
+}
+
+func (x MetadataOperator) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MetadataOperator) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[6].Descriptor()
+}
+
+func (MetadataOperator) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[6]
+}
+
+func (x MetadataOperator) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MetadataOperator.Descriptor instead.
+func (MetadataOperator) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{6}
+}
+
 // Категории деталей космических кораблей
 type Category int32
 
@@ -67,11 +499,11 @@ func (x Category) String() string {
 }
 
 func (Category) Descriptor() protoreflect.EnumDescriptor {
-	return file_inventory_v1_inventory_proto_enumTypes[0].Descriptor()
+	return file_inventory_v1_inventory_proto_enumTypes[7].Descriptor()
 }
 
 func (Category) Type() protoreflect.EnumType {
-	return &file_inventory_v1_inventory_proto_enumTypes[0]
+	return &file_inventory_v1_inventory_proto_enumTypes[7]
 }
 
 func (x Category) Number() protoreflect.EnumNumber {
@@ -80,14 +512,138 @@ func (x Category) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Category.Descriptor instead.
 func (Category) EnumDescriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{0}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{7}
+}
+
+// Тип значения поля метаданных
+type MetadataFieldType int32
+
+const (
+	// Тип не указан
+	MetadataFieldType_METADATA_FIELD_TYPE_UNSPECIFIED MetadataFieldType = 0
+	// Строка
+	MetadataFieldType_METADATA_FIELD_TYPE_STRING MetadataFieldType = 1
+	// Число
+	MetadataFieldType_METADATA_FIELD_TYPE_NUMBER MetadataFieldType = 2
+	// Целое число
+	MetadataFieldType_METADATA_FIELD_TYPE_INTEGER MetadataFieldType = 3
+	// Логическое значение
+	MetadataFieldType_METADATA_FIELD_TYPE_BOOL MetadataFieldType = 4
+)
+
+// Enum value maps for MetadataFieldType.
+var (
+	MetadataFieldType_name = map[int32]string{
+		0: "METADATA_FIELD_TYPE_UNSPECIFIED",
+		1: "METADATA_FIELD_TYPE_STRING",
+		2: "METADATA_FIELD_TYPE_NUMBER",
+		3: "METADATA_FIELD_TYPE_INTEGER",
+		4: "METADATA_FIELD_TYPE_BOOL",
+	}
+	MetadataFieldType_value = map[string]int32{
+		"METADATA_FIELD_TYPE_UNSPECIFIED": 0,
+		"METADATA_FIELD_TYPE_STRING":      1,
+		"METADATA_FIELD_TYPE_NUMBER":      2,
+		"METADATA_FIELD_TYPE_INTEGER":     3,
+		"METADATA_FIELD_TYPE_BOOL":        4,
+	}
+)
+
+func (x MetadataFieldType) Enum() *MetadataFieldType {
+	p := new(MetadataFieldType)
+	*p = x
+	return p
+}
+
+func (x MetadataFieldType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MetadataFieldType) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[8].Descriptor()
+}
+
+func (MetadataFieldType) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[8]
+}
+
+func (x MetadataFieldType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MetadataFieldType.Descriptor instead.
+func (MetadataFieldType) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{8}
+}
+
+// Поле сортировки списка деталей
+type PartsSortField int32
+
+const (
+	// По умолчанию — по дате создания
+	PartsSortField_PARTS_SORT_FIELD_UNSPECIFIED PartsSortField = 0
+	// По названию
+	PartsSortField_PARTS_SORT_FIELD_NAME PartsSortField = 1
+	// По цене
+	PartsSortField_PARTS_SORT_FIELD_PRICE PartsSortField = 2
+	// По дате создания
+	PartsSortField_PARTS_SORT_FIELD_CREATED_AT PartsSortField = 3
+	// По количеству на складе
+	PartsSortField_PARTS_SORT_FIELD_STOCK_QUANTITY PartsSortField = 4
+)
+
+// Enum value maps for PartsSortField.
+var (
+	PartsSortField_name = map[int32]string{
+		0: "PARTS_SORT_FIELD_UNSPECIFIED",
+		1: "PARTS_SORT_FIELD_NAME",
+		2: "PARTS_SORT_FIELD_PRICE",
+		3: "PARTS_SORT_FIELD_CREATED_AT",
+		4: "PARTS_SORT_FIELD_STOCK_QUANTITY",
+	}
+	PartsSortField_value = map[string]int32{
+		"PARTS_SORT_FIELD_UNSPECIFIED":    0,
+		"PARTS_SORT_FIELD_NAME":           1,
+		"PARTS_SORT_FIELD_PRICE":          2,
+		"PARTS_SORT_FIELD_CREATED_AT":     3,
+		"PARTS_SORT_FIELD_STOCK_QUANTITY": 4,
+	}
+)
+
+func (x PartsSortField) Enum() *PartsSortField {
+	p := new(PartsSortField)
+	*p = x
+	return p
+}
+
+func (x PartsSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PartsSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_inventory_v1_inventory_proto_enumTypes[9].Descriptor()
+}
+
+func (PartsSortField) Type() protoreflect.EnumType {
+	return &file_inventory_v1_inventory_proto_enumTypes[9]
+}
+
+func (x PartsSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PartsSortField.Descriptor instead.
+func (PartsSortField) EnumDescriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{9}
 }
 
 // Запрос на получение детали по UUID
 type GetPartRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Уникальный идентификатор детали
-	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Поля детали, которые нужно вернуть, например uuid, name, price. Пусто — все поля
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -129,6 +685,13 @@ func (x *GetPartRequest) GetUuid() string {
 	return ""
 }
 
+func (x *GetPartRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
 // Ответ с информацией о детали
 type GetPartResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -179,7 +742,17 @@ func (x *GetPartResponse) GetPart() *Part {
 type ListPartsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Фильтр по деталям (все поля опциональны)
-	Filter        *PartsFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Filter *PartsFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Размер страницы. 0 — размер по умолчанию (50), максимум 1000
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Токен следующей страницы из предыдущего ответа. Пусто — первая страница
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Поле сортировки. Сортировка должна совпадать с той, что была при получении page_token
+	SortBy PartsSortField `protobuf:"varint,4,opt,name=sort_by,json=sortBy,proto3,enum=inventory.v1.PartsSortField" json:"sort_by,omitempty"`
+	// Сортировать по убыванию
+	Descending bool `protobuf:"varint,5,opt,name=descending,proto3" json:"descending,omitempty"`
+	// Поля детали, которые нужно вернуть. Пусто — все поля
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,6,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -221,15 +794,54 @@ func (x *ListPartsRequest) GetFilter() *PartsFilter {
 	return nil
 }
 
-// Ответ со списком найденных деталей
-type ListPartsResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Список найденных деталей
-	Parts         []*Part `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
+func (x *ListPartsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPartsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListPartsRequest) GetSortBy() PartsSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return PartsSortField_PARTS_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *ListPartsRequest) GetDescending() bool {
+	if x != nil {
+		return x.Descending
+	}
+	return false
+}
+
+func (x *ListPartsRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+// Ответ со списком найденных деталей
+type ListPartsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Список найденных деталей
+	Parts []*Part `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	// Токен следующей страницы. Пусто — страниц больше нет
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Оценка общего количества деталей, подходящих под фильтр
+	TotalSize     int64 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
 func (x *ListPartsResponse) Reset() {
 	*x = ListPartsResponse{}
 	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
@@ -243,8 +855,5213 @@ func (x *ListPartsResponse) String() string {
 
 func (*ListPartsResponse) ProtoMessage() {}
 
-func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
+func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPartsResponse.ProtoReflect.Descriptor instead.
+func (*ListPartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListPartsResponse) GetParts() []*Part {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *ListPartsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListPartsResponse) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+// Запрос деталей по списку UUID
+type BatchGetPartsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID деталей, не больше 1000
+	Uuids []string `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`
+	// Поля детали, которые нужно вернуть. Пусто — все поля
+	ReadMask      *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=read_mask,json=readMask,proto3" json:"read_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetPartsRequest) Reset() {
+	*x = BatchGetPartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetPartsRequest) ProtoMessage() {}
+
+func (x *BatchGetPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetPartsRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetPartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BatchGetPartsRequest) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+func (x *BatchGetPartsRequest) GetReadMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.ReadMask
+	}
+	return nil
+}
+
+// Ответ с деталями по списку UUID
+type BatchGetPartsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Найденные детали в порядке запроса
+	Parts []*Part `protobuf:"bytes,1,rep,name=parts,proto3" json:"parts,omitempty"`
+	// UUID, для которых деталь не найдена или удалена
+	MissingUuids  []string `protobuf:"bytes,2,rep,name=missing_uuids,json=missingUuids,proto3" json:"missing_uuids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetPartsResponse) Reset() {
+	*x = BatchGetPartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetPartsResponse) ProtoMessage() {}
+
+func (x *BatchGetPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetPartsResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetPartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchGetPartsResponse) GetParts() []*Part {
+	if x != nil {
+		return x.Parts
+	}
+	return nil
+}
+
+func (x *BatchGetPartsResponse) GetMissingUuids() []string {
+	if x != nil {
+		return x.MissingUuids
+	}
+	return nil
+}
+
+// Запрос на создание детали
+type CreatePartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Деталь. uuid опционален (генерируется, если не задан), created_at и updated_at игнорируются
+	Part          *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePartRequest) Reset() {
+	*x = CreatePartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePartRequest) ProtoMessage() {}
+
+func (x *CreatePartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePartRequest.ProtoReflect.Descriptor instead.
+func (*CreatePartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CreatePartRequest) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// Ответ с созданной деталью
+type CreatePartResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Созданная деталь
+	Part          *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePartResponse) Reset() {
+	*x = CreatePartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePartResponse) ProtoMessage() {}
+
+func (x *CreatePartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePartResponse.ProtoReflect.Descriptor instead.
+func (*CreatePartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CreatePartResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// Запрос на обновление детали
+type UpdatePartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Новые значения полей, деталь ищется по part.uuid. part.version обязательна:
+	// при несовпадении с текущей версией возвращается ABORTED
+	Part *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	// Обновляемые поля (name, description, price, stock_quantity, category, dimensions,
+	// manufacturer, tags, metadata). Пустая маска обновляет все поля
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePartRequest) Reset() {
+	*x = UpdatePartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePartRequest) ProtoMessage() {}
+
+func (x *UpdatePartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePartRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *UpdatePartRequest) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+func (x *UpdatePartRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// Ответ с обновленной деталью
+type UpdatePartResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Деталь после обновления
+	Part          *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePartResponse) Reset() {
+	*x = UpdatePartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePartResponse) ProtoMessage() {}
+
+func (x *UpdatePartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePartResponse.ProtoReflect.Descriptor instead.
+func (*UpdatePartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdatePartResponse) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+// Запрос на удаление детали
+type DeletePartRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор детали
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePartRequest) Reset() {
+	*x = DeletePartRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePartRequest) ProtoMessage() {}
+
+func (x *DeletePartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePartRequest.ProtoReflect.Descriptor instead.
+func (*DeletePartRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DeletePartRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// Ответ на удаление детали
+type DeletePartResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePartResponse) Reset() {
+	*x = DeletePartResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePartResponse) ProtoMessage() {}
+
+func (x *DeletePartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePartResponse.ProtoReflect.Descriptor instead.
+func (*DeletePartResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{11}
+}
+
+// Запрос полнотекстового поиска деталей
+type SearchPartsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Поисковая строка. Ищется по названию, описанию, тегам и производителю
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Язык запроса. UNSPECIFIED — определяется по тексту запроса
+	Language SearchLanguage `protobuf:"varint,2,opt,name=language,proto3,enum=inventory.v1.SearchLanguage" json:"language,omitempty"`
+	// Дополнительный фильтр (категории, страны производителей и т.д.)
+	Filter *PartsFilter `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Максимальное количество результатов. 0 — значение по умолчанию (20), максимум 100
+	Limit         int32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPartsRequest) Reset() {
+	*x = SearchPartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPartsRequest) ProtoMessage() {}
+
+func (x *SearchPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPartsRequest.ProtoReflect.Descriptor instead.
+func (*SearchPartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *SearchPartsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchPartsRequest) GetLanguage() SearchLanguage {
+	if x != nil {
+		return x.Language
+	}
+	return SearchLanguage_SEARCH_LANGUAGE_UNSPECIFIED
+}
+
+func (x *SearchPartsRequest) GetFilter() *PartsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *SearchPartsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Ответ полнотекстового поиска, результаты отсортированы по убыванию релевантности
+type SearchPartsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Найденные детали
+	Hits          []*PartSearchHit `protobuf:"bytes,1,rep,name=hits,proto3" json:"hits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPartsResponse) Reset() {
+	*x = SearchPartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPartsResponse) ProtoMessage() {}
+
+func (x *SearchPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPartsResponse.ProtoReflect.Descriptor instead.
+func (*SearchPartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *SearchPartsResponse) GetHits() []*PartSearchHit {
+	if x != nil {
+		return x.Hits
+	}
+	return nil
+}
+
+// Найденная деталь с оценкой релевантности
+type PartSearchHit struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Деталь
+	Part *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	// Релевантность (text score MongoDB)
+	Score         float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartSearchHit) Reset() {
+	*x = PartSearchHit{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartSearchHit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartSearchHit) ProtoMessage() {}
+
+func (x *PartSearchHit) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartSearchHit.ProtoReflect.Descriptor instead.
+func (*PartSearchHit) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *PartSearchHit) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+func (x *PartSearchHit) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+// Запрос на приход детали на склад
+type ReceiveStockRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Количество поступивших единиц, больше нуля
+	Quantity int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Причина движения
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Ссылка на документ-основание (накладная, UUID заказа и т.п.)
+	Reference string `protobuf:"bytes,4,opt,name=reference,proto3" json:"reference,omitempty"`
+	// Склад поступления. Пусто — склад по умолчанию
+	WarehouseUuid string `protobuf:"bytes,5,opt,name=warehouse_uuid,json=warehouseUuid,proto3" json:"warehouse_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveStockRequest) Reset() {
+	*x = ReceiveStockRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveStockRequest) ProtoMessage() {}
+
+func (x *ReceiveStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveStockRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReceiveStockRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *ReceiveStockRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReceiveStockRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReceiveStockRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *ReceiveStockRequest) GetWarehouseUuid() string {
+	if x != nil {
+		return x.WarehouseUuid
+	}
+	return ""
+}
+
+// Ответ с записанным движением
+type ReceiveStockResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Движение остатка, stock_after — новый остаток детали
+	Movement      *StockMovement `protobuf:"bytes,1,opt,name=movement,proto3" json:"movement,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveStockResponse) Reset() {
+	*x = ReceiveStockResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveStockResponse) ProtoMessage() {}
+
+func (x *ReceiveStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveStockResponse.ProtoReflect.Descriptor instead.
+func (*ReceiveStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReceiveStockResponse) GetMovement() *StockMovement {
+	if x != nil {
+		return x.Movement
+	}
+	return nil
+}
+
+// Запрос истории движений остатка
+type ListStockMovementsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Размер страницы. 0 — размер по умолчанию (50), максимум 1000
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Токен следующей страницы из предыдущего ответа. Пусто — первая страница
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockMovementsRequest) Reset() {
+	*x = ListStockMovementsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockMovementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockMovementsRequest) ProtoMessage() {}
+
+func (x *ListStockMovementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockMovementsRequest.ProtoReflect.Descriptor instead.
+func (*ListStockMovementsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListStockMovementsRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *ListStockMovementsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListStockMovementsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// Ответ с историей движений остатка
+type ListStockMovementsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Движения, от новых к старым
+	Movements []*StockMovement `protobuf:"bytes,1,rep,name=movements,proto3" json:"movements,omitempty"`
+	// Токен следующей страницы. Пусто — страниц больше нет
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockMovementsResponse) Reset() {
+	*x = ListStockMovementsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockMovementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockMovementsResponse) ProtoMessage() {}
+
+func (x *ListStockMovementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockMovementsResponse.ProtoReflect.Descriptor instead.
+func (*ListStockMovementsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListStockMovementsResponse) GetMovements() []*StockMovement {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+func (x *ListStockMovementsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+// Неизменяемая запись об изменении остатка детали
+type StockMovement struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор движения
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Уникальный идентификатор детали
+	PartUuid string `protobuf:"bytes,2,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Тип движения
+	Type StockMovementType `protobuf:"varint,3,opt,name=type,proto3,enum=inventory.v1.StockMovementType" json:"type,omitempty"`
+	// Изменение остатка: положительное — приход, отрицательное — расход
+	Quantity int64 `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Причина движения
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Ссылка на документ-основание (накладная, UUID заказа и т.п.)
+	Reference string `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"`
+	// Остаток детали после движения (по всем складам)
+	StockAfter int64 `protobuf:"varint,7,opt,name=stock_after,json=stockAfter,proto3" json:"stock_after,omitempty"`
+	// Время движения
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Склад, остаток которого изменился
+	WarehouseUuid string `protobuf:"bytes,9,opt,name=warehouse_uuid,json=warehouseUuid,proto3" json:"warehouse_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockMovement) Reset() {
+	*x = StockMovement{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockMovement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockMovement) ProtoMessage() {}
+
+func (x *StockMovement) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockMovement.ProtoReflect.Descriptor instead.
+func (*StockMovement) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *StockMovement) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *StockMovement) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *StockMovement) GetType() StockMovementType {
+	if x != nil {
+		return x.Type
+	}
+	return StockMovementType_STOCK_MOVEMENT_TYPE_UNSPECIFIED
+}
+
+func (x *StockMovement) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StockMovement) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StockMovement) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *StockMovement) GetStockAfter() int64 {
+	if x != nil {
+		return x.StockAfter
+	}
+	return 0
+}
+
+func (x *StockMovement) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *StockMovement) GetWarehouseUuid() string {
+	if x != nil {
+		return x.WarehouseUuid
+	}
+	return ""
+}
+
+// Запрос на создание склада
+type CreateWarehouseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Склад. uuid генерируется, created_at игнорируется
+	Warehouse     *Warehouse `protobuf:"bytes,1,opt,name=warehouse,proto3" json:"warehouse,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWarehouseRequest) Reset() {
+	*x = CreateWarehouseRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWarehouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWarehouseRequest) ProtoMessage() {}
+
+func (x *CreateWarehouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*CreateWarehouseRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateWarehouseRequest) GetWarehouse() *Warehouse {
+	if x != nil {
+		return x.Warehouse
+	}
+	return nil
+}
+
+// Ответ с созданным складом
+type CreateWarehouseResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Созданный склад
+	Warehouse     *Warehouse `protobuf:"bytes,1,opt,name=warehouse,proto3" json:"warehouse,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWarehouseResponse) Reset() {
+	*x = CreateWarehouseResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWarehouseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWarehouseResponse) ProtoMessage() {}
+
+func (x *CreateWarehouseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWarehouseResponse.ProtoReflect.Descriptor instead.
+func (*CreateWarehouseResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *CreateWarehouseResponse) GetWarehouse() *Warehouse {
+	if x != nil {
+		return x.Warehouse
+	}
+	return nil
+}
+
+// Запрос списка складов
+type ListWarehousesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWarehousesRequest) Reset() {
+	*x = ListWarehousesRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWarehousesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWarehousesRequest) ProtoMessage() {}
+
+func (x *ListWarehousesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWarehousesRequest.ProtoReflect.Descriptor instead.
+func (*ListWarehousesRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{22}
+}
+
+// Ответ со списком складов
+type ListWarehousesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Склады, отсортированные по коду
+	Warehouses    []*Warehouse `protobuf:"bytes,1,rep,name=warehouses,proto3" json:"warehouses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWarehousesResponse) Reset() {
+	*x = ListWarehousesResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWarehousesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWarehousesResponse) ProtoMessage() {}
+
+func (x *ListWarehousesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWarehousesResponse.ProtoReflect.Descriptor instead.
+func (*ListWarehousesResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListWarehousesResponse) GetWarehouses() []*Warehouse {
+	if x != nil {
+		return x.Warehouses
+	}
+	return nil
+}
+
+// Запрос на перемещение остатка между складами
+type TransferStockRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Склад-отправитель
+	FromWarehouseUuid string `protobuf:"bytes,2,opt,name=from_warehouse_uuid,json=fromWarehouseUuid,proto3" json:"from_warehouse_uuid,omitempty"`
+	// Склад-получатель
+	ToWarehouseUuid string `protobuf:"bytes,3,opt,name=to_warehouse_uuid,json=toWarehouseUuid,proto3" json:"to_warehouse_uuid,omitempty"`
+	// Количество единиц, больше нуля
+	Quantity int64 `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Причина перемещения
+	Reason        string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferStockRequest) Reset() {
+	*x = TransferStockRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferStockRequest) ProtoMessage() {}
+
+func (x *TransferStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferStockRequest.ProtoReflect.Descriptor instead.
+func (*TransferStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *TransferStockRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *TransferStockRequest) GetFromWarehouseUuid() string {
+	if x != nil {
+		return x.FromWarehouseUuid
+	}
+	return ""
+}
+
+func (x *TransferStockRequest) GetToWarehouseUuid() string {
+	if x != nil {
+		return x.ToWarehouseUuid
+	}
+	return ""
+}
+
+func (x *TransferStockRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *TransferStockRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Ответ с движениями перемещения
+type TransferStockResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Расход со склада-отправителя
+	Outgoing *StockMovement `protobuf:"bytes,1,opt,name=outgoing,proto3" json:"outgoing,omitempty"`
+	// Приход на склад-получатель
+	Incoming      *StockMovement `protobuf:"bytes,2,opt,name=incoming,proto3" json:"incoming,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferStockResponse) Reset() {
+	*x = TransferStockResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferStockResponse) ProtoMessage() {}
+
+func (x *TransferStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferStockResponse.ProtoReflect.Descriptor instead.
+func (*TransferStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TransferStockResponse) GetOutgoing() *StockMovement {
+	if x != nil {
+		return x.Outgoing
+	}
+	return nil
+}
+
+func (x *TransferStockResponse) GetIncoming() *StockMovement {
+	if x != nil {
+		return x.Incoming
+	}
+	return nil
+}
+
+// Запрос остатков по складам
+type GetStockAvailabilityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID деталей
+	PartUuids     []string `protobuf:"bytes,1,rep,name=part_uuids,json=partUuids,proto3" json:"part_uuids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStockAvailabilityRequest) Reset() {
+	*x = GetStockAvailabilityRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockAvailabilityRequest) ProtoMessage() {}
+
+func (x *GetStockAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*GetStockAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetStockAvailabilityRequest) GetPartUuids() []string {
+	if x != nil {
+		return x.PartUuids
+	}
+	return nil
+}
+
+// Ответ с остатками по складам
+type GetStockAvailabilityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Остатки в порядке part_uuids запроса
+	Availability  []*PartAvailability `protobuf:"bytes,1,rep,name=availability,proto3" json:"availability,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStockAvailabilityResponse) Reset() {
+	*x = GetStockAvailabilityResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockAvailabilityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockAvailabilityResponse) ProtoMessage() {}
+
+func (x *GetStockAvailabilityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockAvailabilityResponse.ProtoReflect.Descriptor instead.
+func (*GetStockAvailabilityResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetStockAvailabilityResponse) GetAvailability() []*PartAvailability {
+	if x != nil {
+		return x.Availability
+	}
+	return nil
+}
+
+// Остатки детали по складам
+type PartAvailability struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Общий остаток по всем складам
+	StockQuantity int64 `protobuf:"varint,2,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
+	// Остатки по складам
+	Locations     []*StockLocation `protobuf:"bytes,3,rep,name=locations,proto3" json:"locations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartAvailability) Reset() {
+	*x = PartAvailability{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartAvailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartAvailability) ProtoMessage() {}
+
+func (x *PartAvailability) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartAvailability.ProtoReflect.Descriptor instead.
+func (*PartAvailability) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PartAvailability) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *PartAvailability) GetStockQuantity() int64 {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return 0
+}
+
+func (x *PartAvailability) GetLocations() []*StockLocation {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+// Запрос на резервирование деталей
+type ReserveStockRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Документ-основание резерва, например UUID заказа
+	Reference string `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	// Резервируемые детали
+	Items         []*ReservationItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveStockRequest) Reset() {
+	*x = ReserveStockRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStockRequest) ProtoMessage() {}
+
+func (x *ReserveStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveStockRequest.ProtoReflect.Descriptor instead.
+func (*ReserveStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ReserveStockRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *ReserveStockRequest) GetItems() []*ReservationItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// Резервируемая деталь
+type ReservationItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Количество единиц, больше нуля
+	Quantity      int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReservationItem) Reset() {
+	*x = ReservationItem{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReservationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReservationItem) ProtoMessage() {}
+
+func (x *ReservationItem) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReservationItem.ProtoReflect.Descriptor instead.
+func (*ReservationItem) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ReservationItem) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *ReservationItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Ответ с движениями резерва
+type ReserveStockResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Движения RESERVATION по складам
+	Movements     []*StockMovement `protobuf:"bytes,1,rep,name=movements,proto3" json:"movements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveStockResponse) Reset() {
+	*x = ReserveStockResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStockResponse) ProtoMessage() {}
+
+func (x *ReserveStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveStockResponse.ProtoReflect.Descriptor instead.
+func (*ReserveStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ReserveStockResponse) GetMovements() []*StockMovement {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+// Запрос на снятие резерва
+type ReleaseStockRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Документ-основание резерва
+	Reference     string `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseStockRequest) Reset() {
+	*x = ReleaseStockRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseStockRequest) ProtoMessage() {}
+
+func (x *ReleaseStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseStockRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ReleaseStockRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+// Ответ с движениями снятия резерва
+type ReleaseStockResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Движения RELEASE. Пусто — резерва не было или он уже снят
+	Movements     []*StockMovement `protobuf:"bytes,1,rep,name=movements,proto3" json:"movements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseStockResponse) Reset() {
+	*x = ReleaseStockResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseStockResponse) ProtoMessage() {}
+
+func (x *ReleaseStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseStockResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ReleaseStockResponse) GetMovements() []*StockMovement {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+// Запрос на расход зарезервированных деталей
+type ConsumeStockRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Документ-основание резерва
+	Reference     string `protobuf:"bytes,1,opt,name=reference,proto3" json:"reference,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumeStockRequest) Reset() {
+	*x = ConsumeStockRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumeStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumeStockRequest) ProtoMessage() {}
+
+func (x *ConsumeStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumeStockRequest.ProtoReflect.Descriptor instead.
+func (*ConsumeStockRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ConsumeStockRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+// Ответ с движениями расхода
+type ConsumeStockResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Движения CONSUMPTION. Пусто — резерва не было или он уже снят либо израсходован
+	Movements     []*StockMovement `protobuf:"bytes,1,rep,name=movements,proto3" json:"movements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumeStockResponse) Reset() {
+	*x = ConsumeStockResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumeStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumeStockResponse) ProtoMessage() {}
+
+func (x *ConsumeStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumeStockResponse.ProtoReflect.Descriptor instead.
+func (*ConsumeStockResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ConsumeStockResponse) GetMovements() []*StockMovement {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+// Склад, на котором хранятся детали
+type Warehouse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор склада
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Уникальный код склада, например BAIKONUR
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// Название склада
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Широта в градусах
+	Latitude float64 `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Долгота в градусах
+	Longitude float64 `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Дата создания записи
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Warehouse) Reset() {
+	*x = Warehouse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Warehouse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Warehouse) ProtoMessage() {}
+
+func (x *Warehouse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Warehouse.ProtoReflect.Descriptor instead.
+func (*Warehouse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *Warehouse) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Warehouse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *Warehouse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Warehouse) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Warehouse) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Warehouse) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Остаток детали на складе
+type StockLocation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор склада
+	WarehouseUuid string `protobuf:"bytes,1,opt,name=warehouse_uuid,json=warehouseUuid,proto3" json:"warehouse_uuid,omitempty"`
+	// Количество единиц на складе
+	Quantity      int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockLocation) Reset() {
+	*x = StockLocation{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockLocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockLocation) ProtoMessage() {}
+
+func (x *StockLocation) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockLocation.ProtoReflect.Descriptor instead.
+func (*StockLocation) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *StockLocation) GetWarehouseUuid() string {
+	if x != nil {
+		return x.WarehouseUuid
+	}
+	return ""
+}
+
+func (x *StockLocation) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Запрос на создание правила совместимости
+type CreateCompatibilityRuleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Правило. uuid генерируется, категория цели-детали подставляется из каталога
+	Rule          *CompatibilityRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCompatibilityRuleRequest) Reset() {
+	*x = CreateCompatibilityRuleRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCompatibilityRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompatibilityRuleRequest) ProtoMessage() {}
+
+func (x *CreateCompatibilityRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCompatibilityRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreateCompatibilityRuleRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateCompatibilityRuleRequest) GetRule() *CompatibilityRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// Ответ с созданным правилом
+type CreateCompatibilityRuleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Созданное правило
+	Rule          *CompatibilityRule `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCompatibilityRuleResponse) Reset() {
+	*x = CreateCompatibilityRuleResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCompatibilityRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCompatibilityRuleResponse) ProtoMessage() {}
+
+func (x *CreateCompatibilityRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCompatibilityRuleResponse.ProtoReflect.Descriptor instead.
+func (*CreateCompatibilityRuleResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateCompatibilityRuleResponse) GetRule() *CompatibilityRule {
+	if x != nil {
+		return x.Rule
+	}
+	return nil
+}
+
+// Запрос на удаление правила совместимости
+type DeleteCompatibilityRuleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор правила
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCompatibilityRuleRequest) Reset() {
+	*x = DeleteCompatibilityRuleRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCompatibilityRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCompatibilityRuleRequest) ProtoMessage() {}
+
+func (x *DeleteCompatibilityRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCompatibilityRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCompatibilityRuleRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DeleteCompatibilityRuleRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// Ответ на удаление правила совместимости
+type DeleteCompatibilityRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCompatibilityRuleResponse) Reset() {
+	*x = DeleteCompatibilityRuleResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCompatibilityRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCompatibilityRuleResponse) ProtoMessage() {}
+
+func (x *DeleteCompatibilityRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCompatibilityRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCompatibilityRuleResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{41}
+}
+
+// Запрос списка правил совместимости
+type ListCompatibilityRulesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompatibilityRulesRequest) Reset() {
+	*x = ListCompatibilityRulesRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompatibilityRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompatibilityRulesRequest) ProtoMessage() {}
+
+func (x *ListCompatibilityRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompatibilityRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListCompatibilityRulesRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{42}
+}
+
+// Ответ со списком правил совместимости
+type ListCompatibilityRulesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Правила совместимости
+	Rules         []*CompatibilityRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompatibilityRulesResponse) Reset() {
+	*x = ListCompatibilityRulesResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompatibilityRulesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompatibilityRulesResponse) ProtoMessage() {}
+
+func (x *ListCompatibilityRulesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompatibilityRulesResponse.ProtoReflect.Descriptor instead.
+func (*ListCompatibilityRulesResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListCompatibilityRulesResponse) GetRules() []*CompatibilityRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// Запрос проверки конфигурации ракеты
+type ValidateConfigurationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID деталей конфигурации
+	PartUuids     []string `protobuf:"bytes,1,rep,name=part_uuids,json=partUuids,proto3" json:"part_uuids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateConfigurationRequest) Reset() {
+	*x = ValidateConfigurationRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateConfigurationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateConfigurationRequest) ProtoMessage() {}
+
+func (x *ValidateConfigurationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateConfigurationRequest.ProtoReflect.Descriptor instead.
+func (*ValidateConfigurationRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ValidateConfigurationRequest) GetPartUuids() []string {
+	if x != nil {
+		return x.PartUuids
+	}
+	return nil
+}
+
+// Результат проверки конфигурации
+type ValidateConfigurationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Конфигурация допустима
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// Нарушенные правила с пояснениями
+	Violations    []*ConfigurationViolation `protobuf:"bytes,2,rep,name=violations,proto3" json:"violations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ValidateConfigurationResponse) Reset() {
+	*x = ValidateConfigurationResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidateConfigurationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidateConfigurationResponse) ProtoMessage() {}
+
+func (x *ValidateConfigurationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidateConfigurationResponse.ProtoReflect.Descriptor instead.
+func (*ValidateConfigurationResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ValidateConfigurationResponse) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *ValidateConfigurationResponse) GetViolations() []*ConfigurationViolation {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
+}
+
+// Часть файла импорта каталога
+type ImportPartsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Формат файла, учитывается в первом сообщении
+	Format CatalogFormat `protobuf:"varint,1,opt,name=format,proto3,enum=inventory.v1.CatalogFormat" json:"format,omitempty"`
+	// Только проверить строки без записи, учитывается в первом сообщении
+	DryRun bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Очередная часть файла
+	Chunk         []byte `protobuf:"bytes,3,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportPartsRequest) Reset() {
+	*x = ImportPartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportPartsRequest) ProtoMessage() {}
+
+func (x *ImportPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportPartsRequest.ProtoReflect.Descriptor instead.
+func (*ImportPartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ImportPartsRequest) GetFormat() CatalogFormat {
+	if x != nil {
+		return x.Format
+	}
+	return CatalogFormat_CATALOG_FORMAT_UNSPECIFIED
+}
+
+func (x *ImportPartsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportPartsRequest) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// Отчет об импорте каталога
+type ImportPartsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Импорт выполнен без записи
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// Количество прочитанных строк
+	Total int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// Количество новых деталей
+	Created int32 `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	// Количество обновленных деталей
+	Updated int32 `protobuf:"varint,4,opt,name=updated,proto3" json:"updated,omitempty"`
+	// Количество строк с ошибками
+	Failed int32 `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	// Ошибки по строкам
+	Errors        []*ImportRowError `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportPartsResponse) Reset() {
+	*x = ImportPartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportPartsResponse) ProtoMessage() {}
+
+func (x *ImportPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportPartsResponse.ProtoReflect.Descriptor instead.
+func (*ImportPartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ImportPartsResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportPartsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImportPartsResponse) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportPartsResponse) GetUpdated() int32 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *ImportPartsResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *ImportPartsResponse) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+// Ошибка строки импорта
+type ImportRowError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Номер записи в файле, начиная с 1 (без заголовка CSV)
+	Row int32 `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	// UUID детали, если его удалось прочитать
+	PartUuid string `protobuf:"bytes,2,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Описание ошибки
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ImportRowError) GetRow() int32 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *ImportRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Запрос на выгрузку каталога
+type ExportPartsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Формат файла
+	Format CatalogFormat `protobuf:"varint,1,opt,name=format,proto3,enum=inventory.v1.CatalogFormat" json:"format,omitempty"`
+	// Фильтр деталей, пусто — весь каталог
+	Filter        *PartsFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportPartsRequest) Reset() {
+	*x = ExportPartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPartsRequest) ProtoMessage() {}
+
+func (x *ExportPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPartsRequest.ProtoReflect.Descriptor instead.
+func (*ExportPartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ExportPartsRequest) GetFormat() CatalogFormat {
+	if x != nil {
+		return x.Format
+	}
+	return CatalogFormat_CATALOG_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportPartsRequest) GetFilter() *PartsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// Часть файла выгрузки каталога
+type ExportPartsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Очередная часть файла
+	Chunk         []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportPartsResponse) Reset() {
+	*x = ExportPartsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportPartsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportPartsResponse) ProtoMessage() {}
+
+func (x *ExportPartsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportPartsResponse.ProtoReflect.Descriptor instead.
+func (*ExportPartsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ExportPartsResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// Вложение детали. Содержимое хранится в GridFS и скачивается через DownloadAttachment
+type Attachment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор вложения
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// UUID детали
+	PartUuid string `protobuf:"bytes,2,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Тип вложения
+	Kind AttachmentKind `protobuf:"varint,3,opt,name=kind,proto3,enum=inventory.v1.AttachmentKind" json:"kind,omitempty"`
+	// Имя файла
+	FileName string `protobuf:"bytes,4,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// MIME-тип содержимого
+	ContentType string `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Размер в байтах
+	Size int64 `protobuf:"varint,6,opt,name=size,proto3" json:"size,omitempty"`
+	// SHA-256 содержимого в hex
+	Sha256 string `protobuf:"bytes,7,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	// Основное изображение детали
+	Primary bool `protobuf:"varint,8,opt,name=primary,proto3" json:"primary,omitempty"`
+	// Дата загрузки
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Attachment) Reset() {
+	*x = Attachment{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Attachment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attachment) ProtoMessage() {}
+
+func (x *Attachment) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attachment.ProtoReflect.Descriptor instead.
+func (*Attachment) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Attachment) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *Attachment) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *Attachment) GetKind() AttachmentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AttachmentKind_ATTACHMENT_KIND_UNSPECIFIED
+}
+
+func (x *Attachment) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *Attachment) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *Attachment) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *Attachment) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *Attachment) GetPrimary() bool {
+	if x != nil {
+		return x.Primary
+	}
+	return false
+}
+
+func (x *Attachment) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Описание загружаемого файла
+type AttachmentUpload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Тип вложения
+	Kind AttachmentKind `protobuf:"varint,2,opt,name=kind,proto3,enum=inventory.v1.AttachmentKind" json:"kind,omitempty"`
+	// Имя файла
+	FileName string `protobuf:"bytes,3,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	// MIME-тип содержимого, должен совпадать с сигнатурой файла
+	ContentType string `protobuf:"bytes,4,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	// Сделать изображение основным для детали
+	Primary bool `protobuf:"varint,5,opt,name=primary,proto3" json:"primary,omitempty"`
+	// Ожидаемый SHA-256 содержимого в hex. Пусто — не проверяется
+	Sha256        string `protobuf:"bytes,6,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttachmentUpload) Reset() {
+	*x = AttachmentUpload{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttachmentUpload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttachmentUpload) ProtoMessage() {}
+
+func (x *AttachmentUpload) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttachmentUpload.ProtoReflect.Descriptor instead.
+func (*AttachmentUpload) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *AttachmentUpload) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *AttachmentUpload) GetKind() AttachmentKind {
+	if x != nil {
+		return x.Kind
+	}
+	return AttachmentKind_ATTACHMENT_KIND_UNSPECIFIED
+}
+
+func (x *AttachmentUpload) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *AttachmentUpload) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *AttachmentUpload) GetPrimary() bool {
+	if x != nil {
+		return x.Primary
+	}
+	return false
+}
+
+func (x *AttachmentUpload) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+// Часть загружаемого вложения
+type UploadAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Описание файла, учитывается в первом сообщении
+	Upload *AttachmentUpload `protobuf:"bytes,1,opt,name=upload,proto3" json:"upload,omitempty"`
+	// Очередная часть файла
+	Chunk         []byte `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentRequest) Reset() {
+	*x = UploadAttachmentRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentRequest) ProtoMessage() {}
+
+func (x *UploadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UploadAttachmentRequest) GetUpload() *AttachmentUpload {
+	if x != nil {
+		return x.Upload
+	}
+	return nil
+}
+
+func (x *UploadAttachmentRequest) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// Ответ на загрузку вложения
+type UploadAttachmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Сохраненное вложение
+	Attachment    *Attachment `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadAttachmentResponse) Reset() {
+	*x = UploadAttachmentResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadAttachmentResponse) ProtoMessage() {}
+
+func (x *UploadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*UploadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UploadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+// Запрос на скачивание вложения
+type DownloadAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID вложения
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentRequest) Reset() {
+	*x = DownloadAttachmentRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentRequest) ProtoMessage() {}
+
+func (x *DownloadAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *DownloadAttachmentRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// Часть скачиваемого вложения
+type DownloadAttachmentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Описание файла, только в первом сообщении
+	Attachment *Attachment `protobuf:"bytes,1,opt,name=attachment,proto3" json:"attachment,omitempty"`
+	// Очередная часть файла
+	Chunk         []byte `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadAttachmentResponse) Reset() {
+	*x = DownloadAttachmentResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadAttachmentResponse) ProtoMessage() {}
+
+func (x *DownloadAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DownloadAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *DownloadAttachmentResponse) GetAttachment() *Attachment {
+	if x != nil {
+		return x.Attachment
+	}
+	return nil
+}
+
+func (x *DownloadAttachmentResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+// Запрос на удаление вложения
+type DeleteAttachmentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID вложения
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentRequest) Reset() {
+	*x = DeleteAttachmentRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentRequest) ProtoMessage() {}
+
+func (x *DeleteAttachmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *DeleteAttachmentRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+// Ответ на удаление вложения
+type DeleteAttachmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttachmentResponse) Reset() {
+	*x = DeleteAttachmentResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttachmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttachmentResponse) ProtoMessage() {}
+
+func (x *DeleteAttachmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttachmentResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAttachmentResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{58}
+}
+
+// Запрос на планирование цены детали
+type SchedulePartPriceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Новая цена, больше нуля
+	Price float64 `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	// Момент вступления цены в силу, должен быть в будущем
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	// Причина изменения цены
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePartPriceRequest) Reset() {
+	*x = SchedulePartPriceRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePartPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePartPriceRequest) ProtoMessage() {}
+
+func (x *SchedulePartPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePartPriceRequest.ProtoReflect.Descriptor instead.
+func (*SchedulePartPriceRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SchedulePartPriceRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *SchedulePartPriceRequest) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *SchedulePartPriceRequest) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *SchedulePartPriceRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Ответ с запланированным изменением цены
+type SchedulePartPriceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Запись истории цен
+	PriceChange   *PriceChange `protobuf:"bytes,1,opt,name=price_change,json=priceChange,proto3" json:"price_change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePartPriceResponse) Reset() {
+	*x = SchedulePartPriceResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePartPriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePartPriceResponse) ProtoMessage() {}
+
+func (x *SchedulePartPriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePartPriceResponse.ProtoReflect.Descriptor instead.
+func (*SchedulePartPriceResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SchedulePartPriceResponse) GetPriceChange() *PriceChange {
+	if x != nil {
+		return x.PriceChange
+	}
+	return nil
+}
+
+// Запрос истории цен детали
+type ListPriceHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор детали
+	PartUuid      string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPriceHistoryRequest) Reset() {
+	*x = ListPriceHistoryRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPriceHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceHistoryRequest) ProtoMessage() {}
+
+func (x *ListPriceHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListPriceHistoryRequest) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+// Ответ с историей цен детали
+type ListPriceHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Изменения цены, от поздних к ранним по effective_from
+	PriceChanges  []*PriceChange `protobuf:"bytes,1,rep,name=price_changes,json=priceChanges,proto3" json:"price_changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPriceHistoryResponse) Reset() {
+	*x = ListPriceHistoryResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPriceHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPriceHistoryResponse) ProtoMessage() {}
+
+func (x *ListPriceHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPriceHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ListPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListPriceHistoryResponse) GetPriceChanges() []*PriceChange {
+	if x != nil {
+		return x.PriceChanges
+	}
+	return nil
+}
+
+// Запрос цен деталей на момент времени
+type GetPartPricesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID деталей
+	PartUuids []string `protobuf:"bytes,1,rep,name=part_uuids,json=partUuids,proto3" json:"part_uuids,omitempty"`
+	// Момент, на который нужны цены. Не задан — текущий момент
+	At            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=at,proto3" json:"at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPartPricesRequest) Reset() {
+	*x = GetPartPricesRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPartPricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartPricesRequest) ProtoMessage() {}
+
+func (x *GetPartPricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartPricesRequest.ProtoReflect.Descriptor instead.
+func (*GetPartPricesRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetPartPricesRequest) GetPartUuids() []string {
+	if x != nil {
+		return x.PartUuids
+	}
+	return nil
+}
+
+func (x *GetPartPricesRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+// Ответ с ценами деталей
+type GetPartPricesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Цены в порядке part_uuids запроса
+	Prices        []*PartPrice `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPartPricesResponse) Reset() {
+	*x = GetPartPricesResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPartPricesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPartPricesResponse) ProtoMessage() {}
+
+func (x *GetPartPricesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPartPricesResponse.ProtoReflect.Descriptor instead.
+func (*GetPartPricesResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetPartPricesResponse) GetPrices() []*PartPrice {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+// Цена детали на момент времени
+type PartPrice struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Действующая цена
+	Price float64 `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	// С какого момента действует цена. Не задан — цена из карточки детали без истории
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartPrice) Reset() {
+	*x = PartPrice{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartPrice) ProtoMessage() {}
+
+func (x *PartPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartPrice.ProtoReflect.Descriptor instead.
+func (*PartPrice) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *PartPrice) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *PartPrice) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PartPrice) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+// Запись истории цен детали
+type PriceChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор записи
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Уникальный идентификатор детали
+	PartUuid string `protobuf:"bytes,2,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Цена
+	Price float64 `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	// Момент вступления цены в силу
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=effective_from,json=effectiveFrom,proto3" json:"effective_from,omitempty"`
+	// Причина изменения цены
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Цена уже записана в карточку детали
+	Applied bool `protobuf:"varint,6,opt,name=applied,proto3" json:"applied,omitempty"`
+	// Время создания записи
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceChange) Reset() {
+	*x = PriceChange{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceChange) ProtoMessage() {}
+
+func (x *PriceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceChange.ProtoReflect.Descriptor instead.
+func (*PriceChange) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *PriceChange) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *PriceChange) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *PriceChange) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *PriceChange) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *PriceChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PriceChange) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *PriceChange) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Запрос подписки на изменения деталей
+type WatchPartsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Фильтр деталей, пусто — весь каталог
+	Filter        *PartsFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPartsRequest) Reset() {
+	*x = WatchPartsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPartsRequest) ProtoMessage() {}
+
+func (x *WatchPartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPartsRequest.ProtoReflect.Descriptor instead.
+func (*WatchPartsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *WatchPartsRequest) GetFilter() *PartsFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+// Событие подписки на детали
+type PartEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Тип события
+	Type PartEventType `protobuf:"varint,1,opt,name=type,proto3,enum=inventory.v1.PartEventType" json:"type,omitempty"`
+	// Уникальный идентификатор детали. Пусто для SNAPSHOT_COMPLETE
+	PartUuid string `protobuf:"bytes,2,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Состояние детали. Не задано для DELETED и SNAPSHOT_COMPLETE
+	Part *Part `protobuf:"bytes,3,opt,name=part,proto3" json:"part,omitempty"`
+	// Время изменения. Не задано для событий снимка
+	OccurredAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartEvent) Reset() {
+	*x = PartEvent{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartEvent) ProtoMessage() {}
+
+func (x *PartEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartEvent.ProtoReflect.Descriptor instead.
+func (*PartEvent) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *PartEvent) GetType() PartEventType {
+	if x != nil {
+		return x.Type
+	}
+	return PartEventType_PART_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *PartEvent) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *PartEvent) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+func (x *PartEvent) GetOccurredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return nil
+}
+
+// Запрос на создание категории
+type CreateCategoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Категория. created_at и updated_at игнорируются
+	Category      *PartCategory `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryRequest) Reset() {
+	*x = CreateCategoryRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryRequest) ProtoMessage() {}
+
+func (x *CreateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*CreateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CreateCategoryRequest) GetCategory() *PartCategory {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// Ответ с созданной категорией
+type CreateCategoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Созданная категория
+	Category      *PartCategory `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCategoryResponse) Reset() {
+	*x = CreateCategoryResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCategoryResponse) ProtoMessage() {}
+
+func (x *CreateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*CreateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CreateCategoryResponse) GetCategory() *PartCategory {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// Запрос на изменение категории
+type UpdateCategoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Новые значения полей, категория ищется по category.code
+	Category *PartCategory `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	// Обновляемые поля (display_name, description, parent_code, metadata_schema).
+	// Пустая маска обновляет все поля
+	UpdateMask    *fieldmaskpb.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *UpdateCategoryRequest) GetCategory() *PartCategory {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *UpdateCategoryRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+// Ответ с измененной категорией
+type UpdateCategoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Категория после изменения
+	Category      *PartCategory `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryResponse) Reset() {
+	*x = UpdateCategoryResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryResponse) ProtoMessage() {}
+
+func (x *UpdateCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *UpdateCategoryResponse) GetCategory() *PartCategory {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+// Запрос на удаление категории
+type DeleteCategoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Код категории
+	Code          string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *DeleteCategoryRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// Ответ на удаление категории
+type DeleteCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryResponse) Reset() {
+	*x = DeleteCategoryResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryResponse) ProtoMessage() {}
+
+func (x *DeleteCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{74}
+}
+
+// Запрос списка категорий
+type ListCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesRequest) Reset() {
+	*x = ListCategoriesRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesRequest) ProtoMessage() {}
+
+func (x *ListCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{75}
+}
+
+// Ответ со списком категорий
+type ListCategoriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Категории, родительская раньше подкатегорий
+	Categories    []*PartCategory `protobuf:"bytes,1,rep,name=categories,proto3" json:"categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCategoriesResponse) Reset() {
+	*x = ListCategoriesResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCategoriesResponse) ProtoMessage() {}
+
+func (x *ListCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListCategoriesResponse) GetCategories() []*PartCategory {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+// Запрос схемы метаданных категории
+type GetCategorySchemaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Код категории
+	Code          string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategorySchemaRequest) Reset() {
+	*x = GetCategorySchemaRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategorySchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategorySchemaRequest) ProtoMessage() {}
+
+func (x *GetCategorySchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategorySchemaRequest.ProtoReflect.Descriptor instead.
+func (*GetCategorySchemaRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetCategorySchemaRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+// Ответ со схемой метаданных категории
+type GetCategorySchemaResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Категория
+	Category *PartCategory `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	// Действующая схема: поля родительских категорий, затем собственные.
+	// Собственное поле заменяет унаследованное с тем же ключом
+	Fields        []*MetadataField `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategorySchemaResponse) Reset() {
+	*x = GetCategorySchemaResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategorySchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategorySchemaResponse) ProtoMessage() {}
+
+func (x *GetCategorySchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategorySchemaResponse.ProtoReflect.Descriptor instead.
+func (*GetCategorySchemaResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetCategorySchemaResponse) GetCategory() *PartCategory {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *GetCategorySchemaResponse) GetFields() []*MetadataField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+// Запрос на создание модели ракеты
+type CreateRocketModelRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Модель. uuid генерируется, created_at игнорируется
+	Model         *RocketModel `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRocketModelRequest) Reset() {
+	*x = CreateRocketModelRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRocketModelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRocketModelRequest) ProtoMessage() {}
+
+func (x *CreateRocketModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRocketModelRequest.ProtoReflect.Descriptor instead.
+func (*CreateRocketModelRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *CreateRocketModelRequest) GetModel() *RocketModel {
+	if x != nil {
+		return x.Model
+	}
+	return nil
+}
+
+// Ответ с созданной моделью ракеты
+type CreateRocketModelResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Созданная модель
+	Model         *RocketModel `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRocketModelResponse) Reset() {
+	*x = CreateRocketModelResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRocketModelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRocketModelResponse) ProtoMessage() {}
+
+func (x *CreateRocketModelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRocketModelResponse.ProtoReflect.Descriptor instead.
+func (*CreateRocketModelResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *CreateRocketModelResponse) GetModel() *RocketModel {
+	if x != nil {
+		return x.Model
+	}
+	return nil
+}
+
+// Запрос списка моделей ракет
+type ListRocketModelsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRocketModelsRequest) Reset() {
+	*x = ListRocketModelsRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRocketModelsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRocketModelsRequest) ProtoMessage() {}
+
+func (x *ListRocketModelsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRocketModelsRequest.ProtoReflect.Descriptor instead.
+func (*ListRocketModelsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{81}
+}
+
+// Ответ со списком моделей ракет
+type ListRocketModelsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Модели с ценой и доступностью одной ракеты
+	Models        []*RocketModelSummary `protobuf:"bytes,1,rep,name=models,proto3" json:"models,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRocketModelsResponse) Reset() {
+	*x = ListRocketModelsResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRocketModelsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRocketModelsResponse) ProtoMessage() {}
+
+func (x *ListRocketModelsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRocketModelsResponse.ProtoReflect.Descriptor instead.
+func (*ListRocketModelsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListRocketModelsResponse) GetModels() []*RocketModelSummary {
+	if x != nil {
+		return x.Models
+	}
+	return nil
+}
+
+// Запрос раскладки модели ракеты на детали
+type ExpandRocketModelRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор модели
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Количество ракет. 0 — одна ракета
+	Quantity      int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpandRocketModelRequest) Reset() {
+	*x = ExpandRocketModelRequest{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpandRocketModelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpandRocketModelRequest) ProtoMessage() {}
+
+func (x *ExpandRocketModelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpandRocketModelRequest.ProtoReflect.Descriptor instead.
+func (*ExpandRocketModelRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ExpandRocketModelRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ExpandRocketModelRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// Модель ракеты, разложенная на детали
+type ExpandRocketModelResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Модель ракеты
+	Model *RocketModel `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	// Строки спецификации с выбранными деталями
+	Lines []*BomLine `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	// Стоимость всех строк
+	TotalPrice float64 `protobuf:"fixed64,3,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	// Все строки обеспечены остатком
+	Available     bool `protobuf:"varint,4,opt,name=available,proto3" json:"available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExpandRocketModelResponse) Reset() {
+	*x = ExpandRocketModelResponse{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExpandRocketModelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExpandRocketModelResponse) ProtoMessage() {}
+
+func (x *ExpandRocketModelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExpandRocketModelResponse.ProtoReflect.Descriptor instead.
+func (*ExpandRocketModelResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ExpandRocketModelResponse) GetModel() *RocketModel {
+	if x != nil {
+		return x.Model
+	}
+	return nil
+}
+
+func (x *ExpandRocketModelResponse) GetLines() []*BomLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *ExpandRocketModelResponse) GetTotalPrice() float64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+func (x *ExpandRocketModelResponse) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+// Модель ракеты — именованная спецификация деталей
+type RocketModel struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор модели
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Название модели
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Описание модели
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Строки спецификации
+	Items []*BomItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	// Дата создания модели
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RocketModel) Reset() {
+	*x = RocketModel{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RocketModel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RocketModel) ProtoMessage() {}
+
+func (x *RocketModel) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RocketModel.ProtoReflect.Descriptor instead.
+func (*RocketModel) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *RocketModel) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *RocketModel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RocketModel) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RocketModel) GetItems() []*BomItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *RocketModel) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Строка спецификации модели ракеты
+type BomItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор основной детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Количество деталей на одну ракету, больше нуля
+	Quantity int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Детали, которыми можно заменить основную, в порядке предпочтения
+	AlternativePartUuids []string `protobuf:"bytes,3,rep,name=alternative_part_uuids,json=alternativePartUuids,proto3" json:"alternative_part_uuids,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *BomItem) Reset() {
+	*x = BomItem{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BomItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BomItem) ProtoMessage() {}
+
+func (x *BomItem) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BomItem.ProtoReflect.Descriptor instead.
+func (*BomItem) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *BomItem) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *BomItem) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *BomItem) GetAlternativePartUuids() []string {
+	if x != nil {
+		return x.AlternativePartUuids
+	}
+	return nil
+}
+
+// Модель ракеты с рассчитанными ценой и доступностью
+type RocketModelSummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Модель ракеты
+	Model *RocketModel `protobuf:"bytes,1,opt,name=model,proto3" json:"model,omitempty"`
+	// Стоимость одной ракеты
+	Price float64 `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	// Одну ракету можно собрать из текущих остатков
+	Available     bool `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RocketModelSummary) Reset() {
+	*x = RocketModelSummary{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RocketModelSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RocketModelSummary) ProtoMessage() {}
+
+func (x *RocketModelSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RocketModelSummary.ProtoReflect.Descriptor instead.
+func (*RocketModelSummary) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *RocketModelSummary) GetModel() *RocketModel {
+	if x != nil {
+		return x.Model
+	}
+	return nil
+}
+
+func (x *RocketModelSummary) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *RocketModelSummary) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+// Строка раскладки модели на детали
+type BomLine struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Выбранная деталь
+	Part *Part `protobuf:"bytes,1,opt,name=part,proto3" json:"part,omitempty"`
+	// Количество деталей с учетом количества ракет
+	Quantity int64 `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Остатка выбранной детали хватает
+	Available bool `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`
+	// Выбрана альтернатива вместо основной детали
+	Alternative   bool `protobuf:"varint,4,opt,name=alternative,proto3" json:"alternative,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BomLine) Reset() {
+	*x = BomLine{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BomLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BomLine) ProtoMessage() {}
+
+func (x *BomLine) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BomLine.ProtoReflect.Descriptor instead.
+func (*BomLine) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *BomLine) GetPart() *Part {
+	if x != nil {
+		return x.Part
+	}
+	return nil
+}
+
+func (x *BomLine) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *BomLine) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+func (x *BomLine) GetAlternative() bool {
+	if x != nil {
+		return x.Alternative
+	}
+	return false
+}
+
+// Правило совместимости между деталями и категориями
+type CompatibilityRule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор правила
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Тип правила
+	Type CompatibilityRuleType `protobuf:"varint,2,opt,name=type,proto3,enum=inventory.v1.CompatibilityRuleType" json:"type,omitempty"`
+	// К кому применяется правило. Пустая цель у REQUIRES — правило для любой конфигурации
+	Subject *RuleTarget `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	// Что требуется, исключается или разрешается
+	Object *RuleTarget `protobuf:"bytes,4,opt,name=object,proto3" json:"object,omitempty"`
+	// Пояснение, которое показывается при нарушении правила
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	// Дата создания правила
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompatibilityRule) Reset() {
+	*x = CompatibilityRule{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompatibilityRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompatibilityRule) ProtoMessage() {}
+
+func (x *CompatibilityRule) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompatibilityRule.ProtoReflect.Descriptor instead.
+func (*CompatibilityRule) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *CompatibilityRule) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *CompatibilityRule) GetType() CompatibilityRuleType {
+	if x != nil {
+		return x.Type
+	}
+	return CompatibilityRuleType_COMPATIBILITY_RULE_TYPE_UNSPECIFIED
+}
+
+func (x *CompatibilityRule) GetSubject() *RuleTarget {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *CompatibilityRule) GetObject() *RuleTarget {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
+func (x *CompatibilityRule) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CompatibilityRule) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Цель правила: конкретная деталь или вся категория
+type RuleTarget struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор детали. Пусто — любая деталь категории
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Категория детали
+	Category Category `protobuf:"varint,2,opt,name=category,proto3,enum=inventory.v1.Category" json:"category,omitempty"`
+	// Код категории из справочника, имеет приоритет над category
+	CategoryCode  string `protobuf:"bytes,3,opt,name=category_code,json=categoryCode,proto3" json:"category_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RuleTarget) Reset() {
+	*x = RuleTarget{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleTarget) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleTarget) ProtoMessage() {}
+
+func (x *RuleTarget) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleTarget.ProtoReflect.Descriptor instead.
+func (*RuleTarget) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *RuleTarget) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *RuleTarget) GetCategory() Category {
+	if x != nil {
+		return x.Category
+	}
+	return Category_CATEGORY_UNSPECIFIED
+}
+
+func (x *RuleTarget) GetCategoryCode() string {
+	if x != nil {
+		return x.CategoryCode
+	}
+	return ""
+}
+
+// Нарушение правила совместимости
+type ConfigurationViolation struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный идентификатор нарушенного правила
+	RuleUuid string `protobuf:"bytes,1,opt,name=rule_uuid,json=ruleUuid,proto3" json:"rule_uuid,omitempty"`
+	// Тип нарушенного правила
+	Type CompatibilityRuleType `protobuf:"varint,2,opt,name=type,proto3,enum=inventory.v1.CompatibilityRuleType" json:"type,omitempty"`
+	// Детали конфигурации, вызвавшие нарушение
+	PartUuids []string `protobuf:"bytes,3,rep,name=part_uuids,json=partUuids,proto3" json:"part_uuids,omitempty"`
+	// Пояснение нарушения
+	Message       string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfigurationViolation) Reset() {
+	*x = ConfigurationViolation{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfigurationViolation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigurationViolation) ProtoMessage() {}
+
+func (x *ConfigurationViolation) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigurationViolation.ProtoReflect.Descriptor instead.
+func (*ConfigurationViolation) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *ConfigurationViolation) GetRuleUuid() string {
+	if x != nil {
+		return x.RuleUuid
+	}
+	return ""
+}
+
+func (x *ConfigurationViolation) GetType() CompatibilityRuleType {
+	if x != nil {
+		return x.Type
+	}
+	return CompatibilityRuleType_COMPATIBILITY_RULE_TYPE_UNSPECIFIED
+}
+
+func (x *ConfigurationViolation) GetPartUuids() []string {
+	if x != nil {
+		return x.PartUuids
+	}
+	return nil
+}
+
+func (x *ConfigurationViolation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Фильтр для поиска деталей
+type PartsFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Список UUID'ов. Пусто — не фильтруем по UUID
+	Uuids []string `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`
+	// Список имён. Пусто — не фильтруем по имени
+	Names []string `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+	// Список категорий вместе с их подкатегориями. Пусто — не фильтруем по категории
+	Categories []Category `protobuf:"varint,3,rep,packed,name=categories,proto3,enum=inventory.v1.Category" json:"categories,omitempty"`
+	// Список стран производителей. Пусто — не фильтруем по стране
+	ManufacturerCountries []string `protobuf:"bytes,4,rep,name=manufacturer_countries,json=manufacturerCountries,proto3" json:"manufacturer_countries,omitempty"`
+	// Список тегов. Пусто — не фильтруем по тегам
+	Tags []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Диапазон цены за единицу
+	Price *DoubleRange `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
+	// Диапазон количества на складе
+	StockQuantity *Int64Range `protobuf:"bytes,7,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
+	// Диапазон длины в сантиметрах
+	Length *DoubleRange `protobuf:"bytes,8,opt,name=length,proto3" json:"length,omitempty"`
+	// Диапазон ширины в сантиметрах
+	Width *DoubleRange `protobuf:"bytes,9,opt,name=width,proto3" json:"width,omitempty"`
+	// Диапазон высоты в сантиметрах
+	Height *DoubleRange `protobuf:"bytes,10,opt,name=height,proto3" json:"height,omitempty"`
+	// Диапазон веса в килограммах
+	Weight *DoubleRange `protobuf:"bytes,11,opt,name=weight,proto3" json:"weight,omitempty"`
+	// Только детали в наличии (stock_quantity > 0)
+	InStockOnly bool `protobuf:"varint,12,opt,name=in_stock_only,json=inStockOnly,proto3" json:"in_stock_only,omitempty"`
+	// Условия на метаданные, объединяются по И
+	Metadata []*MetadataPredicate `protobuf:"bytes,13,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	// Коды категорий вместе с их подкатегориями, объединяются с categories
+	CategoryCodes []string `protobuf:"bytes,14,rep,name=category_codes,json=categoryCodes,proto3" json:"category_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartsFilter) Reset() {
+	*x = PartsFilter{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartsFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartsFilter) ProtoMessage() {}
+
+func (x *PartsFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartsFilter.ProtoReflect.Descriptor instead.
+func (*PartsFilter) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *PartsFilter) GetUuids() []string {
+	if x != nil {
+		return x.Uuids
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetCategories() []Category {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetManufacturerCountries() []string {
+	if x != nil {
+		return x.ManufacturerCountries
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetPrice() *DoubleRange {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetStockQuantity() *Int64Range {
+	if x != nil {
+		return x.StockQuantity
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetLength() *DoubleRange {
+	if x != nil {
+		return x.Length
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetWidth() *DoubleRange {
+	if x != nil {
+		return x.Width
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetHeight() *DoubleRange {
+	if x != nil {
+		return x.Height
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetWeight() *DoubleRange {
+	if x != nil {
+		return x.Weight
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetInStockOnly() bool {
+	if x != nil {
+		return x.InStockOnly
+	}
+	return false
+}
+
+func (x *PartsFilter) GetMetadata() []*MetadataPredicate {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *PartsFilter) GetCategoryCodes() []string {
+	if x != nil {
+		return x.CategoryCodes
+	}
+	return nil
+}
+
+// Диапазон дробных значений, границы включаются. Не заданная граница не ограничивает
+type DoubleRange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Нижняя граница
+	Min *float64 `protobuf:"fixed64,1,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	// Верхняя граница
+	Max           *float64 `protobuf:"fixed64,2,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DoubleRange) Reset() {
+	*x = DoubleRange{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DoubleRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DoubleRange) ProtoMessage() {}
+
+func (x *DoubleRange) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DoubleRange.ProtoReflect.Descriptor instead.
+func (*DoubleRange) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *DoubleRange) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *DoubleRange) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+// Диапазон целых значений, границы включаются. Не заданная граница не ограничивает
+type Int64Range struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Нижняя граница
+	Min *int64 `protobuf:"varint,1,opt,name=min,proto3,oneof" json:"min,omitempty"`
+	// Верхняя граница
+	Max           *int64 `protobuf:"varint,2,opt,name=max,proto3,oneof" json:"max,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Int64Range) Reset() {
+	*x = Int64Range{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Int64Range) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Int64Range) ProtoMessage() {}
+
+func (x *Int64Range) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -255,50 +6072,53 @@ func (x *ListPartsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListPartsResponse.ProtoReflect.Descriptor instead.
-func (*ListPartsResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use Int64Range.ProtoReflect.Descriptor instead.
+func (*Int64Range) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *ListPartsResponse) GetParts() []*Part {
-	if x != nil {
-		return x.Parts
+func (x *Int64Range) GetMin() int64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
 	}
-	return nil
+	return 0
 }
 
-// Фильтр для поиска деталей
-type PartsFilter struct {
+func (x *Int64Range) GetMax() int64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+// Условие на значение метаданных детали
+type MetadataPredicate struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Список UUID'ов. Пусто — не фильтруем по UUID
-	Uuids []string `protobuf:"bytes,1,rep,name=uuids,proto3" json:"uuids,omitempty"`
-	// Список имён. Пусто — не фильтруем по имени
-	Names []string `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
-	// Список категорий. Пусто — не фильтруем по категории
-	Categories []Category `protobuf:"varint,3,rep,packed,name=categories,proto3,enum=inventory.v1.Category" json:"categories,omitempty"`
-	// Список стран производителей. Пусто — не фильтруем по стране
-	ManufacturerCountries []string `protobuf:"bytes,4,rep,name=manufacturer_countries,json=manufacturerCountries,proto3" json:"manufacturer_countries,omitempty"`
-	// Список тегов. Пусто — не фильтруем по тегам
-	Tags          []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	// Ключ в metadata (без точек и без $ в начале)
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Оператор сравнения
+	Operator MetadataOperator `protobuf:"varint,2,opt,name=operator,proto3,enum=inventory.v1.MetadataOperator" json:"operator,omitempty"`
+	// Значение для сравнения. Не используется для EXISTS
+	Value         *Value `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PartsFilter) Reset() {
-	*x = PartsFilter{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+func (x *MetadataPredicate) Reset() {
+	*x = MetadataPredicate{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PartsFilter) String() string {
+func (x *MetadataPredicate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PartsFilter) ProtoMessage() {}
+func (*MetadataPredicate) ProtoMessage() {}
 
-func (x *PartsFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[4]
+func (x *MetadataPredicate) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -309,42 +6129,28 @@ func (x *PartsFilter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PartsFilter.ProtoReflect.Descriptor instead.
-func (*PartsFilter) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *PartsFilter) GetUuids() []string {
-	if x != nil {
-		return x.Uuids
-	}
-	return nil
-}
-
-func (x *PartsFilter) GetNames() []string {
-	if x != nil {
-		return x.Names
-	}
-	return nil
+// Deprecated: Use MetadataPredicate.ProtoReflect.Descriptor instead.
+func (*MetadataPredicate) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *PartsFilter) GetCategories() []Category {
+func (x *MetadataPredicate) GetKey() string {
 	if x != nil {
-		return x.Categories
+		return x.Key
 	}
-	return nil
+	return ""
 }
 
-func (x *PartsFilter) GetManufacturerCountries() []string {
+func (x *MetadataPredicate) GetOperator() MetadataOperator {
 	if x != nil {
-		return x.ManufacturerCountries
+		return x.Operator
 	}
-	return nil
+	return MetadataOperator_METADATA_OPERATOR_UNSPECIFIED
 }
 
-func (x *PartsFilter) GetTags() []string {
+func (x *MetadataPredicate) GetValue() *Value {
 	if x != nil {
-		return x.Tags
+		return x.Value
 	}
 	return nil
 }
@@ -360,9 +6166,10 @@ type Part struct {
 	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	// Цена за единицу
 	Price float64 `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	// Количество на складе
+	// Количество на всех складах
 	StockQuantity int64 `protobuf:"varint,5,opt,name=stock_quantity,json=stockQuantity,proto3" json:"stock_quantity,omitempty"`
-	// Категория детали
+	// Категория детали для ENGINE, FUEL, PORTHOLE и WING, для остальных категорий UNSPECIFIED.
+	// Оставлена для совместимости, новые клиенты используют category_code
 	Category Category `protobuf:"varint,6,opt,name=category,proto3,enum=inventory.v1.Category" json:"category,omitempty"`
 	// Размеры детали
 	Dimensions *Dimensions `protobuf:"bytes,7,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
@@ -375,14 +6182,29 @@ type Part struct {
 	// Дата создания записи
 	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// Дата последнего обновления
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// Порог дозаказа: остаток ниже порога считается низким. 0 — без контроля остатка
+	ReorderThreshold int64 `protobuf:"varint,13,opt,name=reorder_threshold,json=reorderThreshold,proto3" json:"reorder_threshold,omitempty"`
+	// Остаток ниже порога дозаказа (только чтение)
+	StockLow bool `protobuf:"varint,14,opt,name=stock_low,json=stockLow,proto3" json:"stock_low,omitempty"`
+	// Фото, чертежи и документация детали (только чтение)
+	Attachments []*Attachment `protobuf:"bytes,15,rep,name=attachments,proto3" json:"attachments,omitempty"`
+	// Основное изображение: отмеченное primary или первое загруженное (только чтение)
+	PrimaryImage *Attachment `protobuf:"bytes,16,opt,name=primary_image,json=primaryImage,proto3" json:"primary_image,omitempty"`
+	// Остатки по складам (только чтение)
+	StockLocations []*StockLocation `protobuf:"bytes,17,rep,name=stock_locations,json=stockLocations,proto3" json:"stock_locations,omitempty"`
+	// Код категории из справочника категорий. При записи имеет приоритет над category
+	CategoryCode string `protobuf:"bytes,18,opt,name=category_code,json=categoryCode,proto3" json:"category_code,omitempty"`
+	// Версия детали, растет при каждом изменении карточки или остатка.
+	// UpdatePart принимает изменение только если версия совпадает с текущей
+	Version       int64 `protobuf:"varint,19,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Part) Reset() {
 	*x = Part{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -394,7 +6216,7 @@ func (x *Part) String() string {
 func (*Part) ProtoMessage() {}
 
 func (x *Part) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[5]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -407,7 +6229,7 @@ func (x *Part) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Part.ProtoReflect.Descriptor instead.
 func (*Part) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{5}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{96}
 }
 
 func (x *Part) GetUuid() string {
@@ -494,6 +6316,247 @@ func (x *Part) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Part) GetReorderThreshold() int64 {
+	if x != nil {
+		return x.ReorderThreshold
+	}
+	return 0
+}
+
+func (x *Part) GetStockLow() bool {
+	if x != nil {
+		return x.StockLow
+	}
+	return false
+}
+
+func (x *Part) GetAttachments() []*Attachment {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+func (x *Part) GetPrimaryImage() *Attachment {
+	if x != nil {
+		return x.PrimaryImage
+	}
+	return nil
+}
+
+func (x *Part) GetStockLocations() []*StockLocation {
+	if x != nil {
+		return x.StockLocations
+	}
+	return nil
+}
+
+func (x *Part) GetCategoryCode() string {
+	if x != nil {
+		return x.CategoryCode
+	}
+	return ""
+}
+
+func (x *Part) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// Категория деталей из справочника. Категории образуют дерево через parent_code
+type PartCategory struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Уникальный код в верхнем регистре, например AVIONICS. Коды ENGINE, FUEL, PORTHOLE и WING
+	// соответствуют значениям enum Category
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Код родительской категории. Пусто — корневая категория
+	ParentCode string `protobuf:"bytes,2,opt,name=parent_code,json=parentCode,proto3" json:"parent_code,omitempty"`
+	// Отображаемое название
+	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// Описание категории
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// Поля метаданных, которыми описываются детали категории
+	MetadataSchema []*MetadataField `protobuf:"bytes,5,rep,name=metadata_schema,json=metadataSchema,proto3" json:"metadata_schema,omitempty"`
+	// Дата создания записи
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Дата последнего обновления
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PartCategory) Reset() {
+	*x = PartCategory{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PartCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PartCategory) ProtoMessage() {}
+
+func (x *PartCategory) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PartCategory.ProtoReflect.Descriptor instead.
+func (*PartCategory) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *PartCategory) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *PartCategory) GetParentCode() string {
+	if x != nil {
+		return x.ParentCode
+	}
+	return ""
+}
+
+func (x *PartCategory) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *PartCategory) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *PartCategory) GetMetadataSchema() []*MetadataField {
+	if x != nil {
+		return x.MetadataSchema
+	}
+	return nil
+}
+
+func (x *PartCategory) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PartCategory) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// Поле схемы метаданных категории
+type MetadataField struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Ключ в metadata детали
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Отображаемое название поля
+	DisplayName string `protobuf:"bytes,2,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	// Тип значения
+	Type MetadataFieldType `protobuf:"varint,3,opt,name=type,proto3,enum=inventory.v1.MetadataFieldType" json:"type,omitempty"`
+	// Единица измерения, например кН
+	Unit string `protobuf:"bytes,4,opt,name=unit,proto3" json:"unit,omitempty"`
+	// Поле обязательно для деталей категории
+	Required bool `protobuf:"varint,5,opt,name=required,proto3" json:"required,omitempty"`
+	// Допустимый диапазон значения, только для NUMBER и INTEGER
+	Range         *DoubleRange `protobuf:"bytes,6,opt,name=range,proto3" json:"range,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MetadataField) Reset() {
+	*x = MetadataField{}
+	mi := &file_inventory_v1_inventory_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetadataField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetadataField) ProtoMessage() {}
+
+func (x *MetadataField) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_inventory_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetadataField.ProtoReflect.Descriptor instead.
+func (*MetadataField) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *MetadataField) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *MetadataField) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *MetadataField) GetType() MetadataFieldType {
+	if x != nil {
+		return x.Type
+	}
+	return MetadataFieldType_METADATA_FIELD_TYPE_UNSPECIFIED
+}
+
+func (x *MetadataField) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *MetadataField) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *MetadataField) GetRange() *DoubleRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
 // Размеры детали
 type Dimensions struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -511,7 +6574,7 @@ type Dimensions struct {
 
 func (x *Dimensions) Reset() {
 	*x = Dimensions{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -523,7 +6586,7 @@ func (x *Dimensions) String() string {
 func (*Dimensions) ProtoMessage() {}
 
 func (x *Dimensions) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[6]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -536,7 +6599,7 @@ func (x *Dimensions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Dimensions.ProtoReflect.Descriptor instead.
 func (*Dimensions) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{6}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{99}
 }
 
 func (x *Dimensions) GetLength() float64 {
@@ -582,7 +6645,7 @@ type Manufacturer struct {
 
 func (x *Manufacturer) Reset() {
 	*x = Manufacturer{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -594,7 +6657,7 @@ func (x *Manufacturer) String() string {
 func (*Manufacturer) ProtoMessage() {}
 
 func (x *Manufacturer) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[7]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -607,7 +6670,7 @@ func (x *Manufacturer) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Manufacturer.ProtoReflect.Descriptor instead.
 func (*Manufacturer) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{7}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{100}
 }
 
 func (x *Manufacturer) GetName() string {
@@ -647,7 +6710,7 @@ type Value struct {
 
 func (x *Value) Reset() {
 	*x = Value{}
-	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -659,7 +6722,7 @@ func (x *Value) String() string {
 func (*Value) ProtoMessage() {}
 
 func (x *Value) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_inventory_proto_msgTypes[8]
+	mi := &file_inventory_v1_inventory_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -672,7 +6735,7 @@ func (x *Value) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Value.ProtoReflect.Descriptor instead.
 func (*Value) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{8}
+	return file_inventory_v1_inventory_proto_rawDescGZIP(), []int{101}
 }
 
 func (x *Value) GetValue() isValue_Value {
@@ -754,15 +6817,328 @@ var File_inventory_v1_inventory_proto protoreflect.FileDescriptor
 
 const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\n" +
-	"\x1cinventory/v1/inventory.proto\x12\finventory.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"$\n" +
+	"\x1cinventory/v1/inventory.proto\x12\finventory.v1\x1a google/protobuf/field_mask.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"]\n" +
 	"\x0eGetPartRequest\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"9\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x127\n" +
+	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"9\n" +
 	"\x0fGetPartResponse\x12&\n" +
-	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"E\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"\x91\x02\n" +
 	"\x10ListPartsRequest\x121\n" +
-	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\"=\n" +
+	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x125\n" +
+	"\asort_by\x18\x04 \x01(\x0e2\x1c.inventory.v1.PartsSortFieldR\x06sortBy\x12\x1e\n" +
+	"\n" +
+	"descending\x18\x05 \x01(\bR\n" +
+	"descending\x127\n" +
+	"\tread_mask\x18\x06 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"\x84\x01\n" +
 	"\x11ListPartsResponse\x12(\n" +
-	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\"\xbc\x01\n" +
+	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x03R\ttotalSize\"e\n" +
+	"\x14BatchGetPartsRequest\x12\x14\n" +
+	"\x05uuids\x18\x01 \x03(\tR\x05uuids\x127\n" +
+	"\tread_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\breadMask\"f\n" +
+	"\x15BatchGetPartsResponse\x12(\n" +
+	"\x05parts\x18\x01 \x03(\v2\x12.inventory.v1.PartR\x05parts\x12#\n" +
+	"\rmissing_uuids\x18\x02 \x03(\tR\fmissingUuids\";\n" +
+	"\x11CreatePartRequest\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"<\n" +
+	"\x12CreatePartResponse\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"x\n" +
+	"\x11UpdatePartRequest\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"<\n" +
+	"\x12UpdatePartResponse\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\"'\n" +
+	"\x11DeletePartRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"\x14\n" +
+	"\x12DeletePartResponse\"\xad\x01\n" +
+	"\x12SearchPartsRequest\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x128\n" +
+	"\blanguage\x18\x02 \x01(\x0e2\x1c.inventory.v1.SearchLanguageR\blanguage\x121\n" +
+	"\x06filter\x18\x03 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\x12\x14\n" +
+	"\x05limit\x18\x04 \x01(\x05R\x05limit\"F\n" +
+	"\x13SearchPartsResponse\x12/\n" +
+	"\x04hits\x18\x01 \x03(\v2\x1b.inventory.v1.PartSearchHitR\x04hits\"M\n" +
+	"\rPartSearchHit\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\x12\x14\n" +
+	"\x05score\x18\x02 \x01(\x01R\x05score\"\xab\x01\n" +
+	"\x13ReceiveStockRequest\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x1c\n" +
+	"\treference\x18\x04 \x01(\tR\treference\x12%\n" +
+	"\x0ewarehouse_uuid\x18\x05 \x01(\tR\rwarehouseUuid\"O\n" +
+	"\x14ReceiveStockResponse\x127\n" +
+	"\bmovement\x18\x01 \x01(\v2\x1b.inventory.v1.StockMovementR\bmovement\"t\n" +
+	"\x19ListStockMovementsRequest\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x7f\n" +
+	"\x1aListStockMovementsResponse\x129\n" +
+	"\tmovements\x18\x01 \x03(\v2\x1b.inventory.v1.StockMovementR\tmovements\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\"\xca\x02\n" +
+	"\rStockMovement\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x1b\n" +
+	"\tpart_uuid\x18\x02 \x01(\tR\bpartUuid\x123\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1f.inventory.v1.StockMovementTypeR\x04type\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1c\n" +
+	"\treference\x18\x06 \x01(\tR\treference\x12\x1f\n" +
+	"\vstock_after\x18\a \x01(\x03R\n" +
+	"stockAfter\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12%\n" +
+	"\x0ewarehouse_uuid\x18\t \x01(\tR\rwarehouseUuid\"O\n" +
+	"\x16CreateWarehouseRequest\x125\n" +
+	"\twarehouse\x18\x01 \x01(\v2\x17.inventory.v1.WarehouseR\twarehouse\"P\n" +
+	"\x17CreateWarehouseResponse\x125\n" +
+	"\twarehouse\x18\x01 \x01(\v2\x17.inventory.v1.WarehouseR\twarehouse\"\x17\n" +
+	"\x15ListWarehousesRequest\"Q\n" +
+	"\x16ListWarehousesResponse\x127\n" +
+	"\n" +
+	"warehouses\x18\x01 \x03(\v2\x17.inventory.v1.WarehouseR\n" +
+	"warehouses\"\xc3\x01\n" +
+	"\x14TransferStockRequest\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12.\n" +
+	"\x13from_warehouse_uuid\x18\x02 \x01(\tR\x11fromWarehouseUuid\x12*\n" +
+	"\x11to_warehouse_uuid\x18\x03 \x01(\tR\x0ftoWarehouseUuid\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x03R\bquantity\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\x89\x01\n" +
+	"\x15TransferStockResponse\x127\n" +
+	"\boutgoing\x18\x01 \x01(\v2\x1b.inventory.v1.StockMovementR\boutgoing\x127\n" +
+	"\bincoming\x18\x02 \x01(\v2\x1b.inventory.v1.StockMovementR\bincoming\"<\n" +
+	"\x1bGetStockAvailabilityRequest\x12\x1d\n" +
+	"\n" +
+	"part_uuids\x18\x01 \x03(\tR\tpartUuids\"b\n" +
+	"\x1cGetStockAvailabilityResponse\x12B\n" +
+	"\favailability\x18\x01 \x03(\v2\x1e.inventory.v1.PartAvailabilityR\favailability\"\x91\x01\n" +
+	"\x10PartAvailability\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12%\n" +
+	"\x0estock_quantity\x18\x02 \x01(\x03R\rstockQuantity\x129\n" +
+	"\tlocations\x18\x03 \x03(\v2\x1b.inventory.v1.StockLocationR\tlocations\"h\n" +
+	"\x13ReserveStockRequest\x12\x1c\n" +
+	"\treference\x18\x01 \x01(\tR\treference\x123\n" +
+	"\x05items\x18\x02 \x03(\v2\x1d.inventory.v1.ReservationItemR\x05items\"J\n" +
+	"\x0fReservationItem\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"Q\n" +
+	"\x14ReserveStockResponse\x129\n" +
+	"\tmovements\x18\x01 \x03(\v2\x1b.inventory.v1.StockMovementR\tmovements\"3\n" +
+	"\x13ReleaseStockRequest\x12\x1c\n" +
+	"\treference\x18\x01 \x01(\tR\treference\"Q\n" +
+	"\x14ReleaseStockResponse\x129\n" +
+	"\tmovements\x18\x01 \x03(\v2\x1b.inventory.v1.StockMovementR\tmovements\"3\n" +
+	"\x13ConsumeStockRequest\x12\x1c\n" +
+	"\treference\x18\x01 \x01(\tR\treference\"Q\n" +
+	"\x14ConsumeStockResponse\x129\n" +
+	"\tmovements\x18\x01 \x03(\v2\x1b.inventory.v1.StockMovementR\tmovements\"\xbc\x01\n" +
+	"\tWarehouse\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\blatitude\x18\x04 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x05 \x01(\x01R\tlongitude\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"R\n" +
+	"\rStockLocation\x12%\n" +
+	"\x0ewarehouse_uuid\x18\x01 \x01(\tR\rwarehouseUuid\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"U\n" +
+	"\x1eCreateCompatibilityRuleRequest\x123\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1f.inventory.v1.CompatibilityRuleR\x04rule\"V\n" +
+	"\x1fCreateCompatibilityRuleResponse\x123\n" +
+	"\x04rule\x18\x01 \x01(\v2\x1f.inventory.v1.CompatibilityRuleR\x04rule\"4\n" +
+	"\x1eDeleteCompatibilityRuleRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"!\n" +
+	"\x1fDeleteCompatibilityRuleResponse\"\x1f\n" +
+	"\x1dListCompatibilityRulesRequest\"W\n" +
+	"\x1eListCompatibilityRulesResponse\x125\n" +
+	"\x05rules\x18\x01 \x03(\v2\x1f.inventory.v1.CompatibilityRuleR\x05rules\"=\n" +
+	"\x1cValidateConfigurationRequest\x12\x1d\n" +
+	"\n" +
+	"part_uuids\x18\x01 \x03(\tR\tpartUuids\"{\n" +
+	"\x1dValidateConfigurationResponse\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12D\n" +
+	"\n" +
+	"violations\x18\x02 \x03(\v2$.inventory.v1.ConfigurationViolationR\n" +
+	"violations\"x\n" +
+	"\x12ImportPartsRequest\x123\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x1b.inventory.v1.CatalogFormatR\x06format\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05chunk\x18\x03 \x01(\fR\x05chunk\"\xc6\x01\n" +
+	"\x13ImportPartsResponse\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x18\n" +
+	"\acreated\x18\x03 \x01(\x05R\acreated\x12\x18\n" +
+	"\aupdated\x18\x04 \x01(\x05R\aupdated\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x05R\x06failed\x124\n" +
+	"\x06errors\x18\x06 \x03(\v2\x1c.inventory.v1.ImportRowErrorR\x06errors\"Y\n" +
+	"\x0eImportRowError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x05R\x03row\x12\x1b\n" +
+	"\tpart_uuid\x18\x02 \x01(\tR\bpartUuid\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"|\n" +
+	"\x12ExportPartsRequest\x123\n" +
+	"\x06format\x18\x01 \x01(\x0e2\x1b.inventory.v1.CatalogFormatR\x06format\x121\n" +
+	"\x06filter\x18\x02 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\"+\n" +
+	"\x13ExportPartsResponse\x12\x14\n" +
+	"\x05chunk\x18\x01 \x01(\fR\x05chunk\"\xb0\x02\n" +
+	"\n" +
+	"Attachment\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x1b\n" +
+	"\tpart_uuid\x18\x02 \x01(\tR\bpartUuid\x120\n" +
+	"\x04kind\x18\x03 \x01(\x0e2\x1c.inventory.v1.AttachmentKindR\x04kind\x12\x1b\n" +
+	"\tfile_name\x18\x04 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x05 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04size\x18\x06 \x01(\x03R\x04size\x12\x16\n" +
+	"\x06sha256\x18\a \x01(\tR\x06sha256\x12\x18\n" +
+	"\aprimary\x18\b \x01(\bR\aprimary\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xd3\x01\n" +
+	"\x10AttachmentUpload\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x120\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x1c.inventory.v1.AttachmentKindR\x04kind\x12\x1b\n" +
+	"\tfile_name\x18\x03 \x01(\tR\bfileName\x12!\n" +
+	"\fcontent_type\x18\x04 \x01(\tR\vcontentType\x12\x18\n" +
+	"\aprimary\x18\x05 \x01(\bR\aprimary\x12\x16\n" +
+	"\x06sha256\x18\x06 \x01(\tR\x06sha256\"g\n" +
+	"\x17UploadAttachmentRequest\x126\n" +
+	"\x06upload\x18\x01 \x01(\v2\x1e.inventory.v1.AttachmentUploadR\x06upload\x12\x14\n" +
+	"\x05chunk\x18\x02 \x01(\fR\x05chunk\"T\n" +
+	"\x18UploadAttachmentResponse\x128\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x18.inventory.v1.AttachmentR\n" +
+	"attachment\"/\n" +
+	"\x19DownloadAttachmentRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"l\n" +
+	"\x1aDownloadAttachmentResponse\x128\n" +
+	"\n" +
+	"attachment\x18\x01 \x01(\v2\x18.inventory.v1.AttachmentR\n" +
+	"attachment\x12\x14\n" +
+	"\x05chunk\x18\x02 \x01(\fR\x05chunk\"-\n" +
+	"\x17DeleteAttachmentRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"\x1a\n" +
+	"\x18DeleteAttachmentResponse\"\xa8\x01\n" +
+	"\x18SchedulePartPriceRequest\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12A\n" +
+	"\x0eeffective_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"Y\n" +
+	"\x19SchedulePartPriceResponse\x12<\n" +
+	"\fprice_change\x18\x01 \x01(\v2\x19.inventory.v1.PriceChangeR\vpriceChange\"6\n" +
+	"\x17ListPriceHistoryRequest\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\"Z\n" +
+	"\x18ListPriceHistoryResponse\x12>\n" +
+	"\rprice_changes\x18\x01 \x03(\v2\x19.inventory.v1.PriceChangeR\fpriceChanges\"a\n" +
+	"\x14GetPartPricesRequest\x12\x1d\n" +
+	"\n" +
+	"part_uuids\x18\x01 \x03(\tR\tpartUuids\x12*\n" +
+	"\x02at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02at\"H\n" +
+	"\x15GetPartPricesResponse\x12/\n" +
+	"\x06prices\x18\x01 \x03(\v2\x17.inventory.v1.PartPriceR\x06prices\"\x81\x01\n" +
+	"\tPartPrice\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12A\n" +
+	"\x0eeffective_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\"\x84\x02\n" +
+	"\vPriceChange\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x1b\n" +
+	"\tpart_uuid\x18\x02 \x01(\tR\bpartUuid\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x01R\x05price\x12A\n" +
+	"\x0eeffective_from\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\reffectiveFrom\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x18\n" +
+	"\aapplied\x18\x06 \x01(\bR\aapplied\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"F\n" +
+	"\x11WatchPartsRequest\x121\n" +
+	"\x06filter\x18\x01 \x01(\v2\x19.inventory.v1.PartsFilterR\x06filter\"\xbe\x01\n" +
+	"\tPartEvent\x12/\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1b.inventory.v1.PartEventTypeR\x04type\x12\x1b\n" +
+	"\tpart_uuid\x18\x02 \x01(\tR\bpartUuid\x12&\n" +
+	"\x04part\x18\x03 \x01(\v2\x12.inventory.v1.PartR\x04part\x12;\n" +
+	"\voccurred_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"occurredAt\"O\n" +
+	"\x15CreateCategoryRequest\x126\n" +
+	"\bcategory\x18\x01 \x01(\v2\x1a.inventory.v1.PartCategoryR\bcategory\"P\n" +
+	"\x16CreateCategoryResponse\x126\n" +
+	"\bcategory\x18\x01 \x01(\v2\x1a.inventory.v1.PartCategoryR\bcategory\"\x8c\x01\n" +
+	"\x15UpdateCategoryRequest\x126\n" +
+	"\bcategory\x18\x01 \x01(\v2\x1a.inventory.v1.PartCategoryR\bcategory\x12;\n" +
+	"\vupdate_mask\x18\x02 \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\"P\n" +
+	"\x16UpdateCategoryResponse\x126\n" +
+	"\bcategory\x18\x01 \x01(\v2\x1a.inventory.v1.PartCategoryR\bcategory\"+\n" +
+	"\x15DeleteCategoryRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\x18\n" +
+	"\x16DeleteCategoryResponse\"\x17\n" +
+	"\x15ListCategoriesRequest\"T\n" +
+	"\x16ListCategoriesResponse\x12:\n" +
+	"\n" +
+	"categories\x18\x01 \x03(\v2\x1a.inventory.v1.PartCategoryR\n" +
+	"categories\".\n" +
+	"\x18GetCategorySchemaRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\x88\x01\n" +
+	"\x19GetCategorySchemaResponse\x126\n" +
+	"\bcategory\x18\x01 \x01(\v2\x1a.inventory.v1.PartCategoryR\bcategory\x123\n" +
+	"\x06fields\x18\x02 \x03(\v2\x1b.inventory.v1.MetadataFieldR\x06fields\"K\n" +
+	"\x18CreateRocketModelRequest\x12/\n" +
+	"\x05model\x18\x01 \x01(\v2\x19.inventory.v1.RocketModelR\x05model\"L\n" +
+	"\x19CreateRocketModelResponse\x12/\n" +
+	"\x05model\x18\x01 \x01(\v2\x19.inventory.v1.RocketModelR\x05model\"\x19\n" +
+	"\x17ListRocketModelsRequest\"T\n" +
+	"\x18ListRocketModelsResponse\x128\n" +
+	"\x06models\x18\x01 \x03(\v2 .inventory.v1.RocketModelSummaryR\x06models\"J\n" +
+	"\x18ExpandRocketModelRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\"\xb8\x01\n" +
+	"\x19ExpandRocketModelResponse\x12/\n" +
+	"\x05model\x18\x01 \x01(\v2\x19.inventory.v1.RocketModelR\x05model\x12+\n" +
+	"\x05lines\x18\x02 \x03(\v2\x15.inventory.v1.BomLineR\x05lines\x12\x1f\n" +
+	"\vtotal_price\x18\x03 \x01(\x01R\n" +
+	"totalPrice\x12\x1c\n" +
+	"\tavailable\x18\x04 \x01(\bR\tavailable\"\xbf\x01\n" +
+	"\vRocketModel\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12+\n" +
+	"\x05items\x18\x04 \x03(\v2\x15.inventory.v1.BomItemR\x05items\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"x\n" +
+	"\aBomItem\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x124\n" +
+	"\x16alternative_part_uuids\x18\x03 \x03(\tR\x14alternativePartUuids\"y\n" +
+	"\x12RocketModelSummary\x12/\n" +
+	"\x05model\x18\x01 \x01(\v2\x19.inventory.v1.RocketModelR\x05model\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x01R\x05price\x12\x1c\n" +
+	"\tavailable\x18\x03 \x01(\bR\tavailable\"\x8d\x01\n" +
+	"\aBomLine\x12&\n" +
+	"\x04part\x18\x01 \x01(\v2\x12.inventory.v1.PartR\x04part\x12\x1a\n" +
+	"\bquantity\x18\x02 \x01(\x03R\bquantity\x12\x1c\n" +
+	"\tavailable\x18\x03 \x01(\bR\tavailable\x12 \n" +
+	"\valternative\x18\x04 \x01(\bR\valternative\"\xa3\x02\n" +
+	"\x11CompatibilityRule\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x127\n" +
+	"\x04type\x18\x02 \x01(\x0e2#.inventory.v1.CompatibilityRuleTypeR\x04type\x122\n" +
+	"\asubject\x18\x03 \x01(\v2\x18.inventory.v1.RuleTargetR\asubject\x120\n" +
+	"\x06object\x18\x04 \x01(\v2\x18.inventory.v1.RuleTargetR\x06object\x12 \n" +
+	"\vdescription\x18\x05 \x01(\tR\vdescription\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x82\x01\n" +
+	"\n" +
+	"RuleTarget\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x122\n" +
+	"\bcategory\x18\x02 \x01(\x0e2\x16.inventory.v1.CategoryR\bcategory\x12#\n" +
+	"\rcategory_code\x18\x03 \x01(\tR\fcategoryCode\"\xa7\x01\n" +
+	"\x16ConfigurationViolation\x12\x1b\n" +
+	"\trule_uuid\x18\x01 \x01(\tR\bruleUuid\x127\n" +
+	"\x04type\x18\x02 \x01(\x0e2#.inventory.v1.CompatibilityRuleTypeR\x04type\x12\x1d\n" +
+	"\n" +
+	"part_uuids\x18\x03 \x03(\tR\tpartUuids\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\x80\x05\n" +
 	"\vPartsFilter\x12\x14\n" +
 	"\x05uuids\x18\x01 \x03(\tR\x05uuids\x12\x14\n" +
 	"\x05names\x18\x02 \x03(\tR\x05names\x126\n" +
@@ -770,7 +7146,32 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"categories\x18\x03 \x03(\x0e2\x16.inventory.v1.CategoryR\n" +
 	"categories\x125\n" +
 	"\x16manufacturer_countries\x18\x04 \x03(\tR\x15manufacturerCountries\x12\x12\n" +
-	"\x04tags\x18\x05 \x03(\tR\x04tags\"\xd5\x04\n" +
+	"\x04tags\x18\x05 \x03(\tR\x04tags\x12/\n" +
+	"\x05price\x18\x06 \x01(\v2\x19.inventory.v1.DoubleRangeR\x05price\x12?\n" +
+	"\x0estock_quantity\x18\a \x01(\v2\x18.inventory.v1.Int64RangeR\rstockQuantity\x121\n" +
+	"\x06length\x18\b \x01(\v2\x19.inventory.v1.DoubleRangeR\x06length\x12/\n" +
+	"\x05width\x18\t \x01(\v2\x19.inventory.v1.DoubleRangeR\x05width\x121\n" +
+	"\x06height\x18\n" +
+	" \x01(\v2\x19.inventory.v1.DoubleRangeR\x06height\x121\n" +
+	"\x06weight\x18\v \x01(\v2\x19.inventory.v1.DoubleRangeR\x06weight\x12\"\n" +
+	"\rin_stock_only\x18\f \x01(\bR\vinStockOnly\x12;\n" +
+	"\bmetadata\x18\r \x03(\v2\x1f.inventory.v1.MetadataPredicateR\bmetadata\x12%\n" +
+	"\x0ecategory_codes\x18\x0e \x03(\tR\rcategoryCodes\"K\n" +
+	"\vDoubleRange\x12\x15\n" +
+	"\x03min\x18\x01 \x01(\x01H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x02 \x01(\x01H\x01R\x03max\x88\x01\x01B\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"J\n" +
+	"\n" +
+	"Int64Range\x12\x15\n" +
+	"\x03min\x18\x01 \x01(\x03H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x02 \x01(\x03H\x01R\x03max\x88\x01\x01B\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"\x8c\x01\n" +
+	"\x11MetadataPredicate\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12:\n" +
+	"\boperator\x18\x02 \x01(\x0e2\x1e.inventory.v1.MetadataOperatorR\boperator\x12)\n" +
+	"\x05value\x18\x03 \x01(\v2\x13.inventory.v1.ValueR\x05value\"\x9f\a\n" +
 	"\x04Part\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -788,10 +7189,35 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1aP\n" +
+	"updated_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12+\n" +
+	"\x11reorder_threshold\x18\r \x01(\x03R\x10reorderThreshold\x12\x1b\n" +
+	"\tstock_low\x18\x0e \x01(\bR\bstockLow\x12:\n" +
+	"\vattachments\x18\x0f \x03(\v2\x18.inventory.v1.AttachmentR\vattachments\x12=\n" +
+	"\rprimary_image\x18\x10 \x01(\v2\x18.inventory.v1.AttachmentR\fprimaryImage\x12D\n" +
+	"\x0fstock_locations\x18\x11 \x03(\v2\x1b.inventory.v1.StockLocationR\x0estockLocations\x12#\n" +
+	"\rcategory_code\x18\x12 \x01(\tR\fcategoryCode\x12\x18\n" +
+	"\aversion\x18\x13 \x01(\x03R\aversion\x1aP\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12)\n" +
-	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01\"j\n" +
+	"\x05value\x18\x02 \x01(\v2\x13.inventory.v1.ValueR\x05value:\x028\x01\"\xc4\x02\n" +
+	"\fPartCategory\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1f\n" +
+	"\vparent_code\x18\x02 \x01(\tR\n" +
+	"parentCode\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12D\n" +
+	"\x0fmetadata_schema\x18\x05 \x03(\v2\x1b.inventory.v1.MetadataFieldR\x0emetadataSchema\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xda\x01\n" +
+	"\rMetadataField\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12!\n" +
+	"\fdisplay_name\x18\x02 \x01(\tR\vdisplayName\x123\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x1f.inventory.v1.MetadataFieldTypeR\x04type\x12\x12\n" +
+	"\x04unit\x18\x04 \x01(\tR\x04unit\x12\x1a\n" +
+	"\brequired\x18\x05 \x01(\bR\brequired\x12/\n" +
+	"\x05range\x18\x06 \x01(\v2\x19.inventory.v1.DoubleRangeR\x05range\"j\n" +
 	"\n" +
 	"Dimensions\x12\x16\n" +
 	"\x06length\x18\x01 \x01(\x01R\x06length\x12\x14\n" +
@@ -809,16 +7235,110 @@ const file_inventory_v1_inventory_proto_rawDesc = "" +
 	"\fdouble_value\x18\x03 \x01(\x01H\x00R\vdoubleValue\x12\x1f\n" +
 	"\n" +
 	"bool_value\x18\x04 \x01(\bH\x00R\tboolValueB\a\n" +
-	"\x05value*v\n" +
+	"\x05value*\x8a\x02\n" +
+	"\x11StockMovementType\x12#\n" +
+	"\x1fSTOCK_MOVEMENT_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bSTOCK_MOVEMENT_TYPE_RECEIPT\x10\x01\x12#\n" +
+	"\x1fSTOCK_MOVEMENT_TYPE_RESERVATION\x10\x02\x12\x1f\n" +
+	"\x1bSTOCK_MOVEMENT_TYPE_RELEASE\x10\x03\x12#\n" +
+	"\x1fSTOCK_MOVEMENT_TYPE_CONSUMPTION\x10\x04\x12\"\n" +
+	"\x1eSTOCK_MOVEMENT_TYPE_ADJUSTMENT\x10\x05\x12 \n" +
+	"\x1cSTOCK_MOVEMENT_TYPE_TRANSFER\x10\x06*{\n" +
+	"\rCatalogFormat\x12\x1e\n" +
+	"\x1aCATALOG_FORMAT_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12CATALOG_FORMAT_CSV\x10\x01\x12\x17\n" +
+	"\x13CATALOG_FORMAT_JSON\x10\x02\x12\x19\n" +
+	"\x15CATALOG_FORMAT_NDJSON\x10\x03*\x88\x01\n" +
+	"\x0eAttachmentKind\x12\x1f\n" +
+	"\x1bATTACHMENT_KIND_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15ATTACHMENT_KIND_IMAGE\x10\x01\x12\x1b\n" +
+	"\x17ATTACHMENT_KIND_DRAWING\x10\x02\x12\x1d\n" +
+	"\x19ATTACHMENT_KIND_DATASHEET\x10\x03*\xcc\x01\n" +
+	"\rPartEventType\x12\x1f\n" +
+	"\x1bPART_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18PART_EVENT_TYPE_SNAPSHOT\x10\x01\x12%\n" +
+	"!PART_EVENT_TYPE_SNAPSHOT_COMPLETE\x10\x02\x12\x1b\n" +
+	"\x17PART_EVENT_TYPE_CREATED\x10\x03\x12\x1b\n" +
+	"\x17PART_EVENT_TYPE_UPDATED\x10\x04\x12\x1b\n" +
+	"\x17PART_EVENT_TYPE_DELETED\x10\x05*\xb9\x01\n" +
+	"\x15CompatibilityRuleType\x12'\n" +
+	"#COMPATIBILITY_RULE_TYPE_UNSPECIFIED\x10\x00\x12$\n" +
+	" COMPATIBILITY_RULE_TYPE_REQUIRES\x10\x01\x12$\n" +
+	" COMPATIBILITY_RULE_TYPE_EXCLUDES\x10\x02\x12+\n" +
+	"'COMPATIBILITY_RULE_TYPE_COMPATIBLE_WITH\x10\x03*k\n" +
+	"\x0eSearchLanguage\x12\x1f\n" +
+	"\x1bSEARCH_LANGUAGE_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17SEARCH_LANGUAGE_RUSSIAN\x10\x01\x12\x1b\n" +
+	"\x17SEARCH_LANGUAGE_ENGLISH\x10\x02*\xf1\x01\n" +
+	"\x10MetadataOperator\x12!\n" +
+	"\x1dMETADATA_OPERATOR_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14METADATA_OPERATOR_EQ\x10\x01\x12\x18\n" +
+	"\x14METADATA_OPERATOR_NE\x10\x02\x12\x18\n" +
+	"\x14METADATA_OPERATOR_GT\x10\x03\x12\x19\n" +
+	"\x15METADATA_OPERATOR_GTE\x10\x04\x12\x18\n" +
+	"\x14METADATA_OPERATOR_LT\x10\x05\x12\x19\n" +
+	"\x15METADATA_OPERATOR_LTE\x10\x06\x12\x1c\n" +
+	"\x18METADATA_OPERATOR_EXISTS\x10\a*v\n" +
 	"\bCategory\x12\x18\n" +
 	"\x14CATEGORY_UNSPECIFIED\x10\x00\x12\x13\n" +
 	"\x0fCATEGORY_ENGINE\x10\x01\x12\x11\n" +
 	"\rCATEGORY_FUEL\x10\x02\x12\x15\n" +
 	"\x11CATEGORY_PORTHOLE\x10\x03\x12\x11\n" +
-	"\rCATEGORY_WING\x10\x042\xa8\x01\n" +
+	"\rCATEGORY_WING\x10\x04*\xb7\x01\n" +
+	"\x11MetadataFieldType\x12#\n" +
+	"\x1fMETADATA_FIELD_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aMETADATA_FIELD_TYPE_STRING\x10\x01\x12\x1e\n" +
+	"\x1aMETADATA_FIELD_TYPE_NUMBER\x10\x02\x12\x1f\n" +
+	"\x1bMETADATA_FIELD_TYPE_INTEGER\x10\x03\x12\x1c\n" +
+	"\x18METADATA_FIELD_TYPE_BOOL\x10\x04*\xaf\x01\n" +
+	"\x0ePartsSortField\x12 \n" +
+	"\x1cPARTS_SORT_FIELD_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15PARTS_SORT_FIELD_NAME\x10\x01\x12\x1a\n" +
+	"\x16PARTS_SORT_FIELD_PRICE\x10\x02\x12\x1f\n" +
+	"\x1bPARTS_SORT_FIELD_CREATED_AT\x10\x03\x12#\n" +
+	"\x1fPARTS_SORT_FIELD_STOCK_QUANTITY\x10\x042\xc0\x1b\n" +
 	"\x10InventoryService\x12F\n" +
 	"\aGetPart\x12\x1c.inventory.v1.GetPartRequest\x1a\x1d.inventory.v1.GetPartResponse\x12L\n" +
-	"\tListParts\x12\x1e.inventory.v1.ListPartsRequest\x1a\x1f.inventory.v1.ListPartsResponseB\xc7\x01\n" +
+	"\tListParts\x12\x1e.inventory.v1.ListPartsRequest\x1a\x1f.inventory.v1.ListPartsResponse\x12X\n" +
+	"\rBatchGetParts\x12\".inventory.v1.BatchGetPartsRequest\x1a#.inventory.v1.BatchGetPartsResponse\x12O\n" +
+	"\n" +
+	"CreatePart\x12\x1f.inventory.v1.CreatePartRequest\x1a .inventory.v1.CreatePartResponse\x12O\n" +
+	"\n" +
+	"UpdatePart\x12\x1f.inventory.v1.UpdatePartRequest\x1a .inventory.v1.UpdatePartResponse\x12O\n" +
+	"\n" +
+	"DeletePart\x12\x1f.inventory.v1.DeletePartRequest\x1a .inventory.v1.DeletePartResponse\x12R\n" +
+	"\vSearchParts\x12 .inventory.v1.SearchPartsRequest\x1a!.inventory.v1.SearchPartsResponse\x12U\n" +
+	"\fReceiveStock\x12!.inventory.v1.ReceiveStockRequest\x1a\".inventory.v1.ReceiveStockResponse\x12g\n" +
+	"\x12ListStockMovements\x12'.inventory.v1.ListStockMovementsRequest\x1a(.inventory.v1.ListStockMovementsResponse\x12^\n" +
+	"\x0fCreateWarehouse\x12$.inventory.v1.CreateWarehouseRequest\x1a%.inventory.v1.CreateWarehouseResponse\x12[\n" +
+	"\x0eListWarehouses\x12#.inventory.v1.ListWarehousesRequest\x1a$.inventory.v1.ListWarehousesResponse\x12X\n" +
+	"\rTransferStock\x12\".inventory.v1.TransferStockRequest\x1a#.inventory.v1.TransferStockResponse\x12m\n" +
+	"\x14GetStockAvailability\x12).inventory.v1.GetStockAvailabilityRequest\x1a*.inventory.v1.GetStockAvailabilityResponse\x12U\n" +
+	"\fReserveStock\x12!.inventory.v1.ReserveStockRequest\x1a\".inventory.v1.ReserveStockResponse\x12U\n" +
+	"\fReleaseStock\x12!.inventory.v1.ReleaseStockRequest\x1a\".inventory.v1.ReleaseStockResponse\x12U\n" +
+	"\fConsumeStock\x12!.inventory.v1.ConsumeStockRequest\x1a\".inventory.v1.ConsumeStockResponse\x12v\n" +
+	"\x17CreateCompatibilityRule\x12,.inventory.v1.CreateCompatibilityRuleRequest\x1a-.inventory.v1.CreateCompatibilityRuleResponse\x12v\n" +
+	"\x17DeleteCompatibilityRule\x12,.inventory.v1.DeleteCompatibilityRuleRequest\x1a-.inventory.v1.DeleteCompatibilityRuleResponse\x12s\n" +
+	"\x16ListCompatibilityRules\x12+.inventory.v1.ListCompatibilityRulesRequest\x1a,.inventory.v1.ListCompatibilityRulesResponse\x12p\n" +
+	"\x15ValidateConfiguration\x12*.inventory.v1.ValidateConfigurationRequest\x1a+.inventory.v1.ValidateConfigurationResponse\x12T\n" +
+	"\vImportParts\x12 .inventory.v1.ImportPartsRequest\x1a!.inventory.v1.ImportPartsResponse(\x01\x12T\n" +
+	"\vExportParts\x12 .inventory.v1.ExportPartsRequest\x1a!.inventory.v1.ExportPartsResponse0\x01\x12d\n" +
+	"\x11CreateRocketModel\x12&.inventory.v1.CreateRocketModelRequest\x1a'.inventory.v1.CreateRocketModelResponse\x12a\n" +
+	"\x10ListRocketModels\x12%.inventory.v1.ListRocketModelsRequest\x1a&.inventory.v1.ListRocketModelsResponse\x12d\n" +
+	"\x11ExpandRocketModel\x12&.inventory.v1.ExpandRocketModelRequest\x1a'.inventory.v1.ExpandRocketModelResponse\x12c\n" +
+	"\x10UploadAttachment\x12%.inventory.v1.UploadAttachmentRequest\x1a&.inventory.v1.UploadAttachmentResponse(\x01\x12i\n" +
+	"\x12DownloadAttachment\x12'.inventory.v1.DownloadAttachmentRequest\x1a(.inventory.v1.DownloadAttachmentResponse0\x01\x12a\n" +
+	"\x10DeleteAttachment\x12%.inventory.v1.DeleteAttachmentRequest\x1a&.inventory.v1.DeleteAttachmentResponse\x12d\n" +
+	"\x11SchedulePartPrice\x12&.inventory.v1.SchedulePartPriceRequest\x1a'.inventory.v1.SchedulePartPriceResponse\x12a\n" +
+	"\x10ListPriceHistory\x12%.inventory.v1.ListPriceHistoryRequest\x1a&.inventory.v1.ListPriceHistoryResponse\x12X\n" +
+	"\rGetPartPrices\x12\".inventory.v1.GetPartPricesRequest\x1a#.inventory.v1.GetPartPricesResponse\x12H\n" +
+	"\n" +
+	"WatchParts\x12\x1f.inventory.v1.WatchPartsRequest\x1a\x17.inventory.v1.PartEvent0\x01\x12[\n" +
+	"\x0eCreateCategory\x12#.inventory.v1.CreateCategoryRequest\x1a$.inventory.v1.CreateCategoryResponse\x12[\n" +
+	"\x0eUpdateCategory\x12#.inventory.v1.UpdateCategoryRequest\x1a$.inventory.v1.UpdateCategoryResponse\x12[\n" +
+	"\x0eDeleteCategory\x12#.inventory.v1.DeleteCategoryRequest\x1a$.inventory.v1.DeleteCategoryResponse\x12[\n" +
+	"\x0eListCategories\x12#.inventory.v1.ListCategoriesRequest\x1a$.inventory.v1.ListCategoriesResponse\x12d\n" +
+	"\x11GetCategorySchema\x12&.inventory.v1.GetCategorySchemaRequest\x1a'.inventory.v1.GetCategorySchemaResponseB\xc7\x01\n" +
 	"\x10com.inventory.v1B\x0eInventoryProtoP\x01ZRgithub.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/inventory/v1;inventoryv1\xa2\x02\x03IXX\xaa\x02\fInventory.V1\xca\x02\fInventory\\V1\xe2\x02\x18Inventory\\V1\\GPBMetadata\xea\x02\rInventory::V1b\x06proto3"
 
 var (
@@ -833,43 +7353,312 @@ func file_inventory_v1_inventory_proto_rawDescGZIP() []byte {
 	return file_inventory_v1_inventory_proto_rawDescData
 }
 
-var file_inventory_v1_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_inventory_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_inventory_v1_inventory_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_inventory_v1_inventory_proto_msgTypes = make([]protoimpl.MessageInfo, 103)
 var file_inventory_v1_inventory_proto_goTypes = []any{
-	(Category)(0),                 // 0: inventory.v1.Category
-	(*GetPartRequest)(nil),        // 1: inventory.v1.GetPartRequest
-	(*GetPartResponse)(nil),       // 2: inventory.v1.GetPartResponse
-	(*ListPartsRequest)(nil),      // 3: inventory.v1.ListPartsRequest
-	(*ListPartsResponse)(nil),     // 4: inventory.v1.ListPartsResponse
-	(*PartsFilter)(nil),           // 5: inventory.v1.PartsFilter
-	(*Part)(nil),                  // 6: inventory.v1.Part
-	(*Dimensions)(nil),            // 7: inventory.v1.Dimensions
-	(*Manufacturer)(nil),          // 8: inventory.v1.Manufacturer
-	(*Value)(nil),                 // 9: inventory.v1.Value
-	nil,                           // 10: inventory.v1.Part.MetadataEntry
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(StockMovementType)(0),                  // 0: inventory.v1.StockMovementType
+	(CatalogFormat)(0),                      // 1: inventory.v1.CatalogFormat
+	(AttachmentKind)(0),                     // 2: inventory.v1.AttachmentKind
+	(PartEventType)(0),                      // 3: inventory.v1.PartEventType
+	(CompatibilityRuleType)(0),              // 4: inventory.v1.CompatibilityRuleType
+	(SearchLanguage)(0),                     // 5: inventory.v1.SearchLanguage
+	(MetadataOperator)(0),                   // 6: inventory.v1.MetadataOperator
+	(Category)(0),                           // 7: inventory.v1.Category
+	(MetadataFieldType)(0),                  // 8: inventory.v1.MetadataFieldType
+	(PartsSortField)(0),                     // 9: inventory.v1.PartsSortField
+	(*GetPartRequest)(nil),                  // 10: inventory.v1.GetPartRequest
+	(*GetPartResponse)(nil),                 // 11: inventory.v1.GetPartResponse
+	(*ListPartsRequest)(nil),                // 12: inventory.v1.ListPartsRequest
+	(*ListPartsResponse)(nil),               // 13: inventory.v1.ListPartsResponse
+	(*BatchGetPartsRequest)(nil),            // 14: inventory.v1.BatchGetPartsRequest
+	(*BatchGetPartsResponse)(nil),           // 15: inventory.v1.BatchGetPartsResponse
+	(*CreatePartRequest)(nil),               // 16: inventory.v1.CreatePartRequest
+	(*CreatePartResponse)(nil),              // 17: inventory.v1.CreatePartResponse
+	(*UpdatePartRequest)(nil),               // 18: inventory.v1.UpdatePartRequest
+	(*UpdatePartResponse)(nil),              // 19: inventory.v1.UpdatePartResponse
+	(*DeletePartRequest)(nil),               // 20: inventory.v1.DeletePartRequest
+	(*DeletePartResponse)(nil),              // 21: inventory.v1.DeletePartResponse
+	(*SearchPartsRequest)(nil),              // 22: inventory.v1.SearchPartsRequest
+	(*SearchPartsResponse)(nil),             // 23: inventory.v1.SearchPartsResponse
+	(*PartSearchHit)(nil),                   // 24: inventory.v1.PartSearchHit
+	(*ReceiveStockRequest)(nil),             // 25: inventory.v1.ReceiveStockRequest
+	(*ReceiveStockResponse)(nil),            // 26: inventory.v1.ReceiveStockResponse
+	(*ListStockMovementsRequest)(nil),       // 27: inventory.v1.ListStockMovementsRequest
+	(*ListStockMovementsResponse)(nil),      // 28: inventory.v1.ListStockMovementsResponse
+	(*StockMovement)(nil),                   // 29: inventory.v1.StockMovement
+	(*CreateWarehouseRequest)(nil),          // 30: inventory.v1.CreateWarehouseRequest
+	(*CreateWarehouseResponse)(nil),         // 31: inventory.v1.CreateWarehouseResponse
+	(*ListWarehousesRequest)(nil),           // 32: inventory.v1.ListWarehousesRequest
+	(*ListWarehousesResponse)(nil),          // 33: inventory.v1.ListWarehousesResponse
+	(*TransferStockRequest)(nil),            // 34: inventory.v1.TransferStockRequest
+	(*TransferStockResponse)(nil),           // 35: inventory.v1.TransferStockResponse
+	(*GetStockAvailabilityRequest)(nil),     // 36: inventory.v1.GetStockAvailabilityRequest
+	(*GetStockAvailabilityResponse)(nil),    // 37: inventory.v1.GetStockAvailabilityResponse
+	(*PartAvailability)(nil),                // 38: inventory.v1.PartAvailability
+	(*ReserveStockRequest)(nil),             // 39: inventory.v1.ReserveStockRequest
+	(*ReservationItem)(nil),                 // 40: inventory.v1.ReservationItem
+	(*ReserveStockResponse)(nil),            // 41: inventory.v1.ReserveStockResponse
+	(*ReleaseStockRequest)(nil),             // 42: inventory.v1.ReleaseStockRequest
+	(*ReleaseStockResponse)(nil),            // 43: inventory.v1.ReleaseStockResponse
+	(*ConsumeStockRequest)(nil),             // 44: inventory.v1.ConsumeStockRequest
+	(*ConsumeStockResponse)(nil),            // 45: inventory.v1.ConsumeStockResponse
+	(*Warehouse)(nil),                       // 46: inventory.v1.Warehouse
+	(*StockLocation)(nil),                   // 47: inventory.v1.StockLocation
+	(*CreateCompatibilityRuleRequest)(nil),  // 48: inventory.v1.CreateCompatibilityRuleRequest
+	(*CreateCompatibilityRuleResponse)(nil), // 49: inventory.v1.CreateCompatibilityRuleResponse
+	(*DeleteCompatibilityRuleRequest)(nil),  // 50: inventory.v1.DeleteCompatibilityRuleRequest
+	(*DeleteCompatibilityRuleResponse)(nil), // 51: inventory.v1.DeleteCompatibilityRuleResponse
+	(*ListCompatibilityRulesRequest)(nil),   // 52: inventory.v1.ListCompatibilityRulesRequest
+	(*ListCompatibilityRulesResponse)(nil),  // 53: inventory.v1.ListCompatibilityRulesResponse
+	(*ValidateConfigurationRequest)(nil),    // 54: inventory.v1.ValidateConfigurationRequest
+	(*ValidateConfigurationResponse)(nil),   // 55: inventory.v1.ValidateConfigurationResponse
+	(*ImportPartsRequest)(nil),              // 56: inventory.v1.ImportPartsRequest
+	(*ImportPartsResponse)(nil),             // 57: inventory.v1.ImportPartsResponse
+	(*ImportRowError)(nil),                  // 58: inventory.v1.ImportRowError
+	(*ExportPartsRequest)(nil),              // 59: inventory.v1.ExportPartsRequest
+	(*ExportPartsResponse)(nil),             // 60: inventory.v1.ExportPartsResponse
+	(*Attachment)(nil),                      // 61: inventory.v1.Attachment
+	(*AttachmentUpload)(nil),                // 62: inventory.v1.AttachmentUpload
+	(*UploadAttachmentRequest)(nil),         // 63: inventory.v1.UploadAttachmentRequest
+	(*UploadAttachmentResponse)(nil),        // 64: inventory.v1.UploadAttachmentResponse
+	(*DownloadAttachmentRequest)(nil),       // 65: inventory.v1.DownloadAttachmentRequest
+	(*DownloadAttachmentResponse)(nil),      // 66: inventory.v1.DownloadAttachmentResponse
+	(*DeleteAttachmentRequest)(nil),         // 67: inventory.v1.DeleteAttachmentRequest
+	(*DeleteAttachmentResponse)(nil),        // 68: inventory.v1.DeleteAttachmentResponse
+	(*SchedulePartPriceRequest)(nil),        // 69: inventory.v1.SchedulePartPriceRequest
+	(*SchedulePartPriceResponse)(nil),       // 70: inventory.v1.SchedulePartPriceResponse
+	(*ListPriceHistoryRequest)(nil),         // 71: inventory.v1.ListPriceHistoryRequest
+	(*ListPriceHistoryResponse)(nil),        // 72: inventory.v1.ListPriceHistoryResponse
+	(*GetPartPricesRequest)(nil),            // 73: inventory.v1.GetPartPricesRequest
+	(*GetPartPricesResponse)(nil),           // 74: inventory.v1.GetPartPricesResponse
+	(*PartPrice)(nil),                       // 75: inventory.v1.PartPrice
+	(*PriceChange)(nil),                     // 76: inventory.v1.PriceChange
+	(*WatchPartsRequest)(nil),               // 77: inventory.v1.WatchPartsRequest
+	(*PartEvent)(nil),                       // 78: inventory.v1.PartEvent
+	(*CreateCategoryRequest)(nil),           // 79: inventory.v1.CreateCategoryRequest
+	(*CreateCategoryResponse)(nil),          // 80: inventory.v1.CreateCategoryResponse
+	(*UpdateCategoryRequest)(nil),           // 81: inventory.v1.UpdateCategoryRequest
+	(*UpdateCategoryResponse)(nil),          // 82: inventory.v1.UpdateCategoryResponse
+	(*DeleteCategoryRequest)(nil),           // 83: inventory.v1.DeleteCategoryRequest
+	(*DeleteCategoryResponse)(nil),          // 84: inventory.v1.DeleteCategoryResponse
+	(*ListCategoriesRequest)(nil),           // 85: inventory.v1.ListCategoriesRequest
+	(*ListCategoriesResponse)(nil),          // 86: inventory.v1.ListCategoriesResponse
+	(*GetCategorySchemaRequest)(nil),        // 87: inventory.v1.GetCategorySchemaRequest
+	(*GetCategorySchemaResponse)(nil),       // 88: inventory.v1.GetCategorySchemaResponse
+	(*CreateRocketModelRequest)(nil),        // 89: inventory.v1.CreateRocketModelRequest
+	(*CreateRocketModelResponse)(nil),       // 90: inventory.v1.CreateRocketModelResponse
+	(*ListRocketModelsRequest)(nil),         // 91: inventory.v1.ListRocketModelsRequest
+	(*ListRocketModelsResponse)(nil),        // 92: inventory.v1.ListRocketModelsResponse
+	(*ExpandRocketModelRequest)(nil),        // 93: inventory.v1.ExpandRocketModelRequest
+	(*ExpandRocketModelResponse)(nil),       // 94: inventory.v1.ExpandRocketModelResponse
+	(*RocketModel)(nil),                     // 95: inventory.v1.RocketModel
+	(*BomItem)(nil),                         // 96: inventory.v1.BomItem
+	(*RocketModelSummary)(nil),              // 97: inventory.v1.RocketModelSummary
+	(*BomLine)(nil),                         // 98: inventory.v1.BomLine
+	(*CompatibilityRule)(nil),               // 99: inventory.v1.CompatibilityRule
+	(*RuleTarget)(nil),                      // 100: inventory.v1.RuleTarget
+	(*ConfigurationViolation)(nil),          // 101: inventory.v1.ConfigurationViolation
+	(*PartsFilter)(nil),                     // 102: inventory.v1.PartsFilter
+	(*DoubleRange)(nil),                     // 103: inventory.v1.DoubleRange
+	(*Int64Range)(nil),                      // 104: inventory.v1.Int64Range
+	(*MetadataPredicate)(nil),               // 105: inventory.v1.MetadataPredicate
+	(*Part)(nil),                            // 106: inventory.v1.Part
+	(*PartCategory)(nil),                    // 107: inventory.v1.PartCategory
+	(*MetadataField)(nil),                   // 108: inventory.v1.MetadataField
+	(*Dimensions)(nil),                      // 109: inventory.v1.Dimensions
+	(*Manufacturer)(nil),                    // 110: inventory.v1.Manufacturer
+	(*Value)(nil),                           // 111: inventory.v1.Value
+	nil,                                     // 112: inventory.v1.Part.MetadataEntry
+	(*fieldmaskpb.FieldMask)(nil),           // 113: google.protobuf.FieldMask
+	(*timestamppb.Timestamp)(nil),           // 114: google.protobuf.Timestamp
 }
 var file_inventory_v1_inventory_proto_depIdxs = []int32{
-	6,  // 0: inventory.v1.GetPartResponse.part:type_name -> inventory.v1.Part
-	5,  // 1: inventory.v1.ListPartsRequest.filter:type_name -> inventory.v1.PartsFilter
-	6,  // 2: inventory.v1.ListPartsResponse.parts:type_name -> inventory.v1.Part
-	0,  // 3: inventory.v1.PartsFilter.categories:type_name -> inventory.v1.Category
-	0,  // 4: inventory.v1.Part.category:type_name -> inventory.v1.Category
-	7,  // 5: inventory.v1.Part.dimensions:type_name -> inventory.v1.Dimensions
-	8,  // 6: inventory.v1.Part.manufacturer:type_name -> inventory.v1.Manufacturer
-	10, // 7: inventory.v1.Part.metadata:type_name -> inventory.v1.Part.MetadataEntry
-	11, // 8: inventory.v1.Part.created_at:type_name -> google.protobuf.Timestamp
-	11, // 9: inventory.v1.Part.updated_at:type_name -> google.protobuf.Timestamp
-	9,  // 10: inventory.v1.Part.MetadataEntry.value:type_name -> inventory.v1.Value
-	1,  // 11: inventory.v1.InventoryService.GetPart:input_type -> inventory.v1.GetPartRequest
-	3,  // 12: inventory.v1.InventoryService.ListParts:input_type -> inventory.v1.ListPartsRequest
-	2,  // 13: inventory.v1.InventoryService.GetPart:output_type -> inventory.v1.GetPartResponse
-	4,  // 14: inventory.v1.InventoryService.ListParts:output_type -> inventory.v1.ListPartsResponse
-	13, // [13:15] is the sub-list for method output_type
-	11, // [11:13] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	113, // 0: inventory.v1.GetPartRequest.read_mask:type_name -> google.protobuf.FieldMask
+	106, // 1: inventory.v1.GetPartResponse.part:type_name -> inventory.v1.Part
+	102, // 2: inventory.v1.ListPartsRequest.filter:type_name -> inventory.v1.PartsFilter
+	9,   // 3: inventory.v1.ListPartsRequest.sort_by:type_name -> inventory.v1.PartsSortField
+	113, // 4: inventory.v1.ListPartsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	106, // 5: inventory.v1.ListPartsResponse.parts:type_name -> inventory.v1.Part
+	113, // 6: inventory.v1.BatchGetPartsRequest.read_mask:type_name -> google.protobuf.FieldMask
+	106, // 7: inventory.v1.BatchGetPartsResponse.parts:type_name -> inventory.v1.Part
+	106, // 8: inventory.v1.CreatePartRequest.part:type_name -> inventory.v1.Part
+	106, // 9: inventory.v1.CreatePartResponse.part:type_name -> inventory.v1.Part
+	106, // 10: inventory.v1.UpdatePartRequest.part:type_name -> inventory.v1.Part
+	113, // 11: inventory.v1.UpdatePartRequest.update_mask:type_name -> google.protobuf.FieldMask
+	106, // 12: inventory.v1.UpdatePartResponse.part:type_name -> inventory.v1.Part
+	5,   // 13: inventory.v1.SearchPartsRequest.language:type_name -> inventory.v1.SearchLanguage
+	102, // 14: inventory.v1.SearchPartsRequest.filter:type_name -> inventory.v1.PartsFilter
+	24,  // 15: inventory.v1.SearchPartsResponse.hits:type_name -> inventory.v1.PartSearchHit
+	106, // 16: inventory.v1.PartSearchHit.part:type_name -> inventory.v1.Part
+	29,  // 17: inventory.v1.ReceiveStockResponse.movement:type_name -> inventory.v1.StockMovement
+	29,  // 18: inventory.v1.ListStockMovementsResponse.movements:type_name -> inventory.v1.StockMovement
+	0,   // 19: inventory.v1.StockMovement.type:type_name -> inventory.v1.StockMovementType
+	114, // 20: inventory.v1.StockMovement.created_at:type_name -> google.protobuf.Timestamp
+	46,  // 21: inventory.v1.CreateWarehouseRequest.warehouse:type_name -> inventory.v1.Warehouse
+	46,  // 22: inventory.v1.CreateWarehouseResponse.warehouse:type_name -> inventory.v1.Warehouse
+	46,  // 23: inventory.v1.ListWarehousesResponse.warehouses:type_name -> inventory.v1.Warehouse
+	29,  // 24: inventory.v1.TransferStockResponse.outgoing:type_name -> inventory.v1.StockMovement
+	29,  // 25: inventory.v1.TransferStockResponse.incoming:type_name -> inventory.v1.StockMovement
+	38,  // 26: inventory.v1.GetStockAvailabilityResponse.availability:type_name -> inventory.v1.PartAvailability
+	47,  // 27: inventory.v1.PartAvailability.locations:type_name -> inventory.v1.StockLocation
+	40,  // 28: inventory.v1.ReserveStockRequest.items:type_name -> inventory.v1.ReservationItem
+	29,  // 29: inventory.v1.ReserveStockResponse.movements:type_name -> inventory.v1.StockMovement
+	29,  // 30: inventory.v1.ReleaseStockResponse.movements:type_name -> inventory.v1.StockMovement
+	29,  // 31: inventory.v1.ConsumeStockResponse.movements:type_name -> inventory.v1.StockMovement
+	114, // 32: inventory.v1.Warehouse.created_at:type_name -> google.protobuf.Timestamp
+	99,  // 33: inventory.v1.CreateCompatibilityRuleRequest.rule:type_name -> inventory.v1.CompatibilityRule
+	99,  // 34: inventory.v1.CreateCompatibilityRuleResponse.rule:type_name -> inventory.v1.CompatibilityRule
+	99,  // 35: inventory.v1.ListCompatibilityRulesResponse.rules:type_name -> inventory.v1.CompatibilityRule
+	101, // 36: inventory.v1.ValidateConfigurationResponse.violations:type_name -> inventory.v1.ConfigurationViolation
+	1,   // 37: inventory.v1.ImportPartsRequest.format:type_name -> inventory.v1.CatalogFormat
+	58,  // 38: inventory.v1.ImportPartsResponse.errors:type_name -> inventory.v1.ImportRowError
+	1,   // 39: inventory.v1.ExportPartsRequest.format:type_name -> inventory.v1.CatalogFormat
+	102, // 40: inventory.v1.ExportPartsRequest.filter:type_name -> inventory.v1.PartsFilter
+	2,   // 41: inventory.v1.Attachment.kind:type_name -> inventory.v1.AttachmentKind
+	114, // 42: inventory.v1.Attachment.created_at:type_name -> google.protobuf.Timestamp
+	2,   // 43: inventory.v1.AttachmentUpload.kind:type_name -> inventory.v1.AttachmentKind
+	62,  // 44: inventory.v1.UploadAttachmentRequest.upload:type_name -> inventory.v1.AttachmentUpload
+	61,  // 45: inventory.v1.UploadAttachmentResponse.attachment:type_name -> inventory.v1.Attachment
+	61,  // 46: inventory.v1.DownloadAttachmentResponse.attachment:type_name -> inventory.v1.Attachment
+	114, // 47: inventory.v1.SchedulePartPriceRequest.effective_from:type_name -> google.protobuf.Timestamp
+	76,  // 48: inventory.v1.SchedulePartPriceResponse.price_change:type_name -> inventory.v1.PriceChange
+	76,  // 49: inventory.v1.ListPriceHistoryResponse.price_changes:type_name -> inventory.v1.PriceChange
+	114, // 50: inventory.v1.GetPartPricesRequest.at:type_name -> google.protobuf.Timestamp
+	75,  // 51: inventory.v1.GetPartPricesResponse.prices:type_name -> inventory.v1.PartPrice
+	114, // 52: inventory.v1.PartPrice.effective_from:type_name -> google.protobuf.Timestamp
+	114, // 53: inventory.v1.PriceChange.effective_from:type_name -> google.protobuf.Timestamp
+	114, // 54: inventory.v1.PriceChange.created_at:type_name -> google.protobuf.Timestamp
+	102, // 55: inventory.v1.WatchPartsRequest.filter:type_name -> inventory.v1.PartsFilter
+	3,   // 56: inventory.v1.PartEvent.type:type_name -> inventory.v1.PartEventType
+	106, // 57: inventory.v1.PartEvent.part:type_name -> inventory.v1.Part
+	114, // 58: inventory.v1.PartEvent.occurred_at:type_name -> google.protobuf.Timestamp
+	107, // 59: inventory.v1.CreateCategoryRequest.category:type_name -> inventory.v1.PartCategory
+	107, // 60: inventory.v1.CreateCategoryResponse.category:type_name -> inventory.v1.PartCategory
+	107, // 61: inventory.v1.UpdateCategoryRequest.category:type_name -> inventory.v1.PartCategory
+	113, // 62: inventory.v1.UpdateCategoryRequest.update_mask:type_name -> google.protobuf.FieldMask
+	107, // 63: inventory.v1.UpdateCategoryResponse.category:type_name -> inventory.v1.PartCategory
+	107, // 64: inventory.v1.ListCategoriesResponse.categories:type_name -> inventory.v1.PartCategory
+	107, // 65: inventory.v1.GetCategorySchemaResponse.category:type_name -> inventory.v1.PartCategory
+	108, // 66: inventory.v1.GetCategorySchemaResponse.fields:type_name -> inventory.v1.MetadataField
+	95,  // 67: inventory.v1.CreateRocketModelRequest.model:type_name -> inventory.v1.RocketModel
+	95,  // 68: inventory.v1.CreateRocketModelResponse.model:type_name -> inventory.v1.RocketModel
+	97,  // 69: inventory.v1.ListRocketModelsResponse.models:type_name -> inventory.v1.RocketModelSummary
+	95,  // 70: inventory.v1.ExpandRocketModelResponse.model:type_name -> inventory.v1.RocketModel
+	98,  // 71: inventory.v1.ExpandRocketModelResponse.lines:type_name -> inventory.v1.BomLine
+	96,  // 72: inventory.v1.RocketModel.items:type_name -> inventory.v1.BomItem
+	114, // 73: inventory.v1.RocketModel.created_at:type_name -> google.protobuf.Timestamp
+	95,  // 74: inventory.v1.RocketModelSummary.model:type_name -> inventory.v1.RocketModel
+	106, // 75: inventory.v1.BomLine.part:type_name -> inventory.v1.Part
+	4,   // 76: inventory.v1.CompatibilityRule.type:type_name -> inventory.v1.CompatibilityRuleType
+	100, // 77: inventory.v1.CompatibilityRule.subject:type_name -> inventory.v1.RuleTarget
+	100, // 78: inventory.v1.CompatibilityRule.object:type_name -> inventory.v1.RuleTarget
+	114, // 79: inventory.v1.CompatibilityRule.created_at:type_name -> google.protobuf.Timestamp
+	7,   // 80: inventory.v1.RuleTarget.category:type_name -> inventory.v1.Category
+	4,   // 81: inventory.v1.ConfigurationViolation.type:type_name -> inventory.v1.CompatibilityRuleType
+	7,   // 82: inventory.v1.PartsFilter.categories:type_name -> inventory.v1.Category
+	103, // 83: inventory.v1.PartsFilter.price:type_name -> inventory.v1.DoubleRange
+	104, // 84: inventory.v1.PartsFilter.stock_quantity:type_name -> inventory.v1.Int64Range
+	103, // 85: inventory.v1.PartsFilter.length:type_name -> inventory.v1.DoubleRange
+	103, // 86: inventory.v1.PartsFilter.width:type_name -> inventory.v1.DoubleRange
+	103, // 87: inventory.v1.PartsFilter.height:type_name -> inventory.v1.DoubleRange
+	103, // 88: inventory.v1.PartsFilter.weight:type_name -> inventory.v1.DoubleRange
+	105, // 89: inventory.v1.PartsFilter.metadata:type_name -> inventory.v1.MetadataPredicate
+	6,   // 90: inventory.v1.MetadataPredicate.operator:type_name -> inventory.v1.MetadataOperator
+	111, // 91: inventory.v1.MetadataPredicate.value:type_name -> inventory.v1.Value
+	7,   // 92: inventory.v1.Part.category:type_name -> inventory.v1.Category
+	109, // 93: inventory.v1.Part.dimensions:type_name -> inventory.v1.Dimensions
+	110, // 94: inventory.v1.Part.manufacturer:type_name -> inventory.v1.Manufacturer
+	112, // 95: inventory.v1.Part.metadata:type_name -> inventory.v1.Part.MetadataEntry
+	114, // 96: inventory.v1.Part.created_at:type_name -> google.protobuf.Timestamp
+	114, // 97: inventory.v1.Part.updated_at:type_name -> google.protobuf.Timestamp
+	61,  // 98: inventory.v1.Part.attachments:type_name -> inventory.v1.Attachment
+	61,  // 99: inventory.v1.Part.primary_image:type_name -> inventory.v1.Attachment
+	47,  // 100: inventory.v1.Part.stock_locations:type_name -> inventory.v1.StockLocation
+	108, // 101: inventory.v1.PartCategory.metadata_schema:type_name -> inventory.v1.MetadataField
+	114, // 102: inventory.v1.PartCategory.created_at:type_name -> google.protobuf.Timestamp
+	114, // 103: inventory.v1.PartCategory.updated_at:type_name -> google.protobuf.Timestamp
+	8,   // 104: inventory.v1.MetadataField.type:type_name -> inventory.v1.MetadataFieldType
+	103, // 105: inventory.v1.MetadataField.range:type_name -> inventory.v1.DoubleRange
+	111, // 106: inventory.v1.Part.MetadataEntry.value:type_name -> inventory.v1.Value
+	10,  // 107: inventory.v1.InventoryService.GetPart:input_type -> inventory.v1.GetPartRequest
+	12,  // 108: inventory.v1.InventoryService.ListParts:input_type -> inventory.v1.ListPartsRequest
+	14,  // 109: inventory.v1.InventoryService.BatchGetParts:input_type -> inventory.v1.BatchGetPartsRequest
+	16,  // 110: inventory.v1.InventoryService.CreatePart:input_type -> inventory.v1.CreatePartRequest
+	18,  // 111: inventory.v1.InventoryService.UpdatePart:input_type -> inventory.v1.UpdatePartRequest
+	20,  // 112: inventory.v1.InventoryService.DeletePart:input_type -> inventory.v1.DeletePartRequest
+	22,  // 113: inventory.v1.InventoryService.SearchParts:input_type -> inventory.v1.SearchPartsRequest
+	25,  // 114: inventory.v1.InventoryService.ReceiveStock:input_type -> inventory.v1.ReceiveStockRequest
+	27,  // 115: inventory.v1.InventoryService.ListStockMovements:input_type -> inventory.v1.ListStockMovementsRequest
+	30,  // 116: inventory.v1.InventoryService.CreateWarehouse:input_type -> inventory.v1.CreateWarehouseRequest
+	32,  // 117: inventory.v1.InventoryService.ListWarehouses:input_type -> inventory.v1.ListWarehousesRequest
+	34,  // 118: inventory.v1.InventoryService.TransferStock:input_type -> inventory.v1.TransferStockRequest
+	36,  // 119: inventory.v1.InventoryService.GetStockAvailability:input_type -> inventory.v1.GetStockAvailabilityRequest
+	39,  // 120: inventory.v1.InventoryService.ReserveStock:input_type -> inventory.v1.ReserveStockRequest
+	42,  // 121: inventory.v1.InventoryService.ReleaseStock:input_type -> inventory.v1.ReleaseStockRequest
+	44,  // 122: inventory.v1.InventoryService.ConsumeStock:input_type -> inventory.v1.ConsumeStockRequest
+	48,  // 123: inventory.v1.InventoryService.CreateCompatibilityRule:input_type -> inventory.v1.CreateCompatibilityRuleRequest
+	50,  // 124: inventory.v1.InventoryService.DeleteCompatibilityRule:input_type -> inventory.v1.DeleteCompatibilityRuleRequest
+	52,  // 125: inventory.v1.InventoryService.ListCompatibilityRules:input_type -> inventory.v1.ListCompatibilityRulesRequest
+	54,  // 126: inventory.v1.InventoryService.ValidateConfiguration:input_type -> inventory.v1.ValidateConfigurationRequest
+	56,  // 127: inventory.v1.InventoryService.ImportParts:input_type -> inventory.v1.ImportPartsRequest
+	59,  // 128: inventory.v1.InventoryService.ExportParts:input_type -> inventory.v1.ExportPartsRequest
+	89,  // 129: inventory.v1.InventoryService.CreateRocketModel:input_type -> inventory.v1.CreateRocketModelRequest
+	91,  // 130: inventory.v1.InventoryService.ListRocketModels:input_type -> inventory.v1.ListRocketModelsRequest
+	93,  // 131: inventory.v1.InventoryService.ExpandRocketModel:input_type -> inventory.v1.ExpandRocketModelRequest
+	63,  // 132: inventory.v1.InventoryService.UploadAttachment:input_type -> inventory.v1.UploadAttachmentRequest
+	65,  // 133: inventory.v1.InventoryService.DownloadAttachment:input_type -> inventory.v1.DownloadAttachmentRequest
+	67,  // 134: inventory.v1.InventoryService.DeleteAttachment:input_type -> inventory.v1.DeleteAttachmentRequest
+	69,  // 135: inventory.v1.InventoryService.SchedulePartPrice:input_type -> inventory.v1.SchedulePartPriceRequest
+	71,  // 136: inventory.v1.InventoryService.ListPriceHistory:input_type -> inventory.v1.ListPriceHistoryRequest
+	73,  // 137: inventory.v1.InventoryService.GetPartPrices:input_type -> inventory.v1.GetPartPricesRequest
+	77,  // 138: inventory.v1.InventoryService.WatchParts:input_type -> inventory.v1.WatchPartsRequest
+	79,  // 139: inventory.v1.InventoryService.CreateCategory:input_type -> inventory.v1.CreateCategoryRequest
+	81,  // 140: inventory.v1.InventoryService.UpdateCategory:input_type -> inventory.v1.UpdateCategoryRequest
+	83,  // 141: inventory.v1.InventoryService.DeleteCategory:input_type -> inventory.v1.DeleteCategoryRequest
+	85,  // 142: inventory.v1.InventoryService.ListCategories:input_type -> inventory.v1.ListCategoriesRequest
+	87,  // 143: inventory.v1.InventoryService.GetCategorySchema:input_type -> inventory.v1.GetCategorySchemaRequest
+	11,  // 144: inventory.v1.InventoryService.GetPart:output_type -> inventory.v1.GetPartResponse
+	13,  // 145: inventory.v1.InventoryService.ListParts:output_type -> inventory.v1.ListPartsResponse
+	15,  // 146: inventory.v1.InventoryService.BatchGetParts:output_type -> inventory.v1.BatchGetPartsResponse
+	17,  // 147: inventory.v1.InventoryService.CreatePart:output_type -> inventory.v1.CreatePartResponse
+	19,  // 148: inventory.v1.InventoryService.UpdatePart:output_type -> inventory.v1.UpdatePartResponse
+	21,  // 149: inventory.v1.InventoryService.DeletePart:output_type -> inventory.v1.DeletePartResponse
+	23,  // 150: inventory.v1.InventoryService.SearchParts:output_type -> inventory.v1.SearchPartsResponse
+	26,  // 151: inventory.v1.InventoryService.ReceiveStock:output_type -> inventory.v1.ReceiveStockResponse
+	28,  // 152: inventory.v1.InventoryService.ListStockMovements:output_type -> inventory.v1.ListStockMovementsResponse
+	31,  // 153: inventory.v1.InventoryService.CreateWarehouse:output_type -> inventory.v1.CreateWarehouseResponse
+	33,  // 154: inventory.v1.InventoryService.ListWarehouses:output_type -> inventory.v1.ListWarehousesResponse
+	35,  // 155: inventory.v1.InventoryService.TransferStock:output_type -> inventory.v1.TransferStockResponse
+	37,  // 156: inventory.v1.InventoryService.GetStockAvailability:output_type -> inventory.v1.GetStockAvailabilityResponse
+	41,  // 157: inventory.v1.InventoryService.ReserveStock:output_type -> inventory.v1.ReserveStockResponse
+	43,  // 158: inventory.v1.InventoryService.ReleaseStock:output_type -> inventory.v1.ReleaseStockResponse
+	45,  // 159: inventory.v1.InventoryService.ConsumeStock:output_type -> inventory.v1.ConsumeStockResponse
+	49,  // 160: inventory.v1.InventoryService.CreateCompatibilityRule:output_type -> inventory.v1.CreateCompatibilityRuleResponse
+	51,  // 161: inventory.v1.InventoryService.DeleteCompatibilityRule:output_type -> inventory.v1.DeleteCompatibilityRuleResponse
+	53,  // 162: inventory.v1.InventoryService.ListCompatibilityRules:output_type -> inventory.v1.ListCompatibilityRulesResponse
+	55,  // 163: inventory.v1.InventoryService.ValidateConfiguration:output_type -> inventory.v1.ValidateConfigurationResponse
+	57,  // 164: inventory.v1.InventoryService.ImportParts:output_type -> inventory.v1.ImportPartsResponse
+	60,  // 165: inventory.v1.InventoryService.ExportParts:output_type -> inventory.v1.ExportPartsResponse
+	90,  // 166: inventory.v1.InventoryService.CreateRocketModel:output_type -> inventory.v1.CreateRocketModelResponse
+	92,  // 167: inventory.v1.InventoryService.ListRocketModels:output_type -> inventory.v1.ListRocketModelsResponse
+	94,  // 168: inventory.v1.InventoryService.ExpandRocketModel:output_type -> inventory.v1.ExpandRocketModelResponse
+	64,  // 169: inventory.v1.InventoryService.UploadAttachment:output_type -> inventory.v1.UploadAttachmentResponse
+	66,  // 170: inventory.v1.InventoryService.DownloadAttachment:output_type -> inventory.v1.DownloadAttachmentResponse
+	68,  // 171: inventory.v1.InventoryService.DeleteAttachment:output_type -> inventory.v1.DeleteAttachmentResponse
+	70,  // 172: inventory.v1.InventoryService.SchedulePartPrice:output_type -> inventory.v1.SchedulePartPriceResponse
+	72,  // 173: inventory.v1.InventoryService.ListPriceHistory:output_type -> inventory.v1.ListPriceHistoryResponse
+	74,  // 174: inventory.v1.InventoryService.GetPartPrices:output_type -> inventory.v1.GetPartPricesResponse
+	78,  // 175: inventory.v1.InventoryService.WatchParts:output_type -> inventory.v1.PartEvent
+	80,  // 176: inventory.v1.InventoryService.CreateCategory:output_type -> inventory.v1.CreateCategoryResponse
+	82,  // 177: inventory.v1.InventoryService.UpdateCategory:output_type -> inventory.v1.UpdateCategoryResponse
+	84,  // 178: inventory.v1.InventoryService.DeleteCategory:output_type -> inventory.v1.DeleteCategoryResponse
+	86,  // 179: inventory.v1.InventoryService.ListCategories:output_type -> inventory.v1.ListCategoriesResponse
+	88,  // 180: inventory.v1.InventoryService.GetCategorySchema:output_type -> inventory.v1.GetCategorySchemaResponse
+	144, // [144:181] is the sub-list for method output_type
+	107, // [107:144] is the sub-list for method input_type
+	107, // [107:107] is the sub-list for extension type_name
+	107, // [107:107] is the sub-list for extension extendee
+	0,   // [0:107] is the sub-list for field type_name
 }
 
 func init() { file_inventory_v1_inventory_proto_init() }
@@ -877,7 +7666,9 @@ func file_inventory_v1_inventory_proto_init() {
 	if File_inventory_v1_inventory_proto != nil {
 		return
 	}
-	file_inventory_v1_inventory_proto_msgTypes[8].OneofWrappers = []any{
+	file_inventory_v1_inventory_proto_msgTypes[93].OneofWrappers = []any{}
+	file_inventory_v1_inventory_proto_msgTypes[94].OneofWrappers = []any{}
+	file_inventory_v1_inventory_proto_msgTypes[101].OneofWrappers = []any{
 		(*Value_StringValue)(nil),
 		(*Value_Int64Value)(nil),
 		(*Value_DoubleValue)(nil),
@@ -888,8 +7679,8 @@ func file_inventory_v1_inventory_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_inventory_v1_inventory_proto_rawDesc), len(file_inventory_v1_inventory_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   10,
+			NumEnums:      10,
+			NumMessages:   103,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,8 +19,43 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	InventoryService_GetPart_FullMethodName   = "/inventory.v1.InventoryService/GetPart"
-	InventoryService_ListParts_FullMethodName = "/inventory.v1.InventoryService/ListParts"
+	InventoryService_GetPart_FullMethodName                 = "/inventory.v1.InventoryService/GetPart"
+	InventoryService_ListParts_FullMethodName               = "/inventory.v1.InventoryService/ListParts"
+	InventoryService_BatchGetParts_FullMethodName           = "/inventory.v1.InventoryService/BatchGetParts"
+	InventoryService_CreatePart_FullMethodName              = "/inventory.v1.InventoryService/CreatePart"
+	InventoryService_UpdatePart_FullMethodName              = "/inventory.v1.InventoryService/UpdatePart"
+	InventoryService_DeletePart_FullMethodName              = "/inventory.v1.InventoryService/DeletePart"
+	InventoryService_SearchParts_FullMethodName             = "/inventory.v1.InventoryService/SearchParts"
+	InventoryService_ReceiveStock_FullMethodName            = "/inventory.v1.InventoryService/ReceiveStock"
+	InventoryService_ListStockMovements_FullMethodName      = "/inventory.v1.InventoryService/ListStockMovements"
+	InventoryService_CreateWarehouse_FullMethodName         = "/inventory.v1.InventoryService/CreateWarehouse"
+	InventoryService_ListWarehouses_FullMethodName          = "/inventory.v1.InventoryService/ListWarehouses"
+	InventoryService_TransferStock_FullMethodName           = "/inventory.v1.InventoryService/TransferStock"
+	InventoryService_GetStockAvailability_FullMethodName    = "/inventory.v1.InventoryService/GetStockAvailability"
+	InventoryService_ReserveStock_FullMethodName            = "/inventory.v1.InventoryService/ReserveStock"
+	InventoryService_ReleaseStock_FullMethodName            = "/inventory.v1.InventoryService/ReleaseStock"
+	InventoryService_ConsumeStock_FullMethodName            = "/inventory.v1.InventoryService/ConsumeStock"
+	InventoryService_CreateCompatibilityRule_FullMethodName = "/inventory.v1.InventoryService/CreateCompatibilityRule"
+	InventoryService_DeleteCompatibilityRule_FullMethodName = "/inventory.v1.InventoryService/DeleteCompatibilityRule"
+	InventoryService_ListCompatibilityRules_FullMethodName  = "/inventory.v1.InventoryService/ListCompatibilityRules"
+	InventoryService_ValidateConfiguration_FullMethodName   = "/inventory.v1.InventoryService/ValidateConfiguration"
+	InventoryService_ImportParts_FullMethodName             = "/inventory.v1.InventoryService/ImportParts"
+	InventoryService_ExportParts_FullMethodName             = "/inventory.v1.InventoryService/ExportParts"
+	InventoryService_CreateRocketModel_FullMethodName       = "/inventory.v1.InventoryService/CreateRocketModel"
+	InventoryService_ListRocketModels_FullMethodName        = "/inventory.v1.InventoryService/ListRocketModels"
+	InventoryService_ExpandRocketModel_FullMethodName       = "/inventory.v1.InventoryService/ExpandRocketModel"
+	InventoryService_UploadAttachment_FullMethodName        = "/inventory.v1.InventoryService/UploadAttachment"
+	InventoryService_DownloadAttachment_FullMethodName      = "/inventory.v1.InventoryService/DownloadAttachment"
+	InventoryService_DeleteAttachment_FullMethodName        = "/inventory.v1.InventoryService/DeleteAttachment"
+	InventoryService_SchedulePartPrice_FullMethodName       = "/inventory.v1.InventoryService/SchedulePartPrice"
+	InventoryService_ListPriceHistory_FullMethodName        = "/inventory.v1.InventoryService/ListPriceHistory"
+	InventoryService_GetPartPrices_FullMethodName           = "/inventory.v1.InventoryService/GetPartPrices"
+	InventoryService_WatchParts_FullMethodName              = "/inventory.v1.InventoryService/WatchParts"
+	InventoryService_CreateCategory_FullMethodName          = "/inventory.v1.InventoryService/CreateCategory"
+	InventoryService_UpdateCategory_FullMethodName          = "/inventory.v1.InventoryService/UpdateCategory"
+	InventoryService_DeleteCategory_FullMethodName          = "/inventory.v1.InventoryService/DeleteCategory"
+	InventoryService_ListCategories_FullMethodName          = "/inventory.v1.InventoryService/ListCategories"
+	InventoryService_GetCategorySchema_FullMethodName       = "/inventory.v1.InventoryService/GetCategorySchema"
 )
 
 // InventoryServiceClient is the client API for InventoryService service.
@@ -33,6 +68,79 @@ type InventoryServiceClient interface {
 	GetPart(ctx context.Context, in *GetPartRequest, opts ...grpc.CallOption) (*GetPartResponse, error)
 	// Возвращает список деталей с возможностью фильтрации
 	ListParts(ctx context.Context, in *ListPartsRequest, opts ...grpc.CallOption) (*ListPartsResponse, error)
+	// Возвращает детали по списку UUID в порядке запроса и UUID, для которых деталь не найдена
+	BatchGetParts(ctx context.Context, in *BatchGetPartsRequest, opts ...grpc.CallOption) (*BatchGetPartsResponse, error)
+	// Создает деталь (только для администраторов)
+	CreatePart(ctx context.Context, in *CreatePartRequest, opts ...grpc.CallOption) (*CreatePartResponse, error)
+	// Обновляет поля детали, перечисленные в update_mask, если part.version совпадает с текущей (только для администраторов)
+	UpdatePart(ctx context.Context, in *UpdatePartRequest, opts ...grpc.CallOption) (*UpdatePartResponse, error)
+	// Помечает деталь удаленной (только для администраторов)
+	DeletePart(ctx context.Context, in *DeletePartRequest, opts ...grpc.CallOption) (*DeletePartResponse, error)
+	// Полнотекстовый поиск деталей с ранжированием по релевантности
+	SearchParts(ctx context.Context, in *SearchPartsRequest, opts ...grpc.CallOption) (*SearchPartsResponse, error)
+	// Приход детали на склад
+	ReceiveStock(ctx context.Context, in *ReceiveStockRequest, opts ...grpc.CallOption) (*ReceiveStockResponse, error)
+	// История движений остатка детали, от новых к старым
+	ListStockMovements(ctx context.Context, in *ListStockMovementsRequest, opts ...grpc.CallOption) (*ListStockMovementsResponse, error)
+	// Создает склад (только для администраторов)
+	CreateWarehouse(ctx context.Context, in *CreateWarehouseRequest, opts ...grpc.CallOption) (*CreateWarehouseResponse, error)
+	// Возвращает все склады
+	ListWarehouses(ctx context.Context, in *ListWarehousesRequest, opts ...grpc.CallOption) (*ListWarehousesResponse, error)
+	// Перемещает остаток детали между складами (только для администраторов)
+	TransferStock(ctx context.Context, in *TransferStockRequest, opts ...grpc.CallOption) (*TransferStockResponse, error)
+	// Возвращает остатки деталей по складам
+	GetStockAvailability(ctx context.Context, in *GetStockAvailabilityRequest, opts ...grpc.CallOption) (*GetStockAvailabilityResponse, error)
+	// Резервирует детали под заказ, выбирая склады по настроенной стратегии
+	ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*ReserveStockResponse, error)
+	// Снимает все резервы по документу-основанию
+	ReleaseStock(ctx context.Context, in *ReleaseStockRequest, opts ...grpc.CallOption) (*ReleaseStockResponse, error)
+	// Переводит резервы по документу-основанию в расход: детали заказа ушли со склада
+	ConsumeStock(ctx context.Context, in *ConsumeStockRequest, opts ...grpc.CallOption) (*ConsumeStockResponse, error)
+	// Создает правило совместимости деталей (только для администраторов)
+	CreateCompatibilityRule(ctx context.Context, in *CreateCompatibilityRuleRequest, opts ...grpc.CallOption) (*CreateCompatibilityRuleResponse, error)
+	// Удаляет правило совместимости (только для администраторов)
+	DeleteCompatibilityRule(ctx context.Context, in *DeleteCompatibilityRuleRequest, opts ...grpc.CallOption) (*DeleteCompatibilityRuleResponse, error)
+	// Возвращает все правила совместимости
+	ListCompatibilityRules(ctx context.Context, in *ListCompatibilityRulesRequest, opts ...grpc.CallOption) (*ListCompatibilityRulesResponse, error)
+	// Проверяет набор деталей на совместимость и полноту конфигурации
+	ValidateConfiguration(ctx context.Context, in *ValidateConfigurationRequest, opts ...grpc.CallOption) (*ValidateConfigurationResponse, error)
+	// Импортирует каталог деталей из CSV, JSON или NDJSON с upsert по UUID (только для администраторов).
+	// Файл передается частями, формат и dry_run берутся из первого сообщения
+	ImportParts(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportPartsRequest, ImportPartsResponse], error)
+	// Выгружает каталог деталей в CSV, JSON или NDJSON частями
+	ExportParts(ctx context.Context, in *ExportPartsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportPartsResponse], error)
+	// Создает модель ракеты со спецификацией деталей (только для администраторов)
+	CreateRocketModel(ctx context.Context, in *CreateRocketModelRequest, opts ...grpc.CallOption) (*CreateRocketModelResponse, error)
+	// Возвращает модели ракет с рассчитанной ценой и доступностью
+	ListRocketModels(ctx context.Context, in *ListRocketModelsRequest, opts ...grpc.CallOption) (*ListRocketModelsResponse, error)
+	// Раскладывает модель ракеты на детали с учетом остатков и альтернатив
+	ExpandRocketModel(ctx context.Context, in *ExpandRocketModelRequest, opts ...grpc.CallOption) (*ExpandRocketModelResponse, error)
+	// Загружает фото, чертеж или документацию детали в GridFS (только для администраторов).
+	// Описание файла передается в первом сообщении, содержимое — частями
+	UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse], error)
+	// Скачивает вложение: первое сообщение содержит описание файла, следующие — его части
+	DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error)
+	// Удаляет вложение детали (только для администраторов)
+	DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error)
+	// Планирует изменение цены детали с будущей даты (только для администраторов)
+	SchedulePartPrice(ctx context.Context, in *SchedulePartPriceRequest, opts ...grpc.CallOption) (*SchedulePartPriceResponse, error)
+	// История цен детали, включая запланированные, от поздних к ранним
+	ListPriceHistory(ctx context.Context, in *ListPriceHistoryRequest, opts ...grpc.CallOption) (*ListPriceHistoryResponse, error)
+	// Возвращает цены деталей, действовавшие в заданный момент
+	GetPartPrices(ctx context.Context, in *GetPartPricesRequest, opts ...grpc.CallOption) (*GetPartPricesResponse, error)
+	// Подписка на изменения деталей под фильтром: сначала снимок текущего состояния,
+	// затем изменения по мере их появления. Стрим открыт, пока клиент его не закроет
+	WatchParts(ctx context.Context, in *WatchPartsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PartEvent], error)
+	// Создает категорию деталей, в том числе подкатегорию существующей (только для администраторов)
+	CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error)
+	// Изменяет название, описание, родителя или схему метаданных категории (только для администраторов)
+	UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*UpdateCategoryResponse, error)
+	// Удаляет категорию без подкатегорий и деталей (только для администраторов)
+	DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error)
+	// Возвращает все категории, родительская категория идет раньше подкатегорий
+	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	// Возвращает схему метаданных деталей категории вместе с полями, унаследованными от родителей
+	GetCategorySchema(ctx context.Context, in *GetCategorySchemaRequest, opts ...grpc.CallOption) (*GetCategorySchemaResponse, error)
 }
 
 type inventoryServiceClient struct {
@@ -63,6 +171,389 @@ func (c *inventoryServiceClient) ListParts(ctx context.Context, in *ListPartsReq
 	return out, nil
 }
 
+func (c *inventoryServiceClient) BatchGetParts(ctx context.Context, in *BatchGetPartsRequest, opts ...grpc.CallOption) (*BatchGetPartsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetPartsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_BatchGetParts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CreatePart(ctx context.Context, in *CreatePartRequest, opts ...grpc.CallOption) (*CreatePartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePartResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CreatePart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) UpdatePart(ctx context.Context, in *UpdatePartRequest, opts ...grpc.CallOption) (*UpdatePartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePartResponse)
+	err := c.cc.Invoke(ctx, InventoryService_UpdatePart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) DeletePart(ctx context.Context, in *DeletePartRequest, opts ...grpc.CallOption) (*DeletePartResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePartResponse)
+	err := c.cc.Invoke(ctx, InventoryService_DeletePart_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SearchParts(ctx context.Context, in *SearchPartsRequest, opts ...grpc.CallOption) (*SearchPartsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchPartsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SearchParts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ReceiveStock(ctx context.Context, in *ReceiveStockRequest, opts ...grpc.CallOption) (*ReceiveStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReceiveStockResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ReceiveStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListStockMovements(ctx context.Context, in *ListStockMovementsRequest, opts ...grpc.CallOption) (*ListStockMovementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStockMovementsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListStockMovements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CreateWarehouse(ctx context.Context, in *CreateWarehouseRequest, opts ...grpc.CallOption) (*CreateWarehouseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateWarehouseResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CreateWarehouse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListWarehouses(ctx context.Context, in *ListWarehousesRequest, opts ...grpc.CallOption) (*ListWarehousesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListWarehousesResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListWarehouses_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) TransferStock(ctx context.Context, in *TransferStockRequest, opts ...grpc.CallOption) (*TransferStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferStockResponse)
+	err := c.cc.Invoke(ctx, InventoryService_TransferStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetStockAvailability(ctx context.Context, in *GetStockAvailabilityRequest, opts ...grpc.CallOption) (*GetStockAvailabilityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStockAvailabilityResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetStockAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*ReserveStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveStockResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ReserveStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ReleaseStock(ctx context.Context, in *ReleaseStockRequest, opts ...grpc.CallOption) (*ReleaseStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseStockResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ReleaseStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ConsumeStock(ctx context.Context, in *ConsumeStockRequest, opts ...grpc.CallOption) (*ConsumeStockResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConsumeStockResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ConsumeStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) CreateCompatibilityRule(ctx context.Context, in *CreateCompatibilityRuleRequest, opts ...grpc.CallOption) (*CreateCompatibilityRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCompatibilityRuleResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CreateCompatibilityRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) DeleteCompatibilityRule(ctx context.Context, in *DeleteCompatibilityRuleRequest, opts ...grpc.CallOption) (*DeleteCompatibilityRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCompatibilityRuleResponse)
+	err := c.cc.Invoke(ctx, InventoryService_DeleteCompatibilityRule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListCompatibilityRules(ctx context.Context, in *ListCompatibilityRulesRequest, opts ...grpc.CallOption) (*ListCompatibilityRulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCompatibilityRulesResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListCompatibilityRules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ValidateConfiguration(ctx context.Context, in *ValidateConfigurationRequest, opts ...grpc.CallOption) (*ValidateConfigurationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateConfigurationResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ValidateConfiguration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ImportParts(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportPartsRequest, ImportPartsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InventoryService_ServiceDesc.Streams[0], InventoryService_ImportParts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportPartsRequest, ImportPartsResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_ImportPartsClient = grpc.ClientStreamingClient[ImportPartsRequest, ImportPartsResponse]
+
+func (c *inventoryServiceClient) ExportParts(ctx context.Context, in *ExportPartsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportPartsResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InventoryService_ServiceDesc.Streams[1], InventoryService_ExportParts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportPartsRequest, ExportPartsResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_ExportPartsClient = grpc.ServerStreamingClient[ExportPartsResponse]
+
+func (c *inventoryServiceClient) CreateRocketModel(ctx context.Context, in *CreateRocketModelRequest, opts ...grpc.CallOption) (*CreateRocketModelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateRocketModelResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CreateRocketModel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListRocketModels(ctx context.Context, in *ListRocketModelsRequest, opts ...grpc.CallOption) (*ListRocketModelsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRocketModelsResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListRocketModels_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ExpandRocketModel(ctx context.Context, in *ExpandRocketModelRequest, opts ...grpc.CallOption) (*ExpandRocketModelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExpandRocketModelResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ExpandRocketModel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) UploadAttachment(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InventoryService_ServiceDesc.Streams[2], InventoryService_UploadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[UploadAttachmentRequest, UploadAttachmentResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_UploadAttachmentClient = grpc.ClientStreamingClient[UploadAttachmentRequest, UploadAttachmentResponse]
+
+func (c *inventoryServiceClient) DownloadAttachment(ctx context.Context, in *DownloadAttachmentRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DownloadAttachmentResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InventoryService_ServiceDesc.Streams[3], InventoryService_DownloadAttachment_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DownloadAttachmentRequest, DownloadAttachmentResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_DownloadAttachmentClient = grpc.ServerStreamingClient[DownloadAttachmentResponse]
+
+func (c *inventoryServiceClient) DeleteAttachment(ctx context.Context, in *DeleteAttachmentRequest, opts ...grpc.CallOption) (*DeleteAttachmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteAttachmentResponse)
+	err := c.cc.Invoke(ctx, InventoryService_DeleteAttachment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) SchedulePartPrice(ctx context.Context, in *SchedulePartPriceRequest, opts ...grpc.CallOption) (*SchedulePartPriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SchedulePartPriceResponse)
+	err := c.cc.Invoke(ctx, InventoryService_SchedulePartPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListPriceHistory(ctx context.Context, in *ListPriceHistoryRequest, opts ...grpc.CallOption) (*ListPriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetPartPrices(ctx context.Context, in *GetPartPricesRequest, opts ...grpc.CallOption) (*GetPartPricesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPartPricesResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetPartPrices_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) WatchParts(ctx context.Context, in *WatchPartsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PartEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &InventoryService_ServiceDesc.Streams[4], InventoryService_WatchParts_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchPartsRequest, PartEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_WatchPartsClient = grpc.ServerStreamingClient[PartEvent]
+
+func (c *inventoryServiceClient) CreateCategory(ctx context.Context, in *CreateCategoryRequest, opts ...grpc.CallOption) (*CreateCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCategoryResponse)
+	err := c.cc.Invoke(ctx, InventoryService_CreateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) UpdateCategory(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*UpdateCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCategoryResponse)
+	err := c.cc.Invoke(ctx, InventoryService_UpdateCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) DeleteCategory(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*DeleteCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCategoryResponse)
+	err := c.cc.Invoke(ctx, InventoryService_DeleteCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, InventoryService_ListCategories_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryServiceClient) GetCategorySchema(ctx context.Context, in *GetCategorySchemaRequest, opts ...grpc.CallOption) (*GetCategorySchemaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategorySchemaResponse)
+	err := c.cc.Invoke(ctx, InventoryService_GetCategorySchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InventoryServiceServer is the server API for InventoryService service.
 // All implementations must embed UnimplementedInventoryServiceServer
 // for forward compatibility.
@@ -73,75 +564,840 @@ type InventoryServiceServer interface {
 	GetPart(context.Context, *GetPartRequest) (*GetPartResponse, error)
 	// Возвращает список деталей с возможностью фильтрации
 	ListParts(context.Context, *ListPartsRequest) (*ListPartsResponse, error)
+	// Возвращает детали по списку UUID в порядке запроса и UUID, для которых деталь не найдена
+	BatchGetParts(context.Context, *BatchGetPartsRequest) (*BatchGetPartsResponse, error)
+	// Создает деталь (только для администраторов)
+	CreatePart(context.Context, *CreatePartRequest) (*CreatePartResponse, error)
+	// Обновляет поля детали, перечисленные в update_mask, если part.version совпадает с текущей (только для администраторов)
+	UpdatePart(context.Context, *UpdatePartRequest) (*UpdatePartResponse, error)
+	// Помечает деталь удаленной (только для администраторов)
+	DeletePart(context.Context, *DeletePartRequest) (*DeletePartResponse, error)
+	// Полнотекстовый поиск деталей с ранжированием по релевантности
+	SearchParts(context.Context, *SearchPartsRequest) (*SearchPartsResponse, error)
+	// Приход детали на склад
+	ReceiveStock(context.Context, *ReceiveStockRequest) (*ReceiveStockResponse, error)
+	// История движений остатка детали, от новых к старым
+	ListStockMovements(context.Context, *ListStockMovementsRequest) (*ListStockMovementsResponse, error)
+	// Создает склад (только для администраторов)
+	CreateWarehouse(context.Context, *CreateWarehouseRequest) (*CreateWarehouseResponse, error)
+	// Возвращает все склады
+	ListWarehouses(context.Context, *ListWarehousesRequest) (*ListWarehousesResponse, error)
+	// Перемещает остаток детали между складами (только для администраторов)
+	TransferStock(context.Context, *TransferStockRequest) (*TransferStockResponse, error)
+	// Возвращает остатки деталей по складам
+	GetStockAvailability(context.Context, *GetStockAvailabilityRequest) (*GetStockAvailabilityResponse, error)
+	// Резервирует детали под заказ, выбирая склады по настроенной стратегии
+	ReserveStock(context.Context, *ReserveStockRequest) (*ReserveStockResponse, error)
+	// Снимает все резервы по документу-основанию
+	ReleaseStock(context.Context, *ReleaseStockRequest) (*ReleaseStockResponse, error)
+	// Переводит резервы по документу-основанию в расход: детали заказа ушли со склада
+	ConsumeStock(context.Context, *ConsumeStockRequest) (*ConsumeStockResponse, error)
+	// Создает правило совместимости деталей (только для администраторов)
+	CreateCompatibilityRule(context.Context, *CreateCompatibilityRuleRequest) (*CreateCompatibilityRuleResponse, error)
+	// Удаляет правило совместимости (только для администраторов)
+	DeleteCompatibilityRule(context.Context, *DeleteCompatibilityRuleRequest) (*DeleteCompatibilityRuleResponse, error)
+	// Возвращает все правила совместимости
+	ListCompatibilityRules(context.Context, *ListCompatibilityRulesRequest) (*ListCompatibilityRulesResponse, error)
+	// Проверяет набор деталей на совместимость и полноту конфигурации
+	ValidateConfiguration(context.Context, *ValidateConfigurationRequest) (*ValidateConfigurationResponse, error)
+	// Импортирует каталог деталей из CSV, JSON или NDJSON с upsert по UUID (только для администраторов).
+	// Файл передается частями, формат и dry_run берутся из первого сообщения
+	ImportParts(grpc.ClientStreamingServer[ImportPartsRequest, ImportPartsResponse]) error
+	// Выгружает каталог деталей в CSV, JSON или NDJSON частями
+	ExportParts(*ExportPartsRequest, grpc.ServerStreamingServer[ExportPartsResponse]) error
+	// Создает модель ракеты со спецификацией деталей (только для администраторов)
+	CreateRocketModel(context.Context, *CreateRocketModelRequest) (*CreateRocketModelResponse, error)
+	// Возвращает модели ракет с рассчитанной ценой и доступностью
+	ListRocketModels(context.Context, *ListRocketModelsRequest) (*ListRocketModelsResponse, error)
+	// Раскладывает модель ракеты на детали с учетом остатков и альтернатив
+	ExpandRocketModel(context.Context, *ExpandRocketModelRequest) (*ExpandRocketModelResponse, error)
+	// Загружает фото, чертеж или документацию детали в GridFS (только для администраторов).
+	// Описание файла передается в первом сообщении, содержимое — частями
+	UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]) error
+	// Скачивает вложение: первое сообщение содержит описание файла, следующие — его части
+	DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error
+	// Удаляет вложение детали (только для администраторов)
+	DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error)
+	// Планирует изменение цены детали с будущей даты (только для администраторов)
+	SchedulePartPrice(context.Context, *SchedulePartPriceRequest) (*SchedulePartPriceResponse, error)
+	// История цен детали, включая запланированные, от поздних к ранним
+	ListPriceHistory(context.Context, *ListPriceHistoryRequest) (*ListPriceHistoryResponse, error)
+	// Возвращает цены деталей, действовавшие в заданный момент
+	GetPartPrices(context.Context, *GetPartPricesRequest) (*GetPartPricesResponse, error)
+	// Подписка на изменения деталей под фильтром: сначала снимок текущего состояния,
+	// затем изменения по мере их появления. Стрим открыт, пока клиент его не закроет
+	WatchParts(*WatchPartsRequest, grpc.ServerStreamingServer[PartEvent]) error
+	// Создает категорию деталей, в том числе подкатегорию существующей (только для администраторов)
+	CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error)
+	// Изменяет название, описание, родителя или схему метаданных категории (только для администраторов)
+	UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error)
+	// Удаляет категорию без подкатегорий и деталей (только для администраторов)
+	DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error)
+	// Возвращает все категории, родительская категория идет раньше подкатегорий
+	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
+	// Возвращает схему метаданных деталей категории вместе с полями, унаследованными от родителей
+	GetCategorySchema(context.Context, *GetCategorySchemaRequest) (*GetCategorySchemaResponse, error)
+	mustEmbedUnimplementedInventoryServiceServer()
+}
+
+// UnimplementedInventoryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedInventoryServiceServer struct{}
+
+func (UnimplementedInventoryServiceServer) GetPart(context.Context, *GetPartRequest) (*GetPartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPart not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListParts(context.Context, *ListPartsRequest) (*ListPartsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListParts not implemented")
+}
+func (UnimplementedInventoryServiceServer) BatchGetParts(context.Context, *BatchGetPartsRequest) (*BatchGetPartsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetParts not implemented")
+}
+func (UnimplementedInventoryServiceServer) CreatePart(context.Context, *CreatePartRequest) (*CreatePartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePart not implemented")
+}
+func (UnimplementedInventoryServiceServer) UpdatePart(context.Context, *UpdatePartRequest) (*UpdatePartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePart not implemented")
+}
+func (UnimplementedInventoryServiceServer) DeletePart(context.Context, *DeletePartRequest) (*DeletePartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePart not implemented")
+}
+func (UnimplementedInventoryServiceServer) SearchParts(context.Context, *SearchPartsRequest) (*SearchPartsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchParts not implemented")
+}
+func (UnimplementedInventoryServiceServer) ReceiveStock(context.Context, *ReceiveStockRequest) (*ReceiveStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiveStock not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListStockMovements(context.Context, *ListStockMovementsRequest) (*ListStockMovementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStockMovements not implemented")
+}
+func (UnimplementedInventoryServiceServer) CreateWarehouse(context.Context, *CreateWarehouseRequest) (*CreateWarehouseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWarehouse not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListWarehouses(context.Context, *ListWarehousesRequest) (*ListWarehousesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWarehouses not implemented")
+}
+func (UnimplementedInventoryServiceServer) TransferStock(context.Context, *TransferStockRequest) (*TransferStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferStock not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetStockAvailability(context.Context, *GetStockAvailabilityRequest) (*GetStockAvailabilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStockAvailability not implemented")
+}
+func (UnimplementedInventoryServiceServer) ReserveStock(context.Context, *ReserveStockRequest) (*ReserveStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveStock not implemented")
+}
+func (UnimplementedInventoryServiceServer) ReleaseStock(context.Context, *ReleaseStockRequest) (*ReleaseStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseStock not implemented")
+}
+func (UnimplementedInventoryServiceServer) ConsumeStock(context.Context, *ConsumeStockRequest) (*ConsumeStockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConsumeStock not implemented")
+}
+func (UnimplementedInventoryServiceServer) CreateCompatibilityRule(context.Context, *CreateCompatibilityRuleRequest) (*CreateCompatibilityRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCompatibilityRule not implemented")
+}
+func (UnimplementedInventoryServiceServer) DeleteCompatibilityRule(context.Context, *DeleteCompatibilityRuleRequest) (*DeleteCompatibilityRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCompatibilityRule not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListCompatibilityRules(context.Context, *ListCompatibilityRulesRequest) (*ListCompatibilityRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCompatibilityRules not implemented")
+}
+func (UnimplementedInventoryServiceServer) ValidateConfiguration(context.Context, *ValidateConfigurationRequest) (*ValidateConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateConfiguration not implemented")
+}
+func (UnimplementedInventoryServiceServer) ImportParts(grpc.ClientStreamingServer[ImportPartsRequest, ImportPartsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportParts not implemented")
+}
+func (UnimplementedInventoryServiceServer) ExportParts(*ExportPartsRequest, grpc.ServerStreamingServer[ExportPartsResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportParts not implemented")
+}
+func (UnimplementedInventoryServiceServer) CreateRocketModel(context.Context, *CreateRocketModelRequest) (*CreateRocketModelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRocketModel not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListRocketModels(context.Context, *ListRocketModelsRequest) (*ListRocketModelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRocketModels not implemented")
+}
+func (UnimplementedInventoryServiceServer) ExpandRocketModel(context.Context, *ExpandRocketModelRequest) (*ExpandRocketModelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpandRocketModel not implemented")
+}
+func (UnimplementedInventoryServiceServer) UploadAttachment(grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedInventoryServiceServer) DownloadAttachment(*DownloadAttachmentRequest, grpc.ServerStreamingServer[DownloadAttachmentResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadAttachment not implemented")
+}
+func (UnimplementedInventoryServiceServer) DeleteAttachment(context.Context, *DeleteAttachmentRequest) (*DeleteAttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttachment not implemented")
+}
+func (UnimplementedInventoryServiceServer) SchedulePartPrice(context.Context, *SchedulePartPriceRequest) (*SchedulePartPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulePartPrice not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListPriceHistory(context.Context, *ListPriceHistoryRequest) (*ListPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPriceHistory not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetPartPrices(context.Context, *GetPartPricesRequest) (*GetPartPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPartPrices not implemented")
+}
+func (UnimplementedInventoryServiceServer) WatchParts(*WatchPartsRequest, grpc.ServerStreamingServer[PartEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchParts not implemented")
+}
+func (UnimplementedInventoryServiceServer) CreateCategory(context.Context, *CreateCategoryRequest) (*CreateCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCategory not implemented")
+}
+func (UnimplementedInventoryServiceServer) UpdateCategory(context.Context, *UpdateCategoryRequest) (*UpdateCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCategory not implemented")
+}
+func (UnimplementedInventoryServiceServer) DeleteCategory(context.Context, *DeleteCategoryRequest) (*DeleteCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCategory not implemented")
+}
+func (UnimplementedInventoryServiceServer) ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCategories not implemented")
+}
+func (UnimplementedInventoryServiceServer) GetCategorySchema(context.Context, *GetCategorySchemaRequest) (*GetCategorySchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCategorySchema not implemented")
+}
+func (UnimplementedInventoryServiceServer) mustEmbedUnimplementedInventoryServiceServer() {}
+func (UnimplementedInventoryServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeInventoryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InventoryServiceServer will
+// result in compilation errors.
+type UnsafeInventoryServiceServer interface {
 	mustEmbedUnimplementedInventoryServiceServer()
 }
 
-// UnimplementedInventoryServiceServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedInventoryServiceServer struct{}
+func RegisterInventoryServiceServer(s grpc.ServiceRegistrar, srv InventoryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedInventoryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&InventoryService_ServiceDesc, srv)
+}
+
+func _InventoryService_GetPart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetPart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetPart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetPart(ctx, req.(*GetPartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListParts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPartsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListParts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListParts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListParts(ctx, req.(*ListPartsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_BatchGetParts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetPartsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).BatchGetParts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_BatchGetParts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).BatchGetParts(ctx, req.(*BatchGetPartsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CreatePart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CreatePart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CreatePart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CreatePart(ctx, req.(*CreatePartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_UpdatePart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).UpdatePart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_UpdatePart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).UpdatePart(ctx, req.(*UpdatePartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_DeletePart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).DeletePart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_DeletePart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).DeletePart(ctx, req.(*DeletePartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_SearchParts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchPartsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).SearchParts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_SearchParts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).SearchParts(ctx, req.(*SearchPartsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReceiveStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReceiveStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReceiveStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReceiveStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReceiveStock(ctx, req.(*ReceiveStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListStockMovements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStockMovementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListStockMovements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListStockMovements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListStockMovements(ctx, req.(*ListStockMovementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CreateWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWarehouseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CreateWarehouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CreateWarehouse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CreateWarehouse(ctx, req.(*CreateWarehouseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListWarehouses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWarehousesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListWarehouses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListWarehouses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListWarehouses(ctx, req.(*ListWarehousesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_TransferStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).TransferStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_TransferStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).TransferStock(ctx, req.(*TransferStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetStockAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStockAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetStockAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetStockAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetStockAvailability(ctx, req.(*GetStockAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReserveStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReserveStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReserveStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReserveStock(ctx, req.(*ReserveStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ReleaseStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ReleaseStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ReleaseStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ReleaseStock(ctx, req.(*ReleaseStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ConsumeStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumeStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ConsumeStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ConsumeStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ConsumeStock(ctx, req.(*ConsumeStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_CreateCompatibilityRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCompatibilityRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CreateCompatibilityRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CreateCompatibilityRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CreateCompatibilityRule(ctx, req.(*CreateCompatibilityRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_DeleteCompatibilityRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCompatibilityRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).DeleteCompatibilityRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_DeleteCompatibilityRule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).DeleteCompatibilityRule(ctx, req.(*DeleteCompatibilityRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListCompatibilityRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCompatibilityRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListCompatibilityRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListCompatibilityRules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListCompatibilityRules(ctx, req.(*ListCompatibilityRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ValidateConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ValidateConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ValidateConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ValidateConfiguration(ctx, req.(*ValidateConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ImportParts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(InventoryServiceServer).ImportParts(&grpc.GenericServerStream[ImportPartsRequest, ImportPartsResponse]{ServerStream: stream})
+}
 
-func (UnimplementedInventoryServiceServer) GetPart(context.Context, *GetPartRequest) (*GetPartResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPart not implemented")
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_ImportPartsServer = grpc.ClientStreamingServer[ImportPartsRequest, ImportPartsResponse]
+
+func _InventoryService_ExportParts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportPartsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InventoryServiceServer).ExportParts(m, &grpc.GenericServerStream[ExportPartsRequest, ExportPartsResponse]{ServerStream: stream})
 }
-func (UnimplementedInventoryServiceServer) ListParts(context.Context, *ListPartsRequest) (*ListPartsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListParts not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_ExportPartsServer = grpc.ServerStreamingServer[ExportPartsResponse]
+
+func _InventoryService_CreateRocketModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRocketModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CreateRocketModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CreateRocketModel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CreateRocketModel(ctx, req.(*CreateRocketModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedInventoryServiceServer) mustEmbedUnimplementedInventoryServiceServer() {}
-func (UnimplementedInventoryServiceServer) testEmbeddedByValue()                          {}
 
-// UnsafeInventoryServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to InventoryServiceServer will
-// result in compilation errors.
-type UnsafeInventoryServiceServer interface {
-	mustEmbedUnimplementedInventoryServiceServer()
+func _InventoryService_ListRocketModels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRocketModelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListRocketModels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListRocketModels_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListRocketModels(ctx, req.(*ListRocketModelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterInventoryServiceServer(s grpc.ServiceRegistrar, srv InventoryServiceServer) {
-	// If the following call pancis, it indicates UnimplementedInventoryServiceServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+func _InventoryService_ExpandRocketModel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExpandRocketModelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	s.RegisterService(&InventoryService_ServiceDesc, srv)
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ExpandRocketModel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ExpandRocketModel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ExpandRocketModel(ctx, req.(*ExpandRocketModelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_GetPart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPartRequest)
+func _InventoryService_UploadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(InventoryServiceServer).UploadAttachment(&grpc.GenericServerStream[UploadAttachmentRequest, UploadAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_UploadAttachmentServer = grpc.ClientStreamingServer[UploadAttachmentRequest, UploadAttachmentResponse]
+
+func _InventoryService_DownloadAttachment_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadAttachmentRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InventoryServiceServer).DownloadAttachment(m, &grpc.GenericServerStream[DownloadAttachmentRequest, DownloadAttachmentResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_DownloadAttachmentServer = grpc.ServerStreamingServer[DownloadAttachmentResponse]
+
+func _InventoryService_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttachmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).GetPart(ctx, in)
+		return srv.(InventoryServiceServer).DeleteAttachment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_GetPart_FullMethodName,
+		FullMethod: InventoryService_DeleteAttachment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).GetPart(ctx, req.(*GetPartRequest))
+		return srv.(InventoryServiceServer).DeleteAttachment(ctx, req.(*DeleteAttachmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InventoryService_ListParts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListPartsRequest)
+func _InventoryService_SchedulePartPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulePartPriceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InventoryServiceServer).ListParts(ctx, in)
+		return srv.(InventoryServiceServer).SchedulePartPrice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InventoryService_ListParts_FullMethodName,
+		FullMethod: InventoryService_SchedulePartPrice_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InventoryServiceServer).ListParts(ctx, req.(*ListPartsRequest))
+		return srv.(InventoryServiceServer).SchedulePartPrice(ctx, req.(*SchedulePartPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListPriceHistory(ctx, req.(*ListPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetPartPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPartPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetPartPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetPartPrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetPartPrices(ctx, req.(*GetPartPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_WatchParts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchPartsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(InventoryServiceServer).WatchParts(m, &grpc.GenericServerStream[WatchPartsRequest, PartEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type InventoryService_WatchPartsServer = grpc.ServerStreamingServer[PartEvent]
+
+func _InventoryService_CreateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).CreateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_CreateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).CreateCategory(ctx, req.(*CreateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_UpdateCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).UpdateCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_UpdateCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).UpdateCategory(ctx, req.(*UpdateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_DeleteCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).DeleteCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_DeleteCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).DeleteCategory(ctx, req.(*DeleteCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_ListCategories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryService_GetCategorySchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategorySchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryServiceServer).GetCategorySchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InventoryService_GetCategorySchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryServiceServer).GetCategorySchema(ctx, req.(*GetCategorySchemaRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -161,7 +1417,153 @@ var InventoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListParts",
 			Handler:    _InventoryService_ListParts_Handler,
 		},
+		{
+			MethodName: "BatchGetParts",
+			Handler:    _InventoryService_BatchGetParts_Handler,
+		},
+		{
+			MethodName: "CreatePart",
+			Handler:    _InventoryService_CreatePart_Handler,
+		},
+		{
+			MethodName: "UpdatePart",
+			Handler:    _InventoryService_UpdatePart_Handler,
+		},
+		{
+			MethodName: "DeletePart",
+			Handler:    _InventoryService_DeletePart_Handler,
+		},
+		{
+			MethodName: "SearchParts",
+			Handler:    _InventoryService_SearchParts_Handler,
+		},
+		{
+			MethodName: "ReceiveStock",
+			Handler:    _InventoryService_ReceiveStock_Handler,
+		},
+		{
+			MethodName: "ListStockMovements",
+			Handler:    _InventoryService_ListStockMovements_Handler,
+		},
+		{
+			MethodName: "CreateWarehouse",
+			Handler:    _InventoryService_CreateWarehouse_Handler,
+		},
+		{
+			MethodName: "ListWarehouses",
+			Handler:    _InventoryService_ListWarehouses_Handler,
+		},
+		{
+			MethodName: "TransferStock",
+			Handler:    _InventoryService_TransferStock_Handler,
+		},
+		{
+			MethodName: "GetStockAvailability",
+			Handler:    _InventoryService_GetStockAvailability_Handler,
+		},
+		{
+			MethodName: "ReserveStock",
+			Handler:    _InventoryService_ReserveStock_Handler,
+		},
+		{
+			MethodName: "ReleaseStock",
+			Handler:    _InventoryService_ReleaseStock_Handler,
+		},
+		{
+			MethodName: "ConsumeStock",
+			Handler:    _InventoryService_ConsumeStock_Handler,
+		},
+		{
+			MethodName: "CreateCompatibilityRule",
+			Handler:    _InventoryService_CreateCompatibilityRule_Handler,
+		},
+		{
+			MethodName: "DeleteCompatibilityRule",
+			Handler:    _InventoryService_DeleteCompatibilityRule_Handler,
+		},
+		{
+			MethodName: "ListCompatibilityRules",
+			Handler:    _InventoryService_ListCompatibilityRules_Handler,
+		},
+		{
+			MethodName: "ValidateConfiguration",
+			Handler:    _InventoryService_ValidateConfiguration_Handler,
+		},
+		{
+			MethodName: "CreateRocketModel",
+			Handler:    _InventoryService_CreateRocketModel_Handler,
+		},
+		{
+			MethodName: "ListRocketModels",
+			Handler:    _InventoryService_ListRocketModels_Handler,
+		},
+		{
+			MethodName: "ExpandRocketModel",
+			Handler:    _InventoryService_ExpandRocketModel_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _InventoryService_DeleteAttachment_Handler,
+		},
+		{
+			MethodName: "SchedulePartPrice",
+			Handler:    _InventoryService_SchedulePartPrice_Handler,
+		},
+		{
+			MethodName: "ListPriceHistory",
+			Handler:    _InventoryService_ListPriceHistory_Handler,
+		},
+		{
+			MethodName: "GetPartPrices",
+			Handler:    _InventoryService_GetPartPrices_Handler,
+		},
+		{
+			MethodName: "CreateCategory",
+			Handler:    _InventoryService_CreateCategory_Handler,
+		},
+		{
+			MethodName: "UpdateCategory",
+			Handler:    _InventoryService_UpdateCategory_Handler,
+		},
+		{
+			MethodName: "DeleteCategory",
+			Handler:    _InventoryService_DeleteCategory_Handler,
+		},
+		{
+			MethodName: "ListCategories",
+			Handler:    _InventoryService_ListCategories_Handler,
+		},
+		{
+			MethodName: "GetCategorySchema",
+			Handler:    _InventoryService_GetCategorySchema_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportParts",
+			Handler:       _InventoryService_ImportParts_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportParts",
+			Handler:       _InventoryService_ExportParts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "UploadAttachment",
+			Handler:       _InventoryService_UploadAttachment_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DownloadAttachment",
+			Handler:       _InventoryService_DownloadAttachment_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchParts",
+			Handler:       _InventoryService_WatchParts_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "inventory/v1/inventory.proto",
 }
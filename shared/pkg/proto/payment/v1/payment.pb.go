@@ -9,6 +9,7 @@ package paymentv1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -82,6 +83,295 @@ func (PaymentMethod) EnumDescriptor() ([]byte, []int) {
 	return file_payment_v1_payment_proto_rawDescGZIP(), []int{0}
 }
 
+// Статусы платежа
+type PaymentStatus int32
+
+const (
+	// Неизвестный статус
+	PaymentStatus_PAYMENT_STATUS_UNSPECIFIED PaymentStatus = 0
+	// Платеж ожидает подтверждения от платежного шлюза
+	PaymentStatus_PAYMENT_STATUS_PENDING PaymentStatus = 1
+	// Платеж успешно проведен
+	PaymentStatus_PAYMENT_STATUS_SUCCEEDED PaymentStatus = 2
+	// Платеж отклонен
+	PaymentStatus_PAYMENT_STATUS_FAILED PaymentStatus = 3
+)
+
+// Enum value maps for PaymentStatus.
+var (
+	PaymentStatus_name = map[int32]string{
+		0: "PAYMENT_STATUS_UNSPECIFIED",
+		1: "PAYMENT_STATUS_PENDING",
+		2: "PAYMENT_STATUS_SUCCEEDED",
+		3: "PAYMENT_STATUS_FAILED",
+	}
+	PaymentStatus_value = map[string]int32{
+		"PAYMENT_STATUS_UNSPECIFIED": 0,
+		"PAYMENT_STATUS_PENDING":     1,
+		"PAYMENT_STATUS_SUCCEEDED":   2,
+		"PAYMENT_STATUS_FAILED":      3,
+	}
+)
+
+func (x PaymentStatus) Enum() *PaymentStatus {
+	p := new(PaymentStatus)
+	*p = x
+	return p
+}
+
+func (x PaymentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PaymentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[1].Descriptor()
+}
+
+func (PaymentStatus) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[1]
+}
+
+func (x PaymentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PaymentStatus.Descriptor instead.
+func (PaymentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{1}
+}
+
+// Типы проводок в журнале
+type LedgerEntryType int32
+
+const (
+	// Неизвестный тип
+	LedgerEntryType_LEDGER_ENTRY_TYPE_UNSPECIFIED LedgerEntryType = 0
+	// Пополнение счета инвестора
+	LedgerEntryType_LEDGER_ENTRY_TYPE_TOP_UP LedgerEntryType = 1
+	// Оплата заказа деньгами инвестора
+	LedgerEntryType_LEDGER_ENTRY_TYPE_PAYMENT LedgerEntryType = 2
+)
+
+// Enum value maps for LedgerEntryType.
+var (
+	LedgerEntryType_name = map[int32]string{
+		0: "LEDGER_ENTRY_TYPE_UNSPECIFIED",
+		1: "LEDGER_ENTRY_TYPE_TOP_UP",
+		2: "LEDGER_ENTRY_TYPE_PAYMENT",
+	}
+	LedgerEntryType_value = map[string]int32{
+		"LEDGER_ENTRY_TYPE_UNSPECIFIED": 0,
+		"LEDGER_ENTRY_TYPE_TOP_UP":      1,
+		"LEDGER_ENTRY_TYPE_PAYMENT":     2,
+	}
+)
+
+func (x LedgerEntryType) Enum() *LedgerEntryType {
+	p := new(LedgerEntryType)
+	*p = x
+	return p
+}
+
+func (x LedgerEntryType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LedgerEntryType) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[2].Descriptor()
+}
+
+func (LedgerEntryType) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[2]
+}
+
+func (x LedgerEntryType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LedgerEntryType.Descriptor instead.
+func (LedgerEntryType) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{2}
+}
+
+// Решения антифрод-проверки
+type FraudVerdict int32
+
+const (
+	// Неизвестное решение
+	FraudVerdict_FRAUD_VERDICT_UNSPECIFIED FraudVerdict = 0
+	// Платеж разрешен
+	FraudVerdict_FRAUD_VERDICT_APPROVE FraudVerdict = 1
+	// Платеж проводится, но отмечен для ручного разбора
+	FraudVerdict_FRAUD_VERDICT_REVIEW FraudVerdict = 2
+	// Платеж отклонен
+	FraudVerdict_FRAUD_VERDICT_REJECT FraudVerdict = 3
+)
+
+// Enum value maps for FraudVerdict.
+var (
+	FraudVerdict_name = map[int32]string{
+		0: "FRAUD_VERDICT_UNSPECIFIED",
+		1: "FRAUD_VERDICT_APPROVE",
+		2: "FRAUD_VERDICT_REVIEW",
+		3: "FRAUD_VERDICT_REJECT",
+	}
+	FraudVerdict_value = map[string]int32{
+		"FRAUD_VERDICT_UNSPECIFIED": 0,
+		"FRAUD_VERDICT_APPROVE":     1,
+		"FRAUD_VERDICT_REVIEW":      2,
+		"FRAUD_VERDICT_REJECT":      3,
+	}
+)
+
+func (x FraudVerdict) Enum() *FraudVerdict {
+	p := new(FraudVerdict)
+	*p = x
+	return p
+}
+
+func (x FraudVerdict) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FraudVerdict) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[3].Descriptor()
+}
+
+func (FraudVerdict) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[3]
+}
+
+func (x FraudVerdict) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FraudVerdict.Descriptor instead.
+func (FraudVerdict) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{3}
+}
+
+// Статусы плана рассрочки
+type InstallmentPlanStatus int32
+
+const (
+	// Неизвестный статус
+	InstallmentPlanStatus_INSTALLMENT_PLAN_STATUS_UNSPECIFIED InstallmentPlanStatus = 0
+	// План действует, остались неоплаченные платежи
+	InstallmentPlanStatus_INSTALLMENT_PLAN_STATUS_ACTIVE InstallmentPlanStatus = 1
+	// Все платежи списаны
+	InstallmentPlanStatus_INSTALLMENT_PLAN_STATUS_COMPLETED InstallmentPlanStatus = 2
+	// Первый платеж не прошел, план не вступил в силу
+	InstallmentPlanStatus_INSTALLMENT_PLAN_STATUS_CANCELLED InstallmentPlanStatus = 3
+	// Попытки списания платежа исчерпаны, план прекращен
+	InstallmentPlanStatus_INSTALLMENT_PLAN_STATUS_DEFAULTED InstallmentPlanStatus = 4
+)
+
+// Enum value maps for InstallmentPlanStatus.
+var (
+	InstallmentPlanStatus_name = map[int32]string{
+		0: "INSTALLMENT_PLAN_STATUS_UNSPECIFIED",
+		1: "INSTALLMENT_PLAN_STATUS_ACTIVE",
+		2: "INSTALLMENT_PLAN_STATUS_COMPLETED",
+		3: "INSTALLMENT_PLAN_STATUS_CANCELLED",
+		4: "INSTALLMENT_PLAN_STATUS_DEFAULTED",
+	}
+	InstallmentPlanStatus_value = map[string]int32{
+		"INSTALLMENT_PLAN_STATUS_UNSPECIFIED": 0,
+		"INSTALLMENT_PLAN_STATUS_ACTIVE":      1,
+		"INSTALLMENT_PLAN_STATUS_COMPLETED":   2,
+		"INSTALLMENT_PLAN_STATUS_CANCELLED":   3,
+		"INSTALLMENT_PLAN_STATUS_DEFAULTED":   4,
+	}
+)
+
+func (x InstallmentPlanStatus) Enum() *InstallmentPlanStatus {
+	p := new(InstallmentPlanStatus)
+	*p = x
+	return p
+}
+
+func (x InstallmentPlanStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InstallmentPlanStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[4].Descriptor()
+}
+
+func (InstallmentPlanStatus) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[4]
+}
+
+func (x InstallmentPlanStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InstallmentPlanStatus.Descriptor instead.
+func (InstallmentPlanStatus) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{4}
+}
+
+// Статусы платежа по графику рассрочки
+type InstallmentStatus int32
+
+const (
+	// Неизвестный статус
+	InstallmentStatus_INSTALLMENT_STATUS_UNSPECIFIED InstallmentStatus = 0
+	// Ожидает даты списания
+	InstallmentStatus_INSTALLMENT_STATUS_SCHEDULED InstallmentStatus = 1
+	// Списан
+	InstallmentStatus_INSTALLMENT_STATUS_PAID InstallmentStatus = 2
+	// Списание не прошло, будет повторено
+	InstallmentStatus_INSTALLMENT_STATUS_MISSED InstallmentStatus = 3
+	// Попытки списания исчерпаны, повторов не будет
+	InstallmentStatus_INSTALLMENT_STATUS_FAILED InstallmentStatus = 4
+)
+
+// Enum value maps for InstallmentStatus.
+var (
+	InstallmentStatus_name = map[int32]string{
+		0: "INSTALLMENT_STATUS_UNSPECIFIED",
+		1: "INSTALLMENT_STATUS_SCHEDULED",
+		2: "INSTALLMENT_STATUS_PAID",
+		3: "INSTALLMENT_STATUS_MISSED",
+		4: "INSTALLMENT_STATUS_FAILED",
+	}
+	InstallmentStatus_value = map[string]int32{
+		"INSTALLMENT_STATUS_UNSPECIFIED": 0,
+		"INSTALLMENT_STATUS_SCHEDULED":   1,
+		"INSTALLMENT_STATUS_PAID":        2,
+		"INSTALLMENT_STATUS_MISSED":      3,
+		"INSTALLMENT_STATUS_FAILED":      4,
+	}
+)
+
+func (x InstallmentStatus) Enum() *InstallmentStatus {
+	p := new(InstallmentStatus)
+	*p = x
+	return p
+}
+
+func (x InstallmentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (InstallmentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_payment_v1_payment_proto_enumTypes[5].Descriptor()
+}
+
+func (InstallmentStatus) Type() protoreflect.EnumType {
+	return &file_payment_v1_payment_proto_enumTypes[5]
+}
+
+func (x InstallmentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use InstallmentStatus.Descriptor instead.
+func (InstallmentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{5}
+}
+
 // PayOrderRequest - Запрос на оплату пользователя
 type PayOrderRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -91,8 +381,19 @@ type PayOrderRequest struct {
 	UserUuid string `protobuf:"bytes,2,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
 	// Метод оплаты
 	PaymentMethod PaymentMethod `protobuf:"varint,3,opt,name=payment_method,json=paymentMethod,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Сумма платежа (обязательна для PAYMENT_METHOD_INVESTOR_MONEY)
+	Amount float64 `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// UUID владельца заказа. Если не задан, проверка совпадения плательщика с владельцем не выполняется
+	OrderOwnerUuid string `protobuf:"bytes,5,opt,name=order_owner_uuid,json=orderOwnerUuid,proto3" json:"order_owner_uuid,omitempty"`
+	// Позиции заказа для чека. Если не заданы, чек содержит одну позицию на сумму платежа
+	Items []*PaymentItem `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
+	// Количество ежемесячных платежей рассрочки (только для PAYMENT_METHOD_CREDIT_CARD, 0 или 1 - без рассрочки)
+	Installments int32 `protobuf:"varint,7,opt,name=installments,proto3" json:"installments,omitempty"`
+	// UUID транзакции, заранее закрепленный за заказом вызывающей стороной. Если не задан, генерируется payment сервисом.
+	// Повторный запрос с тем же UUID не проводит платеж второй раз
+	TransactionUuid string `protobuf:"bytes,8,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *PayOrderRequest) Reset() {
@@ -146,18 +447,130 @@ func (x *PayOrderRequest) GetPaymentMethod() PaymentMethod {
 	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
 }
 
+func (x *PayOrderRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *PayOrderRequest) GetOrderOwnerUuid() string {
+	if x != nil {
+		return x.OrderOwnerUuid
+	}
+	return ""
+}
+
+func (x *PayOrderRequest) GetItems() []*PaymentItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *PayOrderRequest) GetInstallments() int32 {
+	if x != nil {
+		return x.Installments
+	}
+	return 0
+}
+
+func (x *PayOrderRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+// PaymentItem - Позиция заказа, за которую производится оплата
+type PaymentItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Название детали
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Количество
+	Quantity int32 `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Цена за единицу (с НДС)
+	UnitPrice     float64 `protobuf:"fixed64,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PaymentItem) Reset() {
+	*x = PaymentItem{}
+	mi := &file_payment_v1_payment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PaymentItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentItem) ProtoMessage() {}
+
+func (x *PaymentItem) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentItem.ProtoReflect.Descriptor instead.
+func (*PaymentItem) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PaymentItem) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *PaymentItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PaymentItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PaymentItem) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
 // PayOrderResponse - Ответ на оплату пользователя
 type PayOrderResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// UUID транзакции
 	TransactionUuid string `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Статус платежа
+	Status PaymentStatus `protobuf:"varint,2,opt,name=status,proto3,enum=payment.v1.PaymentStatus" json:"status,omitempty"`
+	// UUID плана рассрочки (если платеж оформлен в рассрочку)
+	InstallmentPlanUuid string `protobuf:"bytes,3,opt,name=installment_plan_uuid,json=installmentPlanUuid,proto3" json:"installment_plan_uuid,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *PayOrderResponse) Reset() {
 	*x = PayOrderResponse{}
-	mi := &file_payment_v1_payment_proto_msgTypes[1]
+	mi := &file_payment_v1_payment_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -169,7 +582,7 @@ func (x *PayOrderResponse) String() string {
 func (*PayOrderResponse) ProtoMessage() {}
 
 func (x *PayOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_payment_v1_payment_proto_msgTypes[1]
+	mi := &file_payment_v1_payment_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -182,7 +595,7 @@ func (x *PayOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PayOrderResponse.ProtoReflect.Descriptor instead.
 func (*PayOrderResponse) Descriptor() ([]byte, []int) {
-	return file_payment_v1_payment_proto_rawDescGZIP(), []int{1}
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *PayOrderResponse) GetTransactionUuid() string {
@@ -192,27 +605,1815 @@ func (x *PayOrderResponse) GetTransactionUuid() string {
 	return ""
 }
 
-var File_payment_v1_payment_proto protoreflect.FileDescriptor
+func (x *PayOrderResponse) GetStatus() PaymentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+}
 
-const file_payment_v1_payment_proto_rawDesc = "" +
-	"\n" +
-	"\x18payment/v1/payment.proto\x12\n" +
-	"payment.v1\"\x8f\x01\n" +
-	"\x0fPayOrderRequest\x12\x1d\n" +
-	"\n" +
-	"order_uuid\x18\x01 \x01(\tR\torderUuid\x12\x1b\n" +
-	"\tuser_uuid\x18\x02 \x01(\tR\buserUuid\x12@\n" +
-	"\x0epayment_method\x18\x03 \x01(\x0e2\x19.payment.v1.PaymentMethodR\rpaymentMethod\"=\n" +
-	"\x10PayOrderResponse\x12)\n" +
-	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid*\xa3\x01\n" +
-	"\rPaymentMethod\x12\x1e\n" +
-	"\x1aPAYMENT_METHOD_UNSPECIFIED\x10\x00\x12\x17\n" +
-	"\x13PAYMENT_METHOD_CARD\x10\x01\x12\x16\n" +
-	"\x12PAYMENT_METHOD_SBP\x10\x02\x12\x1e\n" +
-	"\x1aPAYMENT_METHOD_CREDIT_CARD\x10\x03\x12!\n" +
-	"\x1dPAYMENT_METHOD_INVESTOR_MONEY\x10\x042W\n" +
-	"\x0ePaymentService\x12E\n" +
-	"\bPayOrder\x12\x1b.payment.v1.PayOrderRequest\x1a\x1c.payment.v1.PayOrderResponseB\xb7\x01\n" +
+func (x *PayOrderResponse) GetInstallmentPlanUuid() string {
+	if x != nil {
+		return x.InstallmentPlanUuid
+	}
+	return ""
+}
+
+// ConfirmPaymentRequest - Callback от платежного шлюза с результатом платежа
+type ConfirmPaymentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID транзакции
+	TransactionUuid string `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	// Итоговый статус платежа (SUCCEEDED или FAILED)
+	Status PaymentStatus `protobuf:"varint,2,opt,name=status,proto3,enum=payment.v1.PaymentStatus" json:"status,omitempty"`
+	// Причина отказа (для FAILED)
+	FailureReason string `protobuf:"bytes,3,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmPaymentRequest) Reset() {
+	*x = ConfirmPaymentRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPaymentRequest) ProtoMessage() {}
+
+func (x *ConfirmPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPaymentRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ConfirmPaymentRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *ConfirmPaymentRequest) GetStatus() PaymentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+}
+
+func (x *ConfirmPaymentRequest) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+// ConfirmPaymentResponse - Ответ на callback платежного шлюза
+type ConfirmPaymentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID транзакции
+	TransactionUuid string `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	// Текущий статус платежа
+	Status        PaymentStatus `protobuf:"varint,2,opt,name=status,proto3,enum=payment.v1.PaymentStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmPaymentResponse) Reset() {
+	*x = ConfirmPaymentResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPaymentResponse) ProtoMessage() {}
+
+func (x *ConfirmPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmPaymentResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ConfirmPaymentResponse) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *ConfirmPaymentResponse) GetStatus() PaymentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+}
+
+// GetPaymentStatusRequest - Запрос статуса платежа
+type GetPaymentStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID транзакции
+	TransactionUuid string `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetPaymentStatusRequest) Reset() {
+	*x = GetPaymentStatusRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPaymentStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentStatusRequest) ProtoMessage() {}
+
+func (x *GetPaymentStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetPaymentStatusRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetPaymentStatusRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+// GetPaymentStatusResponse - Текущий статус платежа
+type GetPaymentStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID транзакции
+	TransactionUuid string `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	// Текущий статус платежа
+	Status        PaymentStatus `protobuf:"varint,2,opt,name=status,proto3,enum=payment.v1.PaymentStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPaymentStatusResponse) Reset() {
+	*x = GetPaymentStatusResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPaymentStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPaymentStatusResponse) ProtoMessage() {}
+
+func (x *GetPaymentStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPaymentStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetPaymentStatusResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetPaymentStatusResponse) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *GetPaymentStatusResponse) GetStatus() PaymentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+}
+
+// TopUpInvestorAccountRequest - Запрос на пополнение счета инвестора
+type TopUpInvestorAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID пользователя-инвестора
+	UserUuid string `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	// Сумма пополнения
+	Amount float64 `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Внешний идентификатор пополнения, повторный запрос с тем же reference не проводится дважды
+	Reference     string `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopUpInvestorAccountRequest) Reset() {
+	*x = TopUpInvestorAccountRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopUpInvestorAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopUpInvestorAccountRequest) ProtoMessage() {}
+
+func (x *TopUpInvestorAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopUpInvestorAccountRequest.ProtoReflect.Descriptor instead.
+func (*TopUpInvestorAccountRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TopUpInvestorAccountRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *TopUpInvestorAccountRequest) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TopUpInvestorAccountRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+// TopUpInvestorAccountResponse - Ответ на пополнение счета инвестора
+type TopUpInvestorAccountResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID проводки
+	EntryUuid string `protobuf:"bytes,1,opt,name=entry_uuid,json=entryUuid,proto3" json:"entry_uuid,omitempty"`
+	// Баланс после пополнения
+	Balance       float64 `protobuf:"fixed64,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TopUpInvestorAccountResponse) Reset() {
+	*x = TopUpInvestorAccountResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TopUpInvestorAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TopUpInvestorAccountResponse) ProtoMessage() {}
+
+func (x *TopUpInvestorAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TopUpInvestorAccountResponse.ProtoReflect.Descriptor instead.
+func (*TopUpInvestorAccountResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TopUpInvestorAccountResponse) GetEntryUuid() string {
+	if x != nil {
+		return x.EntryUuid
+	}
+	return ""
+}
+
+func (x *TopUpInvestorAccountResponse) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+// GetInvestorBalanceRequest - Запрос баланса счета инвестора
+type GetInvestorBalanceRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID пользователя-инвестора
+	UserUuid      string `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvestorBalanceRequest) Reset() {
+	*x = GetInvestorBalanceRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvestorBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvestorBalanceRequest) ProtoMessage() {}
+
+func (x *GetInvestorBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvestorBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetInvestorBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetInvestorBalanceRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+// GetInvestorBalanceResponse - Баланс счета инвестора
+type GetInvestorBalanceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Текущий баланс
+	Balance       float64 `protobuf:"fixed64,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvestorBalanceResponse) Reset() {
+	*x = GetInvestorBalanceResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvestorBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvestorBalanceResponse) ProtoMessage() {}
+
+func (x *GetInvestorBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvestorBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetInvestorBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetInvestorBalanceResponse) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+// GetInvestorStatementRequest - Запрос выписки по счету инвестора
+type GetInvestorStatementRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID пользователя-инвестора
+	UserUuid string `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	// Максимальное количество строк выписки (по умолчанию 50)
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Смещение от последней операции
+	Offset        int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvestorStatementRequest) Reset() {
+	*x = GetInvestorStatementRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvestorStatementRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvestorStatementRequest) ProtoMessage() {}
+
+func (x *GetInvestorStatementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvestorStatementRequest.ProtoReflect.Descriptor instead.
+func (*GetInvestorStatementRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetInvestorStatementRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *GetInvestorStatementRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetInvestorStatementRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// GetInvestorStatementResponse - Выписка по счету инвестора
+type GetInvestorStatementResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Текущий баланс
+	Balance float64 `protobuf:"fixed64,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	// Операции по счету, от новых к старым
+	Lines         []*StatementLine `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInvestorStatementResponse) Reset() {
+	*x = GetInvestorStatementResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInvestorStatementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInvestorStatementResponse) ProtoMessage() {}
+
+func (x *GetInvestorStatementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInvestorStatementResponse.ProtoReflect.Descriptor instead.
+func (*GetInvestorStatementResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetInvestorStatementResponse) GetBalance() float64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+func (x *GetInvestorStatementResponse) GetLines() []*StatementLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+// StatementLine - Строка выписки: движение по счету инвестора в рамках одной проводки
+type StatementLine struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID проводки
+	EntryUuid string `protobuf:"bytes,1,opt,name=entry_uuid,json=entryUuid,proto3" json:"entry_uuid,omitempty"`
+	// Тип проводки
+	EntryType LedgerEntryType `protobuf:"varint,2,opt,name=entry_type,json=entryType,proto3,enum=payment.v1.LedgerEntryType" json:"entry_type,omitempty"`
+	// Сумма движения: положительная - зачисление, отрицательная - списание
+	Amount float64 `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Баланс счета после операции
+	BalanceAfter float64 `protobuf:"fixed64,4,opt,name=balance_after,json=balanceAfter,proto3" json:"balance_after,omitempty"`
+	// Идентификатор основания (UUID транзакции или reference пополнения)
+	Reference string `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`
+	// Дата операции
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StatementLine) Reset() {
+	*x = StatementLine{}
+	mi := &file_payment_v1_payment_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StatementLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatementLine) ProtoMessage() {}
+
+func (x *StatementLine) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatementLine.ProtoReflect.Descriptor instead.
+func (*StatementLine) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *StatementLine) GetEntryUuid() string {
+	if x != nil {
+		return x.EntryUuid
+	}
+	return ""
+}
+
+func (x *StatementLine) GetEntryType() LedgerEntryType {
+	if x != nil {
+		return x.EntryType
+	}
+	return LedgerEntryType_LEDGER_ENTRY_TYPE_UNSPECIFIED
+}
+
+func (x *StatementLine) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *StatementLine) GetBalanceAfter() float64 {
+	if x != nil {
+		return x.BalanceAfter
+	}
+	return 0
+}
+
+func (x *StatementLine) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *StatementLine) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ListFlaggedPaymentsRequest - Запрос платежей для ручного разбора
+type ListFlaggedPaymentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Максимальное количество записей (по умолчанию 50)
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Смещение от последней записи
+	Offset        int32 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFlaggedPaymentsRequest) Reset() {
+	*x = ListFlaggedPaymentsRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFlaggedPaymentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFlaggedPaymentsRequest) ProtoMessage() {}
+
+func (x *ListFlaggedPaymentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFlaggedPaymentsRequest.ProtoReflect.Descriptor instead.
+func (*ListFlaggedPaymentsRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListFlaggedPaymentsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListFlaggedPaymentsRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// ListFlaggedPaymentsResponse - Платежи для ручного разбора
+type ListFlaggedPaymentsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Решения антифрод-проверки, от новых к старым
+	Decisions     []*FraudDecision `protobuf:"bytes,1,rep,name=decisions,proto3" json:"decisions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFlaggedPaymentsResponse) Reset() {
+	*x = ListFlaggedPaymentsResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFlaggedPaymentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFlaggedPaymentsResponse) ProtoMessage() {}
+
+func (x *ListFlaggedPaymentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFlaggedPaymentsResponse.ProtoReflect.Descriptor instead.
+func (*ListFlaggedPaymentsResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListFlaggedPaymentsResponse) GetDecisions() []*FraudDecision {
+	if x != nil {
+		return x.Decisions
+	}
+	return nil
+}
+
+// FraudDecision - Решение антифрод-проверки по платежу
+type FraudDecision struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID решения
+	DecisionUuid string `protobuf:"bytes,1,opt,name=decision_uuid,json=decisionUuid,proto3" json:"decision_uuid,omitempty"`
+	// UUID транзакции
+	TransactionUuid string `protobuf:"bytes,2,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	// UUID заказа
+	OrderUuid string `protobuf:"bytes,3,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	// UUID пользователя, который производит оплату
+	UserUuid string `protobuf:"bytes,4,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	// Метод оплаты
+	PaymentMethod PaymentMethod `protobuf:"varint,5,opt,name=payment_method,json=paymentMethod,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"`
+	// Сумма платежа
+	Amount float64 `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Итоговое решение
+	Verdict FraudVerdict `protobuf:"varint,7,opt,name=verdict,proto3,enum=payment.v1.FraudVerdict" json:"verdict,omitempty"`
+	// Причины решения (сработавшие правила)
+	Reasons []string `protobuf:"bytes,8,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	// Дата проверки
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FraudDecision) Reset() {
+	*x = FraudDecision{}
+	mi := &file_payment_v1_payment_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FraudDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FraudDecision) ProtoMessage() {}
+
+func (x *FraudDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FraudDecision.ProtoReflect.Descriptor instead.
+func (*FraudDecision) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *FraudDecision) GetDecisionUuid() string {
+	if x != nil {
+		return x.DecisionUuid
+	}
+	return ""
+}
+
+func (x *FraudDecision) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *FraudDecision) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *FraudDecision) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *FraudDecision) GetPaymentMethod() PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+}
+
+func (x *FraudDecision) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *FraudDecision) GetVerdict() FraudVerdict {
+	if x != nil {
+		return x.Verdict
+	}
+	return FraudVerdict_FRAUD_VERDICT_UNSPECIFIED
+}
+
+func (x *FraudDecision) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *FraudDecision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// GetReceiptRequest - Запрос чека
+type GetReceiptRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID транзакции
+	TransactionUuid string `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetReceiptRequest) Reset() {
+	*x = GetReceiptRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceiptRequest) ProtoMessage() {}
+
+func (x *GetReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceiptRequest.ProtoReflect.Descriptor instead.
+func (*GetReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetReceiptRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+// GetReceiptResponse - Чек по транзакции
+type GetReceiptResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Чек
+	Receipt       *Receipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceiptResponse) Reset() {
+	*x = GetReceiptResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceiptResponse) ProtoMessage() {}
+
+func (x *GetReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceiptResponse.ProtoReflect.Descriptor instead.
+func (*GetReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetReceiptResponse) GetReceipt() *Receipt {
+	if x != nil {
+		return x.Receipt
+	}
+	return nil
+}
+
+// Receipt - Чек успешного платежа
+type Receipt struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID чека
+	ReceiptUuid string `protobuf:"bytes,1,opt,name=receipt_uuid,json=receiptUuid,proto3" json:"receipt_uuid,omitempty"`
+	// UUID транзакции
+	TransactionUuid string `protobuf:"bytes,2,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	// UUID заказа
+	OrderUuid string `protobuf:"bytes,3,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	// UUID пользователя
+	UserUuid string `protobuf:"bytes,4,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	// Метод оплаты
+	PaymentMethod PaymentMethod `protobuf:"varint,5,opt,name=payment_method,json=paymentMethod,proto3,enum=payment.v1.PaymentMethod" json:"payment_method,omitempty"`
+	// Позиции чека
+	Items []*ReceiptItem `protobuf:"bytes,6,rep,name=items,proto3" json:"items,omitempty"`
+	// Ставка НДС, %
+	VatRate float64 `protobuf:"fixed64,7,opt,name=vat_rate,json=vatRate,proto3" json:"vat_rate,omitempty"`
+	// Сумма НДС
+	VatAmount float64 `protobuf:"fixed64,8,opt,name=vat_amount,json=vatAmount,proto3" json:"vat_amount,omitempty"`
+	// Итоговая сумма (с НДС)
+	Total float64 `protobuf:"fixed64,9,opt,name=total,proto3" json:"total,omitempty"`
+	// Текстовое представление чека
+	Text string `protobuf:"bytes,10,opt,name=text,proto3" json:"text,omitempty"`
+	// HTML представление чека
+	Html string `protobuf:"bytes,11,opt,name=html,proto3" json:"html,omitempty"`
+	// Дата выдачи чека
+	IssuedAt      *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Receipt) Reset() {
+	*x = Receipt{}
+	mi := &file_payment_v1_payment_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Receipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Receipt) ProtoMessage() {}
+
+func (x *Receipt) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Receipt.ProtoReflect.Descriptor instead.
+func (*Receipt) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Receipt) GetReceiptUuid() string {
+	if x != nil {
+		return x.ReceiptUuid
+	}
+	return ""
+}
+
+func (x *Receipt) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *Receipt) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *Receipt) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *Receipt) GetPaymentMethod() PaymentMethod {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return PaymentMethod_PAYMENT_METHOD_UNSPECIFIED
+}
+
+func (x *Receipt) GetItems() []*ReceiptItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Receipt) GetVatRate() float64 {
+	if x != nil {
+		return x.VatRate
+	}
+	return 0
+}
+
+func (x *Receipt) GetVatAmount() float64 {
+	if x != nil {
+		return x.VatAmount
+	}
+	return 0
+}
+
+func (x *Receipt) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *Receipt) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Receipt) GetHtml() string {
+	if x != nil {
+		return x.Html
+	}
+	return ""
+}
+
+func (x *Receipt) GetIssuedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return nil
+}
+
+// ReceiptItem - Позиция чека
+type ReceiptItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID детали
+	PartUuid string `protobuf:"bytes,1,opt,name=part_uuid,json=partUuid,proto3" json:"part_uuid,omitempty"`
+	// Наименование
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Количество
+	Quantity int32 `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// Цена за единицу (с НДС)
+	UnitPrice float64 `protobuf:"fixed64,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	// Сумма позиции (с НДС)
+	Total float64 `protobuf:"fixed64,5,opt,name=total,proto3" json:"total,omitempty"`
+	// НДС в сумме позиции
+	VatAmount     float64 `protobuf:"fixed64,6,opt,name=vat_amount,json=vatAmount,proto3" json:"vat_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiptItem) Reset() {
+	*x = ReceiptItem{}
+	mi := &file_payment_v1_payment_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiptItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiptItem) ProtoMessage() {}
+
+func (x *ReceiptItem) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiptItem.ProtoReflect.Descriptor instead.
+func (*ReceiptItem) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ReceiptItem) GetPartUuid() string {
+	if x != nil {
+		return x.PartUuid
+	}
+	return ""
+}
+
+func (x *ReceiptItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ReceiptItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReceiptItem) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *ReceiptItem) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ReceiptItem) GetVatAmount() float64 {
+	if x != nil {
+		return x.VatAmount
+	}
+	return 0
+}
+
+// GetInstallmentPlanRequest - Запрос плана рассрочки
+type GetInstallmentPlanRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID транзакции, оформленной в рассрочку
+	TransactionUuid string `protobuf:"bytes,1,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetInstallmentPlanRequest) Reset() {
+	*x = GetInstallmentPlanRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstallmentPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstallmentPlanRequest) ProtoMessage() {}
+
+func (x *GetInstallmentPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstallmentPlanRequest.ProtoReflect.Descriptor instead.
+func (*GetInstallmentPlanRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetInstallmentPlanRequest) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+// GetInstallmentPlanResponse - План рассрочки
+type GetInstallmentPlanResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// План рассрочки
+	Plan          *InstallmentPlan `protobuf:"bytes,1,opt,name=plan,proto3" json:"plan,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInstallmentPlanResponse) Reset() {
+	*x = GetInstallmentPlanResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInstallmentPlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstallmentPlanResponse) ProtoMessage() {}
+
+func (x *GetInstallmentPlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstallmentPlanResponse.ProtoReflect.Descriptor instead.
+func (*GetInstallmentPlanResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetInstallmentPlanResponse) GetPlan() *InstallmentPlan {
+	if x != nil {
+		return x.Plan
+	}
+	return nil
+}
+
+// ListInstallmentPlansRequest - Запрос планов рассрочки пользователя
+type ListInstallmentPlansRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID пользователя
+	UserUuid string `protobuf:"bytes,1,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	// Максимальное количество планов (по умолчанию 50)
+	Limit int32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Смещение от последнего плана
+	Offset        int32 `protobuf:"varint,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInstallmentPlansRequest) Reset() {
+	*x = ListInstallmentPlansRequest{}
+	mi := &file_payment_v1_payment_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInstallmentPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInstallmentPlansRequest) ProtoMessage() {}
+
+func (x *ListInstallmentPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInstallmentPlansRequest.ProtoReflect.Descriptor instead.
+func (*ListInstallmentPlansRequest) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListInstallmentPlansRequest) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *ListInstallmentPlansRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListInstallmentPlansRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+// ListInstallmentPlansResponse - Планы рассрочки пользователя
+type ListInstallmentPlansResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Планы рассрочки, от новых к старым
+	Plans         []*InstallmentPlan `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListInstallmentPlansResponse) Reset() {
+	*x = ListInstallmentPlansResponse{}
+	mi := &file_payment_v1_payment_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListInstallmentPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListInstallmentPlansResponse) ProtoMessage() {}
+
+func (x *ListInstallmentPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListInstallmentPlansResponse.ProtoReflect.Descriptor instead.
+func (*ListInstallmentPlansResponse) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListInstallmentPlansResponse) GetPlans() []*InstallmentPlan {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+// InstallmentPlan - План рассрочки с графиком платежей
+type InstallmentPlan struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID плана
+	PlanUuid string `protobuf:"bytes,1,opt,name=plan_uuid,json=planUuid,proto3" json:"plan_uuid,omitempty"`
+	// UUID транзакции
+	TransactionUuid string `protobuf:"bytes,2,opt,name=transaction_uuid,json=transactionUuid,proto3" json:"transaction_uuid,omitempty"`
+	// UUID заказа
+	OrderUuid string `protobuf:"bytes,3,opt,name=order_uuid,json=orderUuid,proto3" json:"order_uuid,omitempty"`
+	// UUID пользователя
+	UserUuid string `protobuf:"bytes,4,opt,name=user_uuid,json=userUuid,proto3" json:"user_uuid,omitempty"`
+	// Полная сумма
+	TotalAmount float64 `protobuf:"fixed64,5,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	// Статус плана
+	Status InstallmentPlanStatus `protobuf:"varint,6,opt,name=status,proto3,enum=payment.v1.InstallmentPlanStatus" json:"status,omitempty"`
+	// График платежей
+	Installments []*Installment `protobuf:"bytes,7,rep,name=installments,proto3" json:"installments,omitempty"`
+	// Дата создания
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InstallmentPlan) Reset() {
+	*x = InstallmentPlan{}
+	mi := &file_payment_v1_payment_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InstallmentPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstallmentPlan) ProtoMessage() {}
+
+func (x *InstallmentPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstallmentPlan.ProtoReflect.Descriptor instead.
+func (*InstallmentPlan) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *InstallmentPlan) GetPlanUuid() string {
+	if x != nil {
+		return x.PlanUuid
+	}
+	return ""
+}
+
+func (x *InstallmentPlan) GetTransactionUuid() string {
+	if x != nil {
+		return x.TransactionUuid
+	}
+	return ""
+}
+
+func (x *InstallmentPlan) GetOrderUuid() string {
+	if x != nil {
+		return x.OrderUuid
+	}
+	return ""
+}
+
+func (x *InstallmentPlan) GetUserUuid() string {
+	if x != nil {
+		return x.UserUuid
+	}
+	return ""
+}
+
+func (x *InstallmentPlan) GetTotalAmount() float64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *InstallmentPlan) GetStatus() InstallmentPlanStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InstallmentPlanStatus_INSTALLMENT_PLAN_STATUS_UNSPECIFIED
+}
+
+func (x *InstallmentPlan) GetInstallments() []*Installment {
+	if x != nil {
+		return x.Installments
+	}
+	return nil
+}
+
+func (x *InstallmentPlan) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Installment - Платеж по графику рассрочки
+type Installment struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// UUID платежа
+	InstallmentUuid string `protobuf:"bytes,1,opt,name=installment_uuid,json=installmentUuid,proto3" json:"installment_uuid,omitempty"`
+	// Порядковый номер (с 1)
+	Number int32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	// Сумма платежа
+	Amount float64 `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Дата, когда платеж должен быть списан
+	DueDate *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=due_date,json=dueDate,proto3" json:"due_date,omitempty"`
+	// Статус платежа
+	Status InstallmentStatus `protobuf:"varint,5,opt,name=status,proto3,enum=payment.v1.InstallmentStatus" json:"status,omitempty"`
+	// Количество попыток списания
+	Attempts int32 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Причина последнего отказа
+	FailureReason string `protobuf:"bytes,7,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	// Дата успешного списания
+	PaidAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Installment) Reset() {
+	*x = Installment{}
+	mi := &file_payment_v1_payment_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Installment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Installment) ProtoMessage() {}
+
+func (x *Installment) ProtoReflect() protoreflect.Message {
+	mi := &file_payment_v1_payment_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Installment.ProtoReflect.Descriptor instead.
+func (*Installment) Descriptor() ([]byte, []int) {
+	return file_payment_v1_payment_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Installment) GetInstallmentUuid() string {
+	if x != nil {
+		return x.InstallmentUuid
+	}
+	return ""
+}
+
+func (x *Installment) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Installment) GetAmount() float64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Installment) GetDueDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueDate
+	}
+	return nil
+}
+
+func (x *Installment) GetStatus() InstallmentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InstallmentStatus_INSTALLMENT_STATUS_UNSPECIFIED
+}
+
+func (x *Installment) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *Installment) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *Installment) GetPaidAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PaidAt
+	}
+	return nil
+}
+
+var File_payment_v1_payment_proto protoreflect.FileDescriptor
+
+const file_payment_v1_payment_proto_rawDesc = "" +
+	"\n" +
+	"\x18payment/v1/payment.proto\x12\n" +
+	"payment.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcf\x02\n" +
+	"\x0fPayOrderRequest\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x01 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x02 \x01(\tR\buserUuid\x12@\n" +
+	"\x0epayment_method\x18\x03 \x01(\x0e2\x19.payment.v1.PaymentMethodR\rpaymentMethod\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x01R\x06amount\x12(\n" +
+	"\x10order_owner_uuid\x18\x05 \x01(\tR\x0eorderOwnerUuid\x12-\n" +
+	"\x05items\x18\x06 \x03(\v2\x17.payment.v1.PaymentItemR\x05items\x12\"\n" +
+	"\finstallments\x18\a \x01(\x05R\finstallments\x12)\n" +
+	"\x10transaction_uuid\x18\b \x01(\tR\x0ftransactionUuid\"y\n" +
+	"\vPaymentItem\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x04 \x01(\x01R\tunitPrice\"\xa4\x01\n" +
+	"\x10PayOrderResponse\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\x121\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x19.payment.v1.PaymentStatusR\x06status\x122\n" +
+	"\x15installment_plan_uuid\x18\x03 \x01(\tR\x13installmentPlanUuid\"\x9c\x01\n" +
+	"\x15ConfirmPaymentRequest\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\x121\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x19.payment.v1.PaymentStatusR\x06status\x12%\n" +
+	"\x0efailure_reason\x18\x03 \x01(\tR\rfailureReason\"v\n" +
+	"\x16ConfirmPaymentResponse\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\x121\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x19.payment.v1.PaymentStatusR\x06status\"D\n" +
+	"\x17GetPaymentStatusRequest\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\"x\n" +
+	"\x18GetPaymentStatusResponse\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\x121\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x19.payment.v1.PaymentStatusR\x06status\"p\n" +
+	"\x1bTopUpInvestorAccountRequest\x12\x1b\n" +
+	"\tuser_uuid\x18\x01 \x01(\tR\buserUuid\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1c\n" +
+	"\treference\x18\x03 \x01(\tR\treference\"W\n" +
+	"\x1cTopUpInvestorAccountResponse\x12\x1d\n" +
+	"\n" +
+	"entry_uuid\x18\x01 \x01(\tR\tentryUuid\x12\x18\n" +
+	"\abalance\x18\x02 \x01(\x01R\abalance\"8\n" +
+	"\x19GetInvestorBalanceRequest\x12\x1b\n" +
+	"\tuser_uuid\x18\x01 \x01(\tR\buserUuid\"6\n" +
+	"\x1aGetInvestorBalanceResponse\x12\x18\n" +
+	"\abalance\x18\x01 \x01(\x01R\abalance\"h\n" +
+	"\x1bGetInvestorStatementRequest\x12\x1b\n" +
+	"\tuser_uuid\x18\x01 \x01(\tR\buserUuid\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"i\n" +
+	"\x1cGetInvestorStatementResponse\x12\x18\n" +
+	"\abalance\x18\x01 \x01(\x01R\abalance\x12/\n" +
+	"\x05lines\x18\x02 \x03(\v2\x19.payment.v1.StatementLineR\x05lines\"\x80\x02\n" +
+	"\rStatementLine\x12\x1d\n" +
+	"\n" +
+	"entry_uuid\x18\x01 \x01(\tR\tentryUuid\x12:\n" +
+	"\n" +
+	"entry_type\x18\x02 \x01(\x0e2\x1b.payment.v1.LedgerEntryTypeR\tentryType\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x12#\n" +
+	"\rbalance_after\x18\x04 \x01(\x01R\fbalanceAfter\x12\x1c\n" +
+	"\treference\x18\x05 \x01(\tR\treference\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"J\n" +
+	"\x1aListFlaggedPaymentsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x02 \x01(\x05R\x06offset\"V\n" +
+	"\x1bListFlaggedPaymentsResponse\x127\n" +
+	"\tdecisions\x18\x01 \x03(\v2\x19.payment.v1.FraudDecisionR\tdecisions\"\xfe\x02\n" +
+	"\rFraudDecision\x12#\n" +
+	"\rdecision_uuid\x18\x01 \x01(\tR\fdecisionUuid\x12)\n" +
+	"\x10transaction_uuid\x18\x02 \x01(\tR\x0ftransactionUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x03 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x04 \x01(\tR\buserUuid\x12@\n" +
+	"\x0epayment_method\x18\x05 \x01(\x0e2\x19.payment.v1.PaymentMethodR\rpaymentMethod\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x01R\x06amount\x122\n" +
+	"\averdict\x18\a \x01(\x0e2\x18.payment.v1.FraudVerdictR\averdict\x12\x18\n" +
+	"\areasons\x18\b \x03(\tR\areasons\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\">\n" +
+	"\x11GetReceiptRequest\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\"C\n" +
+	"\x12GetReceiptResponse\x12-\n" +
+	"\areceipt\x18\x01 \x01(\v2\x13.payment.v1.ReceiptR\areceipt\"\xb5\x03\n" +
+	"\aReceipt\x12!\n" +
+	"\freceipt_uuid\x18\x01 \x01(\tR\vreceiptUuid\x12)\n" +
+	"\x10transaction_uuid\x18\x02 \x01(\tR\x0ftransactionUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x03 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x04 \x01(\tR\buserUuid\x12@\n" +
+	"\x0epayment_method\x18\x05 \x01(\x0e2\x19.payment.v1.PaymentMethodR\rpaymentMethod\x12-\n" +
+	"\x05items\x18\x06 \x03(\v2\x17.payment.v1.ReceiptItemR\x05items\x12\x19\n" +
+	"\bvat_rate\x18\a \x01(\x01R\avatRate\x12\x1d\n" +
+	"\n" +
+	"vat_amount\x18\b \x01(\x01R\tvatAmount\x12\x14\n" +
+	"\x05total\x18\t \x01(\x01R\x05total\x12\x12\n" +
+	"\x04text\x18\n" +
+	" \x01(\tR\x04text\x12\x12\n" +
+	"\x04html\x18\v \x01(\tR\x04html\x127\n" +
+	"\tissued_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\bissuedAt\"\xae\x01\n" +
+	"\vReceiptItem\x12\x1b\n" +
+	"\tpart_uuid\x18\x01 \x01(\tR\bpartUuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x04 \x01(\x01R\tunitPrice\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x01R\x05total\x12\x1d\n" +
+	"\n" +
+	"vat_amount\x18\x06 \x01(\x01R\tvatAmount\"F\n" +
+	"\x19GetInstallmentPlanRequest\x12)\n" +
+	"\x10transaction_uuid\x18\x01 \x01(\tR\x0ftransactionUuid\"M\n" +
+	"\x1aGetInstallmentPlanResponse\x12/\n" +
+	"\x04plan\x18\x01 \x01(\v2\x1b.payment.v1.InstallmentPlanR\x04plan\"h\n" +
+	"\x1bListInstallmentPlansRequest\x12\x1b\n" +
+	"\tuser_uuid\x18\x01 \x01(\tR\buserUuid\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x05R\x06offset\"Q\n" +
+	"\x1cListInstallmentPlansResponse\x121\n" +
+	"\x05plans\x18\x01 \x03(\v2\x1b.payment.v1.InstallmentPlanR\x05plans\"\xeb\x02\n" +
+	"\x0fInstallmentPlan\x12\x1b\n" +
+	"\tplan_uuid\x18\x01 \x01(\tR\bplanUuid\x12)\n" +
+	"\x10transaction_uuid\x18\x02 \x01(\tR\x0ftransactionUuid\x12\x1d\n" +
+	"\n" +
+	"order_uuid\x18\x03 \x01(\tR\torderUuid\x12\x1b\n" +
+	"\tuser_uuid\x18\x04 \x01(\tR\buserUuid\x12!\n" +
+	"\ftotal_amount\x18\x05 \x01(\x01R\vtotalAmount\x129\n" +
+	"\x06status\x18\x06 \x01(\x0e2!.payment.v1.InstallmentPlanStatusR\x06status\x12;\n" +
+	"\finstallments\x18\a \x03(\v2\x17.payment.v1.InstallmentR\finstallments\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xce\x02\n" +
+	"\vInstallment\x12)\n" +
+	"\x10installment_uuid\x18\x01 \x01(\tR\x0finstallmentUuid\x12\x16\n" +
+	"\x06number\x18\x02 \x01(\x05R\x06number\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x01R\x06amount\x125\n" +
+	"\bdue_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\adueDate\x125\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1d.payment.v1.InstallmentStatusR\x06status\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x05R\battempts\x12%\n" +
+	"\x0efailure_reason\x18\a \x01(\tR\rfailureReason\x123\n" +
+	"\apaid_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\x06paidAt*\xa3\x01\n" +
+	"\rPaymentMethod\x12\x1e\n" +
+	"\x1aPAYMENT_METHOD_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13PAYMENT_METHOD_CARD\x10\x01\x12\x16\n" +
+	"\x12PAYMENT_METHOD_SBP\x10\x02\x12\x1e\n" +
+	"\x1aPAYMENT_METHOD_CREDIT_CARD\x10\x03\x12!\n" +
+	"\x1dPAYMENT_METHOD_INVESTOR_MONEY\x10\x04*\x84\x01\n" +
+	"\rPaymentStatus\x12\x1e\n" +
+	"\x1aPAYMENT_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16PAYMENT_STATUS_PENDING\x10\x01\x12\x1c\n" +
+	"\x18PAYMENT_STATUS_SUCCEEDED\x10\x02\x12\x19\n" +
+	"\x15PAYMENT_STATUS_FAILED\x10\x03*q\n" +
+	"\x0fLedgerEntryType\x12!\n" +
+	"\x1dLEDGER_ENTRY_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18LEDGER_ENTRY_TYPE_TOP_UP\x10\x01\x12\x1d\n" +
+	"\x19LEDGER_ENTRY_TYPE_PAYMENT\x10\x02*|\n" +
+	"\fFraudVerdict\x12\x1d\n" +
+	"\x19FRAUD_VERDICT_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15FRAUD_VERDICT_APPROVE\x10\x01\x12\x18\n" +
+	"\x14FRAUD_VERDICT_REVIEW\x10\x02\x12\x18\n" +
+	"\x14FRAUD_VERDICT_REJECT\x10\x03*\xd9\x01\n" +
+	"\x15InstallmentPlanStatus\x12'\n" +
+	"#INSTALLMENT_PLAN_STATUS_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eINSTALLMENT_PLAN_STATUS_ACTIVE\x10\x01\x12%\n" +
+	"!INSTALLMENT_PLAN_STATUS_COMPLETED\x10\x02\x12%\n" +
+	"!INSTALLMENT_PLAN_STATUS_CANCELLED\x10\x03\x12%\n" +
+	"!INSTALLMENT_PLAN_STATUS_DEFAULTED\x10\x04*\xb4\x01\n" +
+	"\x11InstallmentStatus\x12\"\n" +
+	"\x1eINSTALLMENT_STATUS_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cINSTALLMENT_STATUS_SCHEDULED\x10\x01\x12\x1b\n" +
+	"\x17INSTALLMENT_STATUS_PAID\x10\x02\x12\x1d\n" +
+	"\x19INSTALLMENT_STATUS_MISSED\x10\x03\x12\x1d\n" +
+	"\x19INSTALLMENT_STATUS_FAILED\x10\x042\xcf\a\n" +
+	"\x0ePaymentService\x12E\n" +
+	"\bPayOrder\x12\x1b.payment.v1.PayOrderRequest\x1a\x1c.payment.v1.PayOrderResponse\x12W\n" +
+	"\x0eConfirmPayment\x12!.payment.v1.ConfirmPaymentRequest\x1a\".payment.v1.ConfirmPaymentResponse\x12]\n" +
+	"\x10GetPaymentStatus\x12#.payment.v1.GetPaymentStatusRequest\x1a$.payment.v1.GetPaymentStatusResponse\x12i\n" +
+	"\x14TopUpInvestorAccount\x12'.payment.v1.TopUpInvestorAccountRequest\x1a(.payment.v1.TopUpInvestorAccountResponse\x12c\n" +
+	"\x12GetInvestorBalance\x12%.payment.v1.GetInvestorBalanceRequest\x1a&.payment.v1.GetInvestorBalanceResponse\x12i\n" +
+	"\x14GetInvestorStatement\x12'.payment.v1.GetInvestorStatementRequest\x1a(.payment.v1.GetInvestorStatementResponse\x12f\n" +
+	"\x13ListFlaggedPayments\x12&.payment.v1.ListFlaggedPaymentsRequest\x1a'.payment.v1.ListFlaggedPaymentsResponse\x12K\n" +
+	"\n" +
+	"GetReceipt\x12\x1d.payment.v1.GetReceiptRequest\x1a\x1e.payment.v1.GetReceiptResponse\x12c\n" +
+	"\x12GetInstallmentPlan\x12%.payment.v1.GetInstallmentPlanRequest\x1a&.payment.v1.GetInstallmentPlanResponse\x12i\n" +
+	"\x14ListInstallmentPlans\x12'.payment.v1.ListInstallmentPlansRequest\x1a(.payment.v1.ListInstallmentPlansResponseB\xb7\x01\n" +
 	"\x0ecom.payment.v1B\fPaymentProtoP\x01ZNgithub.com/Daniil-Sakharov/RocketFactory/shared/pkg/proto/payment/v1;paymentv1\xa2\x02\x03PXX\xaa\x02\n" +
 	"Payment.V1\xca\x02\n" +
 	"Payment\\V1\xe2\x02\x16Payment\\V1\\GPBMetadata\xea\x02\vPayment::V1b\x06proto3"
@@ -229,22 +2430,95 @@ func file_payment_v1_payment_proto_rawDescGZIP() []byte {
 	return file_payment_v1_payment_proto_rawDescData
 }
 
-var file_payment_v1_payment_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_payment_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_payment_v1_payment_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_payment_v1_payment_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_payment_v1_payment_proto_goTypes = []any{
-	(PaymentMethod)(0),       // 0: payment.v1.PaymentMethod
-	(*PayOrderRequest)(nil),  // 1: payment.v1.PayOrderRequest
-	(*PayOrderResponse)(nil), // 2: payment.v1.PayOrderResponse
+	(PaymentMethod)(0),                   // 0: payment.v1.PaymentMethod
+	(PaymentStatus)(0),                   // 1: payment.v1.PaymentStatus
+	(LedgerEntryType)(0),                 // 2: payment.v1.LedgerEntryType
+	(FraudVerdict)(0),                    // 3: payment.v1.FraudVerdict
+	(InstallmentPlanStatus)(0),           // 4: payment.v1.InstallmentPlanStatus
+	(InstallmentStatus)(0),               // 5: payment.v1.InstallmentStatus
+	(*PayOrderRequest)(nil),              // 6: payment.v1.PayOrderRequest
+	(*PaymentItem)(nil),                  // 7: payment.v1.PaymentItem
+	(*PayOrderResponse)(nil),             // 8: payment.v1.PayOrderResponse
+	(*ConfirmPaymentRequest)(nil),        // 9: payment.v1.ConfirmPaymentRequest
+	(*ConfirmPaymentResponse)(nil),       // 10: payment.v1.ConfirmPaymentResponse
+	(*GetPaymentStatusRequest)(nil),      // 11: payment.v1.GetPaymentStatusRequest
+	(*GetPaymentStatusResponse)(nil),     // 12: payment.v1.GetPaymentStatusResponse
+	(*TopUpInvestorAccountRequest)(nil),  // 13: payment.v1.TopUpInvestorAccountRequest
+	(*TopUpInvestorAccountResponse)(nil), // 14: payment.v1.TopUpInvestorAccountResponse
+	(*GetInvestorBalanceRequest)(nil),    // 15: payment.v1.GetInvestorBalanceRequest
+	(*GetInvestorBalanceResponse)(nil),   // 16: payment.v1.GetInvestorBalanceResponse
+	(*GetInvestorStatementRequest)(nil),  // 17: payment.v1.GetInvestorStatementRequest
+	(*GetInvestorStatementResponse)(nil), // 18: payment.v1.GetInvestorStatementResponse
+	(*StatementLine)(nil),                // 19: payment.v1.StatementLine
+	(*ListFlaggedPaymentsRequest)(nil),   // 20: payment.v1.ListFlaggedPaymentsRequest
+	(*ListFlaggedPaymentsResponse)(nil),  // 21: payment.v1.ListFlaggedPaymentsResponse
+	(*FraudDecision)(nil),                // 22: payment.v1.FraudDecision
+	(*GetReceiptRequest)(nil),            // 23: payment.v1.GetReceiptRequest
+	(*GetReceiptResponse)(nil),           // 24: payment.v1.GetReceiptResponse
+	(*Receipt)(nil),                      // 25: payment.v1.Receipt
+	(*ReceiptItem)(nil),                  // 26: payment.v1.ReceiptItem
+	(*GetInstallmentPlanRequest)(nil),    // 27: payment.v1.GetInstallmentPlanRequest
+	(*GetInstallmentPlanResponse)(nil),   // 28: payment.v1.GetInstallmentPlanResponse
+	(*ListInstallmentPlansRequest)(nil),  // 29: payment.v1.ListInstallmentPlansRequest
+	(*ListInstallmentPlansResponse)(nil), // 30: payment.v1.ListInstallmentPlansResponse
+	(*InstallmentPlan)(nil),              // 31: payment.v1.InstallmentPlan
+	(*Installment)(nil),                  // 32: payment.v1.Installment
+	(*timestamppb.Timestamp)(nil),        // 33: google.protobuf.Timestamp
 }
 var file_payment_v1_payment_proto_depIdxs = []int32{
-	0, // 0: payment.v1.PayOrderRequest.payment_method:type_name -> payment.v1.PaymentMethod
-	1, // 1: payment.v1.PaymentService.PayOrder:input_type -> payment.v1.PayOrderRequest
-	2, // 2: payment.v1.PaymentService.PayOrder:output_type -> payment.v1.PayOrderResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	0,  // 0: payment.v1.PayOrderRequest.payment_method:type_name -> payment.v1.PaymentMethod
+	7,  // 1: payment.v1.PayOrderRequest.items:type_name -> payment.v1.PaymentItem
+	1,  // 2: payment.v1.PayOrderResponse.status:type_name -> payment.v1.PaymentStatus
+	1,  // 3: payment.v1.ConfirmPaymentRequest.status:type_name -> payment.v1.PaymentStatus
+	1,  // 4: payment.v1.ConfirmPaymentResponse.status:type_name -> payment.v1.PaymentStatus
+	1,  // 5: payment.v1.GetPaymentStatusResponse.status:type_name -> payment.v1.PaymentStatus
+	19, // 6: payment.v1.GetInvestorStatementResponse.lines:type_name -> payment.v1.StatementLine
+	2,  // 7: payment.v1.StatementLine.entry_type:type_name -> payment.v1.LedgerEntryType
+	33, // 8: payment.v1.StatementLine.created_at:type_name -> google.protobuf.Timestamp
+	22, // 9: payment.v1.ListFlaggedPaymentsResponse.decisions:type_name -> payment.v1.FraudDecision
+	0,  // 10: payment.v1.FraudDecision.payment_method:type_name -> payment.v1.PaymentMethod
+	3,  // 11: payment.v1.FraudDecision.verdict:type_name -> payment.v1.FraudVerdict
+	33, // 12: payment.v1.FraudDecision.created_at:type_name -> google.protobuf.Timestamp
+	25, // 13: payment.v1.GetReceiptResponse.receipt:type_name -> payment.v1.Receipt
+	0,  // 14: payment.v1.Receipt.payment_method:type_name -> payment.v1.PaymentMethod
+	26, // 15: payment.v1.Receipt.items:type_name -> payment.v1.ReceiptItem
+	33, // 16: payment.v1.Receipt.issued_at:type_name -> google.protobuf.Timestamp
+	31, // 17: payment.v1.GetInstallmentPlanResponse.plan:type_name -> payment.v1.InstallmentPlan
+	31, // 18: payment.v1.ListInstallmentPlansResponse.plans:type_name -> payment.v1.InstallmentPlan
+	4,  // 19: payment.v1.InstallmentPlan.status:type_name -> payment.v1.InstallmentPlanStatus
+	32, // 20: payment.v1.InstallmentPlan.installments:type_name -> payment.v1.Installment
+	33, // 21: payment.v1.InstallmentPlan.created_at:type_name -> google.protobuf.Timestamp
+	33, // 22: payment.v1.Installment.due_date:type_name -> google.protobuf.Timestamp
+	5,  // 23: payment.v1.Installment.status:type_name -> payment.v1.InstallmentStatus
+	33, // 24: payment.v1.Installment.paid_at:type_name -> google.protobuf.Timestamp
+	6,  // 25: payment.v1.PaymentService.PayOrder:input_type -> payment.v1.PayOrderRequest
+	9,  // 26: payment.v1.PaymentService.ConfirmPayment:input_type -> payment.v1.ConfirmPaymentRequest
+	11, // 27: payment.v1.PaymentService.GetPaymentStatus:input_type -> payment.v1.GetPaymentStatusRequest
+	13, // 28: payment.v1.PaymentService.TopUpInvestorAccount:input_type -> payment.v1.TopUpInvestorAccountRequest
+	15, // 29: payment.v1.PaymentService.GetInvestorBalance:input_type -> payment.v1.GetInvestorBalanceRequest
+	17, // 30: payment.v1.PaymentService.GetInvestorStatement:input_type -> payment.v1.GetInvestorStatementRequest
+	20, // 31: payment.v1.PaymentService.ListFlaggedPayments:input_type -> payment.v1.ListFlaggedPaymentsRequest
+	23, // 32: payment.v1.PaymentService.GetReceipt:input_type -> payment.v1.GetReceiptRequest
+	27, // 33: payment.v1.PaymentService.GetInstallmentPlan:input_type -> payment.v1.GetInstallmentPlanRequest
+	29, // 34: payment.v1.PaymentService.ListInstallmentPlans:input_type -> payment.v1.ListInstallmentPlansRequest
+	8,  // 35: payment.v1.PaymentService.PayOrder:output_type -> payment.v1.PayOrderResponse
+	10, // 36: payment.v1.PaymentService.ConfirmPayment:output_type -> payment.v1.ConfirmPaymentResponse
+	12, // 37: payment.v1.PaymentService.GetPaymentStatus:output_type -> payment.v1.GetPaymentStatusResponse
+	14, // 38: payment.v1.PaymentService.TopUpInvestorAccount:output_type -> payment.v1.TopUpInvestorAccountResponse
+	16, // 39: payment.v1.PaymentService.GetInvestorBalance:output_type -> payment.v1.GetInvestorBalanceResponse
+	18, // 40: payment.v1.PaymentService.GetInvestorStatement:output_type -> payment.v1.GetInvestorStatementResponse
+	21, // 41: payment.v1.PaymentService.ListFlaggedPayments:output_type -> payment.v1.ListFlaggedPaymentsResponse
+	24, // 42: payment.v1.PaymentService.GetReceipt:output_type -> payment.v1.GetReceiptResponse
+	28, // 43: payment.v1.PaymentService.GetInstallmentPlan:output_type -> payment.v1.GetInstallmentPlanResponse
+	30, // 44: payment.v1.PaymentService.ListInstallmentPlans:output_type -> payment.v1.ListInstallmentPlansResponse
+	35, // [35:45] is the sub-list for method output_type
+	25, // [25:35] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_payment_v1_payment_proto_init() }
@@ -257,8 +2531,8 @@ func file_payment_v1_payment_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_payment_v1_payment_proto_rawDesc), len(file_payment_v1_payment_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   2,
+			NumEnums:      6,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
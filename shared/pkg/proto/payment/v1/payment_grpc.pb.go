@@ -19,7 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	PaymentService_PayOrder_FullMethodName = "/payment.v1.PaymentService/PayOrder"
+	PaymentService_PayOrder_FullMethodName             = "/payment.v1.PaymentService/PayOrder"
+	PaymentService_ConfirmPayment_FullMethodName       = "/payment.v1.PaymentService/ConfirmPayment"
+	PaymentService_GetPaymentStatus_FullMethodName     = "/payment.v1.PaymentService/GetPaymentStatus"
+	PaymentService_TopUpInvestorAccount_FullMethodName = "/payment.v1.PaymentService/TopUpInvestorAccount"
+	PaymentService_GetInvestorBalance_FullMethodName   = "/payment.v1.PaymentService/GetInvestorBalance"
+	PaymentService_GetInvestorStatement_FullMethodName = "/payment.v1.PaymentService/GetInvestorStatement"
+	PaymentService_ListFlaggedPayments_FullMethodName  = "/payment.v1.PaymentService/ListFlaggedPayments"
+	PaymentService_GetReceipt_FullMethodName           = "/payment.v1.PaymentService/GetReceipt"
+	PaymentService_GetInstallmentPlan_FullMethodName   = "/payment.v1.PaymentService/GetInstallmentPlan"
+	PaymentService_ListInstallmentPlans_FullMethodName = "/payment.v1.PaymentService/ListInstallmentPlans"
 )
 
 // PaymentServiceClient is the client API for PaymentService service.
@@ -30,6 +39,25 @@ const (
 type PaymentServiceClient interface {
 	// Обрабатывает команду на оплату и возвращает transaction_uuid
 	PayOrder(ctx context.Context, in *PayOrderRequest, opts ...grpc.CallOption) (*PayOrderResponse, error)
+	// Callback платежного шлюза: подтверждает или отклоняет платеж в статусе PENDING.
+	// Metadata x-webhook-signature - hex HMAC-SHA256 от "transaction_uuid:status:failure_reason" на ключе WEBHOOK_SECRET
+	ConfirmPayment(ctx context.Context, in *ConfirmPaymentRequest, opts ...grpc.CallOption) (*ConfirmPaymentResponse, error)
+	// Возвращает статус платежа по UUID транзакции
+	GetPaymentStatus(ctx context.Context, in *GetPaymentStatusRequest, opts ...grpc.CallOption) (*GetPaymentStatusResponse, error)
+	// Пополняет счет инвестора
+	TopUpInvestorAccount(ctx context.Context, in *TopUpInvestorAccountRequest, opts ...grpc.CallOption) (*TopUpInvestorAccountResponse, error)
+	// Возвращает текущий баланс счета инвестора
+	GetInvestorBalance(ctx context.Context, in *GetInvestorBalanceRequest, opts ...grpc.CallOption) (*GetInvestorBalanceResponse, error)
+	// Возвращает выписку по счету инвестора
+	GetInvestorStatement(ctx context.Context, in *GetInvestorStatementRequest, opts ...grpc.CallOption) (*GetInvestorStatementResponse, error)
+	// Возвращает платежи, отмеченные антифрод-проверкой для ручного разбора
+	ListFlaggedPayments(ctx context.Context, in *ListFlaggedPaymentsRequest, opts ...grpc.CallOption) (*ListFlaggedPaymentsResponse, error)
+	// Возвращает чек по UUID транзакции
+	GetReceipt(ctx context.Context, in *GetReceiptRequest, opts ...grpc.CallOption) (*GetReceiptResponse, error)
+	// Возвращает план рассрочки по UUID транзакции
+	GetInstallmentPlan(ctx context.Context, in *GetInstallmentPlanRequest, opts ...grpc.CallOption) (*GetInstallmentPlanResponse, error)
+	// Возвращает планы рассрочки пользователя
+	ListInstallmentPlans(ctx context.Context, in *ListInstallmentPlansRequest, opts ...grpc.CallOption) (*ListInstallmentPlansResponse, error)
 }
 
 type paymentServiceClient struct {
@@ -50,6 +78,96 @@ func (c *paymentServiceClient) PayOrder(ctx context.Context, in *PayOrderRequest
 	return out, nil
 }
 
+func (c *paymentServiceClient) ConfirmPayment(ctx context.Context, in *ConfirmPaymentRequest, opts ...grpc.CallOption) (*ConfirmPaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ConfirmPaymentResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ConfirmPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetPaymentStatus(ctx context.Context, in *GetPaymentStatusRequest, opts ...grpc.CallOption) (*GetPaymentStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPaymentStatusResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetPaymentStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) TopUpInvestorAccount(ctx context.Context, in *TopUpInvestorAccountRequest, opts ...grpc.CallOption) (*TopUpInvestorAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TopUpInvestorAccountResponse)
+	err := c.cc.Invoke(ctx, PaymentService_TopUpInvestorAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetInvestorBalance(ctx context.Context, in *GetInvestorBalanceRequest, opts ...grpc.CallOption) (*GetInvestorBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInvestorBalanceResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetInvestorBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetInvestorStatement(ctx context.Context, in *GetInvestorStatementRequest, opts ...grpc.CallOption) (*GetInvestorStatementResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInvestorStatementResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetInvestorStatement_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListFlaggedPayments(ctx context.Context, in *ListFlaggedPaymentsRequest, opts ...grpc.CallOption) (*ListFlaggedPaymentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFlaggedPaymentsResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListFlaggedPayments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetReceipt(ctx context.Context, in *GetReceiptRequest, opts ...grpc.CallOption) (*GetReceiptResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReceiptResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetReceipt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) GetInstallmentPlan(ctx context.Context, in *GetInstallmentPlanRequest, opts ...grpc.CallOption) (*GetInstallmentPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetInstallmentPlanResponse)
+	err := c.cc.Invoke(ctx, PaymentService_GetInstallmentPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentServiceClient) ListInstallmentPlans(ctx context.Context, in *ListInstallmentPlansRequest, opts ...grpc.CallOption) (*ListInstallmentPlansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInstallmentPlansResponse)
+	err := c.cc.Invoke(ctx, PaymentService_ListInstallmentPlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaymentServiceServer is the server API for PaymentService service.
 // All implementations must embed UnimplementedPaymentServiceServer
 // for forward compatibility.
@@ -58,6 +176,25 @@ func (c *paymentServiceClient) PayOrder(ctx context.Context, in *PayOrderRequest
 type PaymentServiceServer interface {
 	// Обрабатывает команду на оплату и возвращает transaction_uuid
 	PayOrder(context.Context, *PayOrderRequest) (*PayOrderResponse, error)
+	// Callback платежного шлюза: подтверждает или отклоняет платеж в статусе PENDING.
+	// Metadata x-webhook-signature - hex HMAC-SHA256 от "transaction_uuid:status:failure_reason" на ключе WEBHOOK_SECRET
+	ConfirmPayment(context.Context, *ConfirmPaymentRequest) (*ConfirmPaymentResponse, error)
+	// Возвращает статус платежа по UUID транзакции
+	GetPaymentStatus(context.Context, *GetPaymentStatusRequest) (*GetPaymentStatusResponse, error)
+	// Пополняет счет инвестора
+	TopUpInvestorAccount(context.Context, *TopUpInvestorAccountRequest) (*TopUpInvestorAccountResponse, error)
+	// Возвращает текущий баланс счета инвестора
+	GetInvestorBalance(context.Context, *GetInvestorBalanceRequest) (*GetInvestorBalanceResponse, error)
+	// Возвращает выписку по счету инвестора
+	GetInvestorStatement(context.Context, *GetInvestorStatementRequest) (*GetInvestorStatementResponse, error)
+	// Возвращает платежи, отмеченные антифрод-проверкой для ручного разбора
+	ListFlaggedPayments(context.Context, *ListFlaggedPaymentsRequest) (*ListFlaggedPaymentsResponse, error)
+	// Возвращает чек по UUID транзакции
+	GetReceipt(context.Context, *GetReceiptRequest) (*GetReceiptResponse, error)
+	// Возвращает план рассрочки по UUID транзакции
+	GetInstallmentPlan(context.Context, *GetInstallmentPlanRequest) (*GetInstallmentPlanResponse, error)
+	// Возвращает планы рассрочки пользователя
+	ListInstallmentPlans(context.Context, *ListInstallmentPlansRequest) (*ListInstallmentPlansResponse, error)
 	mustEmbedUnimplementedPaymentServiceServer()
 }
 
@@ -71,6 +208,33 @@ type UnimplementedPaymentServiceServer struct{}
 func (UnimplementedPaymentServiceServer) PayOrder(context.Context, *PayOrderRequest) (*PayOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PayOrder not implemented")
 }
+func (UnimplementedPaymentServiceServer) ConfirmPayment(context.Context, *ConfirmPaymentRequest) (*ConfirmPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmPayment not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetPaymentStatus(context.Context, *GetPaymentStatusRequest) (*GetPaymentStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentStatus not implemented")
+}
+func (UnimplementedPaymentServiceServer) TopUpInvestorAccount(context.Context, *TopUpInvestorAccountRequest) (*TopUpInvestorAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUpInvestorAccount not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetInvestorBalance(context.Context, *GetInvestorBalanceRequest) (*GetInvestorBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvestorBalance not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetInvestorStatement(context.Context, *GetInvestorStatementRequest) (*GetInvestorStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvestorStatement not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListFlaggedPayments(context.Context, *ListFlaggedPaymentsRequest) (*ListFlaggedPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFlaggedPayments not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetReceipt(context.Context, *GetReceiptRequest) (*GetReceiptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReceipt not implemented")
+}
+func (UnimplementedPaymentServiceServer) GetInstallmentPlan(context.Context, *GetInstallmentPlanRequest) (*GetInstallmentPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstallmentPlan not implemented")
+}
+func (UnimplementedPaymentServiceServer) ListInstallmentPlans(context.Context, *ListInstallmentPlansRequest) (*ListInstallmentPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInstallmentPlans not implemented")
+}
 func (UnimplementedPaymentServiceServer) mustEmbedUnimplementedPaymentServiceServer() {}
 func (UnimplementedPaymentServiceServer) testEmbeddedByValue()                        {}
 
@@ -110,6 +274,168 @@ func _PaymentService_PayOrder_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentService_ConfirmPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ConfirmPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ConfirmPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ConfirmPayment(ctx, req.(*ConfirmPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetPaymentStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetPaymentStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetPaymentStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetPaymentStatus(ctx, req.(*GetPaymentStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_TopUpInvestorAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TopUpInvestorAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).TopUpInvestorAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_TopUpInvestorAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).TopUpInvestorAccount(ctx, req.(*TopUpInvestorAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetInvestorBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvestorBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetInvestorBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetInvestorBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetInvestorBalance(ctx, req.(*GetInvestorBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetInvestorStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvestorStatementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetInvestorStatement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetInvestorStatement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetInvestorStatement(ctx, req.(*GetInvestorStatementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListFlaggedPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFlaggedPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListFlaggedPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListFlaggedPayments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListFlaggedPayments(ctx, req.(*ListFlaggedPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReceiptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetReceipt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetReceipt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetReceipt(ctx, req.(*GetReceiptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_GetInstallmentPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstallmentPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).GetInstallmentPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_GetInstallmentPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).GetInstallmentPlan(ctx, req.(*GetInstallmentPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentService_ListInstallmentPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInstallmentPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentServiceServer).ListInstallmentPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentService_ListInstallmentPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentServiceServer).ListInstallmentPlans(ctx, req.(*ListInstallmentPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PaymentService_ServiceDesc is the grpc.ServiceDesc for PaymentService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -121,6 +447,42 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PayOrder",
 			Handler:    _PaymentService_PayOrder_Handler,
 		},
+		{
+			MethodName: "ConfirmPayment",
+			Handler:    _PaymentService_ConfirmPayment_Handler,
+		},
+		{
+			MethodName: "GetPaymentStatus",
+			Handler:    _PaymentService_GetPaymentStatus_Handler,
+		},
+		{
+			MethodName: "TopUpInvestorAccount",
+			Handler:    _PaymentService_TopUpInvestorAccount_Handler,
+		},
+		{
+			MethodName: "GetInvestorBalance",
+			Handler:    _PaymentService_GetInvestorBalance_Handler,
+		},
+		{
+			MethodName: "GetInvestorStatement",
+			Handler:    _PaymentService_GetInvestorStatement_Handler,
+		},
+		{
+			MethodName: "ListFlaggedPayments",
+			Handler:    _PaymentService_ListFlaggedPayments_Handler,
+		},
+		{
+			MethodName: "GetReceipt",
+			Handler:    _PaymentService_GetReceipt_Handler,
+		},
+		{
+			MethodName: "GetInstallmentPlan",
+			Handler:    _PaymentService_GetInstallmentPlan_Handler,
+		},
+		{
+			MethodName: "ListInstallmentPlans",
+			Handler:    _PaymentService_ListInstallmentPlans_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "payment/v1/payment.proto",